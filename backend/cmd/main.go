@@ -16,6 +16,7 @@ import (
 	// 新增：将通知 Worker 作为一个独立的服务加入生命周期管理
 	"github.com/chaitin/WhaleHire/backend/internal/notification/worker"
 	resumemailboxscheduler "github.com/chaitin/WhaleHire/backend/internal/resume_mailbox/scheduler"
+	screeningworker "github.com/chaitin/WhaleHire/backend/internal/screening/worker"
 )
 
 // @title WhaleHire API
@@ -66,6 +67,7 @@ func main() {
 	svc.Add(resumemailboxscheduler.NewServicer(s.resumeMailboxScheduler))
 	// 新增：将通知 Worker 封装为 Servicer，交由 Service 管理
 	svc.Add(worker.NewServicer(s.notificationWorker))
+	svc.Add(screeningworker.NewServicer(s.screeningWorker))
 	if err := svc.Run(); err != nil {
 		panic(err)
 	}
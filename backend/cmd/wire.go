@@ -13,6 +13,7 @@ import (
 	"github.com/chaitin/WhaleHire/backend/config"
 	"github.com/chaitin/WhaleHire/backend/db"
 	auditV1 "github.com/chaitin/WhaleHire/backend/internal/audit/handler/v1"
+	deadLetterV1 "github.com/chaitin/WhaleHire/backend/internal/deadletter/handler/v1"
 	departmentV1 "github.com/chaitin/WhaleHire/backend/internal/department/handler/v1"
 	fileV1 "github.com/chaitin/WhaleHire/backend/internal/file/handler/v1"
 	generalagentV1 "github.com/chaitin/WhaleHire/backend/internal/general_agent/handler/v1"
@@ -24,6 +25,7 @@ import (
 	resumeMailboxSettingV1 "github.com/chaitin/WhaleHire/backend/internal/resume_mailbox/handler/v1"
 	resumemailboxscheduler "github.com/chaitin/WhaleHire/backend/internal/resume_mailbox/scheduler"
 	screeningV1 "github.com/chaitin/WhaleHire/backend/internal/screening/handler/v1"
+	screeningworker "github.com/chaitin/WhaleHire/backend/internal/screening/worker"
 	universityV1 "github.com/chaitin/WhaleHire/backend/internal/university/handler/v1"
 	userV1 "github.com/chaitin/WhaleHire/backend/internal/user/handler/v1"
 	"github.com/chaitin/WhaleHire/backend/pkg/version"
@@ -45,10 +47,12 @@ type Server struct {
 	auditV1                  *auditV1.AuditHandler
 	fileV1                   *fileV1.FileHandler
 	notificationWorker       *notificationworker.NotificationWorker
+	screeningWorker          *screeningworker.ScreeningWorker
 	notificationV1           *notificationV1.NotificationSettingHandler
 	resumeMailboxScheduler   *resumemailboxscheduler.Scheduler
 	resumeMailboxSettingV1   *resumeMailboxSettingV1.ResumeMailboxSettingHandler
 	resumeMailboxStatisticV1 *resumeMailboxSettingV1.ResumeMailboxStatisticHandler
+	deadLetterV1             *deadLetterV1.DeadLetterHandler
 	version                  *version.VersionInfo
 }
 
@@ -15,6 +15,8 @@ import (
 	v1_9 "github.com/chaitin/WhaleHire/backend/internal/audit/handler/v1"
 	"github.com/chaitin/WhaleHire/backend/internal/audit/repo"
 	usecase10 "github.com/chaitin/WhaleHire/backend/internal/audit/usecase"
+	v1_13 "github.com/chaitin/WhaleHire/backend/internal/deadletter/handler/v1"
+	usecase13 "github.com/chaitin/WhaleHire/backend/internal/deadletter/usecase"
 	v1_5 "github.com/chaitin/WhaleHire/backend/internal/department/handler/v1"
 	repo8 "github.com/chaitin/WhaleHire/backend/internal/department/repo"
 	usecase7 "github.com/chaitin/WhaleHire/backend/internal/department/usecase"
@@ -49,6 +51,7 @@ import (
 	repo9 "github.com/chaitin/WhaleHire/backend/internal/screening/repo"
 	service3 "github.com/chaitin/WhaleHire/backend/internal/screening/service"
 	usecase8 "github.com/chaitin/WhaleHire/backend/internal/screening/usecase"
+	worker2 "github.com/chaitin/WhaleHire/backend/internal/screening/worker"
 	v1_8 "github.com/chaitin/WhaleHire/backend/internal/university/handler/v1"
 	repo10 "github.com/chaitin/WhaleHire/backend/internal/university/repo"
 	usecase9 "github.com/chaitin/WhaleHire/backend/internal/university/usecase"
@@ -104,16 +107,21 @@ func newServer() (*Server, error) {
 		return nil, err
 	}
 	storageService := service.NewStorageService(minioClient, configConfig, slogLogger, userRepo)
+	resumeEmbeddingService := service.NewEmbeddingService(configConfig)
 	jobApplicationRepo := repo4.NewJobApplicationRepo(client)
 	jobProfileRepo := repo5.NewJobProfileRepo(client)
 	jobApplicationUsecase := usecase2.NewJobApplicationUsecase(jobApplicationRepo, jobProfileRepo)
 	notificationEventRepo := repo6.NewNotificationEventRepo(client)
 	notificationSettingRepo := repo6.NewNotificationSettingRepo(client)
 	notificationSettingUsecase := usecase3.NewNotificationSettingUsecase(notificationSettingRepo, slogLogger)
-	producer := internal.NewQueueProducer(redisClient, configConfig)
-	notificationUsecase := usecase3.NewNotificationUsecase(notificationEventRepo, notificationSettingUsecase, producer, slogLogger)
-	resumeUsecase := usecase4.NewResumeUsecase(configConfig, resumeRepo, parserService, storageService, jobApplicationUsecase, notificationUsecase, redisClient, slogLogger)
-	resumeHandler := v1_2.NewResumeHandler(web, resumeUsecase, jobApplicationUsecase, redisClient, authMiddleware, slogLogger)
+	backend, err := internal.NewQueueBackend(redisClient, configConfig)
+	if err != nil {
+		return nil, err
+	}
+	producer := internal.NewQueueProducer(backend)
+	notificationUsecase := usecase3.NewNotificationUsecase(notificationEventRepo, notificationSettingUsecase, jobProfileRepo, producer, slogLogger)
+	resumeUsecase := usecase4.NewResumeUsecase(configConfig, resumeRepo, parserService, storageService, resumeEmbeddingService, jobApplicationUsecase, notificationUsecase, redisClient, slogLogger)
+	resumeHandler := v1_2.NewResumeHandler(web, resumeUsecase, jobApplicationUsecase, redisClient, authMiddleware, activeMiddleware, readOnlyMiddleware, slogLogger)
 	generalAgentRepo := repo7.NewGeneralAgentRepo(client)
 	generalAgentUsecase := usecase5.NewGeneralAgentUsecase(configConfig, generalAgentRepo)
 	generalAgentHandler := v1_3.NewGeneralAgentHandler(web, generalAgentUsecase, authMiddleware, sessionSession, slogLogger, configConfig)
@@ -136,8 +144,19 @@ func newServer() (*Server, error) {
 	if err != nil {
 		return nil, err
 	}
+	shortlistService, err := service3.NewShortlistService(configConfig, slogLogger)
+	if err != nil {
+		return nil, err
+	}
+	jobRecommendService, err := service3.NewJobRecommendService(configConfig, slogLogger)
+	if err != nil {
+		return nil, err
+	}
 	weightTemplateRepo := repo9.NewWeightTemplateRepo(client)
-	screeningUsecase := usecase8.NewScreeningUsecase(screeningRepo, screeningNodeRunRepo, jobProfileUsecase, resumeUsecase, userRepo, matchingService, weightPreviewService, notificationUsecase, weightTemplateRepo, configConfig, slogLogger)
+	screeningDimensionRepo := repo9.NewScreeningDimensionRepo(client)
+	screeningScheduleRepo := repo9.NewScreeningScheduleRepo(client)
+	reportService := service3.NewReportService(configConfig, slogLogger)
+	screeningUsecase := usecase8.NewScreeningUsecase(screeningRepo, screeningNodeRunRepo, jobProfileUsecase, resumeUsecase, userRepo, matchingService, weightPreviewService, shortlistService, jobRecommendService, reportService, notificationUsecase, weightTemplateRepo, screeningDimensionRepo, screeningScheduleRepo, producer, redisClient, minioClient, configConfig, slogLogger)
 	screeningHandler := v1_7.NewScreeningHandler(web, screeningUsecase, authMiddleware, slogLogger)
 	universityRepo := repo10.NewUniversityRepo(client, configConfig)
 	universityUsecase := usecase9.NewUniversityUsecase(universityRepo)
@@ -146,9 +165,13 @@ func newServer() (*Server, error) {
 	auditHandler := v1_9.NewAuditHandler(web, auditUsecase, authMiddleware, slogLogger)
 	fileUsecase := usecase11.NewFileUsecase(slogLogger, minioClient, configConfig)
 	fileHandler := v1_10.NewFileHandler(web, fileUsecase, authMiddleware)
-	consumer := internal.NewQueueConsumer(redisClient, configConfig)
+	consumer := internal.NewQueueConsumer(backend)
 	dingTalkAdapter := adapter.NewDingTalkAdapter(notificationSettingUsecase, slogLogger)
-	notificationWorker := worker.NewNotificationWorker(consumer, notificationEventRepo, dingTalkAdapter, slogLogger)
+	emailAdapter := adapter.NewEmailAdapter(notificationSettingUsecase, slogLogger)
+	webhookAdapter := adapter.NewWebhookAdapter(notificationSettingUsecase, slogLogger)
+	deadLetterQueue := internal.NewDeadLetterQueue(backend)
+	notificationWorker := worker.NewNotificationWorker(consumer, producer, deadLetterQueue, notificationEventRepo, dingTalkAdapter, emailAdapter, webhookAdapter, slogLogger)
+	screeningWorker := worker2.NewScreeningWorker(consumer, screeningUsecase, slogLogger)
 	notificationSettingHandler := v1_11.NewNotificationSettingHandler(web, notificationSettingUsecase, slogLogger, authMiddleware)
 	resumeMailboxSettingRepo := repo11.NewResumeMailboxSettingRepo(client)
 	resumeMailboxCursorRepo := repo11.NewResumeMailboxCursorRepo(client)
@@ -158,13 +181,15 @@ func newServer() (*Server, error) {
 		return nil, err
 	}
 	mailboxAdapterFactory := adapter2.NewAdapterFactory(slogLogger)
-	resumeMailboxSyncUsecase := usecase12.NewResumeMailboxSyncUsecase(resumeMailboxSettingRepo, resumeMailboxCursorRepo, resumeMailboxStatisticRepo, credentialVault, mailboxAdapterFactory, resumeUsecase, jobApplicationUsecase, slogLogger)
+	resumeMailboxSyncUsecase := usecase12.NewResumeMailboxSyncUsecase(resumeMailboxSettingRepo, resumeMailboxCursorRepo, resumeMailboxStatisticRepo, credentialVault, mailboxAdapterFactory, resumeUsecase, jobApplicationUsecase, screeningUsecase, slogLogger)
 	schedulerScheduler := scheduler.NewScheduler(resumeMailboxSettingRepo, resumeMailboxSyncUsecase, slogLogger)
 	resumeMailboxScheduler := internal.NewResumeMailboxScheduler(schedulerScheduler)
 	resumeMailboxStatisticUsecase := usecase12.NewResumeMailboxStatisticUsecase(resumeMailboxStatisticRepo)
 	resumeMailboxSettingUsecase := usecase12.NewResumeMailboxSettingUsecase(resumeMailboxSettingRepo, credentialVault, mailboxAdapterFactory, resumeMailboxScheduler, jobProfileUsecase, resumeMailboxStatisticUsecase)
 	resumeMailboxSettingHandler := v1_12.NewResumeMailboxSettingHandler(web, resumeMailboxSettingUsecase, resumeMailboxSyncUsecase, slogLogger, authMiddleware)
 	resumeMailboxStatisticHandler := v1_12.NewResumeMailboxStatisticHandler(web, resumeMailboxStatisticUsecase, slogLogger, authMiddleware)
+	deadLetterUsecase := usecase13.NewDeadLetterUsecase(deadLetterQueue, notificationEventRepo, screeningRepo, slogLogger)
+	deadLetterHandler := v1_13.NewDeadLetterHandler(web, deadLetterUsecase, authMiddleware, activeMiddleware, readOnlyMiddleware, slogLogger)
 	versionInfo := version.NewVersionInfo()
 	server := &Server{
 		config:                   configConfig,
@@ -182,10 +207,12 @@ func newServer() (*Server, error) {
 		auditV1:                  auditHandler,
 		fileV1:                   fileHandler,
 		notificationWorker:       notificationWorker,
+		screeningWorker:          screeningWorker,
 		notificationV1:           notificationSettingHandler,
 		resumeMailboxScheduler:   schedulerScheduler,
 		resumeMailboxSettingV1:   resumeMailboxSettingHandler,
 		resumeMailboxStatisticV1: resumeMailboxStatisticHandler,
+		deadLetterV1:             deadLetterHandler,
 		version:                  versionInfo,
 	}
 	return server, nil
@@ -209,9 +236,11 @@ type Server struct {
 	auditV1                  *v1_9.AuditHandler
 	fileV1                   *v1_10.FileHandler
 	notificationWorker       *worker.NotificationWorker
+	screeningWorker          *worker2.ScreeningWorker
 	notificationV1           *v1_11.NotificationSettingHandler
 	resumeMailboxScheduler   *scheduler.Scheduler
 	resumeMailboxSettingV1   *v1_12.ResumeMailboxSettingHandler
 	resumeMailboxStatisticV1 *v1_12.ResumeMailboxStatisticHandler
+	deadLetterV1             *v1_13.DeadLetterHandler
 	version                  *version.VersionInfo
 }
@@ -96,10 +96,23 @@ type Config struct {
 
 	// DocumentParser 文档解析配置
 	DocumentParser struct {
-		APIKey     string `mapstructure:"api_key" json:"api_key"`
-		BaseURL    string `mapstructure:"base_url" json:"base_url"`
-		Timeout    int    `mapstructure:"timeout" json:"timeout"`
-		MaxRetries int    `mapstructure:"max_retries" json:"max_retries"`
+		Backend      string `mapstructure:"backend" json:"backend"`             // 文本提取后端：remote（默认，调用外部文件解析 API）或 local（本地提取，文件不出内网，需显式开启）
+		APIKey       string `mapstructure:"api_key" json:"api_key"`             // remote 后端的 API Key
+		BaseURL      string `mapstructure:"base_url" json:"base_url"`           // remote 后端的服务地址
+		Timeout      int    `mapstructure:"timeout" json:"timeout"`             // 单次提取超时秒数
+		MaxRetries   int    `mapstructure:"max_retries" json:"max_retries"`     // remote 后端最大重试次数
+		DocConverter string `mapstructure:"doc_converter" json:"doc_converter"` // local 后端提取 .doc 使用的转换命令，需将文本输出到标准输出，如 antiword
+
+		// OCR local 后端识别扫描版 PDF 与图片简历的配置
+		OCR struct {
+			Engine       string `mapstructure:"engine" json:"engine"`                 // OCR 引擎：tesseract（本地识别）或 none（关闭）
+			Command      string `mapstructure:"command" json:"command"`               // tesseract 可执行文件
+			Languages    string `mapstructure:"languages" json:"languages"`           // 识别语言，如 chi_sim+eng
+			Renderer     string `mapstructure:"renderer" json:"renderer"`             // PDF 页面转图片命令，如 pdftoppm
+			DPI          int    `mapstructure:"dpi" json:"dpi"`                       // PDF 页面渲染分辨率
+			Timeout      int    `mapstructure:"timeout" json:"timeout"`               // 单页渲染或识别超时秒数
+			MinPageChars int    `mapstructure:"min_page_chars" json:"min_page_chars"` // 页面有效字符数低于该值时视为扫描页
+		} `mapstructure:"ocr" json:"ocr"`
 	} `mapstructure:"document_parser" json:"document_parser"`
 
 	// Langsmith 配置
@@ -107,10 +120,30 @@ type Config struct {
 		APIKey string `mapstructure:"api_key" json:"api_key"`
 	} `mapstructure:"langsmith" json:"langsmith"`
 
+	// 消息队列配置
+	Queue struct {
+		Backend        string `mapstructure:"backend" json:"backend"`                 // 队列后端：redis 或 memory
+		PendingTimeout int    `mapstructure:"pending_timeout" json:"pending_timeout"` // 已投递未确认消息重新投递前的空闲秒数
+		ClaimInterval  int    `mapstructure:"claim_interval" json:"claim_interval"`   // 回收待确认消息的间隔秒数
+		MaxDeliveries  int64  `mapstructure:"max_deliveries" json:"max_deliveries"`   // 最大投递次数，超过后转入死信队列，0 表示不限制
+	} `mapstructure:"queue" json:"queue"`
+
+	// 模型计费配置
+	LLMPricing struct {
+		Currency string `mapstructure:"currency" json:"currency"` // 计费币种
+		Models   string `mapstructure:"models" json:"models"`     // 覆盖内置价格，格式: openai/gpt-4o=2.5:10;deepseek-chat=0.27:1.1（每百万 tokens 输入:输出单价）
+	} `mapstructure:"llm_pricing" json:"llm_pricing"`
+
 	// 凭证加密配置
 	Credential struct {
 		EncryptionKey string `mapstructure:"encryption_key" json:"encryption_key"`
 	} `mapstructure:"credential" json:"credential"`
+
+	// 筛选结果导出配置
+	ScreeningExport struct {
+		AsyncThreshold int    `mapstructure:"async_threshold" json:"async_threshold"` // 筛选结果数超过该值时异步导出，通过下载链接获取文件
+		FontPath       string `mapstructure:"font_path" json:"font_path"`             // PDF 报告使用的中文 TrueType 字体路径
+	} `mapstructure:"screening_export" json:"screening_export"`
 }
 
 func (c *Config) GetBaseURL(req *http.Request, settings *domain.Setting) string {
@@ -197,20 +230,43 @@ func Init() (*Config, error) {
 	// 文件存储默认配置
 	v.SetDefault("file_storage.local_path", "./uploads")
 	v.SetDefault("file_storage.max_file_size", 10485760) // 10MB
-	v.SetDefault("file_storage.allowed_types", []string{".pdf", ".docx", ".doc"})
+	v.SetDefault("file_storage.allowed_types", []string{".pdf", ".docx", ".doc", ".txt", ".html", ".htm", ".rtf", ".jpg", ".jpeg", ".png"})
 
 	// 文件内容提取默认配置
+	v.SetDefault("document_parser.backend", "remote")
 	v.SetDefault("document_parser.api_key", "")
 	v.SetDefault("document_parser.base_url", "https://api.moonshot.cn/v1")
 	v.SetDefault("document_parser.timeout", 30)
 	v.SetDefault("document_parser.max_retries", 3)
+	v.SetDefault("document_parser.doc_converter", "antiword")
+	v.SetDefault("document_parser.ocr.engine", "tesseract")
+	v.SetDefault("document_parser.ocr.command", "tesseract")
+	v.SetDefault("document_parser.ocr.languages", "chi_sim+eng")
+	v.SetDefault("document_parser.ocr.renderer", "pdftoppm")
+	v.SetDefault("document_parser.ocr.dpi", 300)
+	v.SetDefault("document_parser.ocr.timeout", 60)
+	v.SetDefault("document_parser.ocr.min_page_chars", 20)
 
 	// Langsmith 默认配置
 	v.SetDefault("langsmith.api_key", "")
 
+	// 消息队列默认配置
+	v.SetDefault("queue.backend", "redis")
+	v.SetDefault("queue.pending_timeout", 300)
+	v.SetDefault("queue.claim_interval", 30)
+	v.SetDefault("queue.max_deliveries", 5)
+
+	// 模型计费默认配置
+	v.SetDefault("llm_pricing.currency", "USD")
+	v.SetDefault("llm_pricing.models", "")
+
 	// 凭证加密默认配置
 	v.SetDefault("credential.encryption_key", "")
 
+	// 筛选结果导出默认配置
+	v.SetDefault("screening_export.async_threshold", 200)
+	v.SetDefault("screening_export.font_path", "/usr/share/fonts/droid-nonlatin/DroidSansFallbackFull.ttf")
+
 	// 打印从环境变量中读取的所有配置值
 	fmt.Println("从环境变量读取的配置值:")
 	fmt.Println("Database Master:", v.GetString("database.master"))
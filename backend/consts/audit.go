@@ -38,6 +38,7 @@ const (
 	ResourceTypeNotificationSetting    ResourceType = "notification_setting"     // 通知设置
 	ResourceTypeResumeMailboxSetting   ResourceType = "resume_mailbox_setting"   // 简历邮箱设置
 	ResourceTypeResumeMailboxStatistic ResourceType = "resume_mailbox_statistic" // 简历邮箱统计
+	ResourceTypeDeadLetter             ResourceType = "dead_letter"              // 死信消息
 )
 
 // AuditLogStatus 审计日志状态
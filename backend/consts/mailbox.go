@@ -4,8 +4,9 @@ package consts
 type MailboxProtocol string
 
 const (
-	MailboxProtocolIMAP MailboxProtocol = "imap" // IMAP协议
-	MailboxProtocolPOP3 MailboxProtocol = "pop3" // POP3协议
+	MailboxProtocolIMAP  MailboxProtocol = "imap"  // IMAP协议
+	MailboxProtocolPOP3  MailboxProtocol = "pop3"  // POP3协议
+	MailboxProtocolGraph MailboxProtocol = "graph" // Microsoft Graph 邮件API（Exchange Online）
 )
 
 // Values 返回所有邮箱协议值
@@ -13,6 +14,7 @@ func (MailboxProtocol) Values() []MailboxProtocol {
 	return []MailboxProtocol{
 		MailboxProtocolIMAP,
 		MailboxProtocolPOP3,
+		MailboxProtocolGraph,
 	}
 }
 
@@ -32,6 +34,8 @@ type MailboxAuthType string
 const (
 	MailboxAuthTypePassword MailboxAuthType = "password" // 密码认证
 	MailboxAuthTypeOAuth    MailboxAuthType = "oauth"    // OAuth认证
+	// MailboxAuthTypeClientCredentials OAuth2 客户端凭据（应用权限），用于 Microsoft Graph
+	MailboxAuthTypeClientCredentials MailboxAuthType = "client_credentials"
 )
 
 // Values 返回所有邮箱认证类型值
@@ -39,6 +43,7 @@ func (MailboxAuthType) Values() []MailboxAuthType {
 	return []MailboxAuthType{
 		MailboxAuthTypePassword,
 		MailboxAuthTypeOAuth,
+		MailboxAuthTypeClientCredentials,
 	}
 }
 
@@ -78,6 +83,64 @@ func (m MailboxStatus) IsValid() bool {
 	return false
 }
 
+// MailboxExtractionMode 邮件简历提取方式
+type MailboxExtractionMode string
+
+const (
+	MailboxExtractionModeAttachments      MailboxExtractionMode = "attachments"       // 仅附件
+	MailboxExtractionModeAttachmentsBody  MailboxExtractionMode = "attachments_body"  // 附件 + HTML 正文转文档
+	MailboxExtractionModeAttachmentsLinks MailboxExtractionMode = "attachments_links" // 附件 + 白名单域名内的简历链接
+)
+
+// Values 返回所有邮件简历提取方式值
+func (MailboxExtractionMode) Values() []MailboxExtractionMode {
+	return []MailboxExtractionMode{
+		MailboxExtractionModeAttachments,
+		MailboxExtractionModeAttachmentsBody,
+		MailboxExtractionModeAttachmentsLinks,
+	}
+}
+
+// IsValid 检查邮件简历提取方式是否有效
+func (m MailboxExtractionMode) IsValid() bool {
+	for _, v := range MailboxExtractionMode("").Values() {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MailboxRuleAction 邮箱过滤规则动作
+type MailboxRuleAction string
+
+const (
+	MailboxRuleActionSkip   MailboxRuleAction = "skip"   // 跳过邮件
+	MailboxRuleActionAssign MailboxRuleAction = "assign" // 关联到指定岗位
+	MailboxRuleActionTag    MailboxRuleAction = "tag"    // 设置投递来源
+	MailboxRuleActionScreen MailboxRuleAction = "screen" // 入库后自动发起筛选
+)
+
+// Values 返回所有邮箱过滤规则动作值
+func (MailboxRuleAction) Values() []MailboxRuleAction {
+	return []MailboxRuleAction{
+		MailboxRuleActionSkip,
+		MailboxRuleActionAssign,
+		MailboxRuleActionTag,
+		MailboxRuleActionScreen,
+	}
+}
+
+// IsValid 检查邮箱过滤规则动作是否有效
+func (a MailboxRuleAction) IsValid() bool {
+	for _, v := range MailboxRuleAction("").Values() {
+		if a == v {
+			return true
+		}
+	}
+	return false
+}
+
 // ResumeSourceType 简历来源类型
 type ResumeSourceType string
 
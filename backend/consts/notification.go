@@ -41,3 +41,16 @@ const (
 	// NotificationStatusCancelled 已取消
 	NotificationStatusCancelled NotificationStatus = "cancelled"
 )
+
+const (
+	// WebhookEnvelopeVersion Webhook 消息信封版本
+	WebhookEnvelopeVersion = "v1"
+	// WebhookHeaderEvent Webhook 事件类型请求头
+	WebhookHeaderEvent = "X-WhaleHire-Event"
+	// WebhookHeaderEventID Webhook 事件ID请求头，可用于接收方幂等处理
+	WebhookHeaderEventID = "X-WhaleHire-Event-ID"
+	// WebhookHeaderTimestamp Webhook 签名时间戳请求头（Unix 秒），用于防重放
+	WebhookHeaderTimestamp = "X-WhaleHire-Timestamp"
+	// WebhookHeaderSignature Webhook 签名请求头，格式为 sha256=<hex>
+	WebhookHeaderSignature = "X-WhaleHire-Signature"
+)
@@ -0,0 +1,53 @@
+package consts
+
+// QueueStream 队列流名称
+type QueueStream string
+
+const (
+	QueueStreamNotificationEvents   QueueStream = "notification:events"    // 通知事件流
+	QueueStreamScreeningTaskResumes QueueStream = "screening:task-resumes" // 筛选任务简历流，每条消息对应一份待筛选简历
+)
+
+// Values 返回所有队列流名称
+func (QueueStream) Values() []QueueStream {
+	return []QueueStream{
+		QueueStreamNotificationEvents,
+		QueueStreamScreeningTaskResumes,
+	}
+}
+
+// IsValid 检查队列流名称是否有效
+func (s QueueStream) IsValid() bool {
+	for _, v := range QueueStream("").Values() {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// QueueBackend 消息队列后端类型
+type QueueBackend string
+
+const (
+	QueueBackendRedis  QueueBackend = "redis"  // Redis Streams
+	QueueBackendMemory QueueBackend = "memory" // 进程内队列，适用于单实例部署与集成测试
+)
+
+// Values 返回所有队列后端类型
+func (QueueBackend) Values() []QueueBackend {
+	return []QueueBackend{
+		QueueBackendRedis,
+		QueueBackendMemory,
+	}
+}
+
+// IsValid 检查队列后端类型是否有效
+func (b QueueBackend) IsValid() bool {
+	for _, v := range QueueBackend("").Values() {
+		if b == v {
+			return true
+		}
+	}
+	return false
+}
@@ -150,6 +150,64 @@ func (e EmploymentStatus) IsValid() bool {
 	return false
 }
 
+// ResumeDuplicateStatus 疑似重复简历的处理状态
+type ResumeDuplicateStatus string
+
+const (
+	ResumeDuplicateStatusPending   ResumeDuplicateStatus = "pending"   // 待确认
+	ResumeDuplicateStatusMerged    ResumeDuplicateStatus = "merged"    // 已合并
+	ResumeDuplicateStatusDismissed ResumeDuplicateStatus = "dismissed" // 已忽略，确认不是同一候选人
+)
+
+// Values 返回所有重复处理状态值
+func (ResumeDuplicateStatus) Values() []ResumeDuplicateStatus {
+	return []ResumeDuplicateStatus{
+		ResumeDuplicateStatusPending,
+		ResumeDuplicateStatusMerged,
+		ResumeDuplicateStatusDismissed,
+	}
+}
+
+// IsValid 检查重复处理状态是否有效
+func (s ResumeDuplicateStatus) IsValid() bool {
+	for _, v := range ResumeDuplicateStatus("").Values() {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// ResumeDuplicateReason 重复判定依据
+type ResumeDuplicateReason string
+
+const (
+	ResumeDuplicateReasonFileHash   ResumeDuplicateReason = "file_hash"  // 简历文件内容相同
+	ResumeDuplicateReasonPhone      ResumeDuplicateReason = "phone"      // 手机号相同
+	ResumeDuplicateReasonEmail      ResumeDuplicateReason = "email"      // 邮箱相同
+	ResumeDuplicateReasonName       ResumeDuplicateReason = "name"       // 姓名相似
+	ResumeDuplicateReasonEducation  ResumeDuplicateReason = "education"  // 教育经历相似
+	ResumeDuplicateReasonExperience ResumeDuplicateReason = "experience" // 工作经历相似
+)
+
+// ResumeChunkType 简历语义检索分块类型
+type ResumeChunkType string
+
+const (
+	ResumeChunkTypeSummary    ResumeChunkType = "summary"    // 个人简介
+	ResumeChunkTypeExperience ResumeChunkType = "experience" // 工作经历
+	ResumeChunkTypeProject    ResumeChunkType = "project"    // 项目经验
+)
+
+// Values 返回所有分块类型值
+func (ResumeChunkType) Values() []ResumeChunkType {
+	return []ResumeChunkType{
+		ResumeChunkTypeSummary,
+		ResumeChunkTypeExperience,
+		ResumeChunkTypeProject,
+	}
+}
+
 const (
 	// Redis keys
 	BatchUploadTaskKeyFmt = "batch_upload:task:%s"
@@ -6,6 +6,7 @@ type ScreeningTaskStatus string
 const (
 	ScreeningTaskStatusPending   ScreeningTaskStatus = "pending"   // 待处理
 	ScreeningTaskStatusRunning   ScreeningTaskStatus = "running"   // 运行中
+	ScreeningTaskStatusPaused    ScreeningTaskStatus = "paused"    // 已暂停（预算耗尽）
 	ScreeningTaskStatusCompleted ScreeningTaskStatus = "completed" // 已完成
 	ScreeningTaskStatusFailed    ScreeningTaskStatus = "failed"    // 失败
 	ScreeningTaskStatusCancelled ScreeningTaskStatus = "cancelled" // 已取消
@@ -64,6 +65,7 @@ func (ScreeningTaskStatus) Values() []ScreeningTaskStatus {
 	return []ScreeningTaskStatus{
 		ScreeningTaskStatusPending,
 		ScreeningTaskStatusRunning,
+		ScreeningTaskStatusPaused,
 		ScreeningTaskStatusCompleted,
 		ScreeningTaskStatusFailed,
 	}
@@ -149,3 +151,86 @@ func (m MatchLevel) IsValid() bool {
 	}
 	return false
 }
+
+// ScreeningScheduleMode 岗位自动筛选模式
+type ScreeningScheduleMode string
+
+const (
+	ScreeningScheduleModeInstant ScreeningScheduleMode = "instant" // 新投递解析完成后随即筛选
+	ScreeningScheduleModeDigest  ScreeningScheduleMode = "digest"  // 每日定时汇总筛选
+)
+
+// Values 返回所有自动筛选模式值
+func (ScreeningScheduleMode) Values() []ScreeningScheduleMode {
+	return []ScreeningScheduleMode{
+		ScreeningScheduleModeInstant,
+		ScreeningScheduleModeDigest,
+	}
+}
+
+// IsValid 检查自动筛选模式是否有效
+func (m ScreeningScheduleMode) IsValid() bool {
+	for _, v := range m.Values() {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// ScreeningExportFormat 筛选结果导出格式
+type ScreeningExportFormat string
+
+const (
+	ScreeningExportFormatXLSX ScreeningExportFormat = "xlsx" // Excel 排名表
+	ScreeningExportFormatCSV  ScreeningExportFormat = "csv"  // CSV 排名表
+	ScreeningExportFormatPDF  ScreeningExportFormat = "pdf"  // 逐个候选人的 PDF 匹配报告
+)
+
+// Values 返回所有导出格式值
+func (ScreeningExportFormat) Values() []ScreeningExportFormat {
+	return []ScreeningExportFormat{
+		ScreeningExportFormatXLSX,
+		ScreeningExportFormatCSV,
+		ScreeningExportFormatPDF,
+	}
+}
+
+// IsValid 检查导出格式是否有效
+func (f ScreeningExportFormat) IsValid() bool {
+	for _, v := range f.Values() {
+		if f == v {
+			return true
+		}
+	}
+	return false
+}
+
+// ContentType 返回导出文件的 MIME 类型
+func (f ScreeningExportFormat) ContentType() string {
+	switch f {
+	case ScreeningExportFormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case ScreeningExportFormatCSV:
+		return "text/csv; charset=utf-8"
+	case ScreeningExportFormatPDF:
+		return "application/pdf"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// ScreeningExportStatus 筛选结果异步导出状态
+type ScreeningExportStatus string
+
+const (
+	ScreeningExportStatusPending   ScreeningExportStatus = "pending"   // 等待生成
+	ScreeningExportStatusRunning   ScreeningExportStatus = "running"   // 生成中
+	ScreeningExportStatusCompleted ScreeningExportStatus = "completed" // 已完成，可下载
+	ScreeningExportStatusFailed    ScreeningExportStatus = "failed"    // 生成失败
+)
+
+const (
+	// ScreeningExportKeyFmt 异步导出状态的 Redis 键，参数为导出ID
+	ScreeningExportKeyFmt = "screening_export:%s"
+)
@@ -34,7 +34,9 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db/notificationevent"
 	"github.com/chaitin/WhaleHire/backend/db/notificationsetting"
 	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumechunk"
 	"github.com/chaitin/WhaleHire/backend/db/resumedocumentparse"
+	"github.com/chaitin/WhaleHire/backend/db/resumeduplicate"
 	"github.com/chaitin/WhaleHire/backend/db/resumeeducation"
 	"github.com/chaitin/WhaleHire/backend/db/resumeexperience"
 	"github.com/chaitin/WhaleHire/backend/db/resumejobapplication"
@@ -45,9 +47,11 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db/resumeproject"
 	"github.com/chaitin/WhaleHire/backend/db/resumeskill"
 	"github.com/chaitin/WhaleHire/backend/db/role"
+	"github.com/chaitin/WhaleHire/backend/db/screeningdimension"
 	"github.com/chaitin/WhaleHire/backend/db/screeningnoderun"
 	"github.com/chaitin/WhaleHire/backend/db/screeningresult"
 	"github.com/chaitin/WhaleHire/backend/db/screeningrunmetric"
+	"github.com/chaitin/WhaleHire/backend/db/screeningschedule"
 	"github.com/chaitin/WhaleHire/backend/db/screeningtask"
 	"github.com/chaitin/WhaleHire/backend/db/screeningtaskresume"
 	"github.com/chaitin/WhaleHire/backend/db/setting"
@@ -101,8 +105,12 @@ type Client struct {
 	NotificationSetting *NotificationSettingClient
 	// Resume is the client for interacting with the Resume builders.
 	Resume *ResumeClient
+	// ResumeChunk is the client for interacting with the ResumeChunk builders.
+	ResumeChunk *ResumeChunkClient
 	// ResumeDocumentParse is the client for interacting with the ResumeDocumentParse builders.
 	ResumeDocumentParse *ResumeDocumentParseClient
+	// ResumeDuplicate is the client for interacting with the ResumeDuplicate builders.
+	ResumeDuplicate *ResumeDuplicateClient
 	// ResumeEducation is the client for interacting with the ResumeEducation builders.
 	ResumeEducation *ResumeEducationClient
 	// ResumeExperience is the client for interacting with the ResumeExperience builders.
@@ -123,12 +131,16 @@ type Client struct {
 	ResumeSkill *ResumeSkillClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// ScreeningDimension is the client for interacting with the ScreeningDimension builders.
+	ScreeningDimension *ScreeningDimensionClient
 	// ScreeningNodeRun is the client for interacting with the ScreeningNodeRun builders.
 	ScreeningNodeRun *ScreeningNodeRunClient
 	// ScreeningResult is the client for interacting with the ScreeningResult builders.
 	ScreeningResult *ScreeningResultClient
 	// ScreeningRunMetric is the client for interacting with the ScreeningRunMetric builders.
 	ScreeningRunMetric *ScreeningRunMetricClient
+	// ScreeningSchedule is the client for interacting with the ScreeningSchedule builders.
+	ScreeningSchedule *ScreeningScheduleClient
 	// ScreeningTask is the client for interacting with the ScreeningTask builders.
 	ScreeningTask *ScreeningTaskClient
 	// ScreeningTaskResume is the client for interacting with the ScreeningTaskResume builders.
@@ -174,7 +186,9 @@ func (c *Client) init() {
 	c.NotificationEvent = NewNotificationEventClient(c.config)
 	c.NotificationSetting = NewNotificationSettingClient(c.config)
 	c.Resume = NewResumeClient(c.config)
+	c.ResumeChunk = NewResumeChunkClient(c.config)
 	c.ResumeDocumentParse = NewResumeDocumentParseClient(c.config)
+	c.ResumeDuplicate = NewResumeDuplicateClient(c.config)
 	c.ResumeEducation = NewResumeEducationClient(c.config)
 	c.ResumeExperience = NewResumeExperienceClient(c.config)
 	c.ResumeJobApplication = NewResumeJobApplicationClient(c.config)
@@ -185,9 +199,11 @@ func (c *Client) init() {
 	c.ResumeProject = NewResumeProjectClient(c.config)
 	c.ResumeSkill = NewResumeSkillClient(c.config)
 	c.Role = NewRoleClient(c.config)
+	c.ScreeningDimension = NewScreeningDimensionClient(c.config)
 	c.ScreeningNodeRun = NewScreeningNodeRunClient(c.config)
 	c.ScreeningResult = NewScreeningResultClient(c.config)
 	c.ScreeningRunMetric = NewScreeningRunMetricClient(c.config)
+	c.ScreeningSchedule = NewScreeningScheduleClient(c.config)
 	c.ScreeningTask = NewScreeningTaskClient(c.config)
 	c.ScreeningTaskResume = NewScreeningTaskResumeClient(c.config)
 	c.Setting = NewSettingClient(c.config)
@@ -306,7 +322,9 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		NotificationEvent:        NewNotificationEventClient(cfg),
 		NotificationSetting:      NewNotificationSettingClient(cfg),
 		Resume:                   NewResumeClient(cfg),
+		ResumeChunk:              NewResumeChunkClient(cfg),
 		ResumeDocumentParse:      NewResumeDocumentParseClient(cfg),
+		ResumeDuplicate:          NewResumeDuplicateClient(cfg),
 		ResumeEducation:          NewResumeEducationClient(cfg),
 		ResumeExperience:         NewResumeExperienceClient(cfg),
 		ResumeJobApplication:     NewResumeJobApplicationClient(cfg),
@@ -317,9 +335,11 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ResumeProject:            NewResumeProjectClient(cfg),
 		ResumeSkill:              NewResumeSkillClient(cfg),
 		Role:                     NewRoleClient(cfg),
+		ScreeningDimension:       NewScreeningDimensionClient(cfg),
 		ScreeningNodeRun:         NewScreeningNodeRunClient(cfg),
 		ScreeningResult:          NewScreeningResultClient(cfg),
 		ScreeningRunMetric:       NewScreeningRunMetricClient(cfg),
+		ScreeningSchedule:        NewScreeningScheduleClient(cfg),
 		ScreeningTask:            NewScreeningTaskClient(cfg),
 		ScreeningTaskResume:      NewScreeningTaskResumeClient(cfg),
 		Setting:                  NewSettingClient(cfg),
@@ -365,7 +385,9 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		NotificationEvent:        NewNotificationEventClient(cfg),
 		NotificationSetting:      NewNotificationSettingClient(cfg),
 		Resume:                   NewResumeClient(cfg),
+		ResumeChunk:              NewResumeChunkClient(cfg),
 		ResumeDocumentParse:      NewResumeDocumentParseClient(cfg),
+		ResumeDuplicate:          NewResumeDuplicateClient(cfg),
 		ResumeEducation:          NewResumeEducationClient(cfg),
 		ResumeExperience:         NewResumeExperienceClient(cfg),
 		ResumeJobApplication:     NewResumeJobApplicationClient(cfg),
@@ -376,9 +398,11 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ResumeProject:            NewResumeProjectClient(cfg),
 		ResumeSkill:              NewResumeSkillClient(cfg),
 		Role:                     NewRoleClient(cfg),
+		ScreeningDimension:       NewScreeningDimensionClient(cfg),
 		ScreeningNodeRun:         NewScreeningNodeRunClient(cfg),
 		ScreeningResult:          NewScreeningResultClient(cfg),
 		ScreeningRunMetric:       NewScreeningRunMetricClient(cfg),
+		ScreeningSchedule:        NewScreeningScheduleClient(cfg),
 		ScreeningTask:            NewScreeningTaskClient(cfg),
 		ScreeningTaskResume:      NewScreeningTaskResumeClient(cfg),
 		Setting:                  NewSettingClient(cfg),
@@ -420,13 +444,14 @@ func (c *Client) Use(hooks ...Hook) {
 		c.Conversation, c.Department, c.JobEducationRequirement,
 		c.JobExperienceRequirement, c.JobIndustryRequirement, c.JobPosition,
 		c.JobResponsibility, c.JobSkill, c.JobSkillMeta, c.Message,
-		c.NotificationEvent, c.NotificationSetting, c.Resume, c.ResumeDocumentParse,
-		c.ResumeEducation, c.ResumeExperience, c.ResumeJobApplication, c.ResumeLog,
-		c.ResumeMailboxCursor, c.ResumeMailboxSetting, c.ResumeMailboxStatistic,
-		c.ResumeProject, c.ResumeSkill, c.Role, c.ScreeningNodeRun, c.ScreeningResult,
-		c.ScreeningRunMetric, c.ScreeningTask, c.ScreeningTaskResume, c.Setting,
-		c.UniversityProfile, c.User, c.UserIdentity, c.UserLoginHistory,
-		c.WeightTemplate,
+		c.NotificationEvent, c.NotificationSetting, c.Resume, c.ResumeChunk,
+		c.ResumeDocumentParse, c.ResumeDuplicate, c.ResumeEducation,
+		c.ResumeExperience, c.ResumeJobApplication, c.ResumeLog, c.ResumeMailboxCursor,
+		c.ResumeMailboxSetting, c.ResumeMailboxStatistic, c.ResumeProject,
+		c.ResumeSkill, c.Role, c.ScreeningDimension, c.ScreeningNodeRun,
+		c.ScreeningResult, c.ScreeningRunMetric, c.ScreeningSchedule, c.ScreeningTask,
+		c.ScreeningTaskResume, c.Setting, c.UniversityProfile, c.User, c.UserIdentity,
+		c.UserLoginHistory, c.WeightTemplate,
 	} {
 		n.Use(hooks...)
 	}
@@ -440,13 +465,14 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 		c.Conversation, c.Department, c.JobEducationRequirement,
 		c.JobExperienceRequirement, c.JobIndustryRequirement, c.JobPosition,
 		c.JobResponsibility, c.JobSkill, c.JobSkillMeta, c.Message,
-		c.NotificationEvent, c.NotificationSetting, c.Resume, c.ResumeDocumentParse,
-		c.ResumeEducation, c.ResumeExperience, c.ResumeJobApplication, c.ResumeLog,
-		c.ResumeMailboxCursor, c.ResumeMailboxSetting, c.ResumeMailboxStatistic,
-		c.ResumeProject, c.ResumeSkill, c.Role, c.ScreeningNodeRun, c.ScreeningResult,
-		c.ScreeningRunMetric, c.ScreeningTask, c.ScreeningTaskResume, c.Setting,
-		c.UniversityProfile, c.User, c.UserIdentity, c.UserLoginHistory,
-		c.WeightTemplate,
+		c.NotificationEvent, c.NotificationSetting, c.Resume, c.ResumeChunk,
+		c.ResumeDocumentParse, c.ResumeDuplicate, c.ResumeEducation,
+		c.ResumeExperience, c.ResumeJobApplication, c.ResumeLog, c.ResumeMailboxCursor,
+		c.ResumeMailboxSetting, c.ResumeMailboxStatistic, c.ResumeProject,
+		c.ResumeSkill, c.Role, c.ScreeningDimension, c.ScreeningNodeRun,
+		c.ScreeningResult, c.ScreeningRunMetric, c.ScreeningSchedule, c.ScreeningTask,
+		c.ScreeningTaskResume, c.Setting, c.UniversityProfile, c.User, c.UserIdentity,
+		c.UserLoginHistory, c.WeightTemplate,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -491,8 +517,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.NotificationSetting.mutate(ctx, m)
 	case *ResumeMutation:
 		return c.Resume.mutate(ctx, m)
+	case *ResumeChunkMutation:
+		return c.ResumeChunk.mutate(ctx, m)
 	case *ResumeDocumentParseMutation:
 		return c.ResumeDocumentParse.mutate(ctx, m)
+	case *ResumeDuplicateMutation:
+		return c.ResumeDuplicate.mutate(ctx, m)
 	case *ResumeEducationMutation:
 		return c.ResumeEducation.mutate(ctx, m)
 	case *ResumeExperienceMutation:
@@ -513,12 +543,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ResumeSkill.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
+	case *ScreeningDimensionMutation:
+		return c.ScreeningDimension.mutate(ctx, m)
 	case *ScreeningNodeRunMutation:
 		return c.ScreeningNodeRun.mutate(ctx, m)
 	case *ScreeningResultMutation:
 		return c.ScreeningResult.mutate(ctx, m)
 	case *ScreeningRunMetricMutation:
 		return c.ScreeningRunMetric.mutate(ctx, m)
+	case *ScreeningScheduleMutation:
+		return c.ScreeningSchedule.mutate(ctx, m)
 	case *ScreeningTaskMutation:
 		return c.ScreeningTask.mutate(ctx, m)
 	case *ScreeningTaskResumeMutation:
@@ -2360,6 +2394,38 @@ func (c *JobPositionClient) QueryScreeningResults(jp *JobPosition) *ScreeningRes
 	return query
 }
 
+// QueryScreeningDimensions queries the screening_dimensions edge of a JobPosition.
+func (c *JobPositionClient) QueryScreeningDimensions(jp *JobPosition) *ScreeningDimensionQuery {
+	query := (&ScreeningDimensionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := jp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(jobposition.Table, jobposition.FieldID, id),
+			sqlgraph.To(screeningdimension.Table, screeningdimension.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, jobposition.ScreeningDimensionsTable, jobposition.ScreeningDimensionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(jp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScreeningSchedule queries the screening_schedule edge of a JobPosition.
+func (c *JobPositionClient) QueryScreeningSchedule(jp *JobPosition) *ScreeningScheduleQuery {
+	query := (&ScreeningScheduleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := jp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(jobposition.Table, jobposition.FieldID, id),
+			sqlgraph.To(screeningschedule.Table, screeningschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, jobposition.ScreeningScheduleTable, jobposition.ScreeningScheduleColumn),
+		)
+		fromV = sqlgraph.Neighbors(jp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *JobPositionClient) Hooks() []Hook {
 	hooks := c.hooks.JobPosition
@@ -3561,6 +3627,54 @@ func (c *ResumeClient) QueryScreeningResults(r *Resume) *ScreeningResultQuery {
 	return query
 }
 
+// QueryDuplicateFlags queries the duplicate_flags edge of a Resume.
+func (c *ResumeClient) QueryDuplicateFlags(r *Resume) *ResumeDuplicateQuery {
+	query := (&ResumeDuplicateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resume.Table, resume.FieldID, id),
+			sqlgraph.To(resumeduplicate.Table, resumeduplicate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resume.DuplicateFlagsTable, resume.DuplicateFlagsColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDuplicatedBy queries the duplicated_by edge of a Resume.
+func (c *ResumeClient) QueryDuplicatedBy(r *Resume) *ResumeDuplicateQuery {
+	query := (&ResumeDuplicateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resume.Table, resume.FieldID, id),
+			sqlgraph.To(resumeduplicate.Table, resumeduplicate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resume.DuplicatedByTable, resume.DuplicatedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChunks queries the chunks edge of a Resume.
+func (c *ResumeClient) QueryChunks(r *Resume) *ResumeChunkQuery {
+	query := (&ResumeChunkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resume.Table, resume.FieldID, id),
+			sqlgraph.To(resumechunk.Table, resumechunk.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resume.ChunksTable, resume.ChunksColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ResumeClient) Hooks() []Hook {
 	hooks := c.hooks.Resume
@@ -3588,6 +3702,155 @@ func (c *ResumeClient) mutate(ctx context.Context, m *ResumeMutation) (Value, er
 	}
 }
 
+// ResumeChunkClient is a client for the ResumeChunk schema.
+type ResumeChunkClient struct {
+	config
+}
+
+// NewResumeChunkClient returns a client for the ResumeChunk from the given config.
+func NewResumeChunkClient(c config) *ResumeChunkClient {
+	return &ResumeChunkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `resumechunk.Hooks(f(g(h())))`.
+func (c *ResumeChunkClient) Use(hooks ...Hook) {
+	c.hooks.ResumeChunk = append(c.hooks.ResumeChunk, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `resumechunk.Intercept(f(g(h())))`.
+func (c *ResumeChunkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResumeChunk = append(c.inters.ResumeChunk, interceptors...)
+}
+
+// Create returns a builder for creating a ResumeChunk entity.
+func (c *ResumeChunkClient) Create() *ResumeChunkCreate {
+	mutation := newResumeChunkMutation(c.config, OpCreate)
+	return &ResumeChunkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResumeChunk entities.
+func (c *ResumeChunkClient) CreateBulk(builders ...*ResumeChunkCreate) *ResumeChunkCreateBulk {
+	return &ResumeChunkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResumeChunkClient) MapCreateBulk(slice any, setFunc func(*ResumeChunkCreate, int)) *ResumeChunkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResumeChunkCreateBulk{err: fmt.Errorf("calling to ResumeChunkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ResumeChunkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResumeChunkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ResumeChunk.
+func (c *ResumeChunkClient) Update() *ResumeChunkUpdate {
+	mutation := newResumeChunkMutation(c.config, OpUpdate)
+	return &ResumeChunkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResumeChunkClient) UpdateOne(rc *ResumeChunk) *ResumeChunkUpdateOne {
+	mutation := newResumeChunkMutation(c.config, OpUpdateOne, withResumeChunk(rc))
+	return &ResumeChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResumeChunkClient) UpdateOneID(id uuid.UUID) *ResumeChunkUpdateOne {
+	mutation := newResumeChunkMutation(c.config, OpUpdateOne, withResumeChunkID(id))
+	return &ResumeChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ResumeChunk.
+func (c *ResumeChunkClient) Delete() *ResumeChunkDelete {
+	mutation := newResumeChunkMutation(c.config, OpDelete)
+	return &ResumeChunkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResumeChunkClient) DeleteOne(rc *ResumeChunk) *ResumeChunkDeleteOne {
+	return c.DeleteOneID(rc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ResumeChunkClient) DeleteOneID(id uuid.UUID) *ResumeChunkDeleteOne {
+	builder := c.Delete().Where(resumechunk.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResumeChunkDeleteOne{builder}
+}
+
+// Query returns a query builder for ResumeChunk.
+func (c *ResumeChunkClient) Query() *ResumeChunkQuery {
+	return &ResumeChunkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeResumeChunk},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ResumeChunk entity by its id.
+func (c *ResumeChunkClient) Get(ctx context.Context, id uuid.UUID) (*ResumeChunk, error) {
+	return c.Query().Where(resumechunk.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResumeChunkClient) GetX(ctx context.Context, id uuid.UUID) *ResumeChunk {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryResume queries the resume edge of a ResumeChunk.
+func (c *ResumeChunkClient) QueryResume(rc *ResumeChunk) *ResumeQuery {
+	query := (&ResumeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resumechunk.Table, resumechunk.FieldID, id),
+			sqlgraph.To(resume.Table, resume.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resumechunk.ResumeTable, resumechunk.ResumeColumn),
+		)
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ResumeChunkClient) Hooks() []Hook {
+	return c.hooks.ResumeChunk
+}
+
+// Interceptors returns the client interceptors.
+func (c *ResumeChunkClient) Interceptors() []Interceptor {
+	return c.inters.ResumeChunk
+}
+
+func (c *ResumeChunkClient) mutate(ctx context.Context, m *ResumeChunkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ResumeChunkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ResumeChunkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ResumeChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ResumeChunkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown ResumeChunk mutation op: %q", m.Op())
+	}
+}
+
 // ResumeDocumentParseClient is a client for the ResumeDocumentParse schema.
 type ResumeDocumentParseClient struct {
 	config
@@ -3739,6 +4002,171 @@ func (c *ResumeDocumentParseClient) mutate(ctx context.Context, m *ResumeDocumen
 	}
 }
 
+// ResumeDuplicateClient is a client for the ResumeDuplicate schema.
+type ResumeDuplicateClient struct {
+	config
+}
+
+// NewResumeDuplicateClient returns a client for the ResumeDuplicate from the given config.
+func NewResumeDuplicateClient(c config) *ResumeDuplicateClient {
+	return &ResumeDuplicateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `resumeduplicate.Hooks(f(g(h())))`.
+func (c *ResumeDuplicateClient) Use(hooks ...Hook) {
+	c.hooks.ResumeDuplicate = append(c.hooks.ResumeDuplicate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `resumeduplicate.Intercept(f(g(h())))`.
+func (c *ResumeDuplicateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResumeDuplicate = append(c.inters.ResumeDuplicate, interceptors...)
+}
+
+// Create returns a builder for creating a ResumeDuplicate entity.
+func (c *ResumeDuplicateClient) Create() *ResumeDuplicateCreate {
+	mutation := newResumeDuplicateMutation(c.config, OpCreate)
+	return &ResumeDuplicateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResumeDuplicate entities.
+func (c *ResumeDuplicateClient) CreateBulk(builders ...*ResumeDuplicateCreate) *ResumeDuplicateCreateBulk {
+	return &ResumeDuplicateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResumeDuplicateClient) MapCreateBulk(slice any, setFunc func(*ResumeDuplicateCreate, int)) *ResumeDuplicateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResumeDuplicateCreateBulk{err: fmt.Errorf("calling to ResumeDuplicateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ResumeDuplicateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResumeDuplicateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ResumeDuplicate.
+func (c *ResumeDuplicateClient) Update() *ResumeDuplicateUpdate {
+	mutation := newResumeDuplicateMutation(c.config, OpUpdate)
+	return &ResumeDuplicateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResumeDuplicateClient) UpdateOne(rd *ResumeDuplicate) *ResumeDuplicateUpdateOne {
+	mutation := newResumeDuplicateMutation(c.config, OpUpdateOne, withResumeDuplicate(rd))
+	return &ResumeDuplicateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResumeDuplicateClient) UpdateOneID(id uuid.UUID) *ResumeDuplicateUpdateOne {
+	mutation := newResumeDuplicateMutation(c.config, OpUpdateOne, withResumeDuplicateID(id))
+	return &ResumeDuplicateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ResumeDuplicate.
+func (c *ResumeDuplicateClient) Delete() *ResumeDuplicateDelete {
+	mutation := newResumeDuplicateMutation(c.config, OpDelete)
+	return &ResumeDuplicateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResumeDuplicateClient) DeleteOne(rd *ResumeDuplicate) *ResumeDuplicateDeleteOne {
+	return c.DeleteOneID(rd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ResumeDuplicateClient) DeleteOneID(id uuid.UUID) *ResumeDuplicateDeleteOne {
+	builder := c.Delete().Where(resumeduplicate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResumeDuplicateDeleteOne{builder}
+}
+
+// Query returns a query builder for ResumeDuplicate.
+func (c *ResumeDuplicateClient) Query() *ResumeDuplicateQuery {
+	return &ResumeDuplicateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeResumeDuplicate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ResumeDuplicate entity by its id.
+func (c *ResumeDuplicateClient) Get(ctx context.Context, id uuid.UUID) (*ResumeDuplicate, error) {
+	return c.Query().Where(resumeduplicate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResumeDuplicateClient) GetX(ctx context.Context, id uuid.UUID) *ResumeDuplicate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryResume queries the resume edge of a ResumeDuplicate.
+func (c *ResumeDuplicateClient) QueryResume(rd *ResumeDuplicate) *ResumeQuery {
+	query := (&ResumeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resumeduplicate.Table, resumeduplicate.FieldID, id),
+			sqlgraph.To(resume.Table, resume.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resumeduplicate.ResumeTable, resumeduplicate.ResumeColumn),
+		)
+		fromV = sqlgraph.Neighbors(rd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDuplicateResume queries the duplicate_resume edge of a ResumeDuplicate.
+func (c *ResumeDuplicateClient) QueryDuplicateResume(rd *ResumeDuplicate) *ResumeQuery {
+	query := (&ResumeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resumeduplicate.Table, resumeduplicate.FieldID, id),
+			sqlgraph.To(resume.Table, resume.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resumeduplicate.DuplicateResumeTable, resumeduplicate.DuplicateResumeColumn),
+		)
+		fromV = sqlgraph.Neighbors(rd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ResumeDuplicateClient) Hooks() []Hook {
+	return c.hooks.ResumeDuplicate
+}
+
+// Interceptors returns the client interceptors.
+func (c *ResumeDuplicateClient) Interceptors() []Interceptor {
+	return c.inters.ResumeDuplicate
+}
+
+func (c *ResumeDuplicateClient) mutate(ctx context.Context, m *ResumeDuplicateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ResumeDuplicateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ResumeDuplicateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ResumeDuplicateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ResumeDuplicateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown ResumeDuplicate mutation op: %q", m.Op())
+	}
+}
+
 // ResumeEducationClient is a client for the ResumeEducation schema.
 type ResumeEducationClient struct {
 	config
@@ -5311,6 +5739,157 @@ func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error)
 	}
 }
 
+// ScreeningDimensionClient is a client for the ScreeningDimension schema.
+type ScreeningDimensionClient struct {
+	config
+}
+
+// NewScreeningDimensionClient returns a client for the ScreeningDimension from the given config.
+func NewScreeningDimensionClient(c config) *ScreeningDimensionClient {
+	return &ScreeningDimensionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `screeningdimension.Hooks(f(g(h())))`.
+func (c *ScreeningDimensionClient) Use(hooks ...Hook) {
+	c.hooks.ScreeningDimension = append(c.hooks.ScreeningDimension, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `screeningdimension.Intercept(f(g(h())))`.
+func (c *ScreeningDimensionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScreeningDimension = append(c.inters.ScreeningDimension, interceptors...)
+}
+
+// Create returns a builder for creating a ScreeningDimension entity.
+func (c *ScreeningDimensionClient) Create() *ScreeningDimensionCreate {
+	mutation := newScreeningDimensionMutation(c.config, OpCreate)
+	return &ScreeningDimensionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScreeningDimension entities.
+func (c *ScreeningDimensionClient) CreateBulk(builders ...*ScreeningDimensionCreate) *ScreeningDimensionCreateBulk {
+	return &ScreeningDimensionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScreeningDimensionClient) MapCreateBulk(slice any, setFunc func(*ScreeningDimensionCreate, int)) *ScreeningDimensionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScreeningDimensionCreateBulk{err: fmt.Errorf("calling to ScreeningDimensionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScreeningDimensionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScreeningDimensionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScreeningDimension.
+func (c *ScreeningDimensionClient) Update() *ScreeningDimensionUpdate {
+	mutation := newScreeningDimensionMutation(c.config, OpUpdate)
+	return &ScreeningDimensionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScreeningDimensionClient) UpdateOne(sd *ScreeningDimension) *ScreeningDimensionUpdateOne {
+	mutation := newScreeningDimensionMutation(c.config, OpUpdateOne, withScreeningDimension(sd))
+	return &ScreeningDimensionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScreeningDimensionClient) UpdateOneID(id uuid.UUID) *ScreeningDimensionUpdateOne {
+	mutation := newScreeningDimensionMutation(c.config, OpUpdateOne, withScreeningDimensionID(id))
+	return &ScreeningDimensionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScreeningDimension.
+func (c *ScreeningDimensionClient) Delete() *ScreeningDimensionDelete {
+	mutation := newScreeningDimensionMutation(c.config, OpDelete)
+	return &ScreeningDimensionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScreeningDimensionClient) DeleteOne(sd *ScreeningDimension) *ScreeningDimensionDeleteOne {
+	return c.DeleteOneID(sd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScreeningDimensionClient) DeleteOneID(id uuid.UUID) *ScreeningDimensionDeleteOne {
+	builder := c.Delete().Where(screeningdimension.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScreeningDimensionDeleteOne{builder}
+}
+
+// Query returns a query builder for ScreeningDimension.
+func (c *ScreeningDimensionClient) Query() *ScreeningDimensionQuery {
+	return &ScreeningDimensionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScreeningDimension},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScreeningDimension entity by its id.
+func (c *ScreeningDimensionClient) Get(ctx context.Context, id uuid.UUID) (*ScreeningDimension, error) {
+	return c.Query().Where(screeningdimension.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScreeningDimensionClient) GetX(ctx context.Context, id uuid.UUID) *ScreeningDimension {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryJobPosition queries the job_position edge of a ScreeningDimension.
+func (c *ScreeningDimensionClient) QueryJobPosition(sd *ScreeningDimension) *JobPositionQuery {
+	query := (&JobPositionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(screeningdimension.Table, screeningdimension.FieldID, id),
+			sqlgraph.To(jobposition.Table, jobposition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, screeningdimension.JobPositionTable, screeningdimension.JobPositionColumn),
+		)
+		fromV = sqlgraph.Neighbors(sd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ScreeningDimensionClient) Hooks() []Hook {
+	hooks := c.hooks.ScreeningDimension
+	return append(hooks[:len(hooks):len(hooks)], screeningdimension.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScreeningDimensionClient) Interceptors() []Interceptor {
+	inters := c.inters.ScreeningDimension
+	return append(inters[:len(inters):len(inters)], screeningdimension.Interceptors[:]...)
+}
+
+func (c *ScreeningDimensionClient) mutate(ctx context.Context, m *ScreeningDimensionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScreeningDimensionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScreeningDimensionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScreeningDimensionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScreeningDimensionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown ScreeningDimension mutation op: %q", m.Op())
+	}
+}
+
 // ScreeningNodeRunClient is a client for the ScreeningNodeRun schema.
 type ScreeningNodeRunClient struct {
 	config
@@ -5812,6 +6391,155 @@ func (c *ScreeningRunMetricClient) mutate(ctx context.Context, m *ScreeningRunMe
 	}
 }
 
+// ScreeningScheduleClient is a client for the ScreeningSchedule schema.
+type ScreeningScheduleClient struct {
+	config
+}
+
+// NewScreeningScheduleClient returns a client for the ScreeningSchedule from the given config.
+func NewScreeningScheduleClient(c config) *ScreeningScheduleClient {
+	return &ScreeningScheduleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `screeningschedule.Hooks(f(g(h())))`.
+func (c *ScreeningScheduleClient) Use(hooks ...Hook) {
+	c.hooks.ScreeningSchedule = append(c.hooks.ScreeningSchedule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `screeningschedule.Intercept(f(g(h())))`.
+func (c *ScreeningScheduleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScreeningSchedule = append(c.inters.ScreeningSchedule, interceptors...)
+}
+
+// Create returns a builder for creating a ScreeningSchedule entity.
+func (c *ScreeningScheduleClient) Create() *ScreeningScheduleCreate {
+	mutation := newScreeningScheduleMutation(c.config, OpCreate)
+	return &ScreeningScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScreeningSchedule entities.
+func (c *ScreeningScheduleClient) CreateBulk(builders ...*ScreeningScheduleCreate) *ScreeningScheduleCreateBulk {
+	return &ScreeningScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScreeningScheduleClient) MapCreateBulk(slice any, setFunc func(*ScreeningScheduleCreate, int)) *ScreeningScheduleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScreeningScheduleCreateBulk{err: fmt.Errorf("calling to ScreeningScheduleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScreeningScheduleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScreeningScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScreeningSchedule.
+func (c *ScreeningScheduleClient) Update() *ScreeningScheduleUpdate {
+	mutation := newScreeningScheduleMutation(c.config, OpUpdate)
+	return &ScreeningScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScreeningScheduleClient) UpdateOne(ss *ScreeningSchedule) *ScreeningScheduleUpdateOne {
+	mutation := newScreeningScheduleMutation(c.config, OpUpdateOne, withScreeningSchedule(ss))
+	return &ScreeningScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScreeningScheduleClient) UpdateOneID(id uuid.UUID) *ScreeningScheduleUpdateOne {
+	mutation := newScreeningScheduleMutation(c.config, OpUpdateOne, withScreeningScheduleID(id))
+	return &ScreeningScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScreeningSchedule.
+func (c *ScreeningScheduleClient) Delete() *ScreeningScheduleDelete {
+	mutation := newScreeningScheduleMutation(c.config, OpDelete)
+	return &ScreeningScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScreeningScheduleClient) DeleteOne(ss *ScreeningSchedule) *ScreeningScheduleDeleteOne {
+	return c.DeleteOneID(ss.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScreeningScheduleClient) DeleteOneID(id uuid.UUID) *ScreeningScheduleDeleteOne {
+	builder := c.Delete().Where(screeningschedule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScreeningScheduleDeleteOne{builder}
+}
+
+// Query returns a query builder for ScreeningSchedule.
+func (c *ScreeningScheduleClient) Query() *ScreeningScheduleQuery {
+	return &ScreeningScheduleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScreeningSchedule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScreeningSchedule entity by its id.
+func (c *ScreeningScheduleClient) Get(ctx context.Context, id uuid.UUID) (*ScreeningSchedule, error) {
+	return c.Query().Where(screeningschedule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScreeningScheduleClient) GetX(ctx context.Context, id uuid.UUID) *ScreeningSchedule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryJobPosition queries the job_position edge of a ScreeningSchedule.
+func (c *ScreeningScheduleClient) QueryJobPosition(ss *ScreeningSchedule) *JobPositionQuery {
+	query := (&JobPositionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ss.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(screeningschedule.Table, screeningschedule.FieldID, id),
+			sqlgraph.To(jobposition.Table, jobposition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, screeningschedule.JobPositionTable, screeningschedule.JobPositionColumn),
+		)
+		fromV = sqlgraph.Neighbors(ss.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ScreeningScheduleClient) Hooks() []Hook {
+	return c.hooks.ScreeningSchedule
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScreeningScheduleClient) Interceptors() []Interceptor {
+	return c.inters.ScreeningSchedule
+}
+
+func (c *ScreeningScheduleClient) mutate(ctx context.Context, m *ScreeningScheduleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScreeningScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScreeningScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScreeningScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScreeningScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown ScreeningSchedule mutation op: %q", m.Op())
+	}
+}
+
 // ScreeningTaskClient is a client for the ScreeningTask schema.
 type ScreeningTaskClient struct {
 	config
@@ -7198,25 +7926,25 @@ type (
 		Admin, AdminLoginHistory, AdminRole, Attachment, AuditLog, Conversation,
 		Department, JobEducationRequirement, JobExperienceRequirement,
 		JobIndustryRequirement, JobPosition, JobResponsibility, JobSkill, JobSkillMeta,
-		Message, NotificationEvent, NotificationSetting, Resume, ResumeDocumentParse,
-		ResumeEducation, ResumeExperience, ResumeJobApplication, ResumeLog,
-		ResumeMailboxCursor, ResumeMailboxSetting, ResumeMailboxStatistic,
-		ResumeProject, ResumeSkill, Role, ScreeningNodeRun, ScreeningResult,
-		ScreeningRunMetric, ScreeningTask, ScreeningTaskResume, Setting,
-		UniversityProfile, User, UserIdentity, UserLoginHistory,
-		WeightTemplate []ent.Hook
+		Message, NotificationEvent, NotificationSetting, Resume, ResumeChunk,
+		ResumeDocumentParse, ResumeDuplicate, ResumeEducation, ResumeExperience,
+		ResumeJobApplication, ResumeLog, ResumeMailboxCursor, ResumeMailboxSetting,
+		ResumeMailboxStatistic, ResumeProject, ResumeSkill, Role, ScreeningDimension,
+		ScreeningNodeRun, ScreeningResult, ScreeningRunMetric, ScreeningSchedule,
+		ScreeningTask, ScreeningTaskResume, Setting, UniversityProfile, User,
+		UserIdentity, UserLoginHistory, WeightTemplate []ent.Hook
 	}
 	inters struct {
 		Admin, AdminLoginHistory, AdminRole, Attachment, AuditLog, Conversation,
 		Department, JobEducationRequirement, JobExperienceRequirement,
 		JobIndustryRequirement, JobPosition, JobResponsibility, JobSkill, JobSkillMeta,
-		Message, NotificationEvent, NotificationSetting, Resume, ResumeDocumentParse,
-		ResumeEducation, ResumeExperience, ResumeJobApplication, ResumeLog,
-		ResumeMailboxCursor, ResumeMailboxSetting, ResumeMailboxStatistic,
-		ResumeProject, ResumeSkill, Role, ScreeningNodeRun, ScreeningResult,
-		ScreeningRunMetric, ScreeningTask, ScreeningTaskResume, Setting,
-		UniversityProfile, User, UserIdentity, UserLoginHistory,
-		WeightTemplate []ent.Interceptor
+		Message, NotificationEvent, NotificationSetting, Resume, ResumeChunk,
+		ResumeDocumentParse, ResumeDuplicate, ResumeEducation, ResumeExperience,
+		ResumeJobApplication, ResumeLog, ResumeMailboxCursor, ResumeMailboxSetting,
+		ResumeMailboxStatistic, ResumeProject, ResumeSkill, Role, ScreeningDimension,
+		ScreeningNodeRun, ScreeningResult, ScreeningRunMetric, ScreeningSchedule,
+		ScreeningTask, ScreeningTaskResume, Setting, UniversityProfile, User,
+		UserIdentity, UserLoginHistory, WeightTemplate []ent.Interceptor
 	}
 )
 
@@ -30,7 +30,9 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db/notificationevent"
 	"github.com/chaitin/WhaleHire/backend/db/notificationsetting"
 	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumechunk"
 	"github.com/chaitin/WhaleHire/backend/db/resumedocumentparse"
+	"github.com/chaitin/WhaleHire/backend/db/resumeduplicate"
 	"github.com/chaitin/WhaleHire/backend/db/resumeeducation"
 	"github.com/chaitin/WhaleHire/backend/db/resumeexperience"
 	"github.com/chaitin/WhaleHire/backend/db/resumejobapplication"
@@ -41,9 +43,11 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db/resumeproject"
 	"github.com/chaitin/WhaleHire/backend/db/resumeskill"
 	"github.com/chaitin/WhaleHire/backend/db/role"
+	"github.com/chaitin/WhaleHire/backend/db/screeningdimension"
 	"github.com/chaitin/WhaleHire/backend/db/screeningnoderun"
 	"github.com/chaitin/WhaleHire/backend/db/screeningresult"
 	"github.com/chaitin/WhaleHire/backend/db/screeningrunmetric"
+	"github.com/chaitin/WhaleHire/backend/db/screeningschedule"
 	"github.com/chaitin/WhaleHire/backend/db/screeningtask"
 	"github.com/chaitin/WhaleHire/backend/db/screeningtaskresume"
 	"github.com/chaitin/WhaleHire/backend/db/setting"
@@ -130,7 +134,9 @@ func checkColumn(table, column string) error {
 			notificationevent.Table:        notificationevent.ValidColumn,
 			notificationsetting.Table:      notificationsetting.ValidColumn,
 			resume.Table:                   resume.ValidColumn,
+			resumechunk.Table:              resumechunk.ValidColumn,
 			resumedocumentparse.Table:      resumedocumentparse.ValidColumn,
+			resumeduplicate.Table:          resumeduplicate.ValidColumn,
 			resumeeducation.Table:          resumeeducation.ValidColumn,
 			resumeexperience.Table:         resumeexperience.ValidColumn,
 			resumejobapplication.Table:     resumejobapplication.ValidColumn,
@@ -141,9 +147,11 @@ func checkColumn(table, column string) error {
 			resumeproject.Table:            resumeproject.ValidColumn,
 			resumeskill.Table:              resumeskill.ValidColumn,
 			role.Table:                     role.ValidColumn,
+			screeningdimension.Table:       screeningdimension.ValidColumn,
 			screeningnoderun.Table:         screeningnoderun.ValidColumn,
 			screeningresult.Table:          screeningresult.ValidColumn,
 			screeningrunmetric.Table:       screeningrunmetric.ValidColumn,
+			screeningschedule.Table:        screeningschedule.ValidColumn,
 			screeningtask.Table:            screeningtask.ValidColumn,
 			screeningtaskresume.Table:      screeningtaskresume.ValidColumn,
 			setting.Table:                  setting.ValidColumn,
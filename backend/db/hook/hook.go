@@ -225,6 +225,18 @@ func (f ResumeFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ResumeMutation", m)
 }
 
+// The ResumeChunkFunc type is an adapter to allow the use of ordinary
+// function as ResumeChunk mutator.
+type ResumeChunkFunc func(context.Context, *db.ResumeChunkMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ResumeChunkFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.ResumeChunkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ResumeChunkMutation", m)
+}
+
 // The ResumeDocumentParseFunc type is an adapter to allow the use of ordinary
 // function as ResumeDocumentParse mutator.
 type ResumeDocumentParseFunc func(context.Context, *db.ResumeDocumentParseMutation) (db.Value, error)
@@ -237,6 +249,18 @@ func (f ResumeDocumentParseFunc) Mutate(ctx context.Context, m db.Mutation) (db.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ResumeDocumentParseMutation", m)
 }
 
+// The ResumeDuplicateFunc type is an adapter to allow the use of ordinary
+// function as ResumeDuplicate mutator.
+type ResumeDuplicateFunc func(context.Context, *db.ResumeDuplicateMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ResumeDuplicateFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.ResumeDuplicateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ResumeDuplicateMutation", m)
+}
+
 // The ResumeEducationFunc type is an adapter to allow the use of ordinary
 // function as ResumeEducation mutator.
 type ResumeEducationFunc func(context.Context, *db.ResumeEducationMutation) (db.Value, error)
@@ -357,6 +381,18 @@ func (f RoleFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.RoleMutation", m)
 }
 
+// The ScreeningDimensionFunc type is an adapter to allow the use of ordinary
+// function as ScreeningDimension mutator.
+type ScreeningDimensionFunc func(context.Context, *db.ScreeningDimensionMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScreeningDimensionFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.ScreeningDimensionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ScreeningDimensionMutation", m)
+}
+
 // The ScreeningNodeRunFunc type is an adapter to allow the use of ordinary
 // function as ScreeningNodeRun mutator.
 type ScreeningNodeRunFunc func(context.Context, *db.ScreeningNodeRunMutation) (db.Value, error)
@@ -393,6 +429,18 @@ func (f ScreeningRunMetricFunc) Mutate(ctx context.Context, m db.Mutation) (db.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ScreeningRunMetricMutation", m)
 }
 
+// The ScreeningScheduleFunc type is an adapter to allow the use of ordinary
+// function as ScreeningSchedule mutator.
+type ScreeningScheduleFunc func(context.Context, *db.ScreeningScheduleMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScreeningScheduleFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.ScreeningScheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ScreeningScheduleMutation", m)
+}
+
 // The ScreeningTaskFunc type is an adapter to allow the use of ordinary
 // function as ScreeningTask mutator.
 type ScreeningTaskFunc func(context.Context, *db.ScreeningTaskMutation) (db.Value, error)
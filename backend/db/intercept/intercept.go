@@ -27,7 +27,9 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db/notificationsetting"
 	"github.com/chaitin/WhaleHire/backend/db/predicate"
 	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumechunk"
 	"github.com/chaitin/WhaleHire/backend/db/resumedocumentparse"
+	"github.com/chaitin/WhaleHire/backend/db/resumeduplicate"
 	"github.com/chaitin/WhaleHire/backend/db/resumeeducation"
 	"github.com/chaitin/WhaleHire/backend/db/resumeexperience"
 	"github.com/chaitin/WhaleHire/backend/db/resumejobapplication"
@@ -38,9 +40,11 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db/resumeproject"
 	"github.com/chaitin/WhaleHire/backend/db/resumeskill"
 	"github.com/chaitin/WhaleHire/backend/db/role"
+	"github.com/chaitin/WhaleHire/backend/db/screeningdimension"
 	"github.com/chaitin/WhaleHire/backend/db/screeningnoderun"
 	"github.com/chaitin/WhaleHire/backend/db/screeningresult"
 	"github.com/chaitin/WhaleHire/backend/db/screeningrunmetric"
+	"github.com/chaitin/WhaleHire/backend/db/screeningschedule"
 	"github.com/chaitin/WhaleHire/backend/db/screeningtask"
 	"github.com/chaitin/WhaleHire/backend/db/screeningtaskresume"
 	"github.com/chaitin/WhaleHire/backend/db/setting"
@@ -593,6 +597,33 @@ func (f TraverseResume) Traverse(ctx context.Context, q db.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *db.ResumeQuery", q)
 }
 
+// The ResumeChunkFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ResumeChunkFunc func(context.Context, *db.ResumeChunkQuery) (db.Value, error)
+
+// Query calls f(ctx, q).
+func (f ResumeChunkFunc) Query(ctx context.Context, q db.Query) (db.Value, error) {
+	if q, ok := q.(*db.ResumeChunkQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *db.ResumeChunkQuery", q)
+}
+
+// The TraverseResumeChunk type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseResumeChunk func(context.Context, *db.ResumeChunkQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseResumeChunk) Intercept(next db.Querier) db.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseResumeChunk) Traverse(ctx context.Context, q db.Query) error {
+	if q, ok := q.(*db.ResumeChunkQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *db.ResumeChunkQuery", q)
+}
+
 // The ResumeDocumentParseFunc type is an adapter to allow the use of ordinary function as a Querier.
 type ResumeDocumentParseFunc func(context.Context, *db.ResumeDocumentParseQuery) (db.Value, error)
 
@@ -620,6 +651,33 @@ func (f TraverseResumeDocumentParse) Traverse(ctx context.Context, q db.Query) e
 	return fmt.Errorf("unexpected query type %T. expect *db.ResumeDocumentParseQuery", q)
 }
 
+// The ResumeDuplicateFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ResumeDuplicateFunc func(context.Context, *db.ResumeDuplicateQuery) (db.Value, error)
+
+// Query calls f(ctx, q).
+func (f ResumeDuplicateFunc) Query(ctx context.Context, q db.Query) (db.Value, error) {
+	if q, ok := q.(*db.ResumeDuplicateQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *db.ResumeDuplicateQuery", q)
+}
+
+// The TraverseResumeDuplicate type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseResumeDuplicate func(context.Context, *db.ResumeDuplicateQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseResumeDuplicate) Intercept(next db.Querier) db.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseResumeDuplicate) Traverse(ctx context.Context, q db.Query) error {
+	if q, ok := q.(*db.ResumeDuplicateQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *db.ResumeDuplicateQuery", q)
+}
+
 // The ResumeEducationFunc type is an adapter to allow the use of ordinary function as a Querier.
 type ResumeEducationFunc func(context.Context, *db.ResumeEducationQuery) (db.Value, error)
 
@@ -890,6 +948,33 @@ func (f TraverseRole) Traverse(ctx context.Context, q db.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *db.RoleQuery", q)
 }
 
+// The ScreeningDimensionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ScreeningDimensionFunc func(context.Context, *db.ScreeningDimensionQuery) (db.Value, error)
+
+// Query calls f(ctx, q).
+func (f ScreeningDimensionFunc) Query(ctx context.Context, q db.Query) (db.Value, error) {
+	if q, ok := q.(*db.ScreeningDimensionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *db.ScreeningDimensionQuery", q)
+}
+
+// The TraverseScreeningDimension type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseScreeningDimension func(context.Context, *db.ScreeningDimensionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseScreeningDimension) Intercept(next db.Querier) db.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseScreeningDimension) Traverse(ctx context.Context, q db.Query) error {
+	if q, ok := q.(*db.ScreeningDimensionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *db.ScreeningDimensionQuery", q)
+}
+
 // The ScreeningNodeRunFunc type is an adapter to allow the use of ordinary function as a Querier.
 type ScreeningNodeRunFunc func(context.Context, *db.ScreeningNodeRunQuery) (db.Value, error)
 
@@ -971,6 +1056,33 @@ func (f TraverseScreeningRunMetric) Traverse(ctx context.Context, q db.Query) er
 	return fmt.Errorf("unexpected query type %T. expect *db.ScreeningRunMetricQuery", q)
 }
 
+// The ScreeningScheduleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ScreeningScheduleFunc func(context.Context, *db.ScreeningScheduleQuery) (db.Value, error)
+
+// Query calls f(ctx, q).
+func (f ScreeningScheduleFunc) Query(ctx context.Context, q db.Query) (db.Value, error) {
+	if q, ok := q.(*db.ScreeningScheduleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *db.ScreeningScheduleQuery", q)
+}
+
+// The TraverseScreeningSchedule type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseScreeningSchedule func(context.Context, *db.ScreeningScheduleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseScreeningSchedule) Intercept(next db.Querier) db.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseScreeningSchedule) Traverse(ctx context.Context, q db.Query) error {
+	if q, ok := q.(*db.ScreeningScheduleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *db.ScreeningScheduleQuery", q)
+}
+
 // The ScreeningTaskFunc type is an adapter to allow the use of ordinary function as a Querier.
 type ScreeningTaskFunc func(context.Context, *db.ScreeningTaskQuery) (db.Value, error)
 
@@ -1226,8 +1338,12 @@ func NewQuery(q db.Query) (Query, error) {
 		return &query[*db.NotificationSettingQuery, predicate.NotificationSetting, notificationsetting.OrderOption]{typ: db.TypeNotificationSetting, tq: q}, nil
 	case *db.ResumeQuery:
 		return &query[*db.ResumeQuery, predicate.Resume, resume.OrderOption]{typ: db.TypeResume, tq: q}, nil
+	case *db.ResumeChunkQuery:
+		return &query[*db.ResumeChunkQuery, predicate.ResumeChunk, resumechunk.OrderOption]{typ: db.TypeResumeChunk, tq: q}, nil
 	case *db.ResumeDocumentParseQuery:
 		return &query[*db.ResumeDocumentParseQuery, predicate.ResumeDocumentParse, resumedocumentparse.OrderOption]{typ: db.TypeResumeDocumentParse, tq: q}, nil
+	case *db.ResumeDuplicateQuery:
+		return &query[*db.ResumeDuplicateQuery, predicate.ResumeDuplicate, resumeduplicate.OrderOption]{typ: db.TypeResumeDuplicate, tq: q}, nil
 	case *db.ResumeEducationQuery:
 		return &query[*db.ResumeEducationQuery, predicate.ResumeEducation, resumeeducation.OrderOption]{typ: db.TypeResumeEducation, tq: q}, nil
 	case *db.ResumeExperienceQuery:
@@ -1248,12 +1364,16 @@ func NewQuery(q db.Query) (Query, error) {
 		return &query[*db.ResumeSkillQuery, predicate.ResumeSkill, resumeskill.OrderOption]{typ: db.TypeResumeSkill, tq: q}, nil
 	case *db.RoleQuery:
 		return &query[*db.RoleQuery, predicate.Role, role.OrderOption]{typ: db.TypeRole, tq: q}, nil
+	case *db.ScreeningDimensionQuery:
+		return &query[*db.ScreeningDimensionQuery, predicate.ScreeningDimension, screeningdimension.OrderOption]{typ: db.TypeScreeningDimension, tq: q}, nil
 	case *db.ScreeningNodeRunQuery:
 		return &query[*db.ScreeningNodeRunQuery, predicate.ScreeningNodeRun, screeningnoderun.OrderOption]{typ: db.TypeScreeningNodeRun, tq: q}, nil
 	case *db.ScreeningResultQuery:
 		return &query[*db.ScreeningResultQuery, predicate.ScreeningResult, screeningresult.OrderOption]{typ: db.TypeScreeningResult, tq: q}, nil
 	case *db.ScreeningRunMetricQuery:
 		return &query[*db.ScreeningRunMetricQuery, predicate.ScreeningRunMetric, screeningrunmetric.OrderOption]{typ: db.TypeScreeningRunMetric, tq: q}, nil
+	case *db.ScreeningScheduleQuery:
+		return &query[*db.ScreeningScheduleQuery, predicate.ScreeningSchedule, screeningschedule.OrderOption]{typ: db.TypeScreeningSchedule, tq: q}, nil
 	case *db.ScreeningTaskQuery:
 		return &query[*db.ScreeningTaskQuery, predicate.ScreeningTask, screeningtask.OrderOption]{typ: db.TypeScreeningTask, tq: q}, nil
 	case *db.ScreeningTaskResumeQuery:
@@ -12,6 +12,7 @@ import (
 	"github.com/chaitin/WhaleHire/backend/consts"
 	"github.com/chaitin/WhaleHire/backend/db/department"
 	"github.com/chaitin/WhaleHire/backend/db/jobposition"
+	"github.com/chaitin/WhaleHire/backend/db/screeningschedule"
 	"github.com/chaitin/WhaleHire/backend/db/user"
 	"github.com/google/uuid"
 )
@@ -73,9 +74,13 @@ type JobPositionEdges struct {
 	ScreeningTasks []*ScreeningTask `json:"screening_tasks,omitempty"`
 	// ScreeningResults holds the value of the screening_results edge.
 	ScreeningResults []*ScreeningResult `json:"screening_results,omitempty"`
+	// ScreeningDimensions holds the value of the screening_dimensions edge.
+	ScreeningDimensions []*ScreeningDimension `json:"screening_dimensions,omitempty"`
+	// ScreeningSchedule holds the value of the screening_schedule edge.
+	ScreeningSchedule *ScreeningSchedule `json:"screening_schedule,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [10]bool
+	loadedTypes [12]bool
 }
 
 // DepartmentOrErr returns the Department value or an error if the edge
@@ -172,6 +177,26 @@ func (e JobPositionEdges) ScreeningResultsOrErr() ([]*ScreeningResult, error) {
 	return nil, &NotLoadedError{edge: "screening_results"}
 }
 
+// ScreeningDimensionsOrErr returns the ScreeningDimensions value or an error if the edge
+// was not loaded in eager-loading.
+func (e JobPositionEdges) ScreeningDimensionsOrErr() ([]*ScreeningDimension, error) {
+	if e.loadedTypes[10] {
+		return e.ScreeningDimensions, nil
+	}
+	return nil, &NotLoadedError{edge: "screening_dimensions"}
+}
+
+// ScreeningScheduleOrErr returns the ScreeningSchedule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e JobPositionEdges) ScreeningScheduleOrErr() (*ScreeningSchedule, error) {
+	if e.ScreeningSchedule != nil {
+		return e.ScreeningSchedule, nil
+	} else if e.loadedTypes[11] {
+		return nil, &NotFoundError{label: screeningschedule.Label}
+	}
+	return nil, &NotLoadedError{edge: "screening_schedule"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*JobPosition) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -348,6 +373,16 @@ func (jp *JobPosition) QueryScreeningResults() *ScreeningResultQuery {
 	return NewJobPositionClient(jp.config).QueryScreeningResults(jp)
 }
 
+// QueryScreeningDimensions queries the "screening_dimensions" edge of the JobPosition entity.
+func (jp *JobPosition) QueryScreeningDimensions() *ScreeningDimensionQuery {
+	return NewJobPositionClient(jp.config).QueryScreeningDimensions(jp)
+}
+
+// QueryScreeningSchedule queries the "screening_schedule" edge of the JobPosition entity.
+func (jp *JobPosition) QueryScreeningSchedule() *ScreeningScheduleQuery {
+	return NewJobPositionClient(jp.config).QueryScreeningSchedule(jp)
+}
+
 // Update returns a builder for updating this JobPosition.
 // Note that you need to call JobPosition.Unwrap() before calling this method if this JobPosition
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -61,6 +61,10 @@ const (
 	EdgeScreeningTasks = "screening_tasks"
 	// EdgeScreeningResults holds the string denoting the screening_results edge name in mutations.
 	EdgeScreeningResults = "screening_results"
+	// EdgeScreeningDimensions holds the string denoting the screening_dimensions edge name in mutations.
+	EdgeScreeningDimensions = "screening_dimensions"
+	// EdgeScreeningSchedule holds the string denoting the screening_schedule edge name in mutations.
+	EdgeScreeningSchedule = "screening_schedule"
 	// Table holds the table name of the jobposition in the database.
 	Table = "job_position"
 	// DepartmentTable is the table that holds the department relation/edge.
@@ -133,6 +137,20 @@ const (
 	ScreeningResultsInverseTable = "screening_results"
 	// ScreeningResultsColumn is the table column denoting the screening_results relation/edge.
 	ScreeningResultsColumn = "job_position_id"
+	// ScreeningDimensionsTable is the table that holds the screening_dimensions relation/edge.
+	ScreeningDimensionsTable = "screening_dimensions"
+	// ScreeningDimensionsInverseTable is the table name for the ScreeningDimension entity.
+	// It exists in this package in order to avoid circular dependency with the "screeningdimension" package.
+	ScreeningDimensionsInverseTable = "screening_dimensions"
+	// ScreeningDimensionsColumn is the table column denoting the screening_dimensions relation/edge.
+	ScreeningDimensionsColumn = "job_position_id"
+	// ScreeningScheduleTable is the table that holds the screening_schedule relation/edge.
+	ScreeningScheduleTable = "screening_schedules"
+	// ScreeningScheduleInverseTable is the table name for the ScreeningSchedule entity.
+	// It exists in this package in order to avoid circular dependency with the "screeningschedule" package.
+	ScreeningScheduleInverseTable = "screening_schedules"
+	// ScreeningScheduleColumn is the table column denoting the screening_schedule relation/edge.
+	ScreeningScheduleColumn = "job_position_id"
 )
 
 // Columns holds all SQL columns for jobposition fields.
@@ -379,6 +397,27 @@ func ByScreeningResults(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newScreeningResultsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByScreeningDimensionsCount orders the results by screening_dimensions count.
+func ByScreeningDimensionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScreeningDimensionsStep(), opts...)
+	}
+}
+
+// ByScreeningDimensions orders the results by screening_dimensions terms.
+func ByScreeningDimensions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScreeningDimensionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByScreeningScheduleField orders the results by screening_schedule field.
+func ByScreeningScheduleField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScreeningScheduleStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newDepartmentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -449,3 +488,17 @@ func newScreeningResultsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ScreeningResultsTable, ScreeningResultsColumn),
 	)
 }
+func newScreeningDimensionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScreeningDimensionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ScreeningDimensionsTable, ScreeningDimensionsColumn),
+	)
+}
+func newScreeningScheduleStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScreeningScheduleInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, ScreeningScheduleTable, ScreeningScheduleColumn),
+	)
+}
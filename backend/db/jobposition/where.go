@@ -1022,6 +1022,52 @@ func HasScreeningResultsWith(preds ...predicate.ScreeningResult) predicate.JobPo
 	})
 }
 
+// HasScreeningDimensions applies the HasEdge predicate on the "screening_dimensions" edge.
+func HasScreeningDimensions() predicate.JobPosition {
+	return predicate.JobPosition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ScreeningDimensionsTable, ScreeningDimensionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScreeningDimensionsWith applies the HasEdge predicate on the "screening_dimensions" edge with a given conditions (other predicates).
+func HasScreeningDimensionsWith(preds ...predicate.ScreeningDimension) predicate.JobPosition {
+	return predicate.JobPosition(func(s *sql.Selector) {
+		step := newScreeningDimensionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasScreeningSchedule applies the HasEdge predicate on the "screening_schedule" edge.
+func HasScreeningSchedule() predicate.JobPosition {
+	return predicate.JobPosition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ScreeningScheduleTable, ScreeningScheduleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScreeningScheduleWith applies the HasEdge predicate on the "screening_schedule" edge with a given conditions (other predicates).
+func HasScreeningScheduleWith(preds ...predicate.ScreeningSchedule) predicate.JobPosition {
+	return predicate.JobPosition(func(s *sql.Selector) {
+		step := newScreeningScheduleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.JobPosition) predicate.JobPosition {
 	return predicate.JobPosition(sql.AndPredicates(predicates...))
@@ -21,7 +21,9 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db/jobresponsibility"
 	"github.com/chaitin/WhaleHire/backend/db/jobskill"
 	"github.com/chaitin/WhaleHire/backend/db/resumejobapplication"
+	"github.com/chaitin/WhaleHire/backend/db/screeningdimension"
 	"github.com/chaitin/WhaleHire/backend/db/screeningresult"
+	"github.com/chaitin/WhaleHire/backend/db/screeningschedule"
 	"github.com/chaitin/WhaleHire/backend/db/screeningtask"
 	"github.com/chaitin/WhaleHire/backend/db/user"
 	"github.com/google/uuid"
@@ -345,6 +347,40 @@ func (jpc *JobPositionCreate) AddScreeningResults(s ...*ScreeningResult) *JobPos
 	return jpc.AddScreeningResultIDs(ids...)
 }
 
+// AddScreeningDimensionIDs adds the "screening_dimensions" edge to the ScreeningDimension entity by IDs.
+func (jpc *JobPositionCreate) AddScreeningDimensionIDs(ids ...uuid.UUID) *JobPositionCreate {
+	jpc.mutation.AddScreeningDimensionIDs(ids...)
+	return jpc
+}
+
+// AddScreeningDimensions adds the "screening_dimensions" edges to the ScreeningDimension entity.
+func (jpc *JobPositionCreate) AddScreeningDimensions(s ...*ScreeningDimension) *JobPositionCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return jpc.AddScreeningDimensionIDs(ids...)
+}
+
+// SetScreeningScheduleID sets the "screening_schedule" edge to the ScreeningSchedule entity by ID.
+func (jpc *JobPositionCreate) SetScreeningScheduleID(id uuid.UUID) *JobPositionCreate {
+	jpc.mutation.SetScreeningScheduleID(id)
+	return jpc
+}
+
+// SetNillableScreeningScheduleID sets the "screening_schedule" edge to the ScreeningSchedule entity by ID if the given value is not nil.
+func (jpc *JobPositionCreate) SetNillableScreeningScheduleID(id *uuid.UUID) *JobPositionCreate {
+	if id != nil {
+		jpc = jpc.SetScreeningScheduleID(*id)
+	}
+	return jpc
+}
+
+// SetScreeningSchedule sets the "screening_schedule" edge to the ScreeningSchedule entity.
+func (jpc *JobPositionCreate) SetScreeningSchedule(s *ScreeningSchedule) *JobPositionCreate {
+	return jpc.SetScreeningScheduleID(s.ID)
+}
+
 // Mutation returns the JobPositionMutation object of the builder.
 func (jpc *JobPositionCreate) Mutation() *JobPositionMutation {
 	return jpc.mutation
@@ -678,6 +714,38 @@ func (jpc *JobPositionCreate) createSpec() (*JobPosition, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := jpc.mutation.ScreeningDimensionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   jobposition.ScreeningDimensionsTable,
+			Columns: []string{jobposition.ScreeningDimensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningdimension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := jpc.mutation.ScreeningScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   jobposition.ScreeningScheduleTable,
+			Columns: []string{jobposition.ScreeningScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -22,7 +22,9 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db/jobskill"
 	"github.com/chaitin/WhaleHire/backend/db/predicate"
 	"github.com/chaitin/WhaleHire/backend/db/resumejobapplication"
+	"github.com/chaitin/WhaleHire/backend/db/screeningdimension"
 	"github.com/chaitin/WhaleHire/backend/db/screeningresult"
+	"github.com/chaitin/WhaleHire/backend/db/screeningschedule"
 	"github.com/chaitin/WhaleHire/backend/db/screeningtask"
 	"github.com/chaitin/WhaleHire/backend/db/user"
 	"github.com/google/uuid"
@@ -45,6 +47,8 @@ type JobPositionQuery struct {
 	withResumeApplications     *ResumeJobApplicationQuery
 	withScreeningTasks         *ScreeningTaskQuery
 	withScreeningResults       *ScreeningResultQuery
+	withScreeningDimensions    *ScreeningDimensionQuery
+	withScreeningSchedule      *ScreeningScheduleQuery
 	modifiers                  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -302,6 +306,50 @@ func (jpq *JobPositionQuery) QueryScreeningResults() *ScreeningResultQuery {
 	return query
 }
 
+// QueryScreeningDimensions chains the current query on the "screening_dimensions" edge.
+func (jpq *JobPositionQuery) QueryScreeningDimensions() *ScreeningDimensionQuery {
+	query := (&ScreeningDimensionClient{config: jpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := jpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := jpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(jobposition.Table, jobposition.FieldID, selector),
+			sqlgraph.To(screeningdimension.Table, screeningdimension.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, jobposition.ScreeningDimensionsTable, jobposition.ScreeningDimensionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(jpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryScreeningSchedule chains the current query on the "screening_schedule" edge.
+func (jpq *JobPositionQuery) QueryScreeningSchedule() *ScreeningScheduleQuery {
+	query := (&ScreeningScheduleClient{config: jpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := jpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := jpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(jobposition.Table, jobposition.FieldID, selector),
+			sqlgraph.To(screeningschedule.Table, screeningschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, jobposition.ScreeningScheduleTable, jobposition.ScreeningScheduleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(jpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first JobPosition entity from the query.
 // Returns a *NotFoundError when no JobPosition was found.
 func (jpq *JobPositionQuery) First(ctx context.Context) (*JobPosition, error) {
@@ -504,6 +552,8 @@ func (jpq *JobPositionQuery) Clone() *JobPositionQuery {
 		withResumeApplications:     jpq.withResumeApplications.Clone(),
 		withScreeningTasks:         jpq.withScreeningTasks.Clone(),
 		withScreeningResults:       jpq.withScreeningResults.Clone(),
+		withScreeningDimensions:    jpq.withScreeningDimensions.Clone(),
+		withScreeningSchedule:      jpq.withScreeningSchedule.Clone(),
 		// clone intermediate query.
 		sql:       jpq.sql.Clone(),
 		path:      jpq.path,
@@ -621,6 +671,28 @@ func (jpq *JobPositionQuery) WithScreeningResults(opts ...func(*ScreeningResultQ
 	return jpq
 }
 
+// WithScreeningDimensions tells the query-builder to eager-load the nodes that are connected to
+// the "screening_dimensions" edge. The optional arguments are used to configure the query builder of the edge.
+func (jpq *JobPositionQuery) WithScreeningDimensions(opts ...func(*ScreeningDimensionQuery)) *JobPositionQuery {
+	query := (&ScreeningDimensionClient{config: jpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	jpq.withScreeningDimensions = query
+	return jpq
+}
+
+// WithScreeningSchedule tells the query-builder to eager-load the nodes that are connected to
+// the "screening_schedule" edge. The optional arguments are used to configure the query builder of the edge.
+func (jpq *JobPositionQuery) WithScreeningSchedule(opts ...func(*ScreeningScheduleQuery)) *JobPositionQuery {
+	query := (&ScreeningScheduleClient{config: jpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	jpq.withScreeningSchedule = query
+	return jpq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -699,7 +771,7 @@ func (jpq *JobPositionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*JobPosition{}
 		_spec       = jpq.querySpec()
-		loadedTypes = [10]bool{
+		loadedTypes = [12]bool{
 			jpq.withDepartment != nil,
 			jpq.withCreator != nil,
 			jpq.withResponsibilities != nil,
@@ -710,6 +782,8 @@ func (jpq *JobPositionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			jpq.withResumeApplications != nil,
 			jpq.withScreeningTasks != nil,
 			jpq.withScreeningResults != nil,
+			jpq.withScreeningDimensions != nil,
+			jpq.withScreeningSchedule != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -813,6 +887,21 @@ func (jpq *JobPositionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := jpq.withScreeningDimensions; query != nil {
+		if err := jpq.loadScreeningDimensions(ctx, query, nodes,
+			func(n *JobPosition) { n.Edges.ScreeningDimensions = []*ScreeningDimension{} },
+			func(n *JobPosition, e *ScreeningDimension) {
+				n.Edges.ScreeningDimensions = append(n.Edges.ScreeningDimensions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := jpq.withScreeningSchedule; query != nil {
+		if err := jpq.loadScreeningSchedule(ctx, query, nodes, nil,
+			func(n *JobPosition, e *ScreeningSchedule) { n.Edges.ScreeningSchedule = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -1117,6 +1206,63 @@ func (jpq *JobPositionQuery) loadScreeningResults(ctx context.Context, query *Sc
 	}
 	return nil
 }
+func (jpq *JobPositionQuery) loadScreeningDimensions(ctx context.Context, query *ScreeningDimensionQuery, nodes []*JobPosition, init func(*JobPosition), assign func(*JobPosition, *ScreeningDimension)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*JobPosition)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(screeningdimension.FieldJobPositionID)
+	}
+	query.Where(predicate.ScreeningDimension(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(jobposition.ScreeningDimensionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.JobPositionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "job_position_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (jpq *JobPositionQuery) loadScreeningSchedule(ctx context.Context, query *ScreeningScheduleQuery, nodes []*JobPosition, init func(*JobPosition), assign func(*JobPosition, *ScreeningSchedule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*JobPosition)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(screeningschedule.FieldJobPositionID)
+	}
+	query.Where(predicate.ScreeningSchedule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(jobposition.ScreeningScheduleColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.JobPositionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "job_position_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (jpq *JobPositionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := jpq.querySpec()
@@ -21,7 +21,9 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db/jobskill"
 	"github.com/chaitin/WhaleHire/backend/db/predicate"
 	"github.com/chaitin/WhaleHire/backend/db/resumejobapplication"
+	"github.com/chaitin/WhaleHire/backend/db/screeningdimension"
 	"github.com/chaitin/WhaleHire/backend/db/screeningresult"
+	"github.com/chaitin/WhaleHire/backend/db/screeningschedule"
 	"github.com/chaitin/WhaleHire/backend/db/screeningtask"
 	"github.com/chaitin/WhaleHire/backend/db/user"
 	"github.com/google/uuid"
@@ -387,6 +389,40 @@ func (jpu *JobPositionUpdate) AddScreeningResults(s ...*ScreeningResult) *JobPos
 	return jpu.AddScreeningResultIDs(ids...)
 }
 
+// AddScreeningDimensionIDs adds the "screening_dimensions" edge to the ScreeningDimension entity by IDs.
+func (jpu *JobPositionUpdate) AddScreeningDimensionIDs(ids ...uuid.UUID) *JobPositionUpdate {
+	jpu.mutation.AddScreeningDimensionIDs(ids...)
+	return jpu
+}
+
+// AddScreeningDimensions adds the "screening_dimensions" edges to the ScreeningDimension entity.
+func (jpu *JobPositionUpdate) AddScreeningDimensions(s ...*ScreeningDimension) *JobPositionUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return jpu.AddScreeningDimensionIDs(ids...)
+}
+
+// SetScreeningScheduleID sets the "screening_schedule" edge to the ScreeningSchedule entity by ID.
+func (jpu *JobPositionUpdate) SetScreeningScheduleID(id uuid.UUID) *JobPositionUpdate {
+	jpu.mutation.SetScreeningScheduleID(id)
+	return jpu
+}
+
+// SetNillableScreeningScheduleID sets the "screening_schedule" edge to the ScreeningSchedule entity by ID if the given value is not nil.
+func (jpu *JobPositionUpdate) SetNillableScreeningScheduleID(id *uuid.UUID) *JobPositionUpdate {
+	if id != nil {
+		jpu = jpu.SetScreeningScheduleID(*id)
+	}
+	return jpu
+}
+
+// SetScreeningSchedule sets the "screening_schedule" edge to the ScreeningSchedule entity.
+func (jpu *JobPositionUpdate) SetScreeningSchedule(s *ScreeningSchedule) *JobPositionUpdate {
+	return jpu.SetScreeningScheduleID(s.ID)
+}
+
 // Mutation returns the JobPositionMutation object of the builder.
 func (jpu *JobPositionUpdate) Mutation() *JobPositionMutation {
 	return jpu.mutation
@@ -572,6 +608,33 @@ func (jpu *JobPositionUpdate) RemoveScreeningResults(s ...*ScreeningResult) *Job
 	return jpu.RemoveScreeningResultIDs(ids...)
 }
 
+// ClearScreeningDimensions clears all "screening_dimensions" edges to the ScreeningDimension entity.
+func (jpu *JobPositionUpdate) ClearScreeningDimensions() *JobPositionUpdate {
+	jpu.mutation.ClearScreeningDimensions()
+	return jpu
+}
+
+// RemoveScreeningDimensionIDs removes the "screening_dimensions" edge to ScreeningDimension entities by IDs.
+func (jpu *JobPositionUpdate) RemoveScreeningDimensionIDs(ids ...uuid.UUID) *JobPositionUpdate {
+	jpu.mutation.RemoveScreeningDimensionIDs(ids...)
+	return jpu
+}
+
+// RemoveScreeningDimensions removes "screening_dimensions" edges to ScreeningDimension entities.
+func (jpu *JobPositionUpdate) RemoveScreeningDimensions(s ...*ScreeningDimension) *JobPositionUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return jpu.RemoveScreeningDimensionIDs(ids...)
+}
+
+// ClearScreeningSchedule clears the "screening_schedule" edge to the ScreeningSchedule entity.
+func (jpu *JobPositionUpdate) ClearScreeningSchedule() *JobPositionUpdate {
+	jpu.mutation.ClearScreeningSchedule()
+	return jpu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (jpu *JobPositionUpdate) Save(ctx context.Context) (int, error) {
 	if err := jpu.defaults(); err != nil {
@@ -1119,6 +1182,80 @@ func (jpu *JobPositionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if jpu.mutation.ScreeningDimensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   jobposition.ScreeningDimensionsTable,
+			Columns: []string{jobposition.ScreeningDimensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningdimension.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jpu.mutation.RemovedScreeningDimensionsIDs(); len(nodes) > 0 && !jpu.mutation.ScreeningDimensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   jobposition.ScreeningDimensionsTable,
+			Columns: []string{jobposition.ScreeningDimensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningdimension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jpu.mutation.ScreeningDimensionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   jobposition.ScreeningDimensionsTable,
+			Columns: []string{jobposition.ScreeningDimensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningdimension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if jpu.mutation.ScreeningScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   jobposition.ScreeningScheduleTable,
+			Columns: []string{jobposition.ScreeningScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jpu.mutation.ScreeningScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   jobposition.ScreeningScheduleTable,
+			Columns: []string{jobposition.ScreeningScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(jpu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, jpu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -1487,6 +1624,40 @@ func (jpuo *JobPositionUpdateOne) AddScreeningResults(s ...*ScreeningResult) *Jo
 	return jpuo.AddScreeningResultIDs(ids...)
 }
 
+// AddScreeningDimensionIDs adds the "screening_dimensions" edge to the ScreeningDimension entity by IDs.
+func (jpuo *JobPositionUpdateOne) AddScreeningDimensionIDs(ids ...uuid.UUID) *JobPositionUpdateOne {
+	jpuo.mutation.AddScreeningDimensionIDs(ids...)
+	return jpuo
+}
+
+// AddScreeningDimensions adds the "screening_dimensions" edges to the ScreeningDimension entity.
+func (jpuo *JobPositionUpdateOne) AddScreeningDimensions(s ...*ScreeningDimension) *JobPositionUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return jpuo.AddScreeningDimensionIDs(ids...)
+}
+
+// SetScreeningScheduleID sets the "screening_schedule" edge to the ScreeningSchedule entity by ID.
+func (jpuo *JobPositionUpdateOne) SetScreeningScheduleID(id uuid.UUID) *JobPositionUpdateOne {
+	jpuo.mutation.SetScreeningScheduleID(id)
+	return jpuo
+}
+
+// SetNillableScreeningScheduleID sets the "screening_schedule" edge to the ScreeningSchedule entity by ID if the given value is not nil.
+func (jpuo *JobPositionUpdateOne) SetNillableScreeningScheduleID(id *uuid.UUID) *JobPositionUpdateOne {
+	if id != nil {
+		jpuo = jpuo.SetScreeningScheduleID(*id)
+	}
+	return jpuo
+}
+
+// SetScreeningSchedule sets the "screening_schedule" edge to the ScreeningSchedule entity.
+func (jpuo *JobPositionUpdateOne) SetScreeningSchedule(s *ScreeningSchedule) *JobPositionUpdateOne {
+	return jpuo.SetScreeningScheduleID(s.ID)
+}
+
 // Mutation returns the JobPositionMutation object of the builder.
 func (jpuo *JobPositionUpdateOne) Mutation() *JobPositionMutation {
 	return jpuo.mutation
@@ -1672,6 +1843,33 @@ func (jpuo *JobPositionUpdateOne) RemoveScreeningResults(s ...*ScreeningResult)
 	return jpuo.RemoveScreeningResultIDs(ids...)
 }
 
+// ClearScreeningDimensions clears all "screening_dimensions" edges to the ScreeningDimension entity.
+func (jpuo *JobPositionUpdateOne) ClearScreeningDimensions() *JobPositionUpdateOne {
+	jpuo.mutation.ClearScreeningDimensions()
+	return jpuo
+}
+
+// RemoveScreeningDimensionIDs removes the "screening_dimensions" edge to ScreeningDimension entities by IDs.
+func (jpuo *JobPositionUpdateOne) RemoveScreeningDimensionIDs(ids ...uuid.UUID) *JobPositionUpdateOne {
+	jpuo.mutation.RemoveScreeningDimensionIDs(ids...)
+	return jpuo
+}
+
+// RemoveScreeningDimensions removes "screening_dimensions" edges to ScreeningDimension entities.
+func (jpuo *JobPositionUpdateOne) RemoveScreeningDimensions(s ...*ScreeningDimension) *JobPositionUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return jpuo.RemoveScreeningDimensionIDs(ids...)
+}
+
+// ClearScreeningSchedule clears the "screening_schedule" edge to the ScreeningSchedule entity.
+func (jpuo *JobPositionUpdateOne) ClearScreeningSchedule() *JobPositionUpdateOne {
+	jpuo.mutation.ClearScreeningSchedule()
+	return jpuo
+}
+
 // Where appends a list predicates to the JobPositionUpdate builder.
 func (jpuo *JobPositionUpdateOne) Where(ps ...predicate.JobPosition) *JobPositionUpdateOne {
 	jpuo.mutation.Where(ps...)
@@ -2249,6 +2447,80 @@ func (jpuo *JobPositionUpdateOne) sqlSave(ctx context.Context) (_node *JobPositi
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if jpuo.mutation.ScreeningDimensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   jobposition.ScreeningDimensionsTable,
+			Columns: []string{jobposition.ScreeningDimensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningdimension.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jpuo.mutation.RemovedScreeningDimensionsIDs(); len(nodes) > 0 && !jpuo.mutation.ScreeningDimensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   jobposition.ScreeningDimensionsTable,
+			Columns: []string{jobposition.ScreeningDimensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningdimension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jpuo.mutation.ScreeningDimensionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   jobposition.ScreeningDimensionsTable,
+			Columns: []string{jobposition.ScreeningDimensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningdimension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if jpuo.mutation.ScreeningScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   jobposition.ScreeningScheduleTable,
+			Columns: []string{jobposition.ScreeningScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jpuo.mutation.ScreeningScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   jobposition.ScreeningScheduleTable,
+			Columns: []string{jobposition.ScreeningScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(screeningschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(jpuo.modifiers...)
 	_node = &JobPosition{config: jpuo.config}
 	_spec.Assign = _node.assignValues
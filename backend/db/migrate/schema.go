@@ -492,6 +492,7 @@ var (
 		{Name: "timeout", Type: field.TypeInt, Default: 300},
 		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "trace_id", Type: field.TypeString, Nullable: true},
+		{Name: "setting_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "scheduled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
@@ -524,19 +525,24 @@ var (
 				Columns: []*schema.Column{NotificationEventsColumns[12]},
 			},
 			{
-				Name:    "notificationevent_scheduled_at",
+				Name:    "notificationevent_setting_id",
 				Unique:  false,
 				Columns: []*schema.Column{NotificationEventsColumns[13]},
 			},
+			{
+				Name:    "notificationevent_scheduled_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationEventsColumns[14]},
+			},
 			{
 				Name:    "notificationevent_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{NotificationEventsColumns[15]},
+				Columns: []*schema.Column{NotificationEventsColumns[16]},
 			},
 			{
 				Name:    "notificationevent_status_scheduled_at",
 				Unique:  false,
-				Columns: []*schema.Column{NotificationEventsColumns[4], NotificationEventsColumns[13]},
+				Columns: []*schema.Column{NotificationEventsColumns[4], NotificationEventsColumns[14]},
 			},
 			{
 				Name:    "notificationevent_event_type_status",
@@ -553,6 +559,9 @@ var (
 		{Name: "channel", Type: field.TypeString},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
 		{Name: "dingtalk_config", Type: field.TypeJSON, Nullable: true},
+		{Name: "email_config", Type: field.TypeJSON, Nullable: true},
+		{Name: "webhook_config", Type: field.TypeJSON, Nullable: true},
+		{Name: "routing_rules", Type: field.TypeJSON, Nullable: true},
 		{Name: "max_retry", Type: field.TypeInt, Default: 3},
 		{Name: "timeout", Type: field.TypeInt, Default: 300},
 		{Name: "description", Type: field.TypeString, Nullable: true},
@@ -607,6 +616,13 @@ var (
 		{Name: "honors_certificates", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "other_info", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "resume_file_url", Type: field.TypeString, Nullable: true},
+		{Name: "source", Type: field.TypeString, Nullable: true},
+		{Name: "file_hash", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "normalized_phone", Type: field.TypeString, Nullable: true},
+		{Name: "normalized_email", Type: field.TypeString, Nullable: true},
+		{Name: "normalized_name", Type: field.TypeString, Nullable: true},
+		{Name: "merged_into_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "merged_at", Type: field.TypeTime, Nullable: true},
 		{Name: "status", Type: field.TypeString, Default: "pending"},
 		{Name: "error_message", Type: field.TypeString, Nullable: true},
 		{Name: "parsed_at", Type: field.TypeTime, Nullable: true},
@@ -622,11 +638,80 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "resumes_users_resumes",
-				Columns:    []*schema.Column{ResumesColumns[23]},
+				Columns:    []*schema.Column{ResumesColumns[30]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "resume_file_hash",
+				Unique:  false,
+				Columns: []*schema.Column{ResumesColumns[19]},
+			},
+			{
+				Name:    "resume_normalized_phone",
+				Unique:  false,
+				Columns: []*schema.Column{ResumesColumns[20]},
+			},
+			{
+				Name:    "resume_normalized_email",
+				Unique:  false,
+				Columns: []*schema.Column{ResumesColumns[21]},
+			},
+			{
+				Name:    "resume_normalized_name",
+				Unique:  false,
+				Columns: []*schema.Column{ResumesColumns[22]},
+			},
+			{
+				Name:    "resume_merged_into_id",
+				Unique:  false,
+				Columns: []*schema.Column{ResumesColumns[23]},
+			},
+		},
+	}
+	// ResumeChunksColumns holds the columns for the "resume_chunks" table.
+	ResumeChunksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "chunk_type", Type: field.TypeString},
+		{Name: "source_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "chunk_index", Type: field.TypeInt, Default: 0},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "vector", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "resume_id", Type: field.TypeUUID},
+	}
+	// ResumeChunksTable holds the schema information for the "resume_chunks" table.
+	ResumeChunksTable = &schema.Table{
+		Name:       "resume_chunks",
+		Columns:    ResumeChunksColumns,
+		PrimaryKey: []*schema.Column{ResumeChunksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "resume_chunks_resumes_chunks",
+				Columns:    []*schema.Column{ResumeChunksColumns[8]},
+				RefColumns: []*schema.Column{ResumesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "resumechunk_resume_id",
+				Unique:  false,
+				Columns: []*schema.Column{ResumeChunksColumns[8]},
+			},
+			{
+				Name:    "resumechunk_vector",
+				Unique:  false,
+				Columns: []*schema.Column{ResumeChunksColumns[5]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "vector_cosine_ops",
+					Type:    "ivfflat",
+				},
+			},
+		},
 	}
 	// ResumeDocumentParsesColumns holds the columns for the "resume_document_parses" table.
 	ResumeDocumentParsesColumns = []*schema.Column{
@@ -640,6 +725,7 @@ var (
 		{Name: "upload_at", Type: field.TypeTime, Nullable: true},
 		{Name: "status", Type: field.TypeString, Default: "pending"},
 		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "ocr_confidence", Type: field.TypeFloat64, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "resume_id", Type: field.TypeUUID},
@@ -652,12 +738,62 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "resume_document_parses_resumes_document_parse",
-				Columns:    []*schema.Column{ResumeDocumentParsesColumns[12]},
+				Columns:    []*schema.Column{ResumeDocumentParsesColumns[13]},
 				RefColumns: []*schema.Column{ResumesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// ResumeDuplicatesColumns holds the columns for the "resume_duplicates" table.
+	ResumeDuplicatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "score", Type: field.TypeFloat64},
+		{Name: "reasons", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "reviewed_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "resume_id", Type: field.TypeUUID},
+		{Name: "duplicate_resume_id", Type: field.TypeUUID},
+	}
+	// ResumeDuplicatesTable holds the schema information for the "resume_duplicates" table.
+	ResumeDuplicatesTable = &schema.Table{
+		Name:       "resume_duplicates",
+		Columns:    ResumeDuplicatesColumns,
+		PrimaryKey: []*schema.Column{ResumeDuplicatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "resume_duplicates_resumes_duplicate_flags",
+				Columns:    []*schema.Column{ResumeDuplicatesColumns[8]},
+				RefColumns: []*schema.Column{ResumesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "resume_duplicates_resumes_duplicated_by",
+				Columns:    []*schema.Column{ResumeDuplicatesColumns[9]},
+				RefColumns: []*schema.Column{ResumesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "resumeduplicate_resume_id_duplicate_resume_id",
+				Unique:  true,
+				Columns: []*schema.Column{ResumeDuplicatesColumns[8], ResumeDuplicatesColumns[9]},
+			},
+			{
+				Name:    "resumeduplicate_duplicate_resume_id",
+				Unique:  false,
+				Columns: []*schema.Column{ResumeDuplicatesColumns[9]},
+			},
+			{
+				Name:    "resumeduplicate_status",
+				Unique:  false,
+				Columns: []*schema.Column{ResumeDuplicatesColumns[3]},
+			},
+		},
+	}
 	// ResumeEducationsColumns holds the columns for the "resume_educations" table.
 	ResumeEducationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -850,6 +986,9 @@ var (
 		{Name: "auth_type", Type: field.TypeString, Size: 32, Default: "password"},
 		{Name: "encrypted_credential", Type: field.TypeJSON},
 		{Name: "job_profile_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "extraction_mode", Type: field.TypeString, Size: 32, Default: "attachments"},
+		{Name: "link_allowed_domains", Type: field.TypeJSON, Nullable: true},
+		{Name: "rules", Type: field.TypeJSON, Nullable: true},
 		{Name: "sync_interval_minutes", Type: field.TypeInt, Nullable: true},
 		{Name: "status", Type: field.TypeString, Default: "enabled"},
 		{Name: "last_synced_at", Type: field.TypeTime, Nullable: true},
@@ -867,7 +1006,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "resume_mailbox_settings_users_uploader",
-				Columns:    []*schema.Column{ResumeMailboxSettingsColumns[19]},
+				Columns:    []*schema.Column{ResumeMailboxSettingsColumns[22]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -881,17 +1020,17 @@ var (
 			{
 				Name:    "resumemailboxsetting_status",
 				Unique:  false,
-				Columns: []*schema.Column{ResumeMailboxSettingsColumns[13]},
+				Columns: []*schema.Column{ResumeMailboxSettingsColumns[16]},
 			},
 			{
 				Name:    "resumemailboxsetting_uploader_id",
 				Unique:  false,
-				Columns: []*schema.Column{ResumeMailboxSettingsColumns[19]},
+				Columns: []*schema.Column{ResumeMailboxSettingsColumns[22]},
 			},
 			{
 				Name:    "resumemailboxsetting_last_synced_at",
 				Unique:  false,
-				Columns: []*schema.Column{ResumeMailboxSettingsColumns[14]},
+				Columns: []*schema.Column{ResumeMailboxSettingsColumns[17]},
 			},
 		},
 	}
@@ -903,6 +1042,8 @@ var (
 		{Name: "synced_emails", Type: field.TypeInt, Default: 0},
 		{Name: "parsed_resumes", Type: field.TypeInt, Default: 0},
 		{Name: "failed_resumes", Type: field.TypeInt, Default: 0},
+		{Name: "body_resumes", Type: field.TypeInt, Default: 0},
+		{Name: "link_resumes", Type: field.TypeInt, Default: 0},
 		{Name: "skipped_attachments", Type: field.TypeInt, Default: 0},
 		{Name: "last_sync_duration_ms", Type: field.TypeInt, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
@@ -917,7 +1058,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "resume_mailbox_statistics_resume_mailbox_settings_statistics",
-				Columns:    []*schema.Column{ResumeMailboxStatisticsColumns[10]},
+				Columns:    []*schema.Column{ResumeMailboxStatisticsColumns[12]},
 				RefColumns: []*schema.Column{ResumeMailboxSettingsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -926,12 +1067,12 @@ var (
 			{
 				Name:    "resumemailboxstatistic_mailbox_id_date",
 				Unique:  true,
-				Columns: []*schema.Column{ResumeMailboxStatisticsColumns[10], ResumeMailboxStatisticsColumns[2]},
+				Columns: []*schema.Column{ResumeMailboxStatisticsColumns[12], ResumeMailboxStatisticsColumns[2]},
 			},
 			{
 				Name:    "resumemailboxstatistic_mailbox_id",
 				Unique:  false,
-				Columns: []*schema.Column{ResumeMailboxStatisticsColumns[10]},
+				Columns: []*schema.Column{ResumeMailboxStatisticsColumns[12]},
 			},
 			{
 				Name:    "resumemailboxstatistic_date",
@@ -941,7 +1082,7 @@ var (
 			{
 				Name:    "resumemailboxstatistic_updated_at",
 				Unique:  false,
-				Columns: []*schema.Column{ResumeMailboxStatisticsColumns[9]},
+				Columns: []*schema.Column{ResumeMailboxStatisticsColumns[11]},
 			},
 		},
 	}
@@ -1016,6 +1157,41 @@ var (
 		Columns:    RolesColumns,
 		PrimaryKey: []*schema.Column{RolesColumns[0]},
 	}
+	// ScreeningDimensionsColumns holds the columns for the "screening_dimensions" table.
+	ScreeningDimensionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "key", Type: field.TypeString, Size: 50},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "prompt", Type: field.TypeString, Size: 2147483647},
+		{Name: "weight", Type: field.TypeFloat64, Default: 0},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "job_position_id", Type: field.TypeUUID},
+	}
+	// ScreeningDimensionsTable holds the schema information for the "screening_dimensions" table.
+	ScreeningDimensionsTable = &schema.Table{
+		Name:       "screening_dimensions",
+		Columns:    ScreeningDimensionsColumns,
+		PrimaryKey: []*schema.Column{ScreeningDimensionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "screening_dimensions_job_position_screening_dimensions",
+				Columns:    []*schema.Column{ScreeningDimensionsColumns[10]},
+				RefColumns: []*schema.Column{JobPositionColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "screeningdimension_job_position_id",
+				Unique:  false,
+				Columns: []*schema.Column{ScreeningDimensionsColumns[10]},
+			},
+		},
+	}
 	// ScreeningNodeRunsColumns holds the columns for the "screening_node_runs" table.
 	ScreeningNodeRunsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -1037,6 +1213,8 @@ var (
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
 		{Name: "duration_ms", Type: field.TypeInt, Nullable: true},
+		{Name: "cache_key", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "reused_from_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "task_id", Type: field.TypeUUID},
@@ -1050,13 +1228,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "screening_node_runs_screening_tasks_node_runs",
-				Columns:    []*schema.Column{ScreeningNodeRunsColumns[21]},
+				Columns:    []*schema.Column{ScreeningNodeRunsColumns[23]},
 				RefColumns: []*schema.Column{ScreeningTasksColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "screening_node_runs_screening_task_resumes_node_runs",
-				Columns:    []*schema.Column{ScreeningNodeRunsColumns[22]},
+				Columns:    []*schema.Column{ScreeningNodeRunsColumns[24]},
 				RefColumns: []*schema.Column{ScreeningTaskResumesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1065,12 +1243,12 @@ var (
 			{
 				Name:    "screeningnoderun_task_id",
 				Unique:  false,
-				Columns: []*schema.Column{ScreeningNodeRunsColumns[21]},
+				Columns: []*schema.Column{ScreeningNodeRunsColumns[23]},
 			},
 			{
 				Name:    "screeningnoderun_task_resume_id",
 				Unique:  false,
-				Columns: []*schema.Column{ScreeningNodeRunsColumns[22]},
+				Columns: []*schema.Column{ScreeningNodeRunsColumns[24]},
 			},
 			{
 				Name:    "screeningnoderun_node_key",
@@ -1085,23 +1263,28 @@ var (
 			{
 				Name:    "screeningnoderun_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{ScreeningNodeRunsColumns[19]},
+				Columns: []*schema.Column{ScreeningNodeRunsColumns[21]},
 			},
 			{
 				Name:    "screeningnoderun_task_resume_id_node_key_attempt_no",
 				Unique:  true,
-				Columns: []*schema.Column{ScreeningNodeRunsColumns[22], ScreeningNodeRunsColumns[2], ScreeningNodeRunsColumns[4]},
+				Columns: []*schema.Column{ScreeningNodeRunsColumns[24], ScreeningNodeRunsColumns[2], ScreeningNodeRunsColumns[4]},
 			},
 			{
 				Name:    "screeningnoderun_task_id_node_key",
 				Unique:  false,
-				Columns: []*schema.Column{ScreeningNodeRunsColumns[21], ScreeningNodeRunsColumns[2]},
+				Columns: []*schema.Column{ScreeningNodeRunsColumns[23], ScreeningNodeRunsColumns[2]},
 			},
 			{
 				Name:    "screeningnoderun_node_key_status",
 				Unique:  false,
 				Columns: []*schema.Column{ScreeningNodeRunsColumns[2], ScreeningNodeRunsColumns[3]},
 			},
+			{
+				Name:    "screeningnoderun_cache_key",
+				Unique:  false,
+				Columns: []*schema.Column{ScreeningNodeRunsColumns[19]},
+			},
 		},
 	}
 	// ScreeningResultsColumns holds the columns for the "screening_results" table.
@@ -1112,15 +1295,27 @@ var (
 		{Name: "match_level", Type: field.TypeEnum, Nullable: true, Enums: []string{"excellent", "good", "fair", "poor", "no_match"}},
 		{Name: "dimension_scores", Type: field.TypeJSON, Nullable: true},
 		{Name: "skill_detail", Type: field.TypeJSON, Nullable: true},
+		{Name: "custom_details", Type: field.TypeJSON, Nullable: true},
 		{Name: "responsibility_detail", Type: field.TypeJSON, Nullable: true},
 		{Name: "experience_detail", Type: field.TypeJSON, Nullable: true},
 		{Name: "education_detail", Type: field.TypeJSON, Nullable: true},
 		{Name: "industry_detail", Type: field.TypeJSON, Nullable: true},
 		{Name: "basic_detail", Type: field.TypeJSON, Nullable: true},
 		{Name: "recommendations", Type: field.TypeJSON, Nullable: true},
+		{Name: "knockout_reasons", Type: field.TypeJSON, Nullable: true},
 		{Name: "trace_id", Type: field.TypeString, Nullable: true, Size: 100},
 		{Name: "runtime_metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "sub_agent_versions", Type: field.TypeJSON, Nullable: true},
+		{Name: "job_hash", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "resume_hash", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "agent_hash", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "cached_from_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "human_overall_score", Type: field.TypeFloat64, Nullable: true},
+		{Name: "human_match_level", Type: field.TypeEnum, Nullable: true, Enums: []string{"excellent", "good", "fair", "poor", "no_match"}},
+		{Name: "human_dimension_scores", Type: field.TypeJSON, Nullable: true},
+		{Name: "override_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "overridden_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "overridden_at", Type: field.TypeTime, Nullable: true},
 		{Name: "matched_at", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -1136,19 +1331,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "screening_results_job_position_screening_results",
-				Columns:    []*schema.Column{ScreeningResultsColumns[18]},
+				Columns:    []*schema.Column{ScreeningResultsColumns[30]},
 				RefColumns: []*schema.Column{JobPositionColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "screening_results_resumes_screening_results",
-				Columns:    []*schema.Column{ScreeningResultsColumns[19]},
+				Columns:    []*schema.Column{ScreeningResultsColumns[31]},
 				RefColumns: []*schema.Column{ResumesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "screening_results_screening_tasks_results",
-				Columns:    []*schema.Column{ScreeningResultsColumns[20]},
+				Columns:    []*schema.Column{ScreeningResultsColumns[32]},
 				RefColumns: []*schema.Column{ScreeningTasksColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1157,12 +1352,12 @@ var (
 			{
 				Name:    "screeningresult_task_id",
 				Unique:  false,
-				Columns: []*schema.Column{ScreeningResultsColumns[20]},
+				Columns: []*schema.Column{ScreeningResultsColumns[32]},
 			},
 			{
 				Name:    "screeningresult_job_position_id_resume_id",
 				Unique:  false,
-				Columns: []*schema.Column{ScreeningResultsColumns[18], ScreeningResultsColumns[19]},
+				Columns: []*schema.Column{ScreeningResultsColumns[30], ScreeningResultsColumns[31]},
 			},
 			{
 				Name:    "screeningresult_overall_score",
@@ -1175,7 +1370,7 @@ var (
 			{
 				Name:    "screeningresult_task_id_resume_id",
 				Unique:  true,
-				Columns: []*schema.Column{ScreeningResultsColumns[20], ScreeningResultsColumns[19]},
+				Columns: []*schema.Column{ScreeningResultsColumns[32], ScreeningResultsColumns[31]},
 			},
 			{
 				Name:    "screeningresult_match_level",
@@ -1185,7 +1380,17 @@ var (
 			{
 				Name:    "screeningresult_matched_at",
 				Unique:  false,
-				Columns: []*schema.Column{ScreeningResultsColumns[15]},
+				Columns: []*schema.Column{ScreeningResultsColumns[27]},
+			},
+			{
+				Name:    "screeningresult_job_hash_resume_hash_agent_hash",
+				Unique:  false,
+				Columns: []*schema.Column{ScreeningResultsColumns[17], ScreeningResultsColumns[18], ScreeningResultsColumns[19]},
+			},
+			{
+				Name:    "screeningresult_overridden_at",
+				Unique:  false,
+				Columns: []*schema.Column{ScreeningResultsColumns[26]},
 			},
 		},
 	}
@@ -1233,6 +1438,48 @@ var (
 			},
 		},
 	}
+	// ScreeningSchedulesColumns holds the columns for the "screening_schedules" table.
+	ScreeningSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "mode", Type: field.TypeString, Default: "instant"},
+		{Name: "weight_template_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "llm_config", Type: field.TypeJSON, Nullable: true},
+		{Name: "knockout_rules", Type: field.TypeJSON, Nullable: true},
+		{Name: "blind_mode", Type: field.TypeBool, Default: false},
+		{Name: "digest_hour", Type: field.TypeInt, Default: 2},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_by", Type: field.TypeUUID},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "job_position_id", Type: field.TypeUUID, Unique: true},
+	}
+	// ScreeningSchedulesTable holds the schema information for the "screening_schedules" table.
+	ScreeningSchedulesTable = &schema.Table{
+		Name:       "screening_schedules",
+		Columns:    ScreeningSchedulesColumns,
+		PrimaryKey: []*schema.Column{ScreeningSchedulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "screening_schedules_job_position_screening_schedule",
+				Columns:    []*schema.Column{ScreeningSchedulesColumns[12]},
+				RefColumns: []*schema.Column{JobPositionColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "screeningschedule_job_position_id",
+				Unique:  true,
+				Columns: []*schema.Column{ScreeningSchedulesColumns[12]},
+			},
+			{
+				Name:    "screeningschedule_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{ScreeningSchedulesColumns[7]},
+			},
+		},
+	}
 	// ScreeningTasksColumns holds the columns for the "screening_tasks" table.
 	ScreeningTasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -1246,6 +1493,17 @@ var (
 		{Name: "resume_succeeded", Type: field.TypeInt, Default: 0},
 		{Name: "resume_failed", Type: field.TypeInt, Default: 0},
 		{Name: "agent_version", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "token_budget", Type: field.TypeInt64, Nullable: true},
+		{Name: "cost_budget", Type: field.TypeFloat64, Nullable: true},
+		{Name: "tokens_input", Type: field.TypeInt64, Default: 0},
+		{Name: "tokens_output", Type: field.TypeInt64, Default: 0},
+		{Name: "total_cost", Type: field.TypeFloat64, Default: 0},
+		{Name: "disable_cache", Type: field.TypeBool, Default: false},
+		{Name: "knockout_rules", Type: field.TypeJSON, Nullable: true},
+		{Name: "blind_mode", Type: field.TypeBool, Default: false},
+		{Name: "redaction_policy", Type: field.TypeJSON, Nullable: true},
+		{Name: "shortlist_ranking", Type: field.TypeJSON, Nullable: true},
+		{Name: "custom_dimensions", Type: field.TypeJSON, Nullable: true},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
@@ -1261,13 +1519,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "screening_tasks_job_position_screening_tasks",
-				Columns:    []*schema.Column{ScreeningTasksColumns[15]},
+				Columns:    []*schema.Column{ScreeningTasksColumns[26]},
 				RefColumns: []*schema.Column{JobPositionColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "screening_tasks_users_created_screening_tasks",
-				Columns:    []*schema.Column{ScreeningTasksColumns[16]},
+				Columns:    []*schema.Column{ScreeningTasksColumns[27]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1276,7 +1534,7 @@ var (
 			{
 				Name:    "screeningtask_job_position_id",
 				Unique:  false,
-				Columns: []*schema.Column{ScreeningTasksColumns[15]},
+				Columns: []*schema.Column{ScreeningTasksColumns[26]},
 			},
 			{
 				Name:    "screeningtask_status",
@@ -1286,12 +1544,12 @@ var (
 			{
 				Name:    "screeningtask_created_by",
 				Unique:  false,
-				Columns: []*schema.Column{ScreeningTasksColumns[16]},
+				Columns: []*schema.Column{ScreeningTasksColumns[27]},
 			},
 			{
 				Name:    "screeningtask_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{ScreeningTasksColumns[13]},
+				Columns: []*schema.Column{ScreeningTasksColumns[24]},
 			},
 		},
 	}
@@ -1304,6 +1562,9 @@ var (
 		{Name: "ranking", Type: field.TypeInt, Nullable: true},
 		{Name: "score", Type: field.TypeFloat64, Nullable: true},
 		{Name: "processed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tokens_input", Type: field.TypeInt64, Default: 0},
+		{Name: "tokens_output", Type: field.TypeInt64, Default: 0},
+		{Name: "total_cost", Type: field.TypeFloat64, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "resume_id", Type: field.TypeUUID},
@@ -1317,13 +1578,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "screening_task_resumes_resumes_screening_task_resumes",
-				Columns:    []*schema.Column{ScreeningTaskResumesColumns[9]},
+				Columns:    []*schema.Column{ScreeningTaskResumesColumns[12]},
 				RefColumns: []*schema.Column{ResumesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "screening_task_resumes_screening_tasks_task_resumes",
-				Columns:    []*schema.Column{ScreeningTaskResumesColumns[10]},
+				Columns:    []*schema.Column{ScreeningTaskResumesColumns[13]},
 				RefColumns: []*schema.Column{ScreeningTasksColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1332,7 +1593,7 @@ var (
 			{
 				Name:    "screeningtaskresume_task_id",
 				Unique:  false,
-				Columns: []*schema.Column{ScreeningTaskResumesColumns[10]},
+				Columns: []*schema.Column{ScreeningTaskResumesColumns[13]},
 			},
 			{
 				Name:    "screeningtaskresume_status",
@@ -1350,12 +1611,17 @@ var (
 			{
 				Name:    "screeningtaskresume_task_id_resume_id",
 				Unique:  true,
-				Columns: []*schema.Column{ScreeningTaskResumesColumns[10], ScreeningTaskResumesColumns[9]},
+				Columns: []*schema.Column{ScreeningTaskResumesColumns[13], ScreeningTaskResumesColumns[12]},
 			},
 			{
 				Name:    "screeningtaskresume_task_id_ranking",
 				Unique:  false,
-				Columns: []*schema.Column{ScreeningTaskResumesColumns[10], ScreeningTaskResumesColumns[4]},
+				Columns: []*schema.Column{ScreeningTaskResumesColumns[13], ScreeningTaskResumesColumns[4]},
+			},
+			{
+				Name:    "screeningtaskresume_processed_at",
+				Unique:  false,
+				Columns: []*schema.Column{ScreeningTaskResumesColumns[6]},
 			},
 		},
 	}
@@ -1572,7 +1838,9 @@ var (
 		NotificationEventsTable,
 		NotificationSettingsTable,
 		ResumesTable,
+		ResumeChunksTable,
 		ResumeDocumentParsesTable,
+		ResumeDuplicatesTable,
 		ResumeEducationsTable,
 		ResumeExperiencesTable,
 		ResumeJobApplicationsTable,
@@ -1583,9 +1851,11 @@ var (
 		ResumeProjectsTable,
 		ResumeSkillsTable,
 		RolesTable,
+		ScreeningDimensionsTable,
 		ScreeningNodeRunsTable,
 		ScreeningResultsTable,
 		ScreeningRunMetricsTable,
+		ScreeningSchedulesTable,
 		ScreeningTasksTable,
 		ScreeningTaskResumesTable,
 		SettingsTable,
@@ -1667,10 +1937,19 @@ func init() {
 	ResumesTable.Annotation = &entsql.Annotation{
 		Table: "resumes",
 	}
+	ResumeChunksTable.ForeignKeys[0].RefTable = ResumesTable
+	ResumeChunksTable.Annotation = &entsql.Annotation{
+		Table: "resume_chunks",
+	}
 	ResumeDocumentParsesTable.ForeignKeys[0].RefTable = ResumesTable
 	ResumeDocumentParsesTable.Annotation = &entsql.Annotation{
 		Table: "resume_document_parses",
 	}
+	ResumeDuplicatesTable.ForeignKeys[0].RefTable = ResumesTable
+	ResumeDuplicatesTable.ForeignKeys[1].RefTable = ResumesTable
+	ResumeDuplicatesTable.Annotation = &entsql.Annotation{
+		Table: "resume_duplicates",
+	}
 	ResumeEducationsTable.ForeignKeys[0].RefTable = ResumesTable
 	ResumeEducationsTable.Annotation = &entsql.Annotation{
 		Table: "resume_educations",
@@ -1711,6 +1990,10 @@ func init() {
 	RolesTable.Annotation = &entsql.Annotation{
 		Table: "roles",
 	}
+	ScreeningDimensionsTable.ForeignKeys[0].RefTable = JobPositionTable
+	ScreeningDimensionsTable.Annotation = &entsql.Annotation{
+		Table: "screening_dimensions",
+	}
 	ScreeningNodeRunsTable.ForeignKeys[0].RefTable = ScreeningTasksTable
 	ScreeningNodeRunsTable.ForeignKeys[1].RefTable = ScreeningTaskResumesTable
 	ScreeningNodeRunsTable.Annotation = &entsql.Annotation{
@@ -1726,6 +2009,10 @@ func init() {
 	ScreeningRunMetricsTable.Annotation = &entsql.Annotation{
 		Table: "screening_run_metrics",
 	}
+	ScreeningSchedulesTable.ForeignKeys[0].RefTable = JobPositionTable
+	ScreeningSchedulesTable.Annotation = &entsql.Annotation{
+		Table: "screening_schedules",
+	}
 	ScreeningTasksTable.ForeignKeys[0].RefTable = JobPositionTable
 	ScreeningTasksTable.ForeignKeys[1].RefTable = UsersTable
 	ScreeningTasksTable.Annotation = &entsql.Annotation{
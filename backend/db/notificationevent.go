@@ -44,6 +44,8 @@ type NotificationEvent struct {
 	LastError string `json:"last_error,omitempty"`
 	// TraceID holds the value of the "trace_id" field.
 	TraceID string `json:"trace_id,omitempty"`
+	// 投递的通知设置ID，为空时按渠道广播
+	SettingID *uuid.UUID `json:"setting_id,omitempty"`
 	// 计划投递时间
 	ScheduledAt time.Time `json:"scheduled_at,omitempty"`
 	// 实际投递时间
@@ -60,6 +62,8 @@ func (*NotificationEvent) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case notificationevent.FieldSettingID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case notificationevent.FieldPayload:
 			values[i] = new([]byte)
 		case notificationevent.FieldRetryCount, notificationevent.FieldMaxRetry, notificationevent.FieldTimeout:
@@ -165,6 +169,13 @@ func (ne *NotificationEvent) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				ne.TraceID = value.String
 			}
+		case notificationevent.FieldSettingID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field setting_id", values[i])
+			} else if value.Valid {
+				ne.SettingID = new(uuid.UUID)
+				*ne.SettingID = *value.S.(*uuid.UUID)
+			}
 		case notificationevent.FieldScheduledAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field scheduled_at", values[i])
@@ -261,6 +272,11 @@ func (ne *NotificationEvent) String() string {
 	builder.WriteString("trace_id=")
 	builder.WriteString(ne.TraceID)
 	builder.WriteString(", ")
+	if v := ne.SettingID; v != nil {
+		builder.WriteString("setting_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("scheduled_at=")
 	builder.WriteString(ne.ScheduledAt.Format(time.ANSIC))
 	builder.WriteString(", ")
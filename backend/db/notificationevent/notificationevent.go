@@ -40,6 +40,8 @@ const (
 	FieldLastError = "last_error"
 	// FieldTraceID holds the string denoting the trace_id field in the database.
 	FieldTraceID = "trace_id"
+	// FieldSettingID holds the string denoting the setting_id field in the database.
+	FieldSettingID = "setting_id"
 	// FieldScheduledAt holds the string denoting the scheduled_at field in the database.
 	FieldScheduledAt = "scheduled_at"
 	// FieldDeliveredAt holds the string denoting the delivered_at field in the database.
@@ -67,6 +69,7 @@ var Columns = []string{
 	FieldTimeout,
 	FieldLastError,
 	FieldTraceID,
+	FieldSettingID,
 	FieldScheduledAt,
 	FieldDeliveredAt,
 	FieldCreatedAt,
@@ -174,6 +177,11 @@ func ByTraceID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTraceID, opts...).ToFunc()
 }
 
+// BySettingID orders the results by the setting_id field.
+func BySettingID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSettingID, opts...).ToFunc()
+}
+
 // ByScheduledAt orders the results by the scheduled_at field.
 func ByScheduledAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldScheduledAt, opts...).ToFunc()
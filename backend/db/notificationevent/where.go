@@ -114,6 +114,11 @@ func TraceID(v string) predicate.NotificationEvent {
 	return predicate.NotificationEvent(sql.FieldEQ(FieldTraceID, v))
 }
 
+// SettingID applies equality check predicate on the "setting_id" field. It's identical to SettingIDEQ.
+func SettingID(v uuid.UUID) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldEQ(FieldSettingID, v))
+}
+
 // ScheduledAt applies equality check predicate on the "scheduled_at" field. It's identical to ScheduledAtEQ.
 func ScheduledAt(v time.Time) predicate.NotificationEvent {
 	return predicate.NotificationEvent(sql.FieldEQ(FieldScheduledAt, v))
@@ -836,6 +841,56 @@ func TraceIDContainsFold(v string) predicate.NotificationEvent {
 	return predicate.NotificationEvent(sql.FieldContainsFold(FieldTraceID, v))
 }
 
+// SettingIDEQ applies the EQ predicate on the "setting_id" field.
+func SettingIDEQ(v uuid.UUID) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldEQ(FieldSettingID, v))
+}
+
+// SettingIDNEQ applies the NEQ predicate on the "setting_id" field.
+func SettingIDNEQ(v uuid.UUID) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldNEQ(FieldSettingID, v))
+}
+
+// SettingIDIn applies the In predicate on the "setting_id" field.
+func SettingIDIn(vs ...uuid.UUID) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldIn(FieldSettingID, vs...))
+}
+
+// SettingIDNotIn applies the NotIn predicate on the "setting_id" field.
+func SettingIDNotIn(vs ...uuid.UUID) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldNotIn(FieldSettingID, vs...))
+}
+
+// SettingIDGT applies the GT predicate on the "setting_id" field.
+func SettingIDGT(v uuid.UUID) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldGT(FieldSettingID, v))
+}
+
+// SettingIDGTE applies the GTE predicate on the "setting_id" field.
+func SettingIDGTE(v uuid.UUID) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldGTE(FieldSettingID, v))
+}
+
+// SettingIDLT applies the LT predicate on the "setting_id" field.
+func SettingIDLT(v uuid.UUID) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldLT(FieldSettingID, v))
+}
+
+// SettingIDLTE applies the LTE predicate on the "setting_id" field.
+func SettingIDLTE(v uuid.UUID) predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldLTE(FieldSettingID, v))
+}
+
+// SettingIDIsNil applies the IsNil predicate on the "setting_id" field.
+func SettingIDIsNil() predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldIsNull(FieldSettingID))
+}
+
+// SettingIDNotNil applies the NotNil predicate on the "setting_id" field.
+func SettingIDNotNil() predicate.NotificationEvent {
+	return predicate.NotificationEvent(sql.FieldNotNull(FieldSettingID))
+}
+
 // ScheduledAtEQ applies the EQ predicate on the "scheduled_at" field.
 func ScheduledAtEQ(v time.Time) predicate.NotificationEvent {
 	return predicate.NotificationEvent(sql.FieldEQ(FieldScheduledAt, v))
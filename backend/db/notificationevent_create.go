@@ -161,6 +161,20 @@ func (nec *NotificationEventCreate) SetNillableTraceID(s *string) *NotificationE
 	return nec
 }
 
+// SetSettingID sets the "setting_id" field.
+func (nec *NotificationEventCreate) SetSettingID(u uuid.UUID) *NotificationEventCreate {
+	nec.mutation.SetSettingID(u)
+	return nec
+}
+
+// SetNillableSettingID sets the "setting_id" field if the given value is not nil.
+func (nec *NotificationEventCreate) SetNillableSettingID(u *uuid.UUID) *NotificationEventCreate {
+	if u != nil {
+		nec.SetSettingID(*u)
+	}
+	return nec
+}
+
 // SetScheduledAt sets the "scheduled_at" field.
 func (nec *NotificationEventCreate) SetScheduledAt(t time.Time) *NotificationEventCreate {
 	nec.mutation.SetScheduledAt(t)
@@ -431,6 +445,10 @@ func (nec *NotificationEventCreate) createSpec() (*NotificationEvent, *sqlgraph.
 		_spec.SetField(notificationevent.FieldTraceID, field.TypeString, value)
 		_node.TraceID = value
 	}
+	if value, ok := nec.mutation.SettingID(); ok {
+		_spec.SetField(notificationevent.FieldSettingID, field.TypeUUID, value)
+		_node.SettingID = &value
+	}
 	if value, ok := nec.mutation.ScheduledAt(); ok {
 		_spec.SetField(notificationevent.FieldScheduledAt, field.TypeTime, value)
 		_node.ScheduledAt = value
@@ -679,6 +697,24 @@ func (u *NotificationEventUpsert) ClearTraceID() *NotificationEventUpsert {
 	return u
 }
 
+// SetSettingID sets the "setting_id" field.
+func (u *NotificationEventUpsert) SetSettingID(v uuid.UUID) *NotificationEventUpsert {
+	u.Set(notificationevent.FieldSettingID, v)
+	return u
+}
+
+// UpdateSettingID sets the "setting_id" field to the value that was provided on create.
+func (u *NotificationEventUpsert) UpdateSettingID() *NotificationEventUpsert {
+	u.SetExcluded(notificationevent.FieldSettingID)
+	return u
+}
+
+// ClearSettingID clears the value of the "setting_id" field.
+func (u *NotificationEventUpsert) ClearSettingID() *NotificationEventUpsert {
+	u.SetNull(notificationevent.FieldSettingID)
+	return u
+}
+
 // SetScheduledAt sets the "scheduled_at" field.
 func (u *NotificationEventUpsert) SetScheduledAt(v time.Time) *NotificationEventUpsert {
 	u.Set(notificationevent.FieldScheduledAt, v)
@@ -988,6 +1024,27 @@ func (u *NotificationEventUpsertOne) ClearTraceID() *NotificationEventUpsertOne
 	})
 }
 
+// SetSettingID sets the "setting_id" field.
+func (u *NotificationEventUpsertOne) SetSettingID(v uuid.UUID) *NotificationEventUpsertOne {
+	return u.Update(func(s *NotificationEventUpsert) {
+		s.SetSettingID(v)
+	})
+}
+
+// UpdateSettingID sets the "setting_id" field to the value that was provided on create.
+func (u *NotificationEventUpsertOne) UpdateSettingID() *NotificationEventUpsertOne {
+	return u.Update(func(s *NotificationEventUpsert) {
+		s.UpdateSettingID()
+	})
+}
+
+// ClearSettingID clears the value of the "setting_id" field.
+func (u *NotificationEventUpsertOne) ClearSettingID() *NotificationEventUpsertOne {
+	return u.Update(func(s *NotificationEventUpsert) {
+		s.ClearSettingID()
+	})
+}
+
 // SetScheduledAt sets the "scheduled_at" field.
 func (u *NotificationEventUpsertOne) SetScheduledAt(v time.Time) *NotificationEventUpsertOne {
 	return u.Update(func(s *NotificationEventUpsert) {
@@ -1472,6 +1529,27 @@ func (u *NotificationEventUpsertBulk) ClearTraceID() *NotificationEventUpsertBul
 	})
 }
 
+// SetSettingID sets the "setting_id" field.
+func (u *NotificationEventUpsertBulk) SetSettingID(v uuid.UUID) *NotificationEventUpsertBulk {
+	return u.Update(func(s *NotificationEventUpsert) {
+		s.SetSettingID(v)
+	})
+}
+
+// UpdateSettingID sets the "setting_id" field to the value that was provided on create.
+func (u *NotificationEventUpsertBulk) UpdateSettingID() *NotificationEventUpsertBulk {
+	return u.Update(func(s *NotificationEventUpsert) {
+		s.UpdateSettingID()
+	})
+}
+
+// ClearSettingID clears the value of the "setting_id" field.
+func (u *NotificationEventUpsertBulk) ClearSettingID() *NotificationEventUpsertBulk {
+	return u.Update(func(s *NotificationEventUpsert) {
+		s.ClearSettingID()
+	})
+}
+
 // SetScheduledAt sets the "scheduled_at" field.
 func (u *NotificationEventUpsertBulk) SetScheduledAt(v time.Time) *NotificationEventUpsertBulk {
 	return u.Update(func(s *NotificationEventUpsert) {
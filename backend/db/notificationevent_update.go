@@ -14,6 +14,7 @@ import (
 	"github.com/chaitin/WhaleHire/backend/consts"
 	"github.com/chaitin/WhaleHire/backend/db/notificationevent"
 	"github.com/chaitin/WhaleHire/backend/db/predicate"
+	"github.com/google/uuid"
 )
 
 // NotificationEventUpdate is the builder for updating NotificationEvent entities.
@@ -229,6 +230,26 @@ func (neu *NotificationEventUpdate) ClearTraceID() *NotificationEventUpdate {
 	return neu
 }
 
+// SetSettingID sets the "setting_id" field.
+func (neu *NotificationEventUpdate) SetSettingID(u uuid.UUID) *NotificationEventUpdate {
+	neu.mutation.SetSettingID(u)
+	return neu
+}
+
+// SetNillableSettingID sets the "setting_id" field if the given value is not nil.
+func (neu *NotificationEventUpdate) SetNillableSettingID(u *uuid.UUID) *NotificationEventUpdate {
+	if u != nil {
+		neu.SetSettingID(*u)
+	}
+	return neu
+}
+
+// ClearSettingID clears the value of the "setting_id" field.
+func (neu *NotificationEventUpdate) ClearSettingID() *NotificationEventUpdate {
+	neu.mutation.ClearSettingID()
+	return neu
+}
+
 // SetScheduledAt sets the "scheduled_at" field.
 func (neu *NotificationEventUpdate) SetScheduledAt(t time.Time) *NotificationEventUpdate {
 	neu.mutation.SetScheduledAt(t)
@@ -391,6 +412,12 @@ func (neu *NotificationEventUpdate) sqlSave(ctx context.Context) (n int, err err
 	if neu.mutation.TraceIDCleared() {
 		_spec.ClearField(notificationevent.FieldTraceID, field.TypeString)
 	}
+	if value, ok := neu.mutation.SettingID(); ok {
+		_spec.SetField(notificationevent.FieldSettingID, field.TypeUUID, value)
+	}
+	if neu.mutation.SettingIDCleared() {
+		_spec.ClearField(notificationevent.FieldSettingID, field.TypeUUID)
+	}
 	if value, ok := neu.mutation.ScheduledAt(); ok {
 		_spec.SetField(notificationevent.FieldScheduledAt, field.TypeTime, value)
 	}
@@ -627,6 +654,26 @@ func (neuo *NotificationEventUpdateOne) ClearTraceID() *NotificationEventUpdateO
 	return neuo
 }
 
+// SetSettingID sets the "setting_id" field.
+func (neuo *NotificationEventUpdateOne) SetSettingID(u uuid.UUID) *NotificationEventUpdateOne {
+	neuo.mutation.SetSettingID(u)
+	return neuo
+}
+
+// SetNillableSettingID sets the "setting_id" field if the given value is not nil.
+func (neuo *NotificationEventUpdateOne) SetNillableSettingID(u *uuid.UUID) *NotificationEventUpdateOne {
+	if u != nil {
+		neuo.SetSettingID(*u)
+	}
+	return neuo
+}
+
+// ClearSettingID clears the value of the "setting_id" field.
+func (neuo *NotificationEventUpdateOne) ClearSettingID() *NotificationEventUpdateOne {
+	neuo.mutation.ClearSettingID()
+	return neuo
+}
+
 // SetScheduledAt sets the "scheduled_at" field.
 func (neuo *NotificationEventUpdateOne) SetScheduledAt(t time.Time) *NotificationEventUpdateOne {
 	neuo.mutation.SetScheduledAt(t)
@@ -819,6 +866,12 @@ func (neuo *NotificationEventUpdateOne) sqlSave(ctx context.Context) (_node *Not
 	if neuo.mutation.TraceIDCleared() {
 		_spec.ClearField(notificationevent.FieldTraceID, field.TypeString)
 	}
+	if value, ok := neuo.mutation.SettingID(); ok {
+		_spec.SetField(notificationevent.FieldSettingID, field.TypeUUID, value)
+	}
+	if neuo.mutation.SettingIDCleared() {
+		_spec.ClearField(notificationevent.FieldSettingID, field.TypeUUID)
+	}
 	if value, ok := neuo.mutation.ScheduledAt(); ok {
 		_spec.SetField(notificationevent.FieldScheduledAt, field.TypeTime, value)
 	}
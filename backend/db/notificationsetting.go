@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/chaitin/WhaleHire/backend/consts"
 	"github.com/chaitin/WhaleHire/backend/db/notificationsetting"
+	"github.com/chaitin/WhaleHire/backend/ent/types"
 	"github.com/google/uuid"
 )
 
@@ -30,6 +31,12 @@ type NotificationSetting struct {
 	Enabled bool `json:"enabled,omitempty"`
 	// 钉钉通知配置
 	DingtalkConfig map[string]interface{} `json:"dingtalk_config,omitempty"`
+	// 邮件通知配置
+	EmailConfig map[string]interface{} `json:"email_config,omitempty"`
+	// Webhook通知配置
+	WebhookConfig map[string]interface{} `json:"webhook_config,omitempty"`
+	// 路由规则，为空时接收所有事件
+	RoutingRules []*types.NotificationRoutingRule `json:"routing_rules,omitempty"`
 	// 最大重试次数
 	MaxRetry int `json:"max_retry,omitempty"`
 	// 超时时间(秒)
@@ -48,7 +55,7 @@ func (*NotificationSetting) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case notificationsetting.FieldDingtalkConfig:
+		case notificationsetting.FieldDingtalkConfig, notificationsetting.FieldEmailConfig, notificationsetting.FieldWebhookConfig, notificationsetting.FieldRoutingRules:
 			values[i] = new([]byte)
 		case notificationsetting.FieldEnabled:
 			values[i] = new(sql.NullBool)
@@ -113,6 +120,30 @@ func (ns *NotificationSetting) assignValues(columns []string, values []any) erro
 					return fmt.Errorf("unmarshal field dingtalk_config: %w", err)
 				}
 			}
+		case notificationsetting.FieldEmailConfig:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field email_config", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ns.EmailConfig); err != nil {
+					return fmt.Errorf("unmarshal field email_config: %w", err)
+				}
+			}
+		case notificationsetting.FieldWebhookConfig:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field webhook_config", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ns.WebhookConfig); err != nil {
+					return fmt.Errorf("unmarshal field webhook_config: %w", err)
+				}
+			}
+		case notificationsetting.FieldRoutingRules:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field routing_rules", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ns.RoutingRules); err != nil {
+					return fmt.Errorf("unmarshal field routing_rules: %w", err)
+				}
+			}
 		case notificationsetting.FieldMaxRetry:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field max_retry", values[i])
@@ -194,6 +225,15 @@ func (ns *NotificationSetting) String() string {
 	builder.WriteString("dingtalk_config=")
 	builder.WriteString(fmt.Sprintf("%v", ns.DingtalkConfig))
 	builder.WriteString(", ")
+	builder.WriteString("email_config=")
+	builder.WriteString(fmt.Sprintf("%v", ns.EmailConfig))
+	builder.WriteString(", ")
+	builder.WriteString("webhook_config=")
+	builder.WriteString(fmt.Sprintf("%v", ns.WebhookConfig))
+	builder.WriteString(", ")
+	builder.WriteString("routing_rules=")
+	builder.WriteString(fmt.Sprintf("%v", ns.RoutingRules))
+	builder.WriteString(", ")
 	builder.WriteString("max_retry=")
 	builder.WriteString(fmt.Sprintf("%v", ns.MaxRetry))
 	builder.WriteString(", ")
@@ -25,6 +25,12 @@ const (
 	FieldEnabled = "enabled"
 	// FieldDingtalkConfig holds the string denoting the dingtalk_config field in the database.
 	FieldDingtalkConfig = "dingtalk_config"
+	// FieldEmailConfig holds the string denoting the email_config field in the database.
+	FieldEmailConfig = "email_config"
+	// FieldWebhookConfig holds the string denoting the webhook_config field in the database.
+	FieldWebhookConfig = "webhook_config"
+	// FieldRoutingRules holds the string denoting the routing_rules field in the database.
+	FieldRoutingRules = "routing_rules"
 	// FieldMaxRetry holds the string denoting the max_retry field in the database.
 	FieldMaxRetry = "max_retry"
 	// FieldTimeout holds the string denoting the timeout field in the database.
@@ -47,6 +53,9 @@ var Columns = []string{
 	FieldChannel,
 	FieldEnabled,
 	FieldDingtalkConfig,
+	FieldEmailConfig,
+	FieldWebhookConfig,
+	FieldRoutingRules,
 	FieldMaxRetry,
 	FieldTimeout,
 	FieldDescription,
@@ -321,6 +321,36 @@ func DingtalkConfigNotNil() predicate.NotificationSetting {
 	return predicate.NotificationSetting(sql.FieldNotNull(FieldDingtalkConfig))
 }
 
+// EmailConfigIsNil applies the IsNil predicate on the "email_config" field.
+func EmailConfigIsNil() predicate.NotificationSetting {
+	return predicate.NotificationSetting(sql.FieldIsNull(FieldEmailConfig))
+}
+
+// EmailConfigNotNil applies the NotNil predicate on the "email_config" field.
+func EmailConfigNotNil() predicate.NotificationSetting {
+	return predicate.NotificationSetting(sql.FieldNotNull(FieldEmailConfig))
+}
+
+// WebhookConfigIsNil applies the IsNil predicate on the "webhook_config" field.
+func WebhookConfigIsNil() predicate.NotificationSetting {
+	return predicate.NotificationSetting(sql.FieldIsNull(FieldWebhookConfig))
+}
+
+// WebhookConfigNotNil applies the NotNil predicate on the "webhook_config" field.
+func WebhookConfigNotNil() predicate.NotificationSetting {
+	return predicate.NotificationSetting(sql.FieldNotNull(FieldWebhookConfig))
+}
+
+// RoutingRulesIsNil applies the IsNil predicate on the "routing_rules" field.
+func RoutingRulesIsNil() predicate.NotificationSetting {
+	return predicate.NotificationSetting(sql.FieldIsNull(FieldRoutingRules))
+}
+
+// RoutingRulesNotNil applies the NotNil predicate on the "routing_rules" field.
+func RoutingRulesNotNil() predicate.NotificationSetting {
+	return predicate.NotificationSetting(sql.FieldNotNull(FieldRoutingRules))
+}
+
 // MaxRetryEQ applies the EQ predicate on the "max_retry" field.
 func MaxRetryEQ(v int) predicate.NotificationSetting {
 	return predicate.NotificationSetting(sql.FieldEQ(FieldMaxRetry, v))
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/chaitin/WhaleHire/backend/consts"
 	"github.com/chaitin/WhaleHire/backend/db/notificationsetting"
+	"github.com/chaitin/WhaleHire/backend/ent/types"
 	"github.com/google/uuid"
 )
 
@@ -71,6 +72,24 @@ func (nsc *NotificationSettingCreate) SetDingtalkConfig(m map[string]interface{}
 	return nsc
 }
 
+// SetEmailConfig sets the "email_config" field.
+func (nsc *NotificationSettingCreate) SetEmailConfig(m map[string]interface{}) *NotificationSettingCreate {
+	nsc.mutation.SetEmailConfig(m)
+	return nsc
+}
+
+// SetWebhookConfig sets the "webhook_config" field.
+func (nsc *NotificationSettingCreate) SetWebhookConfig(m map[string]interface{}) *NotificationSettingCreate {
+	nsc.mutation.SetWebhookConfig(m)
+	return nsc
+}
+
+// SetRoutingRules sets the "routing_rules" field.
+func (nsc *NotificationSettingCreate) SetRoutingRules(trr []*types.NotificationRoutingRule) *NotificationSettingCreate {
+	nsc.mutation.SetRoutingRules(trr)
+	return nsc
+}
+
 // SetMaxRetry sets the "max_retry" field.
 func (nsc *NotificationSettingCreate) SetMaxRetry(i int) *NotificationSettingCreate {
 	nsc.mutation.SetMaxRetry(i)
@@ -312,6 +331,18 @@ func (nsc *NotificationSettingCreate) createSpec() (*NotificationSetting, *sqlgr
 		_spec.SetField(notificationsetting.FieldDingtalkConfig, field.TypeJSON, value)
 		_node.DingtalkConfig = value
 	}
+	if value, ok := nsc.mutation.EmailConfig(); ok {
+		_spec.SetField(notificationsetting.FieldEmailConfig, field.TypeJSON, value)
+		_node.EmailConfig = value
+	}
+	if value, ok := nsc.mutation.WebhookConfig(); ok {
+		_spec.SetField(notificationsetting.FieldWebhookConfig, field.TypeJSON, value)
+		_node.WebhookConfig = value
+	}
+	if value, ok := nsc.mutation.RoutingRules(); ok {
+		_spec.SetField(notificationsetting.FieldRoutingRules, field.TypeJSON, value)
+		_node.RoutingRules = value
+	}
 	if value, ok := nsc.mutation.MaxRetry(); ok {
 		_spec.SetField(notificationsetting.FieldMaxRetry, field.TypeInt, value)
 		_node.MaxRetry = value
@@ -456,6 +487,60 @@ func (u *NotificationSettingUpsert) ClearDingtalkConfig() *NotificationSettingUp
 	return u
 }
 
+// SetEmailConfig sets the "email_config" field.
+func (u *NotificationSettingUpsert) SetEmailConfig(v map[string]interface{}) *NotificationSettingUpsert {
+	u.Set(notificationsetting.FieldEmailConfig, v)
+	return u
+}
+
+// UpdateEmailConfig sets the "email_config" field to the value that was provided on create.
+func (u *NotificationSettingUpsert) UpdateEmailConfig() *NotificationSettingUpsert {
+	u.SetExcluded(notificationsetting.FieldEmailConfig)
+	return u
+}
+
+// ClearEmailConfig clears the value of the "email_config" field.
+func (u *NotificationSettingUpsert) ClearEmailConfig() *NotificationSettingUpsert {
+	u.SetNull(notificationsetting.FieldEmailConfig)
+	return u
+}
+
+// SetWebhookConfig sets the "webhook_config" field.
+func (u *NotificationSettingUpsert) SetWebhookConfig(v map[string]interface{}) *NotificationSettingUpsert {
+	u.Set(notificationsetting.FieldWebhookConfig, v)
+	return u
+}
+
+// UpdateWebhookConfig sets the "webhook_config" field to the value that was provided on create.
+func (u *NotificationSettingUpsert) UpdateWebhookConfig() *NotificationSettingUpsert {
+	u.SetExcluded(notificationsetting.FieldWebhookConfig)
+	return u
+}
+
+// ClearWebhookConfig clears the value of the "webhook_config" field.
+func (u *NotificationSettingUpsert) ClearWebhookConfig() *NotificationSettingUpsert {
+	u.SetNull(notificationsetting.FieldWebhookConfig)
+	return u
+}
+
+// SetRoutingRules sets the "routing_rules" field.
+func (u *NotificationSettingUpsert) SetRoutingRules(v []*types.NotificationRoutingRule) *NotificationSettingUpsert {
+	u.Set(notificationsetting.FieldRoutingRules, v)
+	return u
+}
+
+// UpdateRoutingRules sets the "routing_rules" field to the value that was provided on create.
+func (u *NotificationSettingUpsert) UpdateRoutingRules() *NotificationSettingUpsert {
+	u.SetExcluded(notificationsetting.FieldRoutingRules)
+	return u
+}
+
+// ClearRoutingRules clears the value of the "routing_rules" field.
+func (u *NotificationSettingUpsert) ClearRoutingRules() *NotificationSettingUpsert {
+	u.SetNull(notificationsetting.FieldRoutingRules)
+	return u
+}
+
 // SetMaxRetry sets the "max_retry" field.
 func (u *NotificationSettingUpsert) SetMaxRetry(v int) *NotificationSettingUpsert {
 	u.Set(notificationsetting.FieldMaxRetry, v)
@@ -666,6 +751,69 @@ func (u *NotificationSettingUpsertOne) ClearDingtalkConfig() *NotificationSettin
 	})
 }
 
+// SetEmailConfig sets the "email_config" field.
+func (u *NotificationSettingUpsertOne) SetEmailConfig(v map[string]interface{}) *NotificationSettingUpsertOne {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.SetEmailConfig(v)
+	})
+}
+
+// UpdateEmailConfig sets the "email_config" field to the value that was provided on create.
+func (u *NotificationSettingUpsertOne) UpdateEmailConfig() *NotificationSettingUpsertOne {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.UpdateEmailConfig()
+	})
+}
+
+// ClearEmailConfig clears the value of the "email_config" field.
+func (u *NotificationSettingUpsertOne) ClearEmailConfig() *NotificationSettingUpsertOne {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.ClearEmailConfig()
+	})
+}
+
+// SetWebhookConfig sets the "webhook_config" field.
+func (u *NotificationSettingUpsertOne) SetWebhookConfig(v map[string]interface{}) *NotificationSettingUpsertOne {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.SetWebhookConfig(v)
+	})
+}
+
+// UpdateWebhookConfig sets the "webhook_config" field to the value that was provided on create.
+func (u *NotificationSettingUpsertOne) UpdateWebhookConfig() *NotificationSettingUpsertOne {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.UpdateWebhookConfig()
+	})
+}
+
+// ClearWebhookConfig clears the value of the "webhook_config" field.
+func (u *NotificationSettingUpsertOne) ClearWebhookConfig() *NotificationSettingUpsertOne {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.ClearWebhookConfig()
+	})
+}
+
+// SetRoutingRules sets the "routing_rules" field.
+func (u *NotificationSettingUpsertOne) SetRoutingRules(v []*types.NotificationRoutingRule) *NotificationSettingUpsertOne {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.SetRoutingRules(v)
+	})
+}
+
+// UpdateRoutingRules sets the "routing_rules" field to the value that was provided on create.
+func (u *NotificationSettingUpsertOne) UpdateRoutingRules() *NotificationSettingUpsertOne {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.UpdateRoutingRules()
+	})
+}
+
+// ClearRoutingRules clears the value of the "routing_rules" field.
+func (u *NotificationSettingUpsertOne) ClearRoutingRules() *NotificationSettingUpsertOne {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.ClearRoutingRules()
+	})
+}
+
 // SetMaxRetry sets the "max_retry" field.
 func (u *NotificationSettingUpsertOne) SetMaxRetry(v int) *NotificationSettingUpsertOne {
 	return u.Update(func(s *NotificationSettingUpsert) {
@@ -1056,6 +1204,69 @@ func (u *NotificationSettingUpsertBulk) ClearDingtalkConfig() *NotificationSetti
 	})
 }
 
+// SetEmailConfig sets the "email_config" field.
+func (u *NotificationSettingUpsertBulk) SetEmailConfig(v map[string]interface{}) *NotificationSettingUpsertBulk {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.SetEmailConfig(v)
+	})
+}
+
+// UpdateEmailConfig sets the "email_config" field to the value that was provided on create.
+func (u *NotificationSettingUpsertBulk) UpdateEmailConfig() *NotificationSettingUpsertBulk {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.UpdateEmailConfig()
+	})
+}
+
+// ClearEmailConfig clears the value of the "email_config" field.
+func (u *NotificationSettingUpsertBulk) ClearEmailConfig() *NotificationSettingUpsertBulk {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.ClearEmailConfig()
+	})
+}
+
+// SetWebhookConfig sets the "webhook_config" field.
+func (u *NotificationSettingUpsertBulk) SetWebhookConfig(v map[string]interface{}) *NotificationSettingUpsertBulk {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.SetWebhookConfig(v)
+	})
+}
+
+// UpdateWebhookConfig sets the "webhook_config" field to the value that was provided on create.
+func (u *NotificationSettingUpsertBulk) UpdateWebhookConfig() *NotificationSettingUpsertBulk {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.UpdateWebhookConfig()
+	})
+}
+
+// ClearWebhookConfig clears the value of the "webhook_config" field.
+func (u *NotificationSettingUpsertBulk) ClearWebhookConfig() *NotificationSettingUpsertBulk {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.ClearWebhookConfig()
+	})
+}
+
+// SetRoutingRules sets the "routing_rules" field.
+func (u *NotificationSettingUpsertBulk) SetRoutingRules(v []*types.NotificationRoutingRule) *NotificationSettingUpsertBulk {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.SetRoutingRules(v)
+	})
+}
+
+// UpdateRoutingRules sets the "routing_rules" field to the value that was provided on create.
+func (u *NotificationSettingUpsertBulk) UpdateRoutingRules() *NotificationSettingUpsertBulk {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.UpdateRoutingRules()
+	})
+}
+
+// ClearRoutingRules clears the value of the "routing_rules" field.
+func (u *NotificationSettingUpsertBulk) ClearRoutingRules() *NotificationSettingUpsertBulk {
+	return u.Update(func(s *NotificationSettingUpsert) {
+		s.ClearRoutingRules()
+	})
+}
+
 // SetMaxRetry sets the "max_retry" field.
 func (u *NotificationSettingUpsertBulk) SetMaxRetry(v int) *NotificationSettingUpsertBulk {
 	return u.Update(func(s *NotificationSettingUpsert) {
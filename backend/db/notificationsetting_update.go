@@ -10,10 +10,12 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/chaitin/WhaleHire/backend/consts"
 	"github.com/chaitin/WhaleHire/backend/db/notificationsetting"
 	"github.com/chaitin/WhaleHire/backend/db/predicate"
+	"github.com/chaitin/WhaleHire/backend/ent/types"
 )
 
 // NotificationSettingUpdate is the builder for updating NotificationSetting entities.
@@ -104,6 +106,48 @@ func (nsu *NotificationSettingUpdate) ClearDingtalkConfig() *NotificationSetting
 	return nsu
 }
 
+// SetEmailConfig sets the "email_config" field.
+func (nsu *NotificationSettingUpdate) SetEmailConfig(m map[string]interface{}) *NotificationSettingUpdate {
+	nsu.mutation.SetEmailConfig(m)
+	return nsu
+}
+
+// ClearEmailConfig clears the value of the "email_config" field.
+func (nsu *NotificationSettingUpdate) ClearEmailConfig() *NotificationSettingUpdate {
+	nsu.mutation.ClearEmailConfig()
+	return nsu
+}
+
+// SetWebhookConfig sets the "webhook_config" field.
+func (nsu *NotificationSettingUpdate) SetWebhookConfig(m map[string]interface{}) *NotificationSettingUpdate {
+	nsu.mutation.SetWebhookConfig(m)
+	return nsu
+}
+
+// ClearWebhookConfig clears the value of the "webhook_config" field.
+func (nsu *NotificationSettingUpdate) ClearWebhookConfig() *NotificationSettingUpdate {
+	nsu.mutation.ClearWebhookConfig()
+	return nsu
+}
+
+// SetRoutingRules sets the "routing_rules" field.
+func (nsu *NotificationSettingUpdate) SetRoutingRules(trr []*types.NotificationRoutingRule) *NotificationSettingUpdate {
+	nsu.mutation.SetRoutingRules(trr)
+	return nsu
+}
+
+// AppendRoutingRules appends trr to the "routing_rules" field.
+func (nsu *NotificationSettingUpdate) AppendRoutingRules(trr []*types.NotificationRoutingRule) *NotificationSettingUpdate {
+	nsu.mutation.AppendRoutingRules(trr)
+	return nsu
+}
+
+// ClearRoutingRules clears the value of the "routing_rules" field.
+func (nsu *NotificationSettingUpdate) ClearRoutingRules() *NotificationSettingUpdate {
+	nsu.mutation.ClearRoutingRules()
+	return nsu
+}
+
 // SetMaxRetry sets the "max_retry" field.
 func (nsu *NotificationSettingUpdate) SetMaxRetry(i int) *NotificationSettingUpdate {
 	nsu.mutation.ResetMaxRetry()
@@ -282,6 +326,29 @@ func (nsu *NotificationSettingUpdate) sqlSave(ctx context.Context) (n int, err e
 	if nsu.mutation.DingtalkConfigCleared() {
 		_spec.ClearField(notificationsetting.FieldDingtalkConfig, field.TypeJSON)
 	}
+	if value, ok := nsu.mutation.EmailConfig(); ok {
+		_spec.SetField(notificationsetting.FieldEmailConfig, field.TypeJSON, value)
+	}
+	if nsu.mutation.EmailConfigCleared() {
+		_spec.ClearField(notificationsetting.FieldEmailConfig, field.TypeJSON)
+	}
+	if value, ok := nsu.mutation.WebhookConfig(); ok {
+		_spec.SetField(notificationsetting.FieldWebhookConfig, field.TypeJSON, value)
+	}
+	if nsu.mutation.WebhookConfigCleared() {
+		_spec.ClearField(notificationsetting.FieldWebhookConfig, field.TypeJSON)
+	}
+	if value, ok := nsu.mutation.RoutingRules(); ok {
+		_spec.SetField(notificationsetting.FieldRoutingRules, field.TypeJSON, value)
+	}
+	if value, ok := nsu.mutation.AppendedRoutingRules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, notificationsetting.FieldRoutingRules, value)
+		})
+	}
+	if nsu.mutation.RoutingRulesCleared() {
+		_spec.ClearField(notificationsetting.FieldRoutingRules, field.TypeJSON)
+	}
 	if value, ok := nsu.mutation.MaxRetry(); ok {
 		_spec.SetField(notificationsetting.FieldMaxRetry, field.TypeInt, value)
 	}
@@ -402,6 +469,48 @@ func (nsuo *NotificationSettingUpdateOne) ClearDingtalkConfig() *NotificationSet
 	return nsuo
 }
 
+// SetEmailConfig sets the "email_config" field.
+func (nsuo *NotificationSettingUpdateOne) SetEmailConfig(m map[string]interface{}) *NotificationSettingUpdateOne {
+	nsuo.mutation.SetEmailConfig(m)
+	return nsuo
+}
+
+// ClearEmailConfig clears the value of the "email_config" field.
+func (nsuo *NotificationSettingUpdateOne) ClearEmailConfig() *NotificationSettingUpdateOne {
+	nsuo.mutation.ClearEmailConfig()
+	return nsuo
+}
+
+// SetWebhookConfig sets the "webhook_config" field.
+func (nsuo *NotificationSettingUpdateOne) SetWebhookConfig(m map[string]interface{}) *NotificationSettingUpdateOne {
+	nsuo.mutation.SetWebhookConfig(m)
+	return nsuo
+}
+
+// ClearWebhookConfig clears the value of the "webhook_config" field.
+func (nsuo *NotificationSettingUpdateOne) ClearWebhookConfig() *NotificationSettingUpdateOne {
+	nsuo.mutation.ClearWebhookConfig()
+	return nsuo
+}
+
+// SetRoutingRules sets the "routing_rules" field.
+func (nsuo *NotificationSettingUpdateOne) SetRoutingRules(trr []*types.NotificationRoutingRule) *NotificationSettingUpdateOne {
+	nsuo.mutation.SetRoutingRules(trr)
+	return nsuo
+}
+
+// AppendRoutingRules appends trr to the "routing_rules" field.
+func (nsuo *NotificationSettingUpdateOne) AppendRoutingRules(trr []*types.NotificationRoutingRule) *NotificationSettingUpdateOne {
+	nsuo.mutation.AppendRoutingRules(trr)
+	return nsuo
+}
+
+// ClearRoutingRules clears the value of the "routing_rules" field.
+func (nsuo *NotificationSettingUpdateOne) ClearRoutingRules() *NotificationSettingUpdateOne {
+	nsuo.mutation.ClearRoutingRules()
+	return nsuo
+}
+
 // SetMaxRetry sets the "max_retry" field.
 func (nsuo *NotificationSettingUpdateOne) SetMaxRetry(i int) *NotificationSettingUpdateOne {
 	nsuo.mutation.ResetMaxRetry()
@@ -610,6 +719,29 @@ func (nsuo *NotificationSettingUpdateOne) sqlSave(ctx context.Context) (_node *N
 	if nsuo.mutation.DingtalkConfigCleared() {
 		_spec.ClearField(notificationsetting.FieldDingtalkConfig, field.TypeJSON)
 	}
+	if value, ok := nsuo.mutation.EmailConfig(); ok {
+		_spec.SetField(notificationsetting.FieldEmailConfig, field.TypeJSON, value)
+	}
+	if nsuo.mutation.EmailConfigCleared() {
+		_spec.ClearField(notificationsetting.FieldEmailConfig, field.TypeJSON)
+	}
+	if value, ok := nsuo.mutation.WebhookConfig(); ok {
+		_spec.SetField(notificationsetting.FieldWebhookConfig, field.TypeJSON, value)
+	}
+	if nsuo.mutation.WebhookConfigCleared() {
+		_spec.ClearField(notificationsetting.FieldWebhookConfig, field.TypeJSON)
+	}
+	if value, ok := nsuo.mutation.RoutingRules(); ok {
+		_spec.SetField(notificationsetting.FieldRoutingRules, field.TypeJSON, value)
+	}
+	if value, ok := nsuo.mutation.AppendedRoutingRules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, notificationsetting.FieldRoutingRules, value)
+		})
+	}
+	if nsuo.mutation.RoutingRulesCleared() {
+		_spec.ClearField(notificationsetting.FieldRoutingRules, field.TypeJSON)
+	}
 	if value, ok := nsuo.mutation.MaxRetry(); ok {
 		_spec.SetField(notificationsetting.FieldMaxRetry, field.TypeInt, value)
 	}
@@ -263,6 +263,20 @@ func (r *ResumeQuery) Page(ctx context.Context, page, size int) ([]*Resume, *Pag
 	return items, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
 }
 
+func (rc *ResumeChunkQuery) Page(ctx context.Context, page, size int) ([]*ResumeChunk, *PageInfo, error) {
+	cnt, err := rc.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	offset := size * (page - 1)
+	items, err := rc.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	has := (page * size) < cnt
+	return items, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
+}
+
 func (rdp *ResumeDocumentParseQuery) Page(ctx context.Context, page, size int) ([]*ResumeDocumentParse, *PageInfo, error) {
 	cnt, err := rdp.Count(ctx)
 	if err != nil {
@@ -277,6 +291,20 @@ func (rdp *ResumeDocumentParseQuery) Page(ctx context.Context, page, size int) (
 	return items, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
 }
 
+func (rd *ResumeDuplicateQuery) Page(ctx context.Context, page, size int) ([]*ResumeDuplicate, *PageInfo, error) {
+	cnt, err := rd.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	offset := size * (page - 1)
+	items, err := rd.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	has := (page * size) < cnt
+	return items, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
+}
+
 func (re *ResumeEducationQuery) Page(ctx context.Context, page, size int) ([]*ResumeEducation, *PageInfo, error) {
 	cnt, err := re.Count(ctx)
 	if err != nil {
@@ -417,6 +445,20 @@ func (r *RoleQuery) Page(ctx context.Context, page, size int) ([]*Role, *PageInf
 	return items, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
 }
 
+func (sd *ScreeningDimensionQuery) Page(ctx context.Context, page, size int) ([]*ScreeningDimension, *PageInfo, error) {
+	cnt, err := sd.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	offset := size * (page - 1)
+	items, err := sd.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	has := (page * size) < cnt
+	return items, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
+}
+
 func (snr *ScreeningNodeRunQuery) Page(ctx context.Context, page, size int) ([]*ScreeningNodeRun, *PageInfo, error) {
 	cnt, err := snr.Count(ctx)
 	if err != nil {
@@ -459,6 +501,20 @@ func (srm *ScreeningRunMetricQuery) Page(ctx context.Context, page, size int) ([
 	return items, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
 }
 
+func (ss *ScreeningScheduleQuery) Page(ctx context.Context, page, size int) ([]*ScreeningSchedule, *PageInfo, error) {
+	cnt, err := ss.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	offset := size * (page - 1)
+	items, err := ss.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	has := (page * size) < cnt
+	return items, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
+}
+
 func (st *ScreeningTaskQuery) Page(ctx context.Context, page, size int) ([]*ScreeningTask, *PageInfo, error) {
 	cnt, err := st.Count(ctx)
 	if err != nil {
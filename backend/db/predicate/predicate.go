@@ -60,9 +60,15 @@ type NotificationSetting func(*sql.Selector)
 // Resume is the predicate function for resume builders.
 type Resume func(*sql.Selector)
 
+// ResumeChunk is the predicate function for resumechunk builders.
+type ResumeChunk func(*sql.Selector)
+
 // ResumeDocumentParse is the predicate function for resumedocumentparse builders.
 type ResumeDocumentParse func(*sql.Selector)
 
+// ResumeDuplicate is the predicate function for resumeduplicate builders.
+type ResumeDuplicate func(*sql.Selector)
+
 // ResumeEducation is the predicate function for resumeeducation builders.
 type ResumeEducation func(*sql.Selector)
 
@@ -93,6 +99,9 @@ type ResumeSkill func(*sql.Selector)
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
+// ScreeningDimension is the predicate function for screeningdimension builders.
+type ScreeningDimension func(*sql.Selector)
+
 // ScreeningNodeRun is the predicate function for screeningnoderun builders.
 type ScreeningNodeRun func(*sql.Selector)
 
@@ -102,6 +111,9 @@ type ScreeningResult func(*sql.Selector)
 // ScreeningRunMetric is the predicate function for screeningrunmetric builders.
 type ScreeningRunMetric func(*sql.Selector)
 
+// ScreeningSchedule is the predicate function for screeningschedule builders.
+type ScreeningSchedule func(*sql.Selector)
+
 // ScreeningTask is the predicate function for screeningtask builders.
 type ScreeningTask func(*sql.Selector)
 
@@ -56,6 +56,20 @@ type Resume struct {
 	OtherInfo string `json:"other_info,omitempty"`
 	// ResumeFileURL holds the value of the "resume_file_url" field.
 	ResumeFileURL string `json:"resume_file_url,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// FileHash holds the value of the "file_hash" field.
+	FileHash string `json:"file_hash,omitempty"`
+	// NormalizedPhone holds the value of the "normalized_phone" field.
+	NormalizedPhone string `json:"normalized_phone,omitempty"`
+	// NormalizedEmail holds the value of the "normalized_email" field.
+	NormalizedEmail string `json:"normalized_email,omitempty"`
+	// NormalizedName holds the value of the "normalized_name" field.
+	NormalizedName string `json:"normalized_name,omitempty"`
+	// MergedIntoID holds the value of the "merged_into_id" field.
+	MergedIntoID *uuid.UUID `json:"merged_into_id,omitempty"`
+	// MergedAt holds the value of the "merged_at" field.
+	MergedAt *time.Time `json:"merged_at,omitempty"`
 	// Status holds the value of the "status" field.
 	Status string `json:"status,omitempty"`
 	// ErrorMessage holds the value of the "error_message" field.
@@ -94,9 +108,15 @@ type ResumeEdges struct {
 	ScreeningTaskResumes []*ScreeningTaskResume `json:"screening_task_resumes,omitempty"`
 	// ScreeningResults holds the value of the screening_results edge.
 	ScreeningResults []*ScreeningResult `json:"screening_results,omitempty"`
+	// DuplicateFlags holds the value of the duplicate_flags edge.
+	DuplicateFlags []*ResumeDuplicate `json:"duplicate_flags,omitempty"`
+	// DuplicatedBy holds the value of the duplicated_by edge.
+	DuplicatedBy []*ResumeDuplicate `json:"duplicated_by,omitempty"`
+	// Chunks holds the value of the chunks edge.
+	Chunks []*ResumeChunk `json:"chunks,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [10]bool
+	loadedTypes [13]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -191,18 +211,47 @@ func (e ResumeEdges) ScreeningResultsOrErr() ([]*ScreeningResult, error) {
 	return nil, &NotLoadedError{edge: "screening_results"}
 }
 
+// DuplicateFlagsOrErr returns the DuplicateFlags value or an error if the edge
+// was not loaded in eager-loading.
+func (e ResumeEdges) DuplicateFlagsOrErr() ([]*ResumeDuplicate, error) {
+	if e.loadedTypes[10] {
+		return e.DuplicateFlags, nil
+	}
+	return nil, &NotLoadedError{edge: "duplicate_flags"}
+}
+
+// DuplicatedByOrErr returns the DuplicatedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e ResumeEdges) DuplicatedByOrErr() ([]*ResumeDuplicate, error) {
+	if e.loadedTypes[11] {
+		return e.DuplicatedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "duplicated_by"}
+}
+
+// ChunksOrErr returns the Chunks value or an error if the edge
+// was not loaded in eager-loading.
+func (e ResumeEdges) ChunksOrErr() ([]*ResumeChunk, error) {
+	if e.loadedTypes[12] {
+		return e.Chunks, nil
+	}
+	return nil, &NotLoadedError{edge: "chunks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Resume) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case resume.FieldMergedIntoID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case resume.FieldYearsExperience:
 			values[i] = new(sql.NullFloat64)
 		case resume.FieldAge:
 			values[i] = new(sql.NullInt64)
-		case resume.FieldName, resume.FieldGender, resume.FieldEmail, resume.FieldPhone, resume.FieldCurrentCity, resume.FieldHighestEducation, resume.FieldPersonalSummary, resume.FieldExpectedSalary, resume.FieldExpectedCity, resume.FieldEmploymentStatus, resume.FieldHonorsCertificates, resume.FieldOtherInfo, resume.FieldResumeFileURL, resume.FieldStatus, resume.FieldErrorMessage:
+		case resume.FieldName, resume.FieldGender, resume.FieldEmail, resume.FieldPhone, resume.FieldCurrentCity, resume.FieldHighestEducation, resume.FieldPersonalSummary, resume.FieldExpectedSalary, resume.FieldExpectedCity, resume.FieldEmploymentStatus, resume.FieldHonorsCertificates, resume.FieldOtherInfo, resume.FieldResumeFileURL, resume.FieldSource, resume.FieldFileHash, resume.FieldNormalizedPhone, resume.FieldNormalizedEmail, resume.FieldNormalizedName, resume.FieldStatus, resume.FieldErrorMessage:
 			values[i] = new(sql.NullString)
-		case resume.FieldDeletedAt, resume.FieldBirthday, resume.FieldParsedAt, resume.FieldCreatedAt, resume.FieldUpdatedAt:
+		case resume.FieldDeletedAt, resume.FieldBirthday, resume.FieldMergedAt, resume.FieldParsedAt, resume.FieldCreatedAt, resume.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case resume.FieldID, resume.FieldUploaderID:
 			values[i] = new(uuid.UUID)
@@ -335,6 +384,50 @@ func (r *Resume) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				r.ResumeFileURL = value.String
 			}
+		case resume.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				r.Source = value.String
+			}
+		case resume.FieldFileHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_hash", values[i])
+			} else if value.Valid {
+				r.FileHash = value.String
+			}
+		case resume.FieldNormalizedPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field normalized_phone", values[i])
+			} else if value.Valid {
+				r.NormalizedPhone = value.String
+			}
+		case resume.FieldNormalizedEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field normalized_email", values[i])
+			} else if value.Valid {
+				r.NormalizedEmail = value.String
+			}
+		case resume.FieldNormalizedName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field normalized_name", values[i])
+			} else if value.Valid {
+				r.NormalizedName = value.String
+			}
+		case resume.FieldMergedIntoID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field merged_into_id", values[i])
+			} else if value.Valid {
+				r.MergedIntoID = new(uuid.UUID)
+				*r.MergedIntoID = *value.S.(*uuid.UUID)
+			}
+		case resume.FieldMergedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field merged_at", values[i])
+			} else if value.Valid {
+				r.MergedAt = new(time.Time)
+				*r.MergedAt = value.Time
+			}
 		case resume.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -428,6 +521,21 @@ func (r *Resume) QueryScreeningResults() *ScreeningResultQuery {
 	return NewResumeClient(r.config).QueryScreeningResults(r)
 }
 
+// QueryDuplicateFlags queries the "duplicate_flags" edge of the Resume entity.
+func (r *Resume) QueryDuplicateFlags() *ResumeDuplicateQuery {
+	return NewResumeClient(r.config).QueryDuplicateFlags(r)
+}
+
+// QueryDuplicatedBy queries the "duplicated_by" edge of the Resume entity.
+func (r *Resume) QueryDuplicatedBy() *ResumeDuplicateQuery {
+	return NewResumeClient(r.config).QueryDuplicatedBy(r)
+}
+
+// QueryChunks queries the "chunks" edge of the Resume entity.
+func (r *Resume) QueryChunks() *ResumeChunkQuery {
+	return NewResumeClient(r.config).QueryChunks(r)
+}
+
 // Update returns a builder for updating this Resume.
 // Note that you need to call Resume.Unwrap() before calling this method if this Resume
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -505,6 +613,31 @@ func (r *Resume) String() string {
 	builder.WriteString("resume_file_url=")
 	builder.WriteString(r.ResumeFileURL)
 	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(r.Source)
+	builder.WriteString(", ")
+	builder.WriteString("file_hash=")
+	builder.WriteString(r.FileHash)
+	builder.WriteString(", ")
+	builder.WriteString("normalized_phone=")
+	builder.WriteString(r.NormalizedPhone)
+	builder.WriteString(", ")
+	builder.WriteString("normalized_email=")
+	builder.WriteString(r.NormalizedEmail)
+	builder.WriteString(", ")
+	builder.WriteString("normalized_name=")
+	builder.WriteString(r.NormalizedName)
+	builder.WriteString(", ")
+	if v := r.MergedIntoID; v != nil {
+		builder.WriteString("merged_into_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := r.MergedAt; v != nil {
+		builder.WriteString("merged_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(r.Status)
 	builder.WriteString(", ")
@@ -52,6 +52,20 @@ const (
 	FieldOtherInfo = "other_info"
 	// FieldResumeFileURL holds the string denoting the resume_file_url field in the database.
 	FieldResumeFileURL = "resume_file_url"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldFileHash holds the string denoting the file_hash field in the database.
+	FieldFileHash = "file_hash"
+	// FieldNormalizedPhone holds the string denoting the normalized_phone field in the database.
+	FieldNormalizedPhone = "normalized_phone"
+	// FieldNormalizedEmail holds the string denoting the normalized_email field in the database.
+	FieldNormalizedEmail = "normalized_email"
+	// FieldNormalizedName holds the string denoting the normalized_name field in the database.
+	FieldNormalizedName = "normalized_name"
+	// FieldMergedIntoID holds the string denoting the merged_into_id field in the database.
+	FieldMergedIntoID = "merged_into_id"
+	// FieldMergedAt holds the string denoting the merged_at field in the database.
+	FieldMergedAt = "merged_at"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldErrorMessage holds the string denoting the error_message field in the database.
@@ -82,6 +96,12 @@ const (
 	EdgeScreeningTaskResumes = "screening_task_resumes"
 	// EdgeScreeningResults holds the string denoting the screening_results edge name in mutations.
 	EdgeScreeningResults = "screening_results"
+	// EdgeDuplicateFlags holds the string denoting the duplicate_flags edge name in mutations.
+	EdgeDuplicateFlags = "duplicate_flags"
+	// EdgeDuplicatedBy holds the string denoting the duplicated_by edge name in mutations.
+	EdgeDuplicatedBy = "duplicated_by"
+	// EdgeChunks holds the string denoting the chunks edge name in mutations.
+	EdgeChunks = "chunks"
 	// Table holds the table name of the resume in the database.
 	Table = "resumes"
 	// UserTable is the table that holds the user relation/edge.
@@ -154,6 +174,27 @@ const (
 	ScreeningResultsInverseTable = "screening_results"
 	// ScreeningResultsColumn is the table column denoting the screening_results relation/edge.
 	ScreeningResultsColumn = "resume_id"
+	// DuplicateFlagsTable is the table that holds the duplicate_flags relation/edge.
+	DuplicateFlagsTable = "resume_duplicates"
+	// DuplicateFlagsInverseTable is the table name for the ResumeDuplicate entity.
+	// It exists in this package in order to avoid circular dependency with the "resumeduplicate" package.
+	DuplicateFlagsInverseTable = "resume_duplicates"
+	// DuplicateFlagsColumn is the table column denoting the duplicate_flags relation/edge.
+	DuplicateFlagsColumn = "resume_id"
+	// DuplicatedByTable is the table that holds the duplicated_by relation/edge.
+	DuplicatedByTable = "resume_duplicates"
+	// DuplicatedByInverseTable is the table name for the ResumeDuplicate entity.
+	// It exists in this package in order to avoid circular dependency with the "resumeduplicate" package.
+	DuplicatedByInverseTable = "resume_duplicates"
+	// DuplicatedByColumn is the table column denoting the duplicated_by relation/edge.
+	DuplicatedByColumn = "duplicate_resume_id"
+	// ChunksTable is the table that holds the chunks relation/edge.
+	ChunksTable = "resume_chunks"
+	// ChunksInverseTable is the table name for the ResumeChunk entity.
+	// It exists in this package in order to avoid circular dependency with the "resumechunk" package.
+	ChunksInverseTable = "resume_chunks"
+	// ChunksColumn is the table column denoting the chunks relation/edge.
+	ChunksColumn = "resume_id"
 )
 
 // Columns holds all SQL columns for resume fields.
@@ -177,6 +218,13 @@ var Columns = []string{
 	FieldHonorsCertificates,
 	FieldOtherInfo,
 	FieldResumeFileURL,
+	FieldSource,
+	FieldFileHash,
+	FieldNormalizedPhone,
+	FieldNormalizedEmail,
+	FieldNormalizedName,
+	FieldMergedIntoID,
+	FieldMergedAt,
 	FieldStatus,
 	FieldErrorMessage,
 	FieldParsedAt,
@@ -204,6 +252,8 @@ var (
 	Interceptors [1]ent.Interceptor
 	// HighestEducationValidator is a validator for the "highest_education" field. It is called by the builders before save.
 	HighestEducationValidator func(string) error
+	// FileHashValidator is a validator for the "file_hash" field. It is called by the builders before save.
+	FileHashValidator func(string) error
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -314,6 +364,41 @@ func ByResumeFileURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResumeFileURL, opts...).ToFunc()
 }
 
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByFileHash orders the results by the file_hash field.
+func ByFileHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileHash, opts...).ToFunc()
+}
+
+// ByNormalizedPhone orders the results by the normalized_phone field.
+func ByNormalizedPhone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNormalizedPhone, opts...).ToFunc()
+}
+
+// ByNormalizedEmail orders the results by the normalized_email field.
+func ByNormalizedEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNormalizedEmail, opts...).ToFunc()
+}
+
+// ByNormalizedName orders the results by the normalized_name field.
+func ByNormalizedName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNormalizedName, opts...).ToFunc()
+}
+
+// ByMergedIntoID orders the results by the merged_into_id field.
+func ByMergedIntoID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMergedIntoID, opts...).ToFunc()
+}
+
+// ByMergedAt orders the results by the merged_at field.
+func ByMergedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMergedAt, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -471,6 +556,48 @@ func ByScreeningResults(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newScreeningResultsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByDuplicateFlagsCount orders the results by duplicate_flags count.
+func ByDuplicateFlagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDuplicateFlagsStep(), opts...)
+	}
+}
+
+// ByDuplicateFlags orders the results by duplicate_flags terms.
+func ByDuplicateFlags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDuplicateFlagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDuplicatedByCount orders the results by duplicated_by count.
+func ByDuplicatedByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDuplicatedByStep(), opts...)
+	}
+}
+
+// ByDuplicatedBy orders the results by duplicated_by terms.
+func ByDuplicatedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDuplicatedByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChunksCount orders the results by chunks count.
+func ByChunksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChunksStep(), opts...)
+	}
+}
+
+// ByChunks orders the results by chunks terms.
+func ByChunks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChunksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -541,3 +668,24 @@ func newScreeningResultsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ScreeningResultsTable, ScreeningResultsColumn),
 	)
 }
+func newDuplicateFlagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DuplicateFlagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DuplicateFlagsTable, DuplicateFlagsColumn),
+	)
+}
+func newDuplicatedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DuplicatedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DuplicatedByTable, DuplicatedByColumn),
+	)
+}
+func newChunksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChunksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChunksTable, ChunksColumn),
+	)
+}
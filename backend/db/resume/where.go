@@ -148,6 +148,41 @@ func ResumeFileURL(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldEQ(FieldResumeFileURL, v))
 }
 
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldSource, v))
+}
+
+// FileHash applies equality check predicate on the "file_hash" field. It's identical to FileHashEQ.
+func FileHash(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldFileHash, v))
+}
+
+// NormalizedPhone applies equality check predicate on the "normalized_phone" field. It's identical to NormalizedPhoneEQ.
+func NormalizedPhone(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldNormalizedPhone, v))
+}
+
+// NormalizedEmail applies equality check predicate on the "normalized_email" field. It's identical to NormalizedEmailEQ.
+func NormalizedEmail(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldNormalizedEmail, v))
+}
+
+// NormalizedName applies equality check predicate on the "normalized_name" field. It's identical to NormalizedNameEQ.
+func NormalizedName(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldNormalizedName, v))
+}
+
+// MergedIntoID applies equality check predicate on the "merged_into_id" field. It's identical to MergedIntoIDEQ.
+func MergedIntoID(v uuid.UUID) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldMergedIntoID, v))
+}
+
+// MergedAt applies equality check predicate on the "merged_at" field. It's identical to MergedAtEQ.
+func MergedAt(v time.Time) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldMergedAt, v))
+}
+
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
 func Status(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldEQ(FieldStatus, v))
@@ -1387,6 +1422,481 @@ func ResumeFileURLContainsFold(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldContainsFold(FieldResumeFileURL, v))
 }
 
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.Resume {
+	return predicate.Resume(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.Resume {
+	return predicate.Resume(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceIsNil applies the IsNil predicate on the "source" field.
+func SourceIsNil() predicate.Resume {
+	return predicate.Resume(sql.FieldIsNull(FieldSource))
+}
+
+// SourceNotNil applies the NotNil predicate on the "source" field.
+func SourceNotNil() predicate.Resume {
+	return predicate.Resume(sql.FieldNotNull(FieldSource))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldContainsFold(FieldSource, v))
+}
+
+// FileHashEQ applies the EQ predicate on the "file_hash" field.
+func FileHashEQ(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldFileHash, v))
+}
+
+// FileHashNEQ applies the NEQ predicate on the "file_hash" field.
+func FileHashNEQ(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldNEQ(FieldFileHash, v))
+}
+
+// FileHashIn applies the In predicate on the "file_hash" field.
+func FileHashIn(vs ...string) predicate.Resume {
+	return predicate.Resume(sql.FieldIn(FieldFileHash, vs...))
+}
+
+// FileHashNotIn applies the NotIn predicate on the "file_hash" field.
+func FileHashNotIn(vs ...string) predicate.Resume {
+	return predicate.Resume(sql.FieldNotIn(FieldFileHash, vs...))
+}
+
+// FileHashGT applies the GT predicate on the "file_hash" field.
+func FileHashGT(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldGT(FieldFileHash, v))
+}
+
+// FileHashGTE applies the GTE predicate on the "file_hash" field.
+func FileHashGTE(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldGTE(FieldFileHash, v))
+}
+
+// FileHashLT applies the LT predicate on the "file_hash" field.
+func FileHashLT(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldLT(FieldFileHash, v))
+}
+
+// FileHashLTE applies the LTE predicate on the "file_hash" field.
+func FileHashLTE(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldLTE(FieldFileHash, v))
+}
+
+// FileHashContains applies the Contains predicate on the "file_hash" field.
+func FileHashContains(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldContains(FieldFileHash, v))
+}
+
+// FileHashHasPrefix applies the HasPrefix predicate on the "file_hash" field.
+func FileHashHasPrefix(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldHasPrefix(FieldFileHash, v))
+}
+
+// FileHashHasSuffix applies the HasSuffix predicate on the "file_hash" field.
+func FileHashHasSuffix(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldHasSuffix(FieldFileHash, v))
+}
+
+// FileHashIsNil applies the IsNil predicate on the "file_hash" field.
+func FileHashIsNil() predicate.Resume {
+	return predicate.Resume(sql.FieldIsNull(FieldFileHash))
+}
+
+// FileHashNotNil applies the NotNil predicate on the "file_hash" field.
+func FileHashNotNil() predicate.Resume {
+	return predicate.Resume(sql.FieldNotNull(FieldFileHash))
+}
+
+// FileHashEqualFold applies the EqualFold predicate on the "file_hash" field.
+func FileHashEqualFold(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEqualFold(FieldFileHash, v))
+}
+
+// FileHashContainsFold applies the ContainsFold predicate on the "file_hash" field.
+func FileHashContainsFold(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldContainsFold(FieldFileHash, v))
+}
+
+// NormalizedPhoneEQ applies the EQ predicate on the "normalized_phone" field.
+func NormalizedPhoneEQ(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldNormalizedPhone, v))
+}
+
+// NormalizedPhoneNEQ applies the NEQ predicate on the "normalized_phone" field.
+func NormalizedPhoneNEQ(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldNEQ(FieldNormalizedPhone, v))
+}
+
+// NormalizedPhoneIn applies the In predicate on the "normalized_phone" field.
+func NormalizedPhoneIn(vs ...string) predicate.Resume {
+	return predicate.Resume(sql.FieldIn(FieldNormalizedPhone, vs...))
+}
+
+// NormalizedPhoneNotIn applies the NotIn predicate on the "normalized_phone" field.
+func NormalizedPhoneNotIn(vs ...string) predicate.Resume {
+	return predicate.Resume(sql.FieldNotIn(FieldNormalizedPhone, vs...))
+}
+
+// NormalizedPhoneGT applies the GT predicate on the "normalized_phone" field.
+func NormalizedPhoneGT(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldGT(FieldNormalizedPhone, v))
+}
+
+// NormalizedPhoneGTE applies the GTE predicate on the "normalized_phone" field.
+func NormalizedPhoneGTE(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldGTE(FieldNormalizedPhone, v))
+}
+
+// NormalizedPhoneLT applies the LT predicate on the "normalized_phone" field.
+func NormalizedPhoneLT(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldLT(FieldNormalizedPhone, v))
+}
+
+// NormalizedPhoneLTE applies the LTE predicate on the "normalized_phone" field.
+func NormalizedPhoneLTE(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldLTE(FieldNormalizedPhone, v))
+}
+
+// NormalizedPhoneContains applies the Contains predicate on the "normalized_phone" field.
+func NormalizedPhoneContains(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldContains(FieldNormalizedPhone, v))
+}
+
+// NormalizedPhoneHasPrefix applies the HasPrefix predicate on the "normalized_phone" field.
+func NormalizedPhoneHasPrefix(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldHasPrefix(FieldNormalizedPhone, v))
+}
+
+// NormalizedPhoneHasSuffix applies the HasSuffix predicate on the "normalized_phone" field.
+func NormalizedPhoneHasSuffix(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldHasSuffix(FieldNormalizedPhone, v))
+}
+
+// NormalizedPhoneIsNil applies the IsNil predicate on the "normalized_phone" field.
+func NormalizedPhoneIsNil() predicate.Resume {
+	return predicate.Resume(sql.FieldIsNull(FieldNormalizedPhone))
+}
+
+// NormalizedPhoneNotNil applies the NotNil predicate on the "normalized_phone" field.
+func NormalizedPhoneNotNil() predicate.Resume {
+	return predicate.Resume(sql.FieldNotNull(FieldNormalizedPhone))
+}
+
+// NormalizedPhoneEqualFold applies the EqualFold predicate on the "normalized_phone" field.
+func NormalizedPhoneEqualFold(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEqualFold(FieldNormalizedPhone, v))
+}
+
+// NormalizedPhoneContainsFold applies the ContainsFold predicate on the "normalized_phone" field.
+func NormalizedPhoneContainsFold(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldContainsFold(FieldNormalizedPhone, v))
+}
+
+// NormalizedEmailEQ applies the EQ predicate on the "normalized_email" field.
+func NormalizedEmailEQ(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailNEQ applies the NEQ predicate on the "normalized_email" field.
+func NormalizedEmailNEQ(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldNEQ(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailIn applies the In predicate on the "normalized_email" field.
+func NormalizedEmailIn(vs ...string) predicate.Resume {
+	return predicate.Resume(sql.FieldIn(FieldNormalizedEmail, vs...))
+}
+
+// NormalizedEmailNotIn applies the NotIn predicate on the "normalized_email" field.
+func NormalizedEmailNotIn(vs ...string) predicate.Resume {
+	return predicate.Resume(sql.FieldNotIn(FieldNormalizedEmail, vs...))
+}
+
+// NormalizedEmailGT applies the GT predicate on the "normalized_email" field.
+func NormalizedEmailGT(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldGT(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailGTE applies the GTE predicate on the "normalized_email" field.
+func NormalizedEmailGTE(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldGTE(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailLT applies the LT predicate on the "normalized_email" field.
+func NormalizedEmailLT(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldLT(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailLTE applies the LTE predicate on the "normalized_email" field.
+func NormalizedEmailLTE(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldLTE(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailContains applies the Contains predicate on the "normalized_email" field.
+func NormalizedEmailContains(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldContains(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailHasPrefix applies the HasPrefix predicate on the "normalized_email" field.
+func NormalizedEmailHasPrefix(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldHasPrefix(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailHasSuffix applies the HasSuffix predicate on the "normalized_email" field.
+func NormalizedEmailHasSuffix(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldHasSuffix(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailIsNil applies the IsNil predicate on the "normalized_email" field.
+func NormalizedEmailIsNil() predicate.Resume {
+	return predicate.Resume(sql.FieldIsNull(FieldNormalizedEmail))
+}
+
+// NormalizedEmailNotNil applies the NotNil predicate on the "normalized_email" field.
+func NormalizedEmailNotNil() predicate.Resume {
+	return predicate.Resume(sql.FieldNotNull(FieldNormalizedEmail))
+}
+
+// NormalizedEmailEqualFold applies the EqualFold predicate on the "normalized_email" field.
+func NormalizedEmailEqualFold(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEqualFold(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailContainsFold applies the ContainsFold predicate on the "normalized_email" field.
+func NormalizedEmailContainsFold(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldContainsFold(FieldNormalizedEmail, v))
+}
+
+// NormalizedNameEQ applies the EQ predicate on the "normalized_name" field.
+func NormalizedNameEQ(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldNormalizedName, v))
+}
+
+// NormalizedNameNEQ applies the NEQ predicate on the "normalized_name" field.
+func NormalizedNameNEQ(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldNEQ(FieldNormalizedName, v))
+}
+
+// NormalizedNameIn applies the In predicate on the "normalized_name" field.
+func NormalizedNameIn(vs ...string) predicate.Resume {
+	return predicate.Resume(sql.FieldIn(FieldNormalizedName, vs...))
+}
+
+// NormalizedNameNotIn applies the NotIn predicate on the "normalized_name" field.
+func NormalizedNameNotIn(vs ...string) predicate.Resume {
+	return predicate.Resume(sql.FieldNotIn(FieldNormalizedName, vs...))
+}
+
+// NormalizedNameGT applies the GT predicate on the "normalized_name" field.
+func NormalizedNameGT(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldGT(FieldNormalizedName, v))
+}
+
+// NormalizedNameGTE applies the GTE predicate on the "normalized_name" field.
+func NormalizedNameGTE(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldGTE(FieldNormalizedName, v))
+}
+
+// NormalizedNameLT applies the LT predicate on the "normalized_name" field.
+func NormalizedNameLT(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldLT(FieldNormalizedName, v))
+}
+
+// NormalizedNameLTE applies the LTE predicate on the "normalized_name" field.
+func NormalizedNameLTE(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldLTE(FieldNormalizedName, v))
+}
+
+// NormalizedNameContains applies the Contains predicate on the "normalized_name" field.
+func NormalizedNameContains(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldContains(FieldNormalizedName, v))
+}
+
+// NormalizedNameHasPrefix applies the HasPrefix predicate on the "normalized_name" field.
+func NormalizedNameHasPrefix(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldHasPrefix(FieldNormalizedName, v))
+}
+
+// NormalizedNameHasSuffix applies the HasSuffix predicate on the "normalized_name" field.
+func NormalizedNameHasSuffix(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldHasSuffix(FieldNormalizedName, v))
+}
+
+// NormalizedNameIsNil applies the IsNil predicate on the "normalized_name" field.
+func NormalizedNameIsNil() predicate.Resume {
+	return predicate.Resume(sql.FieldIsNull(FieldNormalizedName))
+}
+
+// NormalizedNameNotNil applies the NotNil predicate on the "normalized_name" field.
+func NormalizedNameNotNil() predicate.Resume {
+	return predicate.Resume(sql.FieldNotNull(FieldNormalizedName))
+}
+
+// NormalizedNameEqualFold applies the EqualFold predicate on the "normalized_name" field.
+func NormalizedNameEqualFold(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEqualFold(FieldNormalizedName, v))
+}
+
+// NormalizedNameContainsFold applies the ContainsFold predicate on the "normalized_name" field.
+func NormalizedNameContainsFold(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldContainsFold(FieldNormalizedName, v))
+}
+
+// MergedIntoIDEQ applies the EQ predicate on the "merged_into_id" field.
+func MergedIntoIDEQ(v uuid.UUID) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDNEQ applies the NEQ predicate on the "merged_into_id" field.
+func MergedIntoIDNEQ(v uuid.UUID) predicate.Resume {
+	return predicate.Resume(sql.FieldNEQ(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDIn applies the In predicate on the "merged_into_id" field.
+func MergedIntoIDIn(vs ...uuid.UUID) predicate.Resume {
+	return predicate.Resume(sql.FieldIn(FieldMergedIntoID, vs...))
+}
+
+// MergedIntoIDNotIn applies the NotIn predicate on the "merged_into_id" field.
+func MergedIntoIDNotIn(vs ...uuid.UUID) predicate.Resume {
+	return predicate.Resume(sql.FieldNotIn(FieldMergedIntoID, vs...))
+}
+
+// MergedIntoIDGT applies the GT predicate on the "merged_into_id" field.
+func MergedIntoIDGT(v uuid.UUID) predicate.Resume {
+	return predicate.Resume(sql.FieldGT(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDGTE applies the GTE predicate on the "merged_into_id" field.
+func MergedIntoIDGTE(v uuid.UUID) predicate.Resume {
+	return predicate.Resume(sql.FieldGTE(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDLT applies the LT predicate on the "merged_into_id" field.
+func MergedIntoIDLT(v uuid.UUID) predicate.Resume {
+	return predicate.Resume(sql.FieldLT(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDLTE applies the LTE predicate on the "merged_into_id" field.
+func MergedIntoIDLTE(v uuid.UUID) predicate.Resume {
+	return predicate.Resume(sql.FieldLTE(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDIsNil applies the IsNil predicate on the "merged_into_id" field.
+func MergedIntoIDIsNil() predicate.Resume {
+	return predicate.Resume(sql.FieldIsNull(FieldMergedIntoID))
+}
+
+// MergedIntoIDNotNil applies the NotNil predicate on the "merged_into_id" field.
+func MergedIntoIDNotNil() predicate.Resume {
+	return predicate.Resume(sql.FieldNotNull(FieldMergedIntoID))
+}
+
+// MergedAtEQ applies the EQ predicate on the "merged_at" field.
+func MergedAtEQ(v time.Time) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldMergedAt, v))
+}
+
+// MergedAtNEQ applies the NEQ predicate on the "merged_at" field.
+func MergedAtNEQ(v time.Time) predicate.Resume {
+	return predicate.Resume(sql.FieldNEQ(FieldMergedAt, v))
+}
+
+// MergedAtIn applies the In predicate on the "merged_at" field.
+func MergedAtIn(vs ...time.Time) predicate.Resume {
+	return predicate.Resume(sql.FieldIn(FieldMergedAt, vs...))
+}
+
+// MergedAtNotIn applies the NotIn predicate on the "merged_at" field.
+func MergedAtNotIn(vs ...time.Time) predicate.Resume {
+	return predicate.Resume(sql.FieldNotIn(FieldMergedAt, vs...))
+}
+
+// MergedAtGT applies the GT predicate on the "merged_at" field.
+func MergedAtGT(v time.Time) predicate.Resume {
+	return predicate.Resume(sql.FieldGT(FieldMergedAt, v))
+}
+
+// MergedAtGTE applies the GTE predicate on the "merged_at" field.
+func MergedAtGTE(v time.Time) predicate.Resume {
+	return predicate.Resume(sql.FieldGTE(FieldMergedAt, v))
+}
+
+// MergedAtLT applies the LT predicate on the "merged_at" field.
+func MergedAtLT(v time.Time) predicate.Resume {
+	return predicate.Resume(sql.FieldLT(FieldMergedAt, v))
+}
+
+// MergedAtLTE applies the LTE predicate on the "merged_at" field.
+func MergedAtLTE(v time.Time) predicate.Resume {
+	return predicate.Resume(sql.FieldLTE(FieldMergedAt, v))
+}
+
+// MergedAtIsNil applies the IsNil predicate on the "merged_at" field.
+func MergedAtIsNil() predicate.Resume {
+	return predicate.Resume(sql.FieldIsNull(FieldMergedAt))
+}
+
+// MergedAtNotNil applies the NotNil predicate on the "merged_at" field.
+func MergedAtNotNil() predicate.Resume {
+	return predicate.Resume(sql.FieldNotNull(FieldMergedAt))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldEQ(FieldStatus, v))
@@ -1887,6 +2397,75 @@ func HasScreeningResultsWith(preds ...predicate.ScreeningResult) predicate.Resum
 	})
 }
 
+// HasDuplicateFlags applies the HasEdge predicate on the "duplicate_flags" edge.
+func HasDuplicateFlags() predicate.Resume {
+	return predicate.Resume(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DuplicateFlagsTable, DuplicateFlagsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDuplicateFlagsWith applies the HasEdge predicate on the "duplicate_flags" edge with a given conditions (other predicates).
+func HasDuplicateFlagsWith(preds ...predicate.ResumeDuplicate) predicate.Resume {
+	return predicate.Resume(func(s *sql.Selector) {
+		step := newDuplicateFlagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDuplicatedBy applies the HasEdge predicate on the "duplicated_by" edge.
+func HasDuplicatedBy() predicate.Resume {
+	return predicate.Resume(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DuplicatedByTable, DuplicatedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDuplicatedByWith applies the HasEdge predicate on the "duplicated_by" edge with a given conditions (other predicates).
+func HasDuplicatedByWith(preds ...predicate.ResumeDuplicate) predicate.Resume {
+	return predicate.Resume(func(s *sql.Selector) {
+		step := newDuplicatedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChunks applies the HasEdge predicate on the "chunks" edge.
+func HasChunks() predicate.Resume {
+	return predicate.Resume(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChunksTable, ChunksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChunksWith applies the HasEdge predicate on the "chunks" edge with a given conditions (other predicates).
+func HasChunksWith(preds ...predicate.ResumeChunk) predicate.Resume {
+	return predicate.Resume(func(s *sql.Selector) {
+		step := newChunksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Resume) predicate.Resume {
 	return predicate.Resume(sql.AndPredicates(predicates...))
@@ -14,7 +14,9 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/chaitin/WhaleHire/backend/consts"
 	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumechunk"
 	"github.com/chaitin/WhaleHire/backend/db/resumedocumentparse"
+	"github.com/chaitin/WhaleHire/backend/db/resumeduplicate"
 	"github.com/chaitin/WhaleHire/backend/db/resumeeducation"
 	"github.com/chaitin/WhaleHire/backend/db/resumeexperience"
 	"github.com/chaitin/WhaleHire/backend/db/resumejobapplication"
@@ -279,6 +281,104 @@ func (rc *ResumeCreate) SetNillableResumeFileURL(s *string) *ResumeCreate {
 	return rc
 }
 
+// SetSource sets the "source" field.
+func (rc *ResumeCreate) SetSource(s string) *ResumeCreate {
+	rc.mutation.SetSource(s)
+	return rc
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (rc *ResumeCreate) SetNillableSource(s *string) *ResumeCreate {
+	if s != nil {
+		rc.SetSource(*s)
+	}
+	return rc
+}
+
+// SetFileHash sets the "file_hash" field.
+func (rc *ResumeCreate) SetFileHash(s string) *ResumeCreate {
+	rc.mutation.SetFileHash(s)
+	return rc
+}
+
+// SetNillableFileHash sets the "file_hash" field if the given value is not nil.
+func (rc *ResumeCreate) SetNillableFileHash(s *string) *ResumeCreate {
+	if s != nil {
+		rc.SetFileHash(*s)
+	}
+	return rc
+}
+
+// SetNormalizedPhone sets the "normalized_phone" field.
+func (rc *ResumeCreate) SetNormalizedPhone(s string) *ResumeCreate {
+	rc.mutation.SetNormalizedPhone(s)
+	return rc
+}
+
+// SetNillableNormalizedPhone sets the "normalized_phone" field if the given value is not nil.
+func (rc *ResumeCreate) SetNillableNormalizedPhone(s *string) *ResumeCreate {
+	if s != nil {
+		rc.SetNormalizedPhone(*s)
+	}
+	return rc
+}
+
+// SetNormalizedEmail sets the "normalized_email" field.
+func (rc *ResumeCreate) SetNormalizedEmail(s string) *ResumeCreate {
+	rc.mutation.SetNormalizedEmail(s)
+	return rc
+}
+
+// SetNillableNormalizedEmail sets the "normalized_email" field if the given value is not nil.
+func (rc *ResumeCreate) SetNillableNormalizedEmail(s *string) *ResumeCreate {
+	if s != nil {
+		rc.SetNormalizedEmail(*s)
+	}
+	return rc
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (rc *ResumeCreate) SetNormalizedName(s string) *ResumeCreate {
+	rc.mutation.SetNormalizedName(s)
+	return rc
+}
+
+// SetNillableNormalizedName sets the "normalized_name" field if the given value is not nil.
+func (rc *ResumeCreate) SetNillableNormalizedName(s *string) *ResumeCreate {
+	if s != nil {
+		rc.SetNormalizedName(*s)
+	}
+	return rc
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (rc *ResumeCreate) SetMergedIntoID(u uuid.UUID) *ResumeCreate {
+	rc.mutation.SetMergedIntoID(u)
+	return rc
+}
+
+// SetNillableMergedIntoID sets the "merged_into_id" field if the given value is not nil.
+func (rc *ResumeCreate) SetNillableMergedIntoID(u *uuid.UUID) *ResumeCreate {
+	if u != nil {
+		rc.SetMergedIntoID(*u)
+	}
+	return rc
+}
+
+// SetMergedAt sets the "merged_at" field.
+func (rc *ResumeCreate) SetMergedAt(t time.Time) *ResumeCreate {
+	rc.mutation.SetMergedAt(t)
+	return rc
+}
+
+// SetNillableMergedAt sets the "merged_at" field if the given value is not nil.
+func (rc *ResumeCreate) SetNillableMergedAt(t *time.Time) *ResumeCreate {
+	if t != nil {
+		rc.SetMergedAt(*t)
+	}
+	return rc
+}
+
 // SetStatus sets the "status" field.
 func (rc *ResumeCreate) SetStatus(s string) *ResumeCreate {
 	rc.mutation.SetStatus(s)
@@ -509,6 +609,51 @@ func (rc *ResumeCreate) AddScreeningResults(s ...*ScreeningResult) *ResumeCreate
 	return rc.AddScreeningResultIDs(ids...)
 }
 
+// AddDuplicateFlagIDs adds the "duplicate_flags" edge to the ResumeDuplicate entity by IDs.
+func (rc *ResumeCreate) AddDuplicateFlagIDs(ids ...uuid.UUID) *ResumeCreate {
+	rc.mutation.AddDuplicateFlagIDs(ids...)
+	return rc
+}
+
+// AddDuplicateFlags adds the "duplicate_flags" edges to the ResumeDuplicate entity.
+func (rc *ResumeCreate) AddDuplicateFlags(r ...*ResumeDuplicate) *ResumeCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddDuplicateFlagIDs(ids...)
+}
+
+// AddDuplicatedByIDs adds the "duplicated_by" edge to the ResumeDuplicate entity by IDs.
+func (rc *ResumeCreate) AddDuplicatedByIDs(ids ...uuid.UUID) *ResumeCreate {
+	rc.mutation.AddDuplicatedByIDs(ids...)
+	return rc
+}
+
+// AddDuplicatedBy adds the "duplicated_by" edges to the ResumeDuplicate entity.
+func (rc *ResumeCreate) AddDuplicatedBy(r ...*ResumeDuplicate) *ResumeCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddDuplicatedByIDs(ids...)
+}
+
+// AddChunkIDs adds the "chunks" edge to the ResumeChunk entity by IDs.
+func (rc *ResumeCreate) AddChunkIDs(ids ...uuid.UUID) *ResumeCreate {
+	rc.mutation.AddChunkIDs(ids...)
+	return rc
+}
+
+// AddChunks adds the "chunks" edges to the ResumeChunk entity.
+func (rc *ResumeCreate) AddChunks(r ...*ResumeChunk) *ResumeCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddChunkIDs(ids...)
+}
+
 // Mutation returns the ResumeMutation object of the builder.
 func (rc *ResumeCreate) Mutation() *ResumeMutation {
 	return rc.mutation
@@ -584,6 +729,11 @@ func (rc *ResumeCreate) check() error {
 			return &ValidationError{Name: "highest_education", err: fmt.Errorf(`db: validator failed for field "Resume.highest_education": %w`, err)}
 		}
 	}
+	if v, ok := rc.mutation.FileHash(); ok {
+		if err := resume.FileHashValidator(v); err != nil {
+			return &ValidationError{Name: "file_hash", err: fmt.Errorf(`db: validator failed for field "Resume.file_hash": %w`, err)}
+		}
+	}
 	if _, ok := rc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`db: missing required field "Resume.status"`)}
 	}
@@ -700,6 +850,34 @@ func (rc *ResumeCreate) createSpec() (*Resume, *sqlgraph.CreateSpec) {
 		_spec.SetField(resume.FieldResumeFileURL, field.TypeString, value)
 		_node.ResumeFileURL = value
 	}
+	if value, ok := rc.mutation.Source(); ok {
+		_spec.SetField(resume.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := rc.mutation.FileHash(); ok {
+		_spec.SetField(resume.FieldFileHash, field.TypeString, value)
+		_node.FileHash = value
+	}
+	if value, ok := rc.mutation.NormalizedPhone(); ok {
+		_spec.SetField(resume.FieldNormalizedPhone, field.TypeString, value)
+		_node.NormalizedPhone = value
+	}
+	if value, ok := rc.mutation.NormalizedEmail(); ok {
+		_spec.SetField(resume.FieldNormalizedEmail, field.TypeString, value)
+		_node.NormalizedEmail = value
+	}
+	if value, ok := rc.mutation.NormalizedName(); ok {
+		_spec.SetField(resume.FieldNormalizedName, field.TypeString, value)
+		_node.NormalizedName = value
+	}
+	if value, ok := rc.mutation.MergedIntoID(); ok {
+		_spec.SetField(resume.FieldMergedIntoID, field.TypeUUID, value)
+		_node.MergedIntoID = &value
+	}
+	if value, ok := rc.mutation.MergedAt(); ok {
+		_spec.SetField(resume.FieldMergedAt, field.TypeTime, value)
+		_node.MergedAt = &value
+	}
 	if value, ok := rc.mutation.Status(); ok {
 		_spec.SetField(resume.FieldStatus, field.TypeString, value)
 		_node.Status = value
@@ -881,6 +1059,54 @@ func (rc *ResumeCreate) createSpec() (*Resume, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := rc.mutation.DuplicateFlagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.DuplicateFlagsTable,
+			Columns: []string{resume.DuplicateFlagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.DuplicatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.DuplicatedByTable,
+			Columns: []string{resume.DuplicatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.ChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.ChunksTable,
+			Columns: []string{resume.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumechunk.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -1263,6 +1489,132 @@ func (u *ResumeUpsert) ClearResumeFileURL() *ResumeUpsert {
 	return u
 }
 
+// SetSource sets the "source" field.
+func (u *ResumeUpsert) SetSource(v string) *ResumeUpsert {
+	u.Set(resume.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ResumeUpsert) UpdateSource() *ResumeUpsert {
+	u.SetExcluded(resume.FieldSource)
+	return u
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *ResumeUpsert) ClearSource() *ResumeUpsert {
+	u.SetNull(resume.FieldSource)
+	return u
+}
+
+// SetFileHash sets the "file_hash" field.
+func (u *ResumeUpsert) SetFileHash(v string) *ResumeUpsert {
+	u.Set(resume.FieldFileHash, v)
+	return u
+}
+
+// UpdateFileHash sets the "file_hash" field to the value that was provided on create.
+func (u *ResumeUpsert) UpdateFileHash() *ResumeUpsert {
+	u.SetExcluded(resume.FieldFileHash)
+	return u
+}
+
+// ClearFileHash clears the value of the "file_hash" field.
+func (u *ResumeUpsert) ClearFileHash() *ResumeUpsert {
+	u.SetNull(resume.FieldFileHash)
+	return u
+}
+
+// SetNormalizedPhone sets the "normalized_phone" field.
+func (u *ResumeUpsert) SetNormalizedPhone(v string) *ResumeUpsert {
+	u.Set(resume.FieldNormalizedPhone, v)
+	return u
+}
+
+// UpdateNormalizedPhone sets the "normalized_phone" field to the value that was provided on create.
+func (u *ResumeUpsert) UpdateNormalizedPhone() *ResumeUpsert {
+	u.SetExcluded(resume.FieldNormalizedPhone)
+	return u
+}
+
+// ClearNormalizedPhone clears the value of the "normalized_phone" field.
+func (u *ResumeUpsert) ClearNormalizedPhone() *ResumeUpsert {
+	u.SetNull(resume.FieldNormalizedPhone)
+	return u
+}
+
+// SetNormalizedEmail sets the "normalized_email" field.
+func (u *ResumeUpsert) SetNormalizedEmail(v string) *ResumeUpsert {
+	u.Set(resume.FieldNormalizedEmail, v)
+	return u
+}
+
+// UpdateNormalizedEmail sets the "normalized_email" field to the value that was provided on create.
+func (u *ResumeUpsert) UpdateNormalizedEmail() *ResumeUpsert {
+	u.SetExcluded(resume.FieldNormalizedEmail)
+	return u
+}
+
+// ClearNormalizedEmail clears the value of the "normalized_email" field.
+func (u *ResumeUpsert) ClearNormalizedEmail() *ResumeUpsert {
+	u.SetNull(resume.FieldNormalizedEmail)
+	return u
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (u *ResumeUpsert) SetNormalizedName(v string) *ResumeUpsert {
+	u.Set(resume.FieldNormalizedName, v)
+	return u
+}
+
+// UpdateNormalizedName sets the "normalized_name" field to the value that was provided on create.
+func (u *ResumeUpsert) UpdateNormalizedName() *ResumeUpsert {
+	u.SetExcluded(resume.FieldNormalizedName)
+	return u
+}
+
+// ClearNormalizedName clears the value of the "normalized_name" field.
+func (u *ResumeUpsert) ClearNormalizedName() *ResumeUpsert {
+	u.SetNull(resume.FieldNormalizedName)
+	return u
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (u *ResumeUpsert) SetMergedIntoID(v uuid.UUID) *ResumeUpsert {
+	u.Set(resume.FieldMergedIntoID, v)
+	return u
+}
+
+// UpdateMergedIntoID sets the "merged_into_id" field to the value that was provided on create.
+func (u *ResumeUpsert) UpdateMergedIntoID() *ResumeUpsert {
+	u.SetExcluded(resume.FieldMergedIntoID)
+	return u
+}
+
+// ClearMergedIntoID clears the value of the "merged_into_id" field.
+func (u *ResumeUpsert) ClearMergedIntoID() *ResumeUpsert {
+	u.SetNull(resume.FieldMergedIntoID)
+	return u
+}
+
+// SetMergedAt sets the "merged_at" field.
+func (u *ResumeUpsert) SetMergedAt(v time.Time) *ResumeUpsert {
+	u.Set(resume.FieldMergedAt, v)
+	return u
+}
+
+// UpdateMergedAt sets the "merged_at" field to the value that was provided on create.
+func (u *ResumeUpsert) UpdateMergedAt() *ResumeUpsert {
+	u.SetExcluded(resume.FieldMergedAt)
+	return u
+}
+
+// ClearMergedAt clears the value of the "merged_at" field.
+func (u *ResumeUpsert) ClearMergedAt() *ResumeUpsert {
+	u.SetNull(resume.FieldMergedAt)
+	return u
+}
+
 // SetStatus sets the "status" field.
 func (u *ResumeUpsert) SetStatus(v string) *ResumeUpsert {
 	u.Set(resume.FieldStatus, v)
@@ -1768,6 +2120,153 @@ func (u *ResumeUpsertOne) ClearResumeFileURL() *ResumeUpsertOne {
 	})
 }
 
+// SetSource sets the "source" field.
+func (u *ResumeUpsertOne) SetSource(v string) *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ResumeUpsertOne) UpdateSource() *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *ResumeUpsertOne) ClearSource() *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.ClearSource()
+	})
+}
+
+// SetFileHash sets the "file_hash" field.
+func (u *ResumeUpsertOne) SetFileHash(v string) *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.SetFileHash(v)
+	})
+}
+
+// UpdateFileHash sets the "file_hash" field to the value that was provided on create.
+func (u *ResumeUpsertOne) UpdateFileHash() *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.UpdateFileHash()
+	})
+}
+
+// ClearFileHash clears the value of the "file_hash" field.
+func (u *ResumeUpsertOne) ClearFileHash() *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.ClearFileHash()
+	})
+}
+
+// SetNormalizedPhone sets the "normalized_phone" field.
+func (u *ResumeUpsertOne) SetNormalizedPhone(v string) *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.SetNormalizedPhone(v)
+	})
+}
+
+// UpdateNormalizedPhone sets the "normalized_phone" field to the value that was provided on create.
+func (u *ResumeUpsertOne) UpdateNormalizedPhone() *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.UpdateNormalizedPhone()
+	})
+}
+
+// ClearNormalizedPhone clears the value of the "normalized_phone" field.
+func (u *ResumeUpsertOne) ClearNormalizedPhone() *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.ClearNormalizedPhone()
+	})
+}
+
+// SetNormalizedEmail sets the "normalized_email" field.
+func (u *ResumeUpsertOne) SetNormalizedEmail(v string) *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.SetNormalizedEmail(v)
+	})
+}
+
+// UpdateNormalizedEmail sets the "normalized_email" field to the value that was provided on create.
+func (u *ResumeUpsertOne) UpdateNormalizedEmail() *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.UpdateNormalizedEmail()
+	})
+}
+
+// ClearNormalizedEmail clears the value of the "normalized_email" field.
+func (u *ResumeUpsertOne) ClearNormalizedEmail() *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.ClearNormalizedEmail()
+	})
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (u *ResumeUpsertOne) SetNormalizedName(v string) *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.SetNormalizedName(v)
+	})
+}
+
+// UpdateNormalizedName sets the "normalized_name" field to the value that was provided on create.
+func (u *ResumeUpsertOne) UpdateNormalizedName() *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.UpdateNormalizedName()
+	})
+}
+
+// ClearNormalizedName clears the value of the "normalized_name" field.
+func (u *ResumeUpsertOne) ClearNormalizedName() *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.ClearNormalizedName()
+	})
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (u *ResumeUpsertOne) SetMergedIntoID(v uuid.UUID) *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.SetMergedIntoID(v)
+	})
+}
+
+// UpdateMergedIntoID sets the "merged_into_id" field to the value that was provided on create.
+func (u *ResumeUpsertOne) UpdateMergedIntoID() *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.UpdateMergedIntoID()
+	})
+}
+
+// ClearMergedIntoID clears the value of the "merged_into_id" field.
+func (u *ResumeUpsertOne) ClearMergedIntoID() *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.ClearMergedIntoID()
+	})
+}
+
+// SetMergedAt sets the "merged_at" field.
+func (u *ResumeUpsertOne) SetMergedAt(v time.Time) *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.SetMergedAt(v)
+	})
+}
+
+// UpdateMergedAt sets the "merged_at" field to the value that was provided on create.
+func (u *ResumeUpsertOne) UpdateMergedAt() *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.UpdateMergedAt()
+	})
+}
+
+// ClearMergedAt clears the value of the "merged_at" field.
+func (u *ResumeUpsertOne) ClearMergedAt() *ResumeUpsertOne {
+	return u.Update(func(s *ResumeUpsert) {
+		s.ClearMergedAt()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *ResumeUpsertOne) SetStatus(v string) *ResumeUpsertOne {
 	return u.Update(func(s *ResumeUpsert) {
@@ -2452,6 +2951,153 @@ func (u *ResumeUpsertBulk) ClearResumeFileURL() *ResumeUpsertBulk {
 	})
 }
 
+// SetSource sets the "source" field.
+func (u *ResumeUpsertBulk) SetSource(v string) *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ResumeUpsertBulk) UpdateSource() *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *ResumeUpsertBulk) ClearSource() *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.ClearSource()
+	})
+}
+
+// SetFileHash sets the "file_hash" field.
+func (u *ResumeUpsertBulk) SetFileHash(v string) *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.SetFileHash(v)
+	})
+}
+
+// UpdateFileHash sets the "file_hash" field to the value that was provided on create.
+func (u *ResumeUpsertBulk) UpdateFileHash() *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.UpdateFileHash()
+	})
+}
+
+// ClearFileHash clears the value of the "file_hash" field.
+func (u *ResumeUpsertBulk) ClearFileHash() *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.ClearFileHash()
+	})
+}
+
+// SetNormalizedPhone sets the "normalized_phone" field.
+func (u *ResumeUpsertBulk) SetNormalizedPhone(v string) *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.SetNormalizedPhone(v)
+	})
+}
+
+// UpdateNormalizedPhone sets the "normalized_phone" field to the value that was provided on create.
+func (u *ResumeUpsertBulk) UpdateNormalizedPhone() *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.UpdateNormalizedPhone()
+	})
+}
+
+// ClearNormalizedPhone clears the value of the "normalized_phone" field.
+func (u *ResumeUpsertBulk) ClearNormalizedPhone() *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.ClearNormalizedPhone()
+	})
+}
+
+// SetNormalizedEmail sets the "normalized_email" field.
+func (u *ResumeUpsertBulk) SetNormalizedEmail(v string) *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.SetNormalizedEmail(v)
+	})
+}
+
+// UpdateNormalizedEmail sets the "normalized_email" field to the value that was provided on create.
+func (u *ResumeUpsertBulk) UpdateNormalizedEmail() *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.UpdateNormalizedEmail()
+	})
+}
+
+// ClearNormalizedEmail clears the value of the "normalized_email" field.
+func (u *ResumeUpsertBulk) ClearNormalizedEmail() *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.ClearNormalizedEmail()
+	})
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (u *ResumeUpsertBulk) SetNormalizedName(v string) *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.SetNormalizedName(v)
+	})
+}
+
+// UpdateNormalizedName sets the "normalized_name" field to the value that was provided on create.
+func (u *ResumeUpsertBulk) UpdateNormalizedName() *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.UpdateNormalizedName()
+	})
+}
+
+// ClearNormalizedName clears the value of the "normalized_name" field.
+func (u *ResumeUpsertBulk) ClearNormalizedName() *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.ClearNormalizedName()
+	})
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (u *ResumeUpsertBulk) SetMergedIntoID(v uuid.UUID) *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.SetMergedIntoID(v)
+	})
+}
+
+// UpdateMergedIntoID sets the "merged_into_id" field to the value that was provided on create.
+func (u *ResumeUpsertBulk) UpdateMergedIntoID() *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.UpdateMergedIntoID()
+	})
+}
+
+// ClearMergedIntoID clears the value of the "merged_into_id" field.
+func (u *ResumeUpsertBulk) ClearMergedIntoID() *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.ClearMergedIntoID()
+	})
+}
+
+// SetMergedAt sets the "merged_at" field.
+func (u *ResumeUpsertBulk) SetMergedAt(v time.Time) *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.SetMergedAt(v)
+	})
+}
+
+// UpdateMergedAt sets the "merged_at" field to the value that was provided on create.
+func (u *ResumeUpsertBulk) UpdateMergedAt() *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.UpdateMergedAt()
+	})
+}
+
+// ClearMergedAt clears the value of the "merged_at" field.
+func (u *ResumeUpsertBulk) ClearMergedAt() *ResumeUpsertBulk {
+	return u.Update(func(s *ResumeUpsert) {
+		s.ClearMergedAt()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *ResumeUpsertBulk) SetStatus(v string) *ResumeUpsertBulk {
 	return u.Update(func(s *ResumeUpsert) {
@@ -15,7 +15,9 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/chaitin/WhaleHire/backend/db/predicate"
 	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumechunk"
 	"github.com/chaitin/WhaleHire/backend/db/resumedocumentparse"
+	"github.com/chaitin/WhaleHire/backend/db/resumeduplicate"
 	"github.com/chaitin/WhaleHire/backend/db/resumeeducation"
 	"github.com/chaitin/WhaleHire/backend/db/resumeexperience"
 	"github.com/chaitin/WhaleHire/backend/db/resumejobapplication"
@@ -45,6 +47,9 @@ type ResumeQuery struct {
 	withJobApplications      *ResumeJobApplicationQuery
 	withScreeningTaskResumes *ScreeningTaskResumeQuery
 	withScreeningResults     *ScreeningResultQuery
+	withDuplicateFlags       *ResumeDuplicateQuery
+	withDuplicatedBy         *ResumeDuplicateQuery
+	withChunks               *ResumeChunkQuery
 	modifiers                []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -302,6 +307,72 @@ func (rq *ResumeQuery) QueryScreeningResults() *ScreeningResultQuery {
 	return query
 }
 
+// QueryDuplicateFlags chains the current query on the "duplicate_flags" edge.
+func (rq *ResumeQuery) QueryDuplicateFlags() *ResumeDuplicateQuery {
+	query := (&ResumeDuplicateClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resume.Table, resume.FieldID, selector),
+			sqlgraph.To(resumeduplicate.Table, resumeduplicate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resume.DuplicateFlagsTable, resume.DuplicateFlagsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDuplicatedBy chains the current query on the "duplicated_by" edge.
+func (rq *ResumeQuery) QueryDuplicatedBy() *ResumeDuplicateQuery {
+	query := (&ResumeDuplicateClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resume.Table, resume.FieldID, selector),
+			sqlgraph.To(resumeduplicate.Table, resumeduplicate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resume.DuplicatedByTable, resume.DuplicatedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChunks chains the current query on the "chunks" edge.
+func (rq *ResumeQuery) QueryChunks() *ResumeChunkQuery {
+	query := (&ResumeChunkClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resume.Table, resume.FieldID, selector),
+			sqlgraph.To(resumechunk.Table, resumechunk.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resume.ChunksTable, resume.ChunksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Resume entity from the query.
 // Returns a *NotFoundError when no Resume was found.
 func (rq *ResumeQuery) First(ctx context.Context) (*Resume, error) {
@@ -504,6 +575,9 @@ func (rq *ResumeQuery) Clone() *ResumeQuery {
 		withJobApplications:      rq.withJobApplications.Clone(),
 		withScreeningTaskResumes: rq.withScreeningTaskResumes.Clone(),
 		withScreeningResults:     rq.withScreeningResults.Clone(),
+		withDuplicateFlags:       rq.withDuplicateFlags.Clone(),
+		withDuplicatedBy:         rq.withDuplicatedBy.Clone(),
+		withChunks:               rq.withChunks.Clone(),
 		// clone intermediate query.
 		sql:       rq.sql.Clone(),
 		path:      rq.path,
@@ -621,6 +695,39 @@ func (rq *ResumeQuery) WithScreeningResults(opts ...func(*ScreeningResultQuery))
 	return rq
 }
 
+// WithDuplicateFlags tells the query-builder to eager-load the nodes that are connected to
+// the "duplicate_flags" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *ResumeQuery) WithDuplicateFlags(opts ...func(*ResumeDuplicateQuery)) *ResumeQuery {
+	query := (&ResumeDuplicateClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withDuplicateFlags = query
+	return rq
+}
+
+// WithDuplicatedBy tells the query-builder to eager-load the nodes that are connected to
+// the "duplicated_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *ResumeQuery) WithDuplicatedBy(opts ...func(*ResumeDuplicateQuery)) *ResumeQuery {
+	query := (&ResumeDuplicateClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withDuplicatedBy = query
+	return rq
+}
+
+// WithChunks tells the query-builder to eager-load the nodes that are connected to
+// the "chunks" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *ResumeQuery) WithChunks(opts ...func(*ResumeChunkQuery)) *ResumeQuery {
+	query := (&ResumeChunkClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withChunks = query
+	return rq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -699,7 +806,7 @@ func (rq *ResumeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Resum
 	var (
 		nodes       = []*Resume{}
 		_spec       = rq.querySpec()
-		loadedTypes = [10]bool{
+		loadedTypes = [13]bool{
 			rq.withUser != nil,
 			rq.withEducations != nil,
 			rq.withExperiences != nil,
@@ -710,6 +817,9 @@ func (rq *ResumeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Resum
 			rq.withJobApplications != nil,
 			rq.withScreeningTaskResumes != nil,
 			rq.withScreeningResults != nil,
+			rq.withDuplicateFlags != nil,
+			rq.withDuplicatedBy != nil,
+			rq.withChunks != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -804,6 +914,27 @@ func (rq *ResumeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Resum
 			return nil, err
 		}
 	}
+	if query := rq.withDuplicateFlags; query != nil {
+		if err := rq.loadDuplicateFlags(ctx, query, nodes,
+			func(n *Resume) { n.Edges.DuplicateFlags = []*ResumeDuplicate{} },
+			func(n *Resume, e *ResumeDuplicate) { n.Edges.DuplicateFlags = append(n.Edges.DuplicateFlags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withDuplicatedBy; query != nil {
+		if err := rq.loadDuplicatedBy(ctx, query, nodes,
+			func(n *Resume) { n.Edges.DuplicatedBy = []*ResumeDuplicate{} },
+			func(n *Resume, e *ResumeDuplicate) { n.Edges.DuplicatedBy = append(n.Edges.DuplicatedBy, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withChunks; query != nil {
+		if err := rq.loadChunks(ctx, query, nodes,
+			func(n *Resume) { n.Edges.Chunks = []*ResumeChunk{} },
+			func(n *Resume, e *ResumeChunk) { n.Edges.Chunks = append(n.Edges.Chunks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -1106,6 +1237,96 @@ func (rq *ResumeQuery) loadScreeningResults(ctx context.Context, query *Screenin
 	}
 	return nil
 }
+func (rq *ResumeQuery) loadDuplicateFlags(ctx context.Context, query *ResumeDuplicateQuery, nodes []*Resume, init func(*Resume), assign func(*Resume, *ResumeDuplicate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Resume)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(resumeduplicate.FieldResumeID)
+	}
+	query.Where(predicate.ResumeDuplicate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(resume.DuplicateFlagsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ResumeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "resume_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (rq *ResumeQuery) loadDuplicatedBy(ctx context.Context, query *ResumeDuplicateQuery, nodes []*Resume, init func(*Resume), assign func(*Resume, *ResumeDuplicate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Resume)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(resumeduplicate.FieldDuplicateResumeID)
+	}
+	query.Where(predicate.ResumeDuplicate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(resume.DuplicatedByColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DuplicateResumeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "duplicate_resume_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (rq *ResumeQuery) loadChunks(ctx context.Context, query *ResumeChunkQuery, nodes []*Resume, init func(*Resume), assign func(*Resume, *ResumeChunk)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Resume)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(resumechunk.FieldResumeID)
+	}
+	query.Where(predicate.ResumeChunk(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(resume.ChunksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ResumeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "resume_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (rq *ResumeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := rq.querySpec()
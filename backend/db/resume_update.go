@@ -14,7 +14,9 @@ import (
 	"github.com/chaitin/WhaleHire/backend/consts"
 	"github.com/chaitin/WhaleHire/backend/db/predicate"
 	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumechunk"
 	"github.com/chaitin/WhaleHire/backend/db/resumedocumentparse"
+	"github.com/chaitin/WhaleHire/backend/db/resumeduplicate"
 	"github.com/chaitin/WhaleHire/backend/db/resumeeducation"
 	"github.com/chaitin/WhaleHire/backend/db/resumeexperience"
 	"github.com/chaitin/WhaleHire/backend/db/resumejobapplication"
@@ -409,6 +411,146 @@ func (ru *ResumeUpdate) ClearResumeFileURL() *ResumeUpdate {
 	return ru
 }
 
+// SetSource sets the "source" field.
+func (ru *ResumeUpdate) SetSource(s string) *ResumeUpdate {
+	ru.mutation.SetSource(s)
+	return ru
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (ru *ResumeUpdate) SetNillableSource(s *string) *ResumeUpdate {
+	if s != nil {
+		ru.SetSource(*s)
+	}
+	return ru
+}
+
+// ClearSource clears the value of the "source" field.
+func (ru *ResumeUpdate) ClearSource() *ResumeUpdate {
+	ru.mutation.ClearSource()
+	return ru
+}
+
+// SetFileHash sets the "file_hash" field.
+func (ru *ResumeUpdate) SetFileHash(s string) *ResumeUpdate {
+	ru.mutation.SetFileHash(s)
+	return ru
+}
+
+// SetNillableFileHash sets the "file_hash" field if the given value is not nil.
+func (ru *ResumeUpdate) SetNillableFileHash(s *string) *ResumeUpdate {
+	if s != nil {
+		ru.SetFileHash(*s)
+	}
+	return ru
+}
+
+// ClearFileHash clears the value of the "file_hash" field.
+func (ru *ResumeUpdate) ClearFileHash() *ResumeUpdate {
+	ru.mutation.ClearFileHash()
+	return ru
+}
+
+// SetNormalizedPhone sets the "normalized_phone" field.
+func (ru *ResumeUpdate) SetNormalizedPhone(s string) *ResumeUpdate {
+	ru.mutation.SetNormalizedPhone(s)
+	return ru
+}
+
+// SetNillableNormalizedPhone sets the "normalized_phone" field if the given value is not nil.
+func (ru *ResumeUpdate) SetNillableNormalizedPhone(s *string) *ResumeUpdate {
+	if s != nil {
+		ru.SetNormalizedPhone(*s)
+	}
+	return ru
+}
+
+// ClearNormalizedPhone clears the value of the "normalized_phone" field.
+func (ru *ResumeUpdate) ClearNormalizedPhone() *ResumeUpdate {
+	ru.mutation.ClearNormalizedPhone()
+	return ru
+}
+
+// SetNormalizedEmail sets the "normalized_email" field.
+func (ru *ResumeUpdate) SetNormalizedEmail(s string) *ResumeUpdate {
+	ru.mutation.SetNormalizedEmail(s)
+	return ru
+}
+
+// SetNillableNormalizedEmail sets the "normalized_email" field if the given value is not nil.
+func (ru *ResumeUpdate) SetNillableNormalizedEmail(s *string) *ResumeUpdate {
+	if s != nil {
+		ru.SetNormalizedEmail(*s)
+	}
+	return ru
+}
+
+// ClearNormalizedEmail clears the value of the "normalized_email" field.
+func (ru *ResumeUpdate) ClearNormalizedEmail() *ResumeUpdate {
+	ru.mutation.ClearNormalizedEmail()
+	return ru
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (ru *ResumeUpdate) SetNormalizedName(s string) *ResumeUpdate {
+	ru.mutation.SetNormalizedName(s)
+	return ru
+}
+
+// SetNillableNormalizedName sets the "normalized_name" field if the given value is not nil.
+func (ru *ResumeUpdate) SetNillableNormalizedName(s *string) *ResumeUpdate {
+	if s != nil {
+		ru.SetNormalizedName(*s)
+	}
+	return ru
+}
+
+// ClearNormalizedName clears the value of the "normalized_name" field.
+func (ru *ResumeUpdate) ClearNormalizedName() *ResumeUpdate {
+	ru.mutation.ClearNormalizedName()
+	return ru
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (ru *ResumeUpdate) SetMergedIntoID(u uuid.UUID) *ResumeUpdate {
+	ru.mutation.SetMergedIntoID(u)
+	return ru
+}
+
+// SetNillableMergedIntoID sets the "merged_into_id" field if the given value is not nil.
+func (ru *ResumeUpdate) SetNillableMergedIntoID(u *uuid.UUID) *ResumeUpdate {
+	if u != nil {
+		ru.SetMergedIntoID(*u)
+	}
+	return ru
+}
+
+// ClearMergedIntoID clears the value of the "merged_into_id" field.
+func (ru *ResumeUpdate) ClearMergedIntoID() *ResumeUpdate {
+	ru.mutation.ClearMergedIntoID()
+	return ru
+}
+
+// SetMergedAt sets the "merged_at" field.
+func (ru *ResumeUpdate) SetMergedAt(t time.Time) *ResumeUpdate {
+	ru.mutation.SetMergedAt(t)
+	return ru
+}
+
+// SetNillableMergedAt sets the "merged_at" field if the given value is not nil.
+func (ru *ResumeUpdate) SetNillableMergedAt(t *time.Time) *ResumeUpdate {
+	if t != nil {
+		ru.SetMergedAt(*t)
+	}
+	return ru
+}
+
+// ClearMergedAt clears the value of the "merged_at" field.
+func (ru *ResumeUpdate) ClearMergedAt() *ResumeUpdate {
+	ru.mutation.ClearMergedAt()
+	return ru
+}
+
 // SetStatus sets the "status" field.
 func (ru *ResumeUpdate) SetStatus(s string) *ResumeUpdate {
 	ru.mutation.SetStatus(s)
@@ -629,6 +771,51 @@ func (ru *ResumeUpdate) AddScreeningResults(s ...*ScreeningResult) *ResumeUpdate
 	return ru.AddScreeningResultIDs(ids...)
 }
 
+// AddDuplicateFlagIDs adds the "duplicate_flags" edge to the ResumeDuplicate entity by IDs.
+func (ru *ResumeUpdate) AddDuplicateFlagIDs(ids ...uuid.UUID) *ResumeUpdate {
+	ru.mutation.AddDuplicateFlagIDs(ids...)
+	return ru
+}
+
+// AddDuplicateFlags adds the "duplicate_flags" edges to the ResumeDuplicate entity.
+func (ru *ResumeUpdate) AddDuplicateFlags(r ...*ResumeDuplicate) *ResumeUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddDuplicateFlagIDs(ids...)
+}
+
+// AddDuplicatedByIDs adds the "duplicated_by" edge to the ResumeDuplicate entity by IDs.
+func (ru *ResumeUpdate) AddDuplicatedByIDs(ids ...uuid.UUID) *ResumeUpdate {
+	ru.mutation.AddDuplicatedByIDs(ids...)
+	return ru
+}
+
+// AddDuplicatedBy adds the "duplicated_by" edges to the ResumeDuplicate entity.
+func (ru *ResumeUpdate) AddDuplicatedBy(r ...*ResumeDuplicate) *ResumeUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddDuplicatedByIDs(ids...)
+}
+
+// AddChunkIDs adds the "chunks" edge to the ResumeChunk entity by IDs.
+func (ru *ResumeUpdate) AddChunkIDs(ids ...uuid.UUID) *ResumeUpdate {
+	ru.mutation.AddChunkIDs(ids...)
+	return ru
+}
+
+// AddChunks adds the "chunks" edges to the ResumeChunk entity.
+func (ru *ResumeUpdate) AddChunks(r ...*ResumeChunk) *ResumeUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddChunkIDs(ids...)
+}
+
 // Mutation returns the ResumeMutation object of the builder.
 func (ru *ResumeUpdate) Mutation() *ResumeMutation {
 	return ru.mutation
@@ -829,6 +1016,69 @@ func (ru *ResumeUpdate) RemoveScreeningResults(s ...*ScreeningResult) *ResumeUpd
 	return ru.RemoveScreeningResultIDs(ids...)
 }
 
+// ClearDuplicateFlags clears all "duplicate_flags" edges to the ResumeDuplicate entity.
+func (ru *ResumeUpdate) ClearDuplicateFlags() *ResumeUpdate {
+	ru.mutation.ClearDuplicateFlags()
+	return ru
+}
+
+// RemoveDuplicateFlagIDs removes the "duplicate_flags" edge to ResumeDuplicate entities by IDs.
+func (ru *ResumeUpdate) RemoveDuplicateFlagIDs(ids ...uuid.UUID) *ResumeUpdate {
+	ru.mutation.RemoveDuplicateFlagIDs(ids...)
+	return ru
+}
+
+// RemoveDuplicateFlags removes "duplicate_flags" edges to ResumeDuplicate entities.
+func (ru *ResumeUpdate) RemoveDuplicateFlags(r ...*ResumeDuplicate) *ResumeUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveDuplicateFlagIDs(ids...)
+}
+
+// ClearDuplicatedBy clears all "duplicated_by" edges to the ResumeDuplicate entity.
+func (ru *ResumeUpdate) ClearDuplicatedBy() *ResumeUpdate {
+	ru.mutation.ClearDuplicatedBy()
+	return ru
+}
+
+// RemoveDuplicatedByIDs removes the "duplicated_by" edge to ResumeDuplicate entities by IDs.
+func (ru *ResumeUpdate) RemoveDuplicatedByIDs(ids ...uuid.UUID) *ResumeUpdate {
+	ru.mutation.RemoveDuplicatedByIDs(ids...)
+	return ru
+}
+
+// RemoveDuplicatedBy removes "duplicated_by" edges to ResumeDuplicate entities.
+func (ru *ResumeUpdate) RemoveDuplicatedBy(r ...*ResumeDuplicate) *ResumeUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveDuplicatedByIDs(ids...)
+}
+
+// ClearChunks clears all "chunks" edges to the ResumeChunk entity.
+func (ru *ResumeUpdate) ClearChunks() *ResumeUpdate {
+	ru.mutation.ClearChunks()
+	return ru
+}
+
+// RemoveChunkIDs removes the "chunks" edge to ResumeChunk entities by IDs.
+func (ru *ResumeUpdate) RemoveChunkIDs(ids ...uuid.UUID) *ResumeUpdate {
+	ru.mutation.RemoveChunkIDs(ids...)
+	return ru
+}
+
+// RemoveChunks removes "chunks" edges to ResumeChunk entities.
+func (ru *ResumeUpdate) RemoveChunks(r ...*ResumeChunk) *ResumeUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveChunkIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ru *ResumeUpdate) Save(ctx context.Context) (int, error) {
 	if err := ru.defaults(); err != nil {
@@ -878,6 +1128,11 @@ func (ru *ResumeUpdate) check() error {
 			return &ValidationError{Name: "highest_education", err: fmt.Errorf(`db: validator failed for field "Resume.highest_education": %w`, err)}
 		}
 	}
+	if v, ok := ru.mutation.FileHash(); ok {
+		if err := resume.FileHashValidator(v); err != nil {
+			return &ValidationError{Name: "file_hash", err: fmt.Errorf(`db: validator failed for field "Resume.file_hash": %w`, err)}
+		}
+	}
 	if ru.mutation.UserCleared() && len(ru.mutation.UserIDs()) > 0 {
 		return errors.New(`db: clearing a required unique edge "Resume.user"`)
 	}
@@ -1010,6 +1265,48 @@ func (ru *ResumeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ru.mutation.ResumeFileURLCleared() {
 		_spec.ClearField(resume.FieldResumeFileURL, field.TypeString)
 	}
+	if value, ok := ru.mutation.Source(); ok {
+		_spec.SetField(resume.FieldSource, field.TypeString, value)
+	}
+	if ru.mutation.SourceCleared() {
+		_spec.ClearField(resume.FieldSource, field.TypeString)
+	}
+	if value, ok := ru.mutation.FileHash(); ok {
+		_spec.SetField(resume.FieldFileHash, field.TypeString, value)
+	}
+	if ru.mutation.FileHashCleared() {
+		_spec.ClearField(resume.FieldFileHash, field.TypeString)
+	}
+	if value, ok := ru.mutation.NormalizedPhone(); ok {
+		_spec.SetField(resume.FieldNormalizedPhone, field.TypeString, value)
+	}
+	if ru.mutation.NormalizedPhoneCleared() {
+		_spec.ClearField(resume.FieldNormalizedPhone, field.TypeString)
+	}
+	if value, ok := ru.mutation.NormalizedEmail(); ok {
+		_spec.SetField(resume.FieldNormalizedEmail, field.TypeString, value)
+	}
+	if ru.mutation.NormalizedEmailCleared() {
+		_spec.ClearField(resume.FieldNormalizedEmail, field.TypeString)
+	}
+	if value, ok := ru.mutation.NormalizedName(); ok {
+		_spec.SetField(resume.FieldNormalizedName, field.TypeString, value)
+	}
+	if ru.mutation.NormalizedNameCleared() {
+		_spec.ClearField(resume.FieldNormalizedName, field.TypeString)
+	}
+	if value, ok := ru.mutation.MergedIntoID(); ok {
+		_spec.SetField(resume.FieldMergedIntoID, field.TypeUUID, value)
+	}
+	if ru.mutation.MergedIntoIDCleared() {
+		_spec.ClearField(resume.FieldMergedIntoID, field.TypeUUID)
+	}
+	if value, ok := ru.mutation.MergedAt(); ok {
+		_spec.SetField(resume.FieldMergedAt, field.TypeTime, value)
+	}
+	if ru.mutation.MergedAtCleared() {
+		_spec.ClearField(resume.FieldMergedAt, field.TypeTime)
+	}
 	if value, ok := ru.mutation.Status(); ok {
 		_spec.SetField(resume.FieldStatus, field.TypeString, value)
 	}
@@ -1465,6 +1762,141 @@ func (ru *ResumeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ru.mutation.DuplicateFlagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.DuplicateFlagsTable,
+			Columns: []string{resume.DuplicateFlagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedDuplicateFlagsIDs(); len(nodes) > 0 && !ru.mutation.DuplicateFlagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.DuplicateFlagsTable,
+			Columns: []string{resume.DuplicateFlagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.DuplicateFlagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.DuplicateFlagsTable,
+			Columns: []string{resume.DuplicateFlagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.DuplicatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.DuplicatedByTable,
+			Columns: []string{resume.DuplicatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedDuplicatedByIDs(); len(nodes) > 0 && !ru.mutation.DuplicatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.DuplicatedByTable,
+			Columns: []string{resume.DuplicatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.DuplicatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.DuplicatedByTable,
+			Columns: []string{resume.DuplicatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.ChunksTable,
+			Columns: []string{resume.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumechunk.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedChunksIDs(); len(nodes) > 0 && !ru.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.ChunksTable,
+			Columns: []string{resume.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumechunk.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.ChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.ChunksTable,
+			Columns: []string{resume.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumechunk.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(ru.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -1855,6 +2287,146 @@ func (ruo *ResumeUpdateOne) ClearResumeFileURL() *ResumeUpdateOne {
 	return ruo
 }
 
+// SetSource sets the "source" field.
+func (ruo *ResumeUpdateOne) SetSource(s string) *ResumeUpdateOne {
+	ruo.mutation.SetSource(s)
+	return ruo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (ruo *ResumeUpdateOne) SetNillableSource(s *string) *ResumeUpdateOne {
+	if s != nil {
+		ruo.SetSource(*s)
+	}
+	return ruo
+}
+
+// ClearSource clears the value of the "source" field.
+func (ruo *ResumeUpdateOne) ClearSource() *ResumeUpdateOne {
+	ruo.mutation.ClearSource()
+	return ruo
+}
+
+// SetFileHash sets the "file_hash" field.
+func (ruo *ResumeUpdateOne) SetFileHash(s string) *ResumeUpdateOne {
+	ruo.mutation.SetFileHash(s)
+	return ruo
+}
+
+// SetNillableFileHash sets the "file_hash" field if the given value is not nil.
+func (ruo *ResumeUpdateOne) SetNillableFileHash(s *string) *ResumeUpdateOne {
+	if s != nil {
+		ruo.SetFileHash(*s)
+	}
+	return ruo
+}
+
+// ClearFileHash clears the value of the "file_hash" field.
+func (ruo *ResumeUpdateOne) ClearFileHash() *ResumeUpdateOne {
+	ruo.mutation.ClearFileHash()
+	return ruo
+}
+
+// SetNormalizedPhone sets the "normalized_phone" field.
+func (ruo *ResumeUpdateOne) SetNormalizedPhone(s string) *ResumeUpdateOne {
+	ruo.mutation.SetNormalizedPhone(s)
+	return ruo
+}
+
+// SetNillableNormalizedPhone sets the "normalized_phone" field if the given value is not nil.
+func (ruo *ResumeUpdateOne) SetNillableNormalizedPhone(s *string) *ResumeUpdateOne {
+	if s != nil {
+		ruo.SetNormalizedPhone(*s)
+	}
+	return ruo
+}
+
+// ClearNormalizedPhone clears the value of the "normalized_phone" field.
+func (ruo *ResumeUpdateOne) ClearNormalizedPhone() *ResumeUpdateOne {
+	ruo.mutation.ClearNormalizedPhone()
+	return ruo
+}
+
+// SetNormalizedEmail sets the "normalized_email" field.
+func (ruo *ResumeUpdateOne) SetNormalizedEmail(s string) *ResumeUpdateOne {
+	ruo.mutation.SetNormalizedEmail(s)
+	return ruo
+}
+
+// SetNillableNormalizedEmail sets the "normalized_email" field if the given value is not nil.
+func (ruo *ResumeUpdateOne) SetNillableNormalizedEmail(s *string) *ResumeUpdateOne {
+	if s != nil {
+		ruo.SetNormalizedEmail(*s)
+	}
+	return ruo
+}
+
+// ClearNormalizedEmail clears the value of the "normalized_email" field.
+func (ruo *ResumeUpdateOne) ClearNormalizedEmail() *ResumeUpdateOne {
+	ruo.mutation.ClearNormalizedEmail()
+	return ruo
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (ruo *ResumeUpdateOne) SetNormalizedName(s string) *ResumeUpdateOne {
+	ruo.mutation.SetNormalizedName(s)
+	return ruo
+}
+
+// SetNillableNormalizedName sets the "normalized_name" field if the given value is not nil.
+func (ruo *ResumeUpdateOne) SetNillableNormalizedName(s *string) *ResumeUpdateOne {
+	if s != nil {
+		ruo.SetNormalizedName(*s)
+	}
+	return ruo
+}
+
+// ClearNormalizedName clears the value of the "normalized_name" field.
+func (ruo *ResumeUpdateOne) ClearNormalizedName() *ResumeUpdateOne {
+	ruo.mutation.ClearNormalizedName()
+	return ruo
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (ruo *ResumeUpdateOne) SetMergedIntoID(u uuid.UUID) *ResumeUpdateOne {
+	ruo.mutation.SetMergedIntoID(u)
+	return ruo
+}
+
+// SetNillableMergedIntoID sets the "merged_into_id" field if the given value is not nil.
+func (ruo *ResumeUpdateOne) SetNillableMergedIntoID(u *uuid.UUID) *ResumeUpdateOne {
+	if u != nil {
+		ruo.SetMergedIntoID(*u)
+	}
+	return ruo
+}
+
+// ClearMergedIntoID clears the value of the "merged_into_id" field.
+func (ruo *ResumeUpdateOne) ClearMergedIntoID() *ResumeUpdateOne {
+	ruo.mutation.ClearMergedIntoID()
+	return ruo
+}
+
+// SetMergedAt sets the "merged_at" field.
+func (ruo *ResumeUpdateOne) SetMergedAt(t time.Time) *ResumeUpdateOne {
+	ruo.mutation.SetMergedAt(t)
+	return ruo
+}
+
+// SetNillableMergedAt sets the "merged_at" field if the given value is not nil.
+func (ruo *ResumeUpdateOne) SetNillableMergedAt(t *time.Time) *ResumeUpdateOne {
+	if t != nil {
+		ruo.SetMergedAt(*t)
+	}
+	return ruo
+}
+
+// ClearMergedAt clears the value of the "merged_at" field.
+func (ruo *ResumeUpdateOne) ClearMergedAt() *ResumeUpdateOne {
+	ruo.mutation.ClearMergedAt()
+	return ruo
+}
+
 // SetStatus sets the "status" field.
 func (ruo *ResumeUpdateOne) SetStatus(s string) *ResumeUpdateOne {
 	ruo.mutation.SetStatus(s)
@@ -2075,6 +2647,51 @@ func (ruo *ResumeUpdateOne) AddScreeningResults(s ...*ScreeningResult) *ResumeUp
 	return ruo.AddScreeningResultIDs(ids...)
 }
 
+// AddDuplicateFlagIDs adds the "duplicate_flags" edge to the ResumeDuplicate entity by IDs.
+func (ruo *ResumeUpdateOne) AddDuplicateFlagIDs(ids ...uuid.UUID) *ResumeUpdateOne {
+	ruo.mutation.AddDuplicateFlagIDs(ids...)
+	return ruo
+}
+
+// AddDuplicateFlags adds the "duplicate_flags" edges to the ResumeDuplicate entity.
+func (ruo *ResumeUpdateOne) AddDuplicateFlags(r ...*ResumeDuplicate) *ResumeUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddDuplicateFlagIDs(ids...)
+}
+
+// AddDuplicatedByIDs adds the "duplicated_by" edge to the ResumeDuplicate entity by IDs.
+func (ruo *ResumeUpdateOne) AddDuplicatedByIDs(ids ...uuid.UUID) *ResumeUpdateOne {
+	ruo.mutation.AddDuplicatedByIDs(ids...)
+	return ruo
+}
+
+// AddDuplicatedBy adds the "duplicated_by" edges to the ResumeDuplicate entity.
+func (ruo *ResumeUpdateOne) AddDuplicatedBy(r ...*ResumeDuplicate) *ResumeUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddDuplicatedByIDs(ids...)
+}
+
+// AddChunkIDs adds the "chunks" edge to the ResumeChunk entity by IDs.
+func (ruo *ResumeUpdateOne) AddChunkIDs(ids ...uuid.UUID) *ResumeUpdateOne {
+	ruo.mutation.AddChunkIDs(ids...)
+	return ruo
+}
+
+// AddChunks adds the "chunks" edges to the ResumeChunk entity.
+func (ruo *ResumeUpdateOne) AddChunks(r ...*ResumeChunk) *ResumeUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddChunkIDs(ids...)
+}
+
 // Mutation returns the ResumeMutation object of the builder.
 func (ruo *ResumeUpdateOne) Mutation() *ResumeMutation {
 	return ruo.mutation
@@ -2275,6 +2892,69 @@ func (ruo *ResumeUpdateOne) RemoveScreeningResults(s ...*ScreeningResult) *Resum
 	return ruo.RemoveScreeningResultIDs(ids...)
 }
 
+// ClearDuplicateFlags clears all "duplicate_flags" edges to the ResumeDuplicate entity.
+func (ruo *ResumeUpdateOne) ClearDuplicateFlags() *ResumeUpdateOne {
+	ruo.mutation.ClearDuplicateFlags()
+	return ruo
+}
+
+// RemoveDuplicateFlagIDs removes the "duplicate_flags" edge to ResumeDuplicate entities by IDs.
+func (ruo *ResumeUpdateOne) RemoveDuplicateFlagIDs(ids ...uuid.UUID) *ResumeUpdateOne {
+	ruo.mutation.RemoveDuplicateFlagIDs(ids...)
+	return ruo
+}
+
+// RemoveDuplicateFlags removes "duplicate_flags" edges to ResumeDuplicate entities.
+func (ruo *ResumeUpdateOne) RemoveDuplicateFlags(r ...*ResumeDuplicate) *ResumeUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveDuplicateFlagIDs(ids...)
+}
+
+// ClearDuplicatedBy clears all "duplicated_by" edges to the ResumeDuplicate entity.
+func (ruo *ResumeUpdateOne) ClearDuplicatedBy() *ResumeUpdateOne {
+	ruo.mutation.ClearDuplicatedBy()
+	return ruo
+}
+
+// RemoveDuplicatedByIDs removes the "duplicated_by" edge to ResumeDuplicate entities by IDs.
+func (ruo *ResumeUpdateOne) RemoveDuplicatedByIDs(ids ...uuid.UUID) *ResumeUpdateOne {
+	ruo.mutation.RemoveDuplicatedByIDs(ids...)
+	return ruo
+}
+
+// RemoveDuplicatedBy removes "duplicated_by" edges to ResumeDuplicate entities.
+func (ruo *ResumeUpdateOne) RemoveDuplicatedBy(r ...*ResumeDuplicate) *ResumeUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveDuplicatedByIDs(ids...)
+}
+
+// ClearChunks clears all "chunks" edges to the ResumeChunk entity.
+func (ruo *ResumeUpdateOne) ClearChunks() *ResumeUpdateOne {
+	ruo.mutation.ClearChunks()
+	return ruo
+}
+
+// RemoveChunkIDs removes the "chunks" edge to ResumeChunk entities by IDs.
+func (ruo *ResumeUpdateOne) RemoveChunkIDs(ids ...uuid.UUID) *ResumeUpdateOne {
+	ruo.mutation.RemoveChunkIDs(ids...)
+	return ruo
+}
+
+// RemoveChunks removes "chunks" edges to ResumeChunk entities.
+func (ruo *ResumeUpdateOne) RemoveChunks(r ...*ResumeChunk) *ResumeUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveChunkIDs(ids...)
+}
+
 // Where appends a list predicates to the ResumeUpdate builder.
 func (ruo *ResumeUpdateOne) Where(ps ...predicate.Resume) *ResumeUpdateOne {
 	ruo.mutation.Where(ps...)
@@ -2337,6 +3017,11 @@ func (ruo *ResumeUpdateOne) check() error {
 			return &ValidationError{Name: "highest_education", err: fmt.Errorf(`db: validator failed for field "Resume.highest_education": %w`, err)}
 		}
 	}
+	if v, ok := ruo.mutation.FileHash(); ok {
+		if err := resume.FileHashValidator(v); err != nil {
+			return &ValidationError{Name: "file_hash", err: fmt.Errorf(`db: validator failed for field "Resume.file_hash": %w`, err)}
+		}
+	}
 	if ruo.mutation.UserCleared() && len(ruo.mutation.UserIDs()) > 0 {
 		return errors.New(`db: clearing a required unique edge "Resume.user"`)
 	}
@@ -2486,6 +3171,48 @@ func (ruo *ResumeUpdateOne) sqlSave(ctx context.Context) (_node *Resume, err err
 	if ruo.mutation.ResumeFileURLCleared() {
 		_spec.ClearField(resume.FieldResumeFileURL, field.TypeString)
 	}
+	if value, ok := ruo.mutation.Source(); ok {
+		_spec.SetField(resume.FieldSource, field.TypeString, value)
+	}
+	if ruo.mutation.SourceCleared() {
+		_spec.ClearField(resume.FieldSource, field.TypeString)
+	}
+	if value, ok := ruo.mutation.FileHash(); ok {
+		_spec.SetField(resume.FieldFileHash, field.TypeString, value)
+	}
+	if ruo.mutation.FileHashCleared() {
+		_spec.ClearField(resume.FieldFileHash, field.TypeString)
+	}
+	if value, ok := ruo.mutation.NormalizedPhone(); ok {
+		_spec.SetField(resume.FieldNormalizedPhone, field.TypeString, value)
+	}
+	if ruo.mutation.NormalizedPhoneCleared() {
+		_spec.ClearField(resume.FieldNormalizedPhone, field.TypeString)
+	}
+	if value, ok := ruo.mutation.NormalizedEmail(); ok {
+		_spec.SetField(resume.FieldNormalizedEmail, field.TypeString, value)
+	}
+	if ruo.mutation.NormalizedEmailCleared() {
+		_spec.ClearField(resume.FieldNormalizedEmail, field.TypeString)
+	}
+	if value, ok := ruo.mutation.NormalizedName(); ok {
+		_spec.SetField(resume.FieldNormalizedName, field.TypeString, value)
+	}
+	if ruo.mutation.NormalizedNameCleared() {
+		_spec.ClearField(resume.FieldNormalizedName, field.TypeString)
+	}
+	if value, ok := ruo.mutation.MergedIntoID(); ok {
+		_spec.SetField(resume.FieldMergedIntoID, field.TypeUUID, value)
+	}
+	if ruo.mutation.MergedIntoIDCleared() {
+		_spec.ClearField(resume.FieldMergedIntoID, field.TypeUUID)
+	}
+	if value, ok := ruo.mutation.MergedAt(); ok {
+		_spec.SetField(resume.FieldMergedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.MergedAtCleared() {
+		_spec.ClearField(resume.FieldMergedAt, field.TypeTime)
+	}
 	if value, ok := ruo.mutation.Status(); ok {
 		_spec.SetField(resume.FieldStatus, field.TypeString, value)
 	}
@@ -2941,6 +3668,141 @@ func (ruo *ResumeUpdateOne) sqlSave(ctx context.Context) (_node *Resume, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ruo.mutation.DuplicateFlagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.DuplicateFlagsTable,
+			Columns: []string{resume.DuplicateFlagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedDuplicateFlagsIDs(); len(nodes) > 0 && !ruo.mutation.DuplicateFlagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.DuplicateFlagsTable,
+			Columns: []string{resume.DuplicateFlagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.DuplicateFlagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.DuplicateFlagsTable,
+			Columns: []string{resume.DuplicateFlagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.DuplicatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.DuplicatedByTable,
+			Columns: []string{resume.DuplicatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedDuplicatedByIDs(); len(nodes) > 0 && !ruo.mutation.DuplicatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.DuplicatedByTable,
+			Columns: []string{resume.DuplicatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.DuplicatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.DuplicatedByTable,
+			Columns: []string{resume.DuplicatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.ChunksTable,
+			Columns: []string{resume.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumechunk.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedChunksIDs(); len(nodes) > 0 && !ruo.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.ChunksTable,
+			Columns: []string{resume.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumechunk.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.ChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.ChunksTable,
+			Columns: []string{resume.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumechunk.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(ruo.modifiers...)
 	_node = &Resume{config: ruo.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumechunk"
+	"github.com/google/uuid"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// ResumeChunk is the model entity for the ResumeChunk schema.
+type ResumeChunk struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ResumeID holds the value of the "resume_id" field.
+	ResumeID uuid.UUID `json:"resume_id,omitempty"`
+	// ChunkType holds the value of the "chunk_type" field.
+	ChunkType consts.ResumeChunkType `json:"chunk_type,omitempty"`
+	// SourceID holds the value of the "source_id" field.
+	SourceID *uuid.UUID `json:"source_id,omitempty"`
+	// ChunkIndex holds the value of the "chunk_index" field.
+	ChunkIndex int `json:"chunk_index,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// 向量嵌入（需要在迁移中执行 CREATE EXTENSION IF NOT EXISTS vector;）
+	Vector *pgvector.Vector `json:"vector,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ResumeChunkQuery when eager-loading is set.
+	Edges        ResumeChunkEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ResumeChunkEdges holds the relations/edges for other nodes in the graph.
+type ResumeChunkEdges struct {
+	// Resume holds the value of the resume edge.
+	Resume *Resume `json:"resume,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ResumeOrErr returns the Resume value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResumeChunkEdges) ResumeOrErr() (*Resume, error) {
+	if e.Resume != nil {
+		return e.Resume, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: resume.Label}
+	}
+	return nil, &NotLoadedError{edge: "resume"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ResumeChunk) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case resumechunk.FieldSourceID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case resumechunk.FieldVector:
+			values[i] = new(pgvector.Vector)
+		case resumechunk.FieldChunkIndex:
+			values[i] = new(sql.NullInt64)
+		case resumechunk.FieldChunkType, resumechunk.FieldContent:
+			values[i] = new(sql.NullString)
+		case resumechunk.FieldCreatedAt, resumechunk.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case resumechunk.FieldID, resumechunk.FieldResumeID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ResumeChunk fields.
+func (rc *ResumeChunk) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case resumechunk.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rc.ID = *value
+			}
+		case resumechunk.FieldResumeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field resume_id", values[i])
+			} else if value != nil {
+				rc.ResumeID = *value
+			}
+		case resumechunk.FieldChunkType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field chunk_type", values[i])
+			} else if value.Valid {
+				rc.ChunkType = consts.ResumeChunkType(value.String)
+			}
+		case resumechunk.FieldSourceID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field source_id", values[i])
+			} else if value.Valid {
+				rc.SourceID = new(uuid.UUID)
+				*rc.SourceID = *value.S.(*uuid.UUID)
+			}
+		case resumechunk.FieldChunkIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chunk_index", values[i])
+			} else if value.Valid {
+				rc.ChunkIndex = int(value.Int64)
+			}
+		case resumechunk.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				rc.Content = value.String
+			}
+		case resumechunk.FieldVector:
+			if value, ok := values[i].(*pgvector.Vector); !ok {
+				return fmt.Errorf("unexpected type %T for field vector", values[i])
+			} else if value != nil {
+				rc.Vector = value
+			}
+		case resumechunk.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rc.CreatedAt = value.Time
+			}
+		case resumechunk.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rc.UpdatedAt = value.Time
+			}
+		default:
+			rc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ResumeChunk.
+// This includes values selected through modifiers, order, etc.
+func (rc *ResumeChunk) Value(name string) (ent.Value, error) {
+	return rc.selectValues.Get(name)
+}
+
+// QueryResume queries the "resume" edge of the ResumeChunk entity.
+func (rc *ResumeChunk) QueryResume() *ResumeQuery {
+	return NewResumeChunkClient(rc.config).QueryResume(rc)
+}
+
+// Update returns a builder for updating this ResumeChunk.
+// Note that you need to call ResumeChunk.Unwrap() before calling this method if this ResumeChunk
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rc *ResumeChunk) Update() *ResumeChunkUpdateOne {
+	return NewResumeChunkClient(rc.config).UpdateOne(rc)
+}
+
+// Unwrap unwraps the ResumeChunk entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rc *ResumeChunk) Unwrap() *ResumeChunk {
+	_tx, ok := rc.config.driver.(*txDriver)
+	if !ok {
+		panic("db: ResumeChunk is not a transactional entity")
+	}
+	rc.config.driver = _tx.drv
+	return rc
+}
+
+// String implements the fmt.Stringer.
+func (rc *ResumeChunk) String() string {
+	var builder strings.Builder
+	builder.WriteString("ResumeChunk(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rc.ID))
+	builder.WriteString("resume_id=")
+	builder.WriteString(fmt.Sprintf("%v", rc.ResumeID))
+	builder.WriteString(", ")
+	builder.WriteString("chunk_type=")
+	builder.WriteString(fmt.Sprintf("%v", rc.ChunkType))
+	builder.WriteString(", ")
+	if v := rc.SourceID; v != nil {
+		builder.WriteString("source_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("chunk_index=")
+	builder.WriteString(fmt.Sprintf("%v", rc.ChunkIndex))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(rc.Content)
+	builder.WriteString(", ")
+	builder.WriteString("vector=")
+	builder.WriteString(fmt.Sprintf("%v", rc.Vector))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ResumeChunks is a parsable slice of ResumeChunk.
+type ResumeChunks []*ResumeChunk
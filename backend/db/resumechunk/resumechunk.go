@@ -0,0 +1,143 @@
+// Code generated by ent, DO NOT EDIT.
+
+package resumechunk
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the resumechunk type in the database.
+	Label = "resume_chunk"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldResumeID holds the string denoting the resume_id field in the database.
+	FieldResumeID = "resume_id"
+	// FieldChunkType holds the string denoting the chunk_type field in the database.
+	FieldChunkType = "chunk_type"
+	// FieldSourceID holds the string denoting the source_id field in the database.
+	FieldSourceID = "source_id"
+	// FieldChunkIndex holds the string denoting the chunk_index field in the database.
+	FieldChunkIndex = "chunk_index"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldVector holds the string denoting the vector field in the database.
+	FieldVector = "vector"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeResume holds the string denoting the resume edge name in mutations.
+	EdgeResume = "resume"
+	// Table holds the table name of the resumechunk in the database.
+	Table = "resume_chunks"
+	// ResumeTable is the table that holds the resume relation/edge.
+	ResumeTable = "resume_chunks"
+	// ResumeInverseTable is the table name for the Resume entity.
+	// It exists in this package in order to avoid circular dependency with the "resume" package.
+	ResumeInverseTable = "resumes"
+	// ResumeColumn is the table column denoting the resume relation/edge.
+	ResumeColumn = "resume_id"
+)
+
+// Columns holds all SQL columns for resumechunk fields.
+var Columns = []string{
+	FieldID,
+	FieldResumeID,
+	FieldChunkType,
+	FieldSourceID,
+	FieldChunkIndex,
+	FieldContent,
+	FieldVector,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultChunkIndex holds the default value on creation for the "chunk_index" field.
+	DefaultChunkIndex int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ResumeChunk queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByResumeID orders the results by the resume_id field.
+func ByResumeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResumeID, opts...).ToFunc()
+}
+
+// ByChunkType orders the results by the chunk_type field.
+func ByChunkType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChunkType, opts...).ToFunc()
+}
+
+// BySourceID orders the results by the source_id field.
+func BySourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceID, opts...).ToFunc()
+}
+
+// ByChunkIndex orders the results by the chunk_index field.
+func ByChunkIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChunkIndex, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByVector orders the results by the vector field.
+func ByVector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVector, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByResumeField orders the results by resume field.
+func ByResumeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newResumeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newResumeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ResumeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ResumeTable, ResumeColumn),
+	)
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package resumechunk
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db/predicate"
+	"github.com/google/uuid"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldLTE(FieldID, id))
+}
+
+// ResumeID applies equality check predicate on the "resume_id" field. It's identical to ResumeIDEQ.
+func ResumeID(v uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldEQ(FieldResumeID, v))
+}
+
+// ChunkType applies equality check predicate on the "chunk_type" field. It's identical to ChunkTypeEQ.
+func ChunkType(v consts.ResumeChunkType) predicate.ResumeChunk {
+	vc := string(v)
+	return predicate.ResumeChunk(sql.FieldEQ(FieldChunkType, vc))
+}
+
+// SourceID applies equality check predicate on the "source_id" field. It's identical to SourceIDEQ.
+func SourceID(v uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldEQ(FieldSourceID, v))
+}
+
+// ChunkIndex applies equality check predicate on the "chunk_index" field. It's identical to ChunkIndexEQ.
+func ChunkIndex(v int) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldEQ(FieldChunkIndex, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldEQ(FieldContent, v))
+}
+
+// Vector applies equality check predicate on the "vector" field. It's identical to VectorEQ.
+func Vector(v *pgvector.Vector) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldEQ(FieldVector, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ResumeIDEQ applies the EQ predicate on the "resume_id" field.
+func ResumeIDEQ(v uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldEQ(FieldResumeID, v))
+}
+
+// ResumeIDNEQ applies the NEQ predicate on the "resume_id" field.
+func ResumeIDNEQ(v uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNEQ(FieldResumeID, v))
+}
+
+// ResumeIDIn applies the In predicate on the "resume_id" field.
+func ResumeIDIn(vs ...uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldIn(FieldResumeID, vs...))
+}
+
+// ResumeIDNotIn applies the NotIn predicate on the "resume_id" field.
+func ResumeIDNotIn(vs ...uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNotIn(FieldResumeID, vs...))
+}
+
+// ChunkTypeEQ applies the EQ predicate on the "chunk_type" field.
+func ChunkTypeEQ(v consts.ResumeChunkType) predicate.ResumeChunk {
+	vc := string(v)
+	return predicate.ResumeChunk(sql.FieldEQ(FieldChunkType, vc))
+}
+
+// ChunkTypeNEQ applies the NEQ predicate on the "chunk_type" field.
+func ChunkTypeNEQ(v consts.ResumeChunkType) predicate.ResumeChunk {
+	vc := string(v)
+	return predicate.ResumeChunk(sql.FieldNEQ(FieldChunkType, vc))
+}
+
+// ChunkTypeIn applies the In predicate on the "chunk_type" field.
+func ChunkTypeIn(vs ...consts.ResumeChunkType) predicate.ResumeChunk {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.ResumeChunk(sql.FieldIn(FieldChunkType, v...))
+}
+
+// ChunkTypeNotIn applies the NotIn predicate on the "chunk_type" field.
+func ChunkTypeNotIn(vs ...consts.ResumeChunkType) predicate.ResumeChunk {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.ResumeChunk(sql.FieldNotIn(FieldChunkType, v...))
+}
+
+// ChunkTypeGT applies the GT predicate on the "chunk_type" field.
+func ChunkTypeGT(v consts.ResumeChunkType) predicate.ResumeChunk {
+	vc := string(v)
+	return predicate.ResumeChunk(sql.FieldGT(FieldChunkType, vc))
+}
+
+// ChunkTypeGTE applies the GTE predicate on the "chunk_type" field.
+func ChunkTypeGTE(v consts.ResumeChunkType) predicate.ResumeChunk {
+	vc := string(v)
+	return predicate.ResumeChunk(sql.FieldGTE(FieldChunkType, vc))
+}
+
+// ChunkTypeLT applies the LT predicate on the "chunk_type" field.
+func ChunkTypeLT(v consts.ResumeChunkType) predicate.ResumeChunk {
+	vc := string(v)
+	return predicate.ResumeChunk(sql.FieldLT(FieldChunkType, vc))
+}
+
+// ChunkTypeLTE applies the LTE predicate on the "chunk_type" field.
+func ChunkTypeLTE(v consts.ResumeChunkType) predicate.ResumeChunk {
+	vc := string(v)
+	return predicate.ResumeChunk(sql.FieldLTE(FieldChunkType, vc))
+}
+
+// ChunkTypeContains applies the Contains predicate on the "chunk_type" field.
+func ChunkTypeContains(v consts.ResumeChunkType) predicate.ResumeChunk {
+	vc := string(v)
+	return predicate.ResumeChunk(sql.FieldContains(FieldChunkType, vc))
+}
+
+// ChunkTypeHasPrefix applies the HasPrefix predicate on the "chunk_type" field.
+func ChunkTypeHasPrefix(v consts.ResumeChunkType) predicate.ResumeChunk {
+	vc := string(v)
+	return predicate.ResumeChunk(sql.FieldHasPrefix(FieldChunkType, vc))
+}
+
+// ChunkTypeHasSuffix applies the HasSuffix predicate on the "chunk_type" field.
+func ChunkTypeHasSuffix(v consts.ResumeChunkType) predicate.ResumeChunk {
+	vc := string(v)
+	return predicate.ResumeChunk(sql.FieldHasSuffix(FieldChunkType, vc))
+}
+
+// ChunkTypeEqualFold applies the EqualFold predicate on the "chunk_type" field.
+func ChunkTypeEqualFold(v consts.ResumeChunkType) predicate.ResumeChunk {
+	vc := string(v)
+	return predicate.ResumeChunk(sql.FieldEqualFold(FieldChunkType, vc))
+}
+
+// ChunkTypeContainsFold applies the ContainsFold predicate on the "chunk_type" field.
+func ChunkTypeContainsFold(v consts.ResumeChunkType) predicate.ResumeChunk {
+	vc := string(v)
+	return predicate.ResumeChunk(sql.FieldContainsFold(FieldChunkType, vc))
+}
+
+// SourceIDEQ applies the EQ predicate on the "source_id" field.
+func SourceIDEQ(v uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldEQ(FieldSourceID, v))
+}
+
+// SourceIDNEQ applies the NEQ predicate on the "source_id" field.
+func SourceIDNEQ(v uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNEQ(FieldSourceID, v))
+}
+
+// SourceIDIn applies the In predicate on the "source_id" field.
+func SourceIDIn(vs ...uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldIn(FieldSourceID, vs...))
+}
+
+// SourceIDNotIn applies the NotIn predicate on the "source_id" field.
+func SourceIDNotIn(vs ...uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNotIn(FieldSourceID, vs...))
+}
+
+// SourceIDGT applies the GT predicate on the "source_id" field.
+func SourceIDGT(v uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldGT(FieldSourceID, v))
+}
+
+// SourceIDGTE applies the GTE predicate on the "source_id" field.
+func SourceIDGTE(v uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldGTE(FieldSourceID, v))
+}
+
+// SourceIDLT applies the LT predicate on the "source_id" field.
+func SourceIDLT(v uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldLT(FieldSourceID, v))
+}
+
+// SourceIDLTE applies the LTE predicate on the "source_id" field.
+func SourceIDLTE(v uuid.UUID) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldLTE(FieldSourceID, v))
+}
+
+// SourceIDIsNil applies the IsNil predicate on the "source_id" field.
+func SourceIDIsNil() predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldIsNull(FieldSourceID))
+}
+
+// SourceIDNotNil applies the NotNil predicate on the "source_id" field.
+func SourceIDNotNil() predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNotNull(FieldSourceID))
+}
+
+// ChunkIndexEQ applies the EQ predicate on the "chunk_index" field.
+func ChunkIndexEQ(v int) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldEQ(FieldChunkIndex, v))
+}
+
+// ChunkIndexNEQ applies the NEQ predicate on the "chunk_index" field.
+func ChunkIndexNEQ(v int) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNEQ(FieldChunkIndex, v))
+}
+
+// ChunkIndexIn applies the In predicate on the "chunk_index" field.
+func ChunkIndexIn(vs ...int) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldIn(FieldChunkIndex, vs...))
+}
+
+// ChunkIndexNotIn applies the NotIn predicate on the "chunk_index" field.
+func ChunkIndexNotIn(vs ...int) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNotIn(FieldChunkIndex, vs...))
+}
+
+// ChunkIndexGT applies the GT predicate on the "chunk_index" field.
+func ChunkIndexGT(v int) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldGT(FieldChunkIndex, v))
+}
+
+// ChunkIndexGTE applies the GTE predicate on the "chunk_index" field.
+func ChunkIndexGTE(v int) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldGTE(FieldChunkIndex, v))
+}
+
+// ChunkIndexLT applies the LT predicate on the "chunk_index" field.
+func ChunkIndexLT(v int) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldLT(FieldChunkIndex, v))
+}
+
+// ChunkIndexLTE applies the LTE predicate on the "chunk_index" field.
+func ChunkIndexLTE(v int) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldLTE(FieldChunkIndex, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldContainsFold(FieldContent, v))
+}
+
+// VectorEQ applies the EQ predicate on the "vector" field.
+func VectorEQ(v *pgvector.Vector) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldEQ(FieldVector, v))
+}
+
+// VectorNEQ applies the NEQ predicate on the "vector" field.
+func VectorNEQ(v *pgvector.Vector) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNEQ(FieldVector, v))
+}
+
+// VectorIn applies the In predicate on the "vector" field.
+func VectorIn(vs ...*pgvector.Vector) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldIn(FieldVector, vs...))
+}
+
+// VectorNotIn applies the NotIn predicate on the "vector" field.
+func VectorNotIn(vs ...*pgvector.Vector) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNotIn(FieldVector, vs...))
+}
+
+// VectorGT applies the GT predicate on the "vector" field.
+func VectorGT(v *pgvector.Vector) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldGT(FieldVector, v))
+}
+
+// VectorGTE applies the GTE predicate on the "vector" field.
+func VectorGTE(v *pgvector.Vector) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldGTE(FieldVector, v))
+}
+
+// VectorLT applies the LT predicate on the "vector" field.
+func VectorLT(v *pgvector.Vector) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldLT(FieldVector, v))
+}
+
+// VectorLTE applies the LTE predicate on the "vector" field.
+func VectorLTE(v *pgvector.Vector) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldLTE(FieldVector, v))
+}
+
+// VectorIsNil applies the IsNil predicate on the "vector" field.
+func VectorIsNil() predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldIsNull(FieldVector))
+}
+
+// VectorNotNil applies the NotNil predicate on the "vector" field.
+func VectorNotNil() predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNotNull(FieldVector))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasResume applies the HasEdge predicate on the "resume" edge.
+func HasResume() predicate.ResumeChunk {
+	return predicate.ResumeChunk(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ResumeTable, ResumeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResumeWith applies the HasEdge predicate on the "resume" edge with a given conditions (other predicates).
+func HasResumeWith(preds ...predicate.Resume) predicate.ResumeChunk {
+	return predicate.ResumeChunk(func(s *sql.Selector) {
+		step := newResumeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ResumeChunk) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ResumeChunk) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ResumeChunk) predicate.ResumeChunk {
+	return predicate.ResumeChunk(sql.NotPredicates(p))
+}
@@ -0,0 +1,1002 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumechunk"
+	"github.com/google/uuid"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// ResumeChunkCreate is the builder for creating a ResumeChunk entity.
+type ResumeChunkCreate struct {
+	config
+	mutation *ResumeChunkMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetResumeID sets the "resume_id" field.
+func (rcc *ResumeChunkCreate) SetResumeID(u uuid.UUID) *ResumeChunkCreate {
+	rcc.mutation.SetResumeID(u)
+	return rcc
+}
+
+// SetChunkType sets the "chunk_type" field.
+func (rcc *ResumeChunkCreate) SetChunkType(cct consts.ResumeChunkType) *ResumeChunkCreate {
+	rcc.mutation.SetChunkType(cct)
+	return rcc
+}
+
+// SetSourceID sets the "source_id" field.
+func (rcc *ResumeChunkCreate) SetSourceID(u uuid.UUID) *ResumeChunkCreate {
+	rcc.mutation.SetSourceID(u)
+	return rcc
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (rcc *ResumeChunkCreate) SetNillableSourceID(u *uuid.UUID) *ResumeChunkCreate {
+	if u != nil {
+		rcc.SetSourceID(*u)
+	}
+	return rcc
+}
+
+// SetChunkIndex sets the "chunk_index" field.
+func (rcc *ResumeChunkCreate) SetChunkIndex(i int) *ResumeChunkCreate {
+	rcc.mutation.SetChunkIndex(i)
+	return rcc
+}
+
+// SetNillableChunkIndex sets the "chunk_index" field if the given value is not nil.
+func (rcc *ResumeChunkCreate) SetNillableChunkIndex(i *int) *ResumeChunkCreate {
+	if i != nil {
+		rcc.SetChunkIndex(*i)
+	}
+	return rcc
+}
+
+// SetContent sets the "content" field.
+func (rcc *ResumeChunkCreate) SetContent(s string) *ResumeChunkCreate {
+	rcc.mutation.SetContent(s)
+	return rcc
+}
+
+// SetVector sets the "vector" field.
+func (rcc *ResumeChunkCreate) SetVector(pg *pgvector.Vector) *ResumeChunkCreate {
+	rcc.mutation.SetVector(pg)
+	return rcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rcc *ResumeChunkCreate) SetCreatedAt(t time.Time) *ResumeChunkCreate {
+	rcc.mutation.SetCreatedAt(t)
+	return rcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rcc *ResumeChunkCreate) SetNillableCreatedAt(t *time.Time) *ResumeChunkCreate {
+	if t != nil {
+		rcc.SetCreatedAt(*t)
+	}
+	return rcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcc *ResumeChunkCreate) SetUpdatedAt(t time.Time) *ResumeChunkCreate {
+	rcc.mutation.SetUpdatedAt(t)
+	return rcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rcc *ResumeChunkCreate) SetNillableUpdatedAt(t *time.Time) *ResumeChunkCreate {
+	if t != nil {
+		rcc.SetUpdatedAt(*t)
+	}
+	return rcc
+}
+
+// SetID sets the "id" field.
+func (rcc *ResumeChunkCreate) SetID(u uuid.UUID) *ResumeChunkCreate {
+	rcc.mutation.SetID(u)
+	return rcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rcc *ResumeChunkCreate) SetNillableID(u *uuid.UUID) *ResumeChunkCreate {
+	if u != nil {
+		rcc.SetID(*u)
+	}
+	return rcc
+}
+
+// SetResume sets the "resume" edge to the Resume entity.
+func (rcc *ResumeChunkCreate) SetResume(r *Resume) *ResumeChunkCreate {
+	return rcc.SetResumeID(r.ID)
+}
+
+// Mutation returns the ResumeChunkMutation object of the builder.
+func (rcc *ResumeChunkCreate) Mutation() *ResumeChunkMutation {
+	return rcc.mutation
+}
+
+// Save creates the ResumeChunk in the database.
+func (rcc *ResumeChunkCreate) Save(ctx context.Context) (*ResumeChunk, error) {
+	rcc.defaults()
+	return withHooks(ctx, rcc.sqlSave, rcc.mutation, rcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rcc *ResumeChunkCreate) SaveX(ctx context.Context) *ResumeChunk {
+	v, err := rcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcc *ResumeChunkCreate) Exec(ctx context.Context) error {
+	_, err := rcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcc *ResumeChunkCreate) ExecX(ctx context.Context) {
+	if err := rcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcc *ResumeChunkCreate) defaults() {
+	if _, ok := rcc.mutation.ChunkIndex(); !ok {
+		v := resumechunk.DefaultChunkIndex
+		rcc.mutation.SetChunkIndex(v)
+	}
+	if _, ok := rcc.mutation.CreatedAt(); !ok {
+		v := resumechunk.DefaultCreatedAt()
+		rcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rcc.mutation.UpdatedAt(); !ok {
+		v := resumechunk.DefaultUpdatedAt()
+		rcc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rcc.mutation.ID(); !ok {
+		v := resumechunk.DefaultID()
+		rcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcc *ResumeChunkCreate) check() error {
+	if _, ok := rcc.mutation.ResumeID(); !ok {
+		return &ValidationError{Name: "resume_id", err: errors.New(`db: missing required field "ResumeChunk.resume_id"`)}
+	}
+	if _, ok := rcc.mutation.ChunkType(); !ok {
+		return &ValidationError{Name: "chunk_type", err: errors.New(`db: missing required field "ResumeChunk.chunk_type"`)}
+	}
+	if _, ok := rcc.mutation.ChunkIndex(); !ok {
+		return &ValidationError{Name: "chunk_index", err: errors.New(`db: missing required field "ResumeChunk.chunk_index"`)}
+	}
+	if _, ok := rcc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`db: missing required field "ResumeChunk.content"`)}
+	}
+	if _, ok := rcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "ResumeChunk.created_at"`)}
+	}
+	if _, ok := rcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "ResumeChunk.updated_at"`)}
+	}
+	if len(rcc.mutation.ResumeIDs()) == 0 {
+		return &ValidationError{Name: "resume", err: errors.New(`db: missing required edge "ResumeChunk.resume"`)}
+	}
+	return nil
+}
+
+func (rcc *ResumeChunkCreate) sqlSave(ctx context.Context) (*ResumeChunk, error) {
+	if err := rcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rcc.mutation.id = &_node.ID
+	rcc.mutation.done = true
+	return _node, nil
+}
+
+func (rcc *ResumeChunkCreate) createSpec() (*ResumeChunk, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ResumeChunk{config: rcc.config}
+		_spec = sqlgraph.NewCreateSpec(resumechunk.Table, sqlgraph.NewFieldSpec(resumechunk.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = rcc.conflict
+	if id, ok := rcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rcc.mutation.ChunkType(); ok {
+		_spec.SetField(resumechunk.FieldChunkType, field.TypeString, value)
+		_node.ChunkType = value
+	}
+	if value, ok := rcc.mutation.SourceID(); ok {
+		_spec.SetField(resumechunk.FieldSourceID, field.TypeUUID, value)
+		_node.SourceID = &value
+	}
+	if value, ok := rcc.mutation.ChunkIndex(); ok {
+		_spec.SetField(resumechunk.FieldChunkIndex, field.TypeInt, value)
+		_node.ChunkIndex = value
+	}
+	if value, ok := rcc.mutation.Content(); ok {
+		_spec.SetField(resumechunk.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := rcc.mutation.Vector(); ok {
+		_spec.SetField(resumechunk.FieldVector, field.TypeOther, value)
+		_node.Vector = value
+	}
+	if value, ok := rcc.mutation.CreatedAt(); ok {
+		_spec.SetField(resumechunk.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(resumechunk.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := rcc.mutation.ResumeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumechunk.ResumeTable,
+			Columns: []string{resumechunk.ResumeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resume.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ResumeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResumeChunk.Create().
+//		SetResumeID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResumeChunkUpsert) {
+//			SetResumeID(v+v).
+//		}).
+//		Exec(ctx)
+func (rcc *ResumeChunkCreate) OnConflict(opts ...sql.ConflictOption) *ResumeChunkUpsertOne {
+	rcc.conflict = opts
+	return &ResumeChunkUpsertOne{
+		create: rcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResumeChunk.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcc *ResumeChunkCreate) OnConflictColumns(columns ...string) *ResumeChunkUpsertOne {
+	rcc.conflict = append(rcc.conflict, sql.ConflictColumns(columns...))
+	return &ResumeChunkUpsertOne{
+		create: rcc,
+	}
+}
+
+type (
+	// ResumeChunkUpsertOne is the builder for "upsert"-ing
+	//  one ResumeChunk node.
+	ResumeChunkUpsertOne struct {
+		create *ResumeChunkCreate
+	}
+
+	// ResumeChunkUpsert is the "OnConflict" setter.
+	ResumeChunkUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetResumeID sets the "resume_id" field.
+func (u *ResumeChunkUpsert) SetResumeID(v uuid.UUID) *ResumeChunkUpsert {
+	u.Set(resumechunk.FieldResumeID, v)
+	return u
+}
+
+// UpdateResumeID sets the "resume_id" field to the value that was provided on create.
+func (u *ResumeChunkUpsert) UpdateResumeID() *ResumeChunkUpsert {
+	u.SetExcluded(resumechunk.FieldResumeID)
+	return u
+}
+
+// SetChunkType sets the "chunk_type" field.
+func (u *ResumeChunkUpsert) SetChunkType(v consts.ResumeChunkType) *ResumeChunkUpsert {
+	u.Set(resumechunk.FieldChunkType, v)
+	return u
+}
+
+// UpdateChunkType sets the "chunk_type" field to the value that was provided on create.
+func (u *ResumeChunkUpsert) UpdateChunkType() *ResumeChunkUpsert {
+	u.SetExcluded(resumechunk.FieldChunkType)
+	return u
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *ResumeChunkUpsert) SetSourceID(v uuid.UUID) *ResumeChunkUpsert {
+	u.Set(resumechunk.FieldSourceID, v)
+	return u
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *ResumeChunkUpsert) UpdateSourceID() *ResumeChunkUpsert {
+	u.SetExcluded(resumechunk.FieldSourceID)
+	return u
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *ResumeChunkUpsert) ClearSourceID() *ResumeChunkUpsert {
+	u.SetNull(resumechunk.FieldSourceID)
+	return u
+}
+
+// SetChunkIndex sets the "chunk_index" field.
+func (u *ResumeChunkUpsert) SetChunkIndex(v int) *ResumeChunkUpsert {
+	u.Set(resumechunk.FieldChunkIndex, v)
+	return u
+}
+
+// UpdateChunkIndex sets the "chunk_index" field to the value that was provided on create.
+func (u *ResumeChunkUpsert) UpdateChunkIndex() *ResumeChunkUpsert {
+	u.SetExcluded(resumechunk.FieldChunkIndex)
+	return u
+}
+
+// AddChunkIndex adds v to the "chunk_index" field.
+func (u *ResumeChunkUpsert) AddChunkIndex(v int) *ResumeChunkUpsert {
+	u.Add(resumechunk.FieldChunkIndex, v)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *ResumeChunkUpsert) SetContent(v string) *ResumeChunkUpsert {
+	u.Set(resumechunk.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ResumeChunkUpsert) UpdateContent() *ResumeChunkUpsert {
+	u.SetExcluded(resumechunk.FieldContent)
+	return u
+}
+
+// SetVector sets the "vector" field.
+func (u *ResumeChunkUpsert) SetVector(v *pgvector.Vector) *ResumeChunkUpsert {
+	u.Set(resumechunk.FieldVector, v)
+	return u
+}
+
+// UpdateVector sets the "vector" field to the value that was provided on create.
+func (u *ResumeChunkUpsert) UpdateVector() *ResumeChunkUpsert {
+	u.SetExcluded(resumechunk.FieldVector)
+	return u
+}
+
+// ClearVector clears the value of the "vector" field.
+func (u *ResumeChunkUpsert) ClearVector() *ResumeChunkUpsert {
+	u.SetNull(resumechunk.FieldVector)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ResumeChunkUpsert) SetCreatedAt(v time.Time) *ResumeChunkUpsert {
+	u.Set(resumechunk.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ResumeChunkUpsert) UpdateCreatedAt() *ResumeChunkUpsert {
+	u.SetExcluded(resumechunk.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ResumeChunkUpsert) SetUpdatedAt(v time.Time) *ResumeChunkUpsert {
+	u.Set(resumechunk.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ResumeChunkUpsert) UpdateUpdatedAt() *ResumeChunkUpsert {
+	u.SetExcluded(resumechunk.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ResumeChunk.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(resumechunk.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ResumeChunkUpsertOne) UpdateNewValues() *ResumeChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(resumechunk.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResumeChunk.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ResumeChunkUpsertOne) Ignore() *ResumeChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResumeChunkUpsertOne) DoNothing() *ResumeChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResumeChunkCreate.OnConflict
+// documentation for more info.
+func (u *ResumeChunkUpsertOne) Update(set func(*ResumeChunkUpsert)) *ResumeChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResumeChunkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetResumeID sets the "resume_id" field.
+func (u *ResumeChunkUpsertOne) SetResumeID(v uuid.UUID) *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.SetResumeID(v)
+	})
+}
+
+// UpdateResumeID sets the "resume_id" field to the value that was provided on create.
+func (u *ResumeChunkUpsertOne) UpdateResumeID() *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.UpdateResumeID()
+	})
+}
+
+// SetChunkType sets the "chunk_type" field.
+func (u *ResumeChunkUpsertOne) SetChunkType(v consts.ResumeChunkType) *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.SetChunkType(v)
+	})
+}
+
+// UpdateChunkType sets the "chunk_type" field to the value that was provided on create.
+func (u *ResumeChunkUpsertOne) UpdateChunkType() *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.UpdateChunkType()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *ResumeChunkUpsertOne) SetSourceID(v uuid.UUID) *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *ResumeChunkUpsertOne) UpdateSourceID() *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *ResumeChunkUpsertOne) ClearSourceID() *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.ClearSourceID()
+	})
+}
+
+// SetChunkIndex sets the "chunk_index" field.
+func (u *ResumeChunkUpsertOne) SetChunkIndex(v int) *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.SetChunkIndex(v)
+	})
+}
+
+// AddChunkIndex adds v to the "chunk_index" field.
+func (u *ResumeChunkUpsertOne) AddChunkIndex(v int) *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.AddChunkIndex(v)
+	})
+}
+
+// UpdateChunkIndex sets the "chunk_index" field to the value that was provided on create.
+func (u *ResumeChunkUpsertOne) UpdateChunkIndex() *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.UpdateChunkIndex()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ResumeChunkUpsertOne) SetContent(v string) *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ResumeChunkUpsertOne) UpdateContent() *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetVector sets the "vector" field.
+func (u *ResumeChunkUpsertOne) SetVector(v *pgvector.Vector) *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.SetVector(v)
+	})
+}
+
+// UpdateVector sets the "vector" field to the value that was provided on create.
+func (u *ResumeChunkUpsertOne) UpdateVector() *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.UpdateVector()
+	})
+}
+
+// ClearVector clears the value of the "vector" field.
+func (u *ResumeChunkUpsertOne) ClearVector() *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.ClearVector()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ResumeChunkUpsertOne) SetCreatedAt(v time.Time) *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ResumeChunkUpsertOne) UpdateCreatedAt() *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ResumeChunkUpsertOne) SetUpdatedAt(v time.Time) *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ResumeChunkUpsertOne) UpdateUpdatedAt() *ResumeChunkUpsertOne {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ResumeChunkUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for ResumeChunkCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResumeChunkUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ResumeChunkUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: ResumeChunkUpsertOne.ID is not supported by MySQL driver. Use ResumeChunkUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ResumeChunkUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ResumeChunkCreateBulk is the builder for creating many ResumeChunk entities in bulk.
+type ResumeChunkCreateBulk struct {
+	config
+	err      error
+	builders []*ResumeChunkCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ResumeChunk entities in the database.
+func (rccb *ResumeChunkCreateBulk) Save(ctx context.Context) ([]*ResumeChunk, error) {
+	if rccb.err != nil {
+		return nil, rccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rccb.builders))
+	nodes := make([]*ResumeChunk, len(rccb.builders))
+	mutators := make([]Mutator, len(rccb.builders))
+	for i := range rccb.builders {
+		func(i int, root context.Context) {
+			builder := rccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResumeChunkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rccb *ResumeChunkCreateBulk) SaveX(ctx context.Context) []*ResumeChunk {
+	v, err := rccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rccb *ResumeChunkCreateBulk) Exec(ctx context.Context) error {
+	_, err := rccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rccb *ResumeChunkCreateBulk) ExecX(ctx context.Context) {
+	if err := rccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResumeChunk.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResumeChunkUpsert) {
+//			SetResumeID(v+v).
+//		}).
+//		Exec(ctx)
+func (rccb *ResumeChunkCreateBulk) OnConflict(opts ...sql.ConflictOption) *ResumeChunkUpsertBulk {
+	rccb.conflict = opts
+	return &ResumeChunkUpsertBulk{
+		create: rccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResumeChunk.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rccb *ResumeChunkCreateBulk) OnConflictColumns(columns ...string) *ResumeChunkUpsertBulk {
+	rccb.conflict = append(rccb.conflict, sql.ConflictColumns(columns...))
+	return &ResumeChunkUpsertBulk{
+		create: rccb,
+	}
+}
+
+// ResumeChunkUpsertBulk is the builder for "upsert"-ing
+// a bulk of ResumeChunk nodes.
+type ResumeChunkUpsertBulk struct {
+	create *ResumeChunkCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ResumeChunk.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(resumechunk.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ResumeChunkUpsertBulk) UpdateNewValues() *ResumeChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(resumechunk.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResumeChunk.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ResumeChunkUpsertBulk) Ignore() *ResumeChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResumeChunkUpsertBulk) DoNothing() *ResumeChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResumeChunkCreateBulk.OnConflict
+// documentation for more info.
+func (u *ResumeChunkUpsertBulk) Update(set func(*ResumeChunkUpsert)) *ResumeChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResumeChunkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetResumeID sets the "resume_id" field.
+func (u *ResumeChunkUpsertBulk) SetResumeID(v uuid.UUID) *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.SetResumeID(v)
+	})
+}
+
+// UpdateResumeID sets the "resume_id" field to the value that was provided on create.
+func (u *ResumeChunkUpsertBulk) UpdateResumeID() *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.UpdateResumeID()
+	})
+}
+
+// SetChunkType sets the "chunk_type" field.
+func (u *ResumeChunkUpsertBulk) SetChunkType(v consts.ResumeChunkType) *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.SetChunkType(v)
+	})
+}
+
+// UpdateChunkType sets the "chunk_type" field to the value that was provided on create.
+func (u *ResumeChunkUpsertBulk) UpdateChunkType() *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.UpdateChunkType()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *ResumeChunkUpsertBulk) SetSourceID(v uuid.UUID) *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *ResumeChunkUpsertBulk) UpdateSourceID() *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *ResumeChunkUpsertBulk) ClearSourceID() *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.ClearSourceID()
+	})
+}
+
+// SetChunkIndex sets the "chunk_index" field.
+func (u *ResumeChunkUpsertBulk) SetChunkIndex(v int) *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.SetChunkIndex(v)
+	})
+}
+
+// AddChunkIndex adds v to the "chunk_index" field.
+func (u *ResumeChunkUpsertBulk) AddChunkIndex(v int) *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.AddChunkIndex(v)
+	})
+}
+
+// UpdateChunkIndex sets the "chunk_index" field to the value that was provided on create.
+func (u *ResumeChunkUpsertBulk) UpdateChunkIndex() *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.UpdateChunkIndex()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ResumeChunkUpsertBulk) SetContent(v string) *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ResumeChunkUpsertBulk) UpdateContent() *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetVector sets the "vector" field.
+func (u *ResumeChunkUpsertBulk) SetVector(v *pgvector.Vector) *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.SetVector(v)
+	})
+}
+
+// UpdateVector sets the "vector" field to the value that was provided on create.
+func (u *ResumeChunkUpsertBulk) UpdateVector() *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.UpdateVector()
+	})
+}
+
+// ClearVector clears the value of the "vector" field.
+func (u *ResumeChunkUpsertBulk) ClearVector() *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.ClearVector()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ResumeChunkUpsertBulk) SetCreatedAt(v time.Time) *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ResumeChunkUpsertBulk) UpdateCreatedAt() *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ResumeChunkUpsertBulk) SetUpdatedAt(v time.Time) *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ResumeChunkUpsertBulk) UpdateUpdatedAt() *ResumeChunkUpsertBulk {
+	return u.Update(func(s *ResumeChunkUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ResumeChunkUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the ResumeChunkCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for ResumeChunkCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResumeChunkUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
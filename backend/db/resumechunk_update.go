@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db/predicate"
+	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumechunk"
+	"github.com/google/uuid"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// ResumeChunkUpdate is the builder for updating ResumeChunk entities.
+type ResumeChunkUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ResumeChunkMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ResumeChunkUpdate builder.
+func (rcu *ResumeChunkUpdate) Where(ps ...predicate.ResumeChunk) *ResumeChunkUpdate {
+	rcu.mutation.Where(ps...)
+	return rcu
+}
+
+// SetResumeID sets the "resume_id" field.
+func (rcu *ResumeChunkUpdate) SetResumeID(u uuid.UUID) *ResumeChunkUpdate {
+	rcu.mutation.SetResumeID(u)
+	return rcu
+}
+
+// SetNillableResumeID sets the "resume_id" field if the given value is not nil.
+func (rcu *ResumeChunkUpdate) SetNillableResumeID(u *uuid.UUID) *ResumeChunkUpdate {
+	if u != nil {
+		rcu.SetResumeID(*u)
+	}
+	return rcu
+}
+
+// SetChunkType sets the "chunk_type" field.
+func (rcu *ResumeChunkUpdate) SetChunkType(cct consts.ResumeChunkType) *ResumeChunkUpdate {
+	rcu.mutation.SetChunkType(cct)
+	return rcu
+}
+
+// SetNillableChunkType sets the "chunk_type" field if the given value is not nil.
+func (rcu *ResumeChunkUpdate) SetNillableChunkType(cct *consts.ResumeChunkType) *ResumeChunkUpdate {
+	if cct != nil {
+		rcu.SetChunkType(*cct)
+	}
+	return rcu
+}
+
+// SetSourceID sets the "source_id" field.
+func (rcu *ResumeChunkUpdate) SetSourceID(u uuid.UUID) *ResumeChunkUpdate {
+	rcu.mutation.SetSourceID(u)
+	return rcu
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (rcu *ResumeChunkUpdate) SetNillableSourceID(u *uuid.UUID) *ResumeChunkUpdate {
+	if u != nil {
+		rcu.SetSourceID(*u)
+	}
+	return rcu
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (rcu *ResumeChunkUpdate) ClearSourceID() *ResumeChunkUpdate {
+	rcu.mutation.ClearSourceID()
+	return rcu
+}
+
+// SetChunkIndex sets the "chunk_index" field.
+func (rcu *ResumeChunkUpdate) SetChunkIndex(i int) *ResumeChunkUpdate {
+	rcu.mutation.ResetChunkIndex()
+	rcu.mutation.SetChunkIndex(i)
+	return rcu
+}
+
+// SetNillableChunkIndex sets the "chunk_index" field if the given value is not nil.
+func (rcu *ResumeChunkUpdate) SetNillableChunkIndex(i *int) *ResumeChunkUpdate {
+	if i != nil {
+		rcu.SetChunkIndex(*i)
+	}
+	return rcu
+}
+
+// AddChunkIndex adds i to the "chunk_index" field.
+func (rcu *ResumeChunkUpdate) AddChunkIndex(i int) *ResumeChunkUpdate {
+	rcu.mutation.AddChunkIndex(i)
+	return rcu
+}
+
+// SetContent sets the "content" field.
+func (rcu *ResumeChunkUpdate) SetContent(s string) *ResumeChunkUpdate {
+	rcu.mutation.SetContent(s)
+	return rcu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (rcu *ResumeChunkUpdate) SetNillableContent(s *string) *ResumeChunkUpdate {
+	if s != nil {
+		rcu.SetContent(*s)
+	}
+	return rcu
+}
+
+// SetVector sets the "vector" field.
+func (rcu *ResumeChunkUpdate) SetVector(pg *pgvector.Vector) *ResumeChunkUpdate {
+	rcu.mutation.SetVector(pg)
+	return rcu
+}
+
+// ClearVector clears the value of the "vector" field.
+func (rcu *ResumeChunkUpdate) ClearVector() *ResumeChunkUpdate {
+	rcu.mutation.ClearVector()
+	return rcu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rcu *ResumeChunkUpdate) SetCreatedAt(t time.Time) *ResumeChunkUpdate {
+	rcu.mutation.SetCreatedAt(t)
+	return rcu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rcu *ResumeChunkUpdate) SetNillableCreatedAt(t *time.Time) *ResumeChunkUpdate {
+	if t != nil {
+		rcu.SetCreatedAt(*t)
+	}
+	return rcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcu *ResumeChunkUpdate) SetUpdatedAt(t time.Time) *ResumeChunkUpdate {
+	rcu.mutation.SetUpdatedAt(t)
+	return rcu
+}
+
+// SetResume sets the "resume" edge to the Resume entity.
+func (rcu *ResumeChunkUpdate) SetResume(r *Resume) *ResumeChunkUpdate {
+	return rcu.SetResumeID(r.ID)
+}
+
+// Mutation returns the ResumeChunkMutation object of the builder.
+func (rcu *ResumeChunkUpdate) Mutation() *ResumeChunkMutation {
+	return rcu.mutation
+}
+
+// ClearResume clears the "resume" edge to the Resume entity.
+func (rcu *ResumeChunkUpdate) ClearResume() *ResumeChunkUpdate {
+	rcu.mutation.ClearResume()
+	return rcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rcu *ResumeChunkUpdate) Save(ctx context.Context) (int, error) {
+	rcu.defaults()
+	return withHooks(ctx, rcu.sqlSave, rcu.mutation, rcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcu *ResumeChunkUpdate) SaveX(ctx context.Context) int {
+	affected, err := rcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rcu *ResumeChunkUpdate) Exec(ctx context.Context) error {
+	_, err := rcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcu *ResumeChunkUpdate) ExecX(ctx context.Context) {
+	if err := rcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcu *ResumeChunkUpdate) defaults() {
+	if _, ok := rcu.mutation.UpdatedAt(); !ok {
+		v := resumechunk.UpdateDefaultUpdatedAt()
+		rcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcu *ResumeChunkUpdate) check() error {
+	if rcu.mutation.ResumeCleared() && len(rcu.mutation.ResumeIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "ResumeChunk.resume"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rcu *ResumeChunkUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResumeChunkUpdate {
+	rcu.modifiers = append(rcu.modifiers, modifiers...)
+	return rcu
+}
+
+func (rcu *ResumeChunkUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resumechunk.Table, resumechunk.Columns, sqlgraph.NewFieldSpec(resumechunk.FieldID, field.TypeUUID))
+	if ps := rcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcu.mutation.ChunkType(); ok {
+		_spec.SetField(resumechunk.FieldChunkType, field.TypeString, value)
+	}
+	if value, ok := rcu.mutation.SourceID(); ok {
+		_spec.SetField(resumechunk.FieldSourceID, field.TypeUUID, value)
+	}
+	if rcu.mutation.SourceIDCleared() {
+		_spec.ClearField(resumechunk.FieldSourceID, field.TypeUUID)
+	}
+	if value, ok := rcu.mutation.ChunkIndex(); ok {
+		_spec.SetField(resumechunk.FieldChunkIndex, field.TypeInt, value)
+	}
+	if value, ok := rcu.mutation.AddedChunkIndex(); ok {
+		_spec.AddField(resumechunk.FieldChunkIndex, field.TypeInt, value)
+	}
+	if value, ok := rcu.mutation.Content(); ok {
+		_spec.SetField(resumechunk.FieldContent, field.TypeString, value)
+	}
+	if value, ok := rcu.mutation.Vector(); ok {
+		_spec.SetField(resumechunk.FieldVector, field.TypeOther, value)
+	}
+	if rcu.mutation.VectorCleared() {
+		_spec.ClearField(resumechunk.FieldVector, field.TypeOther)
+	}
+	if value, ok := rcu.mutation.CreatedAt(); ok {
+		_spec.SetField(resumechunk.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := rcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(resumechunk.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rcu.mutation.ResumeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumechunk.ResumeTable,
+			Columns: []string{resumechunk.ResumeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resume.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rcu.mutation.ResumeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumechunk.ResumeTable,
+			Columns: []string{resumechunk.ResumeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resume.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(rcu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resumechunk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rcu.mutation.done = true
+	return n, nil
+}
+
+// ResumeChunkUpdateOne is the builder for updating a single ResumeChunk entity.
+type ResumeChunkUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ResumeChunkMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetResumeID sets the "resume_id" field.
+func (rcuo *ResumeChunkUpdateOne) SetResumeID(u uuid.UUID) *ResumeChunkUpdateOne {
+	rcuo.mutation.SetResumeID(u)
+	return rcuo
+}
+
+// SetNillableResumeID sets the "resume_id" field if the given value is not nil.
+func (rcuo *ResumeChunkUpdateOne) SetNillableResumeID(u *uuid.UUID) *ResumeChunkUpdateOne {
+	if u != nil {
+		rcuo.SetResumeID(*u)
+	}
+	return rcuo
+}
+
+// SetChunkType sets the "chunk_type" field.
+func (rcuo *ResumeChunkUpdateOne) SetChunkType(cct consts.ResumeChunkType) *ResumeChunkUpdateOne {
+	rcuo.mutation.SetChunkType(cct)
+	return rcuo
+}
+
+// SetNillableChunkType sets the "chunk_type" field if the given value is not nil.
+func (rcuo *ResumeChunkUpdateOne) SetNillableChunkType(cct *consts.ResumeChunkType) *ResumeChunkUpdateOne {
+	if cct != nil {
+		rcuo.SetChunkType(*cct)
+	}
+	return rcuo
+}
+
+// SetSourceID sets the "source_id" field.
+func (rcuo *ResumeChunkUpdateOne) SetSourceID(u uuid.UUID) *ResumeChunkUpdateOne {
+	rcuo.mutation.SetSourceID(u)
+	return rcuo
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (rcuo *ResumeChunkUpdateOne) SetNillableSourceID(u *uuid.UUID) *ResumeChunkUpdateOne {
+	if u != nil {
+		rcuo.SetSourceID(*u)
+	}
+	return rcuo
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (rcuo *ResumeChunkUpdateOne) ClearSourceID() *ResumeChunkUpdateOne {
+	rcuo.mutation.ClearSourceID()
+	return rcuo
+}
+
+// SetChunkIndex sets the "chunk_index" field.
+func (rcuo *ResumeChunkUpdateOne) SetChunkIndex(i int) *ResumeChunkUpdateOne {
+	rcuo.mutation.ResetChunkIndex()
+	rcuo.mutation.SetChunkIndex(i)
+	return rcuo
+}
+
+// SetNillableChunkIndex sets the "chunk_index" field if the given value is not nil.
+func (rcuo *ResumeChunkUpdateOne) SetNillableChunkIndex(i *int) *ResumeChunkUpdateOne {
+	if i != nil {
+		rcuo.SetChunkIndex(*i)
+	}
+	return rcuo
+}
+
+// AddChunkIndex adds i to the "chunk_index" field.
+func (rcuo *ResumeChunkUpdateOne) AddChunkIndex(i int) *ResumeChunkUpdateOne {
+	rcuo.mutation.AddChunkIndex(i)
+	return rcuo
+}
+
+// SetContent sets the "content" field.
+func (rcuo *ResumeChunkUpdateOne) SetContent(s string) *ResumeChunkUpdateOne {
+	rcuo.mutation.SetContent(s)
+	return rcuo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (rcuo *ResumeChunkUpdateOne) SetNillableContent(s *string) *ResumeChunkUpdateOne {
+	if s != nil {
+		rcuo.SetContent(*s)
+	}
+	return rcuo
+}
+
+// SetVector sets the "vector" field.
+func (rcuo *ResumeChunkUpdateOne) SetVector(pg *pgvector.Vector) *ResumeChunkUpdateOne {
+	rcuo.mutation.SetVector(pg)
+	return rcuo
+}
+
+// ClearVector clears the value of the "vector" field.
+func (rcuo *ResumeChunkUpdateOne) ClearVector() *ResumeChunkUpdateOne {
+	rcuo.mutation.ClearVector()
+	return rcuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rcuo *ResumeChunkUpdateOne) SetCreatedAt(t time.Time) *ResumeChunkUpdateOne {
+	rcuo.mutation.SetCreatedAt(t)
+	return rcuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rcuo *ResumeChunkUpdateOne) SetNillableCreatedAt(t *time.Time) *ResumeChunkUpdateOne {
+	if t != nil {
+		rcuo.SetCreatedAt(*t)
+	}
+	return rcuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcuo *ResumeChunkUpdateOne) SetUpdatedAt(t time.Time) *ResumeChunkUpdateOne {
+	rcuo.mutation.SetUpdatedAt(t)
+	return rcuo
+}
+
+// SetResume sets the "resume" edge to the Resume entity.
+func (rcuo *ResumeChunkUpdateOne) SetResume(r *Resume) *ResumeChunkUpdateOne {
+	return rcuo.SetResumeID(r.ID)
+}
+
+// Mutation returns the ResumeChunkMutation object of the builder.
+func (rcuo *ResumeChunkUpdateOne) Mutation() *ResumeChunkMutation {
+	return rcuo.mutation
+}
+
+// ClearResume clears the "resume" edge to the Resume entity.
+func (rcuo *ResumeChunkUpdateOne) ClearResume() *ResumeChunkUpdateOne {
+	rcuo.mutation.ClearResume()
+	return rcuo
+}
+
+// Where appends a list predicates to the ResumeChunkUpdate builder.
+func (rcuo *ResumeChunkUpdateOne) Where(ps ...predicate.ResumeChunk) *ResumeChunkUpdateOne {
+	rcuo.mutation.Where(ps...)
+	return rcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rcuo *ResumeChunkUpdateOne) Select(field string, fields ...string) *ResumeChunkUpdateOne {
+	rcuo.fields = append([]string{field}, fields...)
+	return rcuo
+}
+
+// Save executes the query and returns the updated ResumeChunk entity.
+func (rcuo *ResumeChunkUpdateOne) Save(ctx context.Context) (*ResumeChunk, error) {
+	rcuo.defaults()
+	return withHooks(ctx, rcuo.sqlSave, rcuo.mutation, rcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcuo *ResumeChunkUpdateOne) SaveX(ctx context.Context) *ResumeChunk {
+	node, err := rcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rcuo *ResumeChunkUpdateOne) Exec(ctx context.Context) error {
+	_, err := rcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcuo *ResumeChunkUpdateOne) ExecX(ctx context.Context) {
+	if err := rcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcuo *ResumeChunkUpdateOne) defaults() {
+	if _, ok := rcuo.mutation.UpdatedAt(); !ok {
+		v := resumechunk.UpdateDefaultUpdatedAt()
+		rcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcuo *ResumeChunkUpdateOne) check() error {
+	if rcuo.mutation.ResumeCleared() && len(rcuo.mutation.ResumeIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "ResumeChunk.resume"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rcuo *ResumeChunkUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResumeChunkUpdateOne {
+	rcuo.modifiers = append(rcuo.modifiers, modifiers...)
+	return rcuo
+}
+
+func (rcuo *ResumeChunkUpdateOne) sqlSave(ctx context.Context) (_node *ResumeChunk, err error) {
+	if err := rcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resumechunk.Table, resumechunk.Columns, sqlgraph.NewFieldSpec(resumechunk.FieldID, field.TypeUUID))
+	id, ok := rcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "ResumeChunk.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resumechunk.FieldID)
+		for _, f := range fields {
+			if !resumechunk.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != resumechunk.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcuo.mutation.ChunkType(); ok {
+		_spec.SetField(resumechunk.FieldChunkType, field.TypeString, value)
+	}
+	if value, ok := rcuo.mutation.SourceID(); ok {
+		_spec.SetField(resumechunk.FieldSourceID, field.TypeUUID, value)
+	}
+	if rcuo.mutation.SourceIDCleared() {
+		_spec.ClearField(resumechunk.FieldSourceID, field.TypeUUID)
+	}
+	if value, ok := rcuo.mutation.ChunkIndex(); ok {
+		_spec.SetField(resumechunk.FieldChunkIndex, field.TypeInt, value)
+	}
+	if value, ok := rcuo.mutation.AddedChunkIndex(); ok {
+		_spec.AddField(resumechunk.FieldChunkIndex, field.TypeInt, value)
+	}
+	if value, ok := rcuo.mutation.Content(); ok {
+		_spec.SetField(resumechunk.FieldContent, field.TypeString, value)
+	}
+	if value, ok := rcuo.mutation.Vector(); ok {
+		_spec.SetField(resumechunk.FieldVector, field.TypeOther, value)
+	}
+	if rcuo.mutation.VectorCleared() {
+		_spec.ClearField(resumechunk.FieldVector, field.TypeOther)
+	}
+	if value, ok := rcuo.mutation.CreatedAt(); ok {
+		_spec.SetField(resumechunk.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := rcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(resumechunk.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rcuo.mutation.ResumeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumechunk.ResumeTable,
+			Columns: []string{resumechunk.ResumeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resume.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rcuo.mutation.ResumeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumechunk.ResumeTable,
+			Columns: []string{resumechunk.ResumeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resume.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(rcuo.modifiers...)
+	_node = &ResumeChunk{config: rcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resumechunk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rcuo.mutation.done = true
+	return _node, nil
+}
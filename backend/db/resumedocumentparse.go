@@ -39,6 +39,8 @@ type ResumeDocumentParse struct {
 	Status string `json:"status,omitempty"`
 	// ErrorMessage holds the value of the "error_message" field.
 	ErrorMessage string `json:"error_message,omitempty"`
+	// OcrConfidence holds the value of the "ocr_confidence" field.
+	OcrConfidence *float64 `json:"ocr_confidence,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -74,6 +76,8 @@ func (*ResumeDocumentParse) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case resumedocumentparse.FieldOcrConfidence:
+			values[i] = new(sql.NullFloat64)
 		case resumedocumentparse.FieldFileID, resumedocumentparse.FieldContent, resumedocumentparse.FieldFileType, resumedocumentparse.FieldFilename, resumedocumentparse.FieldTitle, resumedocumentparse.FieldStatus, resumedocumentparse.FieldErrorMessage:
 			values[i] = new(sql.NullString)
 		case resumedocumentparse.FieldDeletedAt, resumedocumentparse.FieldUploadAt, resumedocumentparse.FieldCreatedAt, resumedocumentparse.FieldUpdatedAt:
@@ -161,6 +165,13 @@ func (rdp *ResumeDocumentParse) assignValues(columns []string, values []any) err
 			} else if value.Valid {
 				rdp.ErrorMessage = value.String
 			}
+		case resumedocumentparse.FieldOcrConfidence:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field ocr_confidence", values[i])
+			} else if value.Valid {
+				rdp.OcrConfidence = new(float64)
+				*rdp.OcrConfidence = value.Float64
+			}
 		case resumedocumentparse.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -244,6 +255,11 @@ func (rdp *ResumeDocumentParse) String() string {
 	builder.WriteString("error_message=")
 	builder.WriteString(rdp.ErrorMessage)
 	builder.WriteString(", ")
+	if v := rdp.OcrConfidence; v != nil {
+		builder.WriteString("ocr_confidence=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(rdp.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -36,6 +36,8 @@ const (
 	FieldStatus = "status"
 	// FieldErrorMessage holds the string denoting the error_message field in the database.
 	FieldErrorMessage = "error_message"
+	// FieldOcrConfidence holds the string denoting the ocr_confidence field in the database.
+	FieldOcrConfidence = "ocr_confidence"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -66,6 +68,7 @@ var Columns = []string{
 	FieldUploadAt,
 	FieldStatus,
 	FieldErrorMessage,
+	FieldOcrConfidence,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -158,6 +161,11 @@ func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
 }
 
+// ByOcrConfidence orders the results by the ocr_confidence field.
+func ByOcrConfidence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOcrConfidence, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -106,6 +106,11 @@ func ErrorMessage(v string) predicate.ResumeDocumentParse {
 	return predicate.ResumeDocumentParse(sql.FieldEQ(FieldErrorMessage, v))
 }
 
+// OcrConfidence applies equality check predicate on the "ocr_confidence" field. It's identical to OcrConfidenceEQ.
+func OcrConfidence(v float64) predicate.ResumeDocumentParse {
+	return predicate.ResumeDocumentParse(sql.FieldEQ(FieldOcrConfidence, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.ResumeDocumentParse {
 	return predicate.ResumeDocumentParse(sql.FieldEQ(FieldCreatedAt, v))
@@ -751,6 +756,56 @@ func ErrorMessageContainsFold(v string) predicate.ResumeDocumentParse {
 	return predicate.ResumeDocumentParse(sql.FieldContainsFold(FieldErrorMessage, v))
 }
 
+// OcrConfidenceEQ applies the EQ predicate on the "ocr_confidence" field.
+func OcrConfidenceEQ(v float64) predicate.ResumeDocumentParse {
+	return predicate.ResumeDocumentParse(sql.FieldEQ(FieldOcrConfidence, v))
+}
+
+// OcrConfidenceNEQ applies the NEQ predicate on the "ocr_confidence" field.
+func OcrConfidenceNEQ(v float64) predicate.ResumeDocumentParse {
+	return predicate.ResumeDocumentParse(sql.FieldNEQ(FieldOcrConfidence, v))
+}
+
+// OcrConfidenceIn applies the In predicate on the "ocr_confidence" field.
+func OcrConfidenceIn(vs ...float64) predicate.ResumeDocumentParse {
+	return predicate.ResumeDocumentParse(sql.FieldIn(FieldOcrConfidence, vs...))
+}
+
+// OcrConfidenceNotIn applies the NotIn predicate on the "ocr_confidence" field.
+func OcrConfidenceNotIn(vs ...float64) predicate.ResumeDocumentParse {
+	return predicate.ResumeDocumentParse(sql.FieldNotIn(FieldOcrConfidence, vs...))
+}
+
+// OcrConfidenceGT applies the GT predicate on the "ocr_confidence" field.
+func OcrConfidenceGT(v float64) predicate.ResumeDocumentParse {
+	return predicate.ResumeDocumentParse(sql.FieldGT(FieldOcrConfidence, v))
+}
+
+// OcrConfidenceGTE applies the GTE predicate on the "ocr_confidence" field.
+func OcrConfidenceGTE(v float64) predicate.ResumeDocumentParse {
+	return predicate.ResumeDocumentParse(sql.FieldGTE(FieldOcrConfidence, v))
+}
+
+// OcrConfidenceLT applies the LT predicate on the "ocr_confidence" field.
+func OcrConfidenceLT(v float64) predicate.ResumeDocumentParse {
+	return predicate.ResumeDocumentParse(sql.FieldLT(FieldOcrConfidence, v))
+}
+
+// OcrConfidenceLTE applies the LTE predicate on the "ocr_confidence" field.
+func OcrConfidenceLTE(v float64) predicate.ResumeDocumentParse {
+	return predicate.ResumeDocumentParse(sql.FieldLTE(FieldOcrConfidence, v))
+}
+
+// OcrConfidenceIsNil applies the IsNil predicate on the "ocr_confidence" field.
+func OcrConfidenceIsNil() predicate.ResumeDocumentParse {
+	return predicate.ResumeDocumentParse(sql.FieldIsNull(FieldOcrConfidence))
+}
+
+// OcrConfidenceNotNil applies the NotNil predicate on the "ocr_confidence" field.
+func OcrConfidenceNotNil() predicate.ResumeDocumentParse {
+	return predicate.ResumeDocumentParse(sql.FieldNotNull(FieldOcrConfidence))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ResumeDocumentParse {
 	return predicate.ResumeDocumentParse(sql.FieldEQ(FieldCreatedAt, v))
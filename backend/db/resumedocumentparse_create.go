@@ -157,6 +157,20 @@ func (rdpc *ResumeDocumentParseCreate) SetNillableErrorMessage(s *string) *Resum
 	return rdpc
 }
 
+// SetOcrConfidence sets the "ocr_confidence" field.
+func (rdpc *ResumeDocumentParseCreate) SetOcrConfidence(f float64) *ResumeDocumentParseCreate {
+	rdpc.mutation.SetOcrConfidence(f)
+	return rdpc
+}
+
+// SetNillableOcrConfidence sets the "ocr_confidence" field if the given value is not nil.
+func (rdpc *ResumeDocumentParseCreate) SetNillableOcrConfidence(f *float64) *ResumeDocumentParseCreate {
+	if f != nil {
+		rdpc.SetOcrConfidence(*f)
+	}
+	return rdpc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (rdpc *ResumeDocumentParseCreate) SetCreatedAt(t time.Time) *ResumeDocumentParseCreate {
 	rdpc.mutation.SetCreatedAt(t)
@@ -358,6 +372,10 @@ func (rdpc *ResumeDocumentParseCreate) createSpec() (*ResumeDocumentParse, *sqlg
 		_spec.SetField(resumedocumentparse.FieldErrorMessage, field.TypeString, value)
 		_node.ErrorMessage = value
 	}
+	if value, ok := rdpc.mutation.OcrConfidence(); ok {
+		_spec.SetField(resumedocumentparse.FieldOcrConfidence, field.TypeFloat64, value)
+		_node.OcrConfidence = &value
+	}
 	if value, ok := rdpc.mutation.CreatedAt(); ok {
 		_spec.SetField(resumedocumentparse.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -603,6 +621,30 @@ func (u *ResumeDocumentParseUpsert) ClearErrorMessage() *ResumeDocumentParseUpse
 	return u
 }
 
+// SetOcrConfidence sets the "ocr_confidence" field.
+func (u *ResumeDocumentParseUpsert) SetOcrConfidence(v float64) *ResumeDocumentParseUpsert {
+	u.Set(resumedocumentparse.FieldOcrConfidence, v)
+	return u
+}
+
+// UpdateOcrConfidence sets the "ocr_confidence" field to the value that was provided on create.
+func (u *ResumeDocumentParseUpsert) UpdateOcrConfidence() *ResumeDocumentParseUpsert {
+	u.SetExcluded(resumedocumentparse.FieldOcrConfidence)
+	return u
+}
+
+// AddOcrConfidence adds v to the "ocr_confidence" field.
+func (u *ResumeDocumentParseUpsert) AddOcrConfidence(v float64) *ResumeDocumentParseUpsert {
+	u.Add(resumedocumentparse.FieldOcrConfidence, v)
+	return u
+}
+
+// ClearOcrConfidence clears the value of the "ocr_confidence" field.
+func (u *ResumeDocumentParseUpsert) ClearOcrConfidence() *ResumeDocumentParseUpsert {
+	u.SetNull(resumedocumentparse.FieldOcrConfidence)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *ResumeDocumentParseUpsert) SetCreatedAt(v time.Time) *ResumeDocumentParseUpsert {
 	u.Set(resumedocumentparse.FieldCreatedAt, v)
@@ -871,6 +913,34 @@ func (u *ResumeDocumentParseUpsertOne) ClearErrorMessage() *ResumeDocumentParseU
 	})
 }
 
+// SetOcrConfidence sets the "ocr_confidence" field.
+func (u *ResumeDocumentParseUpsertOne) SetOcrConfidence(v float64) *ResumeDocumentParseUpsertOne {
+	return u.Update(func(s *ResumeDocumentParseUpsert) {
+		s.SetOcrConfidence(v)
+	})
+}
+
+// AddOcrConfidence adds v to the "ocr_confidence" field.
+func (u *ResumeDocumentParseUpsertOne) AddOcrConfidence(v float64) *ResumeDocumentParseUpsertOne {
+	return u.Update(func(s *ResumeDocumentParseUpsert) {
+		s.AddOcrConfidence(v)
+	})
+}
+
+// UpdateOcrConfidence sets the "ocr_confidence" field to the value that was provided on create.
+func (u *ResumeDocumentParseUpsertOne) UpdateOcrConfidence() *ResumeDocumentParseUpsertOne {
+	return u.Update(func(s *ResumeDocumentParseUpsert) {
+		s.UpdateOcrConfidence()
+	})
+}
+
+// ClearOcrConfidence clears the value of the "ocr_confidence" field.
+func (u *ResumeDocumentParseUpsertOne) ClearOcrConfidence() *ResumeDocumentParseUpsertOne {
+	return u.Update(func(s *ResumeDocumentParseUpsert) {
+		s.ClearOcrConfidence()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *ResumeDocumentParseUpsertOne) SetCreatedAt(v time.Time) *ResumeDocumentParseUpsertOne {
 	return u.Update(func(s *ResumeDocumentParseUpsert) {
@@ -1310,6 +1380,34 @@ func (u *ResumeDocumentParseUpsertBulk) ClearErrorMessage() *ResumeDocumentParse
 	})
 }
 
+// SetOcrConfidence sets the "ocr_confidence" field.
+func (u *ResumeDocumentParseUpsertBulk) SetOcrConfidence(v float64) *ResumeDocumentParseUpsertBulk {
+	return u.Update(func(s *ResumeDocumentParseUpsert) {
+		s.SetOcrConfidence(v)
+	})
+}
+
+// AddOcrConfidence adds v to the "ocr_confidence" field.
+func (u *ResumeDocumentParseUpsertBulk) AddOcrConfidence(v float64) *ResumeDocumentParseUpsertBulk {
+	return u.Update(func(s *ResumeDocumentParseUpsert) {
+		s.AddOcrConfidence(v)
+	})
+}
+
+// UpdateOcrConfidence sets the "ocr_confidence" field to the value that was provided on create.
+func (u *ResumeDocumentParseUpsertBulk) UpdateOcrConfidence() *ResumeDocumentParseUpsertBulk {
+	return u.Update(func(s *ResumeDocumentParseUpsert) {
+		s.UpdateOcrConfidence()
+	})
+}
+
+// ClearOcrConfidence clears the value of the "ocr_confidence" field.
+func (u *ResumeDocumentParseUpsertBulk) ClearOcrConfidence() *ResumeDocumentParseUpsertBulk {
+	return u.Update(func(s *ResumeDocumentParseUpsert) {
+		s.ClearOcrConfidence()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *ResumeDocumentParseUpsertBulk) SetCreatedAt(v time.Time) *ResumeDocumentParseUpsertBulk {
 	return u.Update(func(s *ResumeDocumentParseUpsert) {
@@ -219,6 +219,33 @@ func (rdpu *ResumeDocumentParseUpdate) ClearErrorMessage() *ResumeDocumentParseU
 	return rdpu
 }
 
+// SetOcrConfidence sets the "ocr_confidence" field.
+func (rdpu *ResumeDocumentParseUpdate) SetOcrConfidence(f float64) *ResumeDocumentParseUpdate {
+	rdpu.mutation.ResetOcrConfidence()
+	rdpu.mutation.SetOcrConfidence(f)
+	return rdpu
+}
+
+// SetNillableOcrConfidence sets the "ocr_confidence" field if the given value is not nil.
+func (rdpu *ResumeDocumentParseUpdate) SetNillableOcrConfidence(f *float64) *ResumeDocumentParseUpdate {
+	if f != nil {
+		rdpu.SetOcrConfidence(*f)
+	}
+	return rdpu
+}
+
+// AddOcrConfidence adds f to the "ocr_confidence" field.
+func (rdpu *ResumeDocumentParseUpdate) AddOcrConfidence(f float64) *ResumeDocumentParseUpdate {
+	rdpu.mutation.AddOcrConfidence(f)
+	return rdpu
+}
+
+// ClearOcrConfidence clears the value of the "ocr_confidence" field.
+func (rdpu *ResumeDocumentParseUpdate) ClearOcrConfidence() *ResumeDocumentParseUpdate {
+	rdpu.mutation.ClearOcrConfidence()
+	return rdpu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (rdpu *ResumeDocumentParseUpdate) SetCreatedAt(t time.Time) *ResumeDocumentParseUpdate {
 	rdpu.mutation.SetCreatedAt(t)
@@ -374,6 +401,15 @@ func (rdpu *ResumeDocumentParseUpdate) sqlSave(ctx context.Context) (n int, err
 	if rdpu.mutation.ErrorMessageCleared() {
 		_spec.ClearField(resumedocumentparse.FieldErrorMessage, field.TypeString)
 	}
+	if value, ok := rdpu.mutation.OcrConfidence(); ok {
+		_spec.SetField(resumedocumentparse.FieldOcrConfidence, field.TypeFloat64, value)
+	}
+	if value, ok := rdpu.mutation.AddedOcrConfidence(); ok {
+		_spec.AddField(resumedocumentparse.FieldOcrConfidence, field.TypeFloat64, value)
+	}
+	if rdpu.mutation.OcrConfidenceCleared() {
+		_spec.ClearField(resumedocumentparse.FieldOcrConfidence, field.TypeFloat64)
+	}
 	if value, ok := rdpu.mutation.CreatedAt(); ok {
 		_spec.SetField(resumedocumentparse.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -619,6 +655,33 @@ func (rdpuo *ResumeDocumentParseUpdateOne) ClearErrorMessage() *ResumeDocumentPa
 	return rdpuo
 }
 
+// SetOcrConfidence sets the "ocr_confidence" field.
+func (rdpuo *ResumeDocumentParseUpdateOne) SetOcrConfidence(f float64) *ResumeDocumentParseUpdateOne {
+	rdpuo.mutation.ResetOcrConfidence()
+	rdpuo.mutation.SetOcrConfidence(f)
+	return rdpuo
+}
+
+// SetNillableOcrConfidence sets the "ocr_confidence" field if the given value is not nil.
+func (rdpuo *ResumeDocumentParseUpdateOne) SetNillableOcrConfidence(f *float64) *ResumeDocumentParseUpdateOne {
+	if f != nil {
+		rdpuo.SetOcrConfidence(*f)
+	}
+	return rdpuo
+}
+
+// AddOcrConfidence adds f to the "ocr_confidence" field.
+func (rdpuo *ResumeDocumentParseUpdateOne) AddOcrConfidence(f float64) *ResumeDocumentParseUpdateOne {
+	rdpuo.mutation.AddOcrConfidence(f)
+	return rdpuo
+}
+
+// ClearOcrConfidence clears the value of the "ocr_confidence" field.
+func (rdpuo *ResumeDocumentParseUpdateOne) ClearOcrConfidence() *ResumeDocumentParseUpdateOne {
+	rdpuo.mutation.ClearOcrConfidence()
+	return rdpuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (rdpuo *ResumeDocumentParseUpdateOne) SetCreatedAt(t time.Time) *ResumeDocumentParseUpdateOne {
 	rdpuo.mutation.SetCreatedAt(t)
@@ -804,6 +867,15 @@ func (rdpuo *ResumeDocumentParseUpdateOne) sqlSave(ctx context.Context) (_node *
 	if rdpuo.mutation.ErrorMessageCleared() {
 		_spec.ClearField(resumedocumentparse.FieldErrorMessage, field.TypeString)
 	}
+	if value, ok := rdpuo.mutation.OcrConfidence(); ok {
+		_spec.SetField(resumedocumentparse.FieldOcrConfidence, field.TypeFloat64, value)
+	}
+	if value, ok := rdpuo.mutation.AddedOcrConfidence(); ok {
+		_spec.AddField(resumedocumentparse.FieldOcrConfidence, field.TypeFloat64, value)
+	}
+	if rdpuo.mutation.OcrConfidenceCleared() {
+		_spec.ClearField(resumedocumentparse.FieldOcrConfidence, field.TypeFloat64)
+	}
 	if value, ok := rdpuo.mutation.CreatedAt(); ok {
 		_spec.SetField(resumedocumentparse.FieldCreatedAt, field.TypeTime, value)
 	}
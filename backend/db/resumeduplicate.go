@@ -0,0 +1,258 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumeduplicate"
+	"github.com/google/uuid"
+)
+
+// ResumeDuplicate is the model entity for the ResumeDuplicate schema.
+type ResumeDuplicate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// 触发检测的简历ID
+	ResumeID uuid.UUID `json:"resume_id,omitempty"`
+	// 疑似重复的已有简历ID
+	DuplicateResumeID uuid.UUID `json:"duplicate_resume_id,omitempty"`
+	// 重复相似度 0-1
+	Score float64 `json:"score,omitempty"`
+	// 判定依据：file_hash/phone/email/name/education/experience
+	Reasons []string `json:"reasons,omitempty"`
+	// 处理状态：pending/merged/dismissed
+	Status consts.ResumeDuplicateStatus `json:"status,omitempty"`
+	// 处理人ID
+	ReviewedBy *uuid.UUID `json:"reviewed_by,omitempty"`
+	// 处理时间
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ResumeDuplicateQuery when eager-loading is set.
+	Edges        ResumeDuplicateEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ResumeDuplicateEdges holds the relations/edges for other nodes in the graph.
+type ResumeDuplicateEdges struct {
+	// Resume holds the value of the resume edge.
+	Resume *Resume `json:"resume,omitempty"`
+	// DuplicateResume holds the value of the duplicate_resume edge.
+	DuplicateResume *Resume `json:"duplicate_resume,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ResumeOrErr returns the Resume value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResumeDuplicateEdges) ResumeOrErr() (*Resume, error) {
+	if e.Resume != nil {
+		return e.Resume, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: resume.Label}
+	}
+	return nil, &NotLoadedError{edge: "resume"}
+}
+
+// DuplicateResumeOrErr returns the DuplicateResume value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResumeDuplicateEdges) DuplicateResumeOrErr() (*Resume, error) {
+	if e.DuplicateResume != nil {
+		return e.DuplicateResume, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: resume.Label}
+	}
+	return nil, &NotLoadedError{edge: "duplicate_resume"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ResumeDuplicate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case resumeduplicate.FieldReviewedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case resumeduplicate.FieldReasons:
+			values[i] = new([]byte)
+		case resumeduplicate.FieldScore:
+			values[i] = new(sql.NullFloat64)
+		case resumeduplicate.FieldStatus:
+			values[i] = new(sql.NullString)
+		case resumeduplicate.FieldReviewedAt, resumeduplicate.FieldCreatedAt, resumeduplicate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case resumeduplicate.FieldID, resumeduplicate.FieldResumeID, resumeduplicate.FieldDuplicateResumeID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ResumeDuplicate fields.
+func (rd *ResumeDuplicate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case resumeduplicate.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rd.ID = *value
+			}
+		case resumeduplicate.FieldResumeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field resume_id", values[i])
+			} else if value != nil {
+				rd.ResumeID = *value
+			}
+		case resumeduplicate.FieldDuplicateResumeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field duplicate_resume_id", values[i])
+			} else if value != nil {
+				rd.DuplicateResumeID = *value
+			}
+		case resumeduplicate.FieldScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				rd.Score = value.Float64
+			}
+		case resumeduplicate.FieldReasons:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field reasons", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rd.Reasons); err != nil {
+					return fmt.Errorf("unmarshal field reasons: %w", err)
+				}
+			}
+		case resumeduplicate.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				rd.Status = consts.ResumeDuplicateStatus(value.String)
+			}
+		case resumeduplicate.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				rd.ReviewedBy = new(uuid.UUID)
+				*rd.ReviewedBy = *value.S.(*uuid.UUID)
+			}
+		case resumeduplicate.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				rd.ReviewedAt = new(time.Time)
+				*rd.ReviewedAt = value.Time
+			}
+		case resumeduplicate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rd.CreatedAt = value.Time
+			}
+		case resumeduplicate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rd.UpdatedAt = value.Time
+			}
+		default:
+			rd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ResumeDuplicate.
+// This includes values selected through modifiers, order, etc.
+func (rd *ResumeDuplicate) Value(name string) (ent.Value, error) {
+	return rd.selectValues.Get(name)
+}
+
+// QueryResume queries the "resume" edge of the ResumeDuplicate entity.
+func (rd *ResumeDuplicate) QueryResume() *ResumeQuery {
+	return NewResumeDuplicateClient(rd.config).QueryResume(rd)
+}
+
+// QueryDuplicateResume queries the "duplicate_resume" edge of the ResumeDuplicate entity.
+func (rd *ResumeDuplicate) QueryDuplicateResume() *ResumeQuery {
+	return NewResumeDuplicateClient(rd.config).QueryDuplicateResume(rd)
+}
+
+// Update returns a builder for updating this ResumeDuplicate.
+// Note that you need to call ResumeDuplicate.Unwrap() before calling this method if this ResumeDuplicate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rd *ResumeDuplicate) Update() *ResumeDuplicateUpdateOne {
+	return NewResumeDuplicateClient(rd.config).UpdateOne(rd)
+}
+
+// Unwrap unwraps the ResumeDuplicate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rd *ResumeDuplicate) Unwrap() *ResumeDuplicate {
+	_tx, ok := rd.config.driver.(*txDriver)
+	if !ok {
+		panic("db: ResumeDuplicate is not a transactional entity")
+	}
+	rd.config.driver = _tx.drv
+	return rd
+}
+
+// String implements the fmt.Stringer.
+func (rd *ResumeDuplicate) String() string {
+	var builder strings.Builder
+	builder.WriteString("ResumeDuplicate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rd.ID))
+	builder.WriteString("resume_id=")
+	builder.WriteString(fmt.Sprintf("%v", rd.ResumeID))
+	builder.WriteString(", ")
+	builder.WriteString("duplicate_resume_id=")
+	builder.WriteString(fmt.Sprintf("%v", rd.DuplicateResumeID))
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", rd.Score))
+	builder.WriteString(", ")
+	builder.WriteString("reasons=")
+	builder.WriteString(fmt.Sprintf("%v", rd.Reasons))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", rd.Status))
+	builder.WriteString(", ")
+	if v := rd.ReviewedBy; v != nil {
+		builder.WriteString("reviewed_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := rd.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rd.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rd.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ResumeDuplicates is a parsable slice of ResumeDuplicate.
+type ResumeDuplicates []*ResumeDuplicate
@@ -0,0 +1,514 @@
+// Code generated by ent, DO NOT EDIT.
+
+package resumeduplicate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldLTE(FieldID, id))
+}
+
+// ResumeID applies equality check predicate on the "resume_id" field. It's identical to ResumeIDEQ.
+func ResumeID(v uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldResumeID, v))
+}
+
+// DuplicateResumeID applies equality check predicate on the "duplicate_resume_id" field. It's identical to DuplicateResumeIDEQ.
+func DuplicateResumeID(v uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldDuplicateResumeID, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v float64) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldScore, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v consts.ResumeDuplicateStatus) predicate.ResumeDuplicate {
+	vc := string(v)
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldStatus, vc))
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ResumeIDEQ applies the EQ predicate on the "resume_id" field.
+func ResumeIDEQ(v uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldResumeID, v))
+}
+
+// ResumeIDNEQ applies the NEQ predicate on the "resume_id" field.
+func ResumeIDNEQ(v uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNEQ(FieldResumeID, v))
+}
+
+// ResumeIDIn applies the In predicate on the "resume_id" field.
+func ResumeIDIn(vs ...uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldIn(FieldResumeID, vs...))
+}
+
+// ResumeIDNotIn applies the NotIn predicate on the "resume_id" field.
+func ResumeIDNotIn(vs ...uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNotIn(FieldResumeID, vs...))
+}
+
+// DuplicateResumeIDEQ applies the EQ predicate on the "duplicate_resume_id" field.
+func DuplicateResumeIDEQ(v uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldDuplicateResumeID, v))
+}
+
+// DuplicateResumeIDNEQ applies the NEQ predicate on the "duplicate_resume_id" field.
+func DuplicateResumeIDNEQ(v uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNEQ(FieldDuplicateResumeID, v))
+}
+
+// DuplicateResumeIDIn applies the In predicate on the "duplicate_resume_id" field.
+func DuplicateResumeIDIn(vs ...uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldIn(FieldDuplicateResumeID, vs...))
+}
+
+// DuplicateResumeIDNotIn applies the NotIn predicate on the "duplicate_resume_id" field.
+func DuplicateResumeIDNotIn(vs ...uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNotIn(FieldDuplicateResumeID, vs...))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v float64) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v float64) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...float64) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...float64) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v float64) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v float64) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v float64) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v float64) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldLTE(FieldScore, v))
+}
+
+// ReasonsIsNil applies the IsNil predicate on the "reasons" field.
+func ReasonsIsNil() predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldIsNull(FieldReasons))
+}
+
+// ReasonsNotNil applies the NotNil predicate on the "reasons" field.
+func ReasonsNotNil() predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNotNull(FieldReasons))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v consts.ResumeDuplicateStatus) predicate.ResumeDuplicate {
+	vc := string(v)
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v consts.ResumeDuplicateStatus) predicate.ResumeDuplicate {
+	vc := string(v)
+	return predicate.ResumeDuplicate(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...consts.ResumeDuplicateStatus) predicate.ResumeDuplicate {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.ResumeDuplicate(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...consts.ResumeDuplicateStatus) predicate.ResumeDuplicate {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.ResumeDuplicate(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v consts.ResumeDuplicateStatus) predicate.ResumeDuplicate {
+	vc := string(v)
+	return predicate.ResumeDuplicate(sql.FieldGT(FieldStatus, vc))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v consts.ResumeDuplicateStatus) predicate.ResumeDuplicate {
+	vc := string(v)
+	return predicate.ResumeDuplicate(sql.FieldGTE(FieldStatus, vc))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v consts.ResumeDuplicateStatus) predicate.ResumeDuplicate {
+	vc := string(v)
+	return predicate.ResumeDuplicate(sql.FieldLT(FieldStatus, vc))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v consts.ResumeDuplicateStatus) predicate.ResumeDuplicate {
+	vc := string(v)
+	return predicate.ResumeDuplicate(sql.FieldLTE(FieldStatus, vc))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v consts.ResumeDuplicateStatus) predicate.ResumeDuplicate {
+	vc := string(v)
+	return predicate.ResumeDuplicate(sql.FieldContains(FieldStatus, vc))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v consts.ResumeDuplicateStatus) predicate.ResumeDuplicate {
+	vc := string(v)
+	return predicate.ResumeDuplicate(sql.FieldHasPrefix(FieldStatus, vc))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v consts.ResumeDuplicateStatus) predicate.ResumeDuplicate {
+	vc := string(v)
+	return predicate.ResumeDuplicate(sql.FieldHasSuffix(FieldStatus, vc))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v consts.ResumeDuplicateStatus) predicate.ResumeDuplicate {
+	vc := string(v)
+	return predicate.ResumeDuplicate(sql.FieldEqualFold(FieldStatus, vc))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v consts.ResumeDuplicateStatus) predicate.ResumeDuplicate {
+	vc := string(v)
+	return predicate.ResumeDuplicate(sql.FieldContainsFold(FieldStatus, vc))
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNotIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldGT(FieldReviewedBy, v))
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldGTE(FieldReviewedBy, v))
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldLT(FieldReviewedBy, v))
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v uuid.UUID) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldLTE(FieldReviewedBy, v))
+}
+
+// ReviewedByIsNil applies the IsNil predicate on the "reviewed_by" field.
+func ReviewedByIsNil() predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldIsNull(FieldReviewedBy))
+}
+
+// ReviewedByNotNil applies the NotNil predicate on the "reviewed_by" field.
+func ReviewedByNotNil() predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNotNull(FieldReviewedBy))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasResume applies the HasEdge predicate on the "resume" edge.
+func HasResume() predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ResumeTable, ResumeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResumeWith applies the HasEdge predicate on the "resume" edge with a given conditions (other predicates).
+func HasResumeWith(preds ...predicate.Resume) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(func(s *sql.Selector) {
+		step := newResumeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDuplicateResume applies the HasEdge predicate on the "duplicate_resume" edge.
+func HasDuplicateResume() predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DuplicateResumeTable, DuplicateResumeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDuplicateResumeWith applies the HasEdge predicate on the "duplicate_resume" edge with a given conditions (other predicates).
+func HasDuplicateResumeWith(preds ...predicate.Resume) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(func(s *sql.Selector) {
+		step := newDuplicateResumeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ResumeDuplicate) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ResumeDuplicate) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ResumeDuplicate) predicate.ResumeDuplicate {
+	return predicate.ResumeDuplicate(sql.NotPredicates(p))
+}
@@ -0,0 +1,1066 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumeduplicate"
+	"github.com/google/uuid"
+)
+
+// ResumeDuplicateCreate is the builder for creating a ResumeDuplicate entity.
+type ResumeDuplicateCreate struct {
+	config
+	mutation *ResumeDuplicateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetResumeID sets the "resume_id" field.
+func (rdc *ResumeDuplicateCreate) SetResumeID(u uuid.UUID) *ResumeDuplicateCreate {
+	rdc.mutation.SetResumeID(u)
+	return rdc
+}
+
+// SetDuplicateResumeID sets the "duplicate_resume_id" field.
+func (rdc *ResumeDuplicateCreate) SetDuplicateResumeID(u uuid.UUID) *ResumeDuplicateCreate {
+	rdc.mutation.SetDuplicateResumeID(u)
+	return rdc
+}
+
+// SetScore sets the "score" field.
+func (rdc *ResumeDuplicateCreate) SetScore(f float64) *ResumeDuplicateCreate {
+	rdc.mutation.SetScore(f)
+	return rdc
+}
+
+// SetReasons sets the "reasons" field.
+func (rdc *ResumeDuplicateCreate) SetReasons(s []string) *ResumeDuplicateCreate {
+	rdc.mutation.SetReasons(s)
+	return rdc
+}
+
+// SetStatus sets the "status" field.
+func (rdc *ResumeDuplicateCreate) SetStatus(cds consts.ResumeDuplicateStatus) *ResumeDuplicateCreate {
+	rdc.mutation.SetStatus(cds)
+	return rdc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rdc *ResumeDuplicateCreate) SetNillableStatus(cds *consts.ResumeDuplicateStatus) *ResumeDuplicateCreate {
+	if cds != nil {
+		rdc.SetStatus(*cds)
+	}
+	return rdc
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (rdc *ResumeDuplicateCreate) SetReviewedBy(u uuid.UUID) *ResumeDuplicateCreate {
+	rdc.mutation.SetReviewedBy(u)
+	return rdc
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (rdc *ResumeDuplicateCreate) SetNillableReviewedBy(u *uuid.UUID) *ResumeDuplicateCreate {
+	if u != nil {
+		rdc.SetReviewedBy(*u)
+	}
+	return rdc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (rdc *ResumeDuplicateCreate) SetReviewedAt(t time.Time) *ResumeDuplicateCreate {
+	rdc.mutation.SetReviewedAt(t)
+	return rdc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (rdc *ResumeDuplicateCreate) SetNillableReviewedAt(t *time.Time) *ResumeDuplicateCreate {
+	if t != nil {
+		rdc.SetReviewedAt(*t)
+	}
+	return rdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rdc *ResumeDuplicateCreate) SetCreatedAt(t time.Time) *ResumeDuplicateCreate {
+	rdc.mutation.SetCreatedAt(t)
+	return rdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rdc *ResumeDuplicateCreate) SetNillableCreatedAt(t *time.Time) *ResumeDuplicateCreate {
+	if t != nil {
+		rdc.SetCreatedAt(*t)
+	}
+	return rdc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rdc *ResumeDuplicateCreate) SetUpdatedAt(t time.Time) *ResumeDuplicateCreate {
+	rdc.mutation.SetUpdatedAt(t)
+	return rdc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rdc *ResumeDuplicateCreate) SetNillableUpdatedAt(t *time.Time) *ResumeDuplicateCreate {
+	if t != nil {
+		rdc.SetUpdatedAt(*t)
+	}
+	return rdc
+}
+
+// SetID sets the "id" field.
+func (rdc *ResumeDuplicateCreate) SetID(u uuid.UUID) *ResumeDuplicateCreate {
+	rdc.mutation.SetID(u)
+	return rdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rdc *ResumeDuplicateCreate) SetNillableID(u *uuid.UUID) *ResumeDuplicateCreate {
+	if u != nil {
+		rdc.SetID(*u)
+	}
+	return rdc
+}
+
+// SetResume sets the "resume" edge to the Resume entity.
+func (rdc *ResumeDuplicateCreate) SetResume(r *Resume) *ResumeDuplicateCreate {
+	return rdc.SetResumeID(r.ID)
+}
+
+// SetDuplicateResume sets the "duplicate_resume" edge to the Resume entity.
+func (rdc *ResumeDuplicateCreate) SetDuplicateResume(r *Resume) *ResumeDuplicateCreate {
+	return rdc.SetDuplicateResumeID(r.ID)
+}
+
+// Mutation returns the ResumeDuplicateMutation object of the builder.
+func (rdc *ResumeDuplicateCreate) Mutation() *ResumeDuplicateMutation {
+	return rdc.mutation
+}
+
+// Save creates the ResumeDuplicate in the database.
+func (rdc *ResumeDuplicateCreate) Save(ctx context.Context) (*ResumeDuplicate, error) {
+	rdc.defaults()
+	return withHooks(ctx, rdc.sqlSave, rdc.mutation, rdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rdc *ResumeDuplicateCreate) SaveX(ctx context.Context) *ResumeDuplicate {
+	v, err := rdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rdc *ResumeDuplicateCreate) Exec(ctx context.Context) error {
+	_, err := rdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdc *ResumeDuplicateCreate) ExecX(ctx context.Context) {
+	if err := rdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rdc *ResumeDuplicateCreate) defaults() {
+	if _, ok := rdc.mutation.Status(); !ok {
+		v := resumeduplicate.DefaultStatus
+		rdc.mutation.SetStatus(v)
+	}
+	if _, ok := rdc.mutation.CreatedAt(); !ok {
+		v := resumeduplicate.DefaultCreatedAt()
+		rdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rdc.mutation.UpdatedAt(); !ok {
+		v := resumeduplicate.DefaultUpdatedAt()
+		rdc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rdc.mutation.ID(); !ok {
+		v := resumeduplicate.DefaultID()
+		rdc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rdc *ResumeDuplicateCreate) check() error {
+	if _, ok := rdc.mutation.ResumeID(); !ok {
+		return &ValidationError{Name: "resume_id", err: errors.New(`db: missing required field "ResumeDuplicate.resume_id"`)}
+	}
+	if _, ok := rdc.mutation.DuplicateResumeID(); !ok {
+		return &ValidationError{Name: "duplicate_resume_id", err: errors.New(`db: missing required field "ResumeDuplicate.duplicate_resume_id"`)}
+	}
+	if _, ok := rdc.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`db: missing required field "ResumeDuplicate.score"`)}
+	}
+	if _, ok := rdc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`db: missing required field "ResumeDuplicate.status"`)}
+	}
+	if _, ok := rdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "ResumeDuplicate.created_at"`)}
+	}
+	if _, ok := rdc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "ResumeDuplicate.updated_at"`)}
+	}
+	if len(rdc.mutation.ResumeIDs()) == 0 {
+		return &ValidationError{Name: "resume", err: errors.New(`db: missing required edge "ResumeDuplicate.resume"`)}
+	}
+	if len(rdc.mutation.DuplicateResumeIDs()) == 0 {
+		return &ValidationError{Name: "duplicate_resume", err: errors.New(`db: missing required edge "ResumeDuplicate.duplicate_resume"`)}
+	}
+	return nil
+}
+
+func (rdc *ResumeDuplicateCreate) sqlSave(ctx context.Context) (*ResumeDuplicate, error) {
+	if err := rdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rdc.mutation.id = &_node.ID
+	rdc.mutation.done = true
+	return _node, nil
+}
+
+func (rdc *ResumeDuplicateCreate) createSpec() (*ResumeDuplicate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ResumeDuplicate{config: rdc.config}
+		_spec = sqlgraph.NewCreateSpec(resumeduplicate.Table, sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = rdc.conflict
+	if id, ok := rdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rdc.mutation.Score(); ok {
+		_spec.SetField(resumeduplicate.FieldScore, field.TypeFloat64, value)
+		_node.Score = value
+	}
+	if value, ok := rdc.mutation.Reasons(); ok {
+		_spec.SetField(resumeduplicate.FieldReasons, field.TypeJSON, value)
+		_node.Reasons = value
+	}
+	if value, ok := rdc.mutation.Status(); ok {
+		_spec.SetField(resumeduplicate.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := rdc.mutation.ReviewedBy(); ok {
+		_spec.SetField(resumeduplicate.FieldReviewedBy, field.TypeUUID, value)
+		_node.ReviewedBy = &value
+	}
+	if value, ok := rdc.mutation.ReviewedAt(); ok {
+		_spec.SetField(resumeduplicate.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := rdc.mutation.CreatedAt(); ok {
+		_spec.SetField(resumeduplicate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rdc.mutation.UpdatedAt(); ok {
+		_spec.SetField(resumeduplicate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := rdc.mutation.ResumeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumeduplicate.ResumeTable,
+			Columns: []string{resumeduplicate.ResumeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resume.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ResumeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rdc.mutation.DuplicateResumeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumeduplicate.DuplicateResumeTable,
+			Columns: []string{resumeduplicate.DuplicateResumeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resume.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DuplicateResumeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResumeDuplicate.Create().
+//		SetResumeID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResumeDuplicateUpsert) {
+//			SetResumeID(v+v).
+//		}).
+//		Exec(ctx)
+func (rdc *ResumeDuplicateCreate) OnConflict(opts ...sql.ConflictOption) *ResumeDuplicateUpsertOne {
+	rdc.conflict = opts
+	return &ResumeDuplicateUpsertOne{
+		create: rdc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResumeDuplicate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rdc *ResumeDuplicateCreate) OnConflictColumns(columns ...string) *ResumeDuplicateUpsertOne {
+	rdc.conflict = append(rdc.conflict, sql.ConflictColumns(columns...))
+	return &ResumeDuplicateUpsertOne{
+		create: rdc,
+	}
+}
+
+type (
+	// ResumeDuplicateUpsertOne is the builder for "upsert"-ing
+	//  one ResumeDuplicate node.
+	ResumeDuplicateUpsertOne struct {
+		create *ResumeDuplicateCreate
+	}
+
+	// ResumeDuplicateUpsert is the "OnConflict" setter.
+	ResumeDuplicateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetResumeID sets the "resume_id" field.
+func (u *ResumeDuplicateUpsert) SetResumeID(v uuid.UUID) *ResumeDuplicateUpsert {
+	u.Set(resumeduplicate.FieldResumeID, v)
+	return u
+}
+
+// UpdateResumeID sets the "resume_id" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsert) UpdateResumeID() *ResumeDuplicateUpsert {
+	u.SetExcluded(resumeduplicate.FieldResumeID)
+	return u
+}
+
+// SetDuplicateResumeID sets the "duplicate_resume_id" field.
+func (u *ResumeDuplicateUpsert) SetDuplicateResumeID(v uuid.UUID) *ResumeDuplicateUpsert {
+	u.Set(resumeduplicate.FieldDuplicateResumeID, v)
+	return u
+}
+
+// UpdateDuplicateResumeID sets the "duplicate_resume_id" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsert) UpdateDuplicateResumeID() *ResumeDuplicateUpsert {
+	u.SetExcluded(resumeduplicate.FieldDuplicateResumeID)
+	return u
+}
+
+// SetScore sets the "score" field.
+func (u *ResumeDuplicateUpsert) SetScore(v float64) *ResumeDuplicateUpsert {
+	u.Set(resumeduplicate.FieldScore, v)
+	return u
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsert) UpdateScore() *ResumeDuplicateUpsert {
+	u.SetExcluded(resumeduplicate.FieldScore)
+	return u
+}
+
+// AddScore adds v to the "score" field.
+func (u *ResumeDuplicateUpsert) AddScore(v float64) *ResumeDuplicateUpsert {
+	u.Add(resumeduplicate.FieldScore, v)
+	return u
+}
+
+// SetReasons sets the "reasons" field.
+func (u *ResumeDuplicateUpsert) SetReasons(v []string) *ResumeDuplicateUpsert {
+	u.Set(resumeduplicate.FieldReasons, v)
+	return u
+}
+
+// UpdateReasons sets the "reasons" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsert) UpdateReasons() *ResumeDuplicateUpsert {
+	u.SetExcluded(resumeduplicate.FieldReasons)
+	return u
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (u *ResumeDuplicateUpsert) ClearReasons() *ResumeDuplicateUpsert {
+	u.SetNull(resumeduplicate.FieldReasons)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ResumeDuplicateUpsert) SetStatus(v consts.ResumeDuplicateStatus) *ResumeDuplicateUpsert {
+	u.Set(resumeduplicate.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsert) UpdateStatus() *ResumeDuplicateUpsert {
+	u.SetExcluded(resumeduplicate.FieldStatus)
+	return u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *ResumeDuplicateUpsert) SetReviewedBy(v uuid.UUID) *ResumeDuplicateUpsert {
+	u.Set(resumeduplicate.FieldReviewedBy, v)
+	return u
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsert) UpdateReviewedBy() *ResumeDuplicateUpsert {
+	u.SetExcluded(resumeduplicate.FieldReviewedBy)
+	return u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *ResumeDuplicateUpsert) ClearReviewedBy() *ResumeDuplicateUpsert {
+	u.SetNull(resumeduplicate.FieldReviewedBy)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ResumeDuplicateUpsert) SetReviewedAt(v time.Time) *ResumeDuplicateUpsert {
+	u.Set(resumeduplicate.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsert) UpdateReviewedAt() *ResumeDuplicateUpsert {
+	u.SetExcluded(resumeduplicate.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ResumeDuplicateUpsert) ClearReviewedAt() *ResumeDuplicateUpsert {
+	u.SetNull(resumeduplicate.FieldReviewedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ResumeDuplicateUpsert) SetUpdatedAt(v time.Time) *ResumeDuplicateUpsert {
+	u.Set(resumeduplicate.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsert) UpdateUpdatedAt() *ResumeDuplicateUpsert {
+	u.SetExcluded(resumeduplicate.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ResumeDuplicate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(resumeduplicate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ResumeDuplicateUpsertOne) UpdateNewValues() *ResumeDuplicateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(resumeduplicate.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(resumeduplicate.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResumeDuplicate.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ResumeDuplicateUpsertOne) Ignore() *ResumeDuplicateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResumeDuplicateUpsertOne) DoNothing() *ResumeDuplicateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResumeDuplicateCreate.OnConflict
+// documentation for more info.
+func (u *ResumeDuplicateUpsertOne) Update(set func(*ResumeDuplicateUpsert)) *ResumeDuplicateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResumeDuplicateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetResumeID sets the "resume_id" field.
+func (u *ResumeDuplicateUpsertOne) SetResumeID(v uuid.UUID) *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.SetResumeID(v)
+	})
+}
+
+// UpdateResumeID sets the "resume_id" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsertOne) UpdateResumeID() *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.UpdateResumeID()
+	})
+}
+
+// SetDuplicateResumeID sets the "duplicate_resume_id" field.
+func (u *ResumeDuplicateUpsertOne) SetDuplicateResumeID(v uuid.UUID) *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.SetDuplicateResumeID(v)
+	})
+}
+
+// UpdateDuplicateResumeID sets the "duplicate_resume_id" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsertOne) UpdateDuplicateResumeID() *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.UpdateDuplicateResumeID()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *ResumeDuplicateUpsertOne) SetScore(v float64) *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *ResumeDuplicateUpsertOne) AddScore(v float64) *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsertOne) UpdateScore() *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// SetReasons sets the "reasons" field.
+func (u *ResumeDuplicateUpsertOne) SetReasons(v []string) *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.SetReasons(v)
+	})
+}
+
+// UpdateReasons sets the "reasons" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsertOne) UpdateReasons() *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.UpdateReasons()
+	})
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (u *ResumeDuplicateUpsertOne) ClearReasons() *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.ClearReasons()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ResumeDuplicateUpsertOne) SetStatus(v consts.ResumeDuplicateStatus) *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsertOne) UpdateStatus() *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *ResumeDuplicateUpsertOne) SetReviewedBy(v uuid.UUID) *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsertOne) UpdateReviewedBy() *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *ResumeDuplicateUpsertOne) ClearReviewedBy() *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ResumeDuplicateUpsertOne) SetReviewedAt(v time.Time) *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsertOne) UpdateReviewedAt() *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ResumeDuplicateUpsertOne) ClearReviewedAt() *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ResumeDuplicateUpsertOne) SetUpdatedAt(v time.Time) *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsertOne) UpdateUpdatedAt() *ResumeDuplicateUpsertOne {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ResumeDuplicateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for ResumeDuplicateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResumeDuplicateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ResumeDuplicateUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: ResumeDuplicateUpsertOne.ID is not supported by MySQL driver. Use ResumeDuplicateUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ResumeDuplicateUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ResumeDuplicateCreateBulk is the builder for creating many ResumeDuplicate entities in bulk.
+type ResumeDuplicateCreateBulk struct {
+	config
+	err      error
+	builders []*ResumeDuplicateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ResumeDuplicate entities in the database.
+func (rdcb *ResumeDuplicateCreateBulk) Save(ctx context.Context) ([]*ResumeDuplicate, error) {
+	if rdcb.err != nil {
+		return nil, rdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rdcb.builders))
+	nodes := make([]*ResumeDuplicate, len(rdcb.builders))
+	mutators := make([]Mutator, len(rdcb.builders))
+	for i := range rdcb.builders {
+		func(i int, root context.Context) {
+			builder := rdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResumeDuplicateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rdcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rdcb *ResumeDuplicateCreateBulk) SaveX(ctx context.Context) []*ResumeDuplicate {
+	v, err := rdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rdcb *ResumeDuplicateCreateBulk) Exec(ctx context.Context) error {
+	_, err := rdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdcb *ResumeDuplicateCreateBulk) ExecX(ctx context.Context) {
+	if err := rdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResumeDuplicate.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResumeDuplicateUpsert) {
+//			SetResumeID(v+v).
+//		}).
+//		Exec(ctx)
+func (rdcb *ResumeDuplicateCreateBulk) OnConflict(opts ...sql.ConflictOption) *ResumeDuplicateUpsertBulk {
+	rdcb.conflict = opts
+	return &ResumeDuplicateUpsertBulk{
+		create: rdcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResumeDuplicate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rdcb *ResumeDuplicateCreateBulk) OnConflictColumns(columns ...string) *ResumeDuplicateUpsertBulk {
+	rdcb.conflict = append(rdcb.conflict, sql.ConflictColumns(columns...))
+	return &ResumeDuplicateUpsertBulk{
+		create: rdcb,
+	}
+}
+
+// ResumeDuplicateUpsertBulk is the builder for "upsert"-ing
+// a bulk of ResumeDuplicate nodes.
+type ResumeDuplicateUpsertBulk struct {
+	create *ResumeDuplicateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ResumeDuplicate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(resumeduplicate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ResumeDuplicateUpsertBulk) UpdateNewValues() *ResumeDuplicateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(resumeduplicate.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(resumeduplicate.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResumeDuplicate.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ResumeDuplicateUpsertBulk) Ignore() *ResumeDuplicateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResumeDuplicateUpsertBulk) DoNothing() *ResumeDuplicateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResumeDuplicateCreateBulk.OnConflict
+// documentation for more info.
+func (u *ResumeDuplicateUpsertBulk) Update(set func(*ResumeDuplicateUpsert)) *ResumeDuplicateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResumeDuplicateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetResumeID sets the "resume_id" field.
+func (u *ResumeDuplicateUpsertBulk) SetResumeID(v uuid.UUID) *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.SetResumeID(v)
+	})
+}
+
+// UpdateResumeID sets the "resume_id" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsertBulk) UpdateResumeID() *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.UpdateResumeID()
+	})
+}
+
+// SetDuplicateResumeID sets the "duplicate_resume_id" field.
+func (u *ResumeDuplicateUpsertBulk) SetDuplicateResumeID(v uuid.UUID) *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.SetDuplicateResumeID(v)
+	})
+}
+
+// UpdateDuplicateResumeID sets the "duplicate_resume_id" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsertBulk) UpdateDuplicateResumeID() *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.UpdateDuplicateResumeID()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *ResumeDuplicateUpsertBulk) SetScore(v float64) *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *ResumeDuplicateUpsertBulk) AddScore(v float64) *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsertBulk) UpdateScore() *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// SetReasons sets the "reasons" field.
+func (u *ResumeDuplicateUpsertBulk) SetReasons(v []string) *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.SetReasons(v)
+	})
+}
+
+// UpdateReasons sets the "reasons" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsertBulk) UpdateReasons() *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.UpdateReasons()
+	})
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (u *ResumeDuplicateUpsertBulk) ClearReasons() *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.ClearReasons()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ResumeDuplicateUpsertBulk) SetStatus(v consts.ResumeDuplicateStatus) *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsertBulk) UpdateStatus() *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *ResumeDuplicateUpsertBulk) SetReviewedBy(v uuid.UUID) *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsertBulk) UpdateReviewedBy() *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *ResumeDuplicateUpsertBulk) ClearReviewedBy() *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ResumeDuplicateUpsertBulk) SetReviewedAt(v time.Time) *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsertBulk) UpdateReviewedAt() *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ResumeDuplicateUpsertBulk) ClearReviewedAt() *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ResumeDuplicateUpsertBulk) SetUpdatedAt(v time.Time) *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ResumeDuplicateUpsertBulk) UpdateUpdatedAt() *ResumeDuplicateUpsertBulk {
+	return u.Update(func(s *ResumeDuplicateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ResumeDuplicateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the ResumeDuplicateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for ResumeDuplicateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResumeDuplicateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
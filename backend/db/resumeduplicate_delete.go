@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/WhaleHire/backend/db/predicate"
+	"github.com/chaitin/WhaleHire/backend/db/resumeduplicate"
+)
+
+// ResumeDuplicateDelete is the builder for deleting a ResumeDuplicate entity.
+type ResumeDuplicateDelete struct {
+	config
+	hooks    []Hook
+	mutation *ResumeDuplicateMutation
+}
+
+// Where appends a list predicates to the ResumeDuplicateDelete builder.
+func (rdd *ResumeDuplicateDelete) Where(ps ...predicate.ResumeDuplicate) *ResumeDuplicateDelete {
+	rdd.mutation.Where(ps...)
+	return rdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rdd *ResumeDuplicateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rdd.sqlExec, rdd.mutation, rdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdd *ResumeDuplicateDelete) ExecX(ctx context.Context) int {
+	n, err := rdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rdd *ResumeDuplicateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(resumeduplicate.Table, sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID))
+	if ps := rdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rdd.mutation.done = true
+	return affected, err
+}
+
+// ResumeDuplicateDeleteOne is the builder for deleting a single ResumeDuplicate entity.
+type ResumeDuplicateDeleteOne struct {
+	rdd *ResumeDuplicateDelete
+}
+
+// Where appends a list predicates to the ResumeDuplicateDelete builder.
+func (rddo *ResumeDuplicateDeleteOne) Where(ps ...predicate.ResumeDuplicate) *ResumeDuplicateDeleteOne {
+	rddo.rdd.mutation.Where(ps...)
+	return rddo
+}
+
+// Exec executes the deletion query.
+func (rddo *ResumeDuplicateDeleteOne) Exec(ctx context.Context) error {
+	n, err := rddo.rdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{resumeduplicate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rddo *ResumeDuplicateDeleteOne) ExecX(ctx context.Context) {
+	if err := rddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
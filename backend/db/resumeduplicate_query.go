@@ -0,0 +1,731 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/WhaleHire/backend/db/predicate"
+	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumeduplicate"
+	"github.com/google/uuid"
+)
+
+// ResumeDuplicateQuery is the builder for querying ResumeDuplicate entities.
+type ResumeDuplicateQuery struct {
+	config
+	ctx                 *QueryContext
+	order               []resumeduplicate.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.ResumeDuplicate
+	withResume          *ResumeQuery
+	withDuplicateResume *ResumeQuery
+	modifiers           []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ResumeDuplicateQuery builder.
+func (rdq *ResumeDuplicateQuery) Where(ps ...predicate.ResumeDuplicate) *ResumeDuplicateQuery {
+	rdq.predicates = append(rdq.predicates, ps...)
+	return rdq
+}
+
+// Limit the number of records to be returned by this query.
+func (rdq *ResumeDuplicateQuery) Limit(limit int) *ResumeDuplicateQuery {
+	rdq.ctx.Limit = &limit
+	return rdq
+}
+
+// Offset to start from.
+func (rdq *ResumeDuplicateQuery) Offset(offset int) *ResumeDuplicateQuery {
+	rdq.ctx.Offset = &offset
+	return rdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rdq *ResumeDuplicateQuery) Unique(unique bool) *ResumeDuplicateQuery {
+	rdq.ctx.Unique = &unique
+	return rdq
+}
+
+// Order specifies how the records should be ordered.
+func (rdq *ResumeDuplicateQuery) Order(o ...resumeduplicate.OrderOption) *ResumeDuplicateQuery {
+	rdq.order = append(rdq.order, o...)
+	return rdq
+}
+
+// QueryResume chains the current query on the "resume" edge.
+func (rdq *ResumeDuplicateQuery) QueryResume() *ResumeQuery {
+	query := (&ResumeClient{config: rdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resumeduplicate.Table, resumeduplicate.FieldID, selector),
+			sqlgraph.To(resume.Table, resume.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resumeduplicate.ResumeTable, resumeduplicate.ResumeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDuplicateResume chains the current query on the "duplicate_resume" edge.
+func (rdq *ResumeDuplicateQuery) QueryDuplicateResume() *ResumeQuery {
+	query := (&ResumeClient{config: rdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resumeduplicate.Table, resumeduplicate.FieldID, selector),
+			sqlgraph.To(resume.Table, resume.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resumeduplicate.DuplicateResumeTable, resumeduplicate.DuplicateResumeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ResumeDuplicate entity from the query.
+// Returns a *NotFoundError when no ResumeDuplicate was found.
+func (rdq *ResumeDuplicateQuery) First(ctx context.Context) (*ResumeDuplicate, error) {
+	nodes, err := rdq.Limit(1).All(setContextOp(ctx, rdq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{resumeduplicate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rdq *ResumeDuplicateQuery) FirstX(ctx context.Context) *ResumeDuplicate {
+	node, err := rdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ResumeDuplicate ID from the query.
+// Returns a *NotFoundError when no ResumeDuplicate ID was found.
+func (rdq *ResumeDuplicateQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rdq.Limit(1).IDs(setContextOp(ctx, rdq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{resumeduplicate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rdq *ResumeDuplicateQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := rdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ResumeDuplicate entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ResumeDuplicate entity is found.
+// Returns a *NotFoundError when no ResumeDuplicate entities are found.
+func (rdq *ResumeDuplicateQuery) Only(ctx context.Context) (*ResumeDuplicate, error) {
+	nodes, err := rdq.Limit(2).All(setContextOp(ctx, rdq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{resumeduplicate.Label}
+	default:
+		return nil, &NotSingularError{resumeduplicate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rdq *ResumeDuplicateQuery) OnlyX(ctx context.Context) *ResumeDuplicate {
+	node, err := rdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ResumeDuplicate ID in the query.
+// Returns a *NotSingularError when more than one ResumeDuplicate ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rdq *ResumeDuplicateQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rdq.Limit(2).IDs(setContextOp(ctx, rdq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{resumeduplicate.Label}
+	default:
+		err = &NotSingularError{resumeduplicate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rdq *ResumeDuplicateQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := rdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ResumeDuplicates.
+func (rdq *ResumeDuplicateQuery) All(ctx context.Context) ([]*ResumeDuplicate, error) {
+	ctx = setContextOp(ctx, rdq.ctx, ent.OpQueryAll)
+	if err := rdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ResumeDuplicate, *ResumeDuplicateQuery]()
+	return withInterceptors[[]*ResumeDuplicate](ctx, rdq, qr, rdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rdq *ResumeDuplicateQuery) AllX(ctx context.Context) []*ResumeDuplicate {
+	nodes, err := rdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ResumeDuplicate IDs.
+func (rdq *ResumeDuplicateQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if rdq.ctx.Unique == nil && rdq.path != nil {
+		rdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rdq.ctx, ent.OpQueryIDs)
+	if err = rdq.Select(resumeduplicate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rdq *ResumeDuplicateQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := rdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rdq *ResumeDuplicateQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rdq.ctx, ent.OpQueryCount)
+	if err := rdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rdq, querierCount[*ResumeDuplicateQuery](), rdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rdq *ResumeDuplicateQuery) CountX(ctx context.Context) int {
+	count, err := rdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rdq *ResumeDuplicateQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rdq.ctx, ent.OpQueryExist)
+	switch _, err := rdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rdq *ResumeDuplicateQuery) ExistX(ctx context.Context) bool {
+	exist, err := rdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ResumeDuplicateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rdq *ResumeDuplicateQuery) Clone() *ResumeDuplicateQuery {
+	if rdq == nil {
+		return nil
+	}
+	return &ResumeDuplicateQuery{
+		config:              rdq.config,
+		ctx:                 rdq.ctx.Clone(),
+		order:               append([]resumeduplicate.OrderOption{}, rdq.order...),
+		inters:              append([]Interceptor{}, rdq.inters...),
+		predicates:          append([]predicate.ResumeDuplicate{}, rdq.predicates...),
+		withResume:          rdq.withResume.Clone(),
+		withDuplicateResume: rdq.withDuplicateResume.Clone(),
+		// clone intermediate query.
+		sql:       rdq.sql.Clone(),
+		path:      rdq.path,
+		modifiers: append([]func(*sql.Selector){}, rdq.modifiers...),
+	}
+}
+
+// WithResume tells the query-builder to eager-load the nodes that are connected to
+// the "resume" edge. The optional arguments are used to configure the query builder of the edge.
+func (rdq *ResumeDuplicateQuery) WithResume(opts ...func(*ResumeQuery)) *ResumeDuplicateQuery {
+	query := (&ResumeClient{config: rdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rdq.withResume = query
+	return rdq
+}
+
+// WithDuplicateResume tells the query-builder to eager-load the nodes that are connected to
+// the "duplicate_resume" edge. The optional arguments are used to configure the query builder of the edge.
+func (rdq *ResumeDuplicateQuery) WithDuplicateResume(opts ...func(*ResumeQuery)) *ResumeDuplicateQuery {
+	query := (&ResumeClient{config: rdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rdq.withDuplicateResume = query
+	return rdq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ResumeID uuid.UUID `json:"resume_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ResumeDuplicate.Query().
+//		GroupBy(resumeduplicate.FieldResumeID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (rdq *ResumeDuplicateQuery) GroupBy(field string, fields ...string) *ResumeDuplicateGroupBy {
+	rdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ResumeDuplicateGroupBy{build: rdq}
+	grbuild.flds = &rdq.ctx.Fields
+	grbuild.label = resumeduplicate.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ResumeID uuid.UUID `json:"resume_id,omitempty"`
+//	}
+//
+//	client.ResumeDuplicate.Query().
+//		Select(resumeduplicate.FieldResumeID).
+//		Scan(ctx, &v)
+func (rdq *ResumeDuplicateQuery) Select(fields ...string) *ResumeDuplicateSelect {
+	rdq.ctx.Fields = append(rdq.ctx.Fields, fields...)
+	sbuild := &ResumeDuplicateSelect{ResumeDuplicateQuery: rdq}
+	sbuild.label = resumeduplicate.Label
+	sbuild.flds, sbuild.scan = &rdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ResumeDuplicateSelect configured with the given aggregations.
+func (rdq *ResumeDuplicateQuery) Aggregate(fns ...AggregateFunc) *ResumeDuplicateSelect {
+	return rdq.Select().Aggregate(fns...)
+}
+
+func (rdq *ResumeDuplicateQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rdq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rdq.ctx.Fields {
+		if !resumeduplicate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if rdq.path != nil {
+		prev, err := rdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rdq.sql = prev
+	}
+	return nil
+}
+
+func (rdq *ResumeDuplicateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ResumeDuplicate, error) {
+	var (
+		nodes       = []*ResumeDuplicate{}
+		_spec       = rdq.querySpec()
+		loadedTypes = [2]bool{
+			rdq.withResume != nil,
+			rdq.withDuplicateResume != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ResumeDuplicate).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ResumeDuplicate{config: rdq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(rdq.modifiers) > 0 {
+		_spec.Modifiers = rdq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rdq.withResume; query != nil {
+		if err := rdq.loadResume(ctx, query, nodes, nil,
+			func(n *ResumeDuplicate, e *Resume) { n.Edges.Resume = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rdq.withDuplicateResume; query != nil {
+		if err := rdq.loadDuplicateResume(ctx, query, nodes, nil,
+			func(n *ResumeDuplicate, e *Resume) { n.Edges.DuplicateResume = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rdq *ResumeDuplicateQuery) loadResume(ctx context.Context, query *ResumeQuery, nodes []*ResumeDuplicate, init func(*ResumeDuplicate), assign func(*ResumeDuplicate, *Resume)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ResumeDuplicate)
+	for i := range nodes {
+		fk := nodes[i].ResumeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(resume.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "resume_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rdq *ResumeDuplicateQuery) loadDuplicateResume(ctx context.Context, query *ResumeQuery, nodes []*ResumeDuplicate, init func(*ResumeDuplicate), assign func(*ResumeDuplicate, *Resume)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ResumeDuplicate)
+	for i := range nodes {
+		fk := nodes[i].DuplicateResumeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(resume.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "duplicate_resume_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rdq *ResumeDuplicateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rdq.querySpec()
+	if len(rdq.modifiers) > 0 {
+		_spec.Modifiers = rdq.modifiers
+	}
+	_spec.Node.Columns = rdq.ctx.Fields
+	if len(rdq.ctx.Fields) > 0 {
+		_spec.Unique = rdq.ctx.Unique != nil && *rdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rdq.driver, _spec)
+}
+
+func (rdq *ResumeDuplicateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(resumeduplicate.Table, resumeduplicate.Columns, sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID))
+	_spec.From = rdq.sql
+	if unique := rdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resumeduplicate.FieldID)
+		for i := range fields {
+			if fields[i] != resumeduplicate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if rdq.withResume != nil {
+			_spec.Node.AddColumnOnce(resumeduplicate.FieldResumeID)
+		}
+		if rdq.withDuplicateResume != nil {
+			_spec.Node.AddColumnOnce(resumeduplicate.FieldDuplicateResumeID)
+		}
+	}
+	if ps := rdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rdq *ResumeDuplicateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rdq.driver.Dialect())
+	t1 := builder.Table(resumeduplicate.Table)
+	columns := rdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = resumeduplicate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rdq.sql != nil {
+		selector = rdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rdq.ctx.Unique != nil && *rdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range rdq.modifiers {
+		m(selector)
+	}
+	for _, p := range rdq.predicates {
+		p(selector)
+	}
+	for _, p := range rdq.order {
+		p(selector)
+	}
+	if offset := rdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rdq *ResumeDuplicateQuery) ForUpdate(opts ...sql.LockOption) *ResumeDuplicateQuery {
+	if rdq.driver.Dialect() == dialect.Postgres {
+		rdq.Unique(false)
+	}
+	rdq.modifiers = append(rdq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rdq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rdq *ResumeDuplicateQuery) ForShare(opts ...sql.LockOption) *ResumeDuplicateQuery {
+	if rdq.driver.Dialect() == dialect.Postgres {
+		rdq.Unique(false)
+	}
+	rdq.modifiers = append(rdq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rdq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rdq *ResumeDuplicateQuery) Modify(modifiers ...func(s *sql.Selector)) *ResumeDuplicateSelect {
+	rdq.modifiers = append(rdq.modifiers, modifiers...)
+	return rdq.Select()
+}
+
+// ResumeDuplicateGroupBy is the group-by builder for ResumeDuplicate entities.
+type ResumeDuplicateGroupBy struct {
+	selector
+	build *ResumeDuplicateQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rdgb *ResumeDuplicateGroupBy) Aggregate(fns ...AggregateFunc) *ResumeDuplicateGroupBy {
+	rdgb.fns = append(rdgb.fns, fns...)
+	return rdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rdgb *ResumeDuplicateGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rdgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResumeDuplicateQuery, *ResumeDuplicateGroupBy](ctx, rdgb.build, rdgb, rdgb.build.inters, v)
+}
+
+func (rdgb *ResumeDuplicateGroupBy) sqlScan(ctx context.Context, root *ResumeDuplicateQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rdgb.fns))
+	for _, fn := range rdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rdgb.flds)+len(rdgb.fns))
+		for _, f := range *rdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ResumeDuplicateSelect is the builder for selecting fields of ResumeDuplicate entities.
+type ResumeDuplicateSelect struct {
+	*ResumeDuplicateQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rds *ResumeDuplicateSelect) Aggregate(fns ...AggregateFunc) *ResumeDuplicateSelect {
+	rds.fns = append(rds.fns, fns...)
+	return rds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rds *ResumeDuplicateSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rds.ctx, ent.OpQuerySelect)
+	if err := rds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResumeDuplicateQuery, *ResumeDuplicateSelect](ctx, rds.ResumeDuplicateQuery, rds, rds.inters, v)
+}
+
+func (rds *ResumeDuplicateSelect) sqlScan(ctx context.Context, root *ResumeDuplicateQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rds.fns))
+	for _, fn := range rds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rds *ResumeDuplicateSelect) Modify(modifiers ...func(s *sql.Selector)) *ResumeDuplicateSelect {
+	rds.modifiers = append(rds.modifiers, modifiers...)
+	return rds
+}
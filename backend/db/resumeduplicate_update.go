@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db/predicate"
+	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumeduplicate"
+	"github.com/google/uuid"
+)
+
+// ResumeDuplicateUpdate is the builder for updating ResumeDuplicate entities.
+type ResumeDuplicateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ResumeDuplicateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ResumeDuplicateUpdate builder.
+func (rdu *ResumeDuplicateUpdate) Where(ps ...predicate.ResumeDuplicate) *ResumeDuplicateUpdate {
+	rdu.mutation.Where(ps...)
+	return rdu
+}
+
+// SetResumeID sets the "resume_id" field.
+func (rdu *ResumeDuplicateUpdate) SetResumeID(u uuid.UUID) *ResumeDuplicateUpdate {
+	rdu.mutation.SetResumeID(u)
+	return rdu
+}
+
+// SetNillableResumeID sets the "resume_id" field if the given value is not nil.
+func (rdu *ResumeDuplicateUpdate) SetNillableResumeID(u *uuid.UUID) *ResumeDuplicateUpdate {
+	if u != nil {
+		rdu.SetResumeID(*u)
+	}
+	return rdu
+}
+
+// SetDuplicateResumeID sets the "duplicate_resume_id" field.
+func (rdu *ResumeDuplicateUpdate) SetDuplicateResumeID(u uuid.UUID) *ResumeDuplicateUpdate {
+	rdu.mutation.SetDuplicateResumeID(u)
+	return rdu
+}
+
+// SetNillableDuplicateResumeID sets the "duplicate_resume_id" field if the given value is not nil.
+func (rdu *ResumeDuplicateUpdate) SetNillableDuplicateResumeID(u *uuid.UUID) *ResumeDuplicateUpdate {
+	if u != nil {
+		rdu.SetDuplicateResumeID(*u)
+	}
+	return rdu
+}
+
+// SetScore sets the "score" field.
+func (rdu *ResumeDuplicateUpdate) SetScore(f float64) *ResumeDuplicateUpdate {
+	rdu.mutation.ResetScore()
+	rdu.mutation.SetScore(f)
+	return rdu
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (rdu *ResumeDuplicateUpdate) SetNillableScore(f *float64) *ResumeDuplicateUpdate {
+	if f != nil {
+		rdu.SetScore(*f)
+	}
+	return rdu
+}
+
+// AddScore adds f to the "score" field.
+func (rdu *ResumeDuplicateUpdate) AddScore(f float64) *ResumeDuplicateUpdate {
+	rdu.mutation.AddScore(f)
+	return rdu
+}
+
+// SetReasons sets the "reasons" field.
+func (rdu *ResumeDuplicateUpdate) SetReasons(s []string) *ResumeDuplicateUpdate {
+	rdu.mutation.SetReasons(s)
+	return rdu
+}
+
+// AppendReasons appends s to the "reasons" field.
+func (rdu *ResumeDuplicateUpdate) AppendReasons(s []string) *ResumeDuplicateUpdate {
+	rdu.mutation.AppendReasons(s)
+	return rdu
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (rdu *ResumeDuplicateUpdate) ClearReasons() *ResumeDuplicateUpdate {
+	rdu.mutation.ClearReasons()
+	return rdu
+}
+
+// SetStatus sets the "status" field.
+func (rdu *ResumeDuplicateUpdate) SetStatus(cds consts.ResumeDuplicateStatus) *ResumeDuplicateUpdate {
+	rdu.mutation.SetStatus(cds)
+	return rdu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rdu *ResumeDuplicateUpdate) SetNillableStatus(cds *consts.ResumeDuplicateStatus) *ResumeDuplicateUpdate {
+	if cds != nil {
+		rdu.SetStatus(*cds)
+	}
+	return rdu
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (rdu *ResumeDuplicateUpdate) SetReviewedBy(u uuid.UUID) *ResumeDuplicateUpdate {
+	rdu.mutation.SetReviewedBy(u)
+	return rdu
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (rdu *ResumeDuplicateUpdate) SetNillableReviewedBy(u *uuid.UUID) *ResumeDuplicateUpdate {
+	if u != nil {
+		rdu.SetReviewedBy(*u)
+	}
+	return rdu
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (rdu *ResumeDuplicateUpdate) ClearReviewedBy() *ResumeDuplicateUpdate {
+	rdu.mutation.ClearReviewedBy()
+	return rdu
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (rdu *ResumeDuplicateUpdate) SetReviewedAt(t time.Time) *ResumeDuplicateUpdate {
+	rdu.mutation.SetReviewedAt(t)
+	return rdu
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (rdu *ResumeDuplicateUpdate) SetNillableReviewedAt(t *time.Time) *ResumeDuplicateUpdate {
+	if t != nil {
+		rdu.SetReviewedAt(*t)
+	}
+	return rdu
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (rdu *ResumeDuplicateUpdate) ClearReviewedAt() *ResumeDuplicateUpdate {
+	rdu.mutation.ClearReviewedAt()
+	return rdu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rdu *ResumeDuplicateUpdate) SetUpdatedAt(t time.Time) *ResumeDuplicateUpdate {
+	rdu.mutation.SetUpdatedAt(t)
+	return rdu
+}
+
+// SetResume sets the "resume" edge to the Resume entity.
+func (rdu *ResumeDuplicateUpdate) SetResume(r *Resume) *ResumeDuplicateUpdate {
+	return rdu.SetResumeID(r.ID)
+}
+
+// SetDuplicateResume sets the "duplicate_resume" edge to the Resume entity.
+func (rdu *ResumeDuplicateUpdate) SetDuplicateResume(r *Resume) *ResumeDuplicateUpdate {
+	return rdu.SetDuplicateResumeID(r.ID)
+}
+
+// Mutation returns the ResumeDuplicateMutation object of the builder.
+func (rdu *ResumeDuplicateUpdate) Mutation() *ResumeDuplicateMutation {
+	return rdu.mutation
+}
+
+// ClearResume clears the "resume" edge to the Resume entity.
+func (rdu *ResumeDuplicateUpdate) ClearResume() *ResumeDuplicateUpdate {
+	rdu.mutation.ClearResume()
+	return rdu
+}
+
+// ClearDuplicateResume clears the "duplicate_resume" edge to the Resume entity.
+func (rdu *ResumeDuplicateUpdate) ClearDuplicateResume() *ResumeDuplicateUpdate {
+	rdu.mutation.ClearDuplicateResume()
+	return rdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rdu *ResumeDuplicateUpdate) Save(ctx context.Context) (int, error) {
+	rdu.defaults()
+	return withHooks(ctx, rdu.sqlSave, rdu.mutation, rdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rdu *ResumeDuplicateUpdate) SaveX(ctx context.Context) int {
+	affected, err := rdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rdu *ResumeDuplicateUpdate) Exec(ctx context.Context) error {
+	_, err := rdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdu *ResumeDuplicateUpdate) ExecX(ctx context.Context) {
+	if err := rdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rdu *ResumeDuplicateUpdate) defaults() {
+	if _, ok := rdu.mutation.UpdatedAt(); !ok {
+		v := resumeduplicate.UpdateDefaultUpdatedAt()
+		rdu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rdu *ResumeDuplicateUpdate) check() error {
+	if rdu.mutation.ResumeCleared() && len(rdu.mutation.ResumeIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "ResumeDuplicate.resume"`)
+	}
+	if rdu.mutation.DuplicateResumeCleared() && len(rdu.mutation.DuplicateResumeIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "ResumeDuplicate.duplicate_resume"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rdu *ResumeDuplicateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResumeDuplicateUpdate {
+	rdu.modifiers = append(rdu.modifiers, modifiers...)
+	return rdu
+}
+
+func (rdu *ResumeDuplicateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resumeduplicate.Table, resumeduplicate.Columns, sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID))
+	if ps := rdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rdu.mutation.Score(); ok {
+		_spec.SetField(resumeduplicate.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := rdu.mutation.AddedScore(); ok {
+		_spec.AddField(resumeduplicate.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := rdu.mutation.Reasons(); ok {
+		_spec.SetField(resumeduplicate.FieldReasons, field.TypeJSON, value)
+	}
+	if value, ok := rdu.mutation.AppendedReasons(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, resumeduplicate.FieldReasons, value)
+		})
+	}
+	if rdu.mutation.ReasonsCleared() {
+		_spec.ClearField(resumeduplicate.FieldReasons, field.TypeJSON)
+	}
+	if value, ok := rdu.mutation.Status(); ok {
+		_spec.SetField(resumeduplicate.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := rdu.mutation.ReviewedBy(); ok {
+		_spec.SetField(resumeduplicate.FieldReviewedBy, field.TypeUUID, value)
+	}
+	if rdu.mutation.ReviewedByCleared() {
+		_spec.ClearField(resumeduplicate.FieldReviewedBy, field.TypeUUID)
+	}
+	if value, ok := rdu.mutation.ReviewedAt(); ok {
+		_spec.SetField(resumeduplicate.FieldReviewedAt, field.TypeTime, value)
+	}
+	if rdu.mutation.ReviewedAtCleared() {
+		_spec.ClearField(resumeduplicate.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := rdu.mutation.UpdatedAt(); ok {
+		_spec.SetField(resumeduplicate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rdu.mutation.ResumeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumeduplicate.ResumeTable,
+			Columns: []string{resumeduplicate.ResumeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resume.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rdu.mutation.ResumeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumeduplicate.ResumeTable,
+			Columns: []string{resumeduplicate.ResumeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resume.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rdu.mutation.DuplicateResumeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumeduplicate.DuplicateResumeTable,
+			Columns: []string{resumeduplicate.DuplicateResumeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resume.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rdu.mutation.DuplicateResumeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumeduplicate.DuplicateResumeTable,
+			Columns: []string{resumeduplicate.DuplicateResumeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resume.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(rdu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resumeduplicate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rdu.mutation.done = true
+	return n, nil
+}
+
+// ResumeDuplicateUpdateOne is the builder for updating a single ResumeDuplicate entity.
+type ResumeDuplicateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ResumeDuplicateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetResumeID sets the "resume_id" field.
+func (rduo *ResumeDuplicateUpdateOne) SetResumeID(u uuid.UUID) *ResumeDuplicateUpdateOne {
+	rduo.mutation.SetResumeID(u)
+	return rduo
+}
+
+// SetNillableResumeID sets the "resume_id" field if the given value is not nil.
+func (rduo *ResumeDuplicateUpdateOne) SetNillableResumeID(u *uuid.UUID) *ResumeDuplicateUpdateOne {
+	if u != nil {
+		rduo.SetResumeID(*u)
+	}
+	return rduo
+}
+
+// SetDuplicateResumeID sets the "duplicate_resume_id" field.
+func (rduo *ResumeDuplicateUpdateOne) SetDuplicateResumeID(u uuid.UUID) *ResumeDuplicateUpdateOne {
+	rduo.mutation.SetDuplicateResumeID(u)
+	return rduo
+}
+
+// SetNillableDuplicateResumeID sets the "duplicate_resume_id" field if the given value is not nil.
+func (rduo *ResumeDuplicateUpdateOne) SetNillableDuplicateResumeID(u *uuid.UUID) *ResumeDuplicateUpdateOne {
+	if u != nil {
+		rduo.SetDuplicateResumeID(*u)
+	}
+	return rduo
+}
+
+// SetScore sets the "score" field.
+func (rduo *ResumeDuplicateUpdateOne) SetScore(f float64) *ResumeDuplicateUpdateOne {
+	rduo.mutation.ResetScore()
+	rduo.mutation.SetScore(f)
+	return rduo
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (rduo *ResumeDuplicateUpdateOne) SetNillableScore(f *float64) *ResumeDuplicateUpdateOne {
+	if f != nil {
+		rduo.SetScore(*f)
+	}
+	return rduo
+}
+
+// AddScore adds f to the "score" field.
+func (rduo *ResumeDuplicateUpdateOne) AddScore(f float64) *ResumeDuplicateUpdateOne {
+	rduo.mutation.AddScore(f)
+	return rduo
+}
+
+// SetReasons sets the "reasons" field.
+func (rduo *ResumeDuplicateUpdateOne) SetReasons(s []string) *ResumeDuplicateUpdateOne {
+	rduo.mutation.SetReasons(s)
+	return rduo
+}
+
+// AppendReasons appends s to the "reasons" field.
+func (rduo *ResumeDuplicateUpdateOne) AppendReasons(s []string) *ResumeDuplicateUpdateOne {
+	rduo.mutation.AppendReasons(s)
+	return rduo
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (rduo *ResumeDuplicateUpdateOne) ClearReasons() *ResumeDuplicateUpdateOne {
+	rduo.mutation.ClearReasons()
+	return rduo
+}
+
+// SetStatus sets the "status" field.
+func (rduo *ResumeDuplicateUpdateOne) SetStatus(cds consts.ResumeDuplicateStatus) *ResumeDuplicateUpdateOne {
+	rduo.mutation.SetStatus(cds)
+	return rduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rduo *ResumeDuplicateUpdateOne) SetNillableStatus(cds *consts.ResumeDuplicateStatus) *ResumeDuplicateUpdateOne {
+	if cds != nil {
+		rduo.SetStatus(*cds)
+	}
+	return rduo
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (rduo *ResumeDuplicateUpdateOne) SetReviewedBy(u uuid.UUID) *ResumeDuplicateUpdateOne {
+	rduo.mutation.SetReviewedBy(u)
+	return rduo
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (rduo *ResumeDuplicateUpdateOne) SetNillableReviewedBy(u *uuid.UUID) *ResumeDuplicateUpdateOne {
+	if u != nil {
+		rduo.SetReviewedBy(*u)
+	}
+	return rduo
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (rduo *ResumeDuplicateUpdateOne) ClearReviewedBy() *ResumeDuplicateUpdateOne {
+	rduo.mutation.ClearReviewedBy()
+	return rduo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (rduo *ResumeDuplicateUpdateOne) SetReviewedAt(t time.Time) *ResumeDuplicateUpdateOne {
+	rduo.mutation.SetReviewedAt(t)
+	return rduo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (rduo *ResumeDuplicateUpdateOne) SetNillableReviewedAt(t *time.Time) *ResumeDuplicateUpdateOne {
+	if t != nil {
+		rduo.SetReviewedAt(*t)
+	}
+	return rduo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (rduo *ResumeDuplicateUpdateOne) ClearReviewedAt() *ResumeDuplicateUpdateOne {
+	rduo.mutation.ClearReviewedAt()
+	return rduo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rduo *ResumeDuplicateUpdateOne) SetUpdatedAt(t time.Time) *ResumeDuplicateUpdateOne {
+	rduo.mutation.SetUpdatedAt(t)
+	return rduo
+}
+
+// SetResume sets the "resume" edge to the Resume entity.
+func (rduo *ResumeDuplicateUpdateOne) SetResume(r *Resume) *ResumeDuplicateUpdateOne {
+	return rduo.SetResumeID(r.ID)
+}
+
+// SetDuplicateResume sets the "duplicate_resume" edge to the Resume entity.
+func (rduo *ResumeDuplicateUpdateOne) SetDuplicateResume(r *Resume) *ResumeDuplicateUpdateOne {
+	return rduo.SetDuplicateResumeID(r.ID)
+}
+
+// Mutation returns the ResumeDuplicateMutation object of the builder.
+func (rduo *ResumeDuplicateUpdateOne) Mutation() *ResumeDuplicateMutation {
+	return rduo.mutation
+}
+
+// ClearResume clears the "resume" edge to the Resume entity.
+func (rduo *ResumeDuplicateUpdateOne) ClearResume() *ResumeDuplicateUpdateOne {
+	rduo.mutation.ClearResume()
+	return rduo
+}
+
+// ClearDuplicateResume clears the "duplicate_resume" edge to the Resume entity.
+func (rduo *ResumeDuplicateUpdateOne) ClearDuplicateResume() *ResumeDuplicateUpdateOne {
+	rduo.mutation.ClearDuplicateResume()
+	return rduo
+}
+
+// Where appends a list predicates to the ResumeDuplicateUpdate builder.
+func (rduo *ResumeDuplicateUpdateOne) Where(ps ...predicate.ResumeDuplicate) *ResumeDuplicateUpdateOne {
+	rduo.mutation.Where(ps...)
+	return rduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rduo *ResumeDuplicateUpdateOne) Select(field string, fields ...string) *ResumeDuplicateUpdateOne {
+	rduo.fields = append([]string{field}, fields...)
+	return rduo
+}
+
+// Save executes the query and returns the updated ResumeDuplicate entity.
+func (rduo *ResumeDuplicateUpdateOne) Save(ctx context.Context) (*ResumeDuplicate, error) {
+	rduo.defaults()
+	return withHooks(ctx, rduo.sqlSave, rduo.mutation, rduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rduo *ResumeDuplicateUpdateOne) SaveX(ctx context.Context) *ResumeDuplicate {
+	node, err := rduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rduo *ResumeDuplicateUpdateOne) Exec(ctx context.Context) error {
+	_, err := rduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rduo *ResumeDuplicateUpdateOne) ExecX(ctx context.Context) {
+	if err := rduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rduo *ResumeDuplicateUpdateOne) defaults() {
+	if _, ok := rduo.mutation.UpdatedAt(); !ok {
+		v := resumeduplicate.UpdateDefaultUpdatedAt()
+		rduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rduo *ResumeDuplicateUpdateOne) check() error {
+	if rduo.mutation.ResumeCleared() && len(rduo.mutation.ResumeIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "ResumeDuplicate.resume"`)
+	}
+	if rduo.mutation.DuplicateResumeCleared() && len(rduo.mutation.DuplicateResumeIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "ResumeDuplicate.duplicate_resume"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rduo *ResumeDuplicateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResumeDuplicateUpdateOne {
+	rduo.modifiers = append(rduo.modifiers, modifiers...)
+	return rduo
+}
+
+func (rduo *ResumeDuplicateUpdateOne) sqlSave(ctx context.Context) (_node *ResumeDuplicate, err error) {
+	if err := rduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resumeduplicate.Table, resumeduplicate.Columns, sqlgraph.NewFieldSpec(resumeduplicate.FieldID, field.TypeUUID))
+	id, ok := rduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "ResumeDuplicate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resumeduplicate.FieldID)
+		for _, f := range fields {
+			if !resumeduplicate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != resumeduplicate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rduo.mutation.Score(); ok {
+		_spec.SetField(resumeduplicate.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := rduo.mutation.AddedScore(); ok {
+		_spec.AddField(resumeduplicate.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := rduo.mutation.Reasons(); ok {
+		_spec.SetField(resumeduplicate.FieldReasons, field.TypeJSON, value)
+	}
+	if value, ok := rduo.mutation.AppendedReasons(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, resumeduplicate.FieldReasons, value)
+		})
+	}
+	if rduo.mutation.ReasonsCleared() {
+		_spec.ClearField(resumeduplicate.FieldReasons, field.TypeJSON)
+	}
+	if value, ok := rduo.mutation.Status(); ok {
+		_spec.SetField(resumeduplicate.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := rduo.mutation.ReviewedBy(); ok {
+		_spec.SetField(resumeduplicate.FieldReviewedBy, field.TypeUUID, value)
+	}
+	if rduo.mutation.ReviewedByCleared() {
+		_spec.ClearField(resumeduplicate.FieldReviewedBy, field.TypeUUID)
+	}
+	if value, ok := rduo.mutation.ReviewedAt(); ok {
+		_spec.SetField(resumeduplicate.FieldReviewedAt, field.TypeTime, value)
+	}
+	if rduo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(resumeduplicate.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := rduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(resumeduplicate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rduo.mutation.ResumeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumeduplicate.ResumeTable,
+			Columns: []string{resumeduplicate.ResumeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resume.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rduo.mutation.ResumeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumeduplicate.ResumeTable,
+			Columns: []string{resumeduplicate.ResumeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resume.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rduo.mutation.DuplicateResumeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumeduplicate.DuplicateResumeTable,
+			Columns: []string{resumeduplicate.DuplicateResumeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resume.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rduo.mutation.DuplicateResumeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumeduplicate.DuplicateResumeTable,
+			Columns: []string{resumeduplicate.DuplicateResumeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resume.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(rduo.modifiers...)
+	_node = &ResumeDuplicate{config: rduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resumeduplicate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rduo.mutation.done = true
+	return _node, nil
+}
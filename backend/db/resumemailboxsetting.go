@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/chaitin/WhaleHire/backend/db/resumemailboxsetting"
 	"github.com/chaitin/WhaleHire/backend/db/user"
+	"github.com/chaitin/WhaleHire/backend/ent/types"
 	"github.com/google/uuid"
 )
 
@@ -44,6 +45,12 @@ type ResumeMailboxSetting struct {
 	UploaderID uuid.UUID `json:"uploader_id,omitempty"`
 	// 岗位画像ID列表
 	JobProfileIds []uuid.UUID `json:"job_profile_ids,omitempty"`
+	// 简历提取方式：attachments/attachments_body/attachments_links
+	ExtractionMode string `json:"extraction_mode,omitempty"`
+	// 允许跟随下载简历链接的域名白名单
+	LinkAllowedDomains []string `json:"link_allowed_domains,omitempty"`
+	// 邮件过滤规则，按顺序匹配
+	Rules []*types.ResumeMailboxRule `json:"rules,omitempty"`
 	// 自定义同步频率(分钟)，为空则使用平台默认
 	SyncIntervalMinutes *int `json:"sync_interval_minutes,omitempty"`
 	// 状态
@@ -111,13 +118,13 @@ func (*ResumeMailboxSetting) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case resumemailboxsetting.FieldEncryptedCredential, resumemailboxsetting.FieldJobProfileIds:
+		case resumemailboxsetting.FieldEncryptedCredential, resumemailboxsetting.FieldJobProfileIds, resumemailboxsetting.FieldLinkAllowedDomains, resumemailboxsetting.FieldRules:
 			values[i] = new([]byte)
 		case resumemailboxsetting.FieldUseSsl:
 			values[i] = new(sql.NullBool)
 		case resumemailboxsetting.FieldPort, resumemailboxsetting.FieldSyncIntervalMinutes, resumemailboxsetting.FieldRetryCount:
 			values[i] = new(sql.NullInt64)
-		case resumemailboxsetting.FieldName, resumemailboxsetting.FieldEmailAddress, resumemailboxsetting.FieldProtocol, resumemailboxsetting.FieldHost, resumemailboxsetting.FieldFolder, resumemailboxsetting.FieldAuthType, resumemailboxsetting.FieldStatus, resumemailboxsetting.FieldLastError:
+		case resumemailboxsetting.FieldName, resumemailboxsetting.FieldEmailAddress, resumemailboxsetting.FieldProtocol, resumemailboxsetting.FieldHost, resumemailboxsetting.FieldFolder, resumemailboxsetting.FieldAuthType, resumemailboxsetting.FieldExtractionMode, resumemailboxsetting.FieldStatus, resumemailboxsetting.FieldLastError:
 			values[i] = new(sql.NullString)
 		case resumemailboxsetting.FieldDeletedAt, resumemailboxsetting.FieldLastSyncedAt, resumemailboxsetting.FieldCreatedAt, resumemailboxsetting.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -220,6 +227,28 @@ func (rms *ResumeMailboxSetting) assignValues(columns []string, values []any) er
 					return fmt.Errorf("unmarshal field job_profile_ids: %w", err)
 				}
 			}
+		case resumemailboxsetting.FieldExtractionMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field extraction_mode", values[i])
+			} else if value.Valid {
+				rms.ExtractionMode = value.String
+			}
+		case resumemailboxsetting.FieldLinkAllowedDomains:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field link_allowed_domains", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rms.LinkAllowedDomains); err != nil {
+					return fmt.Errorf("unmarshal field link_allowed_domains: %w", err)
+				}
+			}
+		case resumemailboxsetting.FieldRules:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rules", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rms.Rules); err != nil {
+					return fmt.Errorf("unmarshal field rules: %w", err)
+				}
+			}
 		case resumemailboxsetting.FieldSyncIntervalMinutes:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field sync_interval_minutes", values[i])
@@ -351,6 +380,15 @@ func (rms *ResumeMailboxSetting) String() string {
 	builder.WriteString("job_profile_ids=")
 	builder.WriteString(fmt.Sprintf("%v", rms.JobProfileIds))
 	builder.WriteString(", ")
+	builder.WriteString("extraction_mode=")
+	builder.WriteString(rms.ExtractionMode)
+	builder.WriteString(", ")
+	builder.WriteString("link_allowed_domains=")
+	builder.WriteString(fmt.Sprintf("%v", rms.LinkAllowedDomains))
+	builder.WriteString(", ")
+	builder.WriteString("rules=")
+	builder.WriteString(fmt.Sprintf("%v", rms.Rules))
+	builder.WriteString(", ")
 	if v := rms.SyncIntervalMinutes; v != nil {
 		builder.WriteString("sync_interval_minutes=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
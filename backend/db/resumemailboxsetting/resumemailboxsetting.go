@@ -40,6 +40,12 @@ const (
 	FieldUploaderID = "uploader_id"
 	// FieldJobProfileIds holds the string denoting the job_profile_ids field in the database.
 	FieldJobProfileIds = "job_profile_ids"
+	// FieldExtractionMode holds the string denoting the extraction_mode field in the database.
+	FieldExtractionMode = "extraction_mode"
+	// FieldLinkAllowedDomains holds the string denoting the link_allowed_domains field in the database.
+	FieldLinkAllowedDomains = "link_allowed_domains"
+	// FieldRules holds the string denoting the rules field in the database.
+	FieldRules = "rules"
 	// FieldSyncIntervalMinutes holds the string denoting the sync_interval_minutes field in the database.
 	FieldSyncIntervalMinutes = "sync_interval_minutes"
 	// FieldStatus holds the string denoting the status field in the database.
@@ -100,6 +106,9 @@ var Columns = []string{
 	FieldEncryptedCredential,
 	FieldUploaderID,
 	FieldJobProfileIds,
+	FieldExtractionMode,
+	FieldLinkAllowedDomains,
+	FieldRules,
 	FieldSyncIntervalMinutes,
 	FieldStatus,
 	FieldLastSyncedAt,
@@ -143,6 +152,10 @@ var (
 	DefaultAuthType string
 	// AuthTypeValidator is a validator for the "auth_type" field. It is called by the builders before save.
 	AuthTypeValidator func(string) error
+	// DefaultExtractionMode holds the default value on creation for the "extraction_mode" field.
+	DefaultExtractionMode string
+	// ExtractionModeValidator is a validator for the "extraction_mode" field. It is called by the builders before save.
+	ExtractionModeValidator func(string) error
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
 	// DefaultRetryCount holds the default value on creation for the "retry_count" field.
@@ -215,6 +228,11 @@ func ByUploaderID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUploaderID, opts...).ToFunc()
 }
 
+// ByExtractionMode orders the results by the extraction_mode field.
+func ByExtractionMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExtractionMode, opts...).ToFunc()
+}
+
 // BySyncIntervalMinutes orders the results by the sync_interval_minutes field.
 func BySyncIntervalMinutes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSyncIntervalMinutes, opts...).ToFunc()
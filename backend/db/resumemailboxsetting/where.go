@@ -106,6 +106,11 @@ func UploaderID(v uuid.UUID) predicate.ResumeMailboxSetting {
 	return predicate.ResumeMailboxSetting(sql.FieldEQ(FieldUploaderID, v))
 }
 
+// ExtractionMode applies equality check predicate on the "extraction_mode" field. It's identical to ExtractionModeEQ.
+func ExtractionMode(v string) predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldEQ(FieldExtractionMode, v))
+}
+
 // SyncIntervalMinutes applies equality check predicate on the "sync_interval_minutes" field. It's identical to SyncIntervalMinutesEQ.
 func SyncIntervalMinutes(v int) predicate.ResumeMailboxSetting {
 	return predicate.ResumeMailboxSetting(sql.FieldEQ(FieldSyncIntervalMinutes, v))
@@ -671,6 +676,91 @@ func JobProfileIdsNotNil() predicate.ResumeMailboxSetting {
 	return predicate.ResumeMailboxSetting(sql.FieldNotNull(FieldJobProfileIds))
 }
 
+// ExtractionModeEQ applies the EQ predicate on the "extraction_mode" field.
+func ExtractionModeEQ(v string) predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldEQ(FieldExtractionMode, v))
+}
+
+// ExtractionModeNEQ applies the NEQ predicate on the "extraction_mode" field.
+func ExtractionModeNEQ(v string) predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldNEQ(FieldExtractionMode, v))
+}
+
+// ExtractionModeIn applies the In predicate on the "extraction_mode" field.
+func ExtractionModeIn(vs ...string) predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldIn(FieldExtractionMode, vs...))
+}
+
+// ExtractionModeNotIn applies the NotIn predicate on the "extraction_mode" field.
+func ExtractionModeNotIn(vs ...string) predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldNotIn(FieldExtractionMode, vs...))
+}
+
+// ExtractionModeGT applies the GT predicate on the "extraction_mode" field.
+func ExtractionModeGT(v string) predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldGT(FieldExtractionMode, v))
+}
+
+// ExtractionModeGTE applies the GTE predicate on the "extraction_mode" field.
+func ExtractionModeGTE(v string) predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldGTE(FieldExtractionMode, v))
+}
+
+// ExtractionModeLT applies the LT predicate on the "extraction_mode" field.
+func ExtractionModeLT(v string) predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldLT(FieldExtractionMode, v))
+}
+
+// ExtractionModeLTE applies the LTE predicate on the "extraction_mode" field.
+func ExtractionModeLTE(v string) predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldLTE(FieldExtractionMode, v))
+}
+
+// ExtractionModeContains applies the Contains predicate on the "extraction_mode" field.
+func ExtractionModeContains(v string) predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldContains(FieldExtractionMode, v))
+}
+
+// ExtractionModeHasPrefix applies the HasPrefix predicate on the "extraction_mode" field.
+func ExtractionModeHasPrefix(v string) predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldHasPrefix(FieldExtractionMode, v))
+}
+
+// ExtractionModeHasSuffix applies the HasSuffix predicate on the "extraction_mode" field.
+func ExtractionModeHasSuffix(v string) predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldHasSuffix(FieldExtractionMode, v))
+}
+
+// ExtractionModeEqualFold applies the EqualFold predicate on the "extraction_mode" field.
+func ExtractionModeEqualFold(v string) predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldEqualFold(FieldExtractionMode, v))
+}
+
+// ExtractionModeContainsFold applies the ContainsFold predicate on the "extraction_mode" field.
+func ExtractionModeContainsFold(v string) predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldContainsFold(FieldExtractionMode, v))
+}
+
+// LinkAllowedDomainsIsNil applies the IsNil predicate on the "link_allowed_domains" field.
+func LinkAllowedDomainsIsNil() predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldIsNull(FieldLinkAllowedDomains))
+}
+
+// LinkAllowedDomainsNotNil applies the NotNil predicate on the "link_allowed_domains" field.
+func LinkAllowedDomainsNotNil() predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldNotNull(FieldLinkAllowedDomains))
+}
+
+// RulesIsNil applies the IsNil predicate on the "rules" field.
+func RulesIsNil() predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldIsNull(FieldRules))
+}
+
+// RulesNotNil applies the NotNil predicate on the "rules" field.
+func RulesNotNil() predicate.ResumeMailboxSetting {
+	return predicate.ResumeMailboxSetting(sql.FieldNotNull(FieldRules))
+}
+
 // SyncIntervalMinutesEQ applies the EQ predicate on the "sync_interval_minutes" field.
 func SyncIntervalMinutesEQ(v int) predicate.ResumeMailboxSetting {
 	return predicate.ResumeMailboxSetting(sql.FieldEQ(FieldSyncIntervalMinutes, v))
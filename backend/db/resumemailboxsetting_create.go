@@ -16,6 +16,7 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db/resumemailboxsetting"
 	"github.com/chaitin/WhaleHire/backend/db/resumemailboxstatistic"
 	"github.com/chaitin/WhaleHire/backend/db/user"
+	"github.com/chaitin/WhaleHire/backend/ent/types"
 	"github.com/google/uuid"
 )
 
@@ -131,6 +132,32 @@ func (rmsc *ResumeMailboxSettingCreate) SetJobProfileIds(u []uuid.UUID) *ResumeM
 	return rmsc
 }
 
+// SetExtractionMode sets the "extraction_mode" field.
+func (rmsc *ResumeMailboxSettingCreate) SetExtractionMode(s string) *ResumeMailboxSettingCreate {
+	rmsc.mutation.SetExtractionMode(s)
+	return rmsc
+}
+
+// SetNillableExtractionMode sets the "extraction_mode" field if the given value is not nil.
+func (rmsc *ResumeMailboxSettingCreate) SetNillableExtractionMode(s *string) *ResumeMailboxSettingCreate {
+	if s != nil {
+		rmsc.SetExtractionMode(*s)
+	}
+	return rmsc
+}
+
+// SetLinkAllowedDomains sets the "link_allowed_domains" field.
+func (rmsc *ResumeMailboxSettingCreate) SetLinkAllowedDomains(s []string) *ResumeMailboxSettingCreate {
+	rmsc.mutation.SetLinkAllowedDomains(s)
+	return rmsc
+}
+
+// SetRules sets the "rules" field.
+func (rmsc *ResumeMailboxSettingCreate) SetRules(tmr []*types.ResumeMailboxRule) *ResumeMailboxSettingCreate {
+	rmsc.mutation.SetRules(tmr)
+	return rmsc
+}
+
 // SetSyncIntervalMinutes sets the "sync_interval_minutes" field.
 func (rmsc *ResumeMailboxSettingCreate) SetSyncIntervalMinutes(i int) *ResumeMailboxSettingCreate {
 	rmsc.mutation.SetSyncIntervalMinutes(i)
@@ -323,6 +350,10 @@ func (rmsc *ResumeMailboxSettingCreate) defaults() error {
 		v := resumemailboxsetting.DefaultAuthType
 		rmsc.mutation.SetAuthType(v)
 	}
+	if _, ok := rmsc.mutation.ExtractionMode(); !ok {
+		v := resumemailboxsetting.DefaultExtractionMode
+		rmsc.mutation.SetExtractionMode(v)
+	}
 	if _, ok := rmsc.mutation.Status(); !ok {
 		v := resumemailboxsetting.DefaultStatus
 		rmsc.mutation.SetStatus(v)
@@ -414,6 +445,14 @@ func (rmsc *ResumeMailboxSettingCreate) check() error {
 	if _, ok := rmsc.mutation.UploaderID(); !ok {
 		return &ValidationError{Name: "uploader_id", err: errors.New(`db: missing required field "ResumeMailboxSetting.uploader_id"`)}
 	}
+	if _, ok := rmsc.mutation.ExtractionMode(); !ok {
+		return &ValidationError{Name: "extraction_mode", err: errors.New(`db: missing required field "ResumeMailboxSetting.extraction_mode"`)}
+	}
+	if v, ok := rmsc.mutation.ExtractionMode(); ok {
+		if err := resumemailboxsetting.ExtractionModeValidator(v); err != nil {
+			return &ValidationError{Name: "extraction_mode", err: fmt.Errorf(`db: validator failed for field "ResumeMailboxSetting.extraction_mode": %w`, err)}
+		}
+	}
 	if _, ok := rmsc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`db: missing required field "ResumeMailboxSetting.status"`)}
 	}
@@ -509,6 +548,18 @@ func (rmsc *ResumeMailboxSettingCreate) createSpec() (*ResumeMailboxSetting, *sq
 		_spec.SetField(resumemailboxsetting.FieldJobProfileIds, field.TypeJSON, value)
 		_node.JobProfileIds = value
 	}
+	if value, ok := rmsc.mutation.ExtractionMode(); ok {
+		_spec.SetField(resumemailboxsetting.FieldExtractionMode, field.TypeString, value)
+		_node.ExtractionMode = value
+	}
+	if value, ok := rmsc.mutation.LinkAllowedDomains(); ok {
+		_spec.SetField(resumemailboxsetting.FieldLinkAllowedDomains, field.TypeJSON, value)
+		_node.LinkAllowedDomains = value
+	}
+	if value, ok := rmsc.mutation.Rules(); ok {
+		_spec.SetField(resumemailboxsetting.FieldRules, field.TypeJSON, value)
+		_node.Rules = value
+	}
 	if value, ok := rmsc.mutation.SyncIntervalMinutes(); ok {
 		_spec.SetField(resumemailboxsetting.FieldSyncIntervalMinutes, field.TypeInt, value)
 		_node.SyncIntervalMinutes = &value
@@ -806,6 +857,54 @@ func (u *ResumeMailboxSettingUpsert) ClearJobProfileIds() *ResumeMailboxSettingU
 	return u
 }
 
+// SetExtractionMode sets the "extraction_mode" field.
+func (u *ResumeMailboxSettingUpsert) SetExtractionMode(v string) *ResumeMailboxSettingUpsert {
+	u.Set(resumemailboxsetting.FieldExtractionMode, v)
+	return u
+}
+
+// UpdateExtractionMode sets the "extraction_mode" field to the value that was provided on create.
+func (u *ResumeMailboxSettingUpsert) UpdateExtractionMode() *ResumeMailboxSettingUpsert {
+	u.SetExcluded(resumemailboxsetting.FieldExtractionMode)
+	return u
+}
+
+// SetLinkAllowedDomains sets the "link_allowed_domains" field.
+func (u *ResumeMailboxSettingUpsert) SetLinkAllowedDomains(v []string) *ResumeMailboxSettingUpsert {
+	u.Set(resumemailboxsetting.FieldLinkAllowedDomains, v)
+	return u
+}
+
+// UpdateLinkAllowedDomains sets the "link_allowed_domains" field to the value that was provided on create.
+func (u *ResumeMailboxSettingUpsert) UpdateLinkAllowedDomains() *ResumeMailboxSettingUpsert {
+	u.SetExcluded(resumemailboxsetting.FieldLinkAllowedDomains)
+	return u
+}
+
+// ClearLinkAllowedDomains clears the value of the "link_allowed_domains" field.
+func (u *ResumeMailboxSettingUpsert) ClearLinkAllowedDomains() *ResumeMailboxSettingUpsert {
+	u.SetNull(resumemailboxsetting.FieldLinkAllowedDomains)
+	return u
+}
+
+// SetRules sets the "rules" field.
+func (u *ResumeMailboxSettingUpsert) SetRules(v []*types.ResumeMailboxRule) *ResumeMailboxSettingUpsert {
+	u.Set(resumemailboxsetting.FieldRules, v)
+	return u
+}
+
+// UpdateRules sets the "rules" field to the value that was provided on create.
+func (u *ResumeMailboxSettingUpsert) UpdateRules() *ResumeMailboxSettingUpsert {
+	u.SetExcluded(resumemailboxsetting.FieldRules)
+	return u
+}
+
+// ClearRules clears the value of the "rules" field.
+func (u *ResumeMailboxSettingUpsert) ClearRules() *ResumeMailboxSettingUpsert {
+	u.SetNull(resumemailboxsetting.FieldRules)
+	return u
+}
+
 // SetSyncIntervalMinutes sets the "sync_interval_minutes" field.
 func (u *ResumeMailboxSettingUpsert) SetSyncIntervalMinutes(v int) *ResumeMailboxSettingUpsert {
 	u.Set(resumemailboxsetting.FieldSyncIntervalMinutes, v)
@@ -1155,6 +1254,62 @@ func (u *ResumeMailboxSettingUpsertOne) ClearJobProfileIds() *ResumeMailboxSetti
 	})
 }
 
+// SetExtractionMode sets the "extraction_mode" field.
+func (u *ResumeMailboxSettingUpsertOne) SetExtractionMode(v string) *ResumeMailboxSettingUpsertOne {
+	return u.Update(func(s *ResumeMailboxSettingUpsert) {
+		s.SetExtractionMode(v)
+	})
+}
+
+// UpdateExtractionMode sets the "extraction_mode" field to the value that was provided on create.
+func (u *ResumeMailboxSettingUpsertOne) UpdateExtractionMode() *ResumeMailboxSettingUpsertOne {
+	return u.Update(func(s *ResumeMailboxSettingUpsert) {
+		s.UpdateExtractionMode()
+	})
+}
+
+// SetLinkAllowedDomains sets the "link_allowed_domains" field.
+func (u *ResumeMailboxSettingUpsertOne) SetLinkAllowedDomains(v []string) *ResumeMailboxSettingUpsertOne {
+	return u.Update(func(s *ResumeMailboxSettingUpsert) {
+		s.SetLinkAllowedDomains(v)
+	})
+}
+
+// UpdateLinkAllowedDomains sets the "link_allowed_domains" field to the value that was provided on create.
+func (u *ResumeMailboxSettingUpsertOne) UpdateLinkAllowedDomains() *ResumeMailboxSettingUpsertOne {
+	return u.Update(func(s *ResumeMailboxSettingUpsert) {
+		s.UpdateLinkAllowedDomains()
+	})
+}
+
+// ClearLinkAllowedDomains clears the value of the "link_allowed_domains" field.
+func (u *ResumeMailboxSettingUpsertOne) ClearLinkAllowedDomains() *ResumeMailboxSettingUpsertOne {
+	return u.Update(func(s *ResumeMailboxSettingUpsert) {
+		s.ClearLinkAllowedDomains()
+	})
+}
+
+// SetRules sets the "rules" field.
+func (u *ResumeMailboxSettingUpsertOne) SetRules(v []*types.ResumeMailboxRule) *ResumeMailboxSettingUpsertOne {
+	return u.Update(func(s *ResumeMailboxSettingUpsert) {
+		s.SetRules(v)
+	})
+}
+
+// UpdateRules sets the "rules" field to the value that was provided on create.
+func (u *ResumeMailboxSettingUpsertOne) UpdateRules() *ResumeMailboxSettingUpsertOne {
+	return u.Update(func(s *ResumeMailboxSettingUpsert) {
+		s.UpdateRules()
+	})
+}
+
+// ClearRules clears the value of the "rules" field.
+func (u *ResumeMailboxSettingUpsertOne) ClearRules() *ResumeMailboxSettingUpsertOne {
+	return u.Update(func(s *ResumeMailboxSettingUpsert) {
+		s.ClearRules()
+	})
+}
+
 // SetSyncIntervalMinutes sets the "sync_interval_minutes" field.
 func (u *ResumeMailboxSettingUpsertOne) SetSyncIntervalMinutes(v int) *ResumeMailboxSettingUpsertOne {
 	return u.Update(func(s *ResumeMailboxSettingUpsert) {
@@ -1688,6 +1843,62 @@ func (u *ResumeMailboxSettingUpsertBulk) ClearJobProfileIds() *ResumeMailboxSett
 	})
 }
 
+// SetExtractionMode sets the "extraction_mode" field.
+func (u *ResumeMailboxSettingUpsertBulk) SetExtractionMode(v string) *ResumeMailboxSettingUpsertBulk {
+	return u.Update(func(s *ResumeMailboxSettingUpsert) {
+		s.SetExtractionMode(v)
+	})
+}
+
+// UpdateExtractionMode sets the "extraction_mode" field to the value that was provided on create.
+func (u *ResumeMailboxSettingUpsertBulk) UpdateExtractionMode() *ResumeMailboxSettingUpsertBulk {
+	return u.Update(func(s *ResumeMailboxSettingUpsert) {
+		s.UpdateExtractionMode()
+	})
+}
+
+// SetLinkAllowedDomains sets the "link_allowed_domains" field.
+func (u *ResumeMailboxSettingUpsertBulk) SetLinkAllowedDomains(v []string) *ResumeMailboxSettingUpsertBulk {
+	return u.Update(func(s *ResumeMailboxSettingUpsert) {
+		s.SetLinkAllowedDomains(v)
+	})
+}
+
+// UpdateLinkAllowedDomains sets the "link_allowed_domains" field to the value that was provided on create.
+func (u *ResumeMailboxSettingUpsertBulk) UpdateLinkAllowedDomains() *ResumeMailboxSettingUpsertBulk {
+	return u.Update(func(s *ResumeMailboxSettingUpsert) {
+		s.UpdateLinkAllowedDomains()
+	})
+}
+
+// ClearLinkAllowedDomains clears the value of the "link_allowed_domains" field.
+func (u *ResumeMailboxSettingUpsertBulk) ClearLinkAllowedDomains() *ResumeMailboxSettingUpsertBulk {
+	return u.Update(func(s *ResumeMailboxSettingUpsert) {
+		s.ClearLinkAllowedDomains()
+	})
+}
+
+// SetRules sets the "rules" field.
+func (u *ResumeMailboxSettingUpsertBulk) SetRules(v []*types.ResumeMailboxRule) *ResumeMailboxSettingUpsertBulk {
+	return u.Update(func(s *ResumeMailboxSettingUpsert) {
+		s.SetRules(v)
+	})
+}
+
+// UpdateRules sets the "rules" field to the value that was provided on create.
+func (u *ResumeMailboxSettingUpsertBulk) UpdateRules() *ResumeMailboxSettingUpsertBulk {
+	return u.Update(func(s *ResumeMailboxSettingUpsert) {
+		s.UpdateRules()
+	})
+}
+
+// ClearRules clears the value of the "rules" field.
+func (u *ResumeMailboxSettingUpsertBulk) ClearRules() *ResumeMailboxSettingUpsertBulk {
+	return u.Update(func(s *ResumeMailboxSettingUpsert) {
+		s.ClearRules()
+	})
+}
+
 // SetSyncIntervalMinutes sets the "sync_interval_minutes" field.
 func (u *ResumeMailboxSettingUpsertBulk) SetSyncIntervalMinutes(v int) *ResumeMailboxSettingUpsertBulk {
 	return u.Update(func(s *ResumeMailboxSettingUpsert) {
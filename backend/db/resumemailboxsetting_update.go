@@ -17,6 +17,7 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db/resumemailboxsetting"
 	"github.com/chaitin/WhaleHire/backend/db/resumemailboxstatistic"
 	"github.com/chaitin/WhaleHire/backend/db/user"
+	"github.com/chaitin/WhaleHire/backend/ent/types"
 	"github.com/google/uuid"
 )
 
@@ -217,6 +218,56 @@ func (rmsu *ResumeMailboxSettingUpdate) ClearJobProfileIds() *ResumeMailboxSetti
 	return rmsu
 }
 
+// SetExtractionMode sets the "extraction_mode" field.
+func (rmsu *ResumeMailboxSettingUpdate) SetExtractionMode(s string) *ResumeMailboxSettingUpdate {
+	rmsu.mutation.SetExtractionMode(s)
+	return rmsu
+}
+
+// SetNillableExtractionMode sets the "extraction_mode" field if the given value is not nil.
+func (rmsu *ResumeMailboxSettingUpdate) SetNillableExtractionMode(s *string) *ResumeMailboxSettingUpdate {
+	if s != nil {
+		rmsu.SetExtractionMode(*s)
+	}
+	return rmsu
+}
+
+// SetLinkAllowedDomains sets the "link_allowed_domains" field.
+func (rmsu *ResumeMailboxSettingUpdate) SetLinkAllowedDomains(s []string) *ResumeMailboxSettingUpdate {
+	rmsu.mutation.SetLinkAllowedDomains(s)
+	return rmsu
+}
+
+// AppendLinkAllowedDomains appends s to the "link_allowed_domains" field.
+func (rmsu *ResumeMailboxSettingUpdate) AppendLinkAllowedDomains(s []string) *ResumeMailboxSettingUpdate {
+	rmsu.mutation.AppendLinkAllowedDomains(s)
+	return rmsu
+}
+
+// ClearLinkAllowedDomains clears the value of the "link_allowed_domains" field.
+func (rmsu *ResumeMailboxSettingUpdate) ClearLinkAllowedDomains() *ResumeMailboxSettingUpdate {
+	rmsu.mutation.ClearLinkAllowedDomains()
+	return rmsu
+}
+
+// SetRules sets the "rules" field.
+func (rmsu *ResumeMailboxSettingUpdate) SetRules(tmr []*types.ResumeMailboxRule) *ResumeMailboxSettingUpdate {
+	rmsu.mutation.SetRules(tmr)
+	return rmsu
+}
+
+// AppendRules appends tmr to the "rules" field.
+func (rmsu *ResumeMailboxSettingUpdate) AppendRules(tmr []*types.ResumeMailboxRule) *ResumeMailboxSettingUpdate {
+	rmsu.mutation.AppendRules(tmr)
+	return rmsu
+}
+
+// ClearRules clears the value of the "rules" field.
+func (rmsu *ResumeMailboxSettingUpdate) ClearRules() *ResumeMailboxSettingUpdate {
+	rmsu.mutation.ClearRules()
+	return rmsu
+}
+
 // SetSyncIntervalMinutes sets the "sync_interval_minutes" field.
 func (rmsu *ResumeMailboxSettingUpdate) SetSyncIntervalMinutes(i int) *ResumeMailboxSettingUpdate {
 	rmsu.mutation.ResetSyncIntervalMinutes()
@@ -487,6 +538,11 @@ func (rmsu *ResumeMailboxSettingUpdate) check() error {
 			return &ValidationError{Name: "auth_type", err: fmt.Errorf(`db: validator failed for field "ResumeMailboxSetting.auth_type": %w`, err)}
 		}
 	}
+	if v, ok := rmsu.mutation.ExtractionMode(); ok {
+		if err := resumemailboxsetting.ExtractionModeValidator(v); err != nil {
+			return &ValidationError{Name: "extraction_mode", err: fmt.Errorf(`db: validator failed for field "ResumeMailboxSetting.extraction_mode": %w`, err)}
+		}
+	}
 	if rmsu.mutation.UploaderCleared() && len(rmsu.mutation.UploaderIDs()) > 0 {
 		return errors.New(`db: clearing a required unique edge "ResumeMailboxSetting.uploader"`)
 	}
@@ -561,6 +617,31 @@ func (rmsu *ResumeMailboxSettingUpdate) sqlSave(ctx context.Context) (n int, err
 	if rmsu.mutation.JobProfileIdsCleared() {
 		_spec.ClearField(resumemailboxsetting.FieldJobProfileIds, field.TypeJSON)
 	}
+	if value, ok := rmsu.mutation.ExtractionMode(); ok {
+		_spec.SetField(resumemailboxsetting.FieldExtractionMode, field.TypeString, value)
+	}
+	if value, ok := rmsu.mutation.LinkAllowedDomains(); ok {
+		_spec.SetField(resumemailboxsetting.FieldLinkAllowedDomains, field.TypeJSON, value)
+	}
+	if value, ok := rmsu.mutation.AppendedLinkAllowedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, resumemailboxsetting.FieldLinkAllowedDomains, value)
+		})
+	}
+	if rmsu.mutation.LinkAllowedDomainsCleared() {
+		_spec.ClearField(resumemailboxsetting.FieldLinkAllowedDomains, field.TypeJSON)
+	}
+	if value, ok := rmsu.mutation.Rules(); ok {
+		_spec.SetField(resumemailboxsetting.FieldRules, field.TypeJSON, value)
+	}
+	if value, ok := rmsu.mutation.AppendedRules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, resumemailboxsetting.FieldRules, value)
+		})
+	}
+	if rmsu.mutation.RulesCleared() {
+		_spec.ClearField(resumemailboxsetting.FieldRules, field.TypeJSON)
+	}
 	if value, ok := rmsu.mutation.SyncIntervalMinutes(); ok {
 		_spec.SetField(resumemailboxsetting.FieldSyncIntervalMinutes, field.TypeInt, value)
 	}
@@ -918,6 +999,56 @@ func (rmsuo *ResumeMailboxSettingUpdateOne) ClearJobProfileIds() *ResumeMailboxS
 	return rmsuo
 }
 
+// SetExtractionMode sets the "extraction_mode" field.
+func (rmsuo *ResumeMailboxSettingUpdateOne) SetExtractionMode(s string) *ResumeMailboxSettingUpdateOne {
+	rmsuo.mutation.SetExtractionMode(s)
+	return rmsuo
+}
+
+// SetNillableExtractionMode sets the "extraction_mode" field if the given value is not nil.
+func (rmsuo *ResumeMailboxSettingUpdateOne) SetNillableExtractionMode(s *string) *ResumeMailboxSettingUpdateOne {
+	if s != nil {
+		rmsuo.SetExtractionMode(*s)
+	}
+	return rmsuo
+}
+
+// SetLinkAllowedDomains sets the "link_allowed_domains" field.
+func (rmsuo *ResumeMailboxSettingUpdateOne) SetLinkAllowedDomains(s []string) *ResumeMailboxSettingUpdateOne {
+	rmsuo.mutation.SetLinkAllowedDomains(s)
+	return rmsuo
+}
+
+// AppendLinkAllowedDomains appends s to the "link_allowed_domains" field.
+func (rmsuo *ResumeMailboxSettingUpdateOne) AppendLinkAllowedDomains(s []string) *ResumeMailboxSettingUpdateOne {
+	rmsuo.mutation.AppendLinkAllowedDomains(s)
+	return rmsuo
+}
+
+// ClearLinkAllowedDomains clears the value of the "link_allowed_domains" field.
+func (rmsuo *ResumeMailboxSettingUpdateOne) ClearLinkAllowedDomains() *ResumeMailboxSettingUpdateOne {
+	rmsuo.mutation.ClearLinkAllowedDomains()
+	return rmsuo
+}
+
+// SetRules sets the "rules" field.
+func (rmsuo *ResumeMailboxSettingUpdateOne) SetRules(tmr []*types.ResumeMailboxRule) *ResumeMailboxSettingUpdateOne {
+	rmsuo.mutation.SetRules(tmr)
+	return rmsuo
+}
+
+// AppendRules appends tmr to the "rules" field.
+func (rmsuo *ResumeMailboxSettingUpdateOne) AppendRules(tmr []*types.ResumeMailboxRule) *ResumeMailboxSettingUpdateOne {
+	rmsuo.mutation.AppendRules(tmr)
+	return rmsuo
+}
+
+// ClearRules clears the value of the "rules" field.
+func (rmsuo *ResumeMailboxSettingUpdateOne) ClearRules() *ResumeMailboxSettingUpdateOne {
+	rmsuo.mutation.ClearRules()
+	return rmsuo
+}
+
 // SetSyncIntervalMinutes sets the "sync_interval_minutes" field.
 func (rmsuo *ResumeMailboxSettingUpdateOne) SetSyncIntervalMinutes(i int) *ResumeMailboxSettingUpdateOne {
 	rmsuo.mutation.ResetSyncIntervalMinutes()
@@ -1201,6 +1332,11 @@ func (rmsuo *ResumeMailboxSettingUpdateOne) check() error {
 			return &ValidationError{Name: "auth_type", err: fmt.Errorf(`db: validator failed for field "ResumeMailboxSetting.auth_type": %w`, err)}
 		}
 	}
+	if v, ok := rmsuo.mutation.ExtractionMode(); ok {
+		if err := resumemailboxsetting.ExtractionModeValidator(v); err != nil {
+			return &ValidationError{Name: "extraction_mode", err: fmt.Errorf(`db: validator failed for field "ResumeMailboxSetting.extraction_mode": %w`, err)}
+		}
+	}
 	if rmsuo.mutation.UploaderCleared() && len(rmsuo.mutation.UploaderIDs()) > 0 {
 		return errors.New(`db: clearing a required unique edge "ResumeMailboxSetting.uploader"`)
 	}
@@ -1292,6 +1428,31 @@ func (rmsuo *ResumeMailboxSettingUpdateOne) sqlSave(ctx context.Context) (_node
 	if rmsuo.mutation.JobProfileIdsCleared() {
 		_spec.ClearField(resumemailboxsetting.FieldJobProfileIds, field.TypeJSON)
 	}
+	if value, ok := rmsuo.mutation.ExtractionMode(); ok {
+		_spec.SetField(resumemailboxsetting.FieldExtractionMode, field.TypeString, value)
+	}
+	if value, ok := rmsuo.mutation.LinkAllowedDomains(); ok {
+		_spec.SetField(resumemailboxsetting.FieldLinkAllowedDomains, field.TypeJSON, value)
+	}
+	if value, ok := rmsuo.mutation.AppendedLinkAllowedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, resumemailboxsetting.FieldLinkAllowedDomains, value)
+		})
+	}
+	if rmsuo.mutation.LinkAllowedDomainsCleared() {
+		_spec.ClearField(resumemailboxsetting.FieldLinkAllowedDomains, field.TypeJSON)
+	}
+	if value, ok := rmsuo.mutation.Rules(); ok {
+		_spec.SetField(resumemailboxsetting.FieldRules, field.TypeJSON, value)
+	}
+	if value, ok := rmsuo.mutation.AppendedRules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, resumemailboxsetting.FieldRules, value)
+		})
+	}
+	if rmsuo.mutation.RulesCleared() {
+		_spec.ClearField(resumemailboxsetting.FieldRules, field.TypeJSON)
+	}
 	if value, ok := rmsuo.mutation.SyncIntervalMinutes(); ok {
 		_spec.SetField(resumemailboxsetting.FieldSyncIntervalMinutes, field.TypeInt, value)
 	}
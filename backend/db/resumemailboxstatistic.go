@@ -31,6 +31,10 @@ type ResumeMailboxStatistic struct {
 	ParsedResumes int `json:"parsed_resumes,omitempty"`
 	// 解析失败数量
 	FailedResumes int `json:"failed_resumes,omitempty"`
+	// 从邮件正文提取入库的简历数量
+	BodyResumes int `json:"body_resumes,omitempty"`
+	// 从邮件链接下载入库的简历数量
+	LinkResumes int `json:"link_resumes,omitempty"`
 	// 被去重或过滤的附件数量
 	SkippedAttachments int `json:"skipped_attachments,omitempty"`
 	// 最近一次同步耗时(毫秒)
@@ -70,7 +74,7 @@ func (*ResumeMailboxStatistic) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case resumemailboxstatistic.FieldSyncedEmails, resumemailboxstatistic.FieldParsedResumes, resumemailboxstatistic.FieldFailedResumes, resumemailboxstatistic.FieldSkippedAttachments, resumemailboxstatistic.FieldLastSyncDurationMs:
+		case resumemailboxstatistic.FieldSyncedEmails, resumemailboxstatistic.FieldParsedResumes, resumemailboxstatistic.FieldFailedResumes, resumemailboxstatistic.FieldBodyResumes, resumemailboxstatistic.FieldLinkResumes, resumemailboxstatistic.FieldSkippedAttachments, resumemailboxstatistic.FieldLastSyncDurationMs:
 			values[i] = new(sql.NullInt64)
 		case resumemailboxstatistic.FieldDeletedAt, resumemailboxstatistic.FieldDate, resumemailboxstatistic.FieldCreatedAt, resumemailboxstatistic.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -133,6 +137,18 @@ func (rms *ResumeMailboxStatistic) assignValues(columns []string, values []any)
 			} else if value.Valid {
 				rms.FailedResumes = int(value.Int64)
 			}
+		case resumemailboxstatistic.FieldBodyResumes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field body_resumes", values[i])
+			} else if value.Valid {
+				rms.BodyResumes = int(value.Int64)
+			}
+		case resumemailboxstatistic.FieldLinkResumes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field link_resumes", values[i])
+			} else if value.Valid {
+				rms.LinkResumes = int(value.Int64)
+			}
 		case resumemailboxstatistic.FieldSkippedAttachments:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field skipped_attachments", values[i])
@@ -216,6 +232,12 @@ func (rms *ResumeMailboxStatistic) String() string {
 	builder.WriteString("failed_resumes=")
 	builder.WriteString(fmt.Sprintf("%v", rms.FailedResumes))
 	builder.WriteString(", ")
+	builder.WriteString("body_resumes=")
+	builder.WriteString(fmt.Sprintf("%v", rms.BodyResumes))
+	builder.WriteString(", ")
+	builder.WriteString("link_resumes=")
+	builder.WriteString(fmt.Sprintf("%v", rms.LinkResumes))
+	builder.WriteString(", ")
 	builder.WriteString("skipped_attachments=")
 	builder.WriteString(fmt.Sprintf("%v", rms.SkippedAttachments))
 	builder.WriteString(", ")
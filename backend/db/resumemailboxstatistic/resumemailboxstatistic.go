@@ -28,6 +28,10 @@ const (
 	FieldParsedResumes = "parsed_resumes"
 	// FieldFailedResumes holds the string denoting the failed_resumes field in the database.
 	FieldFailedResumes = "failed_resumes"
+	// FieldBodyResumes holds the string denoting the body_resumes field in the database.
+	FieldBodyResumes = "body_resumes"
+	// FieldLinkResumes holds the string denoting the link_resumes field in the database.
+	FieldLinkResumes = "link_resumes"
 	// FieldSkippedAttachments holds the string denoting the skipped_attachments field in the database.
 	FieldSkippedAttachments = "skipped_attachments"
 	// FieldLastSyncDurationMs holds the string denoting the last_sync_duration_ms field in the database.
@@ -58,6 +62,8 @@ var Columns = []string{
 	FieldSyncedEmails,
 	FieldParsedResumes,
 	FieldFailedResumes,
+	FieldBodyResumes,
+	FieldLinkResumes,
 	FieldSkippedAttachments,
 	FieldLastSyncDurationMs,
 	FieldCreatedAt,
@@ -88,6 +94,10 @@ var (
 	DefaultParsedResumes int
 	// DefaultFailedResumes holds the default value on creation for the "failed_resumes" field.
 	DefaultFailedResumes int
+	// DefaultBodyResumes holds the default value on creation for the "body_resumes" field.
+	DefaultBodyResumes int
+	// DefaultLinkResumes holds the default value on creation for the "link_resumes" field.
+	DefaultLinkResumes int
 	// DefaultSkippedAttachments holds the default value on creation for the "skipped_attachments" field.
 	DefaultSkippedAttachments int
 	// DefaultLastSyncDurationMs holds the default value on creation for the "last_sync_duration_ms" field.
@@ -140,6 +150,16 @@ func ByFailedResumes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFailedResumes, opts...).ToFunc()
 }
 
+// ByBodyResumes orders the results by the body_resumes field.
+func ByBodyResumes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBodyResumes, opts...).ToFunc()
+}
+
+// ByLinkResumes orders the results by the link_resumes field.
+func ByLinkResumes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLinkResumes, opts...).ToFunc()
+}
+
 // BySkippedAttachments orders the results by the skipped_attachments field.
 func BySkippedAttachments(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSkippedAttachments, opts...).ToFunc()
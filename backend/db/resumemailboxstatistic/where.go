@@ -86,6 +86,16 @@ func FailedResumes(v int) predicate.ResumeMailboxStatistic {
 	return predicate.ResumeMailboxStatistic(sql.FieldEQ(FieldFailedResumes, v))
 }
 
+// BodyResumes applies equality check predicate on the "body_resumes" field. It's identical to BodyResumesEQ.
+func BodyResumes(v int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldEQ(FieldBodyResumes, v))
+}
+
+// LinkResumes applies equality check predicate on the "link_resumes" field. It's identical to LinkResumesEQ.
+func LinkResumes(v int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldEQ(FieldLinkResumes, v))
+}
+
 // SkippedAttachments applies equality check predicate on the "skipped_attachments" field. It's identical to SkippedAttachmentsEQ.
 func SkippedAttachments(v int) predicate.ResumeMailboxStatistic {
 	return predicate.ResumeMailboxStatistic(sql.FieldEQ(FieldSkippedAttachments, v))
@@ -336,6 +346,86 @@ func FailedResumesLTE(v int) predicate.ResumeMailboxStatistic {
 	return predicate.ResumeMailboxStatistic(sql.FieldLTE(FieldFailedResumes, v))
 }
 
+// BodyResumesEQ applies the EQ predicate on the "body_resumes" field.
+func BodyResumesEQ(v int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldEQ(FieldBodyResumes, v))
+}
+
+// BodyResumesNEQ applies the NEQ predicate on the "body_resumes" field.
+func BodyResumesNEQ(v int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldNEQ(FieldBodyResumes, v))
+}
+
+// BodyResumesIn applies the In predicate on the "body_resumes" field.
+func BodyResumesIn(vs ...int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldIn(FieldBodyResumes, vs...))
+}
+
+// BodyResumesNotIn applies the NotIn predicate on the "body_resumes" field.
+func BodyResumesNotIn(vs ...int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldNotIn(FieldBodyResumes, vs...))
+}
+
+// BodyResumesGT applies the GT predicate on the "body_resumes" field.
+func BodyResumesGT(v int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldGT(FieldBodyResumes, v))
+}
+
+// BodyResumesGTE applies the GTE predicate on the "body_resumes" field.
+func BodyResumesGTE(v int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldGTE(FieldBodyResumes, v))
+}
+
+// BodyResumesLT applies the LT predicate on the "body_resumes" field.
+func BodyResumesLT(v int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldLT(FieldBodyResumes, v))
+}
+
+// BodyResumesLTE applies the LTE predicate on the "body_resumes" field.
+func BodyResumesLTE(v int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldLTE(FieldBodyResumes, v))
+}
+
+// LinkResumesEQ applies the EQ predicate on the "link_resumes" field.
+func LinkResumesEQ(v int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldEQ(FieldLinkResumes, v))
+}
+
+// LinkResumesNEQ applies the NEQ predicate on the "link_resumes" field.
+func LinkResumesNEQ(v int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldNEQ(FieldLinkResumes, v))
+}
+
+// LinkResumesIn applies the In predicate on the "link_resumes" field.
+func LinkResumesIn(vs ...int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldIn(FieldLinkResumes, vs...))
+}
+
+// LinkResumesNotIn applies the NotIn predicate on the "link_resumes" field.
+func LinkResumesNotIn(vs ...int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldNotIn(FieldLinkResumes, vs...))
+}
+
+// LinkResumesGT applies the GT predicate on the "link_resumes" field.
+func LinkResumesGT(v int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldGT(FieldLinkResumes, v))
+}
+
+// LinkResumesGTE applies the GTE predicate on the "link_resumes" field.
+func LinkResumesGTE(v int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldGTE(FieldLinkResumes, v))
+}
+
+// LinkResumesLT applies the LT predicate on the "link_resumes" field.
+func LinkResumesLT(v int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldLT(FieldLinkResumes, v))
+}
+
+// LinkResumesLTE applies the LTE predicate on the "link_resumes" field.
+func LinkResumesLTE(v int) predicate.ResumeMailboxStatistic {
+	return predicate.ResumeMailboxStatistic(sql.FieldLTE(FieldLinkResumes, v))
+}
+
 // SkippedAttachmentsEQ applies the EQ predicate on the "skipped_attachments" field.
 func SkippedAttachmentsEQ(v int) predicate.ResumeMailboxStatistic {
 	return predicate.ResumeMailboxStatistic(sql.FieldEQ(FieldSkippedAttachments, v))
@@ -93,6 +93,34 @@ func (rmsc *ResumeMailboxStatisticCreate) SetNillableFailedResumes(i *int) *Resu
 	return rmsc
 }
 
+// SetBodyResumes sets the "body_resumes" field.
+func (rmsc *ResumeMailboxStatisticCreate) SetBodyResumes(i int) *ResumeMailboxStatisticCreate {
+	rmsc.mutation.SetBodyResumes(i)
+	return rmsc
+}
+
+// SetNillableBodyResumes sets the "body_resumes" field if the given value is not nil.
+func (rmsc *ResumeMailboxStatisticCreate) SetNillableBodyResumes(i *int) *ResumeMailboxStatisticCreate {
+	if i != nil {
+		rmsc.SetBodyResumes(*i)
+	}
+	return rmsc
+}
+
+// SetLinkResumes sets the "link_resumes" field.
+func (rmsc *ResumeMailboxStatisticCreate) SetLinkResumes(i int) *ResumeMailboxStatisticCreate {
+	rmsc.mutation.SetLinkResumes(i)
+	return rmsc
+}
+
+// SetNillableLinkResumes sets the "link_resumes" field if the given value is not nil.
+func (rmsc *ResumeMailboxStatisticCreate) SetNillableLinkResumes(i *int) *ResumeMailboxStatisticCreate {
+	if i != nil {
+		rmsc.SetLinkResumes(*i)
+	}
+	return rmsc
+}
+
 // SetSkippedAttachments sets the "skipped_attachments" field.
 func (rmsc *ResumeMailboxStatisticCreate) SetSkippedAttachments(i int) *ResumeMailboxStatisticCreate {
 	rmsc.mutation.SetSkippedAttachments(i)
@@ -217,6 +245,14 @@ func (rmsc *ResumeMailboxStatisticCreate) defaults() error {
 		v := resumemailboxstatistic.DefaultFailedResumes
 		rmsc.mutation.SetFailedResumes(v)
 	}
+	if _, ok := rmsc.mutation.BodyResumes(); !ok {
+		v := resumemailboxstatistic.DefaultBodyResumes
+		rmsc.mutation.SetBodyResumes(v)
+	}
+	if _, ok := rmsc.mutation.LinkResumes(); !ok {
+		v := resumemailboxstatistic.DefaultLinkResumes
+		rmsc.mutation.SetLinkResumes(v)
+	}
 	if _, ok := rmsc.mutation.SkippedAttachments(); !ok {
 		v := resumemailboxstatistic.DefaultSkippedAttachments
 		rmsc.mutation.SetSkippedAttachments(v)
@@ -266,6 +302,12 @@ func (rmsc *ResumeMailboxStatisticCreate) check() error {
 	if _, ok := rmsc.mutation.FailedResumes(); !ok {
 		return &ValidationError{Name: "failed_resumes", err: errors.New(`db: missing required field "ResumeMailboxStatistic.failed_resumes"`)}
 	}
+	if _, ok := rmsc.mutation.BodyResumes(); !ok {
+		return &ValidationError{Name: "body_resumes", err: errors.New(`db: missing required field "ResumeMailboxStatistic.body_resumes"`)}
+	}
+	if _, ok := rmsc.mutation.LinkResumes(); !ok {
+		return &ValidationError{Name: "link_resumes", err: errors.New(`db: missing required field "ResumeMailboxStatistic.link_resumes"`)}
+	}
 	if _, ok := rmsc.mutation.SkippedAttachments(); !ok {
 		return &ValidationError{Name: "skipped_attachments", err: errors.New(`db: missing required field "ResumeMailboxStatistic.skipped_attachments"`)}
 	}
@@ -337,6 +379,14 @@ func (rmsc *ResumeMailboxStatisticCreate) createSpec() (*ResumeMailboxStatistic,
 		_spec.SetField(resumemailboxstatistic.FieldFailedResumes, field.TypeInt, value)
 		_node.FailedResumes = value
 	}
+	if value, ok := rmsc.mutation.BodyResumes(); ok {
+		_spec.SetField(resumemailboxstatistic.FieldBodyResumes, field.TypeInt, value)
+		_node.BodyResumes = value
+	}
+	if value, ok := rmsc.mutation.LinkResumes(); ok {
+		_spec.SetField(resumemailboxstatistic.FieldLinkResumes, field.TypeInt, value)
+		_node.LinkResumes = value
+	}
 	if value, ok := rmsc.mutation.SkippedAttachments(); ok {
 		_spec.SetField(resumemailboxstatistic.FieldSkippedAttachments, field.TypeInt, value)
 		_node.SkippedAttachments = value
@@ -518,6 +568,42 @@ func (u *ResumeMailboxStatisticUpsert) AddFailedResumes(v int) *ResumeMailboxSta
 	return u
 }
 
+// SetBodyResumes sets the "body_resumes" field.
+func (u *ResumeMailboxStatisticUpsert) SetBodyResumes(v int) *ResumeMailboxStatisticUpsert {
+	u.Set(resumemailboxstatistic.FieldBodyResumes, v)
+	return u
+}
+
+// UpdateBodyResumes sets the "body_resumes" field to the value that was provided on create.
+func (u *ResumeMailboxStatisticUpsert) UpdateBodyResumes() *ResumeMailboxStatisticUpsert {
+	u.SetExcluded(resumemailboxstatistic.FieldBodyResumes)
+	return u
+}
+
+// AddBodyResumes adds v to the "body_resumes" field.
+func (u *ResumeMailboxStatisticUpsert) AddBodyResumes(v int) *ResumeMailboxStatisticUpsert {
+	u.Add(resumemailboxstatistic.FieldBodyResumes, v)
+	return u
+}
+
+// SetLinkResumes sets the "link_resumes" field.
+func (u *ResumeMailboxStatisticUpsert) SetLinkResumes(v int) *ResumeMailboxStatisticUpsert {
+	u.Set(resumemailboxstatistic.FieldLinkResumes, v)
+	return u
+}
+
+// UpdateLinkResumes sets the "link_resumes" field to the value that was provided on create.
+func (u *ResumeMailboxStatisticUpsert) UpdateLinkResumes() *ResumeMailboxStatisticUpsert {
+	u.SetExcluded(resumemailboxstatistic.FieldLinkResumes)
+	return u
+}
+
+// AddLinkResumes adds v to the "link_resumes" field.
+func (u *ResumeMailboxStatisticUpsert) AddLinkResumes(v int) *ResumeMailboxStatisticUpsert {
+	u.Add(resumemailboxstatistic.FieldLinkResumes, v)
+	return u
+}
+
 // SetSkippedAttachments sets the "skipped_attachments" field.
 func (u *ResumeMailboxStatisticUpsert) SetSkippedAttachments(v int) *ResumeMailboxStatisticUpsert {
 	u.Set(resumemailboxstatistic.FieldSkippedAttachments, v)
@@ -729,6 +815,48 @@ func (u *ResumeMailboxStatisticUpsertOne) UpdateFailedResumes() *ResumeMailboxSt
 	})
 }
 
+// SetBodyResumes sets the "body_resumes" field.
+func (u *ResumeMailboxStatisticUpsertOne) SetBodyResumes(v int) *ResumeMailboxStatisticUpsertOne {
+	return u.Update(func(s *ResumeMailboxStatisticUpsert) {
+		s.SetBodyResumes(v)
+	})
+}
+
+// AddBodyResumes adds v to the "body_resumes" field.
+func (u *ResumeMailboxStatisticUpsertOne) AddBodyResumes(v int) *ResumeMailboxStatisticUpsertOne {
+	return u.Update(func(s *ResumeMailboxStatisticUpsert) {
+		s.AddBodyResumes(v)
+	})
+}
+
+// UpdateBodyResumes sets the "body_resumes" field to the value that was provided on create.
+func (u *ResumeMailboxStatisticUpsertOne) UpdateBodyResumes() *ResumeMailboxStatisticUpsertOne {
+	return u.Update(func(s *ResumeMailboxStatisticUpsert) {
+		s.UpdateBodyResumes()
+	})
+}
+
+// SetLinkResumes sets the "link_resumes" field.
+func (u *ResumeMailboxStatisticUpsertOne) SetLinkResumes(v int) *ResumeMailboxStatisticUpsertOne {
+	return u.Update(func(s *ResumeMailboxStatisticUpsert) {
+		s.SetLinkResumes(v)
+	})
+}
+
+// AddLinkResumes adds v to the "link_resumes" field.
+func (u *ResumeMailboxStatisticUpsertOne) AddLinkResumes(v int) *ResumeMailboxStatisticUpsertOne {
+	return u.Update(func(s *ResumeMailboxStatisticUpsert) {
+		s.AddLinkResumes(v)
+	})
+}
+
+// UpdateLinkResumes sets the "link_resumes" field to the value that was provided on create.
+func (u *ResumeMailboxStatisticUpsertOne) UpdateLinkResumes() *ResumeMailboxStatisticUpsertOne {
+	return u.Update(func(s *ResumeMailboxStatisticUpsert) {
+		s.UpdateLinkResumes()
+	})
+}
+
 // SetSkippedAttachments sets the "skipped_attachments" field.
 func (u *ResumeMailboxStatisticUpsertOne) SetSkippedAttachments(v int) *ResumeMailboxStatisticUpsertOne {
 	return u.Update(func(s *ResumeMailboxStatisticUpsert) {
@@ -1115,6 +1243,48 @@ func (u *ResumeMailboxStatisticUpsertBulk) UpdateFailedResumes() *ResumeMailboxS
 	})
 }
 
+// SetBodyResumes sets the "body_resumes" field.
+func (u *ResumeMailboxStatisticUpsertBulk) SetBodyResumes(v int) *ResumeMailboxStatisticUpsertBulk {
+	return u.Update(func(s *ResumeMailboxStatisticUpsert) {
+		s.SetBodyResumes(v)
+	})
+}
+
+// AddBodyResumes adds v to the "body_resumes" field.
+func (u *ResumeMailboxStatisticUpsertBulk) AddBodyResumes(v int) *ResumeMailboxStatisticUpsertBulk {
+	return u.Update(func(s *ResumeMailboxStatisticUpsert) {
+		s.AddBodyResumes(v)
+	})
+}
+
+// UpdateBodyResumes sets the "body_resumes" field to the value that was provided on create.
+func (u *ResumeMailboxStatisticUpsertBulk) UpdateBodyResumes() *ResumeMailboxStatisticUpsertBulk {
+	return u.Update(func(s *ResumeMailboxStatisticUpsert) {
+		s.UpdateBodyResumes()
+	})
+}
+
+// SetLinkResumes sets the "link_resumes" field.
+func (u *ResumeMailboxStatisticUpsertBulk) SetLinkResumes(v int) *ResumeMailboxStatisticUpsertBulk {
+	return u.Update(func(s *ResumeMailboxStatisticUpsert) {
+		s.SetLinkResumes(v)
+	})
+}
+
+// AddLinkResumes adds v to the "link_resumes" field.
+func (u *ResumeMailboxStatisticUpsertBulk) AddLinkResumes(v int) *ResumeMailboxStatisticUpsertBulk {
+	return u.Update(func(s *ResumeMailboxStatisticUpsert) {
+		s.AddLinkResumes(v)
+	})
+}
+
+// UpdateLinkResumes sets the "link_resumes" field to the value that was provided on create.
+func (u *ResumeMailboxStatisticUpsertBulk) UpdateLinkResumes() *ResumeMailboxStatisticUpsertBulk {
+	return u.Update(func(s *ResumeMailboxStatisticUpsert) {
+		s.UpdateLinkResumes()
+	})
+}
+
 // SetSkippedAttachments sets the "skipped_attachments" field.
 func (u *ResumeMailboxStatisticUpsertBulk) SetSkippedAttachments(v int) *ResumeMailboxStatisticUpsertBulk {
 	return u.Update(func(s *ResumeMailboxStatisticUpsert) {
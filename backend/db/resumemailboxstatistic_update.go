@@ -142,6 +142,48 @@ func (rmsu *ResumeMailboxStatisticUpdate) AddFailedResumes(i int) *ResumeMailbox
 	return rmsu
 }
 
+// SetBodyResumes sets the "body_resumes" field.
+func (rmsu *ResumeMailboxStatisticUpdate) SetBodyResumes(i int) *ResumeMailboxStatisticUpdate {
+	rmsu.mutation.ResetBodyResumes()
+	rmsu.mutation.SetBodyResumes(i)
+	return rmsu
+}
+
+// SetNillableBodyResumes sets the "body_resumes" field if the given value is not nil.
+func (rmsu *ResumeMailboxStatisticUpdate) SetNillableBodyResumes(i *int) *ResumeMailboxStatisticUpdate {
+	if i != nil {
+		rmsu.SetBodyResumes(*i)
+	}
+	return rmsu
+}
+
+// AddBodyResumes adds i to the "body_resumes" field.
+func (rmsu *ResumeMailboxStatisticUpdate) AddBodyResumes(i int) *ResumeMailboxStatisticUpdate {
+	rmsu.mutation.AddBodyResumes(i)
+	return rmsu
+}
+
+// SetLinkResumes sets the "link_resumes" field.
+func (rmsu *ResumeMailboxStatisticUpdate) SetLinkResumes(i int) *ResumeMailboxStatisticUpdate {
+	rmsu.mutation.ResetLinkResumes()
+	rmsu.mutation.SetLinkResumes(i)
+	return rmsu
+}
+
+// SetNillableLinkResumes sets the "link_resumes" field if the given value is not nil.
+func (rmsu *ResumeMailboxStatisticUpdate) SetNillableLinkResumes(i *int) *ResumeMailboxStatisticUpdate {
+	if i != nil {
+		rmsu.SetLinkResumes(*i)
+	}
+	return rmsu
+}
+
+// AddLinkResumes adds i to the "link_resumes" field.
+func (rmsu *ResumeMailboxStatisticUpdate) AddLinkResumes(i int) *ResumeMailboxStatisticUpdate {
+	rmsu.mutation.AddLinkResumes(i)
+	return rmsu
+}
+
 // SetSkippedAttachments sets the "skipped_attachments" field.
 func (rmsu *ResumeMailboxStatisticUpdate) SetSkippedAttachments(i int) *ResumeMailboxStatisticUpdate {
 	rmsu.mutation.ResetSkippedAttachments()
@@ -301,6 +343,18 @@ func (rmsu *ResumeMailboxStatisticUpdate) sqlSave(ctx context.Context) (n int, e
 	if value, ok := rmsu.mutation.AddedFailedResumes(); ok {
 		_spec.AddField(resumemailboxstatistic.FieldFailedResumes, field.TypeInt, value)
 	}
+	if value, ok := rmsu.mutation.BodyResumes(); ok {
+		_spec.SetField(resumemailboxstatistic.FieldBodyResumes, field.TypeInt, value)
+	}
+	if value, ok := rmsu.mutation.AddedBodyResumes(); ok {
+		_spec.AddField(resumemailboxstatistic.FieldBodyResumes, field.TypeInt, value)
+	}
+	if value, ok := rmsu.mutation.LinkResumes(); ok {
+		_spec.SetField(resumemailboxstatistic.FieldLinkResumes, field.TypeInt, value)
+	}
+	if value, ok := rmsu.mutation.AddedLinkResumes(); ok {
+		_spec.AddField(resumemailboxstatistic.FieldLinkResumes, field.TypeInt, value)
+	}
 	if value, ok := rmsu.mutation.SkippedAttachments(); ok {
 		_spec.SetField(resumemailboxstatistic.FieldSkippedAttachments, field.TypeInt, value)
 	}
@@ -478,6 +532,48 @@ func (rmsuo *ResumeMailboxStatisticUpdateOne) AddFailedResumes(i int) *ResumeMai
 	return rmsuo
 }
 
+// SetBodyResumes sets the "body_resumes" field.
+func (rmsuo *ResumeMailboxStatisticUpdateOne) SetBodyResumes(i int) *ResumeMailboxStatisticUpdateOne {
+	rmsuo.mutation.ResetBodyResumes()
+	rmsuo.mutation.SetBodyResumes(i)
+	return rmsuo
+}
+
+// SetNillableBodyResumes sets the "body_resumes" field if the given value is not nil.
+func (rmsuo *ResumeMailboxStatisticUpdateOne) SetNillableBodyResumes(i *int) *ResumeMailboxStatisticUpdateOne {
+	if i != nil {
+		rmsuo.SetBodyResumes(*i)
+	}
+	return rmsuo
+}
+
+// AddBodyResumes adds i to the "body_resumes" field.
+func (rmsuo *ResumeMailboxStatisticUpdateOne) AddBodyResumes(i int) *ResumeMailboxStatisticUpdateOne {
+	rmsuo.mutation.AddBodyResumes(i)
+	return rmsuo
+}
+
+// SetLinkResumes sets the "link_resumes" field.
+func (rmsuo *ResumeMailboxStatisticUpdateOne) SetLinkResumes(i int) *ResumeMailboxStatisticUpdateOne {
+	rmsuo.mutation.ResetLinkResumes()
+	rmsuo.mutation.SetLinkResumes(i)
+	return rmsuo
+}
+
+// SetNillableLinkResumes sets the "link_resumes" field if the given value is not nil.
+func (rmsuo *ResumeMailboxStatisticUpdateOne) SetNillableLinkResumes(i *int) *ResumeMailboxStatisticUpdateOne {
+	if i != nil {
+		rmsuo.SetLinkResumes(*i)
+	}
+	return rmsuo
+}
+
+// AddLinkResumes adds i to the "link_resumes" field.
+func (rmsuo *ResumeMailboxStatisticUpdateOne) AddLinkResumes(i int) *ResumeMailboxStatisticUpdateOne {
+	rmsuo.mutation.AddLinkResumes(i)
+	return rmsuo
+}
+
 // SetSkippedAttachments sets the "skipped_attachments" field.
 func (rmsuo *ResumeMailboxStatisticUpdateOne) SetSkippedAttachments(i int) *ResumeMailboxStatisticUpdateOne {
 	rmsuo.mutation.ResetSkippedAttachments()
@@ -667,6 +763,18 @@ func (rmsuo *ResumeMailboxStatisticUpdateOne) sqlSave(ctx context.Context) (_nod
 	if value, ok := rmsuo.mutation.AddedFailedResumes(); ok {
 		_spec.AddField(resumemailboxstatistic.FieldFailedResumes, field.TypeInt, value)
 	}
+	if value, ok := rmsuo.mutation.BodyResumes(); ok {
+		_spec.SetField(resumemailboxstatistic.FieldBodyResumes, field.TypeInt, value)
+	}
+	if value, ok := rmsuo.mutation.AddedBodyResumes(); ok {
+		_spec.AddField(resumemailboxstatistic.FieldBodyResumes, field.TypeInt, value)
+	}
+	if value, ok := rmsuo.mutation.LinkResumes(); ok {
+		_spec.SetField(resumemailboxstatistic.FieldLinkResumes, field.TypeInt, value)
+	}
+	if value, ok := rmsuo.mutation.AddedLinkResumes(); ok {
+		_spec.AddField(resumemailboxstatistic.FieldLinkResumes, field.TypeInt, value)
+	}
 	if value, ok := rmsuo.mutation.SkippedAttachments(); ok {
 		_spec.SetField(resumemailboxstatistic.FieldSkippedAttachments, field.TypeInt, value)
 	}
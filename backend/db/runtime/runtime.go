@@ -23,7 +23,9 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db/notificationevent"
 	"github.com/chaitin/WhaleHire/backend/db/notificationsetting"
 	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumechunk"
 	"github.com/chaitin/WhaleHire/backend/db/resumedocumentparse"
+	"github.com/chaitin/WhaleHire/backend/db/resumeduplicate"
 	"github.com/chaitin/WhaleHire/backend/db/resumeeducation"
 	"github.com/chaitin/WhaleHire/backend/db/resumeexperience"
 	"github.com/chaitin/WhaleHire/backend/db/resumejobapplication"
@@ -34,9 +36,11 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db/resumeproject"
 	"github.com/chaitin/WhaleHire/backend/db/resumeskill"
 	"github.com/chaitin/WhaleHire/backend/db/role"
+	"github.com/chaitin/WhaleHire/backend/db/screeningdimension"
 	"github.com/chaitin/WhaleHire/backend/db/screeningnoderun"
 	"github.com/chaitin/WhaleHire/backend/db/screeningresult"
 	"github.com/chaitin/WhaleHire/backend/db/screeningrunmetric"
+	"github.com/chaitin/WhaleHire/backend/db/screeningschedule"
 	"github.com/chaitin/WhaleHire/backend/db/screeningtask"
 	"github.com/chaitin/WhaleHire/backend/db/screeningtaskresume"
 	"github.com/chaitin/WhaleHire/backend/db/setting"
@@ -435,11 +439,11 @@ func init() {
 	// notificationevent.DefaultTimeout holds the default value on creation for the timeout field.
 	notificationevent.DefaultTimeout = notificationeventDescTimeout.Default.(int)
 	// notificationeventDescCreatedAt is the schema descriptor for created_at field.
-	notificationeventDescCreatedAt := notificationeventFields[14].Descriptor()
+	notificationeventDescCreatedAt := notificationeventFields[15].Descriptor()
 	// notificationevent.DefaultCreatedAt holds the default value on creation for the created_at field.
 	notificationevent.DefaultCreatedAt = notificationeventDescCreatedAt.Default.(func() time.Time)
 	// notificationeventDescUpdatedAt is the schema descriptor for updated_at field.
-	notificationeventDescUpdatedAt := notificationeventFields[15].Descriptor()
+	notificationeventDescUpdatedAt := notificationeventFields[16].Descriptor()
 	// notificationevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	notificationevent.DefaultUpdatedAt = notificationeventDescUpdatedAt.Default.(func() time.Time)
 	// notificationevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -464,19 +468,19 @@ func init() {
 	// notificationsetting.DefaultEnabled holds the default value on creation for the enabled field.
 	notificationsetting.DefaultEnabled = notificationsettingDescEnabled.Default.(bool)
 	// notificationsettingDescMaxRetry is the schema descriptor for max_retry field.
-	notificationsettingDescMaxRetry := notificationsettingFields[5].Descriptor()
+	notificationsettingDescMaxRetry := notificationsettingFields[8].Descriptor()
 	// notificationsetting.DefaultMaxRetry holds the default value on creation for the max_retry field.
 	notificationsetting.DefaultMaxRetry = notificationsettingDescMaxRetry.Default.(int)
 	// notificationsettingDescTimeout is the schema descriptor for timeout field.
-	notificationsettingDescTimeout := notificationsettingFields[6].Descriptor()
+	notificationsettingDescTimeout := notificationsettingFields[9].Descriptor()
 	// notificationsetting.DefaultTimeout holds the default value on creation for the timeout field.
 	notificationsetting.DefaultTimeout = notificationsettingDescTimeout.Default.(int)
 	// notificationsettingDescCreatedAt is the schema descriptor for created_at field.
-	notificationsettingDescCreatedAt := notificationsettingFields[8].Descriptor()
+	notificationsettingDescCreatedAt := notificationsettingFields[11].Descriptor()
 	// notificationsetting.DefaultCreatedAt holds the default value on creation for the created_at field.
 	notificationsetting.DefaultCreatedAt = notificationsettingDescCreatedAt.Default.(func() time.Time)
 	// notificationsettingDescUpdatedAt is the schema descriptor for updated_at field.
-	notificationsettingDescUpdatedAt := notificationsettingFields[9].Descriptor()
+	notificationsettingDescUpdatedAt := notificationsettingFields[12].Descriptor()
 	// notificationsetting.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	notificationsetting.DefaultUpdatedAt = notificationsettingDescUpdatedAt.Default.(func() time.Time)
 	// notificationsetting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -496,16 +500,20 @@ func init() {
 	resumeDescHighestEducation := resumeFields[9].Descriptor()
 	// resume.HighestEducationValidator is a validator for the "highest_education" field. It is called by the builders before save.
 	resume.HighestEducationValidator = resumeDescHighestEducation.Validators[0].(func(string) error)
+	// resumeDescFileHash is the schema descriptor for file_hash field.
+	resumeDescFileHash := resumeFields[19].Descriptor()
+	// resume.FileHashValidator is a validator for the "file_hash" field. It is called by the builders before save.
+	resume.FileHashValidator = resumeDescFileHash.Validators[0].(func(string) error)
 	// resumeDescStatus is the schema descriptor for status field.
-	resumeDescStatus := resumeFields[18].Descriptor()
+	resumeDescStatus := resumeFields[25].Descriptor()
 	// resume.DefaultStatus holds the default value on creation for the status field.
 	resume.DefaultStatus = resumeDescStatus.Default.(string)
 	// resumeDescCreatedAt is the schema descriptor for created_at field.
-	resumeDescCreatedAt := resumeFields[21].Descriptor()
+	resumeDescCreatedAt := resumeFields[28].Descriptor()
 	// resume.DefaultCreatedAt holds the default value on creation for the created_at field.
 	resume.DefaultCreatedAt = resumeDescCreatedAt.Default.(func() time.Time)
 	// resumeDescUpdatedAt is the schema descriptor for updated_at field.
-	resumeDescUpdatedAt := resumeFields[22].Descriptor()
+	resumeDescUpdatedAt := resumeFields[29].Descriptor()
 	// resume.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	resume.DefaultUpdatedAt = resumeDescUpdatedAt.Default.(func() time.Time)
 	// resume.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -514,6 +522,26 @@ func init() {
 	resumeDescID := resumeFields[0].Descriptor()
 	// resume.DefaultID holds the default value on creation for the id field.
 	resume.DefaultID = resumeDescID.Default.(func() uuid.UUID)
+	resumechunkFields := schema.ResumeChunk{}.Fields()
+	_ = resumechunkFields
+	// resumechunkDescChunkIndex is the schema descriptor for chunk_index field.
+	resumechunkDescChunkIndex := resumechunkFields[4].Descriptor()
+	// resumechunk.DefaultChunkIndex holds the default value on creation for the chunk_index field.
+	resumechunk.DefaultChunkIndex = resumechunkDescChunkIndex.Default.(int)
+	// resumechunkDescCreatedAt is the schema descriptor for created_at field.
+	resumechunkDescCreatedAt := resumechunkFields[7].Descriptor()
+	// resumechunk.DefaultCreatedAt holds the default value on creation for the created_at field.
+	resumechunk.DefaultCreatedAt = resumechunkDescCreatedAt.Default.(func() time.Time)
+	// resumechunkDescUpdatedAt is the schema descriptor for updated_at field.
+	resumechunkDescUpdatedAt := resumechunkFields[8].Descriptor()
+	// resumechunk.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	resumechunk.DefaultUpdatedAt = resumechunkDescUpdatedAt.Default.(func() time.Time)
+	// resumechunk.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	resumechunk.UpdateDefaultUpdatedAt = resumechunkDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// resumechunkDescID is the schema descriptor for id field.
+	resumechunkDescID := resumechunkFields[0].Descriptor()
+	// resumechunk.DefaultID holds the default value on creation for the id field.
+	resumechunk.DefaultID = resumechunkDescID.Default.(func() uuid.UUID)
 	resumedocumentparseMixin := schema.ResumeDocumentParse{}.Mixin()
 	resumedocumentparseMixinHooks0 := resumedocumentparseMixin[0].Hooks()
 	resumedocumentparse.Hooks[0] = resumedocumentparseMixinHooks0[0]
@@ -526,11 +554,11 @@ func init() {
 	// resumedocumentparse.DefaultStatus holds the default value on creation for the status field.
 	resumedocumentparse.DefaultStatus = resumedocumentparseDescStatus.Default.(string)
 	// resumedocumentparseDescCreatedAt is the schema descriptor for created_at field.
-	resumedocumentparseDescCreatedAt := resumedocumentparseFields[10].Descriptor()
+	resumedocumentparseDescCreatedAt := resumedocumentparseFields[11].Descriptor()
 	// resumedocumentparse.DefaultCreatedAt holds the default value on creation for the created_at field.
 	resumedocumentparse.DefaultCreatedAt = resumedocumentparseDescCreatedAt.Default.(func() time.Time)
 	// resumedocumentparseDescUpdatedAt is the schema descriptor for updated_at field.
-	resumedocumentparseDescUpdatedAt := resumedocumentparseFields[11].Descriptor()
+	resumedocumentparseDescUpdatedAt := resumedocumentparseFields[12].Descriptor()
 	// resumedocumentparse.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	resumedocumentparse.DefaultUpdatedAt = resumedocumentparseDescUpdatedAt.Default.(func() time.Time)
 	// resumedocumentparse.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -539,6 +567,26 @@ func init() {
 	resumedocumentparseDescID := resumedocumentparseFields[0].Descriptor()
 	// resumedocumentparse.DefaultID holds the default value on creation for the id field.
 	resumedocumentparse.DefaultID = resumedocumentparseDescID.Default.(func() uuid.UUID)
+	resumeduplicateFields := schema.ResumeDuplicate{}.Fields()
+	_ = resumeduplicateFields
+	// resumeduplicateDescStatus is the schema descriptor for status field.
+	resumeduplicateDescStatus := resumeduplicateFields[5].Descriptor()
+	// resumeduplicate.DefaultStatus holds the default value on creation for the status field.
+	resumeduplicate.DefaultStatus = consts.ResumeDuplicateStatus(resumeduplicateDescStatus.Default.(string))
+	// resumeduplicateDescCreatedAt is the schema descriptor for created_at field.
+	resumeduplicateDescCreatedAt := resumeduplicateFields[8].Descriptor()
+	// resumeduplicate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	resumeduplicate.DefaultCreatedAt = resumeduplicateDescCreatedAt.Default.(func() time.Time)
+	// resumeduplicateDescUpdatedAt is the schema descriptor for updated_at field.
+	resumeduplicateDescUpdatedAt := resumeduplicateFields[9].Descriptor()
+	// resumeduplicate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	resumeduplicate.DefaultUpdatedAt = resumeduplicateDescUpdatedAt.Default.(func() time.Time)
+	// resumeduplicate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	resumeduplicate.UpdateDefaultUpdatedAt = resumeduplicateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// resumeduplicateDescID is the schema descriptor for id field.
+	resumeduplicateDescID := resumeduplicateFields[0].Descriptor()
+	// resumeduplicate.DefaultID holds the default value on creation for the id field.
+	resumeduplicate.DefaultID = resumeduplicateDescID.Default.(func() uuid.UUID)
 	resumeeducationMixin := schema.ResumeEducation{}.Mixin()
 	resumeeducationMixinHooks0 := resumeeducationMixin[0].Hooks()
 	resumeeducation.Hooks[0] = resumeeducationMixinHooks0[0]
@@ -697,20 +745,26 @@ func init() {
 	resumemailboxsetting.DefaultAuthType = resumemailboxsettingDescAuthType.Default.(string)
 	// resumemailboxsetting.AuthTypeValidator is a validator for the "auth_type" field. It is called by the builders before save.
 	resumemailboxsetting.AuthTypeValidator = resumemailboxsettingDescAuthType.Validators[0].(func(string) error)
+	// resumemailboxsettingDescExtractionMode is the schema descriptor for extraction_mode field.
+	resumemailboxsettingDescExtractionMode := resumemailboxsettingFields[12].Descriptor()
+	// resumemailboxsetting.DefaultExtractionMode holds the default value on creation for the extraction_mode field.
+	resumemailboxsetting.DefaultExtractionMode = resumemailboxsettingDescExtractionMode.Default.(string)
+	// resumemailboxsetting.ExtractionModeValidator is a validator for the "extraction_mode" field. It is called by the builders before save.
+	resumemailboxsetting.ExtractionModeValidator = resumemailboxsettingDescExtractionMode.Validators[0].(func(string) error)
 	// resumemailboxsettingDescStatus is the schema descriptor for status field.
-	resumemailboxsettingDescStatus := resumemailboxsettingFields[13].Descriptor()
+	resumemailboxsettingDescStatus := resumemailboxsettingFields[16].Descriptor()
 	// resumemailboxsetting.DefaultStatus holds the default value on creation for the status field.
 	resumemailboxsetting.DefaultStatus = resumemailboxsettingDescStatus.Default.(string)
 	// resumemailboxsettingDescRetryCount is the schema descriptor for retry_count field.
-	resumemailboxsettingDescRetryCount := resumemailboxsettingFields[16].Descriptor()
+	resumemailboxsettingDescRetryCount := resumemailboxsettingFields[19].Descriptor()
 	// resumemailboxsetting.DefaultRetryCount holds the default value on creation for the retry_count field.
 	resumemailboxsetting.DefaultRetryCount = resumemailboxsettingDescRetryCount.Default.(int)
 	// resumemailboxsettingDescCreatedAt is the schema descriptor for created_at field.
-	resumemailboxsettingDescCreatedAt := resumemailboxsettingFields[17].Descriptor()
+	resumemailboxsettingDescCreatedAt := resumemailboxsettingFields[20].Descriptor()
 	// resumemailboxsetting.DefaultCreatedAt holds the default value on creation for the created_at field.
 	resumemailboxsetting.DefaultCreatedAt = resumemailboxsettingDescCreatedAt.Default.(func() time.Time)
 	// resumemailboxsettingDescUpdatedAt is the schema descriptor for updated_at field.
-	resumemailboxsettingDescUpdatedAt := resumemailboxsettingFields[18].Descriptor()
+	resumemailboxsettingDescUpdatedAt := resumemailboxsettingFields[21].Descriptor()
 	// resumemailboxsetting.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	resumemailboxsetting.DefaultUpdatedAt = resumemailboxsettingDescUpdatedAt.Default.(func() time.Time)
 	// resumemailboxsetting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -738,20 +792,28 @@ func init() {
 	resumemailboxstatisticDescFailedResumes := resumemailboxstatisticFields[5].Descriptor()
 	// resumemailboxstatistic.DefaultFailedResumes holds the default value on creation for the failed_resumes field.
 	resumemailboxstatistic.DefaultFailedResumes = resumemailboxstatisticDescFailedResumes.Default.(int)
+	// resumemailboxstatisticDescBodyResumes is the schema descriptor for body_resumes field.
+	resumemailboxstatisticDescBodyResumes := resumemailboxstatisticFields[6].Descriptor()
+	// resumemailboxstatistic.DefaultBodyResumes holds the default value on creation for the body_resumes field.
+	resumemailboxstatistic.DefaultBodyResumes = resumemailboxstatisticDescBodyResumes.Default.(int)
+	// resumemailboxstatisticDescLinkResumes is the schema descriptor for link_resumes field.
+	resumemailboxstatisticDescLinkResumes := resumemailboxstatisticFields[7].Descriptor()
+	// resumemailboxstatistic.DefaultLinkResumes holds the default value on creation for the link_resumes field.
+	resumemailboxstatistic.DefaultLinkResumes = resumemailboxstatisticDescLinkResumes.Default.(int)
 	// resumemailboxstatisticDescSkippedAttachments is the schema descriptor for skipped_attachments field.
-	resumemailboxstatisticDescSkippedAttachments := resumemailboxstatisticFields[6].Descriptor()
+	resumemailboxstatisticDescSkippedAttachments := resumemailboxstatisticFields[8].Descriptor()
 	// resumemailboxstatistic.DefaultSkippedAttachments holds the default value on creation for the skipped_attachments field.
 	resumemailboxstatistic.DefaultSkippedAttachments = resumemailboxstatisticDescSkippedAttachments.Default.(int)
 	// resumemailboxstatisticDescLastSyncDurationMs is the schema descriptor for last_sync_duration_ms field.
-	resumemailboxstatisticDescLastSyncDurationMs := resumemailboxstatisticFields[7].Descriptor()
+	resumemailboxstatisticDescLastSyncDurationMs := resumemailboxstatisticFields[9].Descriptor()
 	// resumemailboxstatistic.DefaultLastSyncDurationMs holds the default value on creation for the last_sync_duration_ms field.
 	resumemailboxstatistic.DefaultLastSyncDurationMs = resumemailboxstatisticDescLastSyncDurationMs.Default.(int)
 	// resumemailboxstatisticDescCreatedAt is the schema descriptor for created_at field.
-	resumemailboxstatisticDescCreatedAt := resumemailboxstatisticFields[8].Descriptor()
+	resumemailboxstatisticDescCreatedAt := resumemailboxstatisticFields[10].Descriptor()
 	// resumemailboxstatistic.DefaultCreatedAt holds the default value on creation for the created_at field.
 	resumemailboxstatistic.DefaultCreatedAt = resumemailboxstatisticDescCreatedAt.Default.(func() time.Time)
 	// resumemailboxstatisticDescUpdatedAt is the schema descriptor for updated_at field.
-	resumemailboxstatisticDescUpdatedAt := resumemailboxstatisticFields[9].Descriptor()
+	resumemailboxstatisticDescUpdatedAt := resumemailboxstatisticFields[11].Descriptor()
 	// resumemailboxstatistic.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	resumemailboxstatistic.DefaultUpdatedAt = resumemailboxstatisticDescUpdatedAt.Default.(func() time.Time)
 	// resumemailboxstatistic.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -808,6 +870,43 @@ func init() {
 	roleDescCreatedAt := roleFields[3].Descriptor()
 	// role.DefaultCreatedAt holds the default value on creation for the created_at field.
 	role.DefaultCreatedAt = roleDescCreatedAt.Default.(func() time.Time)
+	screeningdimensionMixin := schema.ScreeningDimension{}.Mixin()
+	screeningdimensionMixinHooks0 := screeningdimensionMixin[0].Hooks()
+	screeningdimension.Hooks[0] = screeningdimensionMixinHooks0[0]
+	screeningdimensionMixinInters0 := screeningdimensionMixin[0].Interceptors()
+	screeningdimension.Interceptors[0] = screeningdimensionMixinInters0[0]
+	screeningdimensionFields := schema.ScreeningDimension{}.Fields()
+	_ = screeningdimensionFields
+	// screeningdimensionDescKey is the schema descriptor for key field.
+	screeningdimensionDescKey := screeningdimensionFields[2].Descriptor()
+	// screeningdimension.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	screeningdimension.KeyValidator = screeningdimensionDescKey.Validators[0].(func(string) error)
+	// screeningdimensionDescName is the schema descriptor for name field.
+	screeningdimensionDescName := screeningdimensionFields[3].Descriptor()
+	// screeningdimension.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	screeningdimension.NameValidator = screeningdimensionDescName.Validators[0].(func(string) error)
+	// screeningdimensionDescDescription is the schema descriptor for description field.
+	screeningdimensionDescDescription := screeningdimensionFields[4].Descriptor()
+	// screeningdimension.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	screeningdimension.DescriptionValidator = screeningdimensionDescDescription.Validators[0].(func(string) error)
+	// screeningdimensionDescWeight is the schema descriptor for weight field.
+	screeningdimensionDescWeight := screeningdimensionFields[6].Descriptor()
+	// screeningdimension.DefaultWeight holds the default value on creation for the weight field.
+	screeningdimension.DefaultWeight = screeningdimensionDescWeight.Default.(float64)
+	// screeningdimensionDescCreatedAt is the schema descriptor for created_at field.
+	screeningdimensionDescCreatedAt := screeningdimensionFields[8].Descriptor()
+	// screeningdimension.DefaultCreatedAt holds the default value on creation for the created_at field.
+	screeningdimension.DefaultCreatedAt = screeningdimensionDescCreatedAt.Default.(func() time.Time)
+	// screeningdimensionDescUpdatedAt is the schema descriptor for updated_at field.
+	screeningdimensionDescUpdatedAt := screeningdimensionFields[9].Descriptor()
+	// screeningdimension.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	screeningdimension.DefaultUpdatedAt = screeningdimensionDescUpdatedAt.Default.(func() time.Time)
+	// screeningdimension.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	screeningdimension.UpdateDefaultUpdatedAt = screeningdimensionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// screeningdimensionDescID is the schema descriptor for id field.
+	screeningdimensionDescID := screeningdimensionFields[0].Descriptor()
+	// screeningdimension.DefaultID holds the default value on creation for the id field.
+	screeningdimension.DefaultID = screeningdimensionDescID.Default.(func() uuid.UUID)
 	screeningnoderunMixin := schema.ScreeningNodeRun{}.Mixin()
 	screeningnoderunMixinHooks0 := screeningnoderunMixin[0].Hooks()
 	screeningnoderun.Hooks[0] = screeningnoderunMixinHooks0[0]
@@ -839,12 +938,16 @@ func init() {
 	screeningnoderunDescModelProvider := screeningnoderunFields[9].Descriptor()
 	// screeningnoderun.ModelProviderValidator is a validator for the "model_provider" field. It is called by the builders before save.
 	screeningnoderun.ModelProviderValidator = screeningnoderunDescModelProvider.Validators[0].(func(string) error)
+	// screeningnoderunDescCacheKey is the schema descriptor for cache_key field.
+	screeningnoderunDescCacheKey := screeningnoderunFields[20].Descriptor()
+	// screeningnoderun.CacheKeyValidator is a validator for the "cache_key" field. It is called by the builders before save.
+	screeningnoderun.CacheKeyValidator = screeningnoderunDescCacheKey.Validators[0].(func(string) error)
 	// screeningnoderunDescCreatedAt is the schema descriptor for created_at field.
-	screeningnoderunDescCreatedAt := screeningnoderunFields[20].Descriptor()
+	screeningnoderunDescCreatedAt := screeningnoderunFields[22].Descriptor()
 	// screeningnoderun.DefaultCreatedAt holds the default value on creation for the created_at field.
 	screeningnoderun.DefaultCreatedAt = screeningnoderunDescCreatedAt.Default.(func() time.Time)
 	// screeningnoderunDescUpdatedAt is the schema descriptor for updated_at field.
-	screeningnoderunDescUpdatedAt := screeningnoderunFields[21].Descriptor()
+	screeningnoderunDescUpdatedAt := screeningnoderunFields[23].Descriptor()
 	// screeningnoderun.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	screeningnoderun.DefaultUpdatedAt = screeningnoderunDescUpdatedAt.Default.(func() time.Time)
 	// screeningnoderun.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -861,19 +964,31 @@ func init() {
 	screeningresultFields := schema.ScreeningResult{}.Fields()
 	_ = screeningresultFields
 	// screeningresultDescTraceID is the schema descriptor for trace_id field.
-	screeningresultDescTraceID := screeningresultFields[14].Descriptor()
+	screeningresultDescTraceID := screeningresultFields[16].Descriptor()
 	// screeningresult.TraceIDValidator is a validator for the "trace_id" field. It is called by the builders before save.
 	screeningresult.TraceIDValidator = screeningresultDescTraceID.Validators[0].(func(string) error)
+	// screeningresultDescJobHash is the schema descriptor for job_hash field.
+	screeningresultDescJobHash := screeningresultFields[19].Descriptor()
+	// screeningresult.JobHashValidator is a validator for the "job_hash" field. It is called by the builders before save.
+	screeningresult.JobHashValidator = screeningresultDescJobHash.Validators[0].(func(string) error)
+	// screeningresultDescResumeHash is the schema descriptor for resume_hash field.
+	screeningresultDescResumeHash := screeningresultFields[20].Descriptor()
+	// screeningresult.ResumeHashValidator is a validator for the "resume_hash" field. It is called by the builders before save.
+	screeningresult.ResumeHashValidator = screeningresultDescResumeHash.Validators[0].(func(string) error)
+	// screeningresultDescAgentHash is the schema descriptor for agent_hash field.
+	screeningresultDescAgentHash := screeningresultFields[21].Descriptor()
+	// screeningresult.AgentHashValidator is a validator for the "agent_hash" field. It is called by the builders before save.
+	screeningresult.AgentHashValidator = screeningresultDescAgentHash.Validators[0].(func(string) error)
 	// screeningresultDescMatchedAt is the schema descriptor for matched_at field.
-	screeningresultDescMatchedAt := screeningresultFields[17].Descriptor()
+	screeningresultDescMatchedAt := screeningresultFields[29].Descriptor()
 	// screeningresult.DefaultMatchedAt holds the default value on creation for the matched_at field.
 	screeningresult.DefaultMatchedAt = screeningresultDescMatchedAt.Default.(func() time.Time)
 	// screeningresultDescCreatedAt is the schema descriptor for created_at field.
-	screeningresultDescCreatedAt := screeningresultFields[18].Descriptor()
+	screeningresultDescCreatedAt := screeningresultFields[30].Descriptor()
 	// screeningresult.DefaultCreatedAt holds the default value on creation for the created_at field.
 	screeningresult.DefaultCreatedAt = screeningresultDescCreatedAt.Default.(func() time.Time)
 	// screeningresultDescUpdatedAt is the schema descriptor for updated_at field.
-	screeningresultDescUpdatedAt := screeningresultFields[19].Descriptor()
+	screeningresultDescUpdatedAt := screeningresultFields[31].Descriptor()
 	// screeningresult.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	screeningresult.DefaultUpdatedAt = screeningresultDescUpdatedAt.Default.(func() time.Time)
 	// screeningresult.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -903,6 +1018,54 @@ func init() {
 	screeningrunmetricDescID := screeningrunmetricFields[0].Descriptor()
 	// screeningrunmetric.DefaultID holds the default value on creation for the id field.
 	screeningrunmetric.DefaultID = screeningrunmetricDescID.Default.(func() uuid.UUID)
+	screeningscheduleFields := schema.ScreeningSchedule{}.Fields()
+	_ = screeningscheduleFields
+	// screeningscheduleDescMode is the schema descriptor for mode field.
+	screeningscheduleDescMode := screeningscheduleFields[2].Descriptor()
+	// screeningschedule.DefaultMode holds the default value on creation for the mode field.
+	screeningschedule.DefaultMode = consts.ScreeningScheduleMode(screeningscheduleDescMode.Default.(string))
+	// screeningscheduleDescBlindMode is the schema descriptor for blind_mode field.
+	screeningscheduleDescBlindMode := screeningscheduleFields[6].Descriptor()
+	// screeningschedule.DefaultBlindMode holds the default value on creation for the blind_mode field.
+	screeningschedule.DefaultBlindMode = screeningscheduleDescBlindMode.Default.(bool)
+	// screeningscheduleDescDigestHour is the schema descriptor for digest_hour field.
+	screeningscheduleDescDigestHour := screeningscheduleFields[7].Descriptor()
+	// screeningschedule.DefaultDigestHour holds the default value on creation for the digest_hour field.
+	screeningschedule.DefaultDigestHour = screeningscheduleDescDigestHour.Default.(int)
+	// screeningschedule.DigestHourValidator is a validator for the "digest_hour" field. It is called by the builders before save.
+	screeningschedule.DigestHourValidator = func() func(int) error {
+		validators := screeningscheduleDescDigestHour.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(digest_hour int) error {
+			for _, fn := range fns {
+				if err := fn(digest_hour); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// screeningscheduleDescEnabled is the schema descriptor for enabled field.
+	screeningscheduleDescEnabled := screeningscheduleFields[8].Descriptor()
+	// screeningschedule.DefaultEnabled holds the default value on creation for the enabled field.
+	screeningschedule.DefaultEnabled = screeningscheduleDescEnabled.Default.(bool)
+	// screeningscheduleDescCreatedAt is the schema descriptor for created_at field.
+	screeningscheduleDescCreatedAt := screeningscheduleFields[11].Descriptor()
+	// screeningschedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	screeningschedule.DefaultCreatedAt = screeningscheduleDescCreatedAt.Default.(func() time.Time)
+	// screeningscheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	screeningscheduleDescUpdatedAt := screeningscheduleFields[12].Descriptor()
+	// screeningschedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	screeningschedule.DefaultUpdatedAt = screeningscheduleDescUpdatedAt.Default.(func() time.Time)
+	// screeningschedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	screeningschedule.UpdateDefaultUpdatedAt = screeningscheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// screeningscheduleDescID is the schema descriptor for id field.
+	screeningscheduleDescID := screeningscheduleFields[0].Descriptor()
+	// screeningschedule.DefaultID holds the default value on creation for the id field.
+	screeningschedule.DefaultID = screeningscheduleDescID.Default.(func() uuid.UUID)
 	screeningtaskMixin := schema.ScreeningTask{}.Mixin()
 	screeningtaskMixinHooks0 := screeningtaskMixin[0].Hooks()
 	screeningtask.Hooks[0] = screeningtaskMixinHooks0[0]
@@ -934,12 +1097,32 @@ func init() {
 	screeningtaskDescAgentVersion := screeningtaskFields[11].Descriptor()
 	// screeningtask.AgentVersionValidator is a validator for the "agent_version" field. It is called by the builders before save.
 	screeningtask.AgentVersionValidator = screeningtaskDescAgentVersion.Validators[0].(func(string) error)
+	// screeningtaskDescTokensInput is the schema descriptor for tokens_input field.
+	screeningtaskDescTokensInput := screeningtaskFields[14].Descriptor()
+	// screeningtask.DefaultTokensInput holds the default value on creation for the tokens_input field.
+	screeningtask.DefaultTokensInput = screeningtaskDescTokensInput.Default.(int64)
+	// screeningtaskDescTokensOutput is the schema descriptor for tokens_output field.
+	screeningtaskDescTokensOutput := screeningtaskFields[15].Descriptor()
+	// screeningtask.DefaultTokensOutput holds the default value on creation for the tokens_output field.
+	screeningtask.DefaultTokensOutput = screeningtaskDescTokensOutput.Default.(int64)
+	// screeningtaskDescTotalCost is the schema descriptor for total_cost field.
+	screeningtaskDescTotalCost := screeningtaskFields[16].Descriptor()
+	// screeningtask.DefaultTotalCost holds the default value on creation for the total_cost field.
+	screeningtask.DefaultTotalCost = screeningtaskDescTotalCost.Default.(float64)
+	// screeningtaskDescDisableCache is the schema descriptor for disable_cache field.
+	screeningtaskDescDisableCache := screeningtaskFields[17].Descriptor()
+	// screeningtask.DefaultDisableCache holds the default value on creation for the disable_cache field.
+	screeningtask.DefaultDisableCache = screeningtaskDescDisableCache.Default.(bool)
+	// screeningtaskDescBlindMode is the schema descriptor for blind_mode field.
+	screeningtaskDescBlindMode := screeningtaskFields[19].Descriptor()
+	// screeningtask.DefaultBlindMode holds the default value on creation for the blind_mode field.
+	screeningtask.DefaultBlindMode = screeningtaskDescBlindMode.Default.(bool)
 	// screeningtaskDescCreatedAt is the schema descriptor for created_at field.
-	screeningtaskDescCreatedAt := screeningtaskFields[14].Descriptor()
+	screeningtaskDescCreatedAt := screeningtaskFields[25].Descriptor()
 	// screeningtask.DefaultCreatedAt holds the default value on creation for the created_at field.
 	screeningtask.DefaultCreatedAt = screeningtaskDescCreatedAt.Default.(func() time.Time)
 	// screeningtaskDescUpdatedAt is the schema descriptor for updated_at field.
-	screeningtaskDescUpdatedAt := screeningtaskFields[15].Descriptor()
+	screeningtaskDescUpdatedAt := screeningtaskFields[26].Descriptor()
 	// screeningtask.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	screeningtask.DefaultUpdatedAt = screeningtaskDescUpdatedAt.Default.(func() time.Time)
 	// screeningtask.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -959,12 +1142,24 @@ func init() {
 	screeningtaskresumeDescStatus := screeningtaskresumeFields[3].Descriptor()
 	// screeningtaskresume.DefaultStatus holds the default value on creation for the status field.
 	screeningtaskresume.DefaultStatus = screeningtaskresumeDescStatus.Default.(string)
+	// screeningtaskresumeDescTokensInput is the schema descriptor for tokens_input field.
+	screeningtaskresumeDescTokensInput := screeningtaskresumeFields[8].Descriptor()
+	// screeningtaskresume.DefaultTokensInput holds the default value on creation for the tokens_input field.
+	screeningtaskresume.DefaultTokensInput = screeningtaskresumeDescTokensInput.Default.(int64)
+	// screeningtaskresumeDescTokensOutput is the schema descriptor for tokens_output field.
+	screeningtaskresumeDescTokensOutput := screeningtaskresumeFields[9].Descriptor()
+	// screeningtaskresume.DefaultTokensOutput holds the default value on creation for the tokens_output field.
+	screeningtaskresume.DefaultTokensOutput = screeningtaskresumeDescTokensOutput.Default.(int64)
+	// screeningtaskresumeDescTotalCost is the schema descriptor for total_cost field.
+	screeningtaskresumeDescTotalCost := screeningtaskresumeFields[10].Descriptor()
+	// screeningtaskresume.DefaultTotalCost holds the default value on creation for the total_cost field.
+	screeningtaskresume.DefaultTotalCost = screeningtaskresumeDescTotalCost.Default.(float64)
 	// screeningtaskresumeDescCreatedAt is the schema descriptor for created_at field.
-	screeningtaskresumeDescCreatedAt := screeningtaskresumeFields[8].Descriptor()
+	screeningtaskresumeDescCreatedAt := screeningtaskresumeFields[11].Descriptor()
 	// screeningtaskresume.DefaultCreatedAt holds the default value on creation for the created_at field.
 	screeningtaskresume.DefaultCreatedAt = screeningtaskresumeDescCreatedAt.Default.(func() time.Time)
 	// screeningtaskresumeDescUpdatedAt is the schema descriptor for updated_at field.
-	screeningtaskresumeDescUpdatedAt := screeningtaskresumeFields[9].Descriptor()
+	screeningtaskresumeDescUpdatedAt := screeningtaskresumeFields[12].Descriptor()
 	// screeningtaskresume.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	screeningtaskresume.DefaultUpdatedAt = screeningtaskresumeDescUpdatedAt.Default.(func() time.Time)
 	// screeningtaskresume.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
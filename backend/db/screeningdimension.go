@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/WhaleHire/backend/db/jobposition"
+	"github.com/chaitin/WhaleHire/backend/db/screeningdimension"
+	"github.com/google/uuid"
+)
+
+// ScreeningDimension is the model entity for the ScreeningDimension schema.
+type ScreeningDimension struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// 所属岗位ID
+	JobPositionID uuid.UUID `json:"job_position_id,omitempty"`
+	// 维度标识，同一岗位内唯一
+	Key string `json:"key,omitempty"`
+	// 维度名称
+	Name string `json:"name,omitempty"`
+	// 维度说明
+	Description string `json:"description,omitempty"`
+	// 维度评估提示词
+	Prompt string `json:"prompt,omitempty"`
+	// 维度权重
+	Weight float64 `json:"weight,omitempty"`
+	// 创建者ID
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScreeningDimensionQuery when eager-loading is set.
+	Edges        ScreeningDimensionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScreeningDimensionEdges holds the relations/edges for other nodes in the graph.
+type ScreeningDimensionEdges struct {
+	// JobPosition holds the value of the job_position edge.
+	JobPosition *JobPosition `json:"job_position,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// JobPositionOrErr returns the JobPosition value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScreeningDimensionEdges) JobPositionOrErr() (*JobPosition, error) {
+	if e.JobPosition != nil {
+		return e.JobPosition, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: jobposition.Label}
+	}
+	return nil, &NotLoadedError{edge: "job_position"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScreeningDimension) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case screeningdimension.FieldCreatedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case screeningdimension.FieldWeight:
+			values[i] = new(sql.NullFloat64)
+		case screeningdimension.FieldKey, screeningdimension.FieldName, screeningdimension.FieldDescription, screeningdimension.FieldPrompt:
+			values[i] = new(sql.NullString)
+		case screeningdimension.FieldDeletedAt, screeningdimension.FieldCreatedAt, screeningdimension.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case screeningdimension.FieldID, screeningdimension.FieldJobPositionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScreeningDimension fields.
+func (sd *ScreeningDimension) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case screeningdimension.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sd.ID = *value
+			}
+		case screeningdimension.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				sd.DeletedAt = value.Time
+			}
+		case screeningdimension.FieldJobPositionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field job_position_id", values[i])
+			} else if value != nil {
+				sd.JobPositionID = *value
+			}
+		case screeningdimension.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				sd.Key = value.String
+			}
+		case screeningdimension.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sd.Name = value.String
+			}
+		case screeningdimension.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				sd.Description = value.String
+			}
+		case screeningdimension.FieldPrompt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt", values[i])
+			} else if value.Valid {
+				sd.Prompt = value.String
+			}
+		case screeningdimension.FieldWeight:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field weight", values[i])
+			} else if value.Valid {
+				sd.Weight = value.Float64
+			}
+		case screeningdimension.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				sd.CreatedBy = new(uuid.UUID)
+				*sd.CreatedBy = *value.S.(*uuid.UUID)
+			}
+		case screeningdimension.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sd.CreatedAt = value.Time
+			}
+		case screeningdimension.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sd.UpdatedAt = value.Time
+			}
+		default:
+			sd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScreeningDimension.
+// This includes values selected through modifiers, order, etc.
+func (sd *ScreeningDimension) Value(name string) (ent.Value, error) {
+	return sd.selectValues.Get(name)
+}
+
+// QueryJobPosition queries the "job_position" edge of the ScreeningDimension entity.
+func (sd *ScreeningDimension) QueryJobPosition() *JobPositionQuery {
+	return NewScreeningDimensionClient(sd.config).QueryJobPosition(sd)
+}
+
+// Update returns a builder for updating this ScreeningDimension.
+// Note that you need to call ScreeningDimension.Unwrap() before calling this method if this ScreeningDimension
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sd *ScreeningDimension) Update() *ScreeningDimensionUpdateOne {
+	return NewScreeningDimensionClient(sd.config).UpdateOne(sd)
+}
+
+// Unwrap unwraps the ScreeningDimension entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sd *ScreeningDimension) Unwrap() *ScreeningDimension {
+	_tx, ok := sd.config.driver.(*txDriver)
+	if !ok {
+		panic("db: ScreeningDimension is not a transactional entity")
+	}
+	sd.config.driver = _tx.drv
+	return sd
+}
+
+// String implements the fmt.Stringer.
+func (sd *ScreeningDimension) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScreeningDimension(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sd.ID))
+	builder.WriteString("deleted_at=")
+	builder.WriteString(sd.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("job_position_id=")
+	builder.WriteString(fmt.Sprintf("%v", sd.JobPositionID))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(sd.Key)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(sd.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(sd.Description)
+	builder.WriteString(", ")
+	builder.WriteString("prompt=")
+	builder.WriteString(sd.Prompt)
+	builder.WriteString(", ")
+	builder.WriteString("weight=")
+	builder.WriteString(fmt.Sprintf("%v", sd.Weight))
+	builder.WriteString(", ")
+	if v := sd.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sd.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sd.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScreeningDimensions is a parsable slice of ScreeningDimension.
+type ScreeningDimensions []*ScreeningDimension
@@ -0,0 +1,1132 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/WhaleHire/backend/db/jobposition"
+	"github.com/chaitin/WhaleHire/backend/db/screeningdimension"
+	"github.com/google/uuid"
+)
+
+// ScreeningDimensionCreate is the builder for creating a ScreeningDimension entity.
+type ScreeningDimensionCreate struct {
+	config
+	mutation *ScreeningDimensionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sdc *ScreeningDimensionCreate) SetDeletedAt(t time.Time) *ScreeningDimensionCreate {
+	sdc.mutation.SetDeletedAt(t)
+	return sdc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sdc *ScreeningDimensionCreate) SetNillableDeletedAt(t *time.Time) *ScreeningDimensionCreate {
+	if t != nil {
+		sdc.SetDeletedAt(*t)
+	}
+	return sdc
+}
+
+// SetJobPositionID sets the "job_position_id" field.
+func (sdc *ScreeningDimensionCreate) SetJobPositionID(u uuid.UUID) *ScreeningDimensionCreate {
+	sdc.mutation.SetJobPositionID(u)
+	return sdc
+}
+
+// SetKey sets the "key" field.
+func (sdc *ScreeningDimensionCreate) SetKey(s string) *ScreeningDimensionCreate {
+	sdc.mutation.SetKey(s)
+	return sdc
+}
+
+// SetName sets the "name" field.
+func (sdc *ScreeningDimensionCreate) SetName(s string) *ScreeningDimensionCreate {
+	sdc.mutation.SetName(s)
+	return sdc
+}
+
+// SetDescription sets the "description" field.
+func (sdc *ScreeningDimensionCreate) SetDescription(s string) *ScreeningDimensionCreate {
+	sdc.mutation.SetDescription(s)
+	return sdc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sdc *ScreeningDimensionCreate) SetNillableDescription(s *string) *ScreeningDimensionCreate {
+	if s != nil {
+		sdc.SetDescription(*s)
+	}
+	return sdc
+}
+
+// SetPrompt sets the "prompt" field.
+func (sdc *ScreeningDimensionCreate) SetPrompt(s string) *ScreeningDimensionCreate {
+	sdc.mutation.SetPrompt(s)
+	return sdc
+}
+
+// SetWeight sets the "weight" field.
+func (sdc *ScreeningDimensionCreate) SetWeight(f float64) *ScreeningDimensionCreate {
+	sdc.mutation.SetWeight(f)
+	return sdc
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (sdc *ScreeningDimensionCreate) SetNillableWeight(f *float64) *ScreeningDimensionCreate {
+	if f != nil {
+		sdc.SetWeight(*f)
+	}
+	return sdc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (sdc *ScreeningDimensionCreate) SetCreatedBy(u uuid.UUID) *ScreeningDimensionCreate {
+	sdc.mutation.SetCreatedBy(u)
+	return sdc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (sdc *ScreeningDimensionCreate) SetNillableCreatedBy(u *uuid.UUID) *ScreeningDimensionCreate {
+	if u != nil {
+		sdc.SetCreatedBy(*u)
+	}
+	return sdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sdc *ScreeningDimensionCreate) SetCreatedAt(t time.Time) *ScreeningDimensionCreate {
+	sdc.mutation.SetCreatedAt(t)
+	return sdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sdc *ScreeningDimensionCreate) SetNillableCreatedAt(t *time.Time) *ScreeningDimensionCreate {
+	if t != nil {
+		sdc.SetCreatedAt(*t)
+	}
+	return sdc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sdc *ScreeningDimensionCreate) SetUpdatedAt(t time.Time) *ScreeningDimensionCreate {
+	sdc.mutation.SetUpdatedAt(t)
+	return sdc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sdc *ScreeningDimensionCreate) SetNillableUpdatedAt(t *time.Time) *ScreeningDimensionCreate {
+	if t != nil {
+		sdc.SetUpdatedAt(*t)
+	}
+	return sdc
+}
+
+// SetID sets the "id" field.
+func (sdc *ScreeningDimensionCreate) SetID(u uuid.UUID) *ScreeningDimensionCreate {
+	sdc.mutation.SetID(u)
+	return sdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sdc *ScreeningDimensionCreate) SetNillableID(u *uuid.UUID) *ScreeningDimensionCreate {
+	if u != nil {
+		sdc.SetID(*u)
+	}
+	return sdc
+}
+
+// SetJobPosition sets the "job_position" edge to the JobPosition entity.
+func (sdc *ScreeningDimensionCreate) SetJobPosition(j *JobPosition) *ScreeningDimensionCreate {
+	return sdc.SetJobPositionID(j.ID)
+}
+
+// Mutation returns the ScreeningDimensionMutation object of the builder.
+func (sdc *ScreeningDimensionCreate) Mutation() *ScreeningDimensionMutation {
+	return sdc.mutation
+}
+
+// Save creates the ScreeningDimension in the database.
+func (sdc *ScreeningDimensionCreate) Save(ctx context.Context) (*ScreeningDimension, error) {
+	if err := sdc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, sdc.sqlSave, sdc.mutation, sdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sdc *ScreeningDimensionCreate) SaveX(ctx context.Context) *ScreeningDimension {
+	v, err := sdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sdc *ScreeningDimensionCreate) Exec(ctx context.Context) error {
+	_, err := sdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdc *ScreeningDimensionCreate) ExecX(ctx context.Context) {
+	if err := sdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sdc *ScreeningDimensionCreate) defaults() error {
+	if _, ok := sdc.mutation.Weight(); !ok {
+		v := screeningdimension.DefaultWeight
+		sdc.mutation.SetWeight(v)
+	}
+	if _, ok := sdc.mutation.CreatedAt(); !ok {
+		if screeningdimension.DefaultCreatedAt == nil {
+			return fmt.Errorf("db: uninitialized screeningdimension.DefaultCreatedAt (forgotten import db/runtime?)")
+		}
+		v := screeningdimension.DefaultCreatedAt()
+		sdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sdc.mutation.UpdatedAt(); !ok {
+		if screeningdimension.DefaultUpdatedAt == nil {
+			return fmt.Errorf("db: uninitialized screeningdimension.DefaultUpdatedAt (forgotten import db/runtime?)")
+		}
+		v := screeningdimension.DefaultUpdatedAt()
+		sdc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sdc.mutation.ID(); !ok {
+		if screeningdimension.DefaultID == nil {
+			return fmt.Errorf("db: uninitialized screeningdimension.DefaultID (forgotten import db/runtime?)")
+		}
+		v := screeningdimension.DefaultID()
+		sdc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sdc *ScreeningDimensionCreate) check() error {
+	if _, ok := sdc.mutation.JobPositionID(); !ok {
+		return &ValidationError{Name: "job_position_id", err: errors.New(`db: missing required field "ScreeningDimension.job_position_id"`)}
+	}
+	if _, ok := sdc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`db: missing required field "ScreeningDimension.key"`)}
+	}
+	if v, ok := sdc.mutation.Key(); ok {
+		if err := screeningdimension.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`db: validator failed for field "ScreeningDimension.key": %w`, err)}
+		}
+	}
+	if _, ok := sdc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`db: missing required field "ScreeningDimension.name"`)}
+	}
+	if v, ok := sdc.mutation.Name(); ok {
+		if err := screeningdimension.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`db: validator failed for field "ScreeningDimension.name": %w`, err)}
+		}
+	}
+	if v, ok := sdc.mutation.Description(); ok {
+		if err := screeningdimension.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`db: validator failed for field "ScreeningDimension.description": %w`, err)}
+		}
+	}
+	if _, ok := sdc.mutation.Prompt(); !ok {
+		return &ValidationError{Name: "prompt", err: errors.New(`db: missing required field "ScreeningDimension.prompt"`)}
+	}
+	if _, ok := sdc.mutation.Weight(); !ok {
+		return &ValidationError{Name: "weight", err: errors.New(`db: missing required field "ScreeningDimension.weight"`)}
+	}
+	if _, ok := sdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "ScreeningDimension.created_at"`)}
+	}
+	if _, ok := sdc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "ScreeningDimension.updated_at"`)}
+	}
+	if len(sdc.mutation.JobPositionIDs()) == 0 {
+		return &ValidationError{Name: "job_position", err: errors.New(`db: missing required edge "ScreeningDimension.job_position"`)}
+	}
+	return nil
+}
+
+func (sdc *ScreeningDimensionCreate) sqlSave(ctx context.Context) (*ScreeningDimension, error) {
+	if err := sdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sdc.mutation.id = &_node.ID
+	sdc.mutation.done = true
+	return _node, nil
+}
+
+func (sdc *ScreeningDimensionCreate) createSpec() (*ScreeningDimension, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScreeningDimension{config: sdc.config}
+		_spec = sqlgraph.NewCreateSpec(screeningdimension.Table, sqlgraph.NewFieldSpec(screeningdimension.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = sdc.conflict
+	if id, ok := sdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sdc.mutation.DeletedAt(); ok {
+		_spec.SetField(screeningdimension.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := sdc.mutation.Key(); ok {
+		_spec.SetField(screeningdimension.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := sdc.mutation.Name(); ok {
+		_spec.SetField(screeningdimension.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sdc.mutation.Description(); ok {
+		_spec.SetField(screeningdimension.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := sdc.mutation.Prompt(); ok {
+		_spec.SetField(screeningdimension.FieldPrompt, field.TypeString, value)
+		_node.Prompt = value
+	}
+	if value, ok := sdc.mutation.Weight(); ok {
+		_spec.SetField(screeningdimension.FieldWeight, field.TypeFloat64, value)
+		_node.Weight = value
+	}
+	if value, ok := sdc.mutation.CreatedBy(); ok {
+		_spec.SetField(screeningdimension.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := sdc.mutation.CreatedAt(); ok {
+		_spec.SetField(screeningdimension.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sdc.mutation.UpdatedAt(); ok {
+		_spec.SetField(screeningdimension.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := sdc.mutation.JobPositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   screeningdimension.JobPositionTable,
+			Columns: []string{screeningdimension.JobPositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobposition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.JobPositionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScreeningDimension.Create().
+//		SetDeletedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScreeningDimensionUpsert) {
+//			SetDeletedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sdc *ScreeningDimensionCreate) OnConflict(opts ...sql.ConflictOption) *ScreeningDimensionUpsertOne {
+	sdc.conflict = opts
+	return &ScreeningDimensionUpsertOne{
+		create: sdc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScreeningDimension.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sdc *ScreeningDimensionCreate) OnConflictColumns(columns ...string) *ScreeningDimensionUpsertOne {
+	sdc.conflict = append(sdc.conflict, sql.ConflictColumns(columns...))
+	return &ScreeningDimensionUpsertOne{
+		create: sdc,
+	}
+}
+
+type (
+	// ScreeningDimensionUpsertOne is the builder for "upsert"-ing
+	//  one ScreeningDimension node.
+	ScreeningDimensionUpsertOne struct {
+		create *ScreeningDimensionCreate
+	}
+
+	// ScreeningDimensionUpsert is the "OnConflict" setter.
+	ScreeningDimensionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ScreeningDimensionUpsert) SetDeletedAt(v time.Time) *ScreeningDimensionUpsert {
+	u.Set(screeningdimension.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsert) UpdateDeletedAt() *ScreeningDimensionUpsert {
+	u.SetExcluded(screeningdimension.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ScreeningDimensionUpsert) ClearDeletedAt() *ScreeningDimensionUpsert {
+	u.SetNull(screeningdimension.FieldDeletedAt)
+	return u
+}
+
+// SetJobPositionID sets the "job_position_id" field.
+func (u *ScreeningDimensionUpsert) SetJobPositionID(v uuid.UUID) *ScreeningDimensionUpsert {
+	u.Set(screeningdimension.FieldJobPositionID, v)
+	return u
+}
+
+// UpdateJobPositionID sets the "job_position_id" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsert) UpdateJobPositionID() *ScreeningDimensionUpsert {
+	u.SetExcluded(screeningdimension.FieldJobPositionID)
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *ScreeningDimensionUpsert) SetKey(v string) *ScreeningDimensionUpsert {
+	u.Set(screeningdimension.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsert) UpdateKey() *ScreeningDimensionUpsert {
+	u.SetExcluded(screeningdimension.FieldKey)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ScreeningDimensionUpsert) SetName(v string) *ScreeningDimensionUpsert {
+	u.Set(screeningdimension.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsert) UpdateName() *ScreeningDimensionUpsert {
+	u.SetExcluded(screeningdimension.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ScreeningDimensionUpsert) SetDescription(v string) *ScreeningDimensionUpsert {
+	u.Set(screeningdimension.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsert) UpdateDescription() *ScreeningDimensionUpsert {
+	u.SetExcluded(screeningdimension.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ScreeningDimensionUpsert) ClearDescription() *ScreeningDimensionUpsert {
+	u.SetNull(screeningdimension.FieldDescription)
+	return u
+}
+
+// SetPrompt sets the "prompt" field.
+func (u *ScreeningDimensionUpsert) SetPrompt(v string) *ScreeningDimensionUpsert {
+	u.Set(screeningdimension.FieldPrompt, v)
+	return u
+}
+
+// UpdatePrompt sets the "prompt" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsert) UpdatePrompt() *ScreeningDimensionUpsert {
+	u.SetExcluded(screeningdimension.FieldPrompt)
+	return u
+}
+
+// SetWeight sets the "weight" field.
+func (u *ScreeningDimensionUpsert) SetWeight(v float64) *ScreeningDimensionUpsert {
+	u.Set(screeningdimension.FieldWeight, v)
+	return u
+}
+
+// UpdateWeight sets the "weight" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsert) UpdateWeight() *ScreeningDimensionUpsert {
+	u.SetExcluded(screeningdimension.FieldWeight)
+	return u
+}
+
+// AddWeight adds v to the "weight" field.
+func (u *ScreeningDimensionUpsert) AddWeight(v float64) *ScreeningDimensionUpsert {
+	u.Add(screeningdimension.FieldWeight, v)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ScreeningDimensionUpsert) SetCreatedBy(v uuid.UUID) *ScreeningDimensionUpsert {
+	u.Set(screeningdimension.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsert) UpdateCreatedBy() *ScreeningDimensionUpsert {
+	u.SetExcluded(screeningdimension.FieldCreatedBy)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ScreeningDimensionUpsert) ClearCreatedBy() *ScreeningDimensionUpsert {
+	u.SetNull(screeningdimension.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScreeningDimensionUpsert) SetUpdatedAt(v time.Time) *ScreeningDimensionUpsert {
+	u.Set(screeningdimension.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsert) UpdateUpdatedAt() *ScreeningDimensionUpsert {
+	u.SetExcluded(screeningdimension.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ScreeningDimension.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(screeningdimension.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScreeningDimensionUpsertOne) UpdateNewValues() *ScreeningDimensionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(screeningdimension.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(screeningdimension.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScreeningDimension.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScreeningDimensionUpsertOne) Ignore() *ScreeningDimensionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScreeningDimensionUpsertOne) DoNothing() *ScreeningDimensionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScreeningDimensionCreate.OnConflict
+// documentation for more info.
+func (u *ScreeningDimensionUpsertOne) Update(set func(*ScreeningDimensionUpsert)) *ScreeningDimensionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScreeningDimensionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ScreeningDimensionUpsertOne) SetDeletedAt(v time.Time) *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertOne) UpdateDeletedAt() *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ScreeningDimensionUpsertOne) ClearDeletedAt() *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetJobPositionID sets the "job_position_id" field.
+func (u *ScreeningDimensionUpsertOne) SetJobPositionID(v uuid.UUID) *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetJobPositionID(v)
+	})
+}
+
+// UpdateJobPositionID sets the "job_position_id" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertOne) UpdateJobPositionID() *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdateJobPositionID()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *ScreeningDimensionUpsertOne) SetKey(v string) *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertOne) UpdateKey() *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ScreeningDimensionUpsertOne) SetName(v string) *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertOne) UpdateName() *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ScreeningDimensionUpsertOne) SetDescription(v string) *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertOne) UpdateDescription() *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ScreeningDimensionUpsertOne) ClearDescription() *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetPrompt sets the "prompt" field.
+func (u *ScreeningDimensionUpsertOne) SetPrompt(v string) *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetPrompt(v)
+	})
+}
+
+// UpdatePrompt sets the "prompt" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertOne) UpdatePrompt() *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdatePrompt()
+	})
+}
+
+// SetWeight sets the "weight" field.
+func (u *ScreeningDimensionUpsertOne) SetWeight(v float64) *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetWeight(v)
+	})
+}
+
+// AddWeight adds v to the "weight" field.
+func (u *ScreeningDimensionUpsertOne) AddWeight(v float64) *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.AddWeight(v)
+	})
+}
+
+// UpdateWeight sets the "weight" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertOne) UpdateWeight() *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdateWeight()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ScreeningDimensionUpsertOne) SetCreatedBy(v uuid.UUID) *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertOne) UpdateCreatedBy() *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ScreeningDimensionUpsertOne) ClearCreatedBy() *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScreeningDimensionUpsertOne) SetUpdatedAt(v time.Time) *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertOne) UpdateUpdatedAt() *ScreeningDimensionUpsertOne {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ScreeningDimensionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for ScreeningDimensionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScreeningDimensionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScreeningDimensionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: ScreeningDimensionUpsertOne.ID is not supported by MySQL driver. Use ScreeningDimensionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScreeningDimensionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ScreeningDimensionCreateBulk is the builder for creating many ScreeningDimension entities in bulk.
+type ScreeningDimensionCreateBulk struct {
+	config
+	err      error
+	builders []*ScreeningDimensionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ScreeningDimension entities in the database.
+func (sdcb *ScreeningDimensionCreateBulk) Save(ctx context.Context) ([]*ScreeningDimension, error) {
+	if sdcb.err != nil {
+		return nil, sdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sdcb.builders))
+	nodes := make([]*ScreeningDimension, len(sdcb.builders))
+	mutators := make([]Mutator, len(sdcb.builders))
+	for i := range sdcb.builders {
+		func(i int, root context.Context) {
+			builder := sdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScreeningDimensionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sdcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sdcb *ScreeningDimensionCreateBulk) SaveX(ctx context.Context) []*ScreeningDimension {
+	v, err := sdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sdcb *ScreeningDimensionCreateBulk) Exec(ctx context.Context) error {
+	_, err := sdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdcb *ScreeningDimensionCreateBulk) ExecX(ctx context.Context) {
+	if err := sdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScreeningDimension.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScreeningDimensionUpsert) {
+//			SetDeletedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sdcb *ScreeningDimensionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScreeningDimensionUpsertBulk {
+	sdcb.conflict = opts
+	return &ScreeningDimensionUpsertBulk{
+		create: sdcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScreeningDimension.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sdcb *ScreeningDimensionCreateBulk) OnConflictColumns(columns ...string) *ScreeningDimensionUpsertBulk {
+	sdcb.conflict = append(sdcb.conflict, sql.ConflictColumns(columns...))
+	return &ScreeningDimensionUpsertBulk{
+		create: sdcb,
+	}
+}
+
+// ScreeningDimensionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ScreeningDimension nodes.
+type ScreeningDimensionUpsertBulk struct {
+	create *ScreeningDimensionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ScreeningDimension.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(screeningdimension.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScreeningDimensionUpsertBulk) UpdateNewValues() *ScreeningDimensionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(screeningdimension.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(screeningdimension.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScreeningDimension.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScreeningDimensionUpsertBulk) Ignore() *ScreeningDimensionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScreeningDimensionUpsertBulk) DoNothing() *ScreeningDimensionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScreeningDimensionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScreeningDimensionUpsertBulk) Update(set func(*ScreeningDimensionUpsert)) *ScreeningDimensionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScreeningDimensionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ScreeningDimensionUpsertBulk) SetDeletedAt(v time.Time) *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertBulk) UpdateDeletedAt() *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ScreeningDimensionUpsertBulk) ClearDeletedAt() *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetJobPositionID sets the "job_position_id" field.
+func (u *ScreeningDimensionUpsertBulk) SetJobPositionID(v uuid.UUID) *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetJobPositionID(v)
+	})
+}
+
+// UpdateJobPositionID sets the "job_position_id" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertBulk) UpdateJobPositionID() *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdateJobPositionID()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *ScreeningDimensionUpsertBulk) SetKey(v string) *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertBulk) UpdateKey() *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ScreeningDimensionUpsertBulk) SetName(v string) *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertBulk) UpdateName() *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ScreeningDimensionUpsertBulk) SetDescription(v string) *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertBulk) UpdateDescription() *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ScreeningDimensionUpsertBulk) ClearDescription() *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetPrompt sets the "prompt" field.
+func (u *ScreeningDimensionUpsertBulk) SetPrompt(v string) *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetPrompt(v)
+	})
+}
+
+// UpdatePrompt sets the "prompt" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertBulk) UpdatePrompt() *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdatePrompt()
+	})
+}
+
+// SetWeight sets the "weight" field.
+func (u *ScreeningDimensionUpsertBulk) SetWeight(v float64) *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetWeight(v)
+	})
+}
+
+// AddWeight adds v to the "weight" field.
+func (u *ScreeningDimensionUpsertBulk) AddWeight(v float64) *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.AddWeight(v)
+	})
+}
+
+// UpdateWeight sets the "weight" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertBulk) UpdateWeight() *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdateWeight()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ScreeningDimensionUpsertBulk) SetCreatedBy(v uuid.UUID) *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertBulk) UpdateCreatedBy() *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ScreeningDimensionUpsertBulk) ClearCreatedBy() *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScreeningDimensionUpsertBulk) SetUpdatedAt(v time.Time) *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScreeningDimensionUpsertBulk) UpdateUpdatedAt() *ScreeningDimensionUpsertBulk {
+	return u.Update(func(s *ScreeningDimensionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ScreeningDimensionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the ScreeningDimensionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for ScreeningDimensionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScreeningDimensionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
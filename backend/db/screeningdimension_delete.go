@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/WhaleHire/backend/db/predicate"
+	"github.com/chaitin/WhaleHire/backend/db/screeningdimension"
+)
+
+// ScreeningDimensionDelete is the builder for deleting a ScreeningDimension entity.
+type ScreeningDimensionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ScreeningDimensionMutation
+}
+
+// Where appends a list predicates to the ScreeningDimensionDelete builder.
+func (sdd *ScreeningDimensionDelete) Where(ps ...predicate.ScreeningDimension) *ScreeningDimensionDelete {
+	sdd.mutation.Where(ps...)
+	return sdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sdd *ScreeningDimensionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sdd.sqlExec, sdd.mutation, sdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdd *ScreeningDimensionDelete) ExecX(ctx context.Context) int {
+	n, err := sdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sdd *ScreeningDimensionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(screeningdimension.Table, sqlgraph.NewFieldSpec(screeningdimension.FieldID, field.TypeUUID))
+	if ps := sdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sdd.mutation.done = true
+	return affected, err
+}
+
+// ScreeningDimensionDeleteOne is the builder for deleting a single ScreeningDimension entity.
+type ScreeningDimensionDeleteOne struct {
+	sdd *ScreeningDimensionDelete
+}
+
+// Where appends a list predicates to the ScreeningDimensionDelete builder.
+func (sddo *ScreeningDimensionDeleteOne) Where(ps ...predicate.ScreeningDimension) *ScreeningDimensionDeleteOne {
+	sddo.sdd.mutation.Where(ps...)
+	return sddo
+}
+
+// Exec executes the deletion query.
+func (sddo *ScreeningDimensionDeleteOne) Exec(ctx context.Context) error {
+	n, err := sddo.sdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{screeningdimension.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sddo *ScreeningDimensionDeleteOne) ExecX(ctx context.Context) {
+	if err := sddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
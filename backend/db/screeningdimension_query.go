@@ -0,0 +1,657 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/WhaleHire/backend/db/jobposition"
+	"github.com/chaitin/WhaleHire/backend/db/predicate"
+	"github.com/chaitin/WhaleHire/backend/db/screeningdimension"
+	"github.com/google/uuid"
+)
+
+// ScreeningDimensionQuery is the builder for querying ScreeningDimension entities.
+type ScreeningDimensionQuery struct {
+	config
+	ctx             *QueryContext
+	order           []screeningdimension.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.ScreeningDimension
+	withJobPosition *JobPositionQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ScreeningDimensionQuery builder.
+func (sdq *ScreeningDimensionQuery) Where(ps ...predicate.ScreeningDimension) *ScreeningDimensionQuery {
+	sdq.predicates = append(sdq.predicates, ps...)
+	return sdq
+}
+
+// Limit the number of records to be returned by this query.
+func (sdq *ScreeningDimensionQuery) Limit(limit int) *ScreeningDimensionQuery {
+	sdq.ctx.Limit = &limit
+	return sdq
+}
+
+// Offset to start from.
+func (sdq *ScreeningDimensionQuery) Offset(offset int) *ScreeningDimensionQuery {
+	sdq.ctx.Offset = &offset
+	return sdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sdq *ScreeningDimensionQuery) Unique(unique bool) *ScreeningDimensionQuery {
+	sdq.ctx.Unique = &unique
+	return sdq
+}
+
+// Order specifies how the records should be ordered.
+func (sdq *ScreeningDimensionQuery) Order(o ...screeningdimension.OrderOption) *ScreeningDimensionQuery {
+	sdq.order = append(sdq.order, o...)
+	return sdq
+}
+
+// QueryJobPosition chains the current query on the "job_position" edge.
+func (sdq *ScreeningDimensionQuery) QueryJobPosition() *JobPositionQuery {
+	query := (&JobPositionClient{config: sdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(screeningdimension.Table, screeningdimension.FieldID, selector),
+			sqlgraph.To(jobposition.Table, jobposition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, screeningdimension.JobPositionTable, screeningdimension.JobPositionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ScreeningDimension entity from the query.
+// Returns a *NotFoundError when no ScreeningDimension was found.
+func (sdq *ScreeningDimensionQuery) First(ctx context.Context) (*ScreeningDimension, error) {
+	nodes, err := sdq.Limit(1).All(setContextOp(ctx, sdq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{screeningdimension.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sdq *ScreeningDimensionQuery) FirstX(ctx context.Context) *ScreeningDimension {
+	node, err := sdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ScreeningDimension ID from the query.
+// Returns a *NotFoundError when no ScreeningDimension ID was found.
+func (sdq *ScreeningDimensionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = sdq.Limit(1).IDs(setContextOp(ctx, sdq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{screeningdimension.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sdq *ScreeningDimensionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := sdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ScreeningDimension entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ScreeningDimension entity is found.
+// Returns a *NotFoundError when no ScreeningDimension entities are found.
+func (sdq *ScreeningDimensionQuery) Only(ctx context.Context) (*ScreeningDimension, error) {
+	nodes, err := sdq.Limit(2).All(setContextOp(ctx, sdq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{screeningdimension.Label}
+	default:
+		return nil, &NotSingularError{screeningdimension.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sdq *ScreeningDimensionQuery) OnlyX(ctx context.Context) *ScreeningDimension {
+	node, err := sdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ScreeningDimension ID in the query.
+// Returns a *NotSingularError when more than one ScreeningDimension ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sdq *ScreeningDimensionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = sdq.Limit(2).IDs(setContextOp(ctx, sdq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{screeningdimension.Label}
+	default:
+		err = &NotSingularError{screeningdimension.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sdq *ScreeningDimensionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := sdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ScreeningDimensions.
+func (sdq *ScreeningDimensionQuery) All(ctx context.Context) ([]*ScreeningDimension, error) {
+	ctx = setContextOp(ctx, sdq.ctx, ent.OpQueryAll)
+	if err := sdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ScreeningDimension, *ScreeningDimensionQuery]()
+	return withInterceptors[[]*ScreeningDimension](ctx, sdq, qr, sdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sdq *ScreeningDimensionQuery) AllX(ctx context.Context) []*ScreeningDimension {
+	nodes, err := sdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ScreeningDimension IDs.
+func (sdq *ScreeningDimensionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if sdq.ctx.Unique == nil && sdq.path != nil {
+		sdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, sdq.ctx, ent.OpQueryIDs)
+	if err = sdq.Select(screeningdimension.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sdq *ScreeningDimensionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := sdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sdq *ScreeningDimensionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, sdq.ctx, ent.OpQueryCount)
+	if err := sdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, sdq, querierCount[*ScreeningDimensionQuery](), sdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sdq *ScreeningDimensionQuery) CountX(ctx context.Context) int {
+	count, err := sdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sdq *ScreeningDimensionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, sdq.ctx, ent.OpQueryExist)
+	switch _, err := sdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sdq *ScreeningDimensionQuery) ExistX(ctx context.Context) bool {
+	exist, err := sdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ScreeningDimensionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sdq *ScreeningDimensionQuery) Clone() *ScreeningDimensionQuery {
+	if sdq == nil {
+		return nil
+	}
+	return &ScreeningDimensionQuery{
+		config:          sdq.config,
+		ctx:             sdq.ctx.Clone(),
+		order:           append([]screeningdimension.OrderOption{}, sdq.order...),
+		inters:          append([]Interceptor{}, sdq.inters...),
+		predicates:      append([]predicate.ScreeningDimension{}, sdq.predicates...),
+		withJobPosition: sdq.withJobPosition.Clone(),
+		// clone intermediate query.
+		sql:       sdq.sql.Clone(),
+		path:      sdq.path,
+		modifiers: append([]func(*sql.Selector){}, sdq.modifiers...),
+	}
+}
+
+// WithJobPosition tells the query-builder to eager-load the nodes that are connected to
+// the "job_position" edge. The optional arguments are used to configure the query builder of the edge.
+func (sdq *ScreeningDimensionQuery) WithJobPosition(opts ...func(*JobPositionQuery)) *ScreeningDimensionQuery {
+	query := (&JobPositionClient{config: sdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sdq.withJobPosition = query
+	return sdq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ScreeningDimension.Query().
+//		GroupBy(screeningdimension.FieldDeletedAt).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (sdq *ScreeningDimensionQuery) GroupBy(field string, fields ...string) *ScreeningDimensionGroupBy {
+	sdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ScreeningDimensionGroupBy{build: sdq}
+	grbuild.flds = &sdq.ctx.Fields
+	grbuild.label = screeningdimension.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
+//	}
+//
+//	client.ScreeningDimension.Query().
+//		Select(screeningdimension.FieldDeletedAt).
+//		Scan(ctx, &v)
+func (sdq *ScreeningDimensionQuery) Select(fields ...string) *ScreeningDimensionSelect {
+	sdq.ctx.Fields = append(sdq.ctx.Fields, fields...)
+	sbuild := &ScreeningDimensionSelect{ScreeningDimensionQuery: sdq}
+	sbuild.label = screeningdimension.Label
+	sbuild.flds, sbuild.scan = &sdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ScreeningDimensionSelect configured with the given aggregations.
+func (sdq *ScreeningDimensionQuery) Aggregate(fns ...AggregateFunc) *ScreeningDimensionSelect {
+	return sdq.Select().Aggregate(fns...)
+}
+
+func (sdq *ScreeningDimensionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range sdq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range sdq.ctx.Fields {
+		if !screeningdimension.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if sdq.path != nil {
+		prev, err := sdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sdq.sql = prev
+	}
+	return nil
+}
+
+func (sdq *ScreeningDimensionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ScreeningDimension, error) {
+	var (
+		nodes       = []*ScreeningDimension{}
+		_spec       = sdq.querySpec()
+		loadedTypes = [1]bool{
+			sdq.withJobPosition != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ScreeningDimension).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ScreeningDimension{config: sdq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(sdq.modifiers) > 0 {
+		_spec.Modifiers = sdq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := sdq.withJobPosition; query != nil {
+		if err := sdq.loadJobPosition(ctx, query, nodes, nil,
+			func(n *ScreeningDimension, e *JobPosition) { n.Edges.JobPosition = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (sdq *ScreeningDimensionQuery) loadJobPosition(ctx context.Context, query *JobPositionQuery, nodes []*ScreeningDimension, init func(*ScreeningDimension), assign func(*ScreeningDimension, *JobPosition)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ScreeningDimension)
+	for i := range nodes {
+		fk := nodes[i].JobPositionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(jobposition.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "job_position_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (sdq *ScreeningDimensionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sdq.querySpec()
+	if len(sdq.modifiers) > 0 {
+		_spec.Modifiers = sdq.modifiers
+	}
+	_spec.Node.Columns = sdq.ctx.Fields
+	if len(sdq.ctx.Fields) > 0 {
+		_spec.Unique = sdq.ctx.Unique != nil && *sdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, sdq.driver, _spec)
+}
+
+func (sdq *ScreeningDimensionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(screeningdimension.Table, screeningdimension.Columns, sqlgraph.NewFieldSpec(screeningdimension.FieldID, field.TypeUUID))
+	_spec.From = sdq.sql
+	if unique := sdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if sdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := sdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, screeningdimension.FieldID)
+		for i := range fields {
+			if fields[i] != screeningdimension.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if sdq.withJobPosition != nil {
+			_spec.Node.AddColumnOnce(screeningdimension.FieldJobPositionID)
+		}
+	}
+	if ps := sdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sdq *ScreeningDimensionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sdq.driver.Dialect())
+	t1 := builder.Table(screeningdimension.Table)
+	columns := sdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = screeningdimension.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sdq.sql != nil {
+		selector = sdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sdq.ctx.Unique != nil && *sdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range sdq.modifiers {
+		m(selector)
+	}
+	for _, p := range sdq.predicates {
+		p(selector)
+	}
+	for _, p := range sdq.order {
+		p(selector)
+	}
+	if offset := sdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (sdq *ScreeningDimensionQuery) ForUpdate(opts ...sql.LockOption) *ScreeningDimensionQuery {
+	if sdq.driver.Dialect() == dialect.Postgres {
+		sdq.Unique(false)
+	}
+	sdq.modifiers = append(sdq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return sdq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (sdq *ScreeningDimensionQuery) ForShare(opts ...sql.LockOption) *ScreeningDimensionQuery {
+	if sdq.driver.Dialect() == dialect.Postgres {
+		sdq.Unique(false)
+	}
+	sdq.modifiers = append(sdq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return sdq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sdq *ScreeningDimensionQuery) Modify(modifiers ...func(s *sql.Selector)) *ScreeningDimensionSelect {
+	sdq.modifiers = append(sdq.modifiers, modifiers...)
+	return sdq.Select()
+}
+
+// ScreeningDimensionGroupBy is the group-by builder for ScreeningDimension entities.
+type ScreeningDimensionGroupBy struct {
+	selector
+	build *ScreeningDimensionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sdgb *ScreeningDimensionGroupBy) Aggregate(fns ...AggregateFunc) *ScreeningDimensionGroupBy {
+	sdgb.fns = append(sdgb.fns, fns...)
+	return sdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sdgb *ScreeningDimensionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sdgb.build.ctx, ent.OpQueryGroupBy)
+	if err := sdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScreeningDimensionQuery, *ScreeningDimensionGroupBy](ctx, sdgb.build, sdgb, sdgb.build.inters, v)
+}
+
+func (sdgb *ScreeningDimensionGroupBy) sqlScan(ctx context.Context, root *ScreeningDimensionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sdgb.fns))
+	for _, fn := range sdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sdgb.flds)+len(sdgb.fns))
+		for _, f := range *sdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ScreeningDimensionSelect is the builder for selecting fields of ScreeningDimension entities.
+type ScreeningDimensionSelect struct {
+	*ScreeningDimensionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sds *ScreeningDimensionSelect) Aggregate(fns ...AggregateFunc) *ScreeningDimensionSelect {
+	sds.fns = append(sds.fns, fns...)
+	return sds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sds *ScreeningDimensionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sds.ctx, ent.OpQuerySelect)
+	if err := sds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScreeningDimensionQuery, *ScreeningDimensionSelect](ctx, sds.ScreeningDimensionQuery, sds, sds.inters, v)
+}
+
+func (sds *ScreeningDimensionSelect) sqlScan(ctx context.Context, root *ScreeningDimensionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sds.fns))
+	for _, fn := range sds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sds *ScreeningDimensionSelect) Modify(modifiers ...func(s *sql.Selector)) *ScreeningDimensionSelect {
+	sds.modifiers = append(sds.modifiers, modifiers...)
+	return sds
+}
@@ -0,0 +1,714 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/WhaleHire/backend/db/jobposition"
+	"github.com/chaitin/WhaleHire/backend/db/predicate"
+	"github.com/chaitin/WhaleHire/backend/db/screeningdimension"
+	"github.com/google/uuid"
+)
+
+// ScreeningDimensionUpdate is the builder for updating ScreeningDimension entities.
+type ScreeningDimensionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ScreeningDimensionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ScreeningDimensionUpdate builder.
+func (sdu *ScreeningDimensionUpdate) Where(ps ...predicate.ScreeningDimension) *ScreeningDimensionUpdate {
+	sdu.mutation.Where(ps...)
+	return sdu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sdu *ScreeningDimensionUpdate) SetDeletedAt(t time.Time) *ScreeningDimensionUpdate {
+	sdu.mutation.SetDeletedAt(t)
+	return sdu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sdu *ScreeningDimensionUpdate) SetNillableDeletedAt(t *time.Time) *ScreeningDimensionUpdate {
+	if t != nil {
+		sdu.SetDeletedAt(*t)
+	}
+	return sdu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (sdu *ScreeningDimensionUpdate) ClearDeletedAt() *ScreeningDimensionUpdate {
+	sdu.mutation.ClearDeletedAt()
+	return sdu
+}
+
+// SetJobPositionID sets the "job_position_id" field.
+func (sdu *ScreeningDimensionUpdate) SetJobPositionID(u uuid.UUID) *ScreeningDimensionUpdate {
+	sdu.mutation.SetJobPositionID(u)
+	return sdu
+}
+
+// SetNillableJobPositionID sets the "job_position_id" field if the given value is not nil.
+func (sdu *ScreeningDimensionUpdate) SetNillableJobPositionID(u *uuid.UUID) *ScreeningDimensionUpdate {
+	if u != nil {
+		sdu.SetJobPositionID(*u)
+	}
+	return sdu
+}
+
+// SetKey sets the "key" field.
+func (sdu *ScreeningDimensionUpdate) SetKey(s string) *ScreeningDimensionUpdate {
+	sdu.mutation.SetKey(s)
+	return sdu
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (sdu *ScreeningDimensionUpdate) SetNillableKey(s *string) *ScreeningDimensionUpdate {
+	if s != nil {
+		sdu.SetKey(*s)
+	}
+	return sdu
+}
+
+// SetName sets the "name" field.
+func (sdu *ScreeningDimensionUpdate) SetName(s string) *ScreeningDimensionUpdate {
+	sdu.mutation.SetName(s)
+	return sdu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sdu *ScreeningDimensionUpdate) SetNillableName(s *string) *ScreeningDimensionUpdate {
+	if s != nil {
+		sdu.SetName(*s)
+	}
+	return sdu
+}
+
+// SetDescription sets the "description" field.
+func (sdu *ScreeningDimensionUpdate) SetDescription(s string) *ScreeningDimensionUpdate {
+	sdu.mutation.SetDescription(s)
+	return sdu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sdu *ScreeningDimensionUpdate) SetNillableDescription(s *string) *ScreeningDimensionUpdate {
+	if s != nil {
+		sdu.SetDescription(*s)
+	}
+	return sdu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (sdu *ScreeningDimensionUpdate) ClearDescription() *ScreeningDimensionUpdate {
+	sdu.mutation.ClearDescription()
+	return sdu
+}
+
+// SetPrompt sets the "prompt" field.
+func (sdu *ScreeningDimensionUpdate) SetPrompt(s string) *ScreeningDimensionUpdate {
+	sdu.mutation.SetPrompt(s)
+	return sdu
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (sdu *ScreeningDimensionUpdate) SetNillablePrompt(s *string) *ScreeningDimensionUpdate {
+	if s != nil {
+		sdu.SetPrompt(*s)
+	}
+	return sdu
+}
+
+// SetWeight sets the "weight" field.
+func (sdu *ScreeningDimensionUpdate) SetWeight(f float64) *ScreeningDimensionUpdate {
+	sdu.mutation.ResetWeight()
+	sdu.mutation.SetWeight(f)
+	return sdu
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (sdu *ScreeningDimensionUpdate) SetNillableWeight(f *float64) *ScreeningDimensionUpdate {
+	if f != nil {
+		sdu.SetWeight(*f)
+	}
+	return sdu
+}
+
+// AddWeight adds f to the "weight" field.
+func (sdu *ScreeningDimensionUpdate) AddWeight(f float64) *ScreeningDimensionUpdate {
+	sdu.mutation.AddWeight(f)
+	return sdu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (sdu *ScreeningDimensionUpdate) SetCreatedBy(u uuid.UUID) *ScreeningDimensionUpdate {
+	sdu.mutation.SetCreatedBy(u)
+	return sdu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (sdu *ScreeningDimensionUpdate) SetNillableCreatedBy(u *uuid.UUID) *ScreeningDimensionUpdate {
+	if u != nil {
+		sdu.SetCreatedBy(*u)
+	}
+	return sdu
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (sdu *ScreeningDimensionUpdate) ClearCreatedBy() *ScreeningDimensionUpdate {
+	sdu.mutation.ClearCreatedBy()
+	return sdu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sdu *ScreeningDimensionUpdate) SetUpdatedAt(t time.Time) *ScreeningDimensionUpdate {
+	sdu.mutation.SetUpdatedAt(t)
+	return sdu
+}
+
+// SetJobPosition sets the "job_position" edge to the JobPosition entity.
+func (sdu *ScreeningDimensionUpdate) SetJobPosition(j *JobPosition) *ScreeningDimensionUpdate {
+	return sdu.SetJobPositionID(j.ID)
+}
+
+// Mutation returns the ScreeningDimensionMutation object of the builder.
+func (sdu *ScreeningDimensionUpdate) Mutation() *ScreeningDimensionMutation {
+	return sdu.mutation
+}
+
+// ClearJobPosition clears the "job_position" edge to the JobPosition entity.
+func (sdu *ScreeningDimensionUpdate) ClearJobPosition() *ScreeningDimensionUpdate {
+	sdu.mutation.ClearJobPosition()
+	return sdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sdu *ScreeningDimensionUpdate) Save(ctx context.Context) (int, error) {
+	if err := sdu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, sdu.sqlSave, sdu.mutation, sdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sdu *ScreeningDimensionUpdate) SaveX(ctx context.Context) int {
+	affected, err := sdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sdu *ScreeningDimensionUpdate) Exec(ctx context.Context) error {
+	_, err := sdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdu *ScreeningDimensionUpdate) ExecX(ctx context.Context) {
+	if err := sdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sdu *ScreeningDimensionUpdate) defaults() error {
+	if _, ok := sdu.mutation.UpdatedAt(); !ok {
+		if screeningdimension.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("db: uninitialized screeningdimension.UpdateDefaultUpdatedAt (forgotten import db/runtime?)")
+		}
+		v := screeningdimension.UpdateDefaultUpdatedAt()
+		sdu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sdu *ScreeningDimensionUpdate) check() error {
+	if v, ok := sdu.mutation.Key(); ok {
+		if err := screeningdimension.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`db: validator failed for field "ScreeningDimension.key": %w`, err)}
+		}
+	}
+	if v, ok := sdu.mutation.Name(); ok {
+		if err := screeningdimension.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`db: validator failed for field "ScreeningDimension.name": %w`, err)}
+		}
+	}
+	if v, ok := sdu.mutation.Description(); ok {
+		if err := screeningdimension.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`db: validator failed for field "ScreeningDimension.description": %w`, err)}
+		}
+	}
+	if sdu.mutation.JobPositionCleared() && len(sdu.mutation.JobPositionIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "ScreeningDimension.job_position"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sdu *ScreeningDimensionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScreeningDimensionUpdate {
+	sdu.modifiers = append(sdu.modifiers, modifiers...)
+	return sdu
+}
+
+func (sdu *ScreeningDimensionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(screeningdimension.Table, screeningdimension.Columns, sqlgraph.NewFieldSpec(screeningdimension.FieldID, field.TypeUUID))
+	if ps := sdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sdu.mutation.DeletedAt(); ok {
+		_spec.SetField(screeningdimension.FieldDeletedAt, field.TypeTime, value)
+	}
+	if sdu.mutation.DeletedAtCleared() {
+		_spec.ClearField(screeningdimension.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := sdu.mutation.Key(); ok {
+		_spec.SetField(screeningdimension.FieldKey, field.TypeString, value)
+	}
+	if value, ok := sdu.mutation.Name(); ok {
+		_spec.SetField(screeningdimension.FieldName, field.TypeString, value)
+	}
+	if value, ok := sdu.mutation.Description(); ok {
+		_spec.SetField(screeningdimension.FieldDescription, field.TypeString, value)
+	}
+	if sdu.mutation.DescriptionCleared() {
+		_spec.ClearField(screeningdimension.FieldDescription, field.TypeString)
+	}
+	if value, ok := sdu.mutation.Prompt(); ok {
+		_spec.SetField(screeningdimension.FieldPrompt, field.TypeString, value)
+	}
+	if value, ok := sdu.mutation.Weight(); ok {
+		_spec.SetField(screeningdimension.FieldWeight, field.TypeFloat64, value)
+	}
+	if value, ok := sdu.mutation.AddedWeight(); ok {
+		_spec.AddField(screeningdimension.FieldWeight, field.TypeFloat64, value)
+	}
+	if value, ok := sdu.mutation.CreatedBy(); ok {
+		_spec.SetField(screeningdimension.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if sdu.mutation.CreatedByCleared() {
+		_spec.ClearField(screeningdimension.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := sdu.mutation.UpdatedAt(); ok {
+		_spec.SetField(screeningdimension.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sdu.mutation.JobPositionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   screeningdimension.JobPositionTable,
+			Columns: []string{screeningdimension.JobPositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobposition.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sdu.mutation.JobPositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   screeningdimension.JobPositionTable,
+			Columns: []string{screeningdimension.JobPositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobposition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(sdu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, sdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{screeningdimension.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sdu.mutation.done = true
+	return n, nil
+}
+
+// ScreeningDimensionUpdateOne is the builder for updating a single ScreeningDimension entity.
+type ScreeningDimensionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ScreeningDimensionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sduo *ScreeningDimensionUpdateOne) SetDeletedAt(t time.Time) *ScreeningDimensionUpdateOne {
+	sduo.mutation.SetDeletedAt(t)
+	return sduo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sduo *ScreeningDimensionUpdateOne) SetNillableDeletedAt(t *time.Time) *ScreeningDimensionUpdateOne {
+	if t != nil {
+		sduo.SetDeletedAt(*t)
+	}
+	return sduo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (sduo *ScreeningDimensionUpdateOne) ClearDeletedAt() *ScreeningDimensionUpdateOne {
+	sduo.mutation.ClearDeletedAt()
+	return sduo
+}
+
+// SetJobPositionID sets the "job_position_id" field.
+func (sduo *ScreeningDimensionUpdateOne) SetJobPositionID(u uuid.UUID) *ScreeningDimensionUpdateOne {
+	sduo.mutation.SetJobPositionID(u)
+	return sduo
+}
+
+// SetNillableJobPositionID sets the "job_position_id" field if the given value is not nil.
+func (sduo *ScreeningDimensionUpdateOne) SetNillableJobPositionID(u *uuid.UUID) *ScreeningDimensionUpdateOne {
+	if u != nil {
+		sduo.SetJobPositionID(*u)
+	}
+	return sduo
+}
+
+// SetKey sets the "key" field.
+func (sduo *ScreeningDimensionUpdateOne) SetKey(s string) *ScreeningDimensionUpdateOne {
+	sduo.mutation.SetKey(s)
+	return sduo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (sduo *ScreeningDimensionUpdateOne) SetNillableKey(s *string) *ScreeningDimensionUpdateOne {
+	if s != nil {
+		sduo.SetKey(*s)
+	}
+	return sduo
+}
+
+// SetName sets the "name" field.
+func (sduo *ScreeningDimensionUpdateOne) SetName(s string) *ScreeningDimensionUpdateOne {
+	sduo.mutation.SetName(s)
+	return sduo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sduo *ScreeningDimensionUpdateOne) SetNillableName(s *string) *ScreeningDimensionUpdateOne {
+	if s != nil {
+		sduo.SetName(*s)
+	}
+	return sduo
+}
+
+// SetDescription sets the "description" field.
+func (sduo *ScreeningDimensionUpdateOne) SetDescription(s string) *ScreeningDimensionUpdateOne {
+	sduo.mutation.SetDescription(s)
+	return sduo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sduo *ScreeningDimensionUpdateOne) SetNillableDescription(s *string) *ScreeningDimensionUpdateOne {
+	if s != nil {
+		sduo.SetDescription(*s)
+	}
+	return sduo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (sduo *ScreeningDimensionUpdateOne) ClearDescription() *ScreeningDimensionUpdateOne {
+	sduo.mutation.ClearDescription()
+	return sduo
+}
+
+// SetPrompt sets the "prompt" field.
+func (sduo *ScreeningDimensionUpdateOne) SetPrompt(s string) *ScreeningDimensionUpdateOne {
+	sduo.mutation.SetPrompt(s)
+	return sduo
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (sduo *ScreeningDimensionUpdateOne) SetNillablePrompt(s *string) *ScreeningDimensionUpdateOne {
+	if s != nil {
+		sduo.SetPrompt(*s)
+	}
+	return sduo
+}
+
+// SetWeight sets the "weight" field.
+func (sduo *ScreeningDimensionUpdateOne) SetWeight(f float64) *ScreeningDimensionUpdateOne {
+	sduo.mutation.ResetWeight()
+	sduo.mutation.SetWeight(f)
+	return sduo
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (sduo *ScreeningDimensionUpdateOne) SetNillableWeight(f *float64) *ScreeningDimensionUpdateOne {
+	if f != nil {
+		sduo.SetWeight(*f)
+	}
+	return sduo
+}
+
+// AddWeight adds f to the "weight" field.
+func (sduo *ScreeningDimensionUpdateOne) AddWeight(f float64) *ScreeningDimensionUpdateOne {
+	sduo.mutation.AddWeight(f)
+	return sduo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (sduo *ScreeningDimensionUpdateOne) SetCreatedBy(u uuid.UUID) *ScreeningDimensionUpdateOne {
+	sduo.mutation.SetCreatedBy(u)
+	return sduo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (sduo *ScreeningDimensionUpdateOne) SetNillableCreatedBy(u *uuid.UUID) *ScreeningDimensionUpdateOne {
+	if u != nil {
+		sduo.SetCreatedBy(*u)
+	}
+	return sduo
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (sduo *ScreeningDimensionUpdateOne) ClearCreatedBy() *ScreeningDimensionUpdateOne {
+	sduo.mutation.ClearCreatedBy()
+	return sduo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sduo *ScreeningDimensionUpdateOne) SetUpdatedAt(t time.Time) *ScreeningDimensionUpdateOne {
+	sduo.mutation.SetUpdatedAt(t)
+	return sduo
+}
+
+// SetJobPosition sets the "job_position" edge to the JobPosition entity.
+func (sduo *ScreeningDimensionUpdateOne) SetJobPosition(j *JobPosition) *ScreeningDimensionUpdateOne {
+	return sduo.SetJobPositionID(j.ID)
+}
+
+// Mutation returns the ScreeningDimensionMutation object of the builder.
+func (sduo *ScreeningDimensionUpdateOne) Mutation() *ScreeningDimensionMutation {
+	return sduo.mutation
+}
+
+// ClearJobPosition clears the "job_position" edge to the JobPosition entity.
+func (sduo *ScreeningDimensionUpdateOne) ClearJobPosition() *ScreeningDimensionUpdateOne {
+	sduo.mutation.ClearJobPosition()
+	return sduo
+}
+
+// Where appends a list predicates to the ScreeningDimensionUpdate builder.
+func (sduo *ScreeningDimensionUpdateOne) Where(ps ...predicate.ScreeningDimension) *ScreeningDimensionUpdateOne {
+	sduo.mutation.Where(ps...)
+	return sduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sduo *ScreeningDimensionUpdateOne) Select(field string, fields ...string) *ScreeningDimensionUpdateOne {
+	sduo.fields = append([]string{field}, fields...)
+	return sduo
+}
+
+// Save executes the query and returns the updated ScreeningDimension entity.
+func (sduo *ScreeningDimensionUpdateOne) Save(ctx context.Context) (*ScreeningDimension, error) {
+	if err := sduo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, sduo.sqlSave, sduo.mutation, sduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sduo *ScreeningDimensionUpdateOne) SaveX(ctx context.Context) *ScreeningDimension {
+	node, err := sduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sduo *ScreeningDimensionUpdateOne) Exec(ctx context.Context) error {
+	_, err := sduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sduo *ScreeningDimensionUpdateOne) ExecX(ctx context.Context) {
+	if err := sduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sduo *ScreeningDimensionUpdateOne) defaults() error {
+	if _, ok := sduo.mutation.UpdatedAt(); !ok {
+		if screeningdimension.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("db: uninitialized screeningdimension.UpdateDefaultUpdatedAt (forgotten import db/runtime?)")
+		}
+		v := screeningdimension.UpdateDefaultUpdatedAt()
+		sduo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sduo *ScreeningDimensionUpdateOne) check() error {
+	if v, ok := sduo.mutation.Key(); ok {
+		if err := screeningdimension.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`db: validator failed for field "ScreeningDimension.key": %w`, err)}
+		}
+	}
+	if v, ok := sduo.mutation.Name(); ok {
+		if err := screeningdimension.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`db: validator failed for field "ScreeningDimension.name": %w`, err)}
+		}
+	}
+	if v, ok := sduo.mutation.Description(); ok {
+		if err := screeningdimension.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`db: validator failed for field "ScreeningDimension.description": %w`, err)}
+		}
+	}
+	if sduo.mutation.JobPositionCleared() && len(sduo.mutation.JobPositionIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "ScreeningDimension.job_position"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sduo *ScreeningDimensionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScreeningDimensionUpdateOne {
+	sduo.modifiers = append(sduo.modifiers, modifiers...)
+	return sduo
+}
+
+func (sduo *ScreeningDimensionUpdateOne) sqlSave(ctx context.Context) (_node *ScreeningDimension, err error) {
+	if err := sduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(screeningdimension.Table, screeningdimension.Columns, sqlgraph.NewFieldSpec(screeningdimension.FieldID, field.TypeUUID))
+	id, ok := sduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "ScreeningDimension.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, screeningdimension.FieldID)
+		for _, f := range fields {
+			if !screeningdimension.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != screeningdimension.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sduo.mutation.DeletedAt(); ok {
+		_spec.SetField(screeningdimension.FieldDeletedAt, field.TypeTime, value)
+	}
+	if sduo.mutation.DeletedAtCleared() {
+		_spec.ClearField(screeningdimension.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := sduo.mutation.Key(); ok {
+		_spec.SetField(screeningdimension.FieldKey, field.TypeString, value)
+	}
+	if value, ok := sduo.mutation.Name(); ok {
+		_spec.SetField(screeningdimension.FieldName, field.TypeString, value)
+	}
+	if value, ok := sduo.mutation.Description(); ok {
+		_spec.SetField(screeningdimension.FieldDescription, field.TypeString, value)
+	}
+	if sduo.mutation.DescriptionCleared() {
+		_spec.ClearField(screeningdimension.FieldDescription, field.TypeString)
+	}
+	if value, ok := sduo.mutation.Prompt(); ok {
+		_spec.SetField(screeningdimension.FieldPrompt, field.TypeString, value)
+	}
+	if value, ok := sduo.mutation.Weight(); ok {
+		_spec.SetField(screeningdimension.FieldWeight, field.TypeFloat64, value)
+	}
+	if value, ok := sduo.mutation.AddedWeight(); ok {
+		_spec.AddField(screeningdimension.FieldWeight, field.TypeFloat64, value)
+	}
+	if value, ok := sduo.mutation.CreatedBy(); ok {
+		_spec.SetField(screeningdimension.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if sduo.mutation.CreatedByCleared() {
+		_spec.ClearField(screeningdimension.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := sduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(screeningdimension.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sduo.mutation.JobPositionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   screeningdimension.JobPositionTable,
+			Columns: []string{screeningdimension.JobPositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobposition.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sduo.mutation.JobPositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   screeningdimension.JobPositionTable,
+			Columns: []string{screeningdimension.JobPositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobposition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(sduo.modifiers...)
+	_node = &ScreeningDimension{config: sduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{screeningdimension.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sduo.mutation.done = true
+	return _node, nil
+}
@@ -61,6 +61,10 @@ type ScreeningNodeRun struct {
 	FinishedAt time.Time `json:"finished_at,omitempty"`
 	// 耗时(毫秒)
 	DurationMs int `json:"duration_ms,omitempty"`
+	// 维度结果复用键，由节点输入内容、Agent版本与模型计算
+	CacheKey string `json:"cache_key,omitempty"`
+	// 复用的历史节点运行ID
+	ReusedFromID *uuid.UUID `json:"reused_from_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -109,13 +113,15 @@ func (*ScreeningNodeRun) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case screeningnoderun.FieldReusedFromID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case screeningnoderun.FieldLlmParams, screeningnoderun.FieldInputPayload, screeningnoderun.FieldOutputPayload:
 			values[i] = new([]byte)
 		case screeningnoderun.FieldTotalCost:
 			values[i] = new(sql.NullFloat64)
 		case screeningnoderun.FieldAttemptNo, screeningnoderun.FieldTokensInput, screeningnoderun.FieldTokensOutput, screeningnoderun.FieldDurationMs:
 			values[i] = new(sql.NullInt64)
-		case screeningnoderun.FieldNodeKey, screeningnoderun.FieldStatus, screeningnoderun.FieldTraceID, screeningnoderun.FieldAgentVersion, screeningnoderun.FieldModelName, screeningnoderun.FieldModelProvider, screeningnoderun.FieldErrorMessage:
+		case screeningnoderun.FieldNodeKey, screeningnoderun.FieldStatus, screeningnoderun.FieldTraceID, screeningnoderun.FieldAgentVersion, screeningnoderun.FieldModelName, screeningnoderun.FieldModelProvider, screeningnoderun.FieldErrorMessage, screeningnoderun.FieldCacheKey:
 			values[i] = new(sql.NullString)
 		case screeningnoderun.FieldDeletedAt, screeningnoderun.FieldStartedAt, screeningnoderun.FieldFinishedAt, screeningnoderun.FieldCreatedAt, screeningnoderun.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -268,6 +274,19 @@ func (snr *ScreeningNodeRun) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				snr.DurationMs = int(value.Int64)
 			}
+		case screeningnoderun.FieldCacheKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cache_key", values[i])
+			} else if value.Valid {
+				snr.CacheKey = value.String
+			}
+		case screeningnoderun.FieldReusedFromID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reused_from_id", values[i])
+			} else if value.Valid {
+				snr.ReusedFromID = new(uuid.UUID)
+				*snr.ReusedFromID = *value.S.(*uuid.UUID)
+			}
 		case screeningnoderun.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -386,6 +405,14 @@ func (snr *ScreeningNodeRun) String() string {
 	builder.WriteString("duration_ms=")
 	builder.WriteString(fmt.Sprintf("%v", snr.DurationMs))
 	builder.WriteString(", ")
+	builder.WriteString("cache_key=")
+	builder.WriteString(snr.CacheKey)
+	builder.WriteString(", ")
+	if v := snr.ReusedFromID; v != nil {
+		builder.WriteString("reused_from_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(snr.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -56,6 +56,10 @@ const (
 	FieldFinishedAt = "finished_at"
 	// FieldDurationMs holds the string denoting the duration_ms field in the database.
 	FieldDurationMs = "duration_ms"
+	// FieldCacheKey holds the string denoting the cache_key field in the database.
+	FieldCacheKey = "cache_key"
+	// FieldReusedFromID holds the string denoting the reused_from_id field in the database.
+	FieldReusedFromID = "reused_from_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -105,6 +109,8 @@ var Columns = []string{
 	FieldStartedAt,
 	FieldFinishedAt,
 	FieldDurationMs,
+	FieldCacheKey,
+	FieldReusedFromID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -139,6 +145,8 @@ var (
 	ModelNameValidator func(string) error
 	// ModelProviderValidator is a validator for the "model_provider" field. It is called by the builders before save.
 	ModelProviderValidator func(string) error
+	// CacheKeyValidator is a validator for the "cache_key" field. It is called by the builders before save.
+	CacheKeyValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -242,6 +250,16 @@ func ByDurationMs(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDurationMs, opts...).ToFunc()
 }
 
+// ByCacheKey orders the results by the cache_key field.
+func ByCacheKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCacheKey, opts...).ToFunc()
+}
+
+// ByReusedFromID orders the results by the reused_from_id field.
+func ByReusedFromID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReusedFromID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
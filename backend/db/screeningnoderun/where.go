@@ -141,6 +141,16 @@ func DurationMs(v int) predicate.ScreeningNodeRun {
 	return predicate.ScreeningNodeRun(sql.FieldEQ(FieldDurationMs, v))
 }
 
+// CacheKey applies equality check predicate on the "cache_key" field. It's identical to CacheKeyEQ.
+func CacheKey(v string) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldEQ(FieldCacheKey, v))
+}
+
+// ReusedFromID applies equality check predicate on the "reused_from_id" field. It's identical to ReusedFromIDEQ.
+func ReusedFromID(v uuid.UUID) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldEQ(FieldReusedFromID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.ScreeningNodeRun {
 	return predicate.ScreeningNodeRun(sql.FieldEQ(FieldCreatedAt, v))
@@ -1126,6 +1136,131 @@ func DurationMsNotNil() predicate.ScreeningNodeRun {
 	return predicate.ScreeningNodeRun(sql.FieldNotNull(FieldDurationMs))
 }
 
+// CacheKeyEQ applies the EQ predicate on the "cache_key" field.
+func CacheKeyEQ(v string) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldEQ(FieldCacheKey, v))
+}
+
+// CacheKeyNEQ applies the NEQ predicate on the "cache_key" field.
+func CacheKeyNEQ(v string) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldNEQ(FieldCacheKey, v))
+}
+
+// CacheKeyIn applies the In predicate on the "cache_key" field.
+func CacheKeyIn(vs ...string) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldIn(FieldCacheKey, vs...))
+}
+
+// CacheKeyNotIn applies the NotIn predicate on the "cache_key" field.
+func CacheKeyNotIn(vs ...string) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldNotIn(FieldCacheKey, vs...))
+}
+
+// CacheKeyGT applies the GT predicate on the "cache_key" field.
+func CacheKeyGT(v string) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldGT(FieldCacheKey, v))
+}
+
+// CacheKeyGTE applies the GTE predicate on the "cache_key" field.
+func CacheKeyGTE(v string) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldGTE(FieldCacheKey, v))
+}
+
+// CacheKeyLT applies the LT predicate on the "cache_key" field.
+func CacheKeyLT(v string) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldLT(FieldCacheKey, v))
+}
+
+// CacheKeyLTE applies the LTE predicate on the "cache_key" field.
+func CacheKeyLTE(v string) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldLTE(FieldCacheKey, v))
+}
+
+// CacheKeyContains applies the Contains predicate on the "cache_key" field.
+func CacheKeyContains(v string) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldContains(FieldCacheKey, v))
+}
+
+// CacheKeyHasPrefix applies the HasPrefix predicate on the "cache_key" field.
+func CacheKeyHasPrefix(v string) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldHasPrefix(FieldCacheKey, v))
+}
+
+// CacheKeyHasSuffix applies the HasSuffix predicate on the "cache_key" field.
+func CacheKeyHasSuffix(v string) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldHasSuffix(FieldCacheKey, v))
+}
+
+// CacheKeyIsNil applies the IsNil predicate on the "cache_key" field.
+func CacheKeyIsNil() predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldIsNull(FieldCacheKey))
+}
+
+// CacheKeyNotNil applies the NotNil predicate on the "cache_key" field.
+func CacheKeyNotNil() predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldNotNull(FieldCacheKey))
+}
+
+// CacheKeyEqualFold applies the EqualFold predicate on the "cache_key" field.
+func CacheKeyEqualFold(v string) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldEqualFold(FieldCacheKey, v))
+}
+
+// CacheKeyContainsFold applies the ContainsFold predicate on the "cache_key" field.
+func CacheKeyContainsFold(v string) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldContainsFold(FieldCacheKey, v))
+}
+
+// ReusedFromIDEQ applies the EQ predicate on the "reused_from_id" field.
+func ReusedFromIDEQ(v uuid.UUID) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldEQ(FieldReusedFromID, v))
+}
+
+// ReusedFromIDNEQ applies the NEQ predicate on the "reused_from_id" field.
+func ReusedFromIDNEQ(v uuid.UUID) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldNEQ(FieldReusedFromID, v))
+}
+
+// ReusedFromIDIn applies the In predicate on the "reused_from_id" field.
+func ReusedFromIDIn(vs ...uuid.UUID) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldIn(FieldReusedFromID, vs...))
+}
+
+// ReusedFromIDNotIn applies the NotIn predicate on the "reused_from_id" field.
+func ReusedFromIDNotIn(vs ...uuid.UUID) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldNotIn(FieldReusedFromID, vs...))
+}
+
+// ReusedFromIDGT applies the GT predicate on the "reused_from_id" field.
+func ReusedFromIDGT(v uuid.UUID) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldGT(FieldReusedFromID, v))
+}
+
+// ReusedFromIDGTE applies the GTE predicate on the "reused_from_id" field.
+func ReusedFromIDGTE(v uuid.UUID) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldGTE(FieldReusedFromID, v))
+}
+
+// ReusedFromIDLT applies the LT predicate on the "reused_from_id" field.
+func ReusedFromIDLT(v uuid.UUID) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldLT(FieldReusedFromID, v))
+}
+
+// ReusedFromIDLTE applies the LTE predicate on the "reused_from_id" field.
+func ReusedFromIDLTE(v uuid.UUID) predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldLTE(FieldReusedFromID, v))
+}
+
+// ReusedFromIDIsNil applies the IsNil predicate on the "reused_from_id" field.
+func ReusedFromIDIsNil() predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldIsNull(FieldReusedFromID))
+}
+
+// ReusedFromIDNotNil applies the NotNil predicate on the "reused_from_id" field.
+func ReusedFromIDNotNil() predicate.ScreeningNodeRun {
+	return predicate.ScreeningNodeRun(sql.FieldNotNull(FieldReusedFromID))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ScreeningNodeRun {
 	return predicate.ScreeningNodeRun(sql.FieldEQ(FieldCreatedAt, v))
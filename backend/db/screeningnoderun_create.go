@@ -266,6 +266,34 @@ func (snrc *ScreeningNodeRunCreate) SetNillableDurationMs(i *int) *ScreeningNode
 	return snrc
 }
 
+// SetCacheKey sets the "cache_key" field.
+func (snrc *ScreeningNodeRunCreate) SetCacheKey(s string) *ScreeningNodeRunCreate {
+	snrc.mutation.SetCacheKey(s)
+	return snrc
+}
+
+// SetNillableCacheKey sets the "cache_key" field if the given value is not nil.
+func (snrc *ScreeningNodeRunCreate) SetNillableCacheKey(s *string) *ScreeningNodeRunCreate {
+	if s != nil {
+		snrc.SetCacheKey(*s)
+	}
+	return snrc
+}
+
+// SetReusedFromID sets the "reused_from_id" field.
+func (snrc *ScreeningNodeRunCreate) SetReusedFromID(u uuid.UUID) *ScreeningNodeRunCreate {
+	snrc.mutation.SetReusedFromID(u)
+	return snrc
+}
+
+// SetNillableReusedFromID sets the "reused_from_id" field if the given value is not nil.
+func (snrc *ScreeningNodeRunCreate) SetNillableReusedFromID(u *uuid.UUID) *ScreeningNodeRunCreate {
+	if u != nil {
+		snrc.SetReusedFromID(*u)
+	}
+	return snrc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (snrc *ScreeningNodeRunCreate) SetCreatedAt(t time.Time) *ScreeningNodeRunCreate {
 	snrc.mutation.SetCreatedAt(t)
@@ -421,6 +449,11 @@ func (snrc *ScreeningNodeRunCreate) check() error {
 			return &ValidationError{Name: "model_provider", err: fmt.Errorf(`db: validator failed for field "ScreeningNodeRun.model_provider": %w`, err)}
 		}
 	}
+	if v, ok := snrc.mutation.CacheKey(); ok {
+		if err := screeningnoderun.CacheKeyValidator(v); err != nil {
+			return &ValidationError{Name: "cache_key", err: fmt.Errorf(`db: validator failed for field "ScreeningNodeRun.cache_key": %w`, err)}
+		}
+	}
 	if _, ok := snrc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "ScreeningNodeRun.created_at"`)}
 	}
@@ -541,6 +574,14 @@ func (snrc *ScreeningNodeRunCreate) createSpec() (*ScreeningNodeRun, *sqlgraph.C
 		_spec.SetField(screeningnoderun.FieldDurationMs, field.TypeInt, value)
 		_node.DurationMs = value
 	}
+	if value, ok := snrc.mutation.CacheKey(); ok {
+		_spec.SetField(screeningnoderun.FieldCacheKey, field.TypeString, value)
+		_node.CacheKey = value
+	}
+	if value, ok := snrc.mutation.ReusedFromID(); ok {
+		_spec.SetField(screeningnoderun.FieldReusedFromID, field.TypeUUID, value)
+		_node.ReusedFromID = &value
+	}
 	if value, ok := snrc.mutation.CreatedAt(); ok {
 		_spec.SetField(screeningnoderun.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -1001,6 +1042,42 @@ func (u *ScreeningNodeRunUpsert) ClearDurationMs() *ScreeningNodeRunUpsert {
 	return u
 }
 
+// SetCacheKey sets the "cache_key" field.
+func (u *ScreeningNodeRunUpsert) SetCacheKey(v string) *ScreeningNodeRunUpsert {
+	u.Set(screeningnoderun.FieldCacheKey, v)
+	return u
+}
+
+// UpdateCacheKey sets the "cache_key" field to the value that was provided on create.
+func (u *ScreeningNodeRunUpsert) UpdateCacheKey() *ScreeningNodeRunUpsert {
+	u.SetExcluded(screeningnoderun.FieldCacheKey)
+	return u
+}
+
+// ClearCacheKey clears the value of the "cache_key" field.
+func (u *ScreeningNodeRunUpsert) ClearCacheKey() *ScreeningNodeRunUpsert {
+	u.SetNull(screeningnoderun.FieldCacheKey)
+	return u
+}
+
+// SetReusedFromID sets the "reused_from_id" field.
+func (u *ScreeningNodeRunUpsert) SetReusedFromID(v uuid.UUID) *ScreeningNodeRunUpsert {
+	u.Set(screeningnoderun.FieldReusedFromID, v)
+	return u
+}
+
+// UpdateReusedFromID sets the "reused_from_id" field to the value that was provided on create.
+func (u *ScreeningNodeRunUpsert) UpdateReusedFromID() *ScreeningNodeRunUpsert {
+	u.SetExcluded(screeningnoderun.FieldReusedFromID)
+	return u
+}
+
+// ClearReusedFromID clears the value of the "reused_from_id" field.
+func (u *ScreeningNodeRunUpsert) ClearReusedFromID() *ScreeningNodeRunUpsert {
+	u.SetNull(screeningnoderun.FieldReusedFromID)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ScreeningNodeRunUpsert) SetUpdatedAt(v time.Time) *ScreeningNodeRunUpsert {
 	u.Set(screeningnoderun.FieldUpdatedAt, v)
@@ -1491,6 +1568,48 @@ func (u *ScreeningNodeRunUpsertOne) ClearDurationMs() *ScreeningNodeRunUpsertOne
 	})
 }
 
+// SetCacheKey sets the "cache_key" field.
+func (u *ScreeningNodeRunUpsertOne) SetCacheKey(v string) *ScreeningNodeRunUpsertOne {
+	return u.Update(func(s *ScreeningNodeRunUpsert) {
+		s.SetCacheKey(v)
+	})
+}
+
+// UpdateCacheKey sets the "cache_key" field to the value that was provided on create.
+func (u *ScreeningNodeRunUpsertOne) UpdateCacheKey() *ScreeningNodeRunUpsertOne {
+	return u.Update(func(s *ScreeningNodeRunUpsert) {
+		s.UpdateCacheKey()
+	})
+}
+
+// ClearCacheKey clears the value of the "cache_key" field.
+func (u *ScreeningNodeRunUpsertOne) ClearCacheKey() *ScreeningNodeRunUpsertOne {
+	return u.Update(func(s *ScreeningNodeRunUpsert) {
+		s.ClearCacheKey()
+	})
+}
+
+// SetReusedFromID sets the "reused_from_id" field.
+func (u *ScreeningNodeRunUpsertOne) SetReusedFromID(v uuid.UUID) *ScreeningNodeRunUpsertOne {
+	return u.Update(func(s *ScreeningNodeRunUpsert) {
+		s.SetReusedFromID(v)
+	})
+}
+
+// UpdateReusedFromID sets the "reused_from_id" field to the value that was provided on create.
+func (u *ScreeningNodeRunUpsertOne) UpdateReusedFromID() *ScreeningNodeRunUpsertOne {
+	return u.Update(func(s *ScreeningNodeRunUpsert) {
+		s.UpdateReusedFromID()
+	})
+}
+
+// ClearReusedFromID clears the value of the "reused_from_id" field.
+func (u *ScreeningNodeRunUpsertOne) ClearReusedFromID() *ScreeningNodeRunUpsertOne {
+	return u.Update(func(s *ScreeningNodeRunUpsert) {
+		s.ClearReusedFromID()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ScreeningNodeRunUpsertOne) SetUpdatedAt(v time.Time) *ScreeningNodeRunUpsertOne {
 	return u.Update(func(s *ScreeningNodeRunUpsert) {
@@ -2150,6 +2269,48 @@ func (u *ScreeningNodeRunUpsertBulk) ClearDurationMs() *ScreeningNodeRunUpsertBu
 	})
 }
 
+// SetCacheKey sets the "cache_key" field.
+func (u *ScreeningNodeRunUpsertBulk) SetCacheKey(v string) *ScreeningNodeRunUpsertBulk {
+	return u.Update(func(s *ScreeningNodeRunUpsert) {
+		s.SetCacheKey(v)
+	})
+}
+
+// UpdateCacheKey sets the "cache_key" field to the value that was provided on create.
+func (u *ScreeningNodeRunUpsertBulk) UpdateCacheKey() *ScreeningNodeRunUpsertBulk {
+	return u.Update(func(s *ScreeningNodeRunUpsert) {
+		s.UpdateCacheKey()
+	})
+}
+
+// ClearCacheKey clears the value of the "cache_key" field.
+func (u *ScreeningNodeRunUpsertBulk) ClearCacheKey() *ScreeningNodeRunUpsertBulk {
+	return u.Update(func(s *ScreeningNodeRunUpsert) {
+		s.ClearCacheKey()
+	})
+}
+
+// SetReusedFromID sets the "reused_from_id" field.
+func (u *ScreeningNodeRunUpsertBulk) SetReusedFromID(v uuid.UUID) *ScreeningNodeRunUpsertBulk {
+	return u.Update(func(s *ScreeningNodeRunUpsert) {
+		s.SetReusedFromID(v)
+	})
+}
+
+// UpdateReusedFromID sets the "reused_from_id" field to the value that was provided on create.
+func (u *ScreeningNodeRunUpsertBulk) UpdateReusedFromID() *ScreeningNodeRunUpsertBulk {
+	return u.Update(func(s *ScreeningNodeRunUpsert) {
+		s.UpdateReusedFromID()
+	})
+}
+
+// ClearReusedFromID clears the value of the "reused_from_id" field.
+func (u *ScreeningNodeRunUpsertBulk) ClearReusedFromID() *ScreeningNodeRunUpsertBulk {
+	return u.Update(func(s *ScreeningNodeRunUpsert) {
+		s.ClearReusedFromID()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ScreeningNodeRunUpsertBulk) SetUpdatedAt(v time.Time) *ScreeningNodeRunUpsertBulk {
 	return u.Update(func(s *ScreeningNodeRunUpsert) {
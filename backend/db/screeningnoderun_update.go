@@ -419,6 +419,46 @@ func (snru *ScreeningNodeRunUpdate) ClearDurationMs() *ScreeningNodeRunUpdate {
 	return snru
 }
 
+// SetCacheKey sets the "cache_key" field.
+func (snru *ScreeningNodeRunUpdate) SetCacheKey(s string) *ScreeningNodeRunUpdate {
+	snru.mutation.SetCacheKey(s)
+	return snru
+}
+
+// SetNillableCacheKey sets the "cache_key" field if the given value is not nil.
+func (snru *ScreeningNodeRunUpdate) SetNillableCacheKey(s *string) *ScreeningNodeRunUpdate {
+	if s != nil {
+		snru.SetCacheKey(*s)
+	}
+	return snru
+}
+
+// ClearCacheKey clears the value of the "cache_key" field.
+func (snru *ScreeningNodeRunUpdate) ClearCacheKey() *ScreeningNodeRunUpdate {
+	snru.mutation.ClearCacheKey()
+	return snru
+}
+
+// SetReusedFromID sets the "reused_from_id" field.
+func (snru *ScreeningNodeRunUpdate) SetReusedFromID(u uuid.UUID) *ScreeningNodeRunUpdate {
+	snru.mutation.SetReusedFromID(u)
+	return snru
+}
+
+// SetNillableReusedFromID sets the "reused_from_id" field if the given value is not nil.
+func (snru *ScreeningNodeRunUpdate) SetNillableReusedFromID(u *uuid.UUID) *ScreeningNodeRunUpdate {
+	if u != nil {
+		snru.SetReusedFromID(*u)
+	}
+	return snru
+}
+
+// ClearReusedFromID clears the value of the "reused_from_id" field.
+func (snru *ScreeningNodeRunUpdate) ClearReusedFromID() *ScreeningNodeRunUpdate {
+	snru.mutation.ClearReusedFromID()
+	return snru
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (snru *ScreeningNodeRunUpdate) SetUpdatedAt(t time.Time) *ScreeningNodeRunUpdate {
 	snru.mutation.SetUpdatedAt(t)
@@ -516,6 +556,11 @@ func (snru *ScreeningNodeRunUpdate) check() error {
 			return &ValidationError{Name: "model_provider", err: fmt.Errorf(`db: validator failed for field "ScreeningNodeRun.model_provider": %w`, err)}
 		}
 	}
+	if v, ok := snru.mutation.CacheKey(); ok {
+		if err := screeningnoderun.CacheKeyValidator(v); err != nil {
+			return &ValidationError{Name: "cache_key", err: fmt.Errorf(`db: validator failed for field "ScreeningNodeRun.cache_key": %w`, err)}
+		}
+	}
 	if snru.mutation.TaskCleared() && len(snru.mutation.TaskIDs()) > 0 {
 		return errors.New(`db: clearing a required unique edge "ScreeningNodeRun.task"`)
 	}
@@ -660,6 +705,18 @@ func (snru *ScreeningNodeRunUpdate) sqlSave(ctx context.Context) (n int, err err
 	if snru.mutation.DurationMsCleared() {
 		_spec.ClearField(screeningnoderun.FieldDurationMs, field.TypeInt)
 	}
+	if value, ok := snru.mutation.CacheKey(); ok {
+		_spec.SetField(screeningnoderun.FieldCacheKey, field.TypeString, value)
+	}
+	if snru.mutation.CacheKeyCleared() {
+		_spec.ClearField(screeningnoderun.FieldCacheKey, field.TypeString)
+	}
+	if value, ok := snru.mutation.ReusedFromID(); ok {
+		_spec.SetField(screeningnoderun.FieldReusedFromID, field.TypeUUID, value)
+	}
+	if snru.mutation.ReusedFromIDCleared() {
+		_spec.ClearField(screeningnoderun.FieldReusedFromID, field.TypeUUID)
+	}
 	if value, ok := snru.mutation.UpdatedAt(); ok {
 		_spec.SetField(screeningnoderun.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1130,6 +1187,46 @@ func (snruo *ScreeningNodeRunUpdateOne) ClearDurationMs() *ScreeningNodeRunUpdat
 	return snruo
 }
 
+// SetCacheKey sets the "cache_key" field.
+func (snruo *ScreeningNodeRunUpdateOne) SetCacheKey(s string) *ScreeningNodeRunUpdateOne {
+	snruo.mutation.SetCacheKey(s)
+	return snruo
+}
+
+// SetNillableCacheKey sets the "cache_key" field if the given value is not nil.
+func (snruo *ScreeningNodeRunUpdateOne) SetNillableCacheKey(s *string) *ScreeningNodeRunUpdateOne {
+	if s != nil {
+		snruo.SetCacheKey(*s)
+	}
+	return snruo
+}
+
+// ClearCacheKey clears the value of the "cache_key" field.
+func (snruo *ScreeningNodeRunUpdateOne) ClearCacheKey() *ScreeningNodeRunUpdateOne {
+	snruo.mutation.ClearCacheKey()
+	return snruo
+}
+
+// SetReusedFromID sets the "reused_from_id" field.
+func (snruo *ScreeningNodeRunUpdateOne) SetReusedFromID(u uuid.UUID) *ScreeningNodeRunUpdateOne {
+	snruo.mutation.SetReusedFromID(u)
+	return snruo
+}
+
+// SetNillableReusedFromID sets the "reused_from_id" field if the given value is not nil.
+func (snruo *ScreeningNodeRunUpdateOne) SetNillableReusedFromID(u *uuid.UUID) *ScreeningNodeRunUpdateOne {
+	if u != nil {
+		snruo.SetReusedFromID(*u)
+	}
+	return snruo
+}
+
+// ClearReusedFromID clears the value of the "reused_from_id" field.
+func (snruo *ScreeningNodeRunUpdateOne) ClearReusedFromID() *ScreeningNodeRunUpdateOne {
+	snruo.mutation.ClearReusedFromID()
+	return snruo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (snruo *ScreeningNodeRunUpdateOne) SetUpdatedAt(t time.Time) *ScreeningNodeRunUpdateOne {
 	snruo.mutation.SetUpdatedAt(t)
@@ -1240,6 +1337,11 @@ func (snruo *ScreeningNodeRunUpdateOne) check() error {
 			return &ValidationError{Name: "model_provider", err: fmt.Errorf(`db: validator failed for field "ScreeningNodeRun.model_provider": %w`, err)}
 		}
 	}
+	if v, ok := snruo.mutation.CacheKey(); ok {
+		if err := screeningnoderun.CacheKeyValidator(v); err != nil {
+			return &ValidationError{Name: "cache_key", err: fmt.Errorf(`db: validator failed for field "ScreeningNodeRun.cache_key": %w`, err)}
+		}
+	}
 	if snruo.mutation.TaskCleared() && len(snruo.mutation.TaskIDs()) > 0 {
 		return errors.New(`db: clearing a required unique edge "ScreeningNodeRun.task"`)
 	}
@@ -1401,6 +1503,18 @@ func (snruo *ScreeningNodeRunUpdateOne) sqlSave(ctx context.Context) (_node *Scr
 	if snruo.mutation.DurationMsCleared() {
 		_spec.ClearField(screeningnoderun.FieldDurationMs, field.TypeInt)
 	}
+	if value, ok := snruo.mutation.CacheKey(); ok {
+		_spec.SetField(screeningnoderun.FieldCacheKey, field.TypeString, value)
+	}
+	if snruo.mutation.CacheKeyCleared() {
+		_spec.ClearField(screeningnoderun.FieldCacheKey, field.TypeString)
+	}
+	if value, ok := snruo.mutation.ReusedFromID(); ok {
+		_spec.SetField(screeningnoderun.FieldReusedFromID, field.TypeUUID, value)
+	}
+	if snruo.mutation.ReusedFromIDCleared() {
+		_spec.ClearField(screeningnoderun.FieldReusedFromID, field.TypeUUID)
+	}
 	if value, ok := snruo.mutation.UpdatedAt(); ok {
 		_spec.SetField(screeningnoderun.FieldUpdatedAt, field.TypeTime, value)
 	}
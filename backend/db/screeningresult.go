@@ -38,6 +38,8 @@ type ScreeningResult struct {
 	DimensionScores map[string]interface{} `json:"dimension_scores,omitempty"`
 	// 技能匹配详情
 	SkillDetail map[string]interface{} `json:"skill_detail,omitempty"`
+	// 自定义维度匹配详情，按维度标识索引
+	CustomDetails map[string]interface{} `json:"custom_details,omitempty"`
 	// 职责匹配详情
 	ResponsibilityDetail map[string]interface{} `json:"responsibility_detail,omitempty"`
 	// 经验匹配详情
@@ -50,12 +52,34 @@ type ScreeningResult struct {
 	BasicDetail map[string]interface{} `json:"basic_detail,omitempty"`
 	// 匹配建议
 	Recommendations []string `json:"recommendations,omitempty"`
+	// 未通过的硬性淘汰条件，非空表示未调用模型直接判定为不匹配
+	KnockoutReasons []string `json:"knockout_reasons,omitempty"`
 	// 链路追踪ID
 	TraceID string `json:"trace_id,omitempty"`
 	// 运行时元数据
 	RuntimeMetadata map[string]interface{} `json:"runtime_metadata,omitempty"`
 	// 各Agent版本快照
 	SubAgentVersions map[string]interface{} `json:"sub_agent_versions,omitempty"`
+	// 岗位画像内容哈希
+	JobHash string `json:"job_hash,omitempty"`
+	// 简历内容哈希
+	ResumeHash string `json:"resume_hash,omitempty"`
+	// 子Agent版本与模型指纹
+	AgentHash string `json:"agent_hash,omitempty"`
+	// 复用的历史筛选结果ID
+	CachedFromID *uuid.UUID `json:"cached_from_id,omitempty"`
+	// 人工校准的综合得分
+	HumanOverallScore *float64 `json:"human_overall_score,omitempty"`
+	// 人工校准的匹配等级
+	HumanMatchLevel *screeningresult.HumanMatchLevel `json:"human_match_level,omitempty"`
+	// 人工校准的各维度得分
+	HumanDimensionScores map[string]interface{} `json:"human_dimension_scores,omitempty"`
+	// 人工校准原因
+	OverrideReason string `json:"override_reason,omitempty"`
+	// 校准人ID
+	OverriddenBy *uuid.UUID `json:"overridden_by,omitempty"`
+	// 校准时间
+	OverriddenAt *time.Time `json:"overridden_at,omitempty"`
 	// 匹配时间
 	MatchedAt time.Time `json:"matched_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -119,13 +143,15 @@ func (*ScreeningResult) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case screeningresult.FieldDimensionScores, screeningresult.FieldSkillDetail, screeningresult.FieldResponsibilityDetail, screeningresult.FieldExperienceDetail, screeningresult.FieldEducationDetail, screeningresult.FieldIndustryDetail, screeningresult.FieldBasicDetail, screeningresult.FieldRecommendations, screeningresult.FieldRuntimeMetadata, screeningresult.FieldSubAgentVersions:
+		case screeningresult.FieldCachedFromID, screeningresult.FieldOverriddenBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case screeningresult.FieldDimensionScores, screeningresult.FieldSkillDetail, screeningresult.FieldCustomDetails, screeningresult.FieldResponsibilityDetail, screeningresult.FieldExperienceDetail, screeningresult.FieldEducationDetail, screeningresult.FieldIndustryDetail, screeningresult.FieldBasicDetail, screeningresult.FieldRecommendations, screeningresult.FieldKnockoutReasons, screeningresult.FieldRuntimeMetadata, screeningresult.FieldSubAgentVersions, screeningresult.FieldHumanDimensionScores:
 			values[i] = new([]byte)
-		case screeningresult.FieldOverallScore:
+		case screeningresult.FieldOverallScore, screeningresult.FieldHumanOverallScore:
 			values[i] = new(sql.NullFloat64)
-		case screeningresult.FieldMatchLevel, screeningresult.FieldTraceID:
+		case screeningresult.FieldMatchLevel, screeningresult.FieldTraceID, screeningresult.FieldJobHash, screeningresult.FieldResumeHash, screeningresult.FieldAgentHash, screeningresult.FieldHumanMatchLevel, screeningresult.FieldOverrideReason:
 			values[i] = new(sql.NullString)
-		case screeningresult.FieldDeletedAt, screeningresult.FieldMatchedAt, screeningresult.FieldCreatedAt, screeningresult.FieldUpdatedAt:
+		case screeningresult.FieldDeletedAt, screeningresult.FieldOverriddenAt, screeningresult.FieldMatchedAt, screeningresult.FieldCreatedAt, screeningresult.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case screeningresult.FieldID, screeningresult.FieldTaskID, screeningresult.FieldJobPositionID, screeningresult.FieldResumeID:
 			values[i] = new(uuid.UUID)
@@ -202,6 +228,14 @@ func (sr *ScreeningResult) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field skill_detail: %w", err)
 				}
 			}
+		case screeningresult.FieldCustomDetails:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field custom_details", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sr.CustomDetails); err != nil {
+					return fmt.Errorf("unmarshal field custom_details: %w", err)
+				}
+			}
 		case screeningresult.FieldResponsibilityDetail:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field responsibility_detail", values[i])
@@ -250,6 +284,14 @@ func (sr *ScreeningResult) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field recommendations: %w", err)
 				}
 			}
+		case screeningresult.FieldKnockoutReasons:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field knockout_reasons", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sr.KnockoutReasons); err != nil {
+					return fmt.Errorf("unmarshal field knockout_reasons: %w", err)
+				}
+			}
 		case screeningresult.FieldTraceID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field trace_id", values[i])
@@ -272,6 +314,73 @@ func (sr *ScreeningResult) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field sub_agent_versions: %w", err)
 				}
 			}
+		case screeningresult.FieldJobHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_hash", values[i])
+			} else if value.Valid {
+				sr.JobHash = value.String
+			}
+		case screeningresult.FieldResumeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resume_hash", values[i])
+			} else if value.Valid {
+				sr.ResumeHash = value.String
+			}
+		case screeningresult.FieldAgentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_hash", values[i])
+			} else if value.Valid {
+				sr.AgentHash = value.String
+			}
+		case screeningresult.FieldCachedFromID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field cached_from_id", values[i])
+			} else if value.Valid {
+				sr.CachedFromID = new(uuid.UUID)
+				*sr.CachedFromID = *value.S.(*uuid.UUID)
+			}
+		case screeningresult.FieldHumanOverallScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field human_overall_score", values[i])
+			} else if value.Valid {
+				sr.HumanOverallScore = new(float64)
+				*sr.HumanOverallScore = value.Float64
+			}
+		case screeningresult.FieldHumanMatchLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field human_match_level", values[i])
+			} else if value.Valid {
+				sr.HumanMatchLevel = new(screeningresult.HumanMatchLevel)
+				*sr.HumanMatchLevel = screeningresult.HumanMatchLevel(value.String)
+			}
+		case screeningresult.FieldHumanDimensionScores:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field human_dimension_scores", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sr.HumanDimensionScores); err != nil {
+					return fmt.Errorf("unmarshal field human_dimension_scores: %w", err)
+				}
+			}
+		case screeningresult.FieldOverrideReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field override_reason", values[i])
+			} else if value.Valid {
+				sr.OverrideReason = value.String
+			}
+		case screeningresult.FieldOverriddenBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field overridden_by", values[i])
+			} else if value.Valid {
+				sr.OverriddenBy = new(uuid.UUID)
+				*sr.OverriddenBy = *value.S.(*uuid.UUID)
+			}
+		case screeningresult.FieldOverriddenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field overridden_at", values[i])
+			} else if value.Valid {
+				sr.OverriddenAt = new(time.Time)
+				*sr.OverriddenAt = value.Time
+			}
 		case screeningresult.FieldMatchedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field matched_at", values[i])
@@ -365,6 +474,9 @@ func (sr *ScreeningResult) String() string {
 	builder.WriteString("skill_detail=")
 	builder.WriteString(fmt.Sprintf("%v", sr.SkillDetail))
 	builder.WriteString(", ")
+	builder.WriteString("custom_details=")
+	builder.WriteString(fmt.Sprintf("%v", sr.CustomDetails))
+	builder.WriteString(", ")
 	builder.WriteString("responsibility_detail=")
 	builder.WriteString(fmt.Sprintf("%v", sr.ResponsibilityDetail))
 	builder.WriteString(", ")
@@ -383,6 +495,9 @@ func (sr *ScreeningResult) String() string {
 	builder.WriteString("recommendations=")
 	builder.WriteString(fmt.Sprintf("%v", sr.Recommendations))
 	builder.WriteString(", ")
+	builder.WriteString("knockout_reasons=")
+	builder.WriteString(fmt.Sprintf("%v", sr.KnockoutReasons))
+	builder.WriteString(", ")
 	builder.WriteString("trace_id=")
 	builder.WriteString(sr.TraceID)
 	builder.WriteString(", ")
@@ -392,6 +507,46 @@ func (sr *ScreeningResult) String() string {
 	builder.WriteString("sub_agent_versions=")
 	builder.WriteString(fmt.Sprintf("%v", sr.SubAgentVersions))
 	builder.WriteString(", ")
+	builder.WriteString("job_hash=")
+	builder.WriteString(sr.JobHash)
+	builder.WriteString(", ")
+	builder.WriteString("resume_hash=")
+	builder.WriteString(sr.ResumeHash)
+	builder.WriteString(", ")
+	builder.WriteString("agent_hash=")
+	builder.WriteString(sr.AgentHash)
+	builder.WriteString(", ")
+	if v := sr.CachedFromID; v != nil {
+		builder.WriteString("cached_from_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := sr.HumanOverallScore; v != nil {
+		builder.WriteString("human_overall_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := sr.HumanMatchLevel; v != nil {
+		builder.WriteString("human_match_level=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("human_dimension_scores=")
+	builder.WriteString(fmt.Sprintf("%v", sr.HumanDimensionScores))
+	builder.WriteString(", ")
+	builder.WriteString("override_reason=")
+	builder.WriteString(sr.OverrideReason)
+	builder.WriteString(", ")
+	if v := sr.OverriddenBy; v != nil {
+		builder.WriteString("overridden_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := sr.OverriddenAt; v != nil {
+		builder.WriteString("overridden_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("matched_at=")
 	builder.WriteString(sr.MatchedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -33,6 +33,8 @@ const (
 	FieldDimensionScores = "dimension_scores"
 	// FieldSkillDetail holds the string denoting the skill_detail field in the database.
 	FieldSkillDetail = "skill_detail"
+	// FieldCustomDetails holds the string denoting the custom_details field in the database.
+	FieldCustomDetails = "custom_details"
 	// FieldResponsibilityDetail holds the string denoting the responsibility_detail field in the database.
 	FieldResponsibilityDetail = "responsibility_detail"
 	// FieldExperienceDetail holds the string denoting the experience_detail field in the database.
@@ -45,12 +47,34 @@ const (
 	FieldBasicDetail = "basic_detail"
 	// FieldRecommendations holds the string denoting the recommendations field in the database.
 	FieldRecommendations = "recommendations"
+	// FieldKnockoutReasons holds the string denoting the knockout_reasons field in the database.
+	FieldKnockoutReasons = "knockout_reasons"
 	// FieldTraceID holds the string denoting the trace_id field in the database.
 	FieldTraceID = "trace_id"
 	// FieldRuntimeMetadata holds the string denoting the runtime_metadata field in the database.
 	FieldRuntimeMetadata = "runtime_metadata"
 	// FieldSubAgentVersions holds the string denoting the sub_agent_versions field in the database.
 	FieldSubAgentVersions = "sub_agent_versions"
+	// FieldJobHash holds the string denoting the job_hash field in the database.
+	FieldJobHash = "job_hash"
+	// FieldResumeHash holds the string denoting the resume_hash field in the database.
+	FieldResumeHash = "resume_hash"
+	// FieldAgentHash holds the string denoting the agent_hash field in the database.
+	FieldAgentHash = "agent_hash"
+	// FieldCachedFromID holds the string denoting the cached_from_id field in the database.
+	FieldCachedFromID = "cached_from_id"
+	// FieldHumanOverallScore holds the string denoting the human_overall_score field in the database.
+	FieldHumanOverallScore = "human_overall_score"
+	// FieldHumanMatchLevel holds the string denoting the human_match_level field in the database.
+	FieldHumanMatchLevel = "human_match_level"
+	// FieldHumanDimensionScores holds the string denoting the human_dimension_scores field in the database.
+	FieldHumanDimensionScores = "human_dimension_scores"
+	// FieldOverrideReason holds the string denoting the override_reason field in the database.
+	FieldOverrideReason = "override_reason"
+	// FieldOverriddenBy holds the string denoting the overridden_by field in the database.
+	FieldOverriddenBy = "overridden_by"
+	// FieldOverriddenAt holds the string denoting the overridden_at field in the database.
+	FieldOverriddenAt = "overridden_at"
 	// FieldMatchedAt holds the string denoting the matched_at field in the database.
 	FieldMatchedAt = "matched_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -99,15 +123,27 @@ var Columns = []string{
 	FieldMatchLevel,
 	FieldDimensionScores,
 	FieldSkillDetail,
+	FieldCustomDetails,
 	FieldResponsibilityDetail,
 	FieldExperienceDetail,
 	FieldEducationDetail,
 	FieldIndustryDetail,
 	FieldBasicDetail,
 	FieldRecommendations,
+	FieldKnockoutReasons,
 	FieldTraceID,
 	FieldRuntimeMetadata,
 	FieldSubAgentVersions,
+	FieldJobHash,
+	FieldResumeHash,
+	FieldAgentHash,
+	FieldCachedFromID,
+	FieldHumanOverallScore,
+	FieldHumanMatchLevel,
+	FieldHumanDimensionScores,
+	FieldOverrideReason,
+	FieldOverriddenBy,
+	FieldOverriddenAt,
 	FieldMatchedAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -133,6 +169,12 @@ var (
 	Interceptors [1]ent.Interceptor
 	// TraceIDValidator is a validator for the "trace_id" field. It is called by the builders before save.
 	TraceIDValidator func(string) error
+	// JobHashValidator is a validator for the "job_hash" field. It is called by the builders before save.
+	JobHashValidator func(string) error
+	// ResumeHashValidator is a validator for the "resume_hash" field. It is called by the builders before save.
+	ResumeHashValidator func(string) error
+	// AgentHashValidator is a validator for the "agent_hash" field. It is called by the builders before save.
+	AgentHashValidator func(string) error
 	// DefaultMatchedAt holds the default value on creation for the "matched_at" field.
 	DefaultMatchedAt func() time.Time
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -171,6 +213,32 @@ func MatchLevelValidator(ml MatchLevel) error {
 	}
 }
 
+// HumanMatchLevel defines the type for the "human_match_level" enum field.
+type HumanMatchLevel string
+
+// HumanMatchLevel values.
+const (
+	HumanMatchLevelExcellent HumanMatchLevel = "excellent"
+	HumanMatchLevelGood      HumanMatchLevel = "good"
+	HumanMatchLevelFair      HumanMatchLevel = "fair"
+	HumanMatchLevelPoor      HumanMatchLevel = "poor"
+	HumanMatchLevelNoMatch   HumanMatchLevel = "no_match"
+)
+
+func (hml HumanMatchLevel) String() string {
+	return string(hml)
+}
+
+// HumanMatchLevelValidator is a validator for the "human_match_level" field enum values. It is called by the builders before save.
+func HumanMatchLevelValidator(hml HumanMatchLevel) error {
+	switch hml {
+	case HumanMatchLevelExcellent, HumanMatchLevelGood, HumanMatchLevelFair, HumanMatchLevelPoor, HumanMatchLevelNoMatch:
+		return nil
+	default:
+		return fmt.Errorf("screeningresult: invalid enum value for human_match_level field: %q", hml)
+	}
+}
+
 // OrderOption defines the ordering options for the ScreeningResult queries.
 type OrderOption func(*sql.Selector)
 
@@ -214,6 +282,51 @@ func ByTraceID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTraceID, opts...).ToFunc()
 }
 
+// ByJobHash orders the results by the job_hash field.
+func ByJobHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobHash, opts...).ToFunc()
+}
+
+// ByResumeHash orders the results by the resume_hash field.
+func ByResumeHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResumeHash, opts...).ToFunc()
+}
+
+// ByAgentHash orders the results by the agent_hash field.
+func ByAgentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentHash, opts...).ToFunc()
+}
+
+// ByCachedFromID orders the results by the cached_from_id field.
+func ByCachedFromID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCachedFromID, opts...).ToFunc()
+}
+
+// ByHumanOverallScore orders the results by the human_overall_score field.
+func ByHumanOverallScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHumanOverallScore, opts...).ToFunc()
+}
+
+// ByHumanMatchLevel orders the results by the human_match_level field.
+func ByHumanMatchLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHumanMatchLevel, opts...).ToFunc()
+}
+
+// ByOverrideReason orders the results by the override_reason field.
+func ByOverrideReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverrideReason, opts...).ToFunc()
+}
+
+// ByOverriddenBy orders the results by the overridden_by field.
+func ByOverriddenBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverriddenBy, opts...).ToFunc()
+}
+
+// ByOverriddenAt orders the results by the overridden_at field.
+func ByOverriddenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverriddenAt, opts...).ToFunc()
+}
+
 // ByMatchedAt orders the results by the matched_at field.
 func ByMatchedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMatchedAt, opts...).ToFunc()
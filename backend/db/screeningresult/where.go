@@ -86,6 +86,46 @@ func TraceID(v string) predicate.ScreeningResult {
 	return predicate.ScreeningResult(sql.FieldEQ(FieldTraceID, v))
 }
 
+// JobHash applies equality check predicate on the "job_hash" field. It's identical to JobHashEQ.
+func JobHash(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEQ(FieldJobHash, v))
+}
+
+// ResumeHash applies equality check predicate on the "resume_hash" field. It's identical to ResumeHashEQ.
+func ResumeHash(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEQ(FieldResumeHash, v))
+}
+
+// AgentHash applies equality check predicate on the "agent_hash" field. It's identical to AgentHashEQ.
+func AgentHash(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEQ(FieldAgentHash, v))
+}
+
+// CachedFromID applies equality check predicate on the "cached_from_id" field. It's identical to CachedFromIDEQ.
+func CachedFromID(v uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEQ(FieldCachedFromID, v))
+}
+
+// HumanOverallScore applies equality check predicate on the "human_overall_score" field. It's identical to HumanOverallScoreEQ.
+func HumanOverallScore(v float64) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEQ(FieldHumanOverallScore, v))
+}
+
+// OverrideReason applies equality check predicate on the "override_reason" field. It's identical to OverrideReasonEQ.
+func OverrideReason(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEQ(FieldOverrideReason, v))
+}
+
+// OverriddenBy applies equality check predicate on the "overridden_by" field. It's identical to OverriddenByEQ.
+func OverriddenBy(v uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEQ(FieldOverriddenBy, v))
+}
+
+// OverriddenAt applies equality check predicate on the "overridden_at" field. It's identical to OverriddenAtEQ.
+func OverriddenAt(v time.Time) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEQ(FieldOverriddenAt, v))
+}
+
 // MatchedAt applies equality check predicate on the "matched_at" field. It's identical to MatchedAtEQ.
 func MatchedAt(v time.Time) predicate.ScreeningResult {
 	return predicate.ScreeningResult(sql.FieldEQ(FieldMatchedAt, v))
@@ -301,6 +341,16 @@ func SkillDetailNotNil() predicate.ScreeningResult {
 	return predicate.ScreeningResult(sql.FieldNotNull(FieldSkillDetail))
 }
 
+// CustomDetailsIsNil applies the IsNil predicate on the "custom_details" field.
+func CustomDetailsIsNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIsNull(FieldCustomDetails))
+}
+
+// CustomDetailsNotNil applies the NotNil predicate on the "custom_details" field.
+func CustomDetailsNotNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotNull(FieldCustomDetails))
+}
+
 // ResponsibilityDetailIsNil applies the IsNil predicate on the "responsibility_detail" field.
 func ResponsibilityDetailIsNil() predicate.ScreeningResult {
 	return predicate.ScreeningResult(sql.FieldIsNull(FieldResponsibilityDetail))
@@ -361,6 +411,16 @@ func RecommendationsNotNil() predicate.ScreeningResult {
 	return predicate.ScreeningResult(sql.FieldNotNull(FieldRecommendations))
 }
 
+// KnockoutReasonsIsNil applies the IsNil predicate on the "knockout_reasons" field.
+func KnockoutReasonsIsNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIsNull(FieldKnockoutReasons))
+}
+
+// KnockoutReasonsNotNil applies the NotNil predicate on the "knockout_reasons" field.
+func KnockoutReasonsNotNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotNull(FieldKnockoutReasons))
+}
+
 // TraceIDEQ applies the EQ predicate on the "trace_id" field.
 func TraceIDEQ(v string) predicate.ScreeningResult {
 	return predicate.ScreeningResult(sql.FieldEQ(FieldTraceID, v))
@@ -456,6 +516,546 @@ func SubAgentVersionsNotNil() predicate.ScreeningResult {
 	return predicate.ScreeningResult(sql.FieldNotNull(FieldSubAgentVersions))
 }
 
+// JobHashEQ applies the EQ predicate on the "job_hash" field.
+func JobHashEQ(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEQ(FieldJobHash, v))
+}
+
+// JobHashNEQ applies the NEQ predicate on the "job_hash" field.
+func JobHashNEQ(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNEQ(FieldJobHash, v))
+}
+
+// JobHashIn applies the In predicate on the "job_hash" field.
+func JobHashIn(vs ...string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIn(FieldJobHash, vs...))
+}
+
+// JobHashNotIn applies the NotIn predicate on the "job_hash" field.
+func JobHashNotIn(vs ...string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotIn(FieldJobHash, vs...))
+}
+
+// JobHashGT applies the GT predicate on the "job_hash" field.
+func JobHashGT(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldGT(FieldJobHash, v))
+}
+
+// JobHashGTE applies the GTE predicate on the "job_hash" field.
+func JobHashGTE(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldGTE(FieldJobHash, v))
+}
+
+// JobHashLT applies the LT predicate on the "job_hash" field.
+func JobHashLT(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldLT(FieldJobHash, v))
+}
+
+// JobHashLTE applies the LTE predicate on the "job_hash" field.
+func JobHashLTE(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldLTE(FieldJobHash, v))
+}
+
+// JobHashContains applies the Contains predicate on the "job_hash" field.
+func JobHashContains(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldContains(FieldJobHash, v))
+}
+
+// JobHashHasPrefix applies the HasPrefix predicate on the "job_hash" field.
+func JobHashHasPrefix(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldHasPrefix(FieldJobHash, v))
+}
+
+// JobHashHasSuffix applies the HasSuffix predicate on the "job_hash" field.
+func JobHashHasSuffix(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldHasSuffix(FieldJobHash, v))
+}
+
+// JobHashIsNil applies the IsNil predicate on the "job_hash" field.
+func JobHashIsNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIsNull(FieldJobHash))
+}
+
+// JobHashNotNil applies the NotNil predicate on the "job_hash" field.
+func JobHashNotNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotNull(FieldJobHash))
+}
+
+// JobHashEqualFold applies the EqualFold predicate on the "job_hash" field.
+func JobHashEqualFold(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEqualFold(FieldJobHash, v))
+}
+
+// JobHashContainsFold applies the ContainsFold predicate on the "job_hash" field.
+func JobHashContainsFold(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldContainsFold(FieldJobHash, v))
+}
+
+// ResumeHashEQ applies the EQ predicate on the "resume_hash" field.
+func ResumeHashEQ(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEQ(FieldResumeHash, v))
+}
+
+// ResumeHashNEQ applies the NEQ predicate on the "resume_hash" field.
+func ResumeHashNEQ(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNEQ(FieldResumeHash, v))
+}
+
+// ResumeHashIn applies the In predicate on the "resume_hash" field.
+func ResumeHashIn(vs ...string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIn(FieldResumeHash, vs...))
+}
+
+// ResumeHashNotIn applies the NotIn predicate on the "resume_hash" field.
+func ResumeHashNotIn(vs ...string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotIn(FieldResumeHash, vs...))
+}
+
+// ResumeHashGT applies the GT predicate on the "resume_hash" field.
+func ResumeHashGT(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldGT(FieldResumeHash, v))
+}
+
+// ResumeHashGTE applies the GTE predicate on the "resume_hash" field.
+func ResumeHashGTE(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldGTE(FieldResumeHash, v))
+}
+
+// ResumeHashLT applies the LT predicate on the "resume_hash" field.
+func ResumeHashLT(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldLT(FieldResumeHash, v))
+}
+
+// ResumeHashLTE applies the LTE predicate on the "resume_hash" field.
+func ResumeHashLTE(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldLTE(FieldResumeHash, v))
+}
+
+// ResumeHashContains applies the Contains predicate on the "resume_hash" field.
+func ResumeHashContains(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldContains(FieldResumeHash, v))
+}
+
+// ResumeHashHasPrefix applies the HasPrefix predicate on the "resume_hash" field.
+func ResumeHashHasPrefix(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldHasPrefix(FieldResumeHash, v))
+}
+
+// ResumeHashHasSuffix applies the HasSuffix predicate on the "resume_hash" field.
+func ResumeHashHasSuffix(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldHasSuffix(FieldResumeHash, v))
+}
+
+// ResumeHashIsNil applies the IsNil predicate on the "resume_hash" field.
+func ResumeHashIsNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIsNull(FieldResumeHash))
+}
+
+// ResumeHashNotNil applies the NotNil predicate on the "resume_hash" field.
+func ResumeHashNotNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotNull(FieldResumeHash))
+}
+
+// ResumeHashEqualFold applies the EqualFold predicate on the "resume_hash" field.
+func ResumeHashEqualFold(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEqualFold(FieldResumeHash, v))
+}
+
+// ResumeHashContainsFold applies the ContainsFold predicate on the "resume_hash" field.
+func ResumeHashContainsFold(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldContainsFold(FieldResumeHash, v))
+}
+
+// AgentHashEQ applies the EQ predicate on the "agent_hash" field.
+func AgentHashEQ(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEQ(FieldAgentHash, v))
+}
+
+// AgentHashNEQ applies the NEQ predicate on the "agent_hash" field.
+func AgentHashNEQ(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNEQ(FieldAgentHash, v))
+}
+
+// AgentHashIn applies the In predicate on the "agent_hash" field.
+func AgentHashIn(vs ...string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIn(FieldAgentHash, vs...))
+}
+
+// AgentHashNotIn applies the NotIn predicate on the "agent_hash" field.
+func AgentHashNotIn(vs ...string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotIn(FieldAgentHash, vs...))
+}
+
+// AgentHashGT applies the GT predicate on the "agent_hash" field.
+func AgentHashGT(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldGT(FieldAgentHash, v))
+}
+
+// AgentHashGTE applies the GTE predicate on the "agent_hash" field.
+func AgentHashGTE(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldGTE(FieldAgentHash, v))
+}
+
+// AgentHashLT applies the LT predicate on the "agent_hash" field.
+func AgentHashLT(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldLT(FieldAgentHash, v))
+}
+
+// AgentHashLTE applies the LTE predicate on the "agent_hash" field.
+func AgentHashLTE(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldLTE(FieldAgentHash, v))
+}
+
+// AgentHashContains applies the Contains predicate on the "agent_hash" field.
+func AgentHashContains(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldContains(FieldAgentHash, v))
+}
+
+// AgentHashHasPrefix applies the HasPrefix predicate on the "agent_hash" field.
+func AgentHashHasPrefix(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldHasPrefix(FieldAgentHash, v))
+}
+
+// AgentHashHasSuffix applies the HasSuffix predicate on the "agent_hash" field.
+func AgentHashHasSuffix(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldHasSuffix(FieldAgentHash, v))
+}
+
+// AgentHashIsNil applies the IsNil predicate on the "agent_hash" field.
+func AgentHashIsNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIsNull(FieldAgentHash))
+}
+
+// AgentHashNotNil applies the NotNil predicate on the "agent_hash" field.
+func AgentHashNotNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotNull(FieldAgentHash))
+}
+
+// AgentHashEqualFold applies the EqualFold predicate on the "agent_hash" field.
+func AgentHashEqualFold(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEqualFold(FieldAgentHash, v))
+}
+
+// AgentHashContainsFold applies the ContainsFold predicate on the "agent_hash" field.
+func AgentHashContainsFold(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldContainsFold(FieldAgentHash, v))
+}
+
+// CachedFromIDEQ applies the EQ predicate on the "cached_from_id" field.
+func CachedFromIDEQ(v uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEQ(FieldCachedFromID, v))
+}
+
+// CachedFromIDNEQ applies the NEQ predicate on the "cached_from_id" field.
+func CachedFromIDNEQ(v uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNEQ(FieldCachedFromID, v))
+}
+
+// CachedFromIDIn applies the In predicate on the "cached_from_id" field.
+func CachedFromIDIn(vs ...uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIn(FieldCachedFromID, vs...))
+}
+
+// CachedFromIDNotIn applies the NotIn predicate on the "cached_from_id" field.
+func CachedFromIDNotIn(vs ...uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotIn(FieldCachedFromID, vs...))
+}
+
+// CachedFromIDGT applies the GT predicate on the "cached_from_id" field.
+func CachedFromIDGT(v uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldGT(FieldCachedFromID, v))
+}
+
+// CachedFromIDGTE applies the GTE predicate on the "cached_from_id" field.
+func CachedFromIDGTE(v uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldGTE(FieldCachedFromID, v))
+}
+
+// CachedFromIDLT applies the LT predicate on the "cached_from_id" field.
+func CachedFromIDLT(v uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldLT(FieldCachedFromID, v))
+}
+
+// CachedFromIDLTE applies the LTE predicate on the "cached_from_id" field.
+func CachedFromIDLTE(v uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldLTE(FieldCachedFromID, v))
+}
+
+// CachedFromIDIsNil applies the IsNil predicate on the "cached_from_id" field.
+func CachedFromIDIsNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIsNull(FieldCachedFromID))
+}
+
+// CachedFromIDNotNil applies the NotNil predicate on the "cached_from_id" field.
+func CachedFromIDNotNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotNull(FieldCachedFromID))
+}
+
+// HumanOverallScoreEQ applies the EQ predicate on the "human_overall_score" field.
+func HumanOverallScoreEQ(v float64) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEQ(FieldHumanOverallScore, v))
+}
+
+// HumanOverallScoreNEQ applies the NEQ predicate on the "human_overall_score" field.
+func HumanOverallScoreNEQ(v float64) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNEQ(FieldHumanOverallScore, v))
+}
+
+// HumanOverallScoreIn applies the In predicate on the "human_overall_score" field.
+func HumanOverallScoreIn(vs ...float64) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIn(FieldHumanOverallScore, vs...))
+}
+
+// HumanOverallScoreNotIn applies the NotIn predicate on the "human_overall_score" field.
+func HumanOverallScoreNotIn(vs ...float64) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotIn(FieldHumanOverallScore, vs...))
+}
+
+// HumanOverallScoreGT applies the GT predicate on the "human_overall_score" field.
+func HumanOverallScoreGT(v float64) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldGT(FieldHumanOverallScore, v))
+}
+
+// HumanOverallScoreGTE applies the GTE predicate on the "human_overall_score" field.
+func HumanOverallScoreGTE(v float64) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldGTE(FieldHumanOverallScore, v))
+}
+
+// HumanOverallScoreLT applies the LT predicate on the "human_overall_score" field.
+func HumanOverallScoreLT(v float64) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldLT(FieldHumanOverallScore, v))
+}
+
+// HumanOverallScoreLTE applies the LTE predicate on the "human_overall_score" field.
+func HumanOverallScoreLTE(v float64) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldLTE(FieldHumanOverallScore, v))
+}
+
+// HumanOverallScoreIsNil applies the IsNil predicate on the "human_overall_score" field.
+func HumanOverallScoreIsNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIsNull(FieldHumanOverallScore))
+}
+
+// HumanOverallScoreNotNil applies the NotNil predicate on the "human_overall_score" field.
+func HumanOverallScoreNotNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotNull(FieldHumanOverallScore))
+}
+
+// HumanMatchLevelEQ applies the EQ predicate on the "human_match_level" field.
+func HumanMatchLevelEQ(v HumanMatchLevel) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEQ(FieldHumanMatchLevel, v))
+}
+
+// HumanMatchLevelNEQ applies the NEQ predicate on the "human_match_level" field.
+func HumanMatchLevelNEQ(v HumanMatchLevel) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNEQ(FieldHumanMatchLevel, v))
+}
+
+// HumanMatchLevelIn applies the In predicate on the "human_match_level" field.
+func HumanMatchLevelIn(vs ...HumanMatchLevel) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIn(FieldHumanMatchLevel, vs...))
+}
+
+// HumanMatchLevelNotIn applies the NotIn predicate on the "human_match_level" field.
+func HumanMatchLevelNotIn(vs ...HumanMatchLevel) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotIn(FieldHumanMatchLevel, vs...))
+}
+
+// HumanMatchLevelIsNil applies the IsNil predicate on the "human_match_level" field.
+func HumanMatchLevelIsNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIsNull(FieldHumanMatchLevel))
+}
+
+// HumanMatchLevelNotNil applies the NotNil predicate on the "human_match_level" field.
+func HumanMatchLevelNotNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotNull(FieldHumanMatchLevel))
+}
+
+// HumanDimensionScoresIsNil applies the IsNil predicate on the "human_dimension_scores" field.
+func HumanDimensionScoresIsNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIsNull(FieldHumanDimensionScores))
+}
+
+// HumanDimensionScoresNotNil applies the NotNil predicate on the "human_dimension_scores" field.
+func HumanDimensionScoresNotNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotNull(FieldHumanDimensionScores))
+}
+
+// OverrideReasonEQ applies the EQ predicate on the "override_reason" field.
+func OverrideReasonEQ(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEQ(FieldOverrideReason, v))
+}
+
+// OverrideReasonNEQ applies the NEQ predicate on the "override_reason" field.
+func OverrideReasonNEQ(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNEQ(FieldOverrideReason, v))
+}
+
+// OverrideReasonIn applies the In predicate on the "override_reason" field.
+func OverrideReasonIn(vs ...string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIn(FieldOverrideReason, vs...))
+}
+
+// OverrideReasonNotIn applies the NotIn predicate on the "override_reason" field.
+func OverrideReasonNotIn(vs ...string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotIn(FieldOverrideReason, vs...))
+}
+
+// OverrideReasonGT applies the GT predicate on the "override_reason" field.
+func OverrideReasonGT(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldGT(FieldOverrideReason, v))
+}
+
+// OverrideReasonGTE applies the GTE predicate on the "override_reason" field.
+func OverrideReasonGTE(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldGTE(FieldOverrideReason, v))
+}
+
+// OverrideReasonLT applies the LT predicate on the "override_reason" field.
+func OverrideReasonLT(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldLT(FieldOverrideReason, v))
+}
+
+// OverrideReasonLTE applies the LTE predicate on the "override_reason" field.
+func OverrideReasonLTE(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldLTE(FieldOverrideReason, v))
+}
+
+// OverrideReasonContains applies the Contains predicate on the "override_reason" field.
+func OverrideReasonContains(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldContains(FieldOverrideReason, v))
+}
+
+// OverrideReasonHasPrefix applies the HasPrefix predicate on the "override_reason" field.
+func OverrideReasonHasPrefix(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldHasPrefix(FieldOverrideReason, v))
+}
+
+// OverrideReasonHasSuffix applies the HasSuffix predicate on the "override_reason" field.
+func OverrideReasonHasSuffix(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldHasSuffix(FieldOverrideReason, v))
+}
+
+// OverrideReasonIsNil applies the IsNil predicate on the "override_reason" field.
+func OverrideReasonIsNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIsNull(FieldOverrideReason))
+}
+
+// OverrideReasonNotNil applies the NotNil predicate on the "override_reason" field.
+func OverrideReasonNotNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotNull(FieldOverrideReason))
+}
+
+// OverrideReasonEqualFold applies the EqualFold predicate on the "override_reason" field.
+func OverrideReasonEqualFold(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEqualFold(FieldOverrideReason, v))
+}
+
+// OverrideReasonContainsFold applies the ContainsFold predicate on the "override_reason" field.
+func OverrideReasonContainsFold(v string) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldContainsFold(FieldOverrideReason, v))
+}
+
+// OverriddenByEQ applies the EQ predicate on the "overridden_by" field.
+func OverriddenByEQ(v uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEQ(FieldOverriddenBy, v))
+}
+
+// OverriddenByNEQ applies the NEQ predicate on the "overridden_by" field.
+func OverriddenByNEQ(v uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNEQ(FieldOverriddenBy, v))
+}
+
+// OverriddenByIn applies the In predicate on the "overridden_by" field.
+func OverriddenByIn(vs ...uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIn(FieldOverriddenBy, vs...))
+}
+
+// OverriddenByNotIn applies the NotIn predicate on the "overridden_by" field.
+func OverriddenByNotIn(vs ...uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotIn(FieldOverriddenBy, vs...))
+}
+
+// OverriddenByGT applies the GT predicate on the "overridden_by" field.
+func OverriddenByGT(v uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldGT(FieldOverriddenBy, v))
+}
+
+// OverriddenByGTE applies the GTE predicate on the "overridden_by" field.
+func OverriddenByGTE(v uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldGTE(FieldOverriddenBy, v))
+}
+
+// OverriddenByLT applies the LT predicate on the "overridden_by" field.
+func OverriddenByLT(v uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldLT(FieldOverriddenBy, v))
+}
+
+// OverriddenByLTE applies the LTE predicate on the "overridden_by" field.
+func OverriddenByLTE(v uuid.UUID) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldLTE(FieldOverriddenBy, v))
+}
+
+// OverriddenByIsNil applies the IsNil predicate on the "overridden_by" field.
+func OverriddenByIsNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIsNull(FieldOverriddenBy))
+}
+
+// OverriddenByNotNil applies the NotNil predicate on the "overridden_by" field.
+func OverriddenByNotNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotNull(FieldOverriddenBy))
+}
+
+// OverriddenAtEQ applies the EQ predicate on the "overridden_at" field.
+func OverriddenAtEQ(v time.Time) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldEQ(FieldOverriddenAt, v))
+}
+
+// OverriddenAtNEQ applies the NEQ predicate on the "overridden_at" field.
+func OverriddenAtNEQ(v time.Time) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNEQ(FieldOverriddenAt, v))
+}
+
+// OverriddenAtIn applies the In predicate on the "overridden_at" field.
+func OverriddenAtIn(vs ...time.Time) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIn(FieldOverriddenAt, vs...))
+}
+
+// OverriddenAtNotIn applies the NotIn predicate on the "overridden_at" field.
+func OverriddenAtNotIn(vs ...time.Time) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotIn(FieldOverriddenAt, vs...))
+}
+
+// OverriddenAtGT applies the GT predicate on the "overridden_at" field.
+func OverriddenAtGT(v time.Time) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldGT(FieldOverriddenAt, v))
+}
+
+// OverriddenAtGTE applies the GTE predicate on the "overridden_at" field.
+func OverriddenAtGTE(v time.Time) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldGTE(FieldOverriddenAt, v))
+}
+
+// OverriddenAtLT applies the LT predicate on the "overridden_at" field.
+func OverriddenAtLT(v time.Time) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldLT(FieldOverriddenAt, v))
+}
+
+// OverriddenAtLTE applies the LTE predicate on the "overridden_at" field.
+func OverriddenAtLTE(v time.Time) predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldLTE(FieldOverriddenAt, v))
+}
+
+// OverriddenAtIsNil applies the IsNil predicate on the "overridden_at" field.
+func OverriddenAtIsNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldIsNull(FieldOverriddenAt))
+}
+
+// OverriddenAtNotNil applies the NotNil predicate on the "overridden_at" field.
+func OverriddenAtNotNil() predicate.ScreeningResult {
+	return predicate.ScreeningResult(sql.FieldNotNull(FieldOverriddenAt))
+}
+
 // MatchedAtEQ applies the EQ predicate on the "matched_at" field.
 func MatchedAtEQ(v time.Time) predicate.ScreeningResult {
 	return predicate.ScreeningResult(sql.FieldEQ(FieldMatchedAt, v))
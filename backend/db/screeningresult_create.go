@@ -91,6 +91,12 @@ func (src *ScreeningResultCreate) SetSkillDetail(m map[string]interface{}) *Scre
 	return src
 }
 
+// SetCustomDetails sets the "custom_details" field.
+func (src *ScreeningResultCreate) SetCustomDetails(m map[string]interface{}) *ScreeningResultCreate {
+	src.mutation.SetCustomDetails(m)
+	return src
+}
+
 // SetResponsibilityDetail sets the "responsibility_detail" field.
 func (src *ScreeningResultCreate) SetResponsibilityDetail(m map[string]interface{}) *ScreeningResultCreate {
 	src.mutation.SetResponsibilityDetail(m)
@@ -127,6 +133,12 @@ func (src *ScreeningResultCreate) SetRecommendations(s []string) *ScreeningResul
 	return src
 }
 
+// SetKnockoutReasons sets the "knockout_reasons" field.
+func (src *ScreeningResultCreate) SetKnockoutReasons(s []string) *ScreeningResultCreate {
+	src.mutation.SetKnockoutReasons(s)
+	return src
+}
+
 // SetTraceID sets the "trace_id" field.
 func (src *ScreeningResultCreate) SetTraceID(s string) *ScreeningResultCreate {
 	src.mutation.SetTraceID(s)
@@ -153,6 +165,138 @@ func (src *ScreeningResultCreate) SetSubAgentVersions(m map[string]interface{})
 	return src
 }
 
+// SetJobHash sets the "job_hash" field.
+func (src *ScreeningResultCreate) SetJobHash(s string) *ScreeningResultCreate {
+	src.mutation.SetJobHash(s)
+	return src
+}
+
+// SetNillableJobHash sets the "job_hash" field if the given value is not nil.
+func (src *ScreeningResultCreate) SetNillableJobHash(s *string) *ScreeningResultCreate {
+	if s != nil {
+		src.SetJobHash(*s)
+	}
+	return src
+}
+
+// SetResumeHash sets the "resume_hash" field.
+func (src *ScreeningResultCreate) SetResumeHash(s string) *ScreeningResultCreate {
+	src.mutation.SetResumeHash(s)
+	return src
+}
+
+// SetNillableResumeHash sets the "resume_hash" field if the given value is not nil.
+func (src *ScreeningResultCreate) SetNillableResumeHash(s *string) *ScreeningResultCreate {
+	if s != nil {
+		src.SetResumeHash(*s)
+	}
+	return src
+}
+
+// SetAgentHash sets the "agent_hash" field.
+func (src *ScreeningResultCreate) SetAgentHash(s string) *ScreeningResultCreate {
+	src.mutation.SetAgentHash(s)
+	return src
+}
+
+// SetNillableAgentHash sets the "agent_hash" field if the given value is not nil.
+func (src *ScreeningResultCreate) SetNillableAgentHash(s *string) *ScreeningResultCreate {
+	if s != nil {
+		src.SetAgentHash(*s)
+	}
+	return src
+}
+
+// SetCachedFromID sets the "cached_from_id" field.
+func (src *ScreeningResultCreate) SetCachedFromID(u uuid.UUID) *ScreeningResultCreate {
+	src.mutation.SetCachedFromID(u)
+	return src
+}
+
+// SetNillableCachedFromID sets the "cached_from_id" field if the given value is not nil.
+func (src *ScreeningResultCreate) SetNillableCachedFromID(u *uuid.UUID) *ScreeningResultCreate {
+	if u != nil {
+		src.SetCachedFromID(*u)
+	}
+	return src
+}
+
+// SetHumanOverallScore sets the "human_overall_score" field.
+func (src *ScreeningResultCreate) SetHumanOverallScore(f float64) *ScreeningResultCreate {
+	src.mutation.SetHumanOverallScore(f)
+	return src
+}
+
+// SetNillableHumanOverallScore sets the "human_overall_score" field if the given value is not nil.
+func (src *ScreeningResultCreate) SetNillableHumanOverallScore(f *float64) *ScreeningResultCreate {
+	if f != nil {
+		src.SetHumanOverallScore(*f)
+	}
+	return src
+}
+
+// SetHumanMatchLevel sets the "human_match_level" field.
+func (src *ScreeningResultCreate) SetHumanMatchLevel(sml screeningresult.HumanMatchLevel) *ScreeningResultCreate {
+	src.mutation.SetHumanMatchLevel(sml)
+	return src
+}
+
+// SetNillableHumanMatchLevel sets the "human_match_level" field if the given value is not nil.
+func (src *ScreeningResultCreate) SetNillableHumanMatchLevel(sml *screeningresult.HumanMatchLevel) *ScreeningResultCreate {
+	if sml != nil {
+		src.SetHumanMatchLevel(*sml)
+	}
+	return src
+}
+
+// SetHumanDimensionScores sets the "human_dimension_scores" field.
+func (src *ScreeningResultCreate) SetHumanDimensionScores(m map[string]interface{}) *ScreeningResultCreate {
+	src.mutation.SetHumanDimensionScores(m)
+	return src
+}
+
+// SetOverrideReason sets the "override_reason" field.
+func (src *ScreeningResultCreate) SetOverrideReason(s string) *ScreeningResultCreate {
+	src.mutation.SetOverrideReason(s)
+	return src
+}
+
+// SetNillableOverrideReason sets the "override_reason" field if the given value is not nil.
+func (src *ScreeningResultCreate) SetNillableOverrideReason(s *string) *ScreeningResultCreate {
+	if s != nil {
+		src.SetOverrideReason(*s)
+	}
+	return src
+}
+
+// SetOverriddenBy sets the "overridden_by" field.
+func (src *ScreeningResultCreate) SetOverriddenBy(u uuid.UUID) *ScreeningResultCreate {
+	src.mutation.SetOverriddenBy(u)
+	return src
+}
+
+// SetNillableOverriddenBy sets the "overridden_by" field if the given value is not nil.
+func (src *ScreeningResultCreate) SetNillableOverriddenBy(u *uuid.UUID) *ScreeningResultCreate {
+	if u != nil {
+		src.SetOverriddenBy(*u)
+	}
+	return src
+}
+
+// SetOverriddenAt sets the "overridden_at" field.
+func (src *ScreeningResultCreate) SetOverriddenAt(t time.Time) *ScreeningResultCreate {
+	src.mutation.SetOverriddenAt(t)
+	return src
+}
+
+// SetNillableOverriddenAt sets the "overridden_at" field if the given value is not nil.
+func (src *ScreeningResultCreate) SetNillableOverriddenAt(t *time.Time) *ScreeningResultCreate {
+	if t != nil {
+		src.SetOverriddenAt(*t)
+	}
+	return src
+}
+
 // SetMatchedAt sets the "matched_at" field.
 func (src *ScreeningResultCreate) SetMatchedAt(t time.Time) *ScreeningResultCreate {
 	src.mutation.SetMatchedAt(t)
@@ -316,6 +460,26 @@ func (src *ScreeningResultCreate) check() error {
 			return &ValidationError{Name: "trace_id", err: fmt.Errorf(`db: validator failed for field "ScreeningResult.trace_id": %w`, err)}
 		}
 	}
+	if v, ok := src.mutation.JobHash(); ok {
+		if err := screeningresult.JobHashValidator(v); err != nil {
+			return &ValidationError{Name: "job_hash", err: fmt.Errorf(`db: validator failed for field "ScreeningResult.job_hash": %w`, err)}
+		}
+	}
+	if v, ok := src.mutation.ResumeHash(); ok {
+		if err := screeningresult.ResumeHashValidator(v); err != nil {
+			return &ValidationError{Name: "resume_hash", err: fmt.Errorf(`db: validator failed for field "ScreeningResult.resume_hash": %w`, err)}
+		}
+	}
+	if v, ok := src.mutation.AgentHash(); ok {
+		if err := screeningresult.AgentHashValidator(v); err != nil {
+			return &ValidationError{Name: "agent_hash", err: fmt.Errorf(`db: validator failed for field "ScreeningResult.agent_hash": %w`, err)}
+		}
+	}
+	if v, ok := src.mutation.HumanMatchLevel(); ok {
+		if err := screeningresult.HumanMatchLevelValidator(v); err != nil {
+			return &ValidationError{Name: "human_match_level", err: fmt.Errorf(`db: validator failed for field "ScreeningResult.human_match_level": %w`, err)}
+		}
+	}
 	if _, ok := src.mutation.MatchedAt(); !ok {
 		return &ValidationError{Name: "matched_at", err: errors.New(`db: missing required field "ScreeningResult.matched_at"`)}
 	}
@@ -390,6 +554,10 @@ func (src *ScreeningResultCreate) createSpec() (*ScreeningResult, *sqlgraph.Crea
 		_spec.SetField(screeningresult.FieldSkillDetail, field.TypeJSON, value)
 		_node.SkillDetail = value
 	}
+	if value, ok := src.mutation.CustomDetails(); ok {
+		_spec.SetField(screeningresult.FieldCustomDetails, field.TypeJSON, value)
+		_node.CustomDetails = value
+	}
 	if value, ok := src.mutation.ResponsibilityDetail(); ok {
 		_spec.SetField(screeningresult.FieldResponsibilityDetail, field.TypeJSON, value)
 		_node.ResponsibilityDetail = value
@@ -414,6 +582,10 @@ func (src *ScreeningResultCreate) createSpec() (*ScreeningResult, *sqlgraph.Crea
 		_spec.SetField(screeningresult.FieldRecommendations, field.TypeJSON, value)
 		_node.Recommendations = value
 	}
+	if value, ok := src.mutation.KnockoutReasons(); ok {
+		_spec.SetField(screeningresult.FieldKnockoutReasons, field.TypeJSON, value)
+		_node.KnockoutReasons = value
+	}
 	if value, ok := src.mutation.TraceID(); ok {
 		_spec.SetField(screeningresult.FieldTraceID, field.TypeString, value)
 		_node.TraceID = value
@@ -426,6 +598,46 @@ func (src *ScreeningResultCreate) createSpec() (*ScreeningResult, *sqlgraph.Crea
 		_spec.SetField(screeningresult.FieldSubAgentVersions, field.TypeJSON, value)
 		_node.SubAgentVersions = value
 	}
+	if value, ok := src.mutation.JobHash(); ok {
+		_spec.SetField(screeningresult.FieldJobHash, field.TypeString, value)
+		_node.JobHash = value
+	}
+	if value, ok := src.mutation.ResumeHash(); ok {
+		_spec.SetField(screeningresult.FieldResumeHash, field.TypeString, value)
+		_node.ResumeHash = value
+	}
+	if value, ok := src.mutation.AgentHash(); ok {
+		_spec.SetField(screeningresult.FieldAgentHash, field.TypeString, value)
+		_node.AgentHash = value
+	}
+	if value, ok := src.mutation.CachedFromID(); ok {
+		_spec.SetField(screeningresult.FieldCachedFromID, field.TypeUUID, value)
+		_node.CachedFromID = &value
+	}
+	if value, ok := src.mutation.HumanOverallScore(); ok {
+		_spec.SetField(screeningresult.FieldHumanOverallScore, field.TypeFloat64, value)
+		_node.HumanOverallScore = &value
+	}
+	if value, ok := src.mutation.HumanMatchLevel(); ok {
+		_spec.SetField(screeningresult.FieldHumanMatchLevel, field.TypeEnum, value)
+		_node.HumanMatchLevel = &value
+	}
+	if value, ok := src.mutation.HumanDimensionScores(); ok {
+		_spec.SetField(screeningresult.FieldHumanDimensionScores, field.TypeJSON, value)
+		_node.HumanDimensionScores = value
+	}
+	if value, ok := src.mutation.OverrideReason(); ok {
+		_spec.SetField(screeningresult.FieldOverrideReason, field.TypeString, value)
+		_node.OverrideReason = value
+	}
+	if value, ok := src.mutation.OverriddenBy(); ok {
+		_spec.SetField(screeningresult.FieldOverriddenBy, field.TypeUUID, value)
+		_node.OverriddenBy = &value
+	}
+	if value, ok := src.mutation.OverriddenAt(); ok {
+		_spec.SetField(screeningresult.FieldOverriddenAt, field.TypeTime, value)
+		_node.OverriddenAt = &value
+	}
 	if value, ok := src.mutation.MatchedAt(); ok {
 		_spec.SetField(screeningresult.FieldMatchedAt, field.TypeTime, value)
 		_node.MatchedAt = value
@@ -667,6 +879,24 @@ func (u *ScreeningResultUpsert) ClearSkillDetail() *ScreeningResultUpsert {
 	return u
 }
 
+// SetCustomDetails sets the "custom_details" field.
+func (u *ScreeningResultUpsert) SetCustomDetails(v map[string]interface{}) *ScreeningResultUpsert {
+	u.Set(screeningresult.FieldCustomDetails, v)
+	return u
+}
+
+// UpdateCustomDetails sets the "custom_details" field to the value that was provided on create.
+func (u *ScreeningResultUpsert) UpdateCustomDetails() *ScreeningResultUpsert {
+	u.SetExcluded(screeningresult.FieldCustomDetails)
+	return u
+}
+
+// ClearCustomDetails clears the value of the "custom_details" field.
+func (u *ScreeningResultUpsert) ClearCustomDetails() *ScreeningResultUpsert {
+	u.SetNull(screeningresult.FieldCustomDetails)
+	return u
+}
+
 // SetResponsibilityDetail sets the "responsibility_detail" field.
 func (u *ScreeningResultUpsert) SetResponsibilityDetail(v map[string]interface{}) *ScreeningResultUpsert {
 	u.Set(screeningresult.FieldResponsibilityDetail, v)
@@ -775,6 +1005,24 @@ func (u *ScreeningResultUpsert) ClearRecommendations() *ScreeningResultUpsert {
 	return u
 }
 
+// SetKnockoutReasons sets the "knockout_reasons" field.
+func (u *ScreeningResultUpsert) SetKnockoutReasons(v []string) *ScreeningResultUpsert {
+	u.Set(screeningresult.FieldKnockoutReasons, v)
+	return u
+}
+
+// UpdateKnockoutReasons sets the "knockout_reasons" field to the value that was provided on create.
+func (u *ScreeningResultUpsert) UpdateKnockoutReasons() *ScreeningResultUpsert {
+	u.SetExcluded(screeningresult.FieldKnockoutReasons)
+	return u
+}
+
+// ClearKnockoutReasons clears the value of the "knockout_reasons" field.
+func (u *ScreeningResultUpsert) ClearKnockoutReasons() *ScreeningResultUpsert {
+	u.SetNull(screeningresult.FieldKnockoutReasons)
+	return u
+}
+
 // SetTraceID sets the "trace_id" field.
 func (u *ScreeningResultUpsert) SetTraceID(v string) *ScreeningResultUpsert {
 	u.Set(screeningresult.FieldTraceID, v)
@@ -829,6 +1077,192 @@ func (u *ScreeningResultUpsert) ClearSubAgentVersions() *ScreeningResultUpsert {
 	return u
 }
 
+// SetJobHash sets the "job_hash" field.
+func (u *ScreeningResultUpsert) SetJobHash(v string) *ScreeningResultUpsert {
+	u.Set(screeningresult.FieldJobHash, v)
+	return u
+}
+
+// UpdateJobHash sets the "job_hash" field to the value that was provided on create.
+func (u *ScreeningResultUpsert) UpdateJobHash() *ScreeningResultUpsert {
+	u.SetExcluded(screeningresult.FieldJobHash)
+	return u
+}
+
+// ClearJobHash clears the value of the "job_hash" field.
+func (u *ScreeningResultUpsert) ClearJobHash() *ScreeningResultUpsert {
+	u.SetNull(screeningresult.FieldJobHash)
+	return u
+}
+
+// SetResumeHash sets the "resume_hash" field.
+func (u *ScreeningResultUpsert) SetResumeHash(v string) *ScreeningResultUpsert {
+	u.Set(screeningresult.FieldResumeHash, v)
+	return u
+}
+
+// UpdateResumeHash sets the "resume_hash" field to the value that was provided on create.
+func (u *ScreeningResultUpsert) UpdateResumeHash() *ScreeningResultUpsert {
+	u.SetExcluded(screeningresult.FieldResumeHash)
+	return u
+}
+
+// ClearResumeHash clears the value of the "resume_hash" field.
+func (u *ScreeningResultUpsert) ClearResumeHash() *ScreeningResultUpsert {
+	u.SetNull(screeningresult.FieldResumeHash)
+	return u
+}
+
+// SetAgentHash sets the "agent_hash" field.
+func (u *ScreeningResultUpsert) SetAgentHash(v string) *ScreeningResultUpsert {
+	u.Set(screeningresult.FieldAgentHash, v)
+	return u
+}
+
+// UpdateAgentHash sets the "agent_hash" field to the value that was provided on create.
+func (u *ScreeningResultUpsert) UpdateAgentHash() *ScreeningResultUpsert {
+	u.SetExcluded(screeningresult.FieldAgentHash)
+	return u
+}
+
+// ClearAgentHash clears the value of the "agent_hash" field.
+func (u *ScreeningResultUpsert) ClearAgentHash() *ScreeningResultUpsert {
+	u.SetNull(screeningresult.FieldAgentHash)
+	return u
+}
+
+// SetCachedFromID sets the "cached_from_id" field.
+func (u *ScreeningResultUpsert) SetCachedFromID(v uuid.UUID) *ScreeningResultUpsert {
+	u.Set(screeningresult.FieldCachedFromID, v)
+	return u
+}
+
+// UpdateCachedFromID sets the "cached_from_id" field to the value that was provided on create.
+func (u *ScreeningResultUpsert) UpdateCachedFromID() *ScreeningResultUpsert {
+	u.SetExcluded(screeningresult.FieldCachedFromID)
+	return u
+}
+
+// ClearCachedFromID clears the value of the "cached_from_id" field.
+func (u *ScreeningResultUpsert) ClearCachedFromID() *ScreeningResultUpsert {
+	u.SetNull(screeningresult.FieldCachedFromID)
+	return u
+}
+
+// SetHumanOverallScore sets the "human_overall_score" field.
+func (u *ScreeningResultUpsert) SetHumanOverallScore(v float64) *ScreeningResultUpsert {
+	u.Set(screeningresult.FieldHumanOverallScore, v)
+	return u
+}
+
+// UpdateHumanOverallScore sets the "human_overall_score" field to the value that was provided on create.
+func (u *ScreeningResultUpsert) UpdateHumanOverallScore() *ScreeningResultUpsert {
+	u.SetExcluded(screeningresult.FieldHumanOverallScore)
+	return u
+}
+
+// AddHumanOverallScore adds v to the "human_overall_score" field.
+func (u *ScreeningResultUpsert) AddHumanOverallScore(v float64) *ScreeningResultUpsert {
+	u.Add(screeningresult.FieldHumanOverallScore, v)
+	return u
+}
+
+// ClearHumanOverallScore clears the value of the "human_overall_score" field.
+func (u *ScreeningResultUpsert) ClearHumanOverallScore() *ScreeningResultUpsert {
+	u.SetNull(screeningresult.FieldHumanOverallScore)
+	return u
+}
+
+// SetHumanMatchLevel sets the "human_match_level" field.
+func (u *ScreeningResultUpsert) SetHumanMatchLevel(v screeningresult.HumanMatchLevel) *ScreeningResultUpsert {
+	u.Set(screeningresult.FieldHumanMatchLevel, v)
+	return u
+}
+
+// UpdateHumanMatchLevel sets the "human_match_level" field to the value that was provided on create.
+func (u *ScreeningResultUpsert) UpdateHumanMatchLevel() *ScreeningResultUpsert {
+	u.SetExcluded(screeningresult.FieldHumanMatchLevel)
+	return u
+}
+
+// ClearHumanMatchLevel clears the value of the "human_match_level" field.
+func (u *ScreeningResultUpsert) ClearHumanMatchLevel() *ScreeningResultUpsert {
+	u.SetNull(screeningresult.FieldHumanMatchLevel)
+	return u
+}
+
+// SetHumanDimensionScores sets the "human_dimension_scores" field.
+func (u *ScreeningResultUpsert) SetHumanDimensionScores(v map[string]interface{}) *ScreeningResultUpsert {
+	u.Set(screeningresult.FieldHumanDimensionScores, v)
+	return u
+}
+
+// UpdateHumanDimensionScores sets the "human_dimension_scores" field to the value that was provided on create.
+func (u *ScreeningResultUpsert) UpdateHumanDimensionScores() *ScreeningResultUpsert {
+	u.SetExcluded(screeningresult.FieldHumanDimensionScores)
+	return u
+}
+
+// ClearHumanDimensionScores clears the value of the "human_dimension_scores" field.
+func (u *ScreeningResultUpsert) ClearHumanDimensionScores() *ScreeningResultUpsert {
+	u.SetNull(screeningresult.FieldHumanDimensionScores)
+	return u
+}
+
+// SetOverrideReason sets the "override_reason" field.
+func (u *ScreeningResultUpsert) SetOverrideReason(v string) *ScreeningResultUpsert {
+	u.Set(screeningresult.FieldOverrideReason, v)
+	return u
+}
+
+// UpdateOverrideReason sets the "override_reason" field to the value that was provided on create.
+func (u *ScreeningResultUpsert) UpdateOverrideReason() *ScreeningResultUpsert {
+	u.SetExcluded(screeningresult.FieldOverrideReason)
+	return u
+}
+
+// ClearOverrideReason clears the value of the "override_reason" field.
+func (u *ScreeningResultUpsert) ClearOverrideReason() *ScreeningResultUpsert {
+	u.SetNull(screeningresult.FieldOverrideReason)
+	return u
+}
+
+// SetOverriddenBy sets the "overridden_by" field.
+func (u *ScreeningResultUpsert) SetOverriddenBy(v uuid.UUID) *ScreeningResultUpsert {
+	u.Set(screeningresult.FieldOverriddenBy, v)
+	return u
+}
+
+// UpdateOverriddenBy sets the "overridden_by" field to the value that was provided on create.
+func (u *ScreeningResultUpsert) UpdateOverriddenBy() *ScreeningResultUpsert {
+	u.SetExcluded(screeningresult.FieldOverriddenBy)
+	return u
+}
+
+// ClearOverriddenBy clears the value of the "overridden_by" field.
+func (u *ScreeningResultUpsert) ClearOverriddenBy() *ScreeningResultUpsert {
+	u.SetNull(screeningresult.FieldOverriddenBy)
+	return u
+}
+
+// SetOverriddenAt sets the "overridden_at" field.
+func (u *ScreeningResultUpsert) SetOverriddenAt(v time.Time) *ScreeningResultUpsert {
+	u.Set(screeningresult.FieldOverriddenAt, v)
+	return u
+}
+
+// UpdateOverriddenAt sets the "overridden_at" field to the value that was provided on create.
+func (u *ScreeningResultUpsert) UpdateOverriddenAt() *ScreeningResultUpsert {
+	u.SetExcluded(screeningresult.FieldOverriddenAt)
+	return u
+}
+
+// ClearOverriddenAt clears the value of the "overridden_at" field.
+func (u *ScreeningResultUpsert) ClearOverriddenAt() *ScreeningResultUpsert {
+	u.SetNull(screeningresult.FieldOverriddenAt)
+	return u
+}
+
 // SetMatchedAt sets the "matched_at" field.
 func (u *ScreeningResultUpsert) SetMatchedAt(v time.Time) *ScreeningResultUpsert {
 	u.Set(screeningresult.FieldMatchedAt, v)
@@ -1051,6 +1485,27 @@ func (u *ScreeningResultUpsertOne) ClearSkillDetail() *ScreeningResultUpsertOne
 	})
 }
 
+// SetCustomDetails sets the "custom_details" field.
+func (u *ScreeningResultUpsertOne) SetCustomDetails(v map[string]interface{}) *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetCustomDetails(v)
+	})
+}
+
+// UpdateCustomDetails sets the "custom_details" field to the value that was provided on create.
+func (u *ScreeningResultUpsertOne) UpdateCustomDetails() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateCustomDetails()
+	})
+}
+
+// ClearCustomDetails clears the value of the "custom_details" field.
+func (u *ScreeningResultUpsertOne) ClearCustomDetails() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearCustomDetails()
+	})
+}
+
 // SetResponsibilityDetail sets the "responsibility_detail" field.
 func (u *ScreeningResultUpsertOne) SetResponsibilityDetail(v map[string]interface{}) *ScreeningResultUpsertOne {
 	return u.Update(func(s *ScreeningResultUpsert) {
@@ -1177,6 +1632,27 @@ func (u *ScreeningResultUpsertOne) ClearRecommendations() *ScreeningResultUpsert
 	})
 }
 
+// SetKnockoutReasons sets the "knockout_reasons" field.
+func (u *ScreeningResultUpsertOne) SetKnockoutReasons(v []string) *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetKnockoutReasons(v)
+	})
+}
+
+// UpdateKnockoutReasons sets the "knockout_reasons" field to the value that was provided on create.
+func (u *ScreeningResultUpsertOne) UpdateKnockoutReasons() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateKnockoutReasons()
+	})
+}
+
+// ClearKnockoutReasons clears the value of the "knockout_reasons" field.
+func (u *ScreeningResultUpsertOne) ClearKnockoutReasons() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearKnockoutReasons()
+	})
+}
+
 // SetTraceID sets the "trace_id" field.
 func (u *ScreeningResultUpsertOne) SetTraceID(v string) *ScreeningResultUpsertOne {
 	return u.Update(func(s *ScreeningResultUpsert) {
@@ -1240,6 +1716,223 @@ func (u *ScreeningResultUpsertOne) ClearSubAgentVersions() *ScreeningResultUpser
 	})
 }
 
+// SetJobHash sets the "job_hash" field.
+func (u *ScreeningResultUpsertOne) SetJobHash(v string) *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetJobHash(v)
+	})
+}
+
+// UpdateJobHash sets the "job_hash" field to the value that was provided on create.
+func (u *ScreeningResultUpsertOne) UpdateJobHash() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateJobHash()
+	})
+}
+
+// ClearJobHash clears the value of the "job_hash" field.
+func (u *ScreeningResultUpsertOne) ClearJobHash() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearJobHash()
+	})
+}
+
+// SetResumeHash sets the "resume_hash" field.
+func (u *ScreeningResultUpsertOne) SetResumeHash(v string) *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetResumeHash(v)
+	})
+}
+
+// UpdateResumeHash sets the "resume_hash" field to the value that was provided on create.
+func (u *ScreeningResultUpsertOne) UpdateResumeHash() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateResumeHash()
+	})
+}
+
+// ClearResumeHash clears the value of the "resume_hash" field.
+func (u *ScreeningResultUpsertOne) ClearResumeHash() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearResumeHash()
+	})
+}
+
+// SetAgentHash sets the "agent_hash" field.
+func (u *ScreeningResultUpsertOne) SetAgentHash(v string) *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetAgentHash(v)
+	})
+}
+
+// UpdateAgentHash sets the "agent_hash" field to the value that was provided on create.
+func (u *ScreeningResultUpsertOne) UpdateAgentHash() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateAgentHash()
+	})
+}
+
+// ClearAgentHash clears the value of the "agent_hash" field.
+func (u *ScreeningResultUpsertOne) ClearAgentHash() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearAgentHash()
+	})
+}
+
+// SetCachedFromID sets the "cached_from_id" field.
+func (u *ScreeningResultUpsertOne) SetCachedFromID(v uuid.UUID) *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetCachedFromID(v)
+	})
+}
+
+// UpdateCachedFromID sets the "cached_from_id" field to the value that was provided on create.
+func (u *ScreeningResultUpsertOne) UpdateCachedFromID() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateCachedFromID()
+	})
+}
+
+// ClearCachedFromID clears the value of the "cached_from_id" field.
+func (u *ScreeningResultUpsertOne) ClearCachedFromID() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearCachedFromID()
+	})
+}
+
+// SetHumanOverallScore sets the "human_overall_score" field.
+func (u *ScreeningResultUpsertOne) SetHumanOverallScore(v float64) *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetHumanOverallScore(v)
+	})
+}
+
+// AddHumanOverallScore adds v to the "human_overall_score" field.
+func (u *ScreeningResultUpsertOne) AddHumanOverallScore(v float64) *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.AddHumanOverallScore(v)
+	})
+}
+
+// UpdateHumanOverallScore sets the "human_overall_score" field to the value that was provided on create.
+func (u *ScreeningResultUpsertOne) UpdateHumanOverallScore() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateHumanOverallScore()
+	})
+}
+
+// ClearHumanOverallScore clears the value of the "human_overall_score" field.
+func (u *ScreeningResultUpsertOne) ClearHumanOverallScore() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearHumanOverallScore()
+	})
+}
+
+// SetHumanMatchLevel sets the "human_match_level" field.
+func (u *ScreeningResultUpsertOne) SetHumanMatchLevel(v screeningresult.HumanMatchLevel) *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetHumanMatchLevel(v)
+	})
+}
+
+// UpdateHumanMatchLevel sets the "human_match_level" field to the value that was provided on create.
+func (u *ScreeningResultUpsertOne) UpdateHumanMatchLevel() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateHumanMatchLevel()
+	})
+}
+
+// ClearHumanMatchLevel clears the value of the "human_match_level" field.
+func (u *ScreeningResultUpsertOne) ClearHumanMatchLevel() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearHumanMatchLevel()
+	})
+}
+
+// SetHumanDimensionScores sets the "human_dimension_scores" field.
+func (u *ScreeningResultUpsertOne) SetHumanDimensionScores(v map[string]interface{}) *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetHumanDimensionScores(v)
+	})
+}
+
+// UpdateHumanDimensionScores sets the "human_dimension_scores" field to the value that was provided on create.
+func (u *ScreeningResultUpsertOne) UpdateHumanDimensionScores() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateHumanDimensionScores()
+	})
+}
+
+// ClearHumanDimensionScores clears the value of the "human_dimension_scores" field.
+func (u *ScreeningResultUpsertOne) ClearHumanDimensionScores() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearHumanDimensionScores()
+	})
+}
+
+// SetOverrideReason sets the "override_reason" field.
+func (u *ScreeningResultUpsertOne) SetOverrideReason(v string) *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetOverrideReason(v)
+	})
+}
+
+// UpdateOverrideReason sets the "override_reason" field to the value that was provided on create.
+func (u *ScreeningResultUpsertOne) UpdateOverrideReason() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateOverrideReason()
+	})
+}
+
+// ClearOverrideReason clears the value of the "override_reason" field.
+func (u *ScreeningResultUpsertOne) ClearOverrideReason() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearOverrideReason()
+	})
+}
+
+// SetOverriddenBy sets the "overridden_by" field.
+func (u *ScreeningResultUpsertOne) SetOverriddenBy(v uuid.UUID) *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetOverriddenBy(v)
+	})
+}
+
+// UpdateOverriddenBy sets the "overridden_by" field to the value that was provided on create.
+func (u *ScreeningResultUpsertOne) UpdateOverriddenBy() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateOverriddenBy()
+	})
+}
+
+// ClearOverriddenBy clears the value of the "overridden_by" field.
+func (u *ScreeningResultUpsertOne) ClearOverriddenBy() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearOverriddenBy()
+	})
+}
+
+// SetOverriddenAt sets the "overridden_at" field.
+func (u *ScreeningResultUpsertOne) SetOverriddenAt(v time.Time) *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetOverriddenAt(v)
+	})
+}
+
+// UpdateOverriddenAt sets the "overridden_at" field to the value that was provided on create.
+func (u *ScreeningResultUpsertOne) UpdateOverriddenAt() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateOverriddenAt()
+	})
+}
+
+// ClearOverriddenAt clears the value of the "overridden_at" field.
+func (u *ScreeningResultUpsertOne) ClearOverriddenAt() *ScreeningResultUpsertOne {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearOverriddenAt()
+	})
+}
+
 // SetMatchedAt sets the "matched_at" field.
 func (u *ScreeningResultUpsertOne) SetMatchedAt(v time.Time) *ScreeningResultUpsertOne {
 	return u.Update(func(s *ScreeningResultUpsert) {
@@ -1633,6 +2326,27 @@ func (u *ScreeningResultUpsertBulk) ClearSkillDetail() *ScreeningResultUpsertBul
 	})
 }
 
+// SetCustomDetails sets the "custom_details" field.
+func (u *ScreeningResultUpsertBulk) SetCustomDetails(v map[string]interface{}) *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetCustomDetails(v)
+	})
+}
+
+// UpdateCustomDetails sets the "custom_details" field to the value that was provided on create.
+func (u *ScreeningResultUpsertBulk) UpdateCustomDetails() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateCustomDetails()
+	})
+}
+
+// ClearCustomDetails clears the value of the "custom_details" field.
+func (u *ScreeningResultUpsertBulk) ClearCustomDetails() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearCustomDetails()
+	})
+}
+
 // SetResponsibilityDetail sets the "responsibility_detail" field.
 func (u *ScreeningResultUpsertBulk) SetResponsibilityDetail(v map[string]interface{}) *ScreeningResultUpsertBulk {
 	return u.Update(func(s *ScreeningResultUpsert) {
@@ -1759,6 +2473,27 @@ func (u *ScreeningResultUpsertBulk) ClearRecommendations() *ScreeningResultUpser
 	})
 }
 
+// SetKnockoutReasons sets the "knockout_reasons" field.
+func (u *ScreeningResultUpsertBulk) SetKnockoutReasons(v []string) *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetKnockoutReasons(v)
+	})
+}
+
+// UpdateKnockoutReasons sets the "knockout_reasons" field to the value that was provided on create.
+func (u *ScreeningResultUpsertBulk) UpdateKnockoutReasons() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateKnockoutReasons()
+	})
+}
+
+// ClearKnockoutReasons clears the value of the "knockout_reasons" field.
+func (u *ScreeningResultUpsertBulk) ClearKnockoutReasons() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearKnockoutReasons()
+	})
+}
+
 // SetTraceID sets the "trace_id" field.
 func (u *ScreeningResultUpsertBulk) SetTraceID(v string) *ScreeningResultUpsertBulk {
 	return u.Update(func(s *ScreeningResultUpsert) {
@@ -1822,6 +2557,223 @@ func (u *ScreeningResultUpsertBulk) ClearSubAgentVersions() *ScreeningResultUpse
 	})
 }
 
+// SetJobHash sets the "job_hash" field.
+func (u *ScreeningResultUpsertBulk) SetJobHash(v string) *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetJobHash(v)
+	})
+}
+
+// UpdateJobHash sets the "job_hash" field to the value that was provided on create.
+func (u *ScreeningResultUpsertBulk) UpdateJobHash() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateJobHash()
+	})
+}
+
+// ClearJobHash clears the value of the "job_hash" field.
+func (u *ScreeningResultUpsertBulk) ClearJobHash() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearJobHash()
+	})
+}
+
+// SetResumeHash sets the "resume_hash" field.
+func (u *ScreeningResultUpsertBulk) SetResumeHash(v string) *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetResumeHash(v)
+	})
+}
+
+// UpdateResumeHash sets the "resume_hash" field to the value that was provided on create.
+func (u *ScreeningResultUpsertBulk) UpdateResumeHash() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateResumeHash()
+	})
+}
+
+// ClearResumeHash clears the value of the "resume_hash" field.
+func (u *ScreeningResultUpsertBulk) ClearResumeHash() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearResumeHash()
+	})
+}
+
+// SetAgentHash sets the "agent_hash" field.
+func (u *ScreeningResultUpsertBulk) SetAgentHash(v string) *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetAgentHash(v)
+	})
+}
+
+// UpdateAgentHash sets the "agent_hash" field to the value that was provided on create.
+func (u *ScreeningResultUpsertBulk) UpdateAgentHash() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateAgentHash()
+	})
+}
+
+// ClearAgentHash clears the value of the "agent_hash" field.
+func (u *ScreeningResultUpsertBulk) ClearAgentHash() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearAgentHash()
+	})
+}
+
+// SetCachedFromID sets the "cached_from_id" field.
+func (u *ScreeningResultUpsertBulk) SetCachedFromID(v uuid.UUID) *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetCachedFromID(v)
+	})
+}
+
+// UpdateCachedFromID sets the "cached_from_id" field to the value that was provided on create.
+func (u *ScreeningResultUpsertBulk) UpdateCachedFromID() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateCachedFromID()
+	})
+}
+
+// ClearCachedFromID clears the value of the "cached_from_id" field.
+func (u *ScreeningResultUpsertBulk) ClearCachedFromID() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearCachedFromID()
+	})
+}
+
+// SetHumanOverallScore sets the "human_overall_score" field.
+func (u *ScreeningResultUpsertBulk) SetHumanOverallScore(v float64) *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetHumanOverallScore(v)
+	})
+}
+
+// AddHumanOverallScore adds v to the "human_overall_score" field.
+func (u *ScreeningResultUpsertBulk) AddHumanOverallScore(v float64) *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.AddHumanOverallScore(v)
+	})
+}
+
+// UpdateHumanOverallScore sets the "human_overall_score" field to the value that was provided on create.
+func (u *ScreeningResultUpsertBulk) UpdateHumanOverallScore() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateHumanOverallScore()
+	})
+}
+
+// ClearHumanOverallScore clears the value of the "human_overall_score" field.
+func (u *ScreeningResultUpsertBulk) ClearHumanOverallScore() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearHumanOverallScore()
+	})
+}
+
+// SetHumanMatchLevel sets the "human_match_level" field.
+func (u *ScreeningResultUpsertBulk) SetHumanMatchLevel(v screeningresult.HumanMatchLevel) *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetHumanMatchLevel(v)
+	})
+}
+
+// UpdateHumanMatchLevel sets the "human_match_level" field to the value that was provided on create.
+func (u *ScreeningResultUpsertBulk) UpdateHumanMatchLevel() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateHumanMatchLevel()
+	})
+}
+
+// ClearHumanMatchLevel clears the value of the "human_match_level" field.
+func (u *ScreeningResultUpsertBulk) ClearHumanMatchLevel() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearHumanMatchLevel()
+	})
+}
+
+// SetHumanDimensionScores sets the "human_dimension_scores" field.
+func (u *ScreeningResultUpsertBulk) SetHumanDimensionScores(v map[string]interface{}) *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetHumanDimensionScores(v)
+	})
+}
+
+// UpdateHumanDimensionScores sets the "human_dimension_scores" field to the value that was provided on create.
+func (u *ScreeningResultUpsertBulk) UpdateHumanDimensionScores() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateHumanDimensionScores()
+	})
+}
+
+// ClearHumanDimensionScores clears the value of the "human_dimension_scores" field.
+func (u *ScreeningResultUpsertBulk) ClearHumanDimensionScores() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearHumanDimensionScores()
+	})
+}
+
+// SetOverrideReason sets the "override_reason" field.
+func (u *ScreeningResultUpsertBulk) SetOverrideReason(v string) *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetOverrideReason(v)
+	})
+}
+
+// UpdateOverrideReason sets the "override_reason" field to the value that was provided on create.
+func (u *ScreeningResultUpsertBulk) UpdateOverrideReason() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateOverrideReason()
+	})
+}
+
+// ClearOverrideReason clears the value of the "override_reason" field.
+func (u *ScreeningResultUpsertBulk) ClearOverrideReason() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearOverrideReason()
+	})
+}
+
+// SetOverriddenBy sets the "overridden_by" field.
+func (u *ScreeningResultUpsertBulk) SetOverriddenBy(v uuid.UUID) *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetOverriddenBy(v)
+	})
+}
+
+// UpdateOverriddenBy sets the "overridden_by" field to the value that was provided on create.
+func (u *ScreeningResultUpsertBulk) UpdateOverriddenBy() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateOverriddenBy()
+	})
+}
+
+// ClearOverriddenBy clears the value of the "overridden_by" field.
+func (u *ScreeningResultUpsertBulk) ClearOverriddenBy() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearOverriddenBy()
+	})
+}
+
+// SetOverriddenAt sets the "overridden_at" field.
+func (u *ScreeningResultUpsertBulk) SetOverriddenAt(v time.Time) *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.SetOverriddenAt(v)
+	})
+}
+
+// UpdateOverriddenAt sets the "overridden_at" field to the value that was provided on create.
+func (u *ScreeningResultUpsertBulk) UpdateOverriddenAt() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.UpdateOverriddenAt()
+	})
+}
+
+// ClearOverriddenAt clears the value of the "overridden_at" field.
+func (u *ScreeningResultUpsertBulk) ClearOverriddenAt() *ScreeningResultUpsertBulk {
+	return u.Update(func(s *ScreeningResultUpsert) {
+		s.ClearOverriddenAt()
+	})
+}
+
 // SetMatchedAt sets the "matched_at" field.
 func (u *ScreeningResultUpsertBulk) SetMatchedAt(v time.Time) *ScreeningResultUpsertBulk {
 	return u.Update(func(s *ScreeningResultUpsert) {
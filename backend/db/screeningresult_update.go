@@ -161,6 +161,18 @@ func (sru *ScreeningResultUpdate) ClearSkillDetail() *ScreeningResultUpdate {
 	return sru
 }
 
+// SetCustomDetails sets the "custom_details" field.
+func (sru *ScreeningResultUpdate) SetCustomDetails(m map[string]interface{}) *ScreeningResultUpdate {
+	sru.mutation.SetCustomDetails(m)
+	return sru
+}
+
+// ClearCustomDetails clears the value of the "custom_details" field.
+func (sru *ScreeningResultUpdate) ClearCustomDetails() *ScreeningResultUpdate {
+	sru.mutation.ClearCustomDetails()
+	return sru
+}
+
 // SetResponsibilityDetail sets the "responsibility_detail" field.
 func (sru *ScreeningResultUpdate) SetResponsibilityDetail(m map[string]interface{}) *ScreeningResultUpdate {
 	sru.mutation.SetResponsibilityDetail(m)
@@ -239,6 +251,24 @@ func (sru *ScreeningResultUpdate) ClearRecommendations() *ScreeningResultUpdate
 	return sru
 }
 
+// SetKnockoutReasons sets the "knockout_reasons" field.
+func (sru *ScreeningResultUpdate) SetKnockoutReasons(s []string) *ScreeningResultUpdate {
+	sru.mutation.SetKnockoutReasons(s)
+	return sru
+}
+
+// AppendKnockoutReasons appends s to the "knockout_reasons" field.
+func (sru *ScreeningResultUpdate) AppendKnockoutReasons(s []string) *ScreeningResultUpdate {
+	sru.mutation.AppendKnockoutReasons(s)
+	return sru
+}
+
+// ClearKnockoutReasons clears the value of the "knockout_reasons" field.
+func (sru *ScreeningResultUpdate) ClearKnockoutReasons() *ScreeningResultUpdate {
+	sru.mutation.ClearKnockoutReasons()
+	return sru
+}
+
 // SetTraceID sets the "trace_id" field.
 func (sru *ScreeningResultUpdate) SetTraceID(s string) *ScreeningResultUpdate {
 	sru.mutation.SetTraceID(s)
@@ -283,6 +313,205 @@ func (sru *ScreeningResultUpdate) ClearSubAgentVersions() *ScreeningResultUpdate
 	return sru
 }
 
+// SetJobHash sets the "job_hash" field.
+func (sru *ScreeningResultUpdate) SetJobHash(s string) *ScreeningResultUpdate {
+	sru.mutation.SetJobHash(s)
+	return sru
+}
+
+// SetNillableJobHash sets the "job_hash" field if the given value is not nil.
+func (sru *ScreeningResultUpdate) SetNillableJobHash(s *string) *ScreeningResultUpdate {
+	if s != nil {
+		sru.SetJobHash(*s)
+	}
+	return sru
+}
+
+// ClearJobHash clears the value of the "job_hash" field.
+func (sru *ScreeningResultUpdate) ClearJobHash() *ScreeningResultUpdate {
+	sru.mutation.ClearJobHash()
+	return sru
+}
+
+// SetResumeHash sets the "resume_hash" field.
+func (sru *ScreeningResultUpdate) SetResumeHash(s string) *ScreeningResultUpdate {
+	sru.mutation.SetResumeHash(s)
+	return sru
+}
+
+// SetNillableResumeHash sets the "resume_hash" field if the given value is not nil.
+func (sru *ScreeningResultUpdate) SetNillableResumeHash(s *string) *ScreeningResultUpdate {
+	if s != nil {
+		sru.SetResumeHash(*s)
+	}
+	return sru
+}
+
+// ClearResumeHash clears the value of the "resume_hash" field.
+func (sru *ScreeningResultUpdate) ClearResumeHash() *ScreeningResultUpdate {
+	sru.mutation.ClearResumeHash()
+	return sru
+}
+
+// SetAgentHash sets the "agent_hash" field.
+func (sru *ScreeningResultUpdate) SetAgentHash(s string) *ScreeningResultUpdate {
+	sru.mutation.SetAgentHash(s)
+	return sru
+}
+
+// SetNillableAgentHash sets the "agent_hash" field if the given value is not nil.
+func (sru *ScreeningResultUpdate) SetNillableAgentHash(s *string) *ScreeningResultUpdate {
+	if s != nil {
+		sru.SetAgentHash(*s)
+	}
+	return sru
+}
+
+// ClearAgentHash clears the value of the "agent_hash" field.
+func (sru *ScreeningResultUpdate) ClearAgentHash() *ScreeningResultUpdate {
+	sru.mutation.ClearAgentHash()
+	return sru
+}
+
+// SetCachedFromID sets the "cached_from_id" field.
+func (sru *ScreeningResultUpdate) SetCachedFromID(u uuid.UUID) *ScreeningResultUpdate {
+	sru.mutation.SetCachedFromID(u)
+	return sru
+}
+
+// SetNillableCachedFromID sets the "cached_from_id" field if the given value is not nil.
+func (sru *ScreeningResultUpdate) SetNillableCachedFromID(u *uuid.UUID) *ScreeningResultUpdate {
+	if u != nil {
+		sru.SetCachedFromID(*u)
+	}
+	return sru
+}
+
+// ClearCachedFromID clears the value of the "cached_from_id" field.
+func (sru *ScreeningResultUpdate) ClearCachedFromID() *ScreeningResultUpdate {
+	sru.mutation.ClearCachedFromID()
+	return sru
+}
+
+// SetHumanOverallScore sets the "human_overall_score" field.
+func (sru *ScreeningResultUpdate) SetHumanOverallScore(f float64) *ScreeningResultUpdate {
+	sru.mutation.ResetHumanOverallScore()
+	sru.mutation.SetHumanOverallScore(f)
+	return sru
+}
+
+// SetNillableHumanOverallScore sets the "human_overall_score" field if the given value is not nil.
+func (sru *ScreeningResultUpdate) SetNillableHumanOverallScore(f *float64) *ScreeningResultUpdate {
+	if f != nil {
+		sru.SetHumanOverallScore(*f)
+	}
+	return sru
+}
+
+// AddHumanOverallScore adds f to the "human_overall_score" field.
+func (sru *ScreeningResultUpdate) AddHumanOverallScore(f float64) *ScreeningResultUpdate {
+	sru.mutation.AddHumanOverallScore(f)
+	return sru
+}
+
+// ClearHumanOverallScore clears the value of the "human_overall_score" field.
+func (sru *ScreeningResultUpdate) ClearHumanOverallScore() *ScreeningResultUpdate {
+	sru.mutation.ClearHumanOverallScore()
+	return sru
+}
+
+// SetHumanMatchLevel sets the "human_match_level" field.
+func (sru *ScreeningResultUpdate) SetHumanMatchLevel(sml screeningresult.HumanMatchLevel) *ScreeningResultUpdate {
+	sru.mutation.SetHumanMatchLevel(sml)
+	return sru
+}
+
+// SetNillableHumanMatchLevel sets the "human_match_level" field if the given value is not nil.
+func (sru *ScreeningResultUpdate) SetNillableHumanMatchLevel(sml *screeningresult.HumanMatchLevel) *ScreeningResultUpdate {
+	if sml != nil {
+		sru.SetHumanMatchLevel(*sml)
+	}
+	return sru
+}
+
+// ClearHumanMatchLevel clears the value of the "human_match_level" field.
+func (sru *ScreeningResultUpdate) ClearHumanMatchLevel() *ScreeningResultUpdate {
+	sru.mutation.ClearHumanMatchLevel()
+	return sru
+}
+
+// SetHumanDimensionScores sets the "human_dimension_scores" field.
+func (sru *ScreeningResultUpdate) SetHumanDimensionScores(m map[string]interface{}) *ScreeningResultUpdate {
+	sru.mutation.SetHumanDimensionScores(m)
+	return sru
+}
+
+// ClearHumanDimensionScores clears the value of the "human_dimension_scores" field.
+func (sru *ScreeningResultUpdate) ClearHumanDimensionScores() *ScreeningResultUpdate {
+	sru.mutation.ClearHumanDimensionScores()
+	return sru
+}
+
+// SetOverrideReason sets the "override_reason" field.
+func (sru *ScreeningResultUpdate) SetOverrideReason(s string) *ScreeningResultUpdate {
+	sru.mutation.SetOverrideReason(s)
+	return sru
+}
+
+// SetNillableOverrideReason sets the "override_reason" field if the given value is not nil.
+func (sru *ScreeningResultUpdate) SetNillableOverrideReason(s *string) *ScreeningResultUpdate {
+	if s != nil {
+		sru.SetOverrideReason(*s)
+	}
+	return sru
+}
+
+// ClearOverrideReason clears the value of the "override_reason" field.
+func (sru *ScreeningResultUpdate) ClearOverrideReason() *ScreeningResultUpdate {
+	sru.mutation.ClearOverrideReason()
+	return sru
+}
+
+// SetOverriddenBy sets the "overridden_by" field.
+func (sru *ScreeningResultUpdate) SetOverriddenBy(u uuid.UUID) *ScreeningResultUpdate {
+	sru.mutation.SetOverriddenBy(u)
+	return sru
+}
+
+// SetNillableOverriddenBy sets the "overridden_by" field if the given value is not nil.
+func (sru *ScreeningResultUpdate) SetNillableOverriddenBy(u *uuid.UUID) *ScreeningResultUpdate {
+	if u != nil {
+		sru.SetOverriddenBy(*u)
+	}
+	return sru
+}
+
+// ClearOverriddenBy clears the value of the "overridden_by" field.
+func (sru *ScreeningResultUpdate) ClearOverriddenBy() *ScreeningResultUpdate {
+	sru.mutation.ClearOverriddenBy()
+	return sru
+}
+
+// SetOverriddenAt sets the "overridden_at" field.
+func (sru *ScreeningResultUpdate) SetOverriddenAt(t time.Time) *ScreeningResultUpdate {
+	sru.mutation.SetOverriddenAt(t)
+	return sru
+}
+
+// SetNillableOverriddenAt sets the "overridden_at" field if the given value is not nil.
+func (sru *ScreeningResultUpdate) SetNillableOverriddenAt(t *time.Time) *ScreeningResultUpdate {
+	if t != nil {
+		sru.SetOverriddenAt(*t)
+	}
+	return sru
+}
+
+// ClearOverriddenAt clears the value of the "overridden_at" field.
+func (sru *ScreeningResultUpdate) ClearOverriddenAt() *ScreeningResultUpdate {
+	sru.mutation.ClearOverriddenAt()
+	return sru
+}
+
 // SetMatchedAt sets the "matched_at" field.
 func (sru *ScreeningResultUpdate) SetMatchedAt(t time.Time) *ScreeningResultUpdate {
 	sru.mutation.SetMatchedAt(t)
@@ -395,6 +624,26 @@ func (sru *ScreeningResultUpdate) check() error {
 			return &ValidationError{Name: "trace_id", err: fmt.Errorf(`db: validator failed for field "ScreeningResult.trace_id": %w`, err)}
 		}
 	}
+	if v, ok := sru.mutation.JobHash(); ok {
+		if err := screeningresult.JobHashValidator(v); err != nil {
+			return &ValidationError{Name: "job_hash", err: fmt.Errorf(`db: validator failed for field "ScreeningResult.job_hash": %w`, err)}
+		}
+	}
+	if v, ok := sru.mutation.ResumeHash(); ok {
+		if err := screeningresult.ResumeHashValidator(v); err != nil {
+			return &ValidationError{Name: "resume_hash", err: fmt.Errorf(`db: validator failed for field "ScreeningResult.resume_hash": %w`, err)}
+		}
+	}
+	if v, ok := sru.mutation.AgentHash(); ok {
+		if err := screeningresult.AgentHashValidator(v); err != nil {
+			return &ValidationError{Name: "agent_hash", err: fmt.Errorf(`db: validator failed for field "ScreeningResult.agent_hash": %w`, err)}
+		}
+	}
+	if v, ok := sru.mutation.HumanMatchLevel(); ok {
+		if err := screeningresult.HumanMatchLevelValidator(v); err != nil {
+			return &ValidationError{Name: "human_match_level", err: fmt.Errorf(`db: validator failed for field "ScreeningResult.human_match_level": %w`, err)}
+		}
+	}
 	if sru.mutation.TaskCleared() && len(sru.mutation.TaskIDs()) > 0 {
 		return errors.New(`db: clearing a required unique edge "ScreeningResult.task"`)
 	}
@@ -455,6 +704,12 @@ func (sru *ScreeningResultUpdate) sqlSave(ctx context.Context) (n int, err error
 	if sru.mutation.SkillDetailCleared() {
 		_spec.ClearField(screeningresult.FieldSkillDetail, field.TypeJSON)
 	}
+	if value, ok := sru.mutation.CustomDetails(); ok {
+		_spec.SetField(screeningresult.FieldCustomDetails, field.TypeJSON, value)
+	}
+	if sru.mutation.CustomDetailsCleared() {
+		_spec.ClearField(screeningresult.FieldCustomDetails, field.TypeJSON)
+	}
 	if value, ok := sru.mutation.ResponsibilityDetail(); ok {
 		_spec.SetField(screeningresult.FieldResponsibilityDetail, field.TypeJSON, value)
 	}
@@ -496,6 +751,17 @@ func (sru *ScreeningResultUpdate) sqlSave(ctx context.Context) (n int, err error
 	if sru.mutation.RecommendationsCleared() {
 		_spec.ClearField(screeningresult.FieldRecommendations, field.TypeJSON)
 	}
+	if value, ok := sru.mutation.KnockoutReasons(); ok {
+		_spec.SetField(screeningresult.FieldKnockoutReasons, field.TypeJSON, value)
+	}
+	if value, ok := sru.mutation.AppendedKnockoutReasons(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, screeningresult.FieldKnockoutReasons, value)
+		})
+	}
+	if sru.mutation.KnockoutReasonsCleared() {
+		_spec.ClearField(screeningresult.FieldKnockoutReasons, field.TypeJSON)
+	}
 	if value, ok := sru.mutation.TraceID(); ok {
 		_spec.SetField(screeningresult.FieldTraceID, field.TypeString, value)
 	}
@@ -514,6 +780,69 @@ func (sru *ScreeningResultUpdate) sqlSave(ctx context.Context) (n int, err error
 	if sru.mutation.SubAgentVersionsCleared() {
 		_spec.ClearField(screeningresult.FieldSubAgentVersions, field.TypeJSON)
 	}
+	if value, ok := sru.mutation.JobHash(); ok {
+		_spec.SetField(screeningresult.FieldJobHash, field.TypeString, value)
+	}
+	if sru.mutation.JobHashCleared() {
+		_spec.ClearField(screeningresult.FieldJobHash, field.TypeString)
+	}
+	if value, ok := sru.mutation.ResumeHash(); ok {
+		_spec.SetField(screeningresult.FieldResumeHash, field.TypeString, value)
+	}
+	if sru.mutation.ResumeHashCleared() {
+		_spec.ClearField(screeningresult.FieldResumeHash, field.TypeString)
+	}
+	if value, ok := sru.mutation.AgentHash(); ok {
+		_spec.SetField(screeningresult.FieldAgentHash, field.TypeString, value)
+	}
+	if sru.mutation.AgentHashCleared() {
+		_spec.ClearField(screeningresult.FieldAgentHash, field.TypeString)
+	}
+	if value, ok := sru.mutation.CachedFromID(); ok {
+		_spec.SetField(screeningresult.FieldCachedFromID, field.TypeUUID, value)
+	}
+	if sru.mutation.CachedFromIDCleared() {
+		_spec.ClearField(screeningresult.FieldCachedFromID, field.TypeUUID)
+	}
+	if value, ok := sru.mutation.HumanOverallScore(); ok {
+		_spec.SetField(screeningresult.FieldHumanOverallScore, field.TypeFloat64, value)
+	}
+	if value, ok := sru.mutation.AddedHumanOverallScore(); ok {
+		_spec.AddField(screeningresult.FieldHumanOverallScore, field.TypeFloat64, value)
+	}
+	if sru.mutation.HumanOverallScoreCleared() {
+		_spec.ClearField(screeningresult.FieldHumanOverallScore, field.TypeFloat64)
+	}
+	if value, ok := sru.mutation.HumanMatchLevel(); ok {
+		_spec.SetField(screeningresult.FieldHumanMatchLevel, field.TypeEnum, value)
+	}
+	if sru.mutation.HumanMatchLevelCleared() {
+		_spec.ClearField(screeningresult.FieldHumanMatchLevel, field.TypeEnum)
+	}
+	if value, ok := sru.mutation.HumanDimensionScores(); ok {
+		_spec.SetField(screeningresult.FieldHumanDimensionScores, field.TypeJSON, value)
+	}
+	if sru.mutation.HumanDimensionScoresCleared() {
+		_spec.ClearField(screeningresult.FieldHumanDimensionScores, field.TypeJSON)
+	}
+	if value, ok := sru.mutation.OverrideReason(); ok {
+		_spec.SetField(screeningresult.FieldOverrideReason, field.TypeString, value)
+	}
+	if sru.mutation.OverrideReasonCleared() {
+		_spec.ClearField(screeningresult.FieldOverrideReason, field.TypeString)
+	}
+	if value, ok := sru.mutation.OverriddenBy(); ok {
+		_spec.SetField(screeningresult.FieldOverriddenBy, field.TypeUUID, value)
+	}
+	if sru.mutation.OverriddenByCleared() {
+		_spec.ClearField(screeningresult.FieldOverriddenBy, field.TypeUUID)
+	}
+	if value, ok := sru.mutation.OverriddenAt(); ok {
+		_spec.SetField(screeningresult.FieldOverriddenAt, field.TypeTime, value)
+	}
+	if sru.mutation.OverriddenAtCleared() {
+		_spec.ClearField(screeningresult.FieldOverriddenAt, field.TypeTime)
+	}
 	if value, ok := sru.mutation.MatchedAt(); ok {
 		_spec.SetField(screeningresult.FieldMatchedAt, field.TypeTime, value)
 	}
@@ -756,6 +1085,18 @@ func (sruo *ScreeningResultUpdateOne) ClearSkillDetail() *ScreeningResultUpdateO
 	return sruo
 }
 
+// SetCustomDetails sets the "custom_details" field.
+func (sruo *ScreeningResultUpdateOne) SetCustomDetails(m map[string]interface{}) *ScreeningResultUpdateOne {
+	sruo.mutation.SetCustomDetails(m)
+	return sruo
+}
+
+// ClearCustomDetails clears the value of the "custom_details" field.
+func (sruo *ScreeningResultUpdateOne) ClearCustomDetails() *ScreeningResultUpdateOne {
+	sruo.mutation.ClearCustomDetails()
+	return sruo
+}
+
 // SetResponsibilityDetail sets the "responsibility_detail" field.
 func (sruo *ScreeningResultUpdateOne) SetResponsibilityDetail(m map[string]interface{}) *ScreeningResultUpdateOne {
 	sruo.mutation.SetResponsibilityDetail(m)
@@ -834,6 +1175,24 @@ func (sruo *ScreeningResultUpdateOne) ClearRecommendations() *ScreeningResultUpd
 	return sruo
 }
 
+// SetKnockoutReasons sets the "knockout_reasons" field.
+func (sruo *ScreeningResultUpdateOne) SetKnockoutReasons(s []string) *ScreeningResultUpdateOne {
+	sruo.mutation.SetKnockoutReasons(s)
+	return sruo
+}
+
+// AppendKnockoutReasons appends s to the "knockout_reasons" field.
+func (sruo *ScreeningResultUpdateOne) AppendKnockoutReasons(s []string) *ScreeningResultUpdateOne {
+	sruo.mutation.AppendKnockoutReasons(s)
+	return sruo
+}
+
+// ClearKnockoutReasons clears the value of the "knockout_reasons" field.
+func (sruo *ScreeningResultUpdateOne) ClearKnockoutReasons() *ScreeningResultUpdateOne {
+	sruo.mutation.ClearKnockoutReasons()
+	return sruo
+}
+
 // SetTraceID sets the "trace_id" field.
 func (sruo *ScreeningResultUpdateOne) SetTraceID(s string) *ScreeningResultUpdateOne {
 	sruo.mutation.SetTraceID(s)
@@ -878,6 +1237,205 @@ func (sruo *ScreeningResultUpdateOne) ClearSubAgentVersions() *ScreeningResultUp
 	return sruo
 }
 
+// SetJobHash sets the "job_hash" field.
+func (sruo *ScreeningResultUpdateOne) SetJobHash(s string) *ScreeningResultUpdateOne {
+	sruo.mutation.SetJobHash(s)
+	return sruo
+}
+
+// SetNillableJobHash sets the "job_hash" field if the given value is not nil.
+func (sruo *ScreeningResultUpdateOne) SetNillableJobHash(s *string) *ScreeningResultUpdateOne {
+	if s != nil {
+		sruo.SetJobHash(*s)
+	}
+	return sruo
+}
+
+// ClearJobHash clears the value of the "job_hash" field.
+func (sruo *ScreeningResultUpdateOne) ClearJobHash() *ScreeningResultUpdateOne {
+	sruo.mutation.ClearJobHash()
+	return sruo
+}
+
+// SetResumeHash sets the "resume_hash" field.
+func (sruo *ScreeningResultUpdateOne) SetResumeHash(s string) *ScreeningResultUpdateOne {
+	sruo.mutation.SetResumeHash(s)
+	return sruo
+}
+
+// SetNillableResumeHash sets the "resume_hash" field if the given value is not nil.
+func (sruo *ScreeningResultUpdateOne) SetNillableResumeHash(s *string) *ScreeningResultUpdateOne {
+	if s != nil {
+		sruo.SetResumeHash(*s)
+	}
+	return sruo
+}
+
+// ClearResumeHash clears the value of the "resume_hash" field.
+func (sruo *ScreeningResultUpdateOne) ClearResumeHash() *ScreeningResultUpdateOne {
+	sruo.mutation.ClearResumeHash()
+	return sruo
+}
+
+// SetAgentHash sets the "agent_hash" field.
+func (sruo *ScreeningResultUpdateOne) SetAgentHash(s string) *ScreeningResultUpdateOne {
+	sruo.mutation.SetAgentHash(s)
+	return sruo
+}
+
+// SetNillableAgentHash sets the "agent_hash" field if the given value is not nil.
+func (sruo *ScreeningResultUpdateOne) SetNillableAgentHash(s *string) *ScreeningResultUpdateOne {
+	if s != nil {
+		sruo.SetAgentHash(*s)
+	}
+	return sruo
+}
+
+// ClearAgentHash clears the value of the "agent_hash" field.
+func (sruo *ScreeningResultUpdateOne) ClearAgentHash() *ScreeningResultUpdateOne {
+	sruo.mutation.ClearAgentHash()
+	return sruo
+}
+
+// SetCachedFromID sets the "cached_from_id" field.
+func (sruo *ScreeningResultUpdateOne) SetCachedFromID(u uuid.UUID) *ScreeningResultUpdateOne {
+	sruo.mutation.SetCachedFromID(u)
+	return sruo
+}
+
+// SetNillableCachedFromID sets the "cached_from_id" field if the given value is not nil.
+func (sruo *ScreeningResultUpdateOne) SetNillableCachedFromID(u *uuid.UUID) *ScreeningResultUpdateOne {
+	if u != nil {
+		sruo.SetCachedFromID(*u)
+	}
+	return sruo
+}
+
+// ClearCachedFromID clears the value of the "cached_from_id" field.
+func (sruo *ScreeningResultUpdateOne) ClearCachedFromID() *ScreeningResultUpdateOne {
+	sruo.mutation.ClearCachedFromID()
+	return sruo
+}
+
+// SetHumanOverallScore sets the "human_overall_score" field.
+func (sruo *ScreeningResultUpdateOne) SetHumanOverallScore(f float64) *ScreeningResultUpdateOne {
+	sruo.mutation.ResetHumanOverallScore()
+	sruo.mutation.SetHumanOverallScore(f)
+	return sruo
+}
+
+// SetNillableHumanOverallScore sets the "human_overall_score" field if the given value is not nil.
+func (sruo *ScreeningResultUpdateOne) SetNillableHumanOverallScore(f *float64) *ScreeningResultUpdateOne {
+	if f != nil {
+		sruo.SetHumanOverallScore(*f)
+	}
+	return sruo
+}
+
+// AddHumanOverallScore adds f to the "human_overall_score" field.
+func (sruo *ScreeningResultUpdateOne) AddHumanOverallScore(f float64) *ScreeningResultUpdateOne {
+	sruo.mutation.AddHumanOverallScore(f)
+	return sruo
+}
+
+// ClearHumanOverallScore clears the value of the "human_overall_score" field.
+func (sruo *ScreeningResultUpdateOne) ClearHumanOverallScore() *ScreeningResultUpdateOne {
+	sruo.mutation.ClearHumanOverallScore()
+	return sruo
+}
+
+// SetHumanMatchLevel sets the "human_match_level" field.
+func (sruo *ScreeningResultUpdateOne) SetHumanMatchLevel(sml screeningresult.HumanMatchLevel) *ScreeningResultUpdateOne {
+	sruo.mutation.SetHumanMatchLevel(sml)
+	return sruo
+}
+
+// SetNillableHumanMatchLevel sets the "human_match_level" field if the given value is not nil.
+func (sruo *ScreeningResultUpdateOne) SetNillableHumanMatchLevel(sml *screeningresult.HumanMatchLevel) *ScreeningResultUpdateOne {
+	if sml != nil {
+		sruo.SetHumanMatchLevel(*sml)
+	}
+	return sruo
+}
+
+// ClearHumanMatchLevel clears the value of the "human_match_level" field.
+func (sruo *ScreeningResultUpdateOne) ClearHumanMatchLevel() *ScreeningResultUpdateOne {
+	sruo.mutation.ClearHumanMatchLevel()
+	return sruo
+}
+
+// SetHumanDimensionScores sets the "human_dimension_scores" field.
+func (sruo *ScreeningResultUpdateOne) SetHumanDimensionScores(m map[string]interface{}) *ScreeningResultUpdateOne {
+	sruo.mutation.SetHumanDimensionScores(m)
+	return sruo
+}
+
+// ClearHumanDimensionScores clears the value of the "human_dimension_scores" field.
+func (sruo *ScreeningResultUpdateOne) ClearHumanDimensionScores() *ScreeningResultUpdateOne {
+	sruo.mutation.ClearHumanDimensionScores()
+	return sruo
+}
+
+// SetOverrideReason sets the "override_reason" field.
+func (sruo *ScreeningResultUpdateOne) SetOverrideReason(s string) *ScreeningResultUpdateOne {
+	sruo.mutation.SetOverrideReason(s)
+	return sruo
+}
+
+// SetNillableOverrideReason sets the "override_reason" field if the given value is not nil.
+func (sruo *ScreeningResultUpdateOne) SetNillableOverrideReason(s *string) *ScreeningResultUpdateOne {
+	if s != nil {
+		sruo.SetOverrideReason(*s)
+	}
+	return sruo
+}
+
+// ClearOverrideReason clears the value of the "override_reason" field.
+func (sruo *ScreeningResultUpdateOne) ClearOverrideReason() *ScreeningResultUpdateOne {
+	sruo.mutation.ClearOverrideReason()
+	return sruo
+}
+
+// SetOverriddenBy sets the "overridden_by" field.
+func (sruo *ScreeningResultUpdateOne) SetOverriddenBy(u uuid.UUID) *ScreeningResultUpdateOne {
+	sruo.mutation.SetOverriddenBy(u)
+	return sruo
+}
+
+// SetNillableOverriddenBy sets the "overridden_by" field if the given value is not nil.
+func (sruo *ScreeningResultUpdateOne) SetNillableOverriddenBy(u *uuid.UUID) *ScreeningResultUpdateOne {
+	if u != nil {
+		sruo.SetOverriddenBy(*u)
+	}
+	return sruo
+}
+
+// ClearOverriddenBy clears the value of the "overridden_by" field.
+func (sruo *ScreeningResultUpdateOne) ClearOverriddenBy() *ScreeningResultUpdateOne {
+	sruo.mutation.ClearOverriddenBy()
+	return sruo
+}
+
+// SetOverriddenAt sets the "overridden_at" field.
+func (sruo *ScreeningResultUpdateOne) SetOverriddenAt(t time.Time) *ScreeningResultUpdateOne {
+	sruo.mutation.SetOverriddenAt(t)
+	return sruo
+}
+
+// SetNillableOverriddenAt sets the "overridden_at" field if the given value is not nil.
+func (sruo *ScreeningResultUpdateOne) SetNillableOverriddenAt(t *time.Time) *ScreeningResultUpdateOne {
+	if t != nil {
+		sruo.SetOverriddenAt(*t)
+	}
+	return sruo
+}
+
+// ClearOverriddenAt clears the value of the "overridden_at" field.
+func (sruo *ScreeningResultUpdateOne) ClearOverriddenAt() *ScreeningResultUpdateOne {
+	sruo.mutation.ClearOverriddenAt()
+	return sruo
+}
+
 // SetMatchedAt sets the "matched_at" field.
 func (sruo *ScreeningResultUpdateOne) SetMatchedAt(t time.Time) *ScreeningResultUpdateOne {
 	sruo.mutation.SetMatchedAt(t)
@@ -1003,6 +1561,26 @@ func (sruo *ScreeningResultUpdateOne) check() error {
 			return &ValidationError{Name: "trace_id", err: fmt.Errorf(`db: validator failed for field "ScreeningResult.trace_id": %w`, err)}
 		}
 	}
+	if v, ok := sruo.mutation.JobHash(); ok {
+		if err := screeningresult.JobHashValidator(v); err != nil {
+			return &ValidationError{Name: "job_hash", err: fmt.Errorf(`db: validator failed for field "ScreeningResult.job_hash": %w`, err)}
+		}
+	}
+	if v, ok := sruo.mutation.ResumeHash(); ok {
+		if err := screeningresult.ResumeHashValidator(v); err != nil {
+			return &ValidationError{Name: "resume_hash", err: fmt.Errorf(`db: validator failed for field "ScreeningResult.resume_hash": %w`, err)}
+		}
+	}
+	if v, ok := sruo.mutation.AgentHash(); ok {
+		if err := screeningresult.AgentHashValidator(v); err != nil {
+			return &ValidationError{Name: "agent_hash", err: fmt.Errorf(`db: validator failed for field "ScreeningResult.agent_hash": %w`, err)}
+		}
+	}
+	if v, ok := sruo.mutation.HumanMatchLevel(); ok {
+		if err := screeningresult.HumanMatchLevelValidator(v); err != nil {
+			return &ValidationError{Name: "human_match_level", err: fmt.Errorf(`db: validator failed for field "ScreeningResult.human_match_level": %w`, err)}
+		}
+	}
 	if sruo.mutation.TaskCleared() && len(sruo.mutation.TaskIDs()) > 0 {
 		return errors.New(`db: clearing a required unique edge "ScreeningResult.task"`)
 	}
@@ -1080,6 +1658,12 @@ func (sruo *ScreeningResultUpdateOne) sqlSave(ctx context.Context) (_node *Scree
 	if sruo.mutation.SkillDetailCleared() {
 		_spec.ClearField(screeningresult.FieldSkillDetail, field.TypeJSON)
 	}
+	if value, ok := sruo.mutation.CustomDetails(); ok {
+		_spec.SetField(screeningresult.FieldCustomDetails, field.TypeJSON, value)
+	}
+	if sruo.mutation.CustomDetailsCleared() {
+		_spec.ClearField(screeningresult.FieldCustomDetails, field.TypeJSON)
+	}
 	if value, ok := sruo.mutation.ResponsibilityDetail(); ok {
 		_spec.SetField(screeningresult.FieldResponsibilityDetail, field.TypeJSON, value)
 	}
@@ -1121,6 +1705,17 @@ func (sruo *ScreeningResultUpdateOne) sqlSave(ctx context.Context) (_node *Scree
 	if sruo.mutation.RecommendationsCleared() {
 		_spec.ClearField(screeningresult.FieldRecommendations, field.TypeJSON)
 	}
+	if value, ok := sruo.mutation.KnockoutReasons(); ok {
+		_spec.SetField(screeningresult.FieldKnockoutReasons, field.TypeJSON, value)
+	}
+	if value, ok := sruo.mutation.AppendedKnockoutReasons(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, screeningresult.FieldKnockoutReasons, value)
+		})
+	}
+	if sruo.mutation.KnockoutReasonsCleared() {
+		_spec.ClearField(screeningresult.FieldKnockoutReasons, field.TypeJSON)
+	}
 	if value, ok := sruo.mutation.TraceID(); ok {
 		_spec.SetField(screeningresult.FieldTraceID, field.TypeString, value)
 	}
@@ -1139,6 +1734,69 @@ func (sruo *ScreeningResultUpdateOne) sqlSave(ctx context.Context) (_node *Scree
 	if sruo.mutation.SubAgentVersionsCleared() {
 		_spec.ClearField(screeningresult.FieldSubAgentVersions, field.TypeJSON)
 	}
+	if value, ok := sruo.mutation.JobHash(); ok {
+		_spec.SetField(screeningresult.FieldJobHash, field.TypeString, value)
+	}
+	if sruo.mutation.JobHashCleared() {
+		_spec.ClearField(screeningresult.FieldJobHash, field.TypeString)
+	}
+	if value, ok := sruo.mutation.ResumeHash(); ok {
+		_spec.SetField(screeningresult.FieldResumeHash, field.TypeString, value)
+	}
+	if sruo.mutation.ResumeHashCleared() {
+		_spec.ClearField(screeningresult.FieldResumeHash, field.TypeString)
+	}
+	if value, ok := sruo.mutation.AgentHash(); ok {
+		_spec.SetField(screeningresult.FieldAgentHash, field.TypeString, value)
+	}
+	if sruo.mutation.AgentHashCleared() {
+		_spec.ClearField(screeningresult.FieldAgentHash, field.TypeString)
+	}
+	if value, ok := sruo.mutation.CachedFromID(); ok {
+		_spec.SetField(screeningresult.FieldCachedFromID, field.TypeUUID, value)
+	}
+	if sruo.mutation.CachedFromIDCleared() {
+		_spec.ClearField(screeningresult.FieldCachedFromID, field.TypeUUID)
+	}
+	if value, ok := sruo.mutation.HumanOverallScore(); ok {
+		_spec.SetField(screeningresult.FieldHumanOverallScore, field.TypeFloat64, value)
+	}
+	if value, ok := sruo.mutation.AddedHumanOverallScore(); ok {
+		_spec.AddField(screeningresult.FieldHumanOverallScore, field.TypeFloat64, value)
+	}
+	if sruo.mutation.HumanOverallScoreCleared() {
+		_spec.ClearField(screeningresult.FieldHumanOverallScore, field.TypeFloat64)
+	}
+	if value, ok := sruo.mutation.HumanMatchLevel(); ok {
+		_spec.SetField(screeningresult.FieldHumanMatchLevel, field.TypeEnum, value)
+	}
+	if sruo.mutation.HumanMatchLevelCleared() {
+		_spec.ClearField(screeningresult.FieldHumanMatchLevel, field.TypeEnum)
+	}
+	if value, ok := sruo.mutation.HumanDimensionScores(); ok {
+		_spec.SetField(screeningresult.FieldHumanDimensionScores, field.TypeJSON, value)
+	}
+	if sruo.mutation.HumanDimensionScoresCleared() {
+		_spec.ClearField(screeningresult.FieldHumanDimensionScores, field.TypeJSON)
+	}
+	if value, ok := sruo.mutation.OverrideReason(); ok {
+		_spec.SetField(screeningresult.FieldOverrideReason, field.TypeString, value)
+	}
+	if sruo.mutation.OverrideReasonCleared() {
+		_spec.ClearField(screeningresult.FieldOverrideReason, field.TypeString)
+	}
+	if value, ok := sruo.mutation.OverriddenBy(); ok {
+		_spec.SetField(screeningresult.FieldOverriddenBy, field.TypeUUID, value)
+	}
+	if sruo.mutation.OverriddenByCleared() {
+		_spec.ClearField(screeningresult.FieldOverriddenBy, field.TypeUUID)
+	}
+	if value, ok := sruo.mutation.OverriddenAt(); ok {
+		_spec.SetField(screeningresult.FieldOverriddenAt, field.TypeTime, value)
+	}
+	if sruo.mutation.OverriddenAtCleared() {
+		_spec.ClearField(screeningresult.FieldOverriddenAt, field.TypeTime)
+	}
 	if value, ok := sruo.mutation.MatchedAt(); ok {
 		_spec.SetField(screeningresult.FieldMatchedAt, field.TypeTime, value)
 	}
@@ -0,0 +1,277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db/jobposition"
+	"github.com/chaitin/WhaleHire/backend/db/screeningschedule"
+	"github.com/google/uuid"
+)
+
+// ScreeningSchedule is the model entity for the ScreeningSchedule schema.
+type ScreeningSchedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// 岗位ID，每个岗位一条配置
+	JobPositionID uuid.UUID `json:"job_position_id,omitempty"`
+	// 自动筛选模式：instant/digest
+	Mode consts.ScreeningScheduleMode `json:"mode,omitempty"`
+	// 岗位默认权重模板ID，为空时使用系统默认权重
+	WeightTemplateID *uuid.UUID `json:"weight_template_id,omitempty"`
+	// 自动发起任务使用的LLM配置，为空时使用系统默认配置
+	LlmConfig map[string]interface{} `json:"llm_config,omitempty"`
+	// 自动发起任务使用的硬性淘汰条件
+	KnockoutRules map[string]interface{} `json:"knockout_rules,omitempty"`
+	// 自动发起的任务是否为盲筛模式
+	BlindMode bool `json:"blind_mode,omitempty"`
+	// 每日汇总筛选的执行时刻（0-23 时）
+	DigestHour int `json:"digest_hour,omitempty"`
+	// 是否启用
+	Enabled bool `json:"enabled,omitempty"`
+	// 创建者ID，自动发起的筛选任务归属该用户
+	CreatedBy uuid.UUID `json:"created_by,omitempty"`
+	// 最近一次执行时间
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScreeningScheduleQuery when eager-loading is set.
+	Edges        ScreeningScheduleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScreeningScheduleEdges holds the relations/edges for other nodes in the graph.
+type ScreeningScheduleEdges struct {
+	// JobPosition holds the value of the job_position edge.
+	JobPosition *JobPosition `json:"job_position,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// JobPositionOrErr returns the JobPosition value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScreeningScheduleEdges) JobPositionOrErr() (*JobPosition, error) {
+	if e.JobPosition != nil {
+		return e.JobPosition, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: jobposition.Label}
+	}
+	return nil, &NotLoadedError{edge: "job_position"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScreeningSchedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case screeningschedule.FieldWeightTemplateID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case screeningschedule.FieldLlmConfig, screeningschedule.FieldKnockoutRules:
+			values[i] = new([]byte)
+		case screeningschedule.FieldBlindMode, screeningschedule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case screeningschedule.FieldDigestHour:
+			values[i] = new(sql.NullInt64)
+		case screeningschedule.FieldMode:
+			values[i] = new(sql.NullString)
+		case screeningschedule.FieldLastRunAt, screeningschedule.FieldCreatedAt, screeningschedule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case screeningschedule.FieldID, screeningschedule.FieldJobPositionID, screeningschedule.FieldCreatedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScreeningSchedule fields.
+func (ss *ScreeningSchedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case screeningschedule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ss.ID = *value
+			}
+		case screeningschedule.FieldJobPositionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field job_position_id", values[i])
+			} else if value != nil {
+				ss.JobPositionID = *value
+			}
+		case screeningschedule.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				ss.Mode = consts.ScreeningScheduleMode(value.String)
+			}
+		case screeningschedule.FieldWeightTemplateID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field weight_template_id", values[i])
+			} else if value.Valid {
+				ss.WeightTemplateID = new(uuid.UUID)
+				*ss.WeightTemplateID = *value.S.(*uuid.UUID)
+			}
+		case screeningschedule.FieldLlmConfig:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field llm_config", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ss.LlmConfig); err != nil {
+					return fmt.Errorf("unmarshal field llm_config: %w", err)
+				}
+			}
+		case screeningschedule.FieldKnockoutRules:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field knockout_rules", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ss.KnockoutRules); err != nil {
+					return fmt.Errorf("unmarshal field knockout_rules: %w", err)
+				}
+			}
+		case screeningschedule.FieldBlindMode:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field blind_mode", values[i])
+			} else if value.Valid {
+				ss.BlindMode = value.Bool
+			}
+		case screeningschedule.FieldDigestHour:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field digest_hour", values[i])
+			} else if value.Valid {
+				ss.DigestHour = int(value.Int64)
+			}
+		case screeningschedule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				ss.Enabled = value.Bool
+			}
+		case screeningschedule.FieldCreatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value != nil {
+				ss.CreatedBy = *value
+			}
+		case screeningschedule.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				ss.LastRunAt = new(time.Time)
+				*ss.LastRunAt = value.Time
+			}
+		case screeningschedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ss.CreatedAt = value.Time
+			}
+		case screeningschedule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ss.UpdatedAt = value.Time
+			}
+		default:
+			ss.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScreeningSchedule.
+// This includes values selected through modifiers, order, etc.
+func (ss *ScreeningSchedule) Value(name string) (ent.Value, error) {
+	return ss.selectValues.Get(name)
+}
+
+// QueryJobPosition queries the "job_position" edge of the ScreeningSchedule entity.
+func (ss *ScreeningSchedule) QueryJobPosition() *JobPositionQuery {
+	return NewScreeningScheduleClient(ss.config).QueryJobPosition(ss)
+}
+
+// Update returns a builder for updating this ScreeningSchedule.
+// Note that you need to call ScreeningSchedule.Unwrap() before calling this method if this ScreeningSchedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ss *ScreeningSchedule) Update() *ScreeningScheduleUpdateOne {
+	return NewScreeningScheduleClient(ss.config).UpdateOne(ss)
+}
+
+// Unwrap unwraps the ScreeningSchedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ss *ScreeningSchedule) Unwrap() *ScreeningSchedule {
+	_tx, ok := ss.config.driver.(*txDriver)
+	if !ok {
+		panic("db: ScreeningSchedule is not a transactional entity")
+	}
+	ss.config.driver = _tx.drv
+	return ss
+}
+
+// String implements the fmt.Stringer.
+func (ss *ScreeningSchedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScreeningSchedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ss.ID))
+	builder.WriteString("job_position_id=")
+	builder.WriteString(fmt.Sprintf("%v", ss.JobPositionID))
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(fmt.Sprintf("%v", ss.Mode))
+	builder.WriteString(", ")
+	if v := ss.WeightTemplateID; v != nil {
+		builder.WriteString("weight_template_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("llm_config=")
+	builder.WriteString(fmt.Sprintf("%v", ss.LlmConfig))
+	builder.WriteString(", ")
+	builder.WriteString("knockout_rules=")
+	builder.WriteString(fmt.Sprintf("%v", ss.KnockoutRules))
+	builder.WriteString(", ")
+	builder.WriteString("blind_mode=")
+	builder.WriteString(fmt.Sprintf("%v", ss.BlindMode))
+	builder.WriteString(", ")
+	builder.WriteString("digest_hour=")
+	builder.WriteString(fmt.Sprintf("%v", ss.DigestHour))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", ss.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", ss.CreatedBy))
+	builder.WriteString(", ")
+	if v := ss.LastRunAt; v != nil {
+		builder.WriteString("last_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ss.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ss.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScreeningSchedules is a parsable slice of ScreeningSchedule.
+type ScreeningSchedules []*ScreeningSchedule
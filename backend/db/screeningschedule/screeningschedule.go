@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package screeningschedule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the screeningschedule type in the database.
+	Label = "screening_schedule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldJobPositionID holds the string denoting the job_position_id field in the database.
+	FieldJobPositionID = "job_position_id"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldWeightTemplateID holds the string denoting the weight_template_id field in the database.
+	FieldWeightTemplateID = "weight_template_id"
+	// FieldLlmConfig holds the string denoting the llm_config field in the database.
+	FieldLlmConfig = "llm_config"
+	// FieldKnockoutRules holds the string denoting the knockout_rules field in the database.
+	FieldKnockoutRules = "knockout_rules"
+	// FieldBlindMode holds the string denoting the blind_mode field in the database.
+	FieldBlindMode = "blind_mode"
+	// FieldDigestHour holds the string denoting the digest_hour field in the database.
+	FieldDigestHour = "digest_hour"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldLastRunAt holds the string denoting the last_run_at field in the database.
+	FieldLastRunAt = "last_run_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeJobPosition holds the string denoting the job_position edge name in mutations.
+	EdgeJobPosition = "job_position"
+	// Table holds the table name of the screeningschedule in the database.
+	Table = "screening_schedules"
+	// JobPositionTable is the table that holds the job_position relation/edge.
+	JobPositionTable = "screening_schedules"
+	// JobPositionInverseTable is the table name for the JobPosition entity.
+	// It exists in this package in order to avoid circular dependency with the "jobposition" package.
+	JobPositionInverseTable = "job_position"
+	// JobPositionColumn is the table column denoting the job_position relation/edge.
+	JobPositionColumn = "job_position_id"
+)
+
+// Columns holds all SQL columns for screeningschedule fields.
+var Columns = []string{
+	FieldID,
+	FieldJobPositionID,
+	FieldMode,
+	FieldWeightTemplateID,
+	FieldLlmConfig,
+	FieldKnockoutRules,
+	FieldBlindMode,
+	FieldDigestHour,
+	FieldEnabled,
+	FieldCreatedBy,
+	FieldLastRunAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultMode holds the default value on creation for the "mode" field.
+	DefaultMode consts.ScreeningScheduleMode
+	// DefaultBlindMode holds the default value on creation for the "blind_mode" field.
+	DefaultBlindMode bool
+	// DefaultDigestHour holds the default value on creation for the "digest_hour" field.
+	DefaultDigestHour int
+	// DigestHourValidator is a validator for the "digest_hour" field. It is called by the builders before save.
+	DigestHourValidator func(int) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ScreeningSchedule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByJobPositionID orders the results by the job_position_id field.
+func ByJobPositionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobPositionID, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByWeightTemplateID orders the results by the weight_template_id field.
+func ByWeightTemplateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeightTemplateID, opts...).ToFunc()
+}
+
+// ByBlindMode orders the results by the blind_mode field.
+func ByBlindMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlindMode, opts...).ToFunc()
+}
+
+// ByDigestHour orders the results by the digest_hour field.
+func ByDigestHour(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDigestHour, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByLastRunAt orders the results by the last_run_at field.
+func ByLastRunAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastRunAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByJobPositionField orders the results by job_position field.
+func ByJobPositionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJobPositionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newJobPositionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JobPositionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, JobPositionTable, JobPositionColumn),
+	)
+}
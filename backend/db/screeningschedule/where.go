@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package screeningschedule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldLTE(FieldID, id))
+}
+
+// JobPositionID applies equality check predicate on the "job_position_id" field. It's identical to JobPositionIDEQ.
+func JobPositionID(v uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldJobPositionID, v))
+}
+
+// Mode applies equality check predicate on the "mode" field. It's identical to ModeEQ.
+func Mode(v consts.ScreeningScheduleMode) predicate.ScreeningSchedule {
+	vc := string(v)
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldMode, vc))
+}
+
+// WeightTemplateID applies equality check predicate on the "weight_template_id" field. It's identical to WeightTemplateIDEQ.
+func WeightTemplateID(v uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldWeightTemplateID, v))
+}
+
+// BlindMode applies equality check predicate on the "blind_mode" field. It's identical to BlindModeEQ.
+func BlindMode(v bool) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldBlindMode, v))
+}
+
+// DigestHour applies equality check predicate on the "digest_hour" field. It's identical to DigestHourEQ.
+func DigestHour(v int) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldDigestHour, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// LastRunAt applies equality check predicate on the "last_run_at" field. It's identical to LastRunAtEQ.
+func LastRunAt(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// JobPositionIDEQ applies the EQ predicate on the "job_position_id" field.
+func JobPositionIDEQ(v uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldJobPositionID, v))
+}
+
+// JobPositionIDNEQ applies the NEQ predicate on the "job_position_id" field.
+func JobPositionIDNEQ(v uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNEQ(FieldJobPositionID, v))
+}
+
+// JobPositionIDIn applies the In predicate on the "job_position_id" field.
+func JobPositionIDIn(vs ...uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldIn(FieldJobPositionID, vs...))
+}
+
+// JobPositionIDNotIn applies the NotIn predicate on the "job_position_id" field.
+func JobPositionIDNotIn(vs ...uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNotIn(FieldJobPositionID, vs...))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v consts.ScreeningScheduleMode) predicate.ScreeningSchedule {
+	vc := string(v)
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldMode, vc))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v consts.ScreeningScheduleMode) predicate.ScreeningSchedule {
+	vc := string(v)
+	return predicate.ScreeningSchedule(sql.FieldNEQ(FieldMode, vc))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...consts.ScreeningScheduleMode) predicate.ScreeningSchedule {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.ScreeningSchedule(sql.FieldIn(FieldMode, v...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...consts.ScreeningScheduleMode) predicate.ScreeningSchedule {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.ScreeningSchedule(sql.FieldNotIn(FieldMode, v...))
+}
+
+// ModeGT applies the GT predicate on the "mode" field.
+func ModeGT(v consts.ScreeningScheduleMode) predicate.ScreeningSchedule {
+	vc := string(v)
+	return predicate.ScreeningSchedule(sql.FieldGT(FieldMode, vc))
+}
+
+// ModeGTE applies the GTE predicate on the "mode" field.
+func ModeGTE(v consts.ScreeningScheduleMode) predicate.ScreeningSchedule {
+	vc := string(v)
+	return predicate.ScreeningSchedule(sql.FieldGTE(FieldMode, vc))
+}
+
+// ModeLT applies the LT predicate on the "mode" field.
+func ModeLT(v consts.ScreeningScheduleMode) predicate.ScreeningSchedule {
+	vc := string(v)
+	return predicate.ScreeningSchedule(sql.FieldLT(FieldMode, vc))
+}
+
+// ModeLTE applies the LTE predicate on the "mode" field.
+func ModeLTE(v consts.ScreeningScheduleMode) predicate.ScreeningSchedule {
+	vc := string(v)
+	return predicate.ScreeningSchedule(sql.FieldLTE(FieldMode, vc))
+}
+
+// ModeContains applies the Contains predicate on the "mode" field.
+func ModeContains(v consts.ScreeningScheduleMode) predicate.ScreeningSchedule {
+	vc := string(v)
+	return predicate.ScreeningSchedule(sql.FieldContains(FieldMode, vc))
+}
+
+// ModeHasPrefix applies the HasPrefix predicate on the "mode" field.
+func ModeHasPrefix(v consts.ScreeningScheduleMode) predicate.ScreeningSchedule {
+	vc := string(v)
+	return predicate.ScreeningSchedule(sql.FieldHasPrefix(FieldMode, vc))
+}
+
+// ModeHasSuffix applies the HasSuffix predicate on the "mode" field.
+func ModeHasSuffix(v consts.ScreeningScheduleMode) predicate.ScreeningSchedule {
+	vc := string(v)
+	return predicate.ScreeningSchedule(sql.FieldHasSuffix(FieldMode, vc))
+}
+
+// ModeEqualFold applies the EqualFold predicate on the "mode" field.
+func ModeEqualFold(v consts.ScreeningScheduleMode) predicate.ScreeningSchedule {
+	vc := string(v)
+	return predicate.ScreeningSchedule(sql.FieldEqualFold(FieldMode, vc))
+}
+
+// ModeContainsFold applies the ContainsFold predicate on the "mode" field.
+func ModeContainsFold(v consts.ScreeningScheduleMode) predicate.ScreeningSchedule {
+	vc := string(v)
+	return predicate.ScreeningSchedule(sql.FieldContainsFold(FieldMode, vc))
+}
+
+// WeightTemplateIDEQ applies the EQ predicate on the "weight_template_id" field.
+func WeightTemplateIDEQ(v uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldWeightTemplateID, v))
+}
+
+// WeightTemplateIDNEQ applies the NEQ predicate on the "weight_template_id" field.
+func WeightTemplateIDNEQ(v uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNEQ(FieldWeightTemplateID, v))
+}
+
+// WeightTemplateIDIn applies the In predicate on the "weight_template_id" field.
+func WeightTemplateIDIn(vs ...uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldIn(FieldWeightTemplateID, vs...))
+}
+
+// WeightTemplateIDNotIn applies the NotIn predicate on the "weight_template_id" field.
+func WeightTemplateIDNotIn(vs ...uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNotIn(FieldWeightTemplateID, vs...))
+}
+
+// WeightTemplateIDGT applies the GT predicate on the "weight_template_id" field.
+func WeightTemplateIDGT(v uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldGT(FieldWeightTemplateID, v))
+}
+
+// WeightTemplateIDGTE applies the GTE predicate on the "weight_template_id" field.
+func WeightTemplateIDGTE(v uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldGTE(FieldWeightTemplateID, v))
+}
+
+// WeightTemplateIDLT applies the LT predicate on the "weight_template_id" field.
+func WeightTemplateIDLT(v uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldLT(FieldWeightTemplateID, v))
+}
+
+// WeightTemplateIDLTE applies the LTE predicate on the "weight_template_id" field.
+func WeightTemplateIDLTE(v uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldLTE(FieldWeightTemplateID, v))
+}
+
+// WeightTemplateIDIsNil applies the IsNil predicate on the "weight_template_id" field.
+func WeightTemplateIDIsNil() predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldIsNull(FieldWeightTemplateID))
+}
+
+// WeightTemplateIDNotNil applies the NotNil predicate on the "weight_template_id" field.
+func WeightTemplateIDNotNil() predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNotNull(FieldWeightTemplateID))
+}
+
+// LlmConfigIsNil applies the IsNil predicate on the "llm_config" field.
+func LlmConfigIsNil() predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldIsNull(FieldLlmConfig))
+}
+
+// LlmConfigNotNil applies the NotNil predicate on the "llm_config" field.
+func LlmConfigNotNil() predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNotNull(FieldLlmConfig))
+}
+
+// KnockoutRulesIsNil applies the IsNil predicate on the "knockout_rules" field.
+func KnockoutRulesIsNil() predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldIsNull(FieldKnockoutRules))
+}
+
+// KnockoutRulesNotNil applies the NotNil predicate on the "knockout_rules" field.
+func KnockoutRulesNotNil() predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNotNull(FieldKnockoutRules))
+}
+
+// BlindModeEQ applies the EQ predicate on the "blind_mode" field.
+func BlindModeEQ(v bool) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldBlindMode, v))
+}
+
+// BlindModeNEQ applies the NEQ predicate on the "blind_mode" field.
+func BlindModeNEQ(v bool) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNEQ(FieldBlindMode, v))
+}
+
+// DigestHourEQ applies the EQ predicate on the "digest_hour" field.
+func DigestHourEQ(v int) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldDigestHour, v))
+}
+
+// DigestHourNEQ applies the NEQ predicate on the "digest_hour" field.
+func DigestHourNEQ(v int) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNEQ(FieldDigestHour, v))
+}
+
+// DigestHourIn applies the In predicate on the "digest_hour" field.
+func DigestHourIn(vs ...int) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldIn(FieldDigestHour, vs...))
+}
+
+// DigestHourNotIn applies the NotIn predicate on the "digest_hour" field.
+func DigestHourNotIn(vs ...int) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNotIn(FieldDigestHour, vs...))
+}
+
+// DigestHourGT applies the GT predicate on the "digest_hour" field.
+func DigestHourGT(v int) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldGT(FieldDigestHour, v))
+}
+
+// DigestHourGTE applies the GTE predicate on the "digest_hour" field.
+func DigestHourGTE(v int) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldGTE(FieldDigestHour, v))
+}
+
+// DigestHourLT applies the LT predicate on the "digest_hour" field.
+func DigestHourLT(v int) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldLT(FieldDigestHour, v))
+}
+
+// DigestHourLTE applies the LTE predicate on the "digest_hour" field.
+func DigestHourLTE(v int) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldLTE(FieldDigestHour, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uuid.UUID) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// LastRunAtEQ applies the EQ predicate on the "last_run_at" field.
+func LastRunAtEQ(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtNEQ applies the NEQ predicate on the "last_run_at" field.
+func LastRunAtNEQ(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtIn applies the In predicate on the "last_run_at" field.
+func LastRunAtIn(vs ...time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtNotIn applies the NotIn predicate on the "last_run_at" field.
+func LastRunAtNotIn(vs ...time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNotIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtGT applies the GT predicate on the "last_run_at" field.
+func LastRunAtGT(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldGT(FieldLastRunAt, v))
+}
+
+// LastRunAtGTE applies the GTE predicate on the "last_run_at" field.
+func LastRunAtGTE(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldGTE(FieldLastRunAt, v))
+}
+
+// LastRunAtLT applies the LT predicate on the "last_run_at" field.
+func LastRunAtLT(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldLT(FieldLastRunAt, v))
+}
+
+// LastRunAtLTE applies the LTE predicate on the "last_run_at" field.
+func LastRunAtLTE(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldLTE(FieldLastRunAt, v))
+}
+
+// LastRunAtIsNil applies the IsNil predicate on the "last_run_at" field.
+func LastRunAtIsNil() predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldIsNull(FieldLastRunAt))
+}
+
+// LastRunAtNotNil applies the NotNil predicate on the "last_run_at" field.
+func LastRunAtNotNil() predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNotNull(FieldLastRunAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasJobPosition applies the HasEdge predicate on the "job_position" edge.
+func HasJobPosition() predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, JobPositionTable, JobPositionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJobPositionWith applies the HasEdge predicate on the "job_position" edge with a given conditions (other predicates).
+func HasJobPositionWith(preds ...predicate.JobPosition) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(func(s *sql.Selector) {
+		step := newJobPositionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScreeningSchedule) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScreeningSchedule) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScreeningSchedule) predicate.ScreeningSchedule {
+	return predicate.ScreeningSchedule(sql.NotPredicates(p))
+}
@@ -0,0 +1,1262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db/jobposition"
+	"github.com/chaitin/WhaleHire/backend/db/screeningschedule"
+	"github.com/google/uuid"
+)
+
+// ScreeningScheduleCreate is the builder for creating a ScreeningSchedule entity.
+type ScreeningScheduleCreate struct {
+	config
+	mutation *ScreeningScheduleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetJobPositionID sets the "job_position_id" field.
+func (ssc *ScreeningScheduleCreate) SetJobPositionID(u uuid.UUID) *ScreeningScheduleCreate {
+	ssc.mutation.SetJobPositionID(u)
+	return ssc
+}
+
+// SetMode sets the "mode" field.
+func (ssc *ScreeningScheduleCreate) SetMode(csm consts.ScreeningScheduleMode) *ScreeningScheduleCreate {
+	ssc.mutation.SetMode(csm)
+	return ssc
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (ssc *ScreeningScheduleCreate) SetNillableMode(csm *consts.ScreeningScheduleMode) *ScreeningScheduleCreate {
+	if csm != nil {
+		ssc.SetMode(*csm)
+	}
+	return ssc
+}
+
+// SetWeightTemplateID sets the "weight_template_id" field.
+func (ssc *ScreeningScheduleCreate) SetWeightTemplateID(u uuid.UUID) *ScreeningScheduleCreate {
+	ssc.mutation.SetWeightTemplateID(u)
+	return ssc
+}
+
+// SetNillableWeightTemplateID sets the "weight_template_id" field if the given value is not nil.
+func (ssc *ScreeningScheduleCreate) SetNillableWeightTemplateID(u *uuid.UUID) *ScreeningScheduleCreate {
+	if u != nil {
+		ssc.SetWeightTemplateID(*u)
+	}
+	return ssc
+}
+
+// SetLlmConfig sets the "llm_config" field.
+func (ssc *ScreeningScheduleCreate) SetLlmConfig(m map[string]interface{}) *ScreeningScheduleCreate {
+	ssc.mutation.SetLlmConfig(m)
+	return ssc
+}
+
+// SetKnockoutRules sets the "knockout_rules" field.
+func (ssc *ScreeningScheduleCreate) SetKnockoutRules(m map[string]interface{}) *ScreeningScheduleCreate {
+	ssc.mutation.SetKnockoutRules(m)
+	return ssc
+}
+
+// SetBlindMode sets the "blind_mode" field.
+func (ssc *ScreeningScheduleCreate) SetBlindMode(b bool) *ScreeningScheduleCreate {
+	ssc.mutation.SetBlindMode(b)
+	return ssc
+}
+
+// SetNillableBlindMode sets the "blind_mode" field if the given value is not nil.
+func (ssc *ScreeningScheduleCreate) SetNillableBlindMode(b *bool) *ScreeningScheduleCreate {
+	if b != nil {
+		ssc.SetBlindMode(*b)
+	}
+	return ssc
+}
+
+// SetDigestHour sets the "digest_hour" field.
+func (ssc *ScreeningScheduleCreate) SetDigestHour(i int) *ScreeningScheduleCreate {
+	ssc.mutation.SetDigestHour(i)
+	return ssc
+}
+
+// SetNillableDigestHour sets the "digest_hour" field if the given value is not nil.
+func (ssc *ScreeningScheduleCreate) SetNillableDigestHour(i *int) *ScreeningScheduleCreate {
+	if i != nil {
+		ssc.SetDigestHour(*i)
+	}
+	return ssc
+}
+
+// SetEnabled sets the "enabled" field.
+func (ssc *ScreeningScheduleCreate) SetEnabled(b bool) *ScreeningScheduleCreate {
+	ssc.mutation.SetEnabled(b)
+	return ssc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (ssc *ScreeningScheduleCreate) SetNillableEnabled(b *bool) *ScreeningScheduleCreate {
+	if b != nil {
+		ssc.SetEnabled(*b)
+	}
+	return ssc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ssc *ScreeningScheduleCreate) SetCreatedBy(u uuid.UUID) *ScreeningScheduleCreate {
+	ssc.mutation.SetCreatedBy(u)
+	return ssc
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (ssc *ScreeningScheduleCreate) SetLastRunAt(t time.Time) *ScreeningScheduleCreate {
+	ssc.mutation.SetLastRunAt(t)
+	return ssc
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (ssc *ScreeningScheduleCreate) SetNillableLastRunAt(t *time.Time) *ScreeningScheduleCreate {
+	if t != nil {
+		ssc.SetLastRunAt(*t)
+	}
+	return ssc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ssc *ScreeningScheduleCreate) SetCreatedAt(t time.Time) *ScreeningScheduleCreate {
+	ssc.mutation.SetCreatedAt(t)
+	return ssc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ssc *ScreeningScheduleCreate) SetNillableCreatedAt(t *time.Time) *ScreeningScheduleCreate {
+	if t != nil {
+		ssc.SetCreatedAt(*t)
+	}
+	return ssc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ssc *ScreeningScheduleCreate) SetUpdatedAt(t time.Time) *ScreeningScheduleCreate {
+	ssc.mutation.SetUpdatedAt(t)
+	return ssc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ssc *ScreeningScheduleCreate) SetNillableUpdatedAt(t *time.Time) *ScreeningScheduleCreate {
+	if t != nil {
+		ssc.SetUpdatedAt(*t)
+	}
+	return ssc
+}
+
+// SetID sets the "id" field.
+func (ssc *ScreeningScheduleCreate) SetID(u uuid.UUID) *ScreeningScheduleCreate {
+	ssc.mutation.SetID(u)
+	return ssc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ssc *ScreeningScheduleCreate) SetNillableID(u *uuid.UUID) *ScreeningScheduleCreate {
+	if u != nil {
+		ssc.SetID(*u)
+	}
+	return ssc
+}
+
+// SetJobPosition sets the "job_position" edge to the JobPosition entity.
+func (ssc *ScreeningScheduleCreate) SetJobPosition(j *JobPosition) *ScreeningScheduleCreate {
+	return ssc.SetJobPositionID(j.ID)
+}
+
+// Mutation returns the ScreeningScheduleMutation object of the builder.
+func (ssc *ScreeningScheduleCreate) Mutation() *ScreeningScheduleMutation {
+	return ssc.mutation
+}
+
+// Save creates the ScreeningSchedule in the database.
+func (ssc *ScreeningScheduleCreate) Save(ctx context.Context) (*ScreeningSchedule, error) {
+	ssc.defaults()
+	return withHooks(ctx, ssc.sqlSave, ssc.mutation, ssc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ssc *ScreeningScheduleCreate) SaveX(ctx context.Context) *ScreeningSchedule {
+	v, err := ssc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ssc *ScreeningScheduleCreate) Exec(ctx context.Context) error {
+	_, err := ssc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssc *ScreeningScheduleCreate) ExecX(ctx context.Context) {
+	if err := ssc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssc *ScreeningScheduleCreate) defaults() {
+	if _, ok := ssc.mutation.Mode(); !ok {
+		v := screeningschedule.DefaultMode
+		ssc.mutation.SetMode(v)
+	}
+	if _, ok := ssc.mutation.BlindMode(); !ok {
+		v := screeningschedule.DefaultBlindMode
+		ssc.mutation.SetBlindMode(v)
+	}
+	if _, ok := ssc.mutation.DigestHour(); !ok {
+		v := screeningschedule.DefaultDigestHour
+		ssc.mutation.SetDigestHour(v)
+	}
+	if _, ok := ssc.mutation.Enabled(); !ok {
+		v := screeningschedule.DefaultEnabled
+		ssc.mutation.SetEnabled(v)
+	}
+	if _, ok := ssc.mutation.CreatedAt(); !ok {
+		v := screeningschedule.DefaultCreatedAt()
+		ssc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ssc.mutation.UpdatedAt(); !ok {
+		v := screeningschedule.DefaultUpdatedAt()
+		ssc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ssc.mutation.ID(); !ok {
+		v := screeningschedule.DefaultID()
+		ssc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssc *ScreeningScheduleCreate) check() error {
+	if _, ok := ssc.mutation.JobPositionID(); !ok {
+		return &ValidationError{Name: "job_position_id", err: errors.New(`db: missing required field "ScreeningSchedule.job_position_id"`)}
+	}
+	if _, ok := ssc.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`db: missing required field "ScreeningSchedule.mode"`)}
+	}
+	if _, ok := ssc.mutation.BlindMode(); !ok {
+		return &ValidationError{Name: "blind_mode", err: errors.New(`db: missing required field "ScreeningSchedule.blind_mode"`)}
+	}
+	if _, ok := ssc.mutation.DigestHour(); !ok {
+		return &ValidationError{Name: "digest_hour", err: errors.New(`db: missing required field "ScreeningSchedule.digest_hour"`)}
+	}
+	if v, ok := ssc.mutation.DigestHour(); ok {
+		if err := screeningschedule.DigestHourValidator(v); err != nil {
+			return &ValidationError{Name: "digest_hour", err: fmt.Errorf(`db: validator failed for field "ScreeningSchedule.digest_hour": %w`, err)}
+		}
+	}
+	if _, ok := ssc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`db: missing required field "ScreeningSchedule.enabled"`)}
+	}
+	if _, ok := ssc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`db: missing required field "ScreeningSchedule.created_by"`)}
+	}
+	if _, ok := ssc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "ScreeningSchedule.created_at"`)}
+	}
+	if _, ok := ssc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "ScreeningSchedule.updated_at"`)}
+	}
+	if len(ssc.mutation.JobPositionIDs()) == 0 {
+		return &ValidationError{Name: "job_position", err: errors.New(`db: missing required edge "ScreeningSchedule.job_position"`)}
+	}
+	return nil
+}
+
+func (ssc *ScreeningScheduleCreate) sqlSave(ctx context.Context) (*ScreeningSchedule, error) {
+	if err := ssc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ssc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ssc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ssc.mutation.id = &_node.ID
+	ssc.mutation.done = true
+	return _node, nil
+}
+
+func (ssc *ScreeningScheduleCreate) createSpec() (*ScreeningSchedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScreeningSchedule{config: ssc.config}
+		_spec = sqlgraph.NewCreateSpec(screeningschedule.Table, sqlgraph.NewFieldSpec(screeningschedule.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = ssc.conflict
+	if id, ok := ssc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ssc.mutation.Mode(); ok {
+		_spec.SetField(screeningschedule.FieldMode, field.TypeString, value)
+		_node.Mode = value
+	}
+	if value, ok := ssc.mutation.WeightTemplateID(); ok {
+		_spec.SetField(screeningschedule.FieldWeightTemplateID, field.TypeUUID, value)
+		_node.WeightTemplateID = &value
+	}
+	if value, ok := ssc.mutation.LlmConfig(); ok {
+		_spec.SetField(screeningschedule.FieldLlmConfig, field.TypeJSON, value)
+		_node.LlmConfig = value
+	}
+	if value, ok := ssc.mutation.KnockoutRules(); ok {
+		_spec.SetField(screeningschedule.FieldKnockoutRules, field.TypeJSON, value)
+		_node.KnockoutRules = value
+	}
+	if value, ok := ssc.mutation.BlindMode(); ok {
+		_spec.SetField(screeningschedule.FieldBlindMode, field.TypeBool, value)
+		_node.BlindMode = value
+	}
+	if value, ok := ssc.mutation.DigestHour(); ok {
+		_spec.SetField(screeningschedule.FieldDigestHour, field.TypeInt, value)
+		_node.DigestHour = value
+	}
+	if value, ok := ssc.mutation.Enabled(); ok {
+		_spec.SetField(screeningschedule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := ssc.mutation.CreatedBy(); ok {
+		_spec.SetField(screeningschedule.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := ssc.mutation.LastRunAt(); ok {
+		_spec.SetField(screeningschedule.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := ssc.mutation.CreatedAt(); ok {
+		_spec.SetField(screeningschedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ssc.mutation.UpdatedAt(); ok {
+		_spec.SetField(screeningschedule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := ssc.mutation.JobPositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   screeningschedule.JobPositionTable,
+			Columns: []string{screeningschedule.JobPositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobposition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.JobPositionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScreeningSchedule.Create().
+//		SetJobPositionID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScreeningScheduleUpsert) {
+//			SetJobPositionID(v+v).
+//		}).
+//		Exec(ctx)
+func (ssc *ScreeningScheduleCreate) OnConflict(opts ...sql.ConflictOption) *ScreeningScheduleUpsertOne {
+	ssc.conflict = opts
+	return &ScreeningScheduleUpsertOne{
+		create: ssc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScreeningSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ssc *ScreeningScheduleCreate) OnConflictColumns(columns ...string) *ScreeningScheduleUpsertOne {
+	ssc.conflict = append(ssc.conflict, sql.ConflictColumns(columns...))
+	return &ScreeningScheduleUpsertOne{
+		create: ssc,
+	}
+}
+
+type (
+	// ScreeningScheduleUpsertOne is the builder for "upsert"-ing
+	//  one ScreeningSchedule node.
+	ScreeningScheduleUpsertOne struct {
+		create *ScreeningScheduleCreate
+	}
+
+	// ScreeningScheduleUpsert is the "OnConflict" setter.
+	ScreeningScheduleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetJobPositionID sets the "job_position_id" field.
+func (u *ScreeningScheduleUpsert) SetJobPositionID(v uuid.UUID) *ScreeningScheduleUpsert {
+	u.Set(screeningschedule.FieldJobPositionID, v)
+	return u
+}
+
+// UpdateJobPositionID sets the "job_position_id" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsert) UpdateJobPositionID() *ScreeningScheduleUpsert {
+	u.SetExcluded(screeningschedule.FieldJobPositionID)
+	return u
+}
+
+// SetMode sets the "mode" field.
+func (u *ScreeningScheduleUpsert) SetMode(v consts.ScreeningScheduleMode) *ScreeningScheduleUpsert {
+	u.Set(screeningschedule.FieldMode, v)
+	return u
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsert) UpdateMode() *ScreeningScheduleUpsert {
+	u.SetExcluded(screeningschedule.FieldMode)
+	return u
+}
+
+// SetWeightTemplateID sets the "weight_template_id" field.
+func (u *ScreeningScheduleUpsert) SetWeightTemplateID(v uuid.UUID) *ScreeningScheduleUpsert {
+	u.Set(screeningschedule.FieldWeightTemplateID, v)
+	return u
+}
+
+// UpdateWeightTemplateID sets the "weight_template_id" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsert) UpdateWeightTemplateID() *ScreeningScheduleUpsert {
+	u.SetExcluded(screeningschedule.FieldWeightTemplateID)
+	return u
+}
+
+// ClearWeightTemplateID clears the value of the "weight_template_id" field.
+func (u *ScreeningScheduleUpsert) ClearWeightTemplateID() *ScreeningScheduleUpsert {
+	u.SetNull(screeningschedule.FieldWeightTemplateID)
+	return u
+}
+
+// SetLlmConfig sets the "llm_config" field.
+func (u *ScreeningScheduleUpsert) SetLlmConfig(v map[string]interface{}) *ScreeningScheduleUpsert {
+	u.Set(screeningschedule.FieldLlmConfig, v)
+	return u
+}
+
+// UpdateLlmConfig sets the "llm_config" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsert) UpdateLlmConfig() *ScreeningScheduleUpsert {
+	u.SetExcluded(screeningschedule.FieldLlmConfig)
+	return u
+}
+
+// ClearLlmConfig clears the value of the "llm_config" field.
+func (u *ScreeningScheduleUpsert) ClearLlmConfig() *ScreeningScheduleUpsert {
+	u.SetNull(screeningschedule.FieldLlmConfig)
+	return u
+}
+
+// SetKnockoutRules sets the "knockout_rules" field.
+func (u *ScreeningScheduleUpsert) SetKnockoutRules(v map[string]interface{}) *ScreeningScheduleUpsert {
+	u.Set(screeningschedule.FieldKnockoutRules, v)
+	return u
+}
+
+// UpdateKnockoutRules sets the "knockout_rules" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsert) UpdateKnockoutRules() *ScreeningScheduleUpsert {
+	u.SetExcluded(screeningschedule.FieldKnockoutRules)
+	return u
+}
+
+// ClearKnockoutRules clears the value of the "knockout_rules" field.
+func (u *ScreeningScheduleUpsert) ClearKnockoutRules() *ScreeningScheduleUpsert {
+	u.SetNull(screeningschedule.FieldKnockoutRules)
+	return u
+}
+
+// SetBlindMode sets the "blind_mode" field.
+func (u *ScreeningScheduleUpsert) SetBlindMode(v bool) *ScreeningScheduleUpsert {
+	u.Set(screeningschedule.FieldBlindMode, v)
+	return u
+}
+
+// UpdateBlindMode sets the "blind_mode" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsert) UpdateBlindMode() *ScreeningScheduleUpsert {
+	u.SetExcluded(screeningschedule.FieldBlindMode)
+	return u
+}
+
+// SetDigestHour sets the "digest_hour" field.
+func (u *ScreeningScheduleUpsert) SetDigestHour(v int) *ScreeningScheduleUpsert {
+	u.Set(screeningschedule.FieldDigestHour, v)
+	return u
+}
+
+// UpdateDigestHour sets the "digest_hour" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsert) UpdateDigestHour() *ScreeningScheduleUpsert {
+	u.SetExcluded(screeningschedule.FieldDigestHour)
+	return u
+}
+
+// AddDigestHour adds v to the "digest_hour" field.
+func (u *ScreeningScheduleUpsert) AddDigestHour(v int) *ScreeningScheduleUpsert {
+	u.Add(screeningschedule.FieldDigestHour, v)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ScreeningScheduleUpsert) SetEnabled(v bool) *ScreeningScheduleUpsert {
+	u.Set(screeningschedule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsert) UpdateEnabled() *ScreeningScheduleUpsert {
+	u.SetExcluded(screeningschedule.FieldEnabled)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ScreeningScheduleUpsert) SetCreatedBy(v uuid.UUID) *ScreeningScheduleUpsert {
+	u.Set(screeningschedule.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsert) UpdateCreatedBy() *ScreeningScheduleUpsert {
+	u.SetExcluded(screeningschedule.FieldCreatedBy)
+	return u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ScreeningScheduleUpsert) SetLastRunAt(v time.Time) *ScreeningScheduleUpsert {
+	u.Set(screeningschedule.FieldLastRunAt, v)
+	return u
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsert) UpdateLastRunAt() *ScreeningScheduleUpsert {
+	u.SetExcluded(screeningschedule.FieldLastRunAt)
+	return u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *ScreeningScheduleUpsert) ClearLastRunAt() *ScreeningScheduleUpsert {
+	u.SetNull(screeningschedule.FieldLastRunAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScreeningScheduleUpsert) SetUpdatedAt(v time.Time) *ScreeningScheduleUpsert {
+	u.Set(screeningschedule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsert) UpdateUpdatedAt() *ScreeningScheduleUpsert {
+	u.SetExcluded(screeningschedule.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ScreeningSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(screeningschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScreeningScheduleUpsertOne) UpdateNewValues() *ScreeningScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(screeningschedule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(screeningschedule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScreeningSchedule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScreeningScheduleUpsertOne) Ignore() *ScreeningScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScreeningScheduleUpsertOne) DoNothing() *ScreeningScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScreeningScheduleCreate.OnConflict
+// documentation for more info.
+func (u *ScreeningScheduleUpsertOne) Update(set func(*ScreeningScheduleUpsert)) *ScreeningScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScreeningScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetJobPositionID sets the "job_position_id" field.
+func (u *ScreeningScheduleUpsertOne) SetJobPositionID(v uuid.UUID) *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetJobPositionID(v)
+	})
+}
+
+// UpdateJobPositionID sets the "job_position_id" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertOne) UpdateJobPositionID() *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateJobPositionID()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *ScreeningScheduleUpsertOne) SetMode(v consts.ScreeningScheduleMode) *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertOne) UpdateMode() *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetWeightTemplateID sets the "weight_template_id" field.
+func (u *ScreeningScheduleUpsertOne) SetWeightTemplateID(v uuid.UUID) *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetWeightTemplateID(v)
+	})
+}
+
+// UpdateWeightTemplateID sets the "weight_template_id" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertOne) UpdateWeightTemplateID() *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateWeightTemplateID()
+	})
+}
+
+// ClearWeightTemplateID clears the value of the "weight_template_id" field.
+func (u *ScreeningScheduleUpsertOne) ClearWeightTemplateID() *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.ClearWeightTemplateID()
+	})
+}
+
+// SetLlmConfig sets the "llm_config" field.
+func (u *ScreeningScheduleUpsertOne) SetLlmConfig(v map[string]interface{}) *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetLlmConfig(v)
+	})
+}
+
+// UpdateLlmConfig sets the "llm_config" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertOne) UpdateLlmConfig() *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateLlmConfig()
+	})
+}
+
+// ClearLlmConfig clears the value of the "llm_config" field.
+func (u *ScreeningScheduleUpsertOne) ClearLlmConfig() *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.ClearLlmConfig()
+	})
+}
+
+// SetKnockoutRules sets the "knockout_rules" field.
+func (u *ScreeningScheduleUpsertOne) SetKnockoutRules(v map[string]interface{}) *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetKnockoutRules(v)
+	})
+}
+
+// UpdateKnockoutRules sets the "knockout_rules" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertOne) UpdateKnockoutRules() *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateKnockoutRules()
+	})
+}
+
+// ClearKnockoutRules clears the value of the "knockout_rules" field.
+func (u *ScreeningScheduleUpsertOne) ClearKnockoutRules() *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.ClearKnockoutRules()
+	})
+}
+
+// SetBlindMode sets the "blind_mode" field.
+func (u *ScreeningScheduleUpsertOne) SetBlindMode(v bool) *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetBlindMode(v)
+	})
+}
+
+// UpdateBlindMode sets the "blind_mode" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertOne) UpdateBlindMode() *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateBlindMode()
+	})
+}
+
+// SetDigestHour sets the "digest_hour" field.
+func (u *ScreeningScheduleUpsertOne) SetDigestHour(v int) *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetDigestHour(v)
+	})
+}
+
+// AddDigestHour adds v to the "digest_hour" field.
+func (u *ScreeningScheduleUpsertOne) AddDigestHour(v int) *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.AddDigestHour(v)
+	})
+}
+
+// UpdateDigestHour sets the "digest_hour" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertOne) UpdateDigestHour() *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateDigestHour()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ScreeningScheduleUpsertOne) SetEnabled(v bool) *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertOne) UpdateEnabled() *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ScreeningScheduleUpsertOne) SetCreatedBy(v uuid.UUID) *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertOne) UpdateCreatedBy() *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ScreeningScheduleUpsertOne) SetLastRunAt(v time.Time) *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertOne) UpdateLastRunAt() *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *ScreeningScheduleUpsertOne) ClearLastRunAt() *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScreeningScheduleUpsertOne) SetUpdatedAt(v time.Time) *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertOne) UpdateUpdatedAt() *ScreeningScheduleUpsertOne {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ScreeningScheduleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for ScreeningScheduleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScreeningScheduleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScreeningScheduleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: ScreeningScheduleUpsertOne.ID is not supported by MySQL driver. Use ScreeningScheduleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScreeningScheduleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ScreeningScheduleCreateBulk is the builder for creating many ScreeningSchedule entities in bulk.
+type ScreeningScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*ScreeningScheduleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ScreeningSchedule entities in the database.
+func (sscb *ScreeningScheduleCreateBulk) Save(ctx context.Context) ([]*ScreeningSchedule, error) {
+	if sscb.err != nil {
+		return nil, sscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sscb.builders))
+	nodes := make([]*ScreeningSchedule, len(sscb.builders))
+	mutators := make([]Mutator, len(sscb.builders))
+	for i := range sscb.builders {
+		func(i int, root context.Context) {
+			builder := sscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScreeningScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sscb *ScreeningScheduleCreateBulk) SaveX(ctx context.Context) []*ScreeningSchedule {
+	v, err := sscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sscb *ScreeningScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := sscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscb *ScreeningScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := sscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScreeningSchedule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScreeningScheduleUpsert) {
+//			SetJobPositionID(v+v).
+//		}).
+//		Exec(ctx)
+func (sscb *ScreeningScheduleCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScreeningScheduleUpsertBulk {
+	sscb.conflict = opts
+	return &ScreeningScheduleUpsertBulk{
+		create: sscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScreeningSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sscb *ScreeningScheduleCreateBulk) OnConflictColumns(columns ...string) *ScreeningScheduleUpsertBulk {
+	sscb.conflict = append(sscb.conflict, sql.ConflictColumns(columns...))
+	return &ScreeningScheduleUpsertBulk{
+		create: sscb,
+	}
+}
+
+// ScreeningScheduleUpsertBulk is the builder for "upsert"-ing
+// a bulk of ScreeningSchedule nodes.
+type ScreeningScheduleUpsertBulk struct {
+	create *ScreeningScheduleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ScreeningSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(screeningschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScreeningScheduleUpsertBulk) UpdateNewValues() *ScreeningScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(screeningschedule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(screeningschedule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScreeningSchedule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScreeningScheduleUpsertBulk) Ignore() *ScreeningScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScreeningScheduleUpsertBulk) DoNothing() *ScreeningScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScreeningScheduleCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScreeningScheduleUpsertBulk) Update(set func(*ScreeningScheduleUpsert)) *ScreeningScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScreeningScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetJobPositionID sets the "job_position_id" field.
+func (u *ScreeningScheduleUpsertBulk) SetJobPositionID(v uuid.UUID) *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetJobPositionID(v)
+	})
+}
+
+// UpdateJobPositionID sets the "job_position_id" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertBulk) UpdateJobPositionID() *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateJobPositionID()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *ScreeningScheduleUpsertBulk) SetMode(v consts.ScreeningScheduleMode) *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertBulk) UpdateMode() *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetWeightTemplateID sets the "weight_template_id" field.
+func (u *ScreeningScheduleUpsertBulk) SetWeightTemplateID(v uuid.UUID) *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetWeightTemplateID(v)
+	})
+}
+
+// UpdateWeightTemplateID sets the "weight_template_id" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertBulk) UpdateWeightTemplateID() *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateWeightTemplateID()
+	})
+}
+
+// ClearWeightTemplateID clears the value of the "weight_template_id" field.
+func (u *ScreeningScheduleUpsertBulk) ClearWeightTemplateID() *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.ClearWeightTemplateID()
+	})
+}
+
+// SetLlmConfig sets the "llm_config" field.
+func (u *ScreeningScheduleUpsertBulk) SetLlmConfig(v map[string]interface{}) *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetLlmConfig(v)
+	})
+}
+
+// UpdateLlmConfig sets the "llm_config" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertBulk) UpdateLlmConfig() *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateLlmConfig()
+	})
+}
+
+// ClearLlmConfig clears the value of the "llm_config" field.
+func (u *ScreeningScheduleUpsertBulk) ClearLlmConfig() *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.ClearLlmConfig()
+	})
+}
+
+// SetKnockoutRules sets the "knockout_rules" field.
+func (u *ScreeningScheduleUpsertBulk) SetKnockoutRules(v map[string]interface{}) *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetKnockoutRules(v)
+	})
+}
+
+// UpdateKnockoutRules sets the "knockout_rules" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertBulk) UpdateKnockoutRules() *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateKnockoutRules()
+	})
+}
+
+// ClearKnockoutRules clears the value of the "knockout_rules" field.
+func (u *ScreeningScheduleUpsertBulk) ClearKnockoutRules() *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.ClearKnockoutRules()
+	})
+}
+
+// SetBlindMode sets the "blind_mode" field.
+func (u *ScreeningScheduleUpsertBulk) SetBlindMode(v bool) *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetBlindMode(v)
+	})
+}
+
+// UpdateBlindMode sets the "blind_mode" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertBulk) UpdateBlindMode() *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateBlindMode()
+	})
+}
+
+// SetDigestHour sets the "digest_hour" field.
+func (u *ScreeningScheduleUpsertBulk) SetDigestHour(v int) *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetDigestHour(v)
+	})
+}
+
+// AddDigestHour adds v to the "digest_hour" field.
+func (u *ScreeningScheduleUpsertBulk) AddDigestHour(v int) *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.AddDigestHour(v)
+	})
+}
+
+// UpdateDigestHour sets the "digest_hour" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertBulk) UpdateDigestHour() *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateDigestHour()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ScreeningScheduleUpsertBulk) SetEnabled(v bool) *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertBulk) UpdateEnabled() *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ScreeningScheduleUpsertBulk) SetCreatedBy(v uuid.UUID) *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertBulk) UpdateCreatedBy() *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ScreeningScheduleUpsertBulk) SetLastRunAt(v time.Time) *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertBulk) UpdateLastRunAt() *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *ScreeningScheduleUpsertBulk) ClearLastRunAt() *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScreeningScheduleUpsertBulk) SetUpdatedAt(v time.Time) *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScreeningScheduleUpsertBulk) UpdateUpdatedAt() *ScreeningScheduleUpsertBulk {
+	return u.Update(func(s *ScreeningScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ScreeningScheduleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the ScreeningScheduleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for ScreeningScheduleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScreeningScheduleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
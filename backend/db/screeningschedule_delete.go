@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/WhaleHire/backend/db/predicate"
+	"github.com/chaitin/WhaleHire/backend/db/screeningschedule"
+)
+
+// ScreeningScheduleDelete is the builder for deleting a ScreeningSchedule entity.
+type ScreeningScheduleDelete struct {
+	config
+	hooks    []Hook
+	mutation *ScreeningScheduleMutation
+}
+
+// Where appends a list predicates to the ScreeningScheduleDelete builder.
+func (ssd *ScreeningScheduleDelete) Where(ps ...predicate.ScreeningSchedule) *ScreeningScheduleDelete {
+	ssd.mutation.Where(ps...)
+	return ssd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ssd *ScreeningScheduleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ssd.sqlExec, ssd.mutation, ssd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssd *ScreeningScheduleDelete) ExecX(ctx context.Context) int {
+	n, err := ssd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ssd *ScreeningScheduleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(screeningschedule.Table, sqlgraph.NewFieldSpec(screeningschedule.FieldID, field.TypeUUID))
+	if ps := ssd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ssd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ssd.mutation.done = true
+	return affected, err
+}
+
+// ScreeningScheduleDeleteOne is the builder for deleting a single ScreeningSchedule entity.
+type ScreeningScheduleDeleteOne struct {
+	ssd *ScreeningScheduleDelete
+}
+
+// Where appends a list predicates to the ScreeningScheduleDelete builder.
+func (ssdo *ScreeningScheduleDeleteOne) Where(ps ...predicate.ScreeningSchedule) *ScreeningScheduleDeleteOne {
+	ssdo.ssd.mutation.Where(ps...)
+	return ssdo
+}
+
+// Exec executes the deletion query.
+func (ssdo *ScreeningScheduleDeleteOne) Exec(ctx context.Context) error {
+	n, err := ssdo.ssd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{screeningschedule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssdo *ScreeningScheduleDeleteOne) ExecX(ctx context.Context) {
+	if err := ssdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
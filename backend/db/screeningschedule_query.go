@@ -0,0 +1,657 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/WhaleHire/backend/db/jobposition"
+	"github.com/chaitin/WhaleHire/backend/db/predicate"
+	"github.com/chaitin/WhaleHire/backend/db/screeningschedule"
+	"github.com/google/uuid"
+)
+
+// ScreeningScheduleQuery is the builder for querying ScreeningSchedule entities.
+type ScreeningScheduleQuery struct {
+	config
+	ctx             *QueryContext
+	order           []screeningschedule.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.ScreeningSchedule
+	withJobPosition *JobPositionQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ScreeningScheduleQuery builder.
+func (ssq *ScreeningScheduleQuery) Where(ps ...predicate.ScreeningSchedule) *ScreeningScheduleQuery {
+	ssq.predicates = append(ssq.predicates, ps...)
+	return ssq
+}
+
+// Limit the number of records to be returned by this query.
+func (ssq *ScreeningScheduleQuery) Limit(limit int) *ScreeningScheduleQuery {
+	ssq.ctx.Limit = &limit
+	return ssq
+}
+
+// Offset to start from.
+func (ssq *ScreeningScheduleQuery) Offset(offset int) *ScreeningScheduleQuery {
+	ssq.ctx.Offset = &offset
+	return ssq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ssq *ScreeningScheduleQuery) Unique(unique bool) *ScreeningScheduleQuery {
+	ssq.ctx.Unique = &unique
+	return ssq
+}
+
+// Order specifies how the records should be ordered.
+func (ssq *ScreeningScheduleQuery) Order(o ...screeningschedule.OrderOption) *ScreeningScheduleQuery {
+	ssq.order = append(ssq.order, o...)
+	return ssq
+}
+
+// QueryJobPosition chains the current query on the "job_position" edge.
+func (ssq *ScreeningScheduleQuery) QueryJobPosition() *JobPositionQuery {
+	query := (&JobPositionClient{config: ssq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ssq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ssq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(screeningschedule.Table, screeningschedule.FieldID, selector),
+			sqlgraph.To(jobposition.Table, jobposition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, screeningschedule.JobPositionTable, screeningschedule.JobPositionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ssq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ScreeningSchedule entity from the query.
+// Returns a *NotFoundError when no ScreeningSchedule was found.
+func (ssq *ScreeningScheduleQuery) First(ctx context.Context) (*ScreeningSchedule, error) {
+	nodes, err := ssq.Limit(1).All(setContextOp(ctx, ssq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{screeningschedule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ssq *ScreeningScheduleQuery) FirstX(ctx context.Context) *ScreeningSchedule {
+	node, err := ssq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ScreeningSchedule ID from the query.
+// Returns a *NotFoundError when no ScreeningSchedule ID was found.
+func (ssq *ScreeningScheduleQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ssq.Limit(1).IDs(setContextOp(ctx, ssq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{screeningschedule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ssq *ScreeningScheduleQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ssq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ScreeningSchedule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ScreeningSchedule entity is found.
+// Returns a *NotFoundError when no ScreeningSchedule entities are found.
+func (ssq *ScreeningScheduleQuery) Only(ctx context.Context) (*ScreeningSchedule, error) {
+	nodes, err := ssq.Limit(2).All(setContextOp(ctx, ssq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{screeningschedule.Label}
+	default:
+		return nil, &NotSingularError{screeningschedule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ssq *ScreeningScheduleQuery) OnlyX(ctx context.Context) *ScreeningSchedule {
+	node, err := ssq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ScreeningSchedule ID in the query.
+// Returns a *NotSingularError when more than one ScreeningSchedule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ssq *ScreeningScheduleQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ssq.Limit(2).IDs(setContextOp(ctx, ssq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{screeningschedule.Label}
+	default:
+		err = &NotSingularError{screeningschedule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ssq *ScreeningScheduleQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ssq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ScreeningSchedules.
+func (ssq *ScreeningScheduleQuery) All(ctx context.Context) ([]*ScreeningSchedule, error) {
+	ctx = setContextOp(ctx, ssq.ctx, ent.OpQueryAll)
+	if err := ssq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ScreeningSchedule, *ScreeningScheduleQuery]()
+	return withInterceptors[[]*ScreeningSchedule](ctx, ssq, qr, ssq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ssq *ScreeningScheduleQuery) AllX(ctx context.Context) []*ScreeningSchedule {
+	nodes, err := ssq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ScreeningSchedule IDs.
+func (ssq *ScreeningScheduleQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if ssq.ctx.Unique == nil && ssq.path != nil {
+		ssq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ssq.ctx, ent.OpQueryIDs)
+	if err = ssq.Select(screeningschedule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ssq *ScreeningScheduleQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ssq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ssq *ScreeningScheduleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ssq.ctx, ent.OpQueryCount)
+	if err := ssq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ssq, querierCount[*ScreeningScheduleQuery](), ssq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ssq *ScreeningScheduleQuery) CountX(ctx context.Context) int {
+	count, err := ssq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ssq *ScreeningScheduleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ssq.ctx, ent.OpQueryExist)
+	switch _, err := ssq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ssq *ScreeningScheduleQuery) ExistX(ctx context.Context) bool {
+	exist, err := ssq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ScreeningScheduleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ssq *ScreeningScheduleQuery) Clone() *ScreeningScheduleQuery {
+	if ssq == nil {
+		return nil
+	}
+	return &ScreeningScheduleQuery{
+		config:          ssq.config,
+		ctx:             ssq.ctx.Clone(),
+		order:           append([]screeningschedule.OrderOption{}, ssq.order...),
+		inters:          append([]Interceptor{}, ssq.inters...),
+		predicates:      append([]predicate.ScreeningSchedule{}, ssq.predicates...),
+		withJobPosition: ssq.withJobPosition.Clone(),
+		// clone intermediate query.
+		sql:       ssq.sql.Clone(),
+		path:      ssq.path,
+		modifiers: append([]func(*sql.Selector){}, ssq.modifiers...),
+	}
+}
+
+// WithJobPosition tells the query-builder to eager-load the nodes that are connected to
+// the "job_position" edge. The optional arguments are used to configure the query builder of the edge.
+func (ssq *ScreeningScheduleQuery) WithJobPosition(opts ...func(*JobPositionQuery)) *ScreeningScheduleQuery {
+	query := (&JobPositionClient{config: ssq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ssq.withJobPosition = query
+	return ssq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		JobPositionID uuid.UUID `json:"job_position_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ScreeningSchedule.Query().
+//		GroupBy(screeningschedule.FieldJobPositionID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (ssq *ScreeningScheduleQuery) GroupBy(field string, fields ...string) *ScreeningScheduleGroupBy {
+	ssq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ScreeningScheduleGroupBy{build: ssq}
+	grbuild.flds = &ssq.ctx.Fields
+	grbuild.label = screeningschedule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		JobPositionID uuid.UUID `json:"job_position_id,omitempty"`
+//	}
+//
+//	client.ScreeningSchedule.Query().
+//		Select(screeningschedule.FieldJobPositionID).
+//		Scan(ctx, &v)
+func (ssq *ScreeningScheduleQuery) Select(fields ...string) *ScreeningScheduleSelect {
+	ssq.ctx.Fields = append(ssq.ctx.Fields, fields...)
+	sbuild := &ScreeningScheduleSelect{ScreeningScheduleQuery: ssq}
+	sbuild.label = screeningschedule.Label
+	sbuild.flds, sbuild.scan = &ssq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ScreeningScheduleSelect configured with the given aggregations.
+func (ssq *ScreeningScheduleQuery) Aggregate(fns ...AggregateFunc) *ScreeningScheduleSelect {
+	return ssq.Select().Aggregate(fns...)
+}
+
+func (ssq *ScreeningScheduleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ssq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ssq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ssq.ctx.Fields {
+		if !screeningschedule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if ssq.path != nil {
+		prev, err := ssq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ssq.sql = prev
+	}
+	return nil
+}
+
+func (ssq *ScreeningScheduleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ScreeningSchedule, error) {
+	var (
+		nodes       = []*ScreeningSchedule{}
+		_spec       = ssq.querySpec()
+		loadedTypes = [1]bool{
+			ssq.withJobPosition != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ScreeningSchedule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ScreeningSchedule{config: ssq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ssq.modifiers) > 0 {
+		_spec.Modifiers = ssq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ssq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ssq.withJobPosition; query != nil {
+		if err := ssq.loadJobPosition(ctx, query, nodes, nil,
+			func(n *ScreeningSchedule, e *JobPosition) { n.Edges.JobPosition = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ssq *ScreeningScheduleQuery) loadJobPosition(ctx context.Context, query *JobPositionQuery, nodes []*ScreeningSchedule, init func(*ScreeningSchedule), assign func(*ScreeningSchedule, *JobPosition)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ScreeningSchedule)
+	for i := range nodes {
+		fk := nodes[i].JobPositionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(jobposition.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "job_position_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ssq *ScreeningScheduleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ssq.querySpec()
+	if len(ssq.modifiers) > 0 {
+		_spec.Modifiers = ssq.modifiers
+	}
+	_spec.Node.Columns = ssq.ctx.Fields
+	if len(ssq.ctx.Fields) > 0 {
+		_spec.Unique = ssq.ctx.Unique != nil && *ssq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ssq.driver, _spec)
+}
+
+func (ssq *ScreeningScheduleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(screeningschedule.Table, screeningschedule.Columns, sqlgraph.NewFieldSpec(screeningschedule.FieldID, field.TypeUUID))
+	_spec.From = ssq.sql
+	if unique := ssq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ssq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ssq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, screeningschedule.FieldID)
+		for i := range fields {
+			if fields[i] != screeningschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ssq.withJobPosition != nil {
+			_spec.Node.AddColumnOnce(screeningschedule.FieldJobPositionID)
+		}
+	}
+	if ps := ssq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ssq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ssq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ssq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ssq *ScreeningScheduleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ssq.driver.Dialect())
+	t1 := builder.Table(screeningschedule.Table)
+	columns := ssq.ctx.Fields
+	if len(columns) == 0 {
+		columns = screeningschedule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ssq.sql != nil {
+		selector = ssq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ssq.ctx.Unique != nil && *ssq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ssq.modifiers {
+		m(selector)
+	}
+	for _, p := range ssq.predicates {
+		p(selector)
+	}
+	for _, p := range ssq.order {
+		p(selector)
+	}
+	if offset := ssq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ssq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ssq *ScreeningScheduleQuery) ForUpdate(opts ...sql.LockOption) *ScreeningScheduleQuery {
+	if ssq.driver.Dialect() == dialect.Postgres {
+		ssq.Unique(false)
+	}
+	ssq.modifiers = append(ssq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ssq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ssq *ScreeningScheduleQuery) ForShare(opts ...sql.LockOption) *ScreeningScheduleQuery {
+	if ssq.driver.Dialect() == dialect.Postgres {
+		ssq.Unique(false)
+	}
+	ssq.modifiers = append(ssq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ssq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ssq *ScreeningScheduleQuery) Modify(modifiers ...func(s *sql.Selector)) *ScreeningScheduleSelect {
+	ssq.modifiers = append(ssq.modifiers, modifiers...)
+	return ssq.Select()
+}
+
+// ScreeningScheduleGroupBy is the group-by builder for ScreeningSchedule entities.
+type ScreeningScheduleGroupBy struct {
+	selector
+	build *ScreeningScheduleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ssgb *ScreeningScheduleGroupBy) Aggregate(fns ...AggregateFunc) *ScreeningScheduleGroupBy {
+	ssgb.fns = append(ssgb.fns, fns...)
+	return ssgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ssgb *ScreeningScheduleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ssgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ssgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScreeningScheduleQuery, *ScreeningScheduleGroupBy](ctx, ssgb.build, ssgb, ssgb.build.inters, v)
+}
+
+func (ssgb *ScreeningScheduleGroupBy) sqlScan(ctx context.Context, root *ScreeningScheduleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ssgb.fns))
+	for _, fn := range ssgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ssgb.flds)+len(ssgb.fns))
+		for _, f := range *ssgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ssgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ssgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ScreeningScheduleSelect is the builder for selecting fields of ScreeningSchedule entities.
+type ScreeningScheduleSelect struct {
+	*ScreeningScheduleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sss *ScreeningScheduleSelect) Aggregate(fns ...AggregateFunc) *ScreeningScheduleSelect {
+	sss.fns = append(sss.fns, fns...)
+	return sss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sss *ScreeningScheduleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sss.ctx, ent.OpQuerySelect)
+	if err := sss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScreeningScheduleQuery, *ScreeningScheduleSelect](ctx, sss.ScreeningScheduleQuery, sss, sss.inters, v)
+}
+
+func (sss *ScreeningScheduleSelect) sqlScan(ctx context.Context, root *ScreeningScheduleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sss.fns))
+	for _, fn := range sss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sss *ScreeningScheduleSelect) Modify(modifiers ...func(s *sql.Selector)) *ScreeningScheduleSelect {
+	sss.modifiers = append(sss.modifiers, modifiers...)
+	return sss
+}
@@ -0,0 +1,737 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db/jobposition"
+	"github.com/chaitin/WhaleHire/backend/db/predicate"
+	"github.com/chaitin/WhaleHire/backend/db/screeningschedule"
+	"github.com/google/uuid"
+)
+
+// ScreeningScheduleUpdate is the builder for updating ScreeningSchedule entities.
+type ScreeningScheduleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ScreeningScheduleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ScreeningScheduleUpdate builder.
+func (ssu *ScreeningScheduleUpdate) Where(ps ...predicate.ScreeningSchedule) *ScreeningScheduleUpdate {
+	ssu.mutation.Where(ps...)
+	return ssu
+}
+
+// SetJobPositionID sets the "job_position_id" field.
+func (ssu *ScreeningScheduleUpdate) SetJobPositionID(u uuid.UUID) *ScreeningScheduleUpdate {
+	ssu.mutation.SetJobPositionID(u)
+	return ssu
+}
+
+// SetNillableJobPositionID sets the "job_position_id" field if the given value is not nil.
+func (ssu *ScreeningScheduleUpdate) SetNillableJobPositionID(u *uuid.UUID) *ScreeningScheduleUpdate {
+	if u != nil {
+		ssu.SetJobPositionID(*u)
+	}
+	return ssu
+}
+
+// SetMode sets the "mode" field.
+func (ssu *ScreeningScheduleUpdate) SetMode(csm consts.ScreeningScheduleMode) *ScreeningScheduleUpdate {
+	ssu.mutation.SetMode(csm)
+	return ssu
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (ssu *ScreeningScheduleUpdate) SetNillableMode(csm *consts.ScreeningScheduleMode) *ScreeningScheduleUpdate {
+	if csm != nil {
+		ssu.SetMode(*csm)
+	}
+	return ssu
+}
+
+// SetWeightTemplateID sets the "weight_template_id" field.
+func (ssu *ScreeningScheduleUpdate) SetWeightTemplateID(u uuid.UUID) *ScreeningScheduleUpdate {
+	ssu.mutation.SetWeightTemplateID(u)
+	return ssu
+}
+
+// SetNillableWeightTemplateID sets the "weight_template_id" field if the given value is not nil.
+func (ssu *ScreeningScheduleUpdate) SetNillableWeightTemplateID(u *uuid.UUID) *ScreeningScheduleUpdate {
+	if u != nil {
+		ssu.SetWeightTemplateID(*u)
+	}
+	return ssu
+}
+
+// ClearWeightTemplateID clears the value of the "weight_template_id" field.
+func (ssu *ScreeningScheduleUpdate) ClearWeightTemplateID() *ScreeningScheduleUpdate {
+	ssu.mutation.ClearWeightTemplateID()
+	return ssu
+}
+
+// SetLlmConfig sets the "llm_config" field.
+func (ssu *ScreeningScheduleUpdate) SetLlmConfig(m map[string]interface{}) *ScreeningScheduleUpdate {
+	ssu.mutation.SetLlmConfig(m)
+	return ssu
+}
+
+// ClearLlmConfig clears the value of the "llm_config" field.
+func (ssu *ScreeningScheduleUpdate) ClearLlmConfig() *ScreeningScheduleUpdate {
+	ssu.mutation.ClearLlmConfig()
+	return ssu
+}
+
+// SetKnockoutRules sets the "knockout_rules" field.
+func (ssu *ScreeningScheduleUpdate) SetKnockoutRules(m map[string]interface{}) *ScreeningScheduleUpdate {
+	ssu.mutation.SetKnockoutRules(m)
+	return ssu
+}
+
+// ClearKnockoutRules clears the value of the "knockout_rules" field.
+func (ssu *ScreeningScheduleUpdate) ClearKnockoutRules() *ScreeningScheduleUpdate {
+	ssu.mutation.ClearKnockoutRules()
+	return ssu
+}
+
+// SetBlindMode sets the "blind_mode" field.
+func (ssu *ScreeningScheduleUpdate) SetBlindMode(b bool) *ScreeningScheduleUpdate {
+	ssu.mutation.SetBlindMode(b)
+	return ssu
+}
+
+// SetNillableBlindMode sets the "blind_mode" field if the given value is not nil.
+func (ssu *ScreeningScheduleUpdate) SetNillableBlindMode(b *bool) *ScreeningScheduleUpdate {
+	if b != nil {
+		ssu.SetBlindMode(*b)
+	}
+	return ssu
+}
+
+// SetDigestHour sets the "digest_hour" field.
+func (ssu *ScreeningScheduleUpdate) SetDigestHour(i int) *ScreeningScheduleUpdate {
+	ssu.mutation.ResetDigestHour()
+	ssu.mutation.SetDigestHour(i)
+	return ssu
+}
+
+// SetNillableDigestHour sets the "digest_hour" field if the given value is not nil.
+func (ssu *ScreeningScheduleUpdate) SetNillableDigestHour(i *int) *ScreeningScheduleUpdate {
+	if i != nil {
+		ssu.SetDigestHour(*i)
+	}
+	return ssu
+}
+
+// AddDigestHour adds i to the "digest_hour" field.
+func (ssu *ScreeningScheduleUpdate) AddDigestHour(i int) *ScreeningScheduleUpdate {
+	ssu.mutation.AddDigestHour(i)
+	return ssu
+}
+
+// SetEnabled sets the "enabled" field.
+func (ssu *ScreeningScheduleUpdate) SetEnabled(b bool) *ScreeningScheduleUpdate {
+	ssu.mutation.SetEnabled(b)
+	return ssu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (ssu *ScreeningScheduleUpdate) SetNillableEnabled(b *bool) *ScreeningScheduleUpdate {
+	if b != nil {
+		ssu.SetEnabled(*b)
+	}
+	return ssu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ssu *ScreeningScheduleUpdate) SetCreatedBy(u uuid.UUID) *ScreeningScheduleUpdate {
+	ssu.mutation.SetCreatedBy(u)
+	return ssu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ssu *ScreeningScheduleUpdate) SetNillableCreatedBy(u *uuid.UUID) *ScreeningScheduleUpdate {
+	if u != nil {
+		ssu.SetCreatedBy(*u)
+	}
+	return ssu
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (ssu *ScreeningScheduleUpdate) SetLastRunAt(t time.Time) *ScreeningScheduleUpdate {
+	ssu.mutation.SetLastRunAt(t)
+	return ssu
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (ssu *ScreeningScheduleUpdate) SetNillableLastRunAt(t *time.Time) *ScreeningScheduleUpdate {
+	if t != nil {
+		ssu.SetLastRunAt(*t)
+	}
+	return ssu
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (ssu *ScreeningScheduleUpdate) ClearLastRunAt() *ScreeningScheduleUpdate {
+	ssu.mutation.ClearLastRunAt()
+	return ssu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ssu *ScreeningScheduleUpdate) SetUpdatedAt(t time.Time) *ScreeningScheduleUpdate {
+	ssu.mutation.SetUpdatedAt(t)
+	return ssu
+}
+
+// SetJobPosition sets the "job_position" edge to the JobPosition entity.
+func (ssu *ScreeningScheduleUpdate) SetJobPosition(j *JobPosition) *ScreeningScheduleUpdate {
+	return ssu.SetJobPositionID(j.ID)
+}
+
+// Mutation returns the ScreeningScheduleMutation object of the builder.
+func (ssu *ScreeningScheduleUpdate) Mutation() *ScreeningScheduleMutation {
+	return ssu.mutation
+}
+
+// ClearJobPosition clears the "job_position" edge to the JobPosition entity.
+func (ssu *ScreeningScheduleUpdate) ClearJobPosition() *ScreeningScheduleUpdate {
+	ssu.mutation.ClearJobPosition()
+	return ssu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ssu *ScreeningScheduleUpdate) Save(ctx context.Context) (int, error) {
+	ssu.defaults()
+	return withHooks(ctx, ssu.sqlSave, ssu.mutation, ssu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssu *ScreeningScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := ssu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ssu *ScreeningScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := ssu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssu *ScreeningScheduleUpdate) ExecX(ctx context.Context) {
+	if err := ssu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssu *ScreeningScheduleUpdate) defaults() {
+	if _, ok := ssu.mutation.UpdatedAt(); !ok {
+		v := screeningschedule.UpdateDefaultUpdatedAt()
+		ssu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssu *ScreeningScheduleUpdate) check() error {
+	if v, ok := ssu.mutation.DigestHour(); ok {
+		if err := screeningschedule.DigestHourValidator(v); err != nil {
+			return &ValidationError{Name: "digest_hour", err: fmt.Errorf(`db: validator failed for field "ScreeningSchedule.digest_hour": %w`, err)}
+		}
+	}
+	if ssu.mutation.JobPositionCleared() && len(ssu.mutation.JobPositionIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "ScreeningSchedule.job_position"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ssu *ScreeningScheduleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScreeningScheduleUpdate {
+	ssu.modifiers = append(ssu.modifiers, modifiers...)
+	return ssu
+}
+
+func (ssu *ScreeningScheduleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ssu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(screeningschedule.Table, screeningschedule.Columns, sqlgraph.NewFieldSpec(screeningschedule.FieldID, field.TypeUUID))
+	if ps := ssu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssu.mutation.Mode(); ok {
+		_spec.SetField(screeningschedule.FieldMode, field.TypeString, value)
+	}
+	if value, ok := ssu.mutation.WeightTemplateID(); ok {
+		_spec.SetField(screeningschedule.FieldWeightTemplateID, field.TypeUUID, value)
+	}
+	if ssu.mutation.WeightTemplateIDCleared() {
+		_spec.ClearField(screeningschedule.FieldWeightTemplateID, field.TypeUUID)
+	}
+	if value, ok := ssu.mutation.LlmConfig(); ok {
+		_spec.SetField(screeningschedule.FieldLlmConfig, field.TypeJSON, value)
+	}
+	if ssu.mutation.LlmConfigCleared() {
+		_spec.ClearField(screeningschedule.FieldLlmConfig, field.TypeJSON)
+	}
+	if value, ok := ssu.mutation.KnockoutRules(); ok {
+		_spec.SetField(screeningschedule.FieldKnockoutRules, field.TypeJSON, value)
+	}
+	if ssu.mutation.KnockoutRulesCleared() {
+		_spec.ClearField(screeningschedule.FieldKnockoutRules, field.TypeJSON)
+	}
+	if value, ok := ssu.mutation.BlindMode(); ok {
+		_spec.SetField(screeningschedule.FieldBlindMode, field.TypeBool, value)
+	}
+	if value, ok := ssu.mutation.DigestHour(); ok {
+		_spec.SetField(screeningschedule.FieldDigestHour, field.TypeInt, value)
+	}
+	if value, ok := ssu.mutation.AddedDigestHour(); ok {
+		_spec.AddField(screeningschedule.FieldDigestHour, field.TypeInt, value)
+	}
+	if value, ok := ssu.mutation.Enabled(); ok {
+		_spec.SetField(screeningschedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := ssu.mutation.CreatedBy(); ok {
+		_spec.SetField(screeningschedule.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if value, ok := ssu.mutation.LastRunAt(); ok {
+		_spec.SetField(screeningschedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if ssu.mutation.LastRunAtCleared() {
+		_spec.ClearField(screeningschedule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := ssu.mutation.UpdatedAt(); ok {
+		_spec.SetField(screeningschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ssu.mutation.JobPositionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   screeningschedule.JobPositionTable,
+			Columns: []string{screeningschedule.JobPositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobposition.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssu.mutation.JobPositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   screeningschedule.JobPositionTable,
+			Columns: []string{screeningschedule.JobPositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobposition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ssu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ssu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{screeningschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ssu.mutation.done = true
+	return n, nil
+}
+
+// ScreeningScheduleUpdateOne is the builder for updating a single ScreeningSchedule entity.
+type ScreeningScheduleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ScreeningScheduleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetJobPositionID sets the "job_position_id" field.
+func (ssuo *ScreeningScheduleUpdateOne) SetJobPositionID(u uuid.UUID) *ScreeningScheduleUpdateOne {
+	ssuo.mutation.SetJobPositionID(u)
+	return ssuo
+}
+
+// SetNillableJobPositionID sets the "job_position_id" field if the given value is not nil.
+func (ssuo *ScreeningScheduleUpdateOne) SetNillableJobPositionID(u *uuid.UUID) *ScreeningScheduleUpdateOne {
+	if u != nil {
+		ssuo.SetJobPositionID(*u)
+	}
+	return ssuo
+}
+
+// SetMode sets the "mode" field.
+func (ssuo *ScreeningScheduleUpdateOne) SetMode(csm consts.ScreeningScheduleMode) *ScreeningScheduleUpdateOne {
+	ssuo.mutation.SetMode(csm)
+	return ssuo
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (ssuo *ScreeningScheduleUpdateOne) SetNillableMode(csm *consts.ScreeningScheduleMode) *ScreeningScheduleUpdateOne {
+	if csm != nil {
+		ssuo.SetMode(*csm)
+	}
+	return ssuo
+}
+
+// SetWeightTemplateID sets the "weight_template_id" field.
+func (ssuo *ScreeningScheduleUpdateOne) SetWeightTemplateID(u uuid.UUID) *ScreeningScheduleUpdateOne {
+	ssuo.mutation.SetWeightTemplateID(u)
+	return ssuo
+}
+
+// SetNillableWeightTemplateID sets the "weight_template_id" field if the given value is not nil.
+func (ssuo *ScreeningScheduleUpdateOne) SetNillableWeightTemplateID(u *uuid.UUID) *ScreeningScheduleUpdateOne {
+	if u != nil {
+		ssuo.SetWeightTemplateID(*u)
+	}
+	return ssuo
+}
+
+// ClearWeightTemplateID clears the value of the "weight_template_id" field.
+func (ssuo *ScreeningScheduleUpdateOne) ClearWeightTemplateID() *ScreeningScheduleUpdateOne {
+	ssuo.mutation.ClearWeightTemplateID()
+	return ssuo
+}
+
+// SetLlmConfig sets the "llm_config" field.
+func (ssuo *ScreeningScheduleUpdateOne) SetLlmConfig(m map[string]interface{}) *ScreeningScheduleUpdateOne {
+	ssuo.mutation.SetLlmConfig(m)
+	return ssuo
+}
+
+// ClearLlmConfig clears the value of the "llm_config" field.
+func (ssuo *ScreeningScheduleUpdateOne) ClearLlmConfig() *ScreeningScheduleUpdateOne {
+	ssuo.mutation.ClearLlmConfig()
+	return ssuo
+}
+
+// SetKnockoutRules sets the "knockout_rules" field.
+func (ssuo *ScreeningScheduleUpdateOne) SetKnockoutRules(m map[string]interface{}) *ScreeningScheduleUpdateOne {
+	ssuo.mutation.SetKnockoutRules(m)
+	return ssuo
+}
+
+// ClearKnockoutRules clears the value of the "knockout_rules" field.
+func (ssuo *ScreeningScheduleUpdateOne) ClearKnockoutRules() *ScreeningScheduleUpdateOne {
+	ssuo.mutation.ClearKnockoutRules()
+	return ssuo
+}
+
+// SetBlindMode sets the "blind_mode" field.
+func (ssuo *ScreeningScheduleUpdateOne) SetBlindMode(b bool) *ScreeningScheduleUpdateOne {
+	ssuo.mutation.SetBlindMode(b)
+	return ssuo
+}
+
+// SetNillableBlindMode sets the "blind_mode" field if the given value is not nil.
+func (ssuo *ScreeningScheduleUpdateOne) SetNillableBlindMode(b *bool) *ScreeningScheduleUpdateOne {
+	if b != nil {
+		ssuo.SetBlindMode(*b)
+	}
+	return ssuo
+}
+
+// SetDigestHour sets the "digest_hour" field.
+func (ssuo *ScreeningScheduleUpdateOne) SetDigestHour(i int) *ScreeningScheduleUpdateOne {
+	ssuo.mutation.ResetDigestHour()
+	ssuo.mutation.SetDigestHour(i)
+	return ssuo
+}
+
+// SetNillableDigestHour sets the "digest_hour" field if the given value is not nil.
+func (ssuo *ScreeningScheduleUpdateOne) SetNillableDigestHour(i *int) *ScreeningScheduleUpdateOne {
+	if i != nil {
+		ssuo.SetDigestHour(*i)
+	}
+	return ssuo
+}
+
+// AddDigestHour adds i to the "digest_hour" field.
+func (ssuo *ScreeningScheduleUpdateOne) AddDigestHour(i int) *ScreeningScheduleUpdateOne {
+	ssuo.mutation.AddDigestHour(i)
+	return ssuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (ssuo *ScreeningScheduleUpdateOne) SetEnabled(b bool) *ScreeningScheduleUpdateOne {
+	ssuo.mutation.SetEnabled(b)
+	return ssuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (ssuo *ScreeningScheduleUpdateOne) SetNillableEnabled(b *bool) *ScreeningScheduleUpdateOne {
+	if b != nil {
+		ssuo.SetEnabled(*b)
+	}
+	return ssuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ssuo *ScreeningScheduleUpdateOne) SetCreatedBy(u uuid.UUID) *ScreeningScheduleUpdateOne {
+	ssuo.mutation.SetCreatedBy(u)
+	return ssuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ssuo *ScreeningScheduleUpdateOne) SetNillableCreatedBy(u *uuid.UUID) *ScreeningScheduleUpdateOne {
+	if u != nil {
+		ssuo.SetCreatedBy(*u)
+	}
+	return ssuo
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (ssuo *ScreeningScheduleUpdateOne) SetLastRunAt(t time.Time) *ScreeningScheduleUpdateOne {
+	ssuo.mutation.SetLastRunAt(t)
+	return ssuo
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (ssuo *ScreeningScheduleUpdateOne) SetNillableLastRunAt(t *time.Time) *ScreeningScheduleUpdateOne {
+	if t != nil {
+		ssuo.SetLastRunAt(*t)
+	}
+	return ssuo
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (ssuo *ScreeningScheduleUpdateOne) ClearLastRunAt() *ScreeningScheduleUpdateOne {
+	ssuo.mutation.ClearLastRunAt()
+	return ssuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ssuo *ScreeningScheduleUpdateOne) SetUpdatedAt(t time.Time) *ScreeningScheduleUpdateOne {
+	ssuo.mutation.SetUpdatedAt(t)
+	return ssuo
+}
+
+// SetJobPosition sets the "job_position" edge to the JobPosition entity.
+func (ssuo *ScreeningScheduleUpdateOne) SetJobPosition(j *JobPosition) *ScreeningScheduleUpdateOne {
+	return ssuo.SetJobPositionID(j.ID)
+}
+
+// Mutation returns the ScreeningScheduleMutation object of the builder.
+func (ssuo *ScreeningScheduleUpdateOne) Mutation() *ScreeningScheduleMutation {
+	return ssuo.mutation
+}
+
+// ClearJobPosition clears the "job_position" edge to the JobPosition entity.
+func (ssuo *ScreeningScheduleUpdateOne) ClearJobPosition() *ScreeningScheduleUpdateOne {
+	ssuo.mutation.ClearJobPosition()
+	return ssuo
+}
+
+// Where appends a list predicates to the ScreeningScheduleUpdate builder.
+func (ssuo *ScreeningScheduleUpdateOne) Where(ps ...predicate.ScreeningSchedule) *ScreeningScheduleUpdateOne {
+	ssuo.mutation.Where(ps...)
+	return ssuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ssuo *ScreeningScheduleUpdateOne) Select(field string, fields ...string) *ScreeningScheduleUpdateOne {
+	ssuo.fields = append([]string{field}, fields...)
+	return ssuo
+}
+
+// Save executes the query and returns the updated ScreeningSchedule entity.
+func (ssuo *ScreeningScheduleUpdateOne) Save(ctx context.Context) (*ScreeningSchedule, error) {
+	ssuo.defaults()
+	return withHooks(ctx, ssuo.sqlSave, ssuo.mutation, ssuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssuo *ScreeningScheduleUpdateOne) SaveX(ctx context.Context) *ScreeningSchedule {
+	node, err := ssuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ssuo *ScreeningScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := ssuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssuo *ScreeningScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := ssuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssuo *ScreeningScheduleUpdateOne) defaults() {
+	if _, ok := ssuo.mutation.UpdatedAt(); !ok {
+		v := screeningschedule.UpdateDefaultUpdatedAt()
+		ssuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssuo *ScreeningScheduleUpdateOne) check() error {
+	if v, ok := ssuo.mutation.DigestHour(); ok {
+		if err := screeningschedule.DigestHourValidator(v); err != nil {
+			return &ValidationError{Name: "digest_hour", err: fmt.Errorf(`db: validator failed for field "ScreeningSchedule.digest_hour": %w`, err)}
+		}
+	}
+	if ssuo.mutation.JobPositionCleared() && len(ssuo.mutation.JobPositionIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "ScreeningSchedule.job_position"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ssuo *ScreeningScheduleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScreeningScheduleUpdateOne {
+	ssuo.modifiers = append(ssuo.modifiers, modifiers...)
+	return ssuo
+}
+
+func (ssuo *ScreeningScheduleUpdateOne) sqlSave(ctx context.Context) (_node *ScreeningSchedule, err error) {
+	if err := ssuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(screeningschedule.Table, screeningschedule.Columns, sqlgraph.NewFieldSpec(screeningschedule.FieldID, field.TypeUUID))
+	id, ok := ssuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "ScreeningSchedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ssuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, screeningschedule.FieldID)
+		for _, f := range fields {
+			if !screeningschedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != screeningschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ssuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssuo.mutation.Mode(); ok {
+		_spec.SetField(screeningschedule.FieldMode, field.TypeString, value)
+	}
+	if value, ok := ssuo.mutation.WeightTemplateID(); ok {
+		_spec.SetField(screeningschedule.FieldWeightTemplateID, field.TypeUUID, value)
+	}
+	if ssuo.mutation.WeightTemplateIDCleared() {
+		_spec.ClearField(screeningschedule.FieldWeightTemplateID, field.TypeUUID)
+	}
+	if value, ok := ssuo.mutation.LlmConfig(); ok {
+		_spec.SetField(screeningschedule.FieldLlmConfig, field.TypeJSON, value)
+	}
+	if ssuo.mutation.LlmConfigCleared() {
+		_spec.ClearField(screeningschedule.FieldLlmConfig, field.TypeJSON)
+	}
+	if value, ok := ssuo.mutation.KnockoutRules(); ok {
+		_spec.SetField(screeningschedule.FieldKnockoutRules, field.TypeJSON, value)
+	}
+	if ssuo.mutation.KnockoutRulesCleared() {
+		_spec.ClearField(screeningschedule.FieldKnockoutRules, field.TypeJSON)
+	}
+	if value, ok := ssuo.mutation.BlindMode(); ok {
+		_spec.SetField(screeningschedule.FieldBlindMode, field.TypeBool, value)
+	}
+	if value, ok := ssuo.mutation.DigestHour(); ok {
+		_spec.SetField(screeningschedule.FieldDigestHour, field.TypeInt, value)
+	}
+	if value, ok := ssuo.mutation.AddedDigestHour(); ok {
+		_spec.AddField(screeningschedule.FieldDigestHour, field.TypeInt, value)
+	}
+	if value, ok := ssuo.mutation.Enabled(); ok {
+		_spec.SetField(screeningschedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := ssuo.mutation.CreatedBy(); ok {
+		_spec.SetField(screeningschedule.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if value, ok := ssuo.mutation.LastRunAt(); ok {
+		_spec.SetField(screeningschedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if ssuo.mutation.LastRunAtCleared() {
+		_spec.ClearField(screeningschedule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := ssuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(screeningschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ssuo.mutation.JobPositionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   screeningschedule.JobPositionTable,
+			Columns: []string{screeningschedule.JobPositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobposition.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssuo.mutation.JobPositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   screeningschedule.JobPositionTable,
+			Columns: []string{screeningschedule.JobPositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobposition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ssuo.modifiers...)
+	_node = &ScreeningSchedule{config: ssuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ssuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{screeningschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ssuo.mutation.done = true
+	return _node, nil
+}
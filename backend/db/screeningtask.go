@@ -45,6 +45,28 @@ type ScreeningTask struct {
 	ResumeFailed int `json:"resume_failed,omitempty"`
 	// 智能匹配Agent版本号
 	AgentVersion string `json:"agent_version,omitempty"`
+	// Token预算，超出时暂停任务
+	TokenBudget *int64 `json:"token_budget,omitempty"`
+	// 费用预算，超出时暂停任务
+	CostBudget *float64 `json:"cost_budget,omitempty"`
+	// 累计输入Token数
+	TokensInput int64 `json:"tokens_input,omitempty"`
+	// 累计输出Token数
+	TokensOutput int64 `json:"tokens_output,omitempty"`
+	// 累计模型调用费用
+	TotalCost float64 `json:"total_cost,omitempty"`
+	// 禁用筛选结果缓存，强制重新调用模型
+	DisableCache bool `json:"disable_cache,omitempty"`
+	// 硬性淘汰条件，调用模型前校验
+	KnockoutRules map[string]interface{} `json:"knockout_rules,omitempty"`
+	// 盲筛模式，匹配前隐去候选人个人信息
+	BlindMode bool `json:"blind_mode,omitempty"`
+	// 盲筛脱敏策略快照，用于合规审计
+	RedactionPolicy map[string]interface{} `json:"redaction_policy,omitempty"`
+	// 两两比较生成的短名单排名
+	ShortlistRanking map[string]interface{} `json:"shortlist_ranking,omitempty"`
+	// 创建任务时岗位自定义匹配维度快照
+	CustomDimensions []map[string]interface{} `json:"custom_dimensions,omitempty"`
 	// 任务开始时间
 	StartedAt time.Time `json:"started_at,omitempty"`
 	// 任务完成时间
@@ -141,9 +163,13 @@ func (*ScreeningTask) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case screeningtask.FieldDimensionWeights, screeningtask.FieldLlmConfig:
+		case screeningtask.FieldDimensionWeights, screeningtask.FieldLlmConfig, screeningtask.FieldKnockoutRules, screeningtask.FieldRedactionPolicy, screeningtask.FieldShortlistRanking, screeningtask.FieldCustomDimensions:
 			values[i] = new([]byte)
-		case screeningtask.FieldResumeTotal, screeningtask.FieldResumeProcessed, screeningtask.FieldResumeSucceeded, screeningtask.FieldResumeFailed:
+		case screeningtask.FieldDisableCache, screeningtask.FieldBlindMode:
+			values[i] = new(sql.NullBool)
+		case screeningtask.FieldCostBudget, screeningtask.FieldTotalCost:
+			values[i] = new(sql.NullFloat64)
+		case screeningtask.FieldResumeTotal, screeningtask.FieldResumeProcessed, screeningtask.FieldResumeSucceeded, screeningtask.FieldResumeFailed, screeningtask.FieldTokenBudget, screeningtask.FieldTokensInput, screeningtask.FieldTokensOutput:
 			values[i] = new(sql.NullInt64)
 		case screeningtask.FieldStatus, screeningtask.FieldNotes, screeningtask.FieldAgentVersion:
 			values[i] = new(sql.NullString)
@@ -248,6 +274,82 @@ func (st *ScreeningTask) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				st.AgentVersion = value.String
 			}
+		case screeningtask.FieldTokenBudget:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field token_budget", values[i])
+			} else if value.Valid {
+				st.TokenBudget = new(int64)
+				*st.TokenBudget = value.Int64
+			}
+		case screeningtask.FieldCostBudget:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_budget", values[i])
+			} else if value.Valid {
+				st.CostBudget = new(float64)
+				*st.CostBudget = value.Float64
+			}
+		case screeningtask.FieldTokensInput:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tokens_input", values[i])
+			} else if value.Valid {
+				st.TokensInput = value.Int64
+			}
+		case screeningtask.FieldTokensOutput:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tokens_output", values[i])
+			} else if value.Valid {
+				st.TokensOutput = value.Int64
+			}
+		case screeningtask.FieldTotalCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_cost", values[i])
+			} else if value.Valid {
+				st.TotalCost = value.Float64
+			}
+		case screeningtask.FieldDisableCache:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field disable_cache", values[i])
+			} else if value.Valid {
+				st.DisableCache = value.Bool
+			}
+		case screeningtask.FieldKnockoutRules:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field knockout_rules", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &st.KnockoutRules); err != nil {
+					return fmt.Errorf("unmarshal field knockout_rules: %w", err)
+				}
+			}
+		case screeningtask.FieldBlindMode:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field blind_mode", values[i])
+			} else if value.Valid {
+				st.BlindMode = value.Bool
+			}
+		case screeningtask.FieldRedactionPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field redaction_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &st.RedactionPolicy); err != nil {
+					return fmt.Errorf("unmarshal field redaction_policy: %w", err)
+				}
+			}
+		case screeningtask.FieldShortlistRanking:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field shortlist_ranking", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &st.ShortlistRanking); err != nil {
+					return fmt.Errorf("unmarshal field shortlist_ranking: %w", err)
+				}
+			}
+		case screeningtask.FieldCustomDimensions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field custom_dimensions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &st.CustomDimensions); err != nil {
+					return fmt.Errorf("unmarshal field custom_dimensions: %w", err)
+				}
+			}
 		case screeningtask.FieldStartedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field started_at", values[i])
@@ -374,6 +476,43 @@ func (st *ScreeningTask) String() string {
 	builder.WriteString("agent_version=")
 	builder.WriteString(st.AgentVersion)
 	builder.WriteString(", ")
+	if v := st.TokenBudget; v != nil {
+		builder.WriteString("token_budget=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := st.CostBudget; v != nil {
+		builder.WriteString("cost_budget=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("tokens_input=")
+	builder.WriteString(fmt.Sprintf("%v", st.TokensInput))
+	builder.WriteString(", ")
+	builder.WriteString("tokens_output=")
+	builder.WriteString(fmt.Sprintf("%v", st.TokensOutput))
+	builder.WriteString(", ")
+	builder.WriteString("total_cost=")
+	builder.WriteString(fmt.Sprintf("%v", st.TotalCost))
+	builder.WriteString(", ")
+	builder.WriteString("disable_cache=")
+	builder.WriteString(fmt.Sprintf("%v", st.DisableCache))
+	builder.WriteString(", ")
+	builder.WriteString("knockout_rules=")
+	builder.WriteString(fmt.Sprintf("%v", st.KnockoutRules))
+	builder.WriteString(", ")
+	builder.WriteString("blind_mode=")
+	builder.WriteString(fmt.Sprintf("%v", st.BlindMode))
+	builder.WriteString(", ")
+	builder.WriteString("redaction_policy=")
+	builder.WriteString(fmt.Sprintf("%v", st.RedactionPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("shortlist_ranking=")
+	builder.WriteString(fmt.Sprintf("%v", st.ShortlistRanking))
+	builder.WriteString(", ")
+	builder.WriteString("custom_dimensions=")
+	builder.WriteString(fmt.Sprintf("%v", st.CustomDimensions))
+	builder.WriteString(", ")
 	builder.WriteString("started_at=")
 	builder.WriteString(st.StartedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
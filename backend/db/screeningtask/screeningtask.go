@@ -40,6 +40,28 @@ const (
 	FieldResumeFailed = "resume_failed"
 	// FieldAgentVersion holds the string denoting the agent_version field in the database.
 	FieldAgentVersion = "agent_version"
+	// FieldTokenBudget holds the string denoting the token_budget field in the database.
+	FieldTokenBudget = "token_budget"
+	// FieldCostBudget holds the string denoting the cost_budget field in the database.
+	FieldCostBudget = "cost_budget"
+	// FieldTokensInput holds the string denoting the tokens_input field in the database.
+	FieldTokensInput = "tokens_input"
+	// FieldTokensOutput holds the string denoting the tokens_output field in the database.
+	FieldTokensOutput = "tokens_output"
+	// FieldTotalCost holds the string denoting the total_cost field in the database.
+	FieldTotalCost = "total_cost"
+	// FieldDisableCache holds the string denoting the disable_cache field in the database.
+	FieldDisableCache = "disable_cache"
+	// FieldKnockoutRules holds the string denoting the knockout_rules field in the database.
+	FieldKnockoutRules = "knockout_rules"
+	// FieldBlindMode holds the string denoting the blind_mode field in the database.
+	FieldBlindMode = "blind_mode"
+	// FieldRedactionPolicy holds the string denoting the redaction_policy field in the database.
+	FieldRedactionPolicy = "redaction_policy"
+	// FieldShortlistRanking holds the string denoting the shortlist_ranking field in the database.
+	FieldShortlistRanking = "shortlist_ranking"
+	// FieldCustomDimensions holds the string denoting the custom_dimensions field in the database.
+	FieldCustomDimensions = "custom_dimensions"
 	// FieldStartedAt holds the string denoting the started_at field in the database.
 	FieldStartedAt = "started_at"
 	// FieldFinishedAt holds the string denoting the finished_at field in the database.
@@ -121,6 +143,17 @@ var Columns = []string{
 	FieldResumeSucceeded,
 	FieldResumeFailed,
 	FieldAgentVersion,
+	FieldTokenBudget,
+	FieldCostBudget,
+	FieldTokensInput,
+	FieldTokensOutput,
+	FieldTotalCost,
+	FieldDisableCache,
+	FieldKnockoutRules,
+	FieldBlindMode,
+	FieldRedactionPolicy,
+	FieldShortlistRanking,
+	FieldCustomDimensions,
 	FieldStartedAt,
 	FieldFinishedAt,
 	FieldCreatedAt,
@@ -157,6 +190,16 @@ var (
 	DefaultResumeFailed int
 	// AgentVersionValidator is a validator for the "agent_version" field. It is called by the builders before save.
 	AgentVersionValidator func(string) error
+	// DefaultTokensInput holds the default value on creation for the "tokens_input" field.
+	DefaultTokensInput int64
+	// DefaultTokensOutput holds the default value on creation for the "tokens_output" field.
+	DefaultTokensOutput int64
+	// DefaultTotalCost holds the default value on creation for the "total_cost" field.
+	DefaultTotalCost float64
+	// DefaultDisableCache holds the default value on creation for the "disable_cache" field.
+	DefaultDisableCache bool
+	// DefaultBlindMode holds the default value on creation for the "blind_mode" field.
+	DefaultBlindMode bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -225,6 +268,41 @@ func ByAgentVersion(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAgentVersion, opts...).ToFunc()
 }
 
+// ByTokenBudget orders the results by the token_budget field.
+func ByTokenBudget(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokenBudget, opts...).ToFunc()
+}
+
+// ByCostBudget orders the results by the cost_budget field.
+func ByCostBudget(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostBudget, opts...).ToFunc()
+}
+
+// ByTokensInput orders the results by the tokens_input field.
+func ByTokensInput(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokensInput, opts...).ToFunc()
+}
+
+// ByTokensOutput orders the results by the tokens_output field.
+func ByTokensOutput(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokensOutput, opts...).ToFunc()
+}
+
+// ByTotalCost orders the results by the total_cost field.
+func ByTotalCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalCost, opts...).ToFunc()
+}
+
+// ByDisableCache orders the results by the disable_cache field.
+func ByDisableCache(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisableCache, opts...).ToFunc()
+}
+
+// ByBlindMode orders the results by the blind_mode field.
+func ByBlindMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlindMode, opts...).ToFunc()
+}
+
 // ByStartedAt orders the results by the started_at field.
 func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
@@ -106,6 +106,41 @@ func AgentVersion(v string) predicate.ScreeningTask {
 	return predicate.ScreeningTask(sql.FieldEQ(FieldAgentVersion, v))
 }
 
+// TokenBudget applies equality check predicate on the "token_budget" field. It's identical to TokenBudgetEQ.
+func TokenBudget(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldEQ(FieldTokenBudget, v))
+}
+
+// CostBudget applies equality check predicate on the "cost_budget" field. It's identical to CostBudgetEQ.
+func CostBudget(v float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldEQ(FieldCostBudget, v))
+}
+
+// TokensInput applies equality check predicate on the "tokens_input" field. It's identical to TokensInputEQ.
+func TokensInput(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldEQ(FieldTokensInput, v))
+}
+
+// TokensOutput applies equality check predicate on the "tokens_output" field. It's identical to TokensOutputEQ.
+func TokensOutput(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldEQ(FieldTokensOutput, v))
+}
+
+// TotalCost applies equality check predicate on the "total_cost" field. It's identical to TotalCostEQ.
+func TotalCost(v float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldEQ(FieldTotalCost, v))
+}
+
+// DisableCache applies equality check predicate on the "disable_cache" field. It's identical to DisableCacheEQ.
+func DisableCache(v bool) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldEQ(FieldDisableCache, v))
+}
+
+// BlindMode applies equality check predicate on the "blind_mode" field. It's identical to BlindModeEQ.
+func BlindMode(v bool) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldEQ(FieldBlindMode, v))
+}
+
 // StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
 func StartedAt(v time.Time) predicate.ScreeningTask {
 	return predicate.ScreeningTask(sql.FieldEQ(FieldStartedAt, v))
@@ -611,6 +646,286 @@ func AgentVersionContainsFold(v string) predicate.ScreeningTask {
 	return predicate.ScreeningTask(sql.FieldContainsFold(FieldAgentVersion, v))
 }
 
+// TokenBudgetEQ applies the EQ predicate on the "token_budget" field.
+func TokenBudgetEQ(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldEQ(FieldTokenBudget, v))
+}
+
+// TokenBudgetNEQ applies the NEQ predicate on the "token_budget" field.
+func TokenBudgetNEQ(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNEQ(FieldTokenBudget, v))
+}
+
+// TokenBudgetIn applies the In predicate on the "token_budget" field.
+func TokenBudgetIn(vs ...int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldIn(FieldTokenBudget, vs...))
+}
+
+// TokenBudgetNotIn applies the NotIn predicate on the "token_budget" field.
+func TokenBudgetNotIn(vs ...int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNotIn(FieldTokenBudget, vs...))
+}
+
+// TokenBudgetGT applies the GT predicate on the "token_budget" field.
+func TokenBudgetGT(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldGT(FieldTokenBudget, v))
+}
+
+// TokenBudgetGTE applies the GTE predicate on the "token_budget" field.
+func TokenBudgetGTE(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldGTE(FieldTokenBudget, v))
+}
+
+// TokenBudgetLT applies the LT predicate on the "token_budget" field.
+func TokenBudgetLT(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldLT(FieldTokenBudget, v))
+}
+
+// TokenBudgetLTE applies the LTE predicate on the "token_budget" field.
+func TokenBudgetLTE(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldLTE(FieldTokenBudget, v))
+}
+
+// TokenBudgetIsNil applies the IsNil predicate on the "token_budget" field.
+func TokenBudgetIsNil() predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldIsNull(FieldTokenBudget))
+}
+
+// TokenBudgetNotNil applies the NotNil predicate on the "token_budget" field.
+func TokenBudgetNotNil() predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNotNull(FieldTokenBudget))
+}
+
+// CostBudgetEQ applies the EQ predicate on the "cost_budget" field.
+func CostBudgetEQ(v float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldEQ(FieldCostBudget, v))
+}
+
+// CostBudgetNEQ applies the NEQ predicate on the "cost_budget" field.
+func CostBudgetNEQ(v float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNEQ(FieldCostBudget, v))
+}
+
+// CostBudgetIn applies the In predicate on the "cost_budget" field.
+func CostBudgetIn(vs ...float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldIn(FieldCostBudget, vs...))
+}
+
+// CostBudgetNotIn applies the NotIn predicate on the "cost_budget" field.
+func CostBudgetNotIn(vs ...float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNotIn(FieldCostBudget, vs...))
+}
+
+// CostBudgetGT applies the GT predicate on the "cost_budget" field.
+func CostBudgetGT(v float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldGT(FieldCostBudget, v))
+}
+
+// CostBudgetGTE applies the GTE predicate on the "cost_budget" field.
+func CostBudgetGTE(v float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldGTE(FieldCostBudget, v))
+}
+
+// CostBudgetLT applies the LT predicate on the "cost_budget" field.
+func CostBudgetLT(v float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldLT(FieldCostBudget, v))
+}
+
+// CostBudgetLTE applies the LTE predicate on the "cost_budget" field.
+func CostBudgetLTE(v float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldLTE(FieldCostBudget, v))
+}
+
+// CostBudgetIsNil applies the IsNil predicate on the "cost_budget" field.
+func CostBudgetIsNil() predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldIsNull(FieldCostBudget))
+}
+
+// CostBudgetNotNil applies the NotNil predicate on the "cost_budget" field.
+func CostBudgetNotNil() predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNotNull(FieldCostBudget))
+}
+
+// TokensInputEQ applies the EQ predicate on the "tokens_input" field.
+func TokensInputEQ(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldEQ(FieldTokensInput, v))
+}
+
+// TokensInputNEQ applies the NEQ predicate on the "tokens_input" field.
+func TokensInputNEQ(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNEQ(FieldTokensInput, v))
+}
+
+// TokensInputIn applies the In predicate on the "tokens_input" field.
+func TokensInputIn(vs ...int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldIn(FieldTokensInput, vs...))
+}
+
+// TokensInputNotIn applies the NotIn predicate on the "tokens_input" field.
+func TokensInputNotIn(vs ...int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNotIn(FieldTokensInput, vs...))
+}
+
+// TokensInputGT applies the GT predicate on the "tokens_input" field.
+func TokensInputGT(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldGT(FieldTokensInput, v))
+}
+
+// TokensInputGTE applies the GTE predicate on the "tokens_input" field.
+func TokensInputGTE(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldGTE(FieldTokensInput, v))
+}
+
+// TokensInputLT applies the LT predicate on the "tokens_input" field.
+func TokensInputLT(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldLT(FieldTokensInput, v))
+}
+
+// TokensInputLTE applies the LTE predicate on the "tokens_input" field.
+func TokensInputLTE(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldLTE(FieldTokensInput, v))
+}
+
+// TokensOutputEQ applies the EQ predicate on the "tokens_output" field.
+func TokensOutputEQ(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldEQ(FieldTokensOutput, v))
+}
+
+// TokensOutputNEQ applies the NEQ predicate on the "tokens_output" field.
+func TokensOutputNEQ(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNEQ(FieldTokensOutput, v))
+}
+
+// TokensOutputIn applies the In predicate on the "tokens_output" field.
+func TokensOutputIn(vs ...int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldIn(FieldTokensOutput, vs...))
+}
+
+// TokensOutputNotIn applies the NotIn predicate on the "tokens_output" field.
+func TokensOutputNotIn(vs ...int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNotIn(FieldTokensOutput, vs...))
+}
+
+// TokensOutputGT applies the GT predicate on the "tokens_output" field.
+func TokensOutputGT(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldGT(FieldTokensOutput, v))
+}
+
+// TokensOutputGTE applies the GTE predicate on the "tokens_output" field.
+func TokensOutputGTE(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldGTE(FieldTokensOutput, v))
+}
+
+// TokensOutputLT applies the LT predicate on the "tokens_output" field.
+func TokensOutputLT(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldLT(FieldTokensOutput, v))
+}
+
+// TokensOutputLTE applies the LTE predicate on the "tokens_output" field.
+func TokensOutputLTE(v int64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldLTE(FieldTokensOutput, v))
+}
+
+// TotalCostEQ applies the EQ predicate on the "total_cost" field.
+func TotalCostEQ(v float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldEQ(FieldTotalCost, v))
+}
+
+// TotalCostNEQ applies the NEQ predicate on the "total_cost" field.
+func TotalCostNEQ(v float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNEQ(FieldTotalCost, v))
+}
+
+// TotalCostIn applies the In predicate on the "total_cost" field.
+func TotalCostIn(vs ...float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldIn(FieldTotalCost, vs...))
+}
+
+// TotalCostNotIn applies the NotIn predicate on the "total_cost" field.
+func TotalCostNotIn(vs ...float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNotIn(FieldTotalCost, vs...))
+}
+
+// TotalCostGT applies the GT predicate on the "total_cost" field.
+func TotalCostGT(v float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldGT(FieldTotalCost, v))
+}
+
+// TotalCostGTE applies the GTE predicate on the "total_cost" field.
+func TotalCostGTE(v float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldGTE(FieldTotalCost, v))
+}
+
+// TotalCostLT applies the LT predicate on the "total_cost" field.
+func TotalCostLT(v float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldLT(FieldTotalCost, v))
+}
+
+// TotalCostLTE applies the LTE predicate on the "total_cost" field.
+func TotalCostLTE(v float64) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldLTE(FieldTotalCost, v))
+}
+
+// DisableCacheEQ applies the EQ predicate on the "disable_cache" field.
+func DisableCacheEQ(v bool) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldEQ(FieldDisableCache, v))
+}
+
+// DisableCacheNEQ applies the NEQ predicate on the "disable_cache" field.
+func DisableCacheNEQ(v bool) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNEQ(FieldDisableCache, v))
+}
+
+// KnockoutRulesIsNil applies the IsNil predicate on the "knockout_rules" field.
+func KnockoutRulesIsNil() predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldIsNull(FieldKnockoutRules))
+}
+
+// KnockoutRulesNotNil applies the NotNil predicate on the "knockout_rules" field.
+func KnockoutRulesNotNil() predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNotNull(FieldKnockoutRules))
+}
+
+// BlindModeEQ applies the EQ predicate on the "blind_mode" field.
+func BlindModeEQ(v bool) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldEQ(FieldBlindMode, v))
+}
+
+// BlindModeNEQ applies the NEQ predicate on the "blind_mode" field.
+func BlindModeNEQ(v bool) predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNEQ(FieldBlindMode, v))
+}
+
+// RedactionPolicyIsNil applies the IsNil predicate on the "redaction_policy" field.
+func RedactionPolicyIsNil() predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldIsNull(FieldRedactionPolicy))
+}
+
+// RedactionPolicyNotNil applies the NotNil predicate on the "redaction_policy" field.
+func RedactionPolicyNotNil() predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNotNull(FieldRedactionPolicy))
+}
+
+// ShortlistRankingIsNil applies the IsNil predicate on the "shortlist_ranking" field.
+func ShortlistRankingIsNil() predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldIsNull(FieldShortlistRanking))
+}
+
+// ShortlistRankingNotNil applies the NotNil predicate on the "shortlist_ranking" field.
+func ShortlistRankingNotNil() predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNotNull(FieldShortlistRanking))
+}
+
+// CustomDimensionsIsNil applies the IsNil predicate on the "custom_dimensions" field.
+func CustomDimensionsIsNil() predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldIsNull(FieldCustomDimensions))
+}
+
+// CustomDimensionsNotNil applies the NotNil predicate on the "custom_dimensions" field.
+func CustomDimensionsNotNil() predicate.ScreeningTask {
+	return predicate.ScreeningTask(sql.FieldNotNull(FieldCustomDimensions))
+}
+
 // StartedAtEQ applies the EQ predicate on the "started_at" field.
 func StartedAtEQ(v time.Time) predicate.ScreeningTask {
 	return predicate.ScreeningTask(sql.FieldEQ(FieldStartedAt, v))
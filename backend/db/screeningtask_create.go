@@ -166,6 +166,128 @@ func (stc *ScreeningTaskCreate) SetNillableAgentVersion(s *string) *ScreeningTas
 	return stc
 }
 
+// SetTokenBudget sets the "token_budget" field.
+func (stc *ScreeningTaskCreate) SetTokenBudget(i int64) *ScreeningTaskCreate {
+	stc.mutation.SetTokenBudget(i)
+	return stc
+}
+
+// SetNillableTokenBudget sets the "token_budget" field if the given value is not nil.
+func (stc *ScreeningTaskCreate) SetNillableTokenBudget(i *int64) *ScreeningTaskCreate {
+	if i != nil {
+		stc.SetTokenBudget(*i)
+	}
+	return stc
+}
+
+// SetCostBudget sets the "cost_budget" field.
+func (stc *ScreeningTaskCreate) SetCostBudget(f float64) *ScreeningTaskCreate {
+	stc.mutation.SetCostBudget(f)
+	return stc
+}
+
+// SetNillableCostBudget sets the "cost_budget" field if the given value is not nil.
+func (stc *ScreeningTaskCreate) SetNillableCostBudget(f *float64) *ScreeningTaskCreate {
+	if f != nil {
+		stc.SetCostBudget(*f)
+	}
+	return stc
+}
+
+// SetTokensInput sets the "tokens_input" field.
+func (stc *ScreeningTaskCreate) SetTokensInput(i int64) *ScreeningTaskCreate {
+	stc.mutation.SetTokensInput(i)
+	return stc
+}
+
+// SetNillableTokensInput sets the "tokens_input" field if the given value is not nil.
+func (stc *ScreeningTaskCreate) SetNillableTokensInput(i *int64) *ScreeningTaskCreate {
+	if i != nil {
+		stc.SetTokensInput(*i)
+	}
+	return stc
+}
+
+// SetTokensOutput sets the "tokens_output" field.
+func (stc *ScreeningTaskCreate) SetTokensOutput(i int64) *ScreeningTaskCreate {
+	stc.mutation.SetTokensOutput(i)
+	return stc
+}
+
+// SetNillableTokensOutput sets the "tokens_output" field if the given value is not nil.
+func (stc *ScreeningTaskCreate) SetNillableTokensOutput(i *int64) *ScreeningTaskCreate {
+	if i != nil {
+		stc.SetTokensOutput(*i)
+	}
+	return stc
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (stc *ScreeningTaskCreate) SetTotalCost(f float64) *ScreeningTaskCreate {
+	stc.mutation.SetTotalCost(f)
+	return stc
+}
+
+// SetNillableTotalCost sets the "total_cost" field if the given value is not nil.
+func (stc *ScreeningTaskCreate) SetNillableTotalCost(f *float64) *ScreeningTaskCreate {
+	if f != nil {
+		stc.SetTotalCost(*f)
+	}
+	return stc
+}
+
+// SetDisableCache sets the "disable_cache" field.
+func (stc *ScreeningTaskCreate) SetDisableCache(b bool) *ScreeningTaskCreate {
+	stc.mutation.SetDisableCache(b)
+	return stc
+}
+
+// SetNillableDisableCache sets the "disable_cache" field if the given value is not nil.
+func (stc *ScreeningTaskCreate) SetNillableDisableCache(b *bool) *ScreeningTaskCreate {
+	if b != nil {
+		stc.SetDisableCache(*b)
+	}
+	return stc
+}
+
+// SetKnockoutRules sets the "knockout_rules" field.
+func (stc *ScreeningTaskCreate) SetKnockoutRules(m map[string]interface{}) *ScreeningTaskCreate {
+	stc.mutation.SetKnockoutRules(m)
+	return stc
+}
+
+// SetBlindMode sets the "blind_mode" field.
+func (stc *ScreeningTaskCreate) SetBlindMode(b bool) *ScreeningTaskCreate {
+	stc.mutation.SetBlindMode(b)
+	return stc
+}
+
+// SetNillableBlindMode sets the "blind_mode" field if the given value is not nil.
+func (stc *ScreeningTaskCreate) SetNillableBlindMode(b *bool) *ScreeningTaskCreate {
+	if b != nil {
+		stc.SetBlindMode(*b)
+	}
+	return stc
+}
+
+// SetRedactionPolicy sets the "redaction_policy" field.
+func (stc *ScreeningTaskCreate) SetRedactionPolicy(m map[string]interface{}) *ScreeningTaskCreate {
+	stc.mutation.SetRedactionPolicy(m)
+	return stc
+}
+
+// SetShortlistRanking sets the "shortlist_ranking" field.
+func (stc *ScreeningTaskCreate) SetShortlistRanking(m map[string]interface{}) *ScreeningTaskCreate {
+	stc.mutation.SetShortlistRanking(m)
+	return stc
+}
+
+// SetCustomDimensions sets the "custom_dimensions" field.
+func (stc *ScreeningTaskCreate) SetCustomDimensions(m []map[string]interface{}) *ScreeningTaskCreate {
+	stc.mutation.SetCustomDimensions(m)
+	return stc
+}
+
 // SetStartedAt sets the "started_at" field.
 func (stc *ScreeningTaskCreate) SetStartedAt(t time.Time) *ScreeningTaskCreate {
 	stc.mutation.SetStartedAt(t)
@@ -369,6 +491,26 @@ func (stc *ScreeningTaskCreate) defaults() error {
 		v := screeningtask.DefaultResumeFailed
 		stc.mutation.SetResumeFailed(v)
 	}
+	if _, ok := stc.mutation.TokensInput(); !ok {
+		v := screeningtask.DefaultTokensInput
+		stc.mutation.SetTokensInput(v)
+	}
+	if _, ok := stc.mutation.TokensOutput(); !ok {
+		v := screeningtask.DefaultTokensOutput
+		stc.mutation.SetTokensOutput(v)
+	}
+	if _, ok := stc.mutation.TotalCost(); !ok {
+		v := screeningtask.DefaultTotalCost
+		stc.mutation.SetTotalCost(v)
+	}
+	if _, ok := stc.mutation.DisableCache(); !ok {
+		v := screeningtask.DefaultDisableCache
+		stc.mutation.SetDisableCache(v)
+	}
+	if _, ok := stc.mutation.BlindMode(); !ok {
+		v := screeningtask.DefaultBlindMode
+		stc.mutation.SetBlindMode(v)
+	}
 	if _, ok := stc.mutation.CreatedAt(); !ok {
 		if screeningtask.DefaultCreatedAt == nil {
 			return fmt.Errorf("db: uninitialized screeningtask.DefaultCreatedAt (forgotten import db/runtime?)")
@@ -421,6 +563,21 @@ func (stc *ScreeningTaskCreate) check() error {
 			return &ValidationError{Name: "agent_version", err: fmt.Errorf(`db: validator failed for field "ScreeningTask.agent_version": %w`, err)}
 		}
 	}
+	if _, ok := stc.mutation.TokensInput(); !ok {
+		return &ValidationError{Name: "tokens_input", err: errors.New(`db: missing required field "ScreeningTask.tokens_input"`)}
+	}
+	if _, ok := stc.mutation.TokensOutput(); !ok {
+		return &ValidationError{Name: "tokens_output", err: errors.New(`db: missing required field "ScreeningTask.tokens_output"`)}
+	}
+	if _, ok := stc.mutation.TotalCost(); !ok {
+		return &ValidationError{Name: "total_cost", err: errors.New(`db: missing required field "ScreeningTask.total_cost"`)}
+	}
+	if _, ok := stc.mutation.DisableCache(); !ok {
+		return &ValidationError{Name: "disable_cache", err: errors.New(`db: missing required field "ScreeningTask.disable_cache"`)}
+	}
+	if _, ok := stc.mutation.BlindMode(); !ok {
+		return &ValidationError{Name: "blind_mode", err: errors.New(`db: missing required field "ScreeningTask.blind_mode"`)}
+	}
 	if _, ok := stc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "ScreeningTask.created_at"`)}
 	}
@@ -509,6 +666,50 @@ func (stc *ScreeningTaskCreate) createSpec() (*ScreeningTask, *sqlgraph.CreateSp
 		_spec.SetField(screeningtask.FieldAgentVersion, field.TypeString, value)
 		_node.AgentVersion = value
 	}
+	if value, ok := stc.mutation.TokenBudget(); ok {
+		_spec.SetField(screeningtask.FieldTokenBudget, field.TypeInt64, value)
+		_node.TokenBudget = &value
+	}
+	if value, ok := stc.mutation.CostBudget(); ok {
+		_spec.SetField(screeningtask.FieldCostBudget, field.TypeFloat64, value)
+		_node.CostBudget = &value
+	}
+	if value, ok := stc.mutation.TokensInput(); ok {
+		_spec.SetField(screeningtask.FieldTokensInput, field.TypeInt64, value)
+		_node.TokensInput = value
+	}
+	if value, ok := stc.mutation.TokensOutput(); ok {
+		_spec.SetField(screeningtask.FieldTokensOutput, field.TypeInt64, value)
+		_node.TokensOutput = value
+	}
+	if value, ok := stc.mutation.TotalCost(); ok {
+		_spec.SetField(screeningtask.FieldTotalCost, field.TypeFloat64, value)
+		_node.TotalCost = value
+	}
+	if value, ok := stc.mutation.DisableCache(); ok {
+		_spec.SetField(screeningtask.FieldDisableCache, field.TypeBool, value)
+		_node.DisableCache = value
+	}
+	if value, ok := stc.mutation.KnockoutRules(); ok {
+		_spec.SetField(screeningtask.FieldKnockoutRules, field.TypeJSON, value)
+		_node.KnockoutRules = value
+	}
+	if value, ok := stc.mutation.BlindMode(); ok {
+		_spec.SetField(screeningtask.FieldBlindMode, field.TypeBool, value)
+		_node.BlindMode = value
+	}
+	if value, ok := stc.mutation.RedactionPolicy(); ok {
+		_spec.SetField(screeningtask.FieldRedactionPolicy, field.TypeJSON, value)
+		_node.RedactionPolicy = value
+	}
+	if value, ok := stc.mutation.ShortlistRanking(); ok {
+		_spec.SetField(screeningtask.FieldShortlistRanking, field.TypeJSON, value)
+		_node.ShortlistRanking = value
+	}
+	if value, ok := stc.mutation.CustomDimensions(); ok {
+		_spec.SetField(screeningtask.FieldCustomDimensions, field.TypeJSON, value)
+		_node.CustomDimensions = value
+	}
 	if value, ok := stc.mutation.StartedAt(); ok {
 		_spec.SetField(screeningtask.FieldStartedAt, field.TypeTime, value)
 		_node.StartedAt = value
@@ -873,6 +1074,204 @@ func (u *ScreeningTaskUpsert) ClearAgentVersion() *ScreeningTaskUpsert {
 	return u
 }
 
+// SetTokenBudget sets the "token_budget" field.
+func (u *ScreeningTaskUpsert) SetTokenBudget(v int64) *ScreeningTaskUpsert {
+	u.Set(screeningtask.FieldTokenBudget, v)
+	return u
+}
+
+// UpdateTokenBudget sets the "token_budget" field to the value that was provided on create.
+func (u *ScreeningTaskUpsert) UpdateTokenBudget() *ScreeningTaskUpsert {
+	u.SetExcluded(screeningtask.FieldTokenBudget)
+	return u
+}
+
+// AddTokenBudget adds v to the "token_budget" field.
+func (u *ScreeningTaskUpsert) AddTokenBudget(v int64) *ScreeningTaskUpsert {
+	u.Add(screeningtask.FieldTokenBudget, v)
+	return u
+}
+
+// ClearTokenBudget clears the value of the "token_budget" field.
+func (u *ScreeningTaskUpsert) ClearTokenBudget() *ScreeningTaskUpsert {
+	u.SetNull(screeningtask.FieldTokenBudget)
+	return u
+}
+
+// SetCostBudget sets the "cost_budget" field.
+func (u *ScreeningTaskUpsert) SetCostBudget(v float64) *ScreeningTaskUpsert {
+	u.Set(screeningtask.FieldCostBudget, v)
+	return u
+}
+
+// UpdateCostBudget sets the "cost_budget" field to the value that was provided on create.
+func (u *ScreeningTaskUpsert) UpdateCostBudget() *ScreeningTaskUpsert {
+	u.SetExcluded(screeningtask.FieldCostBudget)
+	return u
+}
+
+// AddCostBudget adds v to the "cost_budget" field.
+func (u *ScreeningTaskUpsert) AddCostBudget(v float64) *ScreeningTaskUpsert {
+	u.Add(screeningtask.FieldCostBudget, v)
+	return u
+}
+
+// ClearCostBudget clears the value of the "cost_budget" field.
+func (u *ScreeningTaskUpsert) ClearCostBudget() *ScreeningTaskUpsert {
+	u.SetNull(screeningtask.FieldCostBudget)
+	return u
+}
+
+// SetTokensInput sets the "tokens_input" field.
+func (u *ScreeningTaskUpsert) SetTokensInput(v int64) *ScreeningTaskUpsert {
+	u.Set(screeningtask.FieldTokensInput, v)
+	return u
+}
+
+// UpdateTokensInput sets the "tokens_input" field to the value that was provided on create.
+func (u *ScreeningTaskUpsert) UpdateTokensInput() *ScreeningTaskUpsert {
+	u.SetExcluded(screeningtask.FieldTokensInput)
+	return u
+}
+
+// AddTokensInput adds v to the "tokens_input" field.
+func (u *ScreeningTaskUpsert) AddTokensInput(v int64) *ScreeningTaskUpsert {
+	u.Add(screeningtask.FieldTokensInput, v)
+	return u
+}
+
+// SetTokensOutput sets the "tokens_output" field.
+func (u *ScreeningTaskUpsert) SetTokensOutput(v int64) *ScreeningTaskUpsert {
+	u.Set(screeningtask.FieldTokensOutput, v)
+	return u
+}
+
+// UpdateTokensOutput sets the "tokens_output" field to the value that was provided on create.
+func (u *ScreeningTaskUpsert) UpdateTokensOutput() *ScreeningTaskUpsert {
+	u.SetExcluded(screeningtask.FieldTokensOutput)
+	return u
+}
+
+// AddTokensOutput adds v to the "tokens_output" field.
+func (u *ScreeningTaskUpsert) AddTokensOutput(v int64) *ScreeningTaskUpsert {
+	u.Add(screeningtask.FieldTokensOutput, v)
+	return u
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (u *ScreeningTaskUpsert) SetTotalCost(v float64) *ScreeningTaskUpsert {
+	u.Set(screeningtask.FieldTotalCost, v)
+	return u
+}
+
+// UpdateTotalCost sets the "total_cost" field to the value that was provided on create.
+func (u *ScreeningTaskUpsert) UpdateTotalCost() *ScreeningTaskUpsert {
+	u.SetExcluded(screeningtask.FieldTotalCost)
+	return u
+}
+
+// AddTotalCost adds v to the "total_cost" field.
+func (u *ScreeningTaskUpsert) AddTotalCost(v float64) *ScreeningTaskUpsert {
+	u.Add(screeningtask.FieldTotalCost, v)
+	return u
+}
+
+// SetDisableCache sets the "disable_cache" field.
+func (u *ScreeningTaskUpsert) SetDisableCache(v bool) *ScreeningTaskUpsert {
+	u.Set(screeningtask.FieldDisableCache, v)
+	return u
+}
+
+// UpdateDisableCache sets the "disable_cache" field to the value that was provided on create.
+func (u *ScreeningTaskUpsert) UpdateDisableCache() *ScreeningTaskUpsert {
+	u.SetExcluded(screeningtask.FieldDisableCache)
+	return u
+}
+
+// SetKnockoutRules sets the "knockout_rules" field.
+func (u *ScreeningTaskUpsert) SetKnockoutRules(v map[string]interface{}) *ScreeningTaskUpsert {
+	u.Set(screeningtask.FieldKnockoutRules, v)
+	return u
+}
+
+// UpdateKnockoutRules sets the "knockout_rules" field to the value that was provided on create.
+func (u *ScreeningTaskUpsert) UpdateKnockoutRules() *ScreeningTaskUpsert {
+	u.SetExcluded(screeningtask.FieldKnockoutRules)
+	return u
+}
+
+// ClearKnockoutRules clears the value of the "knockout_rules" field.
+func (u *ScreeningTaskUpsert) ClearKnockoutRules() *ScreeningTaskUpsert {
+	u.SetNull(screeningtask.FieldKnockoutRules)
+	return u
+}
+
+// SetBlindMode sets the "blind_mode" field.
+func (u *ScreeningTaskUpsert) SetBlindMode(v bool) *ScreeningTaskUpsert {
+	u.Set(screeningtask.FieldBlindMode, v)
+	return u
+}
+
+// UpdateBlindMode sets the "blind_mode" field to the value that was provided on create.
+func (u *ScreeningTaskUpsert) UpdateBlindMode() *ScreeningTaskUpsert {
+	u.SetExcluded(screeningtask.FieldBlindMode)
+	return u
+}
+
+// SetRedactionPolicy sets the "redaction_policy" field.
+func (u *ScreeningTaskUpsert) SetRedactionPolicy(v map[string]interface{}) *ScreeningTaskUpsert {
+	u.Set(screeningtask.FieldRedactionPolicy, v)
+	return u
+}
+
+// UpdateRedactionPolicy sets the "redaction_policy" field to the value that was provided on create.
+func (u *ScreeningTaskUpsert) UpdateRedactionPolicy() *ScreeningTaskUpsert {
+	u.SetExcluded(screeningtask.FieldRedactionPolicy)
+	return u
+}
+
+// ClearRedactionPolicy clears the value of the "redaction_policy" field.
+func (u *ScreeningTaskUpsert) ClearRedactionPolicy() *ScreeningTaskUpsert {
+	u.SetNull(screeningtask.FieldRedactionPolicy)
+	return u
+}
+
+// SetShortlistRanking sets the "shortlist_ranking" field.
+func (u *ScreeningTaskUpsert) SetShortlistRanking(v map[string]interface{}) *ScreeningTaskUpsert {
+	u.Set(screeningtask.FieldShortlistRanking, v)
+	return u
+}
+
+// UpdateShortlistRanking sets the "shortlist_ranking" field to the value that was provided on create.
+func (u *ScreeningTaskUpsert) UpdateShortlistRanking() *ScreeningTaskUpsert {
+	u.SetExcluded(screeningtask.FieldShortlistRanking)
+	return u
+}
+
+// ClearShortlistRanking clears the value of the "shortlist_ranking" field.
+func (u *ScreeningTaskUpsert) ClearShortlistRanking() *ScreeningTaskUpsert {
+	u.SetNull(screeningtask.FieldShortlistRanking)
+	return u
+}
+
+// SetCustomDimensions sets the "custom_dimensions" field.
+func (u *ScreeningTaskUpsert) SetCustomDimensions(v []map[string]interface{}) *ScreeningTaskUpsert {
+	u.Set(screeningtask.FieldCustomDimensions, v)
+	return u
+}
+
+// UpdateCustomDimensions sets the "custom_dimensions" field to the value that was provided on create.
+func (u *ScreeningTaskUpsert) UpdateCustomDimensions() *ScreeningTaskUpsert {
+	u.SetExcluded(screeningtask.FieldCustomDimensions)
+	return u
+}
+
+// ClearCustomDimensions clears the value of the "custom_dimensions" field.
+func (u *ScreeningTaskUpsert) ClearCustomDimensions() *ScreeningTaskUpsert {
+	u.SetNull(screeningtask.FieldCustomDimensions)
+	return u
+}
+
 // SetStartedAt sets the "started_at" field.
 func (u *ScreeningTaskUpsert) SetStartedAt(v time.Time) *ScreeningTaskUpsert {
 	u.Set(screeningtask.FieldStartedAt, v)
@@ -1203,6 +1602,237 @@ func (u *ScreeningTaskUpsertOne) ClearAgentVersion() *ScreeningTaskUpsertOne {
 	})
 }
 
+// SetTokenBudget sets the "token_budget" field.
+func (u *ScreeningTaskUpsertOne) SetTokenBudget(v int64) *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetTokenBudget(v)
+	})
+}
+
+// AddTokenBudget adds v to the "token_budget" field.
+func (u *ScreeningTaskUpsertOne) AddTokenBudget(v int64) *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.AddTokenBudget(v)
+	})
+}
+
+// UpdateTokenBudget sets the "token_budget" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertOne) UpdateTokenBudget() *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateTokenBudget()
+	})
+}
+
+// ClearTokenBudget clears the value of the "token_budget" field.
+func (u *ScreeningTaskUpsertOne) ClearTokenBudget() *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.ClearTokenBudget()
+	})
+}
+
+// SetCostBudget sets the "cost_budget" field.
+func (u *ScreeningTaskUpsertOne) SetCostBudget(v float64) *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetCostBudget(v)
+	})
+}
+
+// AddCostBudget adds v to the "cost_budget" field.
+func (u *ScreeningTaskUpsertOne) AddCostBudget(v float64) *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.AddCostBudget(v)
+	})
+}
+
+// UpdateCostBudget sets the "cost_budget" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertOne) UpdateCostBudget() *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateCostBudget()
+	})
+}
+
+// ClearCostBudget clears the value of the "cost_budget" field.
+func (u *ScreeningTaskUpsertOne) ClearCostBudget() *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.ClearCostBudget()
+	})
+}
+
+// SetTokensInput sets the "tokens_input" field.
+func (u *ScreeningTaskUpsertOne) SetTokensInput(v int64) *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetTokensInput(v)
+	})
+}
+
+// AddTokensInput adds v to the "tokens_input" field.
+func (u *ScreeningTaskUpsertOne) AddTokensInput(v int64) *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.AddTokensInput(v)
+	})
+}
+
+// UpdateTokensInput sets the "tokens_input" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertOne) UpdateTokensInput() *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateTokensInput()
+	})
+}
+
+// SetTokensOutput sets the "tokens_output" field.
+func (u *ScreeningTaskUpsertOne) SetTokensOutput(v int64) *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetTokensOutput(v)
+	})
+}
+
+// AddTokensOutput adds v to the "tokens_output" field.
+func (u *ScreeningTaskUpsertOne) AddTokensOutput(v int64) *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.AddTokensOutput(v)
+	})
+}
+
+// UpdateTokensOutput sets the "tokens_output" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertOne) UpdateTokensOutput() *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateTokensOutput()
+	})
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (u *ScreeningTaskUpsertOne) SetTotalCost(v float64) *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetTotalCost(v)
+	})
+}
+
+// AddTotalCost adds v to the "total_cost" field.
+func (u *ScreeningTaskUpsertOne) AddTotalCost(v float64) *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.AddTotalCost(v)
+	})
+}
+
+// UpdateTotalCost sets the "total_cost" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertOne) UpdateTotalCost() *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateTotalCost()
+	})
+}
+
+// SetDisableCache sets the "disable_cache" field.
+func (u *ScreeningTaskUpsertOne) SetDisableCache(v bool) *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetDisableCache(v)
+	})
+}
+
+// UpdateDisableCache sets the "disable_cache" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertOne) UpdateDisableCache() *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateDisableCache()
+	})
+}
+
+// SetKnockoutRules sets the "knockout_rules" field.
+func (u *ScreeningTaskUpsertOne) SetKnockoutRules(v map[string]interface{}) *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetKnockoutRules(v)
+	})
+}
+
+// UpdateKnockoutRules sets the "knockout_rules" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertOne) UpdateKnockoutRules() *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateKnockoutRules()
+	})
+}
+
+// ClearKnockoutRules clears the value of the "knockout_rules" field.
+func (u *ScreeningTaskUpsertOne) ClearKnockoutRules() *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.ClearKnockoutRules()
+	})
+}
+
+// SetBlindMode sets the "blind_mode" field.
+func (u *ScreeningTaskUpsertOne) SetBlindMode(v bool) *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetBlindMode(v)
+	})
+}
+
+// UpdateBlindMode sets the "blind_mode" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertOne) UpdateBlindMode() *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateBlindMode()
+	})
+}
+
+// SetRedactionPolicy sets the "redaction_policy" field.
+func (u *ScreeningTaskUpsertOne) SetRedactionPolicy(v map[string]interface{}) *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetRedactionPolicy(v)
+	})
+}
+
+// UpdateRedactionPolicy sets the "redaction_policy" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertOne) UpdateRedactionPolicy() *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateRedactionPolicy()
+	})
+}
+
+// ClearRedactionPolicy clears the value of the "redaction_policy" field.
+func (u *ScreeningTaskUpsertOne) ClearRedactionPolicy() *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.ClearRedactionPolicy()
+	})
+}
+
+// SetShortlistRanking sets the "shortlist_ranking" field.
+func (u *ScreeningTaskUpsertOne) SetShortlistRanking(v map[string]interface{}) *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetShortlistRanking(v)
+	})
+}
+
+// UpdateShortlistRanking sets the "shortlist_ranking" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertOne) UpdateShortlistRanking() *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateShortlistRanking()
+	})
+}
+
+// ClearShortlistRanking clears the value of the "shortlist_ranking" field.
+func (u *ScreeningTaskUpsertOne) ClearShortlistRanking() *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.ClearShortlistRanking()
+	})
+}
+
+// SetCustomDimensions sets the "custom_dimensions" field.
+func (u *ScreeningTaskUpsertOne) SetCustomDimensions(v []map[string]interface{}) *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetCustomDimensions(v)
+	})
+}
+
+// UpdateCustomDimensions sets the "custom_dimensions" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertOne) UpdateCustomDimensions() *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateCustomDimensions()
+	})
+}
+
+// ClearCustomDimensions clears the value of the "custom_dimensions" field.
+func (u *ScreeningTaskUpsertOne) ClearCustomDimensions() *ScreeningTaskUpsertOne {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.ClearCustomDimensions()
+	})
+}
+
 // SetStartedAt sets the "started_at" field.
 func (u *ScreeningTaskUpsertOne) SetStartedAt(v time.Time) *ScreeningTaskUpsertOne {
 	return u.Update(func(s *ScreeningTaskUpsert) {
@@ -1708,6 +2338,237 @@ func (u *ScreeningTaskUpsertBulk) ClearAgentVersion() *ScreeningTaskUpsertBulk {
 	})
 }
 
+// SetTokenBudget sets the "token_budget" field.
+func (u *ScreeningTaskUpsertBulk) SetTokenBudget(v int64) *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetTokenBudget(v)
+	})
+}
+
+// AddTokenBudget adds v to the "token_budget" field.
+func (u *ScreeningTaskUpsertBulk) AddTokenBudget(v int64) *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.AddTokenBudget(v)
+	})
+}
+
+// UpdateTokenBudget sets the "token_budget" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertBulk) UpdateTokenBudget() *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateTokenBudget()
+	})
+}
+
+// ClearTokenBudget clears the value of the "token_budget" field.
+func (u *ScreeningTaskUpsertBulk) ClearTokenBudget() *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.ClearTokenBudget()
+	})
+}
+
+// SetCostBudget sets the "cost_budget" field.
+func (u *ScreeningTaskUpsertBulk) SetCostBudget(v float64) *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetCostBudget(v)
+	})
+}
+
+// AddCostBudget adds v to the "cost_budget" field.
+func (u *ScreeningTaskUpsertBulk) AddCostBudget(v float64) *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.AddCostBudget(v)
+	})
+}
+
+// UpdateCostBudget sets the "cost_budget" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertBulk) UpdateCostBudget() *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateCostBudget()
+	})
+}
+
+// ClearCostBudget clears the value of the "cost_budget" field.
+func (u *ScreeningTaskUpsertBulk) ClearCostBudget() *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.ClearCostBudget()
+	})
+}
+
+// SetTokensInput sets the "tokens_input" field.
+func (u *ScreeningTaskUpsertBulk) SetTokensInput(v int64) *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetTokensInput(v)
+	})
+}
+
+// AddTokensInput adds v to the "tokens_input" field.
+func (u *ScreeningTaskUpsertBulk) AddTokensInput(v int64) *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.AddTokensInput(v)
+	})
+}
+
+// UpdateTokensInput sets the "tokens_input" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertBulk) UpdateTokensInput() *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateTokensInput()
+	})
+}
+
+// SetTokensOutput sets the "tokens_output" field.
+func (u *ScreeningTaskUpsertBulk) SetTokensOutput(v int64) *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetTokensOutput(v)
+	})
+}
+
+// AddTokensOutput adds v to the "tokens_output" field.
+func (u *ScreeningTaskUpsertBulk) AddTokensOutput(v int64) *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.AddTokensOutput(v)
+	})
+}
+
+// UpdateTokensOutput sets the "tokens_output" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertBulk) UpdateTokensOutput() *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateTokensOutput()
+	})
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (u *ScreeningTaskUpsertBulk) SetTotalCost(v float64) *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetTotalCost(v)
+	})
+}
+
+// AddTotalCost adds v to the "total_cost" field.
+func (u *ScreeningTaskUpsertBulk) AddTotalCost(v float64) *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.AddTotalCost(v)
+	})
+}
+
+// UpdateTotalCost sets the "total_cost" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertBulk) UpdateTotalCost() *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateTotalCost()
+	})
+}
+
+// SetDisableCache sets the "disable_cache" field.
+func (u *ScreeningTaskUpsertBulk) SetDisableCache(v bool) *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetDisableCache(v)
+	})
+}
+
+// UpdateDisableCache sets the "disable_cache" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertBulk) UpdateDisableCache() *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateDisableCache()
+	})
+}
+
+// SetKnockoutRules sets the "knockout_rules" field.
+func (u *ScreeningTaskUpsertBulk) SetKnockoutRules(v map[string]interface{}) *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetKnockoutRules(v)
+	})
+}
+
+// UpdateKnockoutRules sets the "knockout_rules" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertBulk) UpdateKnockoutRules() *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateKnockoutRules()
+	})
+}
+
+// ClearKnockoutRules clears the value of the "knockout_rules" field.
+func (u *ScreeningTaskUpsertBulk) ClearKnockoutRules() *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.ClearKnockoutRules()
+	})
+}
+
+// SetBlindMode sets the "blind_mode" field.
+func (u *ScreeningTaskUpsertBulk) SetBlindMode(v bool) *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetBlindMode(v)
+	})
+}
+
+// UpdateBlindMode sets the "blind_mode" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertBulk) UpdateBlindMode() *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateBlindMode()
+	})
+}
+
+// SetRedactionPolicy sets the "redaction_policy" field.
+func (u *ScreeningTaskUpsertBulk) SetRedactionPolicy(v map[string]interface{}) *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetRedactionPolicy(v)
+	})
+}
+
+// UpdateRedactionPolicy sets the "redaction_policy" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertBulk) UpdateRedactionPolicy() *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateRedactionPolicy()
+	})
+}
+
+// ClearRedactionPolicy clears the value of the "redaction_policy" field.
+func (u *ScreeningTaskUpsertBulk) ClearRedactionPolicy() *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.ClearRedactionPolicy()
+	})
+}
+
+// SetShortlistRanking sets the "shortlist_ranking" field.
+func (u *ScreeningTaskUpsertBulk) SetShortlistRanking(v map[string]interface{}) *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetShortlistRanking(v)
+	})
+}
+
+// UpdateShortlistRanking sets the "shortlist_ranking" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertBulk) UpdateShortlistRanking() *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateShortlistRanking()
+	})
+}
+
+// ClearShortlistRanking clears the value of the "shortlist_ranking" field.
+func (u *ScreeningTaskUpsertBulk) ClearShortlistRanking() *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.ClearShortlistRanking()
+	})
+}
+
+// SetCustomDimensions sets the "custom_dimensions" field.
+func (u *ScreeningTaskUpsertBulk) SetCustomDimensions(v []map[string]interface{}) *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.SetCustomDimensions(v)
+	})
+}
+
+// UpdateCustomDimensions sets the "custom_dimensions" field to the value that was provided on create.
+func (u *ScreeningTaskUpsertBulk) UpdateCustomDimensions() *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.UpdateCustomDimensions()
+	})
+}
+
+// ClearCustomDimensions clears the value of the "custom_dimensions" field.
+func (u *ScreeningTaskUpsertBulk) ClearCustomDimensions() *ScreeningTaskUpsertBulk {
+	return u.Update(func(s *ScreeningTaskUpsert) {
+		s.ClearCustomDimensions()
+	})
+}
+
 // SetStartedAt sets the "started_at" field.
 func (u *ScreeningTaskUpsertBulk) SetStartedAt(v time.Time) *ScreeningTaskUpsertBulk {
 	return u.Update(func(s *ScreeningTaskUpsert) {
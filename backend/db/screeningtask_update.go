@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/chaitin/WhaleHire/backend/db/jobposition"
 	"github.com/chaitin/WhaleHire/backend/db/predicate"
@@ -246,6 +247,205 @@ func (stu *ScreeningTaskUpdate) ClearAgentVersion() *ScreeningTaskUpdate {
 	return stu
 }
 
+// SetTokenBudget sets the "token_budget" field.
+func (stu *ScreeningTaskUpdate) SetTokenBudget(i int64) *ScreeningTaskUpdate {
+	stu.mutation.ResetTokenBudget()
+	stu.mutation.SetTokenBudget(i)
+	return stu
+}
+
+// SetNillableTokenBudget sets the "token_budget" field if the given value is not nil.
+func (stu *ScreeningTaskUpdate) SetNillableTokenBudget(i *int64) *ScreeningTaskUpdate {
+	if i != nil {
+		stu.SetTokenBudget(*i)
+	}
+	return stu
+}
+
+// AddTokenBudget adds i to the "token_budget" field.
+func (stu *ScreeningTaskUpdate) AddTokenBudget(i int64) *ScreeningTaskUpdate {
+	stu.mutation.AddTokenBudget(i)
+	return stu
+}
+
+// ClearTokenBudget clears the value of the "token_budget" field.
+func (stu *ScreeningTaskUpdate) ClearTokenBudget() *ScreeningTaskUpdate {
+	stu.mutation.ClearTokenBudget()
+	return stu
+}
+
+// SetCostBudget sets the "cost_budget" field.
+func (stu *ScreeningTaskUpdate) SetCostBudget(f float64) *ScreeningTaskUpdate {
+	stu.mutation.ResetCostBudget()
+	stu.mutation.SetCostBudget(f)
+	return stu
+}
+
+// SetNillableCostBudget sets the "cost_budget" field if the given value is not nil.
+func (stu *ScreeningTaskUpdate) SetNillableCostBudget(f *float64) *ScreeningTaskUpdate {
+	if f != nil {
+		stu.SetCostBudget(*f)
+	}
+	return stu
+}
+
+// AddCostBudget adds f to the "cost_budget" field.
+func (stu *ScreeningTaskUpdate) AddCostBudget(f float64) *ScreeningTaskUpdate {
+	stu.mutation.AddCostBudget(f)
+	return stu
+}
+
+// ClearCostBudget clears the value of the "cost_budget" field.
+func (stu *ScreeningTaskUpdate) ClearCostBudget() *ScreeningTaskUpdate {
+	stu.mutation.ClearCostBudget()
+	return stu
+}
+
+// SetTokensInput sets the "tokens_input" field.
+func (stu *ScreeningTaskUpdate) SetTokensInput(i int64) *ScreeningTaskUpdate {
+	stu.mutation.ResetTokensInput()
+	stu.mutation.SetTokensInput(i)
+	return stu
+}
+
+// SetNillableTokensInput sets the "tokens_input" field if the given value is not nil.
+func (stu *ScreeningTaskUpdate) SetNillableTokensInput(i *int64) *ScreeningTaskUpdate {
+	if i != nil {
+		stu.SetTokensInput(*i)
+	}
+	return stu
+}
+
+// AddTokensInput adds i to the "tokens_input" field.
+func (stu *ScreeningTaskUpdate) AddTokensInput(i int64) *ScreeningTaskUpdate {
+	stu.mutation.AddTokensInput(i)
+	return stu
+}
+
+// SetTokensOutput sets the "tokens_output" field.
+func (stu *ScreeningTaskUpdate) SetTokensOutput(i int64) *ScreeningTaskUpdate {
+	stu.mutation.ResetTokensOutput()
+	stu.mutation.SetTokensOutput(i)
+	return stu
+}
+
+// SetNillableTokensOutput sets the "tokens_output" field if the given value is not nil.
+func (stu *ScreeningTaskUpdate) SetNillableTokensOutput(i *int64) *ScreeningTaskUpdate {
+	if i != nil {
+		stu.SetTokensOutput(*i)
+	}
+	return stu
+}
+
+// AddTokensOutput adds i to the "tokens_output" field.
+func (stu *ScreeningTaskUpdate) AddTokensOutput(i int64) *ScreeningTaskUpdate {
+	stu.mutation.AddTokensOutput(i)
+	return stu
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (stu *ScreeningTaskUpdate) SetTotalCost(f float64) *ScreeningTaskUpdate {
+	stu.mutation.ResetTotalCost()
+	stu.mutation.SetTotalCost(f)
+	return stu
+}
+
+// SetNillableTotalCost sets the "total_cost" field if the given value is not nil.
+func (stu *ScreeningTaskUpdate) SetNillableTotalCost(f *float64) *ScreeningTaskUpdate {
+	if f != nil {
+		stu.SetTotalCost(*f)
+	}
+	return stu
+}
+
+// AddTotalCost adds f to the "total_cost" field.
+func (stu *ScreeningTaskUpdate) AddTotalCost(f float64) *ScreeningTaskUpdate {
+	stu.mutation.AddTotalCost(f)
+	return stu
+}
+
+// SetDisableCache sets the "disable_cache" field.
+func (stu *ScreeningTaskUpdate) SetDisableCache(b bool) *ScreeningTaskUpdate {
+	stu.mutation.SetDisableCache(b)
+	return stu
+}
+
+// SetNillableDisableCache sets the "disable_cache" field if the given value is not nil.
+func (stu *ScreeningTaskUpdate) SetNillableDisableCache(b *bool) *ScreeningTaskUpdate {
+	if b != nil {
+		stu.SetDisableCache(*b)
+	}
+	return stu
+}
+
+// SetKnockoutRules sets the "knockout_rules" field.
+func (stu *ScreeningTaskUpdate) SetKnockoutRules(m map[string]interface{}) *ScreeningTaskUpdate {
+	stu.mutation.SetKnockoutRules(m)
+	return stu
+}
+
+// ClearKnockoutRules clears the value of the "knockout_rules" field.
+func (stu *ScreeningTaskUpdate) ClearKnockoutRules() *ScreeningTaskUpdate {
+	stu.mutation.ClearKnockoutRules()
+	return stu
+}
+
+// SetBlindMode sets the "blind_mode" field.
+func (stu *ScreeningTaskUpdate) SetBlindMode(b bool) *ScreeningTaskUpdate {
+	stu.mutation.SetBlindMode(b)
+	return stu
+}
+
+// SetNillableBlindMode sets the "blind_mode" field if the given value is not nil.
+func (stu *ScreeningTaskUpdate) SetNillableBlindMode(b *bool) *ScreeningTaskUpdate {
+	if b != nil {
+		stu.SetBlindMode(*b)
+	}
+	return stu
+}
+
+// SetRedactionPolicy sets the "redaction_policy" field.
+func (stu *ScreeningTaskUpdate) SetRedactionPolicy(m map[string]interface{}) *ScreeningTaskUpdate {
+	stu.mutation.SetRedactionPolicy(m)
+	return stu
+}
+
+// ClearRedactionPolicy clears the value of the "redaction_policy" field.
+func (stu *ScreeningTaskUpdate) ClearRedactionPolicy() *ScreeningTaskUpdate {
+	stu.mutation.ClearRedactionPolicy()
+	return stu
+}
+
+// SetShortlistRanking sets the "shortlist_ranking" field.
+func (stu *ScreeningTaskUpdate) SetShortlistRanking(m map[string]interface{}) *ScreeningTaskUpdate {
+	stu.mutation.SetShortlistRanking(m)
+	return stu
+}
+
+// ClearShortlistRanking clears the value of the "shortlist_ranking" field.
+func (stu *ScreeningTaskUpdate) ClearShortlistRanking() *ScreeningTaskUpdate {
+	stu.mutation.ClearShortlistRanking()
+	return stu
+}
+
+// SetCustomDimensions sets the "custom_dimensions" field.
+func (stu *ScreeningTaskUpdate) SetCustomDimensions(m []map[string]interface{}) *ScreeningTaskUpdate {
+	stu.mutation.SetCustomDimensions(m)
+	return stu
+}
+
+// AppendCustomDimensions appends m to the "custom_dimensions" field.
+func (stu *ScreeningTaskUpdate) AppendCustomDimensions(m []map[string]interface{}) *ScreeningTaskUpdate {
+	stu.mutation.AppendCustomDimensions(m)
+	return stu
+}
+
+// ClearCustomDimensions clears the value of the "custom_dimensions" field.
+func (stu *ScreeningTaskUpdate) ClearCustomDimensions() *ScreeningTaskUpdate {
+	stu.mutation.ClearCustomDimensions()
+	return stu
+}
+
 // SetStartedAt sets the "started_at" field.
 func (stu *ScreeningTaskUpdate) SetStartedAt(t time.Time) *ScreeningTaskUpdate {
 	stu.mutation.SetStartedAt(t)
@@ -602,6 +802,77 @@ func (stu *ScreeningTaskUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if stu.mutation.AgentVersionCleared() {
 		_spec.ClearField(screeningtask.FieldAgentVersion, field.TypeString)
 	}
+	if value, ok := stu.mutation.TokenBudget(); ok {
+		_spec.SetField(screeningtask.FieldTokenBudget, field.TypeInt64, value)
+	}
+	if value, ok := stu.mutation.AddedTokenBudget(); ok {
+		_spec.AddField(screeningtask.FieldTokenBudget, field.TypeInt64, value)
+	}
+	if stu.mutation.TokenBudgetCleared() {
+		_spec.ClearField(screeningtask.FieldTokenBudget, field.TypeInt64)
+	}
+	if value, ok := stu.mutation.CostBudget(); ok {
+		_spec.SetField(screeningtask.FieldCostBudget, field.TypeFloat64, value)
+	}
+	if value, ok := stu.mutation.AddedCostBudget(); ok {
+		_spec.AddField(screeningtask.FieldCostBudget, field.TypeFloat64, value)
+	}
+	if stu.mutation.CostBudgetCleared() {
+		_spec.ClearField(screeningtask.FieldCostBudget, field.TypeFloat64)
+	}
+	if value, ok := stu.mutation.TokensInput(); ok {
+		_spec.SetField(screeningtask.FieldTokensInput, field.TypeInt64, value)
+	}
+	if value, ok := stu.mutation.AddedTokensInput(); ok {
+		_spec.AddField(screeningtask.FieldTokensInput, field.TypeInt64, value)
+	}
+	if value, ok := stu.mutation.TokensOutput(); ok {
+		_spec.SetField(screeningtask.FieldTokensOutput, field.TypeInt64, value)
+	}
+	if value, ok := stu.mutation.AddedTokensOutput(); ok {
+		_spec.AddField(screeningtask.FieldTokensOutput, field.TypeInt64, value)
+	}
+	if value, ok := stu.mutation.TotalCost(); ok {
+		_spec.SetField(screeningtask.FieldTotalCost, field.TypeFloat64, value)
+	}
+	if value, ok := stu.mutation.AddedTotalCost(); ok {
+		_spec.AddField(screeningtask.FieldTotalCost, field.TypeFloat64, value)
+	}
+	if value, ok := stu.mutation.DisableCache(); ok {
+		_spec.SetField(screeningtask.FieldDisableCache, field.TypeBool, value)
+	}
+	if value, ok := stu.mutation.KnockoutRules(); ok {
+		_spec.SetField(screeningtask.FieldKnockoutRules, field.TypeJSON, value)
+	}
+	if stu.mutation.KnockoutRulesCleared() {
+		_spec.ClearField(screeningtask.FieldKnockoutRules, field.TypeJSON)
+	}
+	if value, ok := stu.mutation.BlindMode(); ok {
+		_spec.SetField(screeningtask.FieldBlindMode, field.TypeBool, value)
+	}
+	if value, ok := stu.mutation.RedactionPolicy(); ok {
+		_spec.SetField(screeningtask.FieldRedactionPolicy, field.TypeJSON, value)
+	}
+	if stu.mutation.RedactionPolicyCleared() {
+		_spec.ClearField(screeningtask.FieldRedactionPolicy, field.TypeJSON)
+	}
+	if value, ok := stu.mutation.ShortlistRanking(); ok {
+		_spec.SetField(screeningtask.FieldShortlistRanking, field.TypeJSON, value)
+	}
+	if stu.mutation.ShortlistRankingCleared() {
+		_spec.ClearField(screeningtask.FieldShortlistRanking, field.TypeJSON)
+	}
+	if value, ok := stu.mutation.CustomDimensions(); ok {
+		_spec.SetField(screeningtask.FieldCustomDimensions, field.TypeJSON, value)
+	}
+	if value, ok := stu.mutation.AppendedCustomDimensions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, screeningtask.FieldCustomDimensions, value)
+		})
+	}
+	if stu.mutation.CustomDimensionsCleared() {
+		_spec.ClearField(screeningtask.FieldCustomDimensions, field.TypeJSON)
+	}
 	if value, ok := stu.mutation.StartedAt(); ok {
 		_spec.SetField(screeningtask.FieldStartedAt, field.TypeTime, value)
 	}
@@ -1087,6 +1358,205 @@ func (stuo *ScreeningTaskUpdateOne) ClearAgentVersion() *ScreeningTaskUpdateOne
 	return stuo
 }
 
+// SetTokenBudget sets the "token_budget" field.
+func (stuo *ScreeningTaskUpdateOne) SetTokenBudget(i int64) *ScreeningTaskUpdateOne {
+	stuo.mutation.ResetTokenBudget()
+	stuo.mutation.SetTokenBudget(i)
+	return stuo
+}
+
+// SetNillableTokenBudget sets the "token_budget" field if the given value is not nil.
+func (stuo *ScreeningTaskUpdateOne) SetNillableTokenBudget(i *int64) *ScreeningTaskUpdateOne {
+	if i != nil {
+		stuo.SetTokenBudget(*i)
+	}
+	return stuo
+}
+
+// AddTokenBudget adds i to the "token_budget" field.
+func (stuo *ScreeningTaskUpdateOne) AddTokenBudget(i int64) *ScreeningTaskUpdateOne {
+	stuo.mutation.AddTokenBudget(i)
+	return stuo
+}
+
+// ClearTokenBudget clears the value of the "token_budget" field.
+func (stuo *ScreeningTaskUpdateOne) ClearTokenBudget() *ScreeningTaskUpdateOne {
+	stuo.mutation.ClearTokenBudget()
+	return stuo
+}
+
+// SetCostBudget sets the "cost_budget" field.
+func (stuo *ScreeningTaskUpdateOne) SetCostBudget(f float64) *ScreeningTaskUpdateOne {
+	stuo.mutation.ResetCostBudget()
+	stuo.mutation.SetCostBudget(f)
+	return stuo
+}
+
+// SetNillableCostBudget sets the "cost_budget" field if the given value is not nil.
+func (stuo *ScreeningTaskUpdateOne) SetNillableCostBudget(f *float64) *ScreeningTaskUpdateOne {
+	if f != nil {
+		stuo.SetCostBudget(*f)
+	}
+	return stuo
+}
+
+// AddCostBudget adds f to the "cost_budget" field.
+func (stuo *ScreeningTaskUpdateOne) AddCostBudget(f float64) *ScreeningTaskUpdateOne {
+	stuo.mutation.AddCostBudget(f)
+	return stuo
+}
+
+// ClearCostBudget clears the value of the "cost_budget" field.
+func (stuo *ScreeningTaskUpdateOne) ClearCostBudget() *ScreeningTaskUpdateOne {
+	stuo.mutation.ClearCostBudget()
+	return stuo
+}
+
+// SetTokensInput sets the "tokens_input" field.
+func (stuo *ScreeningTaskUpdateOne) SetTokensInput(i int64) *ScreeningTaskUpdateOne {
+	stuo.mutation.ResetTokensInput()
+	stuo.mutation.SetTokensInput(i)
+	return stuo
+}
+
+// SetNillableTokensInput sets the "tokens_input" field if the given value is not nil.
+func (stuo *ScreeningTaskUpdateOne) SetNillableTokensInput(i *int64) *ScreeningTaskUpdateOne {
+	if i != nil {
+		stuo.SetTokensInput(*i)
+	}
+	return stuo
+}
+
+// AddTokensInput adds i to the "tokens_input" field.
+func (stuo *ScreeningTaskUpdateOne) AddTokensInput(i int64) *ScreeningTaskUpdateOne {
+	stuo.mutation.AddTokensInput(i)
+	return stuo
+}
+
+// SetTokensOutput sets the "tokens_output" field.
+func (stuo *ScreeningTaskUpdateOne) SetTokensOutput(i int64) *ScreeningTaskUpdateOne {
+	stuo.mutation.ResetTokensOutput()
+	stuo.mutation.SetTokensOutput(i)
+	return stuo
+}
+
+// SetNillableTokensOutput sets the "tokens_output" field if the given value is not nil.
+func (stuo *ScreeningTaskUpdateOne) SetNillableTokensOutput(i *int64) *ScreeningTaskUpdateOne {
+	if i != nil {
+		stuo.SetTokensOutput(*i)
+	}
+	return stuo
+}
+
+// AddTokensOutput adds i to the "tokens_output" field.
+func (stuo *ScreeningTaskUpdateOne) AddTokensOutput(i int64) *ScreeningTaskUpdateOne {
+	stuo.mutation.AddTokensOutput(i)
+	return stuo
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (stuo *ScreeningTaskUpdateOne) SetTotalCost(f float64) *ScreeningTaskUpdateOne {
+	stuo.mutation.ResetTotalCost()
+	stuo.mutation.SetTotalCost(f)
+	return stuo
+}
+
+// SetNillableTotalCost sets the "total_cost" field if the given value is not nil.
+func (stuo *ScreeningTaskUpdateOne) SetNillableTotalCost(f *float64) *ScreeningTaskUpdateOne {
+	if f != nil {
+		stuo.SetTotalCost(*f)
+	}
+	return stuo
+}
+
+// AddTotalCost adds f to the "total_cost" field.
+func (stuo *ScreeningTaskUpdateOne) AddTotalCost(f float64) *ScreeningTaskUpdateOne {
+	stuo.mutation.AddTotalCost(f)
+	return stuo
+}
+
+// SetDisableCache sets the "disable_cache" field.
+func (stuo *ScreeningTaskUpdateOne) SetDisableCache(b bool) *ScreeningTaskUpdateOne {
+	stuo.mutation.SetDisableCache(b)
+	return stuo
+}
+
+// SetNillableDisableCache sets the "disable_cache" field if the given value is not nil.
+func (stuo *ScreeningTaskUpdateOne) SetNillableDisableCache(b *bool) *ScreeningTaskUpdateOne {
+	if b != nil {
+		stuo.SetDisableCache(*b)
+	}
+	return stuo
+}
+
+// SetKnockoutRules sets the "knockout_rules" field.
+func (stuo *ScreeningTaskUpdateOne) SetKnockoutRules(m map[string]interface{}) *ScreeningTaskUpdateOne {
+	stuo.mutation.SetKnockoutRules(m)
+	return stuo
+}
+
+// ClearKnockoutRules clears the value of the "knockout_rules" field.
+func (stuo *ScreeningTaskUpdateOne) ClearKnockoutRules() *ScreeningTaskUpdateOne {
+	stuo.mutation.ClearKnockoutRules()
+	return stuo
+}
+
+// SetBlindMode sets the "blind_mode" field.
+func (stuo *ScreeningTaskUpdateOne) SetBlindMode(b bool) *ScreeningTaskUpdateOne {
+	stuo.mutation.SetBlindMode(b)
+	return stuo
+}
+
+// SetNillableBlindMode sets the "blind_mode" field if the given value is not nil.
+func (stuo *ScreeningTaskUpdateOne) SetNillableBlindMode(b *bool) *ScreeningTaskUpdateOne {
+	if b != nil {
+		stuo.SetBlindMode(*b)
+	}
+	return stuo
+}
+
+// SetRedactionPolicy sets the "redaction_policy" field.
+func (stuo *ScreeningTaskUpdateOne) SetRedactionPolicy(m map[string]interface{}) *ScreeningTaskUpdateOne {
+	stuo.mutation.SetRedactionPolicy(m)
+	return stuo
+}
+
+// ClearRedactionPolicy clears the value of the "redaction_policy" field.
+func (stuo *ScreeningTaskUpdateOne) ClearRedactionPolicy() *ScreeningTaskUpdateOne {
+	stuo.mutation.ClearRedactionPolicy()
+	return stuo
+}
+
+// SetShortlistRanking sets the "shortlist_ranking" field.
+func (stuo *ScreeningTaskUpdateOne) SetShortlistRanking(m map[string]interface{}) *ScreeningTaskUpdateOne {
+	stuo.mutation.SetShortlistRanking(m)
+	return stuo
+}
+
+// ClearShortlistRanking clears the value of the "shortlist_ranking" field.
+func (stuo *ScreeningTaskUpdateOne) ClearShortlistRanking() *ScreeningTaskUpdateOne {
+	stuo.mutation.ClearShortlistRanking()
+	return stuo
+}
+
+// SetCustomDimensions sets the "custom_dimensions" field.
+func (stuo *ScreeningTaskUpdateOne) SetCustomDimensions(m []map[string]interface{}) *ScreeningTaskUpdateOne {
+	stuo.mutation.SetCustomDimensions(m)
+	return stuo
+}
+
+// AppendCustomDimensions appends m to the "custom_dimensions" field.
+func (stuo *ScreeningTaskUpdateOne) AppendCustomDimensions(m []map[string]interface{}) *ScreeningTaskUpdateOne {
+	stuo.mutation.AppendCustomDimensions(m)
+	return stuo
+}
+
+// ClearCustomDimensions clears the value of the "custom_dimensions" field.
+func (stuo *ScreeningTaskUpdateOne) ClearCustomDimensions() *ScreeningTaskUpdateOne {
+	stuo.mutation.ClearCustomDimensions()
+	return stuo
+}
+
 // SetStartedAt sets the "started_at" field.
 func (stuo *ScreeningTaskUpdateOne) SetStartedAt(t time.Time) *ScreeningTaskUpdateOne {
 	stuo.mutation.SetStartedAt(t)
@@ -1473,6 +1943,77 @@ func (stuo *ScreeningTaskUpdateOne) sqlSave(ctx context.Context) (_node *Screeni
 	if stuo.mutation.AgentVersionCleared() {
 		_spec.ClearField(screeningtask.FieldAgentVersion, field.TypeString)
 	}
+	if value, ok := stuo.mutation.TokenBudget(); ok {
+		_spec.SetField(screeningtask.FieldTokenBudget, field.TypeInt64, value)
+	}
+	if value, ok := stuo.mutation.AddedTokenBudget(); ok {
+		_spec.AddField(screeningtask.FieldTokenBudget, field.TypeInt64, value)
+	}
+	if stuo.mutation.TokenBudgetCleared() {
+		_spec.ClearField(screeningtask.FieldTokenBudget, field.TypeInt64)
+	}
+	if value, ok := stuo.mutation.CostBudget(); ok {
+		_spec.SetField(screeningtask.FieldCostBudget, field.TypeFloat64, value)
+	}
+	if value, ok := stuo.mutation.AddedCostBudget(); ok {
+		_spec.AddField(screeningtask.FieldCostBudget, field.TypeFloat64, value)
+	}
+	if stuo.mutation.CostBudgetCleared() {
+		_spec.ClearField(screeningtask.FieldCostBudget, field.TypeFloat64)
+	}
+	if value, ok := stuo.mutation.TokensInput(); ok {
+		_spec.SetField(screeningtask.FieldTokensInput, field.TypeInt64, value)
+	}
+	if value, ok := stuo.mutation.AddedTokensInput(); ok {
+		_spec.AddField(screeningtask.FieldTokensInput, field.TypeInt64, value)
+	}
+	if value, ok := stuo.mutation.TokensOutput(); ok {
+		_spec.SetField(screeningtask.FieldTokensOutput, field.TypeInt64, value)
+	}
+	if value, ok := stuo.mutation.AddedTokensOutput(); ok {
+		_spec.AddField(screeningtask.FieldTokensOutput, field.TypeInt64, value)
+	}
+	if value, ok := stuo.mutation.TotalCost(); ok {
+		_spec.SetField(screeningtask.FieldTotalCost, field.TypeFloat64, value)
+	}
+	if value, ok := stuo.mutation.AddedTotalCost(); ok {
+		_spec.AddField(screeningtask.FieldTotalCost, field.TypeFloat64, value)
+	}
+	if value, ok := stuo.mutation.DisableCache(); ok {
+		_spec.SetField(screeningtask.FieldDisableCache, field.TypeBool, value)
+	}
+	if value, ok := stuo.mutation.KnockoutRules(); ok {
+		_spec.SetField(screeningtask.FieldKnockoutRules, field.TypeJSON, value)
+	}
+	if stuo.mutation.KnockoutRulesCleared() {
+		_spec.ClearField(screeningtask.FieldKnockoutRules, field.TypeJSON)
+	}
+	if value, ok := stuo.mutation.BlindMode(); ok {
+		_spec.SetField(screeningtask.FieldBlindMode, field.TypeBool, value)
+	}
+	if value, ok := stuo.mutation.RedactionPolicy(); ok {
+		_spec.SetField(screeningtask.FieldRedactionPolicy, field.TypeJSON, value)
+	}
+	if stuo.mutation.RedactionPolicyCleared() {
+		_spec.ClearField(screeningtask.FieldRedactionPolicy, field.TypeJSON)
+	}
+	if value, ok := stuo.mutation.ShortlistRanking(); ok {
+		_spec.SetField(screeningtask.FieldShortlistRanking, field.TypeJSON, value)
+	}
+	if stuo.mutation.ShortlistRankingCleared() {
+		_spec.ClearField(screeningtask.FieldShortlistRanking, field.TypeJSON)
+	}
+	if value, ok := stuo.mutation.CustomDimensions(); ok {
+		_spec.SetField(screeningtask.FieldCustomDimensions, field.TypeJSON, value)
+	}
+	if value, ok := stuo.mutation.AppendedCustomDimensions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, screeningtask.FieldCustomDimensions, value)
+		})
+	}
+	if stuo.mutation.CustomDimensionsCleared() {
+		_spec.ClearField(screeningtask.FieldCustomDimensions, field.TypeJSON)
+	}
 	if value, ok := stuo.mutation.StartedAt(); ok {
 		_spec.SetField(screeningtask.FieldStartedAt, field.TypeTime, value)
 	}
@@ -36,6 +36,12 @@ type ScreeningTaskResume struct {
 	Score float64 `json:"score,omitempty"`
 	// 处理完成时间
 	ProcessedAt time.Time `json:"processed_at,omitempty"`
+	// 输入Token数
+	TokensInput int64 `json:"tokens_input,omitempty"`
+	// 输出Token数
+	TokensOutput int64 `json:"tokens_output,omitempty"`
+	// 模型调用费用
+	TotalCost float64 `json:"total_cost,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -95,9 +101,9 @@ func (*ScreeningTaskResume) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case screeningtaskresume.FieldScore:
+		case screeningtaskresume.FieldScore, screeningtaskresume.FieldTotalCost:
 			values[i] = new(sql.NullFloat64)
-		case screeningtaskresume.FieldRanking:
+		case screeningtaskresume.FieldRanking, screeningtaskresume.FieldTokensInput, screeningtaskresume.FieldTokensOutput:
 			values[i] = new(sql.NullInt64)
 		case screeningtaskresume.FieldStatus, screeningtaskresume.FieldErrorMessage:
 			values[i] = new(sql.NullString)
@@ -174,6 +180,24 @@ func (str *ScreeningTaskResume) assignValues(columns []string, values []any) err
 			} else if value.Valid {
 				str.ProcessedAt = value.Time
 			}
+		case screeningtaskresume.FieldTokensInput:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tokens_input", values[i])
+			} else if value.Valid {
+				str.TokensInput = value.Int64
+			}
+		case screeningtaskresume.FieldTokensOutput:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tokens_output", values[i])
+			} else if value.Valid {
+				str.TokensOutput = value.Int64
+			}
+		case screeningtaskresume.FieldTotalCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_cost", values[i])
+			} else if value.Valid {
+				str.TotalCost = value.Float64
+			}
 		case screeningtaskresume.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -261,6 +285,15 @@ func (str *ScreeningTaskResume) String() string {
 	builder.WriteString("processed_at=")
 	builder.WriteString(str.ProcessedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("tokens_input=")
+	builder.WriteString(fmt.Sprintf("%v", str.TokensInput))
+	builder.WriteString(", ")
+	builder.WriteString("tokens_output=")
+	builder.WriteString(fmt.Sprintf("%v", str.TokensOutput))
+	builder.WriteString(", ")
+	builder.WriteString("total_cost=")
+	builder.WriteString(fmt.Sprintf("%v", str.TotalCost))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(str.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -32,6 +32,12 @@ const (
 	FieldScore = "score"
 	// FieldProcessedAt holds the string denoting the processed_at field in the database.
 	FieldProcessedAt = "processed_at"
+	// FieldTokensInput holds the string denoting the tokens_input field in the database.
+	FieldTokensInput = "tokens_input"
+	// FieldTokensOutput holds the string denoting the tokens_output field in the database.
+	FieldTokensOutput = "tokens_output"
+	// FieldTotalCost holds the string denoting the total_cost field in the database.
+	FieldTotalCost = "total_cost"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -78,6 +84,9 @@ var Columns = []string{
 	FieldRanking,
 	FieldScore,
 	FieldProcessedAt,
+	FieldTokensInput,
+	FieldTokensOutput,
+	FieldTotalCost,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -102,6 +111,12 @@ var (
 	Interceptors [1]ent.Interceptor
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
+	// DefaultTokensInput holds the default value on creation for the "tokens_input" field.
+	DefaultTokensInput int64
+	// DefaultTokensOutput holds the default value on creation for the "tokens_output" field.
+	DefaultTokensOutput int64
+	// DefaultTotalCost holds the default value on creation for the "total_cost" field.
+	DefaultTotalCost float64
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -160,6 +175,21 @@ func ByProcessedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProcessedAt, opts...).ToFunc()
 }
 
+// ByTokensInput orders the results by the tokens_input field.
+func ByTokensInput(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokensInput, opts...).ToFunc()
+}
+
+// ByTokensOutput orders the results by the tokens_output field.
+func ByTokensOutput(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokensOutput, opts...).ToFunc()
+}
+
+// ByTotalCost orders the results by the total_cost field.
+func ByTotalCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalCost, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -96,6 +96,21 @@ func ProcessedAt(v time.Time) predicate.ScreeningTaskResume {
 	return predicate.ScreeningTaskResume(sql.FieldEQ(FieldProcessedAt, v))
 }
 
+// TokensInput applies equality check predicate on the "tokens_input" field. It's identical to TokensInputEQ.
+func TokensInput(v int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldEQ(FieldTokensInput, v))
+}
+
+// TokensOutput applies equality check predicate on the "tokens_output" field. It's identical to TokensOutputEQ.
+func TokensOutput(v int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldEQ(FieldTokensOutput, v))
+}
+
+// TotalCost applies equality check predicate on the "total_cost" field. It's identical to TotalCostEQ.
+func TotalCost(v float64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldEQ(FieldTotalCost, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.ScreeningTaskResume {
 	return predicate.ScreeningTaskResume(sql.FieldEQ(FieldCreatedAt, v))
@@ -486,6 +501,126 @@ func ProcessedAtNotNil() predicate.ScreeningTaskResume {
 	return predicate.ScreeningTaskResume(sql.FieldNotNull(FieldProcessedAt))
 }
 
+// TokensInputEQ applies the EQ predicate on the "tokens_input" field.
+func TokensInputEQ(v int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldEQ(FieldTokensInput, v))
+}
+
+// TokensInputNEQ applies the NEQ predicate on the "tokens_input" field.
+func TokensInputNEQ(v int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldNEQ(FieldTokensInput, v))
+}
+
+// TokensInputIn applies the In predicate on the "tokens_input" field.
+func TokensInputIn(vs ...int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldIn(FieldTokensInput, vs...))
+}
+
+// TokensInputNotIn applies the NotIn predicate on the "tokens_input" field.
+func TokensInputNotIn(vs ...int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldNotIn(FieldTokensInput, vs...))
+}
+
+// TokensInputGT applies the GT predicate on the "tokens_input" field.
+func TokensInputGT(v int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldGT(FieldTokensInput, v))
+}
+
+// TokensInputGTE applies the GTE predicate on the "tokens_input" field.
+func TokensInputGTE(v int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldGTE(FieldTokensInput, v))
+}
+
+// TokensInputLT applies the LT predicate on the "tokens_input" field.
+func TokensInputLT(v int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldLT(FieldTokensInput, v))
+}
+
+// TokensInputLTE applies the LTE predicate on the "tokens_input" field.
+func TokensInputLTE(v int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldLTE(FieldTokensInput, v))
+}
+
+// TokensOutputEQ applies the EQ predicate on the "tokens_output" field.
+func TokensOutputEQ(v int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldEQ(FieldTokensOutput, v))
+}
+
+// TokensOutputNEQ applies the NEQ predicate on the "tokens_output" field.
+func TokensOutputNEQ(v int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldNEQ(FieldTokensOutput, v))
+}
+
+// TokensOutputIn applies the In predicate on the "tokens_output" field.
+func TokensOutputIn(vs ...int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldIn(FieldTokensOutput, vs...))
+}
+
+// TokensOutputNotIn applies the NotIn predicate on the "tokens_output" field.
+func TokensOutputNotIn(vs ...int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldNotIn(FieldTokensOutput, vs...))
+}
+
+// TokensOutputGT applies the GT predicate on the "tokens_output" field.
+func TokensOutputGT(v int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldGT(FieldTokensOutput, v))
+}
+
+// TokensOutputGTE applies the GTE predicate on the "tokens_output" field.
+func TokensOutputGTE(v int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldGTE(FieldTokensOutput, v))
+}
+
+// TokensOutputLT applies the LT predicate on the "tokens_output" field.
+func TokensOutputLT(v int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldLT(FieldTokensOutput, v))
+}
+
+// TokensOutputLTE applies the LTE predicate on the "tokens_output" field.
+func TokensOutputLTE(v int64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldLTE(FieldTokensOutput, v))
+}
+
+// TotalCostEQ applies the EQ predicate on the "total_cost" field.
+func TotalCostEQ(v float64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldEQ(FieldTotalCost, v))
+}
+
+// TotalCostNEQ applies the NEQ predicate on the "total_cost" field.
+func TotalCostNEQ(v float64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldNEQ(FieldTotalCost, v))
+}
+
+// TotalCostIn applies the In predicate on the "total_cost" field.
+func TotalCostIn(vs ...float64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldIn(FieldTotalCost, vs...))
+}
+
+// TotalCostNotIn applies the NotIn predicate on the "total_cost" field.
+func TotalCostNotIn(vs ...float64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldNotIn(FieldTotalCost, vs...))
+}
+
+// TotalCostGT applies the GT predicate on the "total_cost" field.
+func TotalCostGT(v float64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldGT(FieldTotalCost, v))
+}
+
+// TotalCostGTE applies the GTE predicate on the "total_cost" field.
+func TotalCostGTE(v float64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldGTE(FieldTotalCost, v))
+}
+
+// TotalCostLT applies the LT predicate on the "total_cost" field.
+func TotalCostLT(v float64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldLT(FieldTotalCost, v))
+}
+
+// TotalCostLTE applies the LTE predicate on the "total_cost" field.
+func TotalCostLTE(v float64) predicate.ScreeningTaskResume {
+	return predicate.ScreeningTaskResume(sql.FieldLTE(FieldTotalCost, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ScreeningTaskResume {
 	return predicate.ScreeningTaskResume(sql.FieldEQ(FieldCreatedAt, v))
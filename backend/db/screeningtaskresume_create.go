@@ -123,6 +123,48 @@ func (strc *ScreeningTaskResumeCreate) SetNillableProcessedAt(t *time.Time) *Scr
 	return strc
 }
 
+// SetTokensInput sets the "tokens_input" field.
+func (strc *ScreeningTaskResumeCreate) SetTokensInput(i int64) *ScreeningTaskResumeCreate {
+	strc.mutation.SetTokensInput(i)
+	return strc
+}
+
+// SetNillableTokensInput sets the "tokens_input" field if the given value is not nil.
+func (strc *ScreeningTaskResumeCreate) SetNillableTokensInput(i *int64) *ScreeningTaskResumeCreate {
+	if i != nil {
+		strc.SetTokensInput(*i)
+	}
+	return strc
+}
+
+// SetTokensOutput sets the "tokens_output" field.
+func (strc *ScreeningTaskResumeCreate) SetTokensOutput(i int64) *ScreeningTaskResumeCreate {
+	strc.mutation.SetTokensOutput(i)
+	return strc
+}
+
+// SetNillableTokensOutput sets the "tokens_output" field if the given value is not nil.
+func (strc *ScreeningTaskResumeCreate) SetNillableTokensOutput(i *int64) *ScreeningTaskResumeCreate {
+	if i != nil {
+		strc.SetTokensOutput(*i)
+	}
+	return strc
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (strc *ScreeningTaskResumeCreate) SetTotalCost(f float64) *ScreeningTaskResumeCreate {
+	strc.mutation.SetTotalCost(f)
+	return strc
+}
+
+// SetNillableTotalCost sets the "total_cost" field if the given value is not nil.
+func (strc *ScreeningTaskResumeCreate) SetNillableTotalCost(f *float64) *ScreeningTaskResumeCreate {
+	if f != nil {
+		strc.SetTotalCost(*f)
+	}
+	return strc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (strc *ScreeningTaskResumeCreate) SetCreatedAt(t time.Time) *ScreeningTaskResumeCreate {
 	strc.mutation.SetCreatedAt(t)
@@ -231,6 +273,18 @@ func (strc *ScreeningTaskResumeCreate) defaults() error {
 		v := screeningtaskresume.DefaultStatus
 		strc.mutation.SetStatus(v)
 	}
+	if _, ok := strc.mutation.TokensInput(); !ok {
+		v := screeningtaskresume.DefaultTokensInput
+		strc.mutation.SetTokensInput(v)
+	}
+	if _, ok := strc.mutation.TokensOutput(); !ok {
+		v := screeningtaskresume.DefaultTokensOutput
+		strc.mutation.SetTokensOutput(v)
+	}
+	if _, ok := strc.mutation.TotalCost(); !ok {
+		v := screeningtaskresume.DefaultTotalCost
+		strc.mutation.SetTotalCost(v)
+	}
 	if _, ok := strc.mutation.CreatedAt(); !ok {
 		if screeningtaskresume.DefaultCreatedAt == nil {
 			return fmt.Errorf("db: uninitialized screeningtaskresume.DefaultCreatedAt (forgotten import db/runtime?)")
@@ -266,6 +320,15 @@ func (strc *ScreeningTaskResumeCreate) check() error {
 	if _, ok := strc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`db: missing required field "ScreeningTaskResume.status"`)}
 	}
+	if _, ok := strc.mutation.TokensInput(); !ok {
+		return &ValidationError{Name: "tokens_input", err: errors.New(`db: missing required field "ScreeningTaskResume.tokens_input"`)}
+	}
+	if _, ok := strc.mutation.TokensOutput(); !ok {
+		return &ValidationError{Name: "tokens_output", err: errors.New(`db: missing required field "ScreeningTaskResume.tokens_output"`)}
+	}
+	if _, ok := strc.mutation.TotalCost(); !ok {
+		return &ValidationError{Name: "total_cost", err: errors.New(`db: missing required field "ScreeningTaskResume.total_cost"`)}
+	}
 	if _, ok := strc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "ScreeningTaskResume.created_at"`)}
 	}
@@ -338,6 +401,18 @@ func (strc *ScreeningTaskResumeCreate) createSpec() (*ScreeningTaskResume, *sqlg
 		_spec.SetField(screeningtaskresume.FieldProcessedAt, field.TypeTime, value)
 		_node.ProcessedAt = value
 	}
+	if value, ok := strc.mutation.TokensInput(); ok {
+		_spec.SetField(screeningtaskresume.FieldTokensInput, field.TypeInt64, value)
+		_node.TokensInput = value
+	}
+	if value, ok := strc.mutation.TokensOutput(); ok {
+		_spec.SetField(screeningtaskresume.FieldTokensOutput, field.TypeInt64, value)
+		_node.TokensOutput = value
+	}
+	if value, ok := strc.mutation.TotalCost(); ok {
+		_spec.SetField(screeningtaskresume.FieldTotalCost, field.TypeFloat64, value)
+		_node.TotalCost = value
+	}
 	if value, ok := strc.mutation.CreatedAt(); ok {
 		_spec.SetField(screeningtaskresume.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -586,6 +661,60 @@ func (u *ScreeningTaskResumeUpsert) ClearProcessedAt() *ScreeningTaskResumeUpser
 	return u
 }
 
+// SetTokensInput sets the "tokens_input" field.
+func (u *ScreeningTaskResumeUpsert) SetTokensInput(v int64) *ScreeningTaskResumeUpsert {
+	u.Set(screeningtaskresume.FieldTokensInput, v)
+	return u
+}
+
+// UpdateTokensInput sets the "tokens_input" field to the value that was provided on create.
+func (u *ScreeningTaskResumeUpsert) UpdateTokensInput() *ScreeningTaskResumeUpsert {
+	u.SetExcluded(screeningtaskresume.FieldTokensInput)
+	return u
+}
+
+// AddTokensInput adds v to the "tokens_input" field.
+func (u *ScreeningTaskResumeUpsert) AddTokensInput(v int64) *ScreeningTaskResumeUpsert {
+	u.Add(screeningtaskresume.FieldTokensInput, v)
+	return u
+}
+
+// SetTokensOutput sets the "tokens_output" field.
+func (u *ScreeningTaskResumeUpsert) SetTokensOutput(v int64) *ScreeningTaskResumeUpsert {
+	u.Set(screeningtaskresume.FieldTokensOutput, v)
+	return u
+}
+
+// UpdateTokensOutput sets the "tokens_output" field to the value that was provided on create.
+func (u *ScreeningTaskResumeUpsert) UpdateTokensOutput() *ScreeningTaskResumeUpsert {
+	u.SetExcluded(screeningtaskresume.FieldTokensOutput)
+	return u
+}
+
+// AddTokensOutput adds v to the "tokens_output" field.
+func (u *ScreeningTaskResumeUpsert) AddTokensOutput(v int64) *ScreeningTaskResumeUpsert {
+	u.Add(screeningtaskresume.FieldTokensOutput, v)
+	return u
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (u *ScreeningTaskResumeUpsert) SetTotalCost(v float64) *ScreeningTaskResumeUpsert {
+	u.Set(screeningtaskresume.FieldTotalCost, v)
+	return u
+}
+
+// UpdateTotalCost sets the "total_cost" field to the value that was provided on create.
+func (u *ScreeningTaskResumeUpsert) UpdateTotalCost() *ScreeningTaskResumeUpsert {
+	u.SetExcluded(screeningtaskresume.FieldTotalCost)
+	return u
+}
+
+// AddTotalCost adds v to the "total_cost" field.
+func (u *ScreeningTaskResumeUpsert) AddTotalCost(v float64) *ScreeningTaskResumeUpsert {
+	u.Add(screeningtaskresume.FieldTotalCost, v)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ScreeningTaskResumeUpsert) SetUpdatedAt(v time.Time) *ScreeningTaskResumeUpsert {
 	u.Set(screeningtaskresume.FieldUpdatedAt, v)
@@ -810,6 +939,69 @@ func (u *ScreeningTaskResumeUpsertOne) ClearProcessedAt() *ScreeningTaskResumeUp
 	})
 }
 
+// SetTokensInput sets the "tokens_input" field.
+func (u *ScreeningTaskResumeUpsertOne) SetTokensInput(v int64) *ScreeningTaskResumeUpsertOne {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.SetTokensInput(v)
+	})
+}
+
+// AddTokensInput adds v to the "tokens_input" field.
+func (u *ScreeningTaskResumeUpsertOne) AddTokensInput(v int64) *ScreeningTaskResumeUpsertOne {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.AddTokensInput(v)
+	})
+}
+
+// UpdateTokensInput sets the "tokens_input" field to the value that was provided on create.
+func (u *ScreeningTaskResumeUpsertOne) UpdateTokensInput() *ScreeningTaskResumeUpsertOne {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.UpdateTokensInput()
+	})
+}
+
+// SetTokensOutput sets the "tokens_output" field.
+func (u *ScreeningTaskResumeUpsertOne) SetTokensOutput(v int64) *ScreeningTaskResumeUpsertOne {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.SetTokensOutput(v)
+	})
+}
+
+// AddTokensOutput adds v to the "tokens_output" field.
+func (u *ScreeningTaskResumeUpsertOne) AddTokensOutput(v int64) *ScreeningTaskResumeUpsertOne {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.AddTokensOutput(v)
+	})
+}
+
+// UpdateTokensOutput sets the "tokens_output" field to the value that was provided on create.
+func (u *ScreeningTaskResumeUpsertOne) UpdateTokensOutput() *ScreeningTaskResumeUpsertOne {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.UpdateTokensOutput()
+	})
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (u *ScreeningTaskResumeUpsertOne) SetTotalCost(v float64) *ScreeningTaskResumeUpsertOne {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.SetTotalCost(v)
+	})
+}
+
+// AddTotalCost adds v to the "total_cost" field.
+func (u *ScreeningTaskResumeUpsertOne) AddTotalCost(v float64) *ScreeningTaskResumeUpsertOne {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.AddTotalCost(v)
+	})
+}
+
+// UpdateTotalCost sets the "total_cost" field to the value that was provided on create.
+func (u *ScreeningTaskResumeUpsertOne) UpdateTotalCost() *ScreeningTaskResumeUpsertOne {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.UpdateTotalCost()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ScreeningTaskResumeUpsertOne) SetUpdatedAt(v time.Time) *ScreeningTaskResumeUpsertOne {
 	return u.Update(func(s *ScreeningTaskResumeUpsert) {
@@ -1203,6 +1395,69 @@ func (u *ScreeningTaskResumeUpsertBulk) ClearProcessedAt() *ScreeningTaskResumeU
 	})
 }
 
+// SetTokensInput sets the "tokens_input" field.
+func (u *ScreeningTaskResumeUpsertBulk) SetTokensInput(v int64) *ScreeningTaskResumeUpsertBulk {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.SetTokensInput(v)
+	})
+}
+
+// AddTokensInput adds v to the "tokens_input" field.
+func (u *ScreeningTaskResumeUpsertBulk) AddTokensInput(v int64) *ScreeningTaskResumeUpsertBulk {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.AddTokensInput(v)
+	})
+}
+
+// UpdateTokensInput sets the "tokens_input" field to the value that was provided on create.
+func (u *ScreeningTaskResumeUpsertBulk) UpdateTokensInput() *ScreeningTaskResumeUpsertBulk {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.UpdateTokensInput()
+	})
+}
+
+// SetTokensOutput sets the "tokens_output" field.
+func (u *ScreeningTaskResumeUpsertBulk) SetTokensOutput(v int64) *ScreeningTaskResumeUpsertBulk {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.SetTokensOutput(v)
+	})
+}
+
+// AddTokensOutput adds v to the "tokens_output" field.
+func (u *ScreeningTaskResumeUpsertBulk) AddTokensOutput(v int64) *ScreeningTaskResumeUpsertBulk {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.AddTokensOutput(v)
+	})
+}
+
+// UpdateTokensOutput sets the "tokens_output" field to the value that was provided on create.
+func (u *ScreeningTaskResumeUpsertBulk) UpdateTokensOutput() *ScreeningTaskResumeUpsertBulk {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.UpdateTokensOutput()
+	})
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (u *ScreeningTaskResumeUpsertBulk) SetTotalCost(v float64) *ScreeningTaskResumeUpsertBulk {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.SetTotalCost(v)
+	})
+}
+
+// AddTotalCost adds v to the "total_cost" field.
+func (u *ScreeningTaskResumeUpsertBulk) AddTotalCost(v float64) *ScreeningTaskResumeUpsertBulk {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.AddTotalCost(v)
+	})
+}
+
+// UpdateTotalCost sets the "total_cost" field to the value that was provided on create.
+func (u *ScreeningTaskResumeUpsertBulk) UpdateTotalCost() *ScreeningTaskResumeUpsertBulk {
+	return u.Update(func(s *ScreeningTaskResumeUpsert) {
+		s.UpdateTotalCost()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ScreeningTaskResumeUpsertBulk) SetUpdatedAt(v time.Time) *ScreeningTaskResumeUpsertBulk {
 	return u.Update(func(s *ScreeningTaskResumeUpsert) {
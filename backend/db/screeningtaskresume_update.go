@@ -189,6 +189,69 @@ func (stru *ScreeningTaskResumeUpdate) ClearProcessedAt() *ScreeningTaskResumeUp
 	return stru
 }
 
+// SetTokensInput sets the "tokens_input" field.
+func (stru *ScreeningTaskResumeUpdate) SetTokensInput(i int64) *ScreeningTaskResumeUpdate {
+	stru.mutation.ResetTokensInput()
+	stru.mutation.SetTokensInput(i)
+	return stru
+}
+
+// SetNillableTokensInput sets the "tokens_input" field if the given value is not nil.
+func (stru *ScreeningTaskResumeUpdate) SetNillableTokensInput(i *int64) *ScreeningTaskResumeUpdate {
+	if i != nil {
+		stru.SetTokensInput(*i)
+	}
+	return stru
+}
+
+// AddTokensInput adds i to the "tokens_input" field.
+func (stru *ScreeningTaskResumeUpdate) AddTokensInput(i int64) *ScreeningTaskResumeUpdate {
+	stru.mutation.AddTokensInput(i)
+	return stru
+}
+
+// SetTokensOutput sets the "tokens_output" field.
+func (stru *ScreeningTaskResumeUpdate) SetTokensOutput(i int64) *ScreeningTaskResumeUpdate {
+	stru.mutation.ResetTokensOutput()
+	stru.mutation.SetTokensOutput(i)
+	return stru
+}
+
+// SetNillableTokensOutput sets the "tokens_output" field if the given value is not nil.
+func (stru *ScreeningTaskResumeUpdate) SetNillableTokensOutput(i *int64) *ScreeningTaskResumeUpdate {
+	if i != nil {
+		stru.SetTokensOutput(*i)
+	}
+	return stru
+}
+
+// AddTokensOutput adds i to the "tokens_output" field.
+func (stru *ScreeningTaskResumeUpdate) AddTokensOutput(i int64) *ScreeningTaskResumeUpdate {
+	stru.mutation.AddTokensOutput(i)
+	return stru
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (stru *ScreeningTaskResumeUpdate) SetTotalCost(f float64) *ScreeningTaskResumeUpdate {
+	stru.mutation.ResetTotalCost()
+	stru.mutation.SetTotalCost(f)
+	return stru
+}
+
+// SetNillableTotalCost sets the "total_cost" field if the given value is not nil.
+func (stru *ScreeningTaskResumeUpdate) SetNillableTotalCost(f *float64) *ScreeningTaskResumeUpdate {
+	if f != nil {
+		stru.SetTotalCost(*f)
+	}
+	return stru
+}
+
+// AddTotalCost adds f to the "total_cost" field.
+func (stru *ScreeningTaskResumeUpdate) AddTotalCost(f float64) *ScreeningTaskResumeUpdate {
+	stru.mutation.AddTotalCost(f)
+	return stru
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (stru *ScreeningTaskResumeUpdate) SetUpdatedAt(t time.Time) *ScreeningTaskResumeUpdate {
 	stru.mutation.SetUpdatedAt(t)
@@ -368,6 +431,24 @@ func (stru *ScreeningTaskResumeUpdate) sqlSave(ctx context.Context) (n int, err
 	if stru.mutation.ProcessedAtCleared() {
 		_spec.ClearField(screeningtaskresume.FieldProcessedAt, field.TypeTime)
 	}
+	if value, ok := stru.mutation.TokensInput(); ok {
+		_spec.SetField(screeningtaskresume.FieldTokensInput, field.TypeInt64, value)
+	}
+	if value, ok := stru.mutation.AddedTokensInput(); ok {
+		_spec.AddField(screeningtaskresume.FieldTokensInput, field.TypeInt64, value)
+	}
+	if value, ok := stru.mutation.TokensOutput(); ok {
+		_spec.SetField(screeningtaskresume.FieldTokensOutput, field.TypeInt64, value)
+	}
+	if value, ok := stru.mutation.AddedTokensOutput(); ok {
+		_spec.AddField(screeningtaskresume.FieldTokensOutput, field.TypeInt64, value)
+	}
+	if value, ok := stru.mutation.TotalCost(); ok {
+		_spec.SetField(screeningtaskresume.FieldTotalCost, field.TypeFloat64, value)
+	}
+	if value, ok := stru.mutation.AddedTotalCost(); ok {
+		_spec.AddField(screeningtaskresume.FieldTotalCost, field.TypeFloat64, value)
+	}
 	if value, ok := stru.mutation.UpdatedAt(); ok {
 		_spec.SetField(screeningtaskresume.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -652,6 +733,69 @@ func (struo *ScreeningTaskResumeUpdateOne) ClearProcessedAt() *ScreeningTaskResu
 	return struo
 }
 
+// SetTokensInput sets the "tokens_input" field.
+func (struo *ScreeningTaskResumeUpdateOne) SetTokensInput(i int64) *ScreeningTaskResumeUpdateOne {
+	struo.mutation.ResetTokensInput()
+	struo.mutation.SetTokensInput(i)
+	return struo
+}
+
+// SetNillableTokensInput sets the "tokens_input" field if the given value is not nil.
+func (struo *ScreeningTaskResumeUpdateOne) SetNillableTokensInput(i *int64) *ScreeningTaskResumeUpdateOne {
+	if i != nil {
+		struo.SetTokensInput(*i)
+	}
+	return struo
+}
+
+// AddTokensInput adds i to the "tokens_input" field.
+func (struo *ScreeningTaskResumeUpdateOne) AddTokensInput(i int64) *ScreeningTaskResumeUpdateOne {
+	struo.mutation.AddTokensInput(i)
+	return struo
+}
+
+// SetTokensOutput sets the "tokens_output" field.
+func (struo *ScreeningTaskResumeUpdateOne) SetTokensOutput(i int64) *ScreeningTaskResumeUpdateOne {
+	struo.mutation.ResetTokensOutput()
+	struo.mutation.SetTokensOutput(i)
+	return struo
+}
+
+// SetNillableTokensOutput sets the "tokens_output" field if the given value is not nil.
+func (struo *ScreeningTaskResumeUpdateOne) SetNillableTokensOutput(i *int64) *ScreeningTaskResumeUpdateOne {
+	if i != nil {
+		struo.SetTokensOutput(*i)
+	}
+	return struo
+}
+
+// AddTokensOutput adds i to the "tokens_output" field.
+func (struo *ScreeningTaskResumeUpdateOne) AddTokensOutput(i int64) *ScreeningTaskResumeUpdateOne {
+	struo.mutation.AddTokensOutput(i)
+	return struo
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (struo *ScreeningTaskResumeUpdateOne) SetTotalCost(f float64) *ScreeningTaskResumeUpdateOne {
+	struo.mutation.ResetTotalCost()
+	struo.mutation.SetTotalCost(f)
+	return struo
+}
+
+// SetNillableTotalCost sets the "total_cost" field if the given value is not nil.
+func (struo *ScreeningTaskResumeUpdateOne) SetNillableTotalCost(f *float64) *ScreeningTaskResumeUpdateOne {
+	if f != nil {
+		struo.SetTotalCost(*f)
+	}
+	return struo
+}
+
+// AddTotalCost adds f to the "total_cost" field.
+func (struo *ScreeningTaskResumeUpdateOne) AddTotalCost(f float64) *ScreeningTaskResumeUpdateOne {
+	struo.mutation.AddTotalCost(f)
+	return struo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (struo *ScreeningTaskResumeUpdateOne) SetUpdatedAt(t time.Time) *ScreeningTaskResumeUpdateOne {
 	struo.mutation.SetUpdatedAt(t)
@@ -861,6 +1005,24 @@ func (struo *ScreeningTaskResumeUpdateOne) sqlSave(ctx context.Context) (_node *
 	if struo.mutation.ProcessedAtCleared() {
 		_spec.ClearField(screeningtaskresume.FieldProcessedAt, field.TypeTime)
 	}
+	if value, ok := struo.mutation.TokensInput(); ok {
+		_spec.SetField(screeningtaskresume.FieldTokensInput, field.TypeInt64, value)
+	}
+	if value, ok := struo.mutation.AddedTokensInput(); ok {
+		_spec.AddField(screeningtaskresume.FieldTokensInput, field.TypeInt64, value)
+	}
+	if value, ok := struo.mutation.TokensOutput(); ok {
+		_spec.SetField(screeningtaskresume.FieldTokensOutput, field.TypeInt64, value)
+	}
+	if value, ok := struo.mutation.AddedTokensOutput(); ok {
+		_spec.AddField(screeningtaskresume.FieldTokensOutput, field.TypeInt64, value)
+	}
+	if value, ok := struo.mutation.TotalCost(); ok {
+		_spec.SetField(screeningtaskresume.FieldTotalCost, field.TypeFloat64, value)
+	}
+	if value, ok := struo.mutation.AddedTotalCost(); ok {
+		_spec.AddField(screeningtaskresume.FieldTotalCost, field.TypeFloat64, value)
+	}
 	if value, ok := struo.mutation.UpdatedAt(); ok {
 		_spec.SetField(screeningtaskresume.FieldUpdatedAt, field.TypeTime, value)
 	}
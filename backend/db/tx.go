@@ -50,8 +50,12 @@ type Tx struct {
 	NotificationSetting *NotificationSettingClient
 	// Resume is the client for interacting with the Resume builders.
 	Resume *ResumeClient
+	// ResumeChunk is the client for interacting with the ResumeChunk builders.
+	ResumeChunk *ResumeChunkClient
 	// ResumeDocumentParse is the client for interacting with the ResumeDocumentParse builders.
 	ResumeDocumentParse *ResumeDocumentParseClient
+	// ResumeDuplicate is the client for interacting with the ResumeDuplicate builders.
+	ResumeDuplicate *ResumeDuplicateClient
 	// ResumeEducation is the client for interacting with the ResumeEducation builders.
 	ResumeEducation *ResumeEducationClient
 	// ResumeExperience is the client for interacting with the ResumeExperience builders.
@@ -72,12 +76,16 @@ type Tx struct {
 	ResumeSkill *ResumeSkillClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// ScreeningDimension is the client for interacting with the ScreeningDimension builders.
+	ScreeningDimension *ScreeningDimensionClient
 	// ScreeningNodeRun is the client for interacting with the ScreeningNodeRun builders.
 	ScreeningNodeRun *ScreeningNodeRunClient
 	// ScreeningResult is the client for interacting with the ScreeningResult builders.
 	ScreeningResult *ScreeningResultClient
 	// ScreeningRunMetric is the client for interacting with the ScreeningRunMetric builders.
 	ScreeningRunMetric *ScreeningRunMetricClient
+	// ScreeningSchedule is the client for interacting with the ScreeningSchedule builders.
+	ScreeningSchedule *ScreeningScheduleClient
 	// ScreeningTask is the client for interacting with the ScreeningTask builders.
 	ScreeningTask *ScreeningTaskClient
 	// ScreeningTaskResume is the client for interacting with the ScreeningTaskResume builders.
@@ -243,7 +251,9 @@ func (tx *Tx) init() {
 	tx.NotificationEvent = NewNotificationEventClient(tx.config)
 	tx.NotificationSetting = NewNotificationSettingClient(tx.config)
 	tx.Resume = NewResumeClient(tx.config)
+	tx.ResumeChunk = NewResumeChunkClient(tx.config)
 	tx.ResumeDocumentParse = NewResumeDocumentParseClient(tx.config)
+	tx.ResumeDuplicate = NewResumeDuplicateClient(tx.config)
 	tx.ResumeEducation = NewResumeEducationClient(tx.config)
 	tx.ResumeExperience = NewResumeExperienceClient(tx.config)
 	tx.ResumeJobApplication = NewResumeJobApplicationClient(tx.config)
@@ -254,9 +264,11 @@ func (tx *Tx) init() {
 	tx.ResumeProject = NewResumeProjectClient(tx.config)
 	tx.ResumeSkill = NewResumeSkillClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
+	tx.ScreeningDimension = NewScreeningDimensionClient(tx.config)
 	tx.ScreeningNodeRun = NewScreeningNodeRunClient(tx.config)
 	tx.ScreeningResult = NewScreeningResultClient(tx.config)
 	tx.ScreeningRunMetric = NewScreeningRunMetricClient(tx.config)
+	tx.ScreeningSchedule = NewScreeningScheduleClient(tx.config)
 	tx.ScreeningTask = NewScreeningTaskClient(tx.config)
 	tx.ScreeningTaskResume = NewScreeningTaskResumeClient(tx.config)
 	tx.Setting = NewSettingClient(tx.config)
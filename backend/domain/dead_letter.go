@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// DeadLetterUsecase 死信队列管理用例接口
+type DeadLetterUsecase interface {
+	// List 分页列出指定流的死信消息
+	List(ctx context.Context, req *ListDeadLetterReq) (*ListDeadLetterResp, error)
+	// Get 查看单条死信消息
+	Get(ctx context.Context, req *GetDeadLetterReq) (*DeadLetterMessage, error)
+	// Replay 重放单条死信消息
+	Replay(ctx context.Context, req *ReplayDeadLetterReq) error
+	// BatchReplay 批量重放死信消息
+	BatchReplay(ctx context.Context, req *BatchReplayDeadLetterReq) (*BatchReplayDeadLetterResp, error)
+	// Purge 清空指定流的死信队列
+	Purge(ctx context.Context, req *PurgeDeadLetterReq) (*PurgeDeadLetterResp, error)
+}
+
+// ListDeadLetterReq 死信消息列表请求，按消息ID游标翻页
+type ListDeadLetterReq struct {
+	Stream  string `json:"stream" query:"stream" validate:"required"`                        // 原始流名称，如 notification:events
+	AfterID string `json:"after_id,omitempty" query:"after_id"`                              // 翻页游标，返回该消息ID之后的消息
+	Limit   int64  `json:"limit,omitempty" query:"limit" validate:"omitempty,min=1,max=200"` // 每页数量，默认50
+}
+
+// ListDeadLetterResp 死信消息列表响应
+type ListDeadLetterResp struct {
+	Items  []*DeadLetterMessage `json:"items"`
+	Total  int64                `json:"total"`             // 死信消息总数
+	NextID string               `json:"next_id,omitempty"` // 下一页游标，为空表示没有更多
+}
+
+// GetDeadLetterReq 死信消息详情请求
+type GetDeadLetterReq struct {
+	ID     string `param:"id" validate:"required"`                   // 死信消息ID
+	Stream string `json:"stream" query:"stream" validate:"required"` // 原始流名称
+}
+
+// ReplayDeadLetterReq 重放单条死信消息请求
+type ReplayDeadLetterReq struct {
+	ID     string `param:"id" validate:"required"`    // 死信消息ID
+	Stream string `json:"stream" validate:"required"` // 原始流名称
+}
+
+// BatchReplayDeadLetterReq 批量重放死信消息请求，IDs 为空且 All 为 true 时重放全部
+type BatchReplayDeadLetterReq struct {
+	Stream string   `json:"stream" validate:"required"`                 // 原始流名称
+	IDs    []string `json:"ids,omitempty" validate:"omitempty,max=500"` // 死信消息ID列表
+	All    bool     `json:"all,omitempty"`                              // 是否重放全部死信消息
+}
+
+// BatchReplayDeadLetterResp 批量重放死信消息响应
+type BatchReplayDeadLetterResp struct {
+	Replayed int               `json:"replayed"`         // 重放成功数量
+	Failed   map[string]string `json:"failed,omitempty"` // 重放失败的消息ID及原因
+}
+
+// PurgeDeadLetterReq 清空死信队列请求
+type PurgeDeadLetterReq struct {
+	Stream string `json:"stream" query:"stream" validate:"required"` // 原始流名称
+}
+
+// PurgeDeadLetterResp 清空死信队列响应
+type PurgeDeadLetterResp struct {
+	Purged int64 `json:"purged"` // 清除的消息数量
+}
+
+// DeadLetterMessage 死信消息
+type DeadLetterMessage struct {
+	ID         string                 `json:"id"`                  // 死信消息ID
+	Stream     string                 `json:"stream"`              // 原始流名称
+	OriginalID string                 `json:"original_id"`         // 原始消息ID
+	Reason     string                 `json:"reason"`              // 失败原因
+	RetryCount int                    `json:"retry_count"`         // 进入死信前的重试次数
+	FailedAt   *time.Time             `json:"failed_at,omitempty"` // 进入死信队列的时间
+	TraceID    string                 `json:"trace_id,omitempty"`  // 追踪ID
+	Payload    map[string]interface{} `json:"payload"`             // 消息负载
+}
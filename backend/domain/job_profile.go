@@ -25,6 +25,8 @@ type JobProfileUsecase interface {
 	Delete(ctx context.Context, id string) error
 	GetByID(ctx context.Context, id string) (*JobProfileDetail, error)
 	GetByIDs(ctx context.Context, ids []string) ([]*JobProfile, error)
+	// ListPublished 获取全部已发布岗位的完整画像
+	ListPublished(ctx context.Context) ([]*JobProfileDetail, error)
 	List(ctx context.Context, req *ListJobProfileReq) (*ListJobProfileResp, error)
 	Search(ctx context.Context, req *SearchJobProfileReq) (*SearchJobProfileResp, error)
 
@@ -52,6 +54,7 @@ type JobProfileRepo interface {
 	Delete(ctx context.Context, id string) error
 	GetByID(ctx context.Context, id string) (*db.JobPosition, error)
 	GetByIDs(ctx context.Context, ids []string) ([]*db.JobPosition, error)
+	ListPublished(ctx context.Context) ([]*db.JobPosition, error)
 	List(ctx context.Context, req *ListJobProfileRepoReq) ([]*db.JobPosition, *db.PageInfo, error)
 	Search(ctx context.Context, req *SearchJobProfileRepoReq) ([]*db.JobPosition, *db.PageInfo, error)
 	HasRelatedResumes(ctx context.Context, id string) (bool, error)
@@ -36,6 +36,8 @@ type NotificationEventRepo interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*db.NotificationEvent, error)
 	// GetByTraceID 根据TraceID获取通知事件
 	GetByTraceID(ctx context.Context, traceID string) (*db.NotificationEvent, error)
+	// GetByTraceIDAndSetting 根据TraceID与通知设置获取通知事件，用于按设置判断事件是否已发布
+	GetByTraceIDAndSetting(ctx context.Context, traceID string, settingID uuid.UUID) (*db.NotificationEvent, error)
 	// GetPendingEvents 获取待处理的通知事件
 	GetPendingEvents(ctx context.Context, limit int) ([]*db.NotificationEvent, error)
 	// GetRetryableEvents 获取可重试的通知事件
@@ -48,10 +50,14 @@ type NotificationEventRepo interface {
 	MarkAsDelivered(ctx context.Context, id uuid.UUID) error
 	// IncrementRetryCount 增加重试次数
 	IncrementRetryCount(ctx context.Context, id uuid.UUID, errorMsg string) error
+	// ResetForReplay 重置状态与重试次数，用于从死信队列重放
+	ResetForReplay(ctx context.Context, id uuid.UUID) error
 	// GetEventsByType 根据事件类型获取事件列表
 	GetEventsByType(ctx context.Context, eventType consts.NotificationEventType, limit int) ([]*db.NotificationEvent, error)
 	// GetEventsByStatus 根据状态获取事件列表
 	GetEventsByStatus(ctx context.Context, status consts.NotificationStatus, limit int) ([]*db.NotificationEvent, error)
+	// Delete 删除通知事件
+	Delete(ctx context.Context, id uuid.UUID) error
 	// DeleteOldEvents 删除旧事件
 	DeleteOldEvents(ctx context.Context, before time.Time) (int, error)
 }
@@ -70,12 +76,23 @@ type NotificationEvent struct {
 	Timeout     int                          `json:"timeout"`
 	LastError   string                       `json:"last_error"`
 	TraceID     string                       `json:"trace_id"`
+	SettingID   *uuid.UUID                   `json:"setting_id,omitempty"`
 	CreatedAt   time.Time                    `json:"created_at"`
 	ScheduledAt *time.Time                   `json:"scheduled_at"`
 	DeliveredAt *time.Time                   `json:"delivered_at"`
 	UpdatedAt   time.Time                    `json:"updated_at"`
 }
 
+// WebhookEnvelope Webhook 推送的统一消息信封
+type WebhookEnvelope struct {
+	Version   string                       `json:"version"`
+	EventID   string                       `json:"event_id"`
+	EventType consts.NotificationEventType `json:"event_type"`
+	TraceID   string                       `json:"trace_id"`
+	Timestamp int64                        `json:"timestamp"`
+	Payload   map[string]interface{}       `json:"payload"`
+}
+
 // NotificationPayload 通知负载接口
 type NotificationPayload interface {
 	GetEventType() consts.NotificationEventType
@@ -83,6 +100,11 @@ type NotificationPayload interface {
 	GetPayload() map[string]interface{}
 }
 
+// NotificationJobPayload 关联岗位的通知负载，用于通知路由匹配
+type NotificationJobPayload interface {
+	GetJobPositionIDs() []uuid.UUID
+}
+
 // ResumeParseCompletedPayload 简历解析完成事件载荷
 type ResumeParseCompletedPayload struct {
 	ResumeID   uuid.UUID `json:"resume_id"`
@@ -139,6 +161,10 @@ func (p JobMatchingCompletedPayload) GetTarget() string {
 	return p.WebhookURL
 }
 
+func (p JobMatchingCompletedPayload) GetJobPositionIDs() []uuid.UUID {
+	return []uuid.UUID{p.JobID}
+}
+
 func (p JobMatchingCompletedPayload) GetPayload() map[string]interface{} {
 	return map[string]interface{}{
 		"resume_id":   p.ResumeID,
@@ -175,6 +201,10 @@ func (p ScreeningTaskCompletedPayload) GetTarget() string {
 	return p.WebhookURL
 }
 
+func (p ScreeningTaskCompletedPayload) GetJobPositionIDs() []uuid.UUID {
+	return []uuid.UUID{p.JobID}
+}
+
 func (p ScreeningTaskCompletedPayload) GetPayload() map[string]interface{} {
 	return map[string]interface{}{
 		"task_id":      p.TaskID,
@@ -215,6 +245,16 @@ func (p BatchResumeParseCompletedPayload) GetTarget() string {
 	return p.WebhookURL
 }
 
+func (p BatchResumeParseCompletedPayload) GetJobPositionIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(p.JobPositionIDs))
+	for _, id := range p.JobPositionIDs {
+		if parsed, err := uuid.Parse(id); err == nil {
+			ids = append(ids, parsed)
+		}
+	}
+	return ids
+}
+
 func (p BatchResumeParseCompletedPayload) GetPayload() map[string]interface{} {
 	return map[string]interface{}{
 		"task_id":          p.TaskID,
@@ -296,6 +336,7 @@ func (e *NotificationEvent) From(dbEvent *db.NotificationEvent) *NotificationEve
 	e.Timeout = dbEvent.Timeout
 	e.LastError = dbEvent.LastError
 	e.TraceID = dbEvent.TraceID
+	e.SettingID = dbEvent.SettingID
 	e.CreatedAt = dbEvent.CreatedAt
 	e.ScheduledAt = scheduledAt
 	e.DeliveredAt = deliveredAt
@@ -59,6 +59,9 @@ type NotificationSetting struct {
 	Channel        consts.NotificationChannel  `json:"channel"`
 	Enabled        bool                        `json:"enabled"`
 	DingTalkConfig *NotificationDingTalkConfig `json:"dingtalk_config,omitempty"`
+	EmailConfig    *NotificationEmailConfig    `json:"email_config,omitempty"`
+	WebhookConfig  *NotificationWebhookConfig  `json:"webhook_config,omitempty"`
+	RoutingRules   []*NotificationRoutingRule  `json:"routing_rules,omitempty"`
 	MaxRetry       int                         `json:"max_retry"`
 	Timeout        int                         `json:"timeout"`
 	Description    string                      `json:"description"`
@@ -93,9 +96,78 @@ func (ns *NotificationSetting) From(dbSetting *db.NotificationSetting) *Notifica
 		}
 	}
 
+	// 转换 EmailConfig
+	if dbSetting.EmailConfig != nil {
+		configMap := dbSetting.EmailConfig
+		ns.EmailConfig = &NotificationEmailConfig{
+			SMTPHost:   getStringFromMap(configMap, "smtp_host"),
+			SMTPPort:   getIntFromMap(configMap, "smtp_port"),
+			Username:   getStringFromMap(configMap, "username"),
+			Password:   getStringFromMap(configMap, "password"),
+			From:       getStringFromMap(configMap, "from"),
+			FromName:   getStringFromMap(configMap, "from_name"),
+			Recipients: getStringSliceFromMap(configMap, "recipients"),
+			UseTLS:     getBoolFromMap(configMap, "use_tls"),
+		}
+	}
+
+	// 转换 WebhookConfig
+	if dbSetting.WebhookConfig != nil {
+		configMap := dbSetting.WebhookConfig
+		ns.WebhookConfig = &NotificationWebhookConfig{
+			URL:    getStringFromMap(configMap, "url"),
+			Secret: getStringFromMap(configMap, "secret"),
+		}
+	}
+
+	// 转换路由规则
+	if len(dbSetting.RoutingRules) > 0 {
+		ns.RoutingRules = make([]*NotificationRoutingRule, 0, len(dbSetting.RoutingRules))
+		for _, rule := range dbSetting.RoutingRules {
+			if rule == nil {
+				continue
+			}
+			r := &NotificationRoutingRule{
+				DepartmentIDs:  parseUUIDs(rule.DepartmentIDs),
+				JobPositionIDs: parseUUIDs(rule.JobPositionIDs),
+			}
+			for _, eventType := range rule.EventTypes {
+				r.EventTypes = append(r.EventTypes, consts.NotificationEventType(eventType))
+			}
+			ns.RoutingRules = append(ns.RoutingRules, r)
+		}
+	}
+
 	return ns
 }
 
+// MatchesEvent 判断通知设置是否接收该事件：未配置路由规则时接收所有事件，否则任意一条规则匹配即可
+func (ns *NotificationSetting) MatchesEvent(rc *NotificationRoutingContext) bool {
+	if len(ns.RoutingRules) == 0 {
+		return true
+	}
+	for _, rule := range ns.RoutingRules {
+		if rule != nil && rule.Matches(rc) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseUUIDs 将字符串列表解析为 UUID 列表，忽略无效值
+func parseUUIDs(ids []string) []uuid.UUID {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if parsed, err := uuid.Parse(id); err == nil {
+			result = append(result, parsed)
+		}
+	}
+	return result
+}
+
 // getStringFromMap 从 map 中获取字符串值
 func getStringFromMap(m map[string]interface{}, key string) string {
 	if v, ok := m[key]; ok {
@@ -106,6 +178,44 @@ func getStringFromMap(m map[string]interface{}, key string) string {
 	return ""
 }
 
+// getIntFromMap 从 map 中获取整数值，兼容 JSON 反序列化得到的 float64
+func getIntFromMap(m map[string]interface{}, key string) int {
+	switch v := m[key].(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	}
+	return 0
+}
+
+// getBoolFromMap 从 map 中获取布尔值
+func getBoolFromMap(m map[string]interface{}, key string) bool {
+	if v, ok := m[key].(bool); ok {
+		return v
+	}
+	return false
+}
+
+// getStringSliceFromMap 从 map 中获取字符串列表，兼容 JSON 反序列化得到的 []interface{}
+func getStringSliceFromMap(m map[string]interface{}, key string) []string {
+	switch v := m[key].(type) {
+	case []string:
+		return v
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
 // NotificationDingTalkConfig 钉钉通知配置
 type NotificationDingTalkConfig struct {
 	WebhookURL string `json:"webhook_url"`        // 钉钉机器人Webhook URL https://oapi.dingtalk.com/robot/send?access_token=XXX
@@ -114,12 +224,85 @@ type NotificationDingTalkConfig struct {
 	Secret     string `json:"secret,omitempty"`   // 钉钉机器人Secret
 }
 
+// NotificationEmailConfig 邮件通知配置
+type NotificationEmailConfig struct {
+	SMTPHost   string   `json:"smtp_host"`           // SMTP 服务器地址
+	SMTPPort   int      `json:"smtp_port"`           // SMTP 服务器端口，常见为 25/465/587
+	Username   string   `json:"username,omitempty"`  // SMTP 认证用户名，为空时不进行认证
+	Password   string   `json:"password,omitempty"`  // SMTP 认证密码或授权码
+	From       string   `json:"from"`                // 发件人邮箱地址
+	FromName   string   `json:"from_name,omitempty"` // 发件人显示名称
+	Recipients []string `json:"recipients"`          // 收件人邮箱地址列表
+	UseTLS     bool     `json:"use_tls"`             // 是否使用隐式 TLS（如 465 端口），否则在服务器支持时使用 STARTTLS
+}
+
+// NotificationWebhookConfig Webhook 通知配置
+type NotificationWebhookConfig struct {
+	URL    string `json:"url"`    // 接收事件的 HTTP(S) 地址
+	Secret string `json:"secret"` // HMAC-SHA256 签名密钥
+}
+
+// NotificationRoutingRule 通知路由规则，各条件之间为"与"关系，条件为空时表示不限
+type NotificationRoutingRule struct {
+	EventTypes     []consts.NotificationEventType `json:"event_types,omitempty"`      // 订阅的事件类型
+	DepartmentIDs  []uuid.UUID                    `json:"department_ids,omitempty"`   // 限定的部门ID
+	JobPositionIDs []uuid.UUID                    `json:"job_position_ids,omitempty"` // 限定的岗位ID
+}
+
+// NotificationRoutingContext 事件路由上下文，由事件负载关联的岗位及其部门构成
+type NotificationRoutingContext struct {
+	EventType      consts.NotificationEventType
+	DepartmentIDs  []uuid.UUID
+	JobPositionIDs []uuid.UUID
+}
+
+// Matches 判断规则是否匹配事件。规则限定了部门或岗位而事件无法关联到对应部门或岗位时视为不匹配
+func (r *NotificationRoutingRule) Matches(rc *NotificationRoutingContext) bool {
+	if rc == nil {
+		return false
+	}
+	if len(r.EventTypes) > 0 {
+		matched := false
+		for _, eventType := range r.EventTypes {
+			if eventType == rc.EventType {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if len(r.DepartmentIDs) > 0 && !containsAnyUUID(r.DepartmentIDs, rc.DepartmentIDs) {
+		return false
+	}
+	if len(r.JobPositionIDs) > 0 && !containsAnyUUID(r.JobPositionIDs, rc.JobPositionIDs) {
+		return false
+	}
+	return true
+}
+
+// containsAnyUUID 判断两个 UUID 列表是否存在交集
+func containsAnyUUID(set, values []uuid.UUID) bool {
+	for _, v := range values {
+		for _, s := range set {
+			if s == v {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // CreateSettingRequest 创建通知设置请求
 type CreateSettingRequest struct {
 	Name           string                     `json:"name" validate:"required,max=100"`
 	Channel        consts.NotificationChannel `json:"channel" validate:"required"`
 	Enabled        bool                       `json:"enabled"`
 	DingTalkConfig NotificationDingTalkConfig `json:"dingtalk_config,omitempty"`
+	EmailConfig    NotificationEmailConfig    `json:"email_config,omitempty"`
+	WebhookConfig  NotificationWebhookConfig  `json:"webhook_config,omitempty"`
+	RoutingRules   []*NotificationRoutingRule `json:"routing_rules,omitempty"`
 	MaxRetry       int                        `json:"max_retry" validate:"min=0,max=10" default:"3"`
 	Timeout        int                        `json:"timeout" validate:"min=1,max=300" default:"300"`
 	Description    string                     `json:"description" validate:"max=500"`
@@ -131,6 +314,9 @@ type UpdateSettingRequest struct {
 	Channel        consts.NotificationChannel `json:"channel" validate:"required"`
 	Enabled        bool                       `json:"enabled"`
 	DingTalkConfig NotificationDingTalkConfig `json:"dingtalk_config,omitempty"`
+	EmailConfig    NotificationEmailConfig    `json:"email_config,omitempty"`
+	WebhookConfig  NotificationWebhookConfig  `json:"webhook_config,omitempty"`
+	RoutingRules   []*NotificationRoutingRule `json:"routing_rules,omitempty"`
 	MaxRetry       int                        `json:"max_retry" validate:"min=0,max=10" default:"3"`
 	Timeout        int                        `json:"timeout" validate:"min=1,max=300" default:"300"`
 	Description    string                     `json:"description" validate:"max=500"`
@@ -5,6 +5,9 @@ import (
 	"io"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+
 	"github.com/chaitin/WhaleHire/backend/pkg/web"
 
 	"github.com/chaitin/WhaleHire/backend/consts"
@@ -24,6 +27,8 @@ type ResumeUsecase interface {
 
 	// 搜索功能
 	Search(ctx context.Context, req *SearchResumeReq) (*SearchResumeResp, error)
+	SemanticSearch(ctx context.Context, req *SemanticSearchResumeReq) (*SemanticSearchResumeResp, error)
+	ReindexChunks(ctx context.Context, req *ReindexResumeChunksReq) (*ReindexResumeChunksResp, error)
 
 	// 状态管理
 	UpdateStatus(ctx context.Context, id string, status ResumeStatus) error
@@ -38,6 +43,11 @@ type ResumeUsecase interface {
 	BatchUpload(ctx context.Context, req *BatchUploadResumeReq) (*BatchUploadTask, error)
 	GetBatchUploadStatus(ctx context.Context, taskID string) (*BatchUploadTask, error)
 	CancelBatchUpload(ctx context.Context, taskID string) error
+
+	// 重复检测与合并
+	ListDuplicates(ctx context.Context, id string) ([]*ResumeDuplicate, error)
+	Merge(ctx context.Context, req *MergeResumeReq) (*ResumeDetail, error)
+	DismissDuplicate(ctx context.Context, req *DismissResumeDuplicateReq) error
 }
 
 // ResumeRepo 简历数据访问接口
@@ -78,6 +88,21 @@ type ResumeRepo interface {
 
 	// 数据清理
 	ClearParsedData(ctx context.Context, resumeID string) error
+
+	// 重复检测与合并
+	FindDuplicateCandidates(ctx context.Context, query *DuplicateCandidateQuery) ([]*db.Resume, error)
+	SaveDuplicate(ctx context.Context, duplicate *db.ResumeDuplicate) error
+	ListDuplicates(ctx context.Context, resumeID string) ([]*db.ResumeDuplicate, error)
+	GetDuplicate(ctx context.Context, id string) (*db.ResumeDuplicate, error)
+	UpdateDuplicateStatus(ctx context.Context, id string, status consts.ResumeDuplicateStatus, reviewerID string) error
+	Merge(ctx context.Context, canonicalID string, duplicateIDs []string, operatorID string) error
+	ListMergedResumes(ctx context.Context, canonicalID string) ([]*db.Resume, error)
+
+	// 语义检索
+	ReplaceChunks(ctx context.Context, resumeID string, chunks []*db.ResumeChunk) error
+	SearchChunks(ctx context.Context, filter *SearchResumeReq, vector pgvector.Vector, limit int) ([]*ResumeChunkMatch, error)
+	ListByIDs(ctx context.Context, ids []string) ([]*db.Resume, error)
+	ListIndexableResumeIDs(ctx context.Context, afterID string, onlyMissing bool, limit int) ([]string, error)
 }
 
 // ParserService LLM解析服务接口
@@ -85,6 +110,14 @@ type ParserService interface {
 	ParseResume(ctx context.Context, resumeID string, fileURL string, fileType string) (*ParsedResumeData, error)
 }
 
+// ResumeEmbeddingService 简历分块向量化服务接口
+type ResumeEmbeddingService interface {
+	// Enabled 是否已配置嵌入模型
+	Enabled() bool
+	// EmbedTexts 批量生成文本向量，返回顺序与输入一致
+	EmbedTexts(ctx context.Context, texts []string) ([]pgvector.Vector, error)
+}
+
 // StorageService 文件存储服务接口
 type StorageService interface {
 	Upload(ctx context.Context, file io.Reader, filename string) (*FileInfo, error)
@@ -110,8 +143,9 @@ type UploadResumeReq struct {
 	File           io.Reader `json:"-"`
 	Filename       string    `json:"filename" validate:"required"`
 	JobPositionIDs []string  `json:"job_position_ids,omitempty"`  // 关联的岗位ID列表
-	Source         *string   `json:"source"  validate:"required"` // 申请来源，可选值：email（邮箱采集）、manual（手动上传）
+	Source         *string   `json:"source"  validate:"required"` // 申请来源，可选值：email（邮箱采集）、manual（手动上传），同时记录为简历来源
 	Notes          *string   `json:"notes,omitempty"`             // 备注信息
+	WaitForParsing bool      `json:"-"`                           // 是否同步等待解析完成，供需要立即使用解析结果的内部调用方使用
 }
 
 // ListResumeReq 简历列表请求
@@ -242,6 +276,8 @@ type Resume struct {
 	HonorsCertificates string                   `json:"honors_certificates,omitempty"` // 荣誉证书
 	OtherInfo          string                   `json:"other_info,omitempty"`          // 其他信息
 	ResumeFileURL      string                   `json:"resume_file_url"`
+	Source             string                   `json:"source,omitempty"`         // 简历来源：email、manual
+	MergedIntoID       *string                  `json:"merged_into_id,omitempty"` // 已合并到的主简历ID，非空表示该简历仅作为文件历史保留
 	Status             ResumeStatus             `json:"status"`
 	ErrorMessage       string                   `json:"error_message,omitempty"`
 	ParsedAt           *time.Time               `json:"parsed_at,omitempty"`
@@ -280,6 +316,11 @@ func (r *Resume) From(e *db.Resume) *Resume {
 	r.HonorsCertificates = e.HonorsCertificates
 	r.OtherInfo = e.OtherInfo
 	r.ResumeFileURL = e.ResumeFileURL
+	r.Source = e.Source
+	if e.MergedIntoID != nil {
+		mergedIntoID := e.MergedIntoID.String()
+		r.MergedIntoID = &mergedIntoID
+	}
 	r.Status = ResumeStatus(e.Status)
 	r.ErrorMessage = e.ErrorMessage
 	if !e.ParsedAt.IsZero() {
@@ -293,11 +334,86 @@ func (r *Resume) From(e *db.Resume) *Resume {
 // ResumeDetail 简历详情（包含关联数据）
 type ResumeDetail struct {
 	*Resume
-	Educations  []*ResumeEducation  `json:"educations"`
-	Experiences []*ResumeExperience `json:"experiences"`
-	Skills      []*ResumeSkill      `json:"skills"`
-	Projects    []*ResumeProject    `json:"projects"`
-	Logs        []*ResumeLog        `json:"logs"`
+	Educations  []*ResumeEducation   `json:"educations"`
+	Experiences []*ResumeExperience  `json:"experiences"`
+	Skills      []*ResumeSkill       `json:"skills"`
+	Projects    []*ResumeProject     `json:"projects"`
+	Logs        []*ResumeLog         `json:"logs"`
+	FileHistory []*ResumeFileHistory `json:"file_history,omitempty"` // 合并到当前简历的历史简历文件
+}
+
+// ResumeFileHistory 合并到主简历的历史简历文件
+type ResumeFileHistory struct {
+	ResumeID      string `json:"resume_id"`
+	ResumeFileURL string `json:"resume_file_url"`
+	Source        string `json:"source,omitempty"`
+	UploadedAt    int64  `json:"uploaded_at"`
+	MergedAt      int64  `json:"merged_at"`
+}
+
+func (h *ResumeFileHistory) From(e *db.Resume) *ResumeFileHistory {
+	if e == nil {
+		return h
+	}
+	h.ResumeID = e.ID.String()
+	h.ResumeFileURL = e.ResumeFileURL
+	h.Source = e.Source
+	h.UploadedAt = e.CreatedAt.Unix()
+	if e.MergedAt != nil {
+		h.MergedAt = e.MergedAt.Unix()
+	}
+	return h
+}
+
+// DuplicateCandidateQuery 疑似重复简历的候选召回条件，满足任一条件的未合并简历参与相似度比对
+type DuplicateCandidateQuery struct {
+	ResumeID uuid.UUID
+	FileHash string
+	Phone    string // 归一化手机号
+	Email    string // 归一化邮箱
+	Name     string // 归一化姓名，精确匹配
+	// NamePrefix 归一化姓名首字，需同时有相同学校或公司才召回，用于识别姓名写法不一致的简历
+	NamePrefix string
+	Schools    []string // 归一化学校名称
+	Companies  []string // 归一化公司名称
+}
+
+// ResumeDuplicate 疑似重复简历
+type ResumeDuplicate struct {
+	ID        string                         `json:"id"`
+	Resume    *Resume                        `json:"resume"`  // 与当前简历疑似重复的另一份简历
+	Score     float64                        `json:"score"`   // 重复相似度 0-1
+	Reasons   []consts.ResumeDuplicateReason `json:"reasons"` // 判定依据：file_hash/phone/email/name/education/experience
+	Status    consts.ResumeDuplicateStatus   `json:"status"`
+	CreatedAt int64                          `json:"created_at"`
+}
+
+func (d *ResumeDuplicate) From(entity *db.ResumeDuplicate) *ResumeDuplicate {
+	if entity == nil {
+		return d
+	}
+	d.ID = entity.ID.String()
+	d.Score = entity.Score
+	d.Reasons = make([]consts.ResumeDuplicateReason, 0, len(entity.Reasons))
+	for _, reason := range entity.Reasons {
+		d.Reasons = append(d.Reasons, consts.ResumeDuplicateReason(reason))
+	}
+	d.Status = entity.Status
+	d.CreatedAt = entity.CreatedAt.Unix()
+	return d
+}
+
+// MergeResumeReq 合并重复简历请求
+type MergeResumeReq struct {
+	ID           string   `json:"-"`                                       // 主简历ID，合并后保留
+	DuplicateIDs []string `json:"duplicate_ids" validate:"required,min=1"` // 合并到主简历的重复简历ID列表
+	OperatorID   string   `json:"-"`
+}
+
+// DismissResumeDuplicateReq 忽略疑似重复请求
+type DismissResumeDuplicateReq struct {
+	ID         string `json:"-"` // 疑似重复记录ID
+	OperatorID string `json:"-"`
 }
 
 // ResumeEducation 教育经历
@@ -496,6 +612,78 @@ type SearchResumeResp struct {
 	Resumes []*Resume `json:"resumes"`
 }
 
+// SemanticSearchResumeReq 语义检索简历请求，结构化筛选条件与关键词搜索一致
+type SemanticSearchResumeReq struct {
+	Query           string   `json:"query" query:"query" validate:"required"`                    // 自然语言查询，如"做过支付系统的Go工程师"
+	Limit           int      `json:"limit,omitempty" query:"limit" validate:"omitempty,max=100"` // 返回简历数量，默认20
+	UploaderID      *string  `json:"uploader_id,omitempty" query:"uploader_id"`
+	Skills          []string `json:"skills,omitempty" query:"skills"`
+	Education       *string  `json:"education,omitempty" query:"education"`
+	YearsExperience *float64 `json:"years_experience,omitempty" query:"years_experience"`
+	CurrentCity     *string  `json:"current_city,omitempty" query:"current_city"`
+	Gender          *string  `json:"gender,omitempty" query:"gender"`
+	AgeFrom         *int     `json:"age_from,omitempty" query:"age_from"`
+	AgeTo           *int     `json:"age_to,omitempty" query:"age_to"`
+}
+
+// Filter 转换为结构化筛选条件
+func (r *SemanticSearchResumeReq) Filter() *SearchResumeReq {
+	return &SearchResumeReq{
+		UploaderID:      r.UploaderID,
+		Skills:          r.Skills,
+		Education:       r.Education,
+		YearsExperience: r.YearsExperience,
+		CurrentCity:     r.CurrentCity,
+		Gender:          r.Gender,
+		AgeFrom:         r.AgeFrom,
+		AgeTo:           r.AgeTo,
+	}
+}
+
+// SemanticSearchResumeResp 语义检索简历响应
+type SemanticSearchResumeResp struct {
+	Results []*SemanticResumeHit `json:"results"`
+}
+
+// SemanticResumeHit 语义检索命中的简历
+type SemanticResumeHit struct {
+	Resume   *Resume          `json:"resume"`
+	Score    float64          `json:"score"`    // 综合得分 0-1，向量相似度与关键词命中加权
+	Snippets []*ResumeSnippet `json:"snippets"` // 命中片段，按得分降序
+}
+
+// ResumeSnippet 简历命中片段
+type ResumeSnippet struct {
+	ChunkType  consts.ResumeChunkType `json:"chunk_type"`
+	SourceID   *string                `json:"source_id,omitempty"` // 对应的工作经历或项目ID
+	Content    string                 `json:"content"`             // 片段文本，命中的关键词以 <em></em> 标记
+	Similarity float64                `json:"similarity"`          // 向量相似度
+}
+
+// ReindexResumeChunksReq 重建语义检索分块请求，按简历ID游标分批处理
+type ReindexResumeChunksReq struct {
+	AfterID     string `json:"after_id,omitempty"`                                 // 上一批返回的 next_after_id，为空时从头开始
+	Limit       int    `json:"limit,omitempty" validate:"omitempty,min=1,max=500"` // 本批处理的简历数量，默认100
+	OnlyMissing bool   `json:"only_missing,omitempty"`                             // 仅处理尚无分块的简历，用于回填上线前的存量简历
+}
+
+// ReindexResumeChunksResp 重建语义检索分块响应
+type ReindexResumeChunksResp struct {
+	Processed   int      `json:"processed"`               // 本批成功重建分块的简历数量
+	Failed      []string `json:"failed"`                  // 向量化或保存失败的简历ID
+	NextAfterID string   `json:"next_after_id,omitempty"` // 下一批的游标，为空表示已处理完毕
+}
+
+// ResumeChunkMatch 向量检索命中的简历分块
+type ResumeChunkMatch struct {
+	ID        uuid.UUID              `sql:"id"`
+	ResumeID  uuid.UUID              `sql:"resume_id"`
+	ChunkType consts.ResumeChunkType `sql:"chunk_type"`
+	SourceID  *uuid.UUID             `sql:"source_id"`
+	Content   string                 `sql:"content"`
+	Distance  float64                `sql:"distance"` // 余弦距离，相似度为 1 - Distance
+}
+
 // GetResumesByJobPositionIDReq 根据岗位ID获取简历请求
 type GetResumesByJobPositionIDReq struct {
 	web.Pagination
@@ -596,6 +784,7 @@ type FileInfo struct {
 	FileURL      string `json:"file_url"`
 	Size         int64  `json:"size"`
 	ContentType  string `json:"content_type"`
+	ContentHash  string `json:"content_hash"` // 文件内容SHA-256
 }
 
 // ResumeParseProgress 简历解析进度信息
@@ -663,17 +852,18 @@ const (
 
 // ResumeDocumentParse 文档解析结果
 type ResumeDocumentParse struct {
-	ID        string    `json:"id"`
-	ResumeID  string    `json:"resume_id"`
-	FileID    string    `json:"file_id"`
-	Content   string    `json:"content"`
-	FileType  string    `json:"file_type"`
-	Filename  string    `json:"filename"`
-	Title     string    `json:"title"`
-	UploadAt  time.Time `json:"upload_at"`
-	Status    string    `json:"status"`
-	CreatedAt int64     `json:"created_at"`
-	UpdatedAt int64     `json:"updated_at"`
+	ID            string    `json:"id"`
+	ResumeID      string    `json:"resume_id"`
+	FileID        string    `json:"file_id"`
+	Content       string    `json:"content"`
+	FileType      string    `json:"file_type"`
+	Filename      string    `json:"filename"`
+	Title         string    `json:"title"`
+	UploadAt      time.Time `json:"upload_at"`
+	Status        string    `json:"status"`
+	OCRConfidence *float64  `json:"ocr_confidence,omitempty"` // OCR识别平均置信度（0~1），未经过OCR时为空
+	CreatedAt     int64     `json:"created_at"`
+	UpdatedAt     int64     `json:"updated_at"`
 }
 
 func (d *ResumeDocumentParse) From(entity *db.ResumeDocumentParse) *ResumeDocumentParse {
@@ -689,6 +879,7 @@ func (d *ResumeDocumentParse) From(entity *db.ResumeDocumentParse) *ResumeDocume
 	d.Title = entity.Title
 	d.UploadAt = entity.UploadAt
 	d.Status = entity.Status
+	d.OCRConfidence = entity.OcrConfidence
 	d.CreatedAt = entity.CreatedAt.Unix()
 	d.UpdatedAt = entity.UpdatedAt.Unix()
 	return d
@@ -3,10 +3,13 @@ package domain
 import (
 	"context"
 	"io"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/chaitin/WhaleHire/backend/consts"
 	"github.com/chaitin/WhaleHire/backend/db"
 	"github.com/chaitin/WhaleHire/backend/errcode"
 	"github.com/chaitin/WhaleHire/backend/pkg/web"
@@ -143,35 +146,41 @@ type ResumeMailboxScheduler interface {
 
 // CreateResumeMailboxSettingRequest 创建邮箱设置请求
 type CreateResumeMailboxSettingRequest struct {
-	Name                string                 `json:"name" validate:"required,min=1,max=100"`             // 邮箱设置名称
-	EmailAddress        string                 `json:"email_address" validate:"required,email"`            // 邮箱地址
-	Protocol            string                 `json:"protocol" validate:"required,oneof=imap pop3"`       // 邮箱协议：imap或pop3
-	Host                string                 `json:"host" validate:"required,min=1,max=255"`             // 邮箱服务器地址
-	Port                int                    `json:"port" validate:"required,min=1,max=65535"`           // 邮箱服务器端口
-	UseSsl              bool                   `json:"use_ssl"`                                            // 是否使用SSL连接
-	Folder              *string                `json:"folder,omitempty"`                                   // 邮箱文件夹，可选
-	AuthType            string                 `json:"auth_type" validate:"required,oneof=password oauth"` // 认证类型：password或oauth
+	Name                string                 `json:"name" validate:"required,min=1,max=100"`                                // 邮箱设置名称
+	EmailAddress        string                 `json:"email_address" validate:"required,email"`                               // 邮箱地址
+	Protocol            string                 `json:"protocol" validate:"required,oneof=imap pop3 graph"`                    // 邮箱协议：imap、pop3或graph
+	Host                string                 `json:"host" validate:"required,min=1,max=255"`                                // 邮箱服务器地址
+	Port                int                    `json:"port" validate:"required,min=1,max=65535"`                              // 邮箱服务器端口
+	UseSsl              bool                   `json:"use_ssl"`                                                               // 是否使用SSL连接
+	Folder              *string                `json:"folder,omitempty"`                                                      // 邮箱文件夹，可选
+	AuthType            string                 `json:"auth_type" validate:"required,oneof=password oauth client_credentials"` // 认证类型：password、oauth或client_credentials
 	EncryptedCredential map[string]interface{} `json:"encrypted_credential" validate:"required"`
-	UploaderID          uuid.UUID              `json:"uploader_id"`                                                         // 上传者ID
-	JobProfileIDs       []uuid.UUID            `json:"job_profile_ids,omitempty"`                                           // 关联的职位档案ID列表，可选
-	SyncIntervalMinutes *int                   `json:"sync_interval_minutes,omitempty" validate:"omitempty,min=5,max=1440"` // 同步间隔（分钟），可选，范围5-1440
-	Status              string                 `json:"status" validate:"required,oneof=enabled disabled"`                   // 状态：enabled或disabled，必须
+	UploaderID          uuid.UUID              `json:"uploader_id"`                                                                                         // 上传者ID
+	JobProfileIDs       []uuid.UUID            `json:"job_profile_ids,omitempty"`                                                                           // 关联的职位档案ID列表，可选
+	SyncIntervalMinutes *int                   `json:"sync_interval_minutes,omitempty" validate:"omitempty,min=5,max=1440"`                                 // 同步间隔（分钟），可选，范围5-1440
+	ExtractionMode      *string                `json:"extraction_mode,omitempty" validate:"omitempty,oneof=attachments attachments_body attachments_links"` // 简历提取方式，可选，默认attachments
+	LinkAllowedDomains  []string               `json:"link_allowed_domains,omitempty"`                                                                      // 允许跟随下载的链接域名白名单，attachments_links模式必需
+	Rules               []*ResumeMailboxRule   `json:"rules,omitempty"`                                                                                     // 邮件过滤规则，按顺序匹配，可选
+	Status              string                 `json:"status" validate:"required,oneof=enabled disabled"`                                                   // 状态：enabled或disabled，必须
 }
 
 // UpdateResumeMailboxSettingRequest 更新邮箱设置请求
 type UpdateResumeMailboxSettingRequest struct {
-	Name                *string                 `json:"name,omitempty" validate:"omitempty,min=1,max=100"`                   // 邮箱设置名称，可选
-	EmailAddress        *string                 `json:"email_address,omitempty" validate:"omitempty,email"`                  // 邮箱地址，可选
-	Protocol            *string                 `json:"protocol,omitempty" validate:"omitempty,oneof=imap pop3"`             // 邮箱协议：imap或pop3，可选
-	Host                *string                 `json:"host,omitempty" validate:"omitempty,min=1,max=255"`                   // 邮箱服务器地址，可选
-	Port                *int                    `json:"port,omitempty" validate:"omitempty,min=1,max=65535"`                 // 邮箱服务器端口，可选
-	UseSsl              *bool                   `json:"use_ssl,omitempty"`                                                   // 是否使用SSL连接，可选
-	Folder              *string                 `json:"folder,omitempty"`                                                    // 邮箱文件夹，可选
-	AuthType            *string                 `json:"auth_type,omitempty" validate:"omitempty,oneof=password oauth"`       // 认证类型：password或oauth，可选
-	EncryptedCredential *map[string]interface{} `json:"encrypted_credential,omitempty"`                                      // 加密后的认证凭据，可选。password类型需要：username(可选,默认使用email_address)、password(必需)；oauth类型需要：username(可选,默认使用email_address)、access_token(必需)
-	JobProfileIDs       []uuid.UUID             `json:"job_profile_ids,omitempty"`                                           // 关联的职位档案ID列表，可选
-	SyncIntervalMinutes *int                    `json:"sync_interval_minutes,omitempty" validate:"omitempty,min=5,max=1440"` // 同步间隔（分钟），可选，范围5-1440
-	Status              *string                 `json:"status,omitempty" validate:"omitempty,oneof=enabled disabled"`        // 状态：enabled或disabled，可选
+	Name                *string                 `json:"name,omitempty" validate:"omitempty,min=1,max=100"`                                                   // 邮箱设置名称，可选
+	EmailAddress        *string                 `json:"email_address,omitempty" validate:"omitempty,email"`                                                  // 邮箱地址，可选
+	Protocol            *string                 `json:"protocol,omitempty" validate:"omitempty,oneof=imap pop3 graph"`                                       // 邮箱协议：imap、pop3或graph，可选
+	Host                *string                 `json:"host,omitempty" validate:"omitempty,min=1,max=255"`                                                   // 邮箱服务器地址，可选
+	Port                *int                    `json:"port,omitempty" validate:"omitempty,min=1,max=65535"`                                                 // 邮箱服务器端口，可选
+	UseSsl              *bool                   `json:"use_ssl,omitempty"`                                                                                   // 是否使用SSL连接，可选
+	Folder              *string                 `json:"folder,omitempty"`                                                                                    // 邮箱文件夹，可选
+	AuthType            *string                 `json:"auth_type,omitempty" validate:"omitempty,oneof=password oauth client_credentials"`                    // 认证类型：password、oauth或client_credentials，可选
+	EncryptedCredential *map[string]interface{} `json:"encrypted_credential,omitempty"`                                                                      // 加密后的认证凭据，可选。password类型需要：username(可选,默认使用email_address)、password(必需)；oauth类型需要：username(可选,默认使用email_address)、access_token(必需)；client_credentials类型需要：tenant_id、client_id、client_secret(均必需)
+	JobProfileIDs       []uuid.UUID             `json:"job_profile_ids,omitempty"`                                                                           // 关联的职位档案ID列表，可选
+	SyncIntervalMinutes *int                    `json:"sync_interval_minutes,omitempty" validate:"omitempty,min=5,max=1440"`                                 // 同步间隔（分钟），可选，范围5-1440
+	ExtractionMode      *string                 `json:"extraction_mode,omitempty" validate:"omitempty,oneof=attachments attachments_body attachments_links"` // 简历提取方式，可选
+	LinkAllowedDomains  []string                `json:"link_allowed_domains,omitempty"`                                                                      // 允许跟随下载的链接域名白名单，可选
+	Rules               []*ResumeMailboxRule    `json:"rules,omitempty"`                                                                                     // 邮件过滤规则，可选，传空数组清空
+	Status              *string                 `json:"status,omitempty" validate:"omitempty,oneof=enabled disabled"`                                        // 状态：enabled或disabled，可选
 }
 
 // ListResumeMailboxSettingsRequest 获取邮箱设置列表请求
@@ -179,10 +188,10 @@ type ListResumeMailboxSettingsRequest struct {
 	web.Pagination
 
 	// 过滤条件
-	UploaderID    *uuid.UUID  `json:"uploader_id,omitempty" query:"uploader_id"`                                   // 上传者ID筛选，可选
-	JobProfileIDs []uuid.UUID `json:"job_profile_ids,omitempty" query:"job_profile_ids"`                           // 职位档案ID列表筛选，可选
-	Status        *string     `json:"status,omitempty" query:"status" validate:"omitempty,oneof=enabled disabled"` // 状态筛选：enabled或disabled，可选
-	Protocol      *string     `json:"protocol,omitempty" query:"protocol" validate:"omitempty,oneof=imap pop3"`    // 协议筛选：imap或pop3，可选
+	UploaderID    *uuid.UUID  `json:"uploader_id,omitempty" query:"uploader_id"`                                      // 上传者ID筛选，可选
+	JobProfileIDs []uuid.UUID `json:"job_profile_ids,omitempty" query:"job_profile_ids"`                              // 职位档案ID列表筛选，可选
+	Status        *string     `json:"status,omitempty" query:"status" validate:"omitempty,oneof=enabled disabled"`    // 状态筛选：enabled或disabled，可选
+	Protocol      *string     `json:"protocol,omitempty" query:"protocol" validate:"omitempty,oneof=imap pop3 graph"` // 协议筛选：imap、pop3或graph，可选
 }
 
 // ListResumeMailboxSettingsResponse 获取邮箱设置列表响应
@@ -193,14 +202,14 @@ type ListResumeMailboxSettingsResponse struct {
 
 // TestConnectionRequest 测试连接请求
 type TestConnectionRequest struct {
-	EmailAddress        string                 `json:"email_address" validate:"required,email"`                       // 邮箱地址
-	Protocol            string                 `json:"protocol" validate:"required,oneof=imap pop3"`                  // 邮箱协议：imap或pop3
-	Host                string                 `json:"host" validate:"required,min=1,max=255"`                        // 邮箱服务器地址
-	Port                int                    `json:"port" validate:"required,min=1,max=65535"`                      // 邮箱服务器端口
-	UseSsl              bool                   `json:"use_ssl"`                                                       // 是否使用SSL连接
-	Folder              *string                `json:"folder,omitempty"`                                              // 邮箱文件夹，可选
-	AuthType            string                 `json:"auth_type" validate:"required,oneof=password oauth"`            // 认证类型：password或oauth
-	EncryptedCredential map[string]interface{} `json:"encrypted_credential" validate:"required" swaggertype:"object"` // 加密后的认证凭据。password类型需要：username(可选,默认使用email_address)、password(必需)；oauth类型需要：username(可选,默认使用email_address)、access_token(必需)
+	EmailAddress        string                 `json:"email_address" validate:"required,email"`                               // 邮箱地址
+	Protocol            string                 `json:"protocol" validate:"required,oneof=imap pop3 graph"`                    // 邮箱协议：imap、pop3或graph
+	Host                string                 `json:"host" validate:"required,min=1,max=255"`                                // 邮箱服务器地址
+	Port                int                    `json:"port" validate:"required,min=1,max=65535"`                              // 邮箱服务器端口
+	UseSsl              bool                   `json:"use_ssl"`                                                               // 是否使用SSL连接
+	Folder              *string                `json:"folder,omitempty"`                                                      // 邮箱文件夹，可选
+	AuthType            string                 `json:"auth_type" validate:"required,oneof=password oauth client_credentials"` // 认证类型：password、oauth或client_credentials
+	EncryptedCredential map[string]interface{} `json:"encrypted_credential" validate:"required" swaggertype:"object"`         // 加密后的认证凭据。password类型需要：username(可选,默认使用email_address)、password(必需)；oauth类型需要：username(可选,默认使用email_address)、access_token(必需)；client_credentials类型需要：tenant_id、client_id、client_secret(均必需)
 }
 
 // TestConnectionResponse 测试连接响应
@@ -236,7 +245,7 @@ type UpdateSyncStatusRequest struct {
 type ListResumeMailboxSettingReq struct {
 	web.Pagination
 	Status       *string `json:"status,omitempty" query:"status" validate:"omitempty,oneof=enabled disabled"`
-	Protocol     *string `json:"protocol,omitempty" query:"protocol" validate:"omitempty,oneof=imap pop3"`
+	Protocol     *string `json:"protocol,omitempty" query:"protocol" validate:"omitempty,oneof=imap pop3 graph"`
 	EmailAddress *string `json:"email_address,omitempty" query:"email_address"`
 	UploaderID   *string `json:"uploader_id,omitempty" query:"uploader_id"`
 	Keyword      *string `json:"keyword,omitempty" query:"keyword"`
@@ -284,6 +293,8 @@ type UpsertResumeMailboxStatisticRequest struct {
 	ParsedResumes      *int      `json:"parsed_resumes,omitempty" validate:"omitempty,min=0"`        // 解析简历数，可选
 	FailedResumes      *int      `json:"failed_resumes,omitempty" validate:"omitempty,min=0"`        // 失败简历数，可选
 	SkippedAttachments *int      `json:"skipped_attachments,omitempty" validate:"omitempty,min=0"`   // 跳过附件数，可选
+	BodyResumes        *int      `json:"body_resumes,omitempty" validate:"omitempty,min=0"`          // 正文提取简历数，可选
+	LinkResumes        *int      `json:"link_resumes,omitempty" validate:"omitempty,min=0"`          // 链接下载简历数，可选
 	LastSyncDurationMs *int      `json:"last_sync_duration_ms,omitempty" validate:"omitempty,min=0"` // 最后同步耗时，可选
 }
 
@@ -316,6 +327,9 @@ type ResumeMailboxSetting struct {
 	JobProfileIDs       []uuid.UUID            `json:"job_profile_ids,omitempty"`
 	JobProfileNames     []string               `json:"job_profile_names,omitempty"`
 	SyncIntervalMinutes *int                   `json:"sync_interval_minutes,omitempty"`
+	ExtractionMode      string                 `json:"extraction_mode"`
+	LinkAllowedDomains  []string               `json:"link_allowed_domains,omitempty"`
+	Rules               []*ResumeMailboxRule   `json:"rules,omitempty"`
 	Status              string                 `json:"status"`
 	LastSyncedAt        *time.Time             `json:"last_synced_at,omitempty"`
 	LastError           string                 `json:"last_error"`
@@ -345,6 +359,23 @@ func (s *ResumeMailboxSetting) From(entity *db.ResumeMailboxSetting) *ResumeMail
 	// 使用多个JobProfileIDs
 	s.JobProfileIDs = entity.JobProfileIds
 	s.SyncIntervalMinutes = entity.SyncIntervalMinutes
+	s.ExtractionMode = entity.ExtractionMode
+	s.LinkAllowedDomains = entity.LinkAllowedDomains
+	for _, rule := range entity.Rules {
+		if rule == nil {
+			continue
+		}
+		s.Rules = append(s.Rules, &ResumeMailboxRule{
+			Name:              rule.Name,
+			SenderDomains:     rule.SenderDomains,
+			SubjectPattern:    rule.SubjectPattern,
+			RecipientAliases:  rule.RecipientAliases,
+			AttachmentPattern: rule.AttachmentPattern,
+			Action:            consts.MailboxRuleAction(rule.Action),
+			JobPositionIDs:    parseUUIDs(rule.JobPositionIDs),
+			Source:            rule.Source,
+		})
+	}
 	s.Status = string(entity.Status)
 	s.LastSyncedAt = entity.LastSyncedAt
 	s.LastError = entity.LastError
@@ -415,8 +446,12 @@ type MailboxFetchResult struct {
 type MailboxEmail struct {
 	MessageID   string
 	Subject     string
+	From        string   // 发件人地址
+	Recipients  []string // 收件人与抄送地址，用于匹配收件别名
 	ReceivedAt  time.Time
 	Attachments []*MailboxAttachment
+	HTMLBody    string // HTML 正文，用于正文/链接提取
+	TextBody    string // 纯文本正文，用于正文/链接提取
 	RawSize     int64
 }
 
@@ -437,6 +472,10 @@ type ResumeMailboxSyncResult struct {
 	SuccessAttachments int           `json:"success_attachments"`
 	FailedAttachments  int           `json:"failed_attachments"`
 	SkippedAttachments int           `json:"skipped_attachments"`
+	BodyResumes        int           `json:"body_resumes"`                 // 从正文提取入库的简历数，已计入 SuccessAttachments
+	LinkResumes        int           `json:"link_resumes"`                 // 从链接下载入库的简历数，已计入 SuccessAttachments
+	RuleSkippedEmails  int           `json:"rule_skipped_emails"`          // 命中 skip 规则而跳过的邮件数
+	ScreeningTaskIDs   []uuid.UUID   `json:"screening_task_ids,omitempty"` // 命中 screen 规则自动发起的筛选任务
 	Duration           time.Duration `json:"duration"`
 	LastMessageID      string        `json:"last_message_id"`
 	Errors             []string      `json:"errors,omitempty"`
@@ -452,6 +491,8 @@ type ResumeMailboxStatistic struct {
 	ParsedResumes      int       `json:"parsed_resumes"`
 	FailedResumes      int       `json:"failed_resumes"`
 	SkippedAttachments int       `json:"skipped_attachments"`
+	BodyResumes        int       `json:"body_resumes"`
+	LinkResumes        int       `json:"link_resumes"`
 	LastSyncDurationMs int       `json:"last_sync_duration_ms"`
 	CreatedAt          time.Time `json:"created_at"`
 	UpdatedAt          time.Time `json:"updated_at"`
@@ -471,6 +512,8 @@ func (s *ResumeMailboxStatistic) From(entity *db.ResumeMailboxStatistic) *Resume
 	s.FailedResumes = entity.FailedResumes
 	s.TotalParsedResumes = entity.ParsedResumes + entity.FailedResumes // 解析简历总数（成功+失败）
 	s.SkippedAttachments = entity.SkippedAttachments
+	s.BodyResumes = entity.BodyResumes
+	s.LinkResumes = entity.LinkResumes
 	s.LastSyncDurationMs = entity.LastSyncDurationMs
 	s.CreatedAt = entity.CreatedAt
 	s.UpdatedAt = entity.UpdatedAt
@@ -485,6 +528,145 @@ type MailboxStatisticsSummary struct {
 	TotalParsedResumes      int     `json:"total_parsed_resumes"`
 	TotalFailedResumes      int     `json:"total_failed_resumes"`
 	TotalSkippedAttachments int     `json:"total_skipped_attachments"`
+	TotalBodyResumes        int     `json:"total_body_resumes"`
+	TotalLinkResumes        int     `json:"total_link_resumes"`
 	AvgSyncDurationMs       float64 `json:"avg_sync_duration_ms"`
 	SuccessRate             float64 `json:"success_rate"`
 }
+
+// ResumeMailboxRule 邮箱过滤规则，各匹配条件之间为"与"关系，条件为空时表示不限
+type ResumeMailboxRule struct {
+	Name              string                   `json:"name"`                         // 规则名称
+	SenderDomains     []string                 `json:"sender_domains,omitempty"`     // 发件人域名，包含子域名
+	SubjectPattern    string                   `json:"subject_pattern,omitempty"`    // 主题正则
+	RecipientAliases  []string                 `json:"recipient_aliases,omitempty"`  // 收件别名，完整地址或@前的本地部分
+	AttachmentPattern string                   `json:"attachment_pattern,omitempty"` // 附件名正则，任一附件匹配即可
+	Action            consts.MailboxRuleAction `json:"action"`                       // 动作：skip/assign/tag/screen
+	JobPositionIDs    []uuid.UUID              `json:"job_position_ids,omitempty"`   // assign/screen 动作关联的岗位ID
+	Source            string                   `json:"source,omitempty"`             // tag 动作设置的投递来源
+}
+
+// MailboxRuleDecision 邮件命中规则后的处理结果
+type MailboxRuleDecision struct {
+	Skip           bool        // 是否跳过该邮件
+	MatchedRules   []string    // 命中的规则名称
+	JobPositionIDs []uuid.UUID // assign/screen 规则合并后的岗位，为空时沿用邮箱默认岗位
+	Source         string      // tag 规则设置的投递来源，为空时沿用邮箱来源
+	Screen         bool        // 入库后是否自动发起筛选
+}
+
+// Matches 判断规则是否匹配邮件，正则无法编译时视为不匹配
+func (r *ResumeMailboxRule) Matches(email *MailboxEmail) bool {
+	if email == nil {
+		return false
+	}
+	if len(r.SenderDomains) > 0 && !matchesMailDomain(email.From, r.SenderDomains) {
+		return false
+	}
+	if r.SubjectPattern != "" {
+		re, err := regexp.Compile(r.SubjectPattern)
+		if err != nil || !re.MatchString(email.Subject) {
+			return false
+		}
+	}
+	if len(r.RecipientAliases) > 0 && !matchesRecipientAlias(email.Recipients, r.RecipientAliases) {
+		return false
+	}
+	if r.AttachmentPattern != "" {
+		re, err := regexp.Compile(r.AttachmentPattern)
+		if err != nil {
+			return false
+		}
+		matched := false
+		for _, attachment := range email.Attachments {
+			if attachment != nil && re.MatchString(attachment.Filename) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// EvaluateRules 按顺序评估邮箱规则：命中 skip 立即跳过；assign/screen 的岗位取并集；tag 以首条命中为准
+func (s *ResumeMailboxSetting) EvaluateRules(email *MailboxEmail) *MailboxRuleDecision {
+	decision := &MailboxRuleDecision{}
+	for _, rule := range s.Rules {
+		if rule == nil || !rule.Matches(email) {
+			continue
+		}
+		decision.MatchedRules = append(decision.MatchedRules, rule.Name)
+		switch rule.Action {
+		case consts.MailboxRuleActionSkip:
+			decision.Skip = true
+			return decision
+		case consts.MailboxRuleActionAssign:
+			decision.JobPositionIDs = appendUniqueUUIDs(decision.JobPositionIDs, rule.JobPositionIDs)
+		case consts.MailboxRuleActionTag:
+			if decision.Source == "" {
+				decision.Source = rule.Source
+			}
+		case consts.MailboxRuleActionScreen:
+			decision.Screen = true
+			decision.JobPositionIDs = appendUniqueUUIDs(decision.JobPositionIDs, rule.JobPositionIDs)
+		}
+	}
+	return decision
+}
+
+// matchesMailDomain 判断邮件地址的域名是否等于或属于给定域名
+func matchesMailDomain(address string, domains []string) bool {
+	at := strings.LastIndex(address, "@")
+	if at < 0 {
+		return false
+	}
+	host := strings.ToLower(address[at+1:])
+	for _, d := range domains {
+		d = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(d), "@"))
+		if d != "" && (host == d || strings.HasSuffix(host, "."+d)) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesRecipientAlias 判断收件人中是否包含给定别名，别名不含@时只比较本地部分
+func matchesRecipientAlias(recipients []string, aliases []string) bool {
+	for _, recipient := range recipients {
+		recipient = strings.ToLower(strings.TrimSpace(recipient))
+		local := recipient
+		if at := strings.LastIndex(recipient, "@"); at >= 0 {
+			local = recipient[:at]
+		}
+		for _, alias := range aliases {
+			alias = strings.ToLower(strings.TrimSpace(alias))
+			if alias == "" {
+				continue
+			}
+			if alias == recipient || (!strings.Contains(alias, "@") && alias == local) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// appendUniqueUUIDs 追加不重复的 UUID
+func appendUniqueUUIDs(dst, src []uuid.UUID) []uuid.UUID {
+	for _, id := range src {
+		exists := false
+		for _, d := range dst {
+			if d == id {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			dst = append(dst, id)
+		}
+	}
+	return dst
+}
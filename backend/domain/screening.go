@@ -15,6 +15,13 @@ type ScreeningUsecase interface {
 	CreateScreeningTask(ctx context.Context, req *CreateScreeningTaskReq) (*CreateScreeningTaskResp, error)
 	StartScreeningTask(ctx context.Context, req *StartScreeningTaskReq) (*StartScreeningTaskResp, error)
 	CancelScreeningTask(ctx context.Context, req *CancelScreeningTaskReq) (*CancelScreeningTaskResp, error)
+	ContinueScreeningTask(ctx context.Context, req *ContinueScreeningTaskReq) (*ContinueScreeningTaskResp, error)
+	GetScreeningCostReport(ctx context.Context, req *GetScreeningCostReportReq) (*GetScreeningCostReportResp, error)
+	OverrideScreeningResult(ctx context.Context, req *OverrideScreeningResultReq) (*OverrideScreeningResultResp, error)
+	ClearScreeningResultOverride(ctx context.Context, req *GetScreeningResultReq) (*OverrideScreeningResultResp, error)
+	GetScreeningCalibrationReport(ctx context.Context, req *GetScreeningCalibrationReq) (*GetScreeningCalibrationResp, error)
+	GenerateScreeningShortlist(ctx context.Context, req *GenerateScreeningShortlistReq) (*ScreeningShortlistResp, error)
+	GetScreeningShortlist(ctx context.Context, req *GetScreeningShortlistReq) (*ScreeningShortlistResp, error)
 	DeleteScreeningTask(ctx context.Context, req *DeleteScreeningTaskReq) (*DeleteScreeningTaskResp, error)
 	GetScreeningTask(ctx context.Context, req *GetScreeningTaskReq) (*GetScreeningTaskResp, error)
 	ListScreeningTasks(ctx context.Context, req *ListScreeningTasksReq) (*ListScreeningTasksResp, error)
@@ -25,12 +32,32 @@ type ScreeningUsecase interface {
 	GetResumeProgress(ctx context.Context, req *GetResumeProgressReq) (*GetResumeProgressResp, error)
 	GetNodeRuns(ctx context.Context, req *GetNodeRunsReq) (*GetNodeRunsResp, error)
 	PreviewWeights(ctx context.Context, req *PreviewWeightsReq) (*PreviewWeightsResp, error)
+	// RecommendJobPositions 为简历推荐契合的在招岗位
+	RecommendJobPositions(ctx context.Context, req *RecommendJobPositionsReq) (*RecommendJobPositionsResp, error)
+	// ProcessTaskResume 处理队列投递的单份任务简历，返回错误时消息不被确认，稍后重新投递
+	ProcessTaskResume(ctx context.Context, taskID, resumeID uuid.UUID) error
+	// RecoverRunningTasks 恢复运行中任务里心跳超时的简历并重新投递，多副本间仅有一个副本执行
+	RecoverRunningTasks(ctx context.Context) error
 	// Weight Template methods
 	CreateWeightTemplate(ctx context.Context, req *CreateWeightTemplateReq, userID uuid.UUID) (*WeightTemplateResp, error)
 	GetWeightTemplate(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*WeightTemplateResp, error)
 	ListWeightTemplates(ctx context.Context, req *ListWeightTemplatesReq, userID uuid.UUID) (*ListWeightTemplatesResp, error)
 	UpdateWeightTemplate(ctx context.Context, id uuid.UUID, req *UpdateWeightTemplateReq, userID uuid.UUID) (*WeightTemplateResp, error)
 	DeleteWeightTemplate(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
+	// Custom dimension methods
+	CreateScreeningDimension(ctx context.Context, req *CreateScreeningDimensionReq) (*CustomDimension, error)
+	ListScreeningDimensions(ctx context.Context, req *ListScreeningDimensionsReq) (*ListScreeningDimensionsResp, error)
+	UpdateScreeningDimension(ctx context.Context, req *UpdateScreeningDimensionReq) (*CustomDimension, error)
+	DeleteScreeningDimension(ctx context.Context, id uuid.UUID) error
+	// Automatic screening schedule methods
+	UpsertScreeningSchedule(ctx context.Context, req *UpsertScreeningScheduleReq) (*ScreeningSchedule, error)
+	GetScreeningSchedule(ctx context.Context, req *GetScreeningScheduleReq) (*ScreeningSchedule, error)
+	DeleteScreeningSchedule(ctx context.Context, jobPositionID uuid.UUID) error
+	// RunScreeningSchedules 为到期的自动筛选配置发起筛选任务，多副本间仅有一个副本执行
+	RunScreeningSchedules(ctx context.Context, now time.Time) error
+	// Screening export methods
+	ExportScreeningResults(ctx context.Context, req *ExportScreeningResultsReq) (*ExportScreeningResultsResp, error)
+	GetScreeningExport(ctx context.Context, exportID uuid.UUID) (*ScreeningExport, error)
 }
 
 // ScreeningRepo 筛选数据访问接口
@@ -40,16 +67,42 @@ type ScreeningRepo interface {
 	GetScreeningTask(ctx context.Context, id uuid.UUID) (*db.ScreeningTask, error)
 	ListScreeningTasks(ctx context.Context, filter *ScreeningTaskFilter) ([]*db.ScreeningTask, *db.PageInfo, error)
 	UpdateScreeningTask(ctx context.Context, id uuid.UUID, updates map[string]any) error
+	// UpdateScreeningTaskIfStatus 仅当任务处于指定状态时更新，返回是否更新成功
+	UpdateScreeningTaskIfStatus(ctx context.Context, id uuid.UUID, status consts.ScreeningTaskStatus, updates map[string]any) (bool, error)
+	// ListScreeningTaskIDsByStatus 查询指定状态的全部任务ID
+	ListScreeningTaskIDsByStatus(ctx context.Context, status consts.ScreeningTaskStatus) ([]uuid.UUID, error)
 	DeleteScreeningTask(ctx context.Context, id uuid.UUID) error
 
 	CreateScreeningTaskResume(ctx context.Context, taskResume *db.ScreeningTaskResume) (*db.ScreeningTaskResume, error)
 	GetScreeningTaskResume(ctx context.Context, taskID, resumeID uuid.UUID) (*db.ScreeningTaskResume, error)
 	ListScreeningTaskResumes(ctx context.Context, filter *ScreeningTaskResumeFilter) ([]*db.ScreeningTaskResume, *db.PageInfo, error)
 	UpdateScreeningTaskResume(ctx context.Context, taskID, resumeID uuid.UUID, updates map[string]any) error
+	// ListAllScreeningTaskResumes 查询任务下的全部简历关联（不分页）
+	ListAllScreeningTaskResumes(ctx context.Context, taskID uuid.UUID) ([]*db.ScreeningTaskResume, error)
+	// ClaimScreeningTaskResume 将待处理或处理超时（更新时间早于 staleBefore）的任务简历置为处理中，返回是否抢占成功
+	ClaimScreeningTaskResume(ctx context.Context, taskID, resumeID uuid.UUID, staleBefore time.Time) (bool, error)
+	// TouchScreeningTaskResume 刷新运行中任务简历的心跳时间
+	TouchScreeningTaskResume(ctx context.Context, taskID, resumeID uuid.UUID) error
+	// TouchPendingScreeningTaskResumes 刷新待处理任务简历的更新时间，用于记录最近一次投递
+	TouchPendingScreeningTaskResumes(ctx context.Context, taskID uuid.UUID, resumeIDs []uuid.UUID) error
+	// WithScreeningRecoveryLock 在集群内互斥地执行任务恢复，未获取到锁时返回 false
+	WithScreeningRecoveryLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error)
+	// ListScreeningTaskUsage 按任务汇总处理时间落在 [start, end) 内的简历用量
+	ListScreeningTaskUsage(ctx context.Context, start, end time.Time) ([]*ScreeningTaskUsage, error)
 
 	CreateScreeningResult(ctx context.Context, result *db.ScreeningResult) (*db.ScreeningResult, error)
 	GetScreeningResult(ctx context.Context, taskID, resumeID uuid.UUID) (*db.ScreeningResult, error)
+	// DeleteScreeningResult 物理删除任务简历的筛选结果，用于重新处理前清理
+	DeleteScreeningResult(ctx context.Context, taskID, resumeID uuid.UUID) error
+	// GetLatestScreeningResultByFingerprint 按岗位、简历内容哈希与Agent指纹查询最近一次的筛选结果，用于复用
+	GetLatestScreeningResultByFingerprint(ctx context.Context, jobHash, resumeHash, agentHash string) (*db.ScreeningResult, error)
+	// UpdateScreeningResultOverride 保存人工校准信息，override 为 nil 时清除校准
+	UpdateScreeningResultOverride(ctx context.Context, taskID, resumeID uuid.UUID, override *ScreeningResultOverride) (*db.ScreeningResult, error)
+	// ListOverriddenScreeningResults 查询被人工校准过的筛选结果
+	ListOverriddenScreeningResults(ctx context.Context, filter *ScreeningCalibrationFilter) ([]*db.ScreeningResult, error)
 	ListScreeningResults(ctx context.Context, filter *ScreeningResultFilter) ([]*db.ScreeningResult, *db.PageInfo, error)
+	// ListTaskResultsWithResume 查询任务全部筛选结果并预加载简历，按综合得分降序，用于导出报告
+	ListTaskResultsWithResume(ctx context.Context, taskID uuid.UUID) ([]*db.ScreeningResult, error)
 
 	CreateScreeningRunMetric(ctx context.Context, metric *db.ScreeningRunMetric) (*db.ScreeningRunMetric, error)
 	GetScreeningRunMetric(ctx context.Context, taskID uuid.UUID) (*db.ScreeningRunMetric, error)
@@ -76,6 +129,7 @@ type CreateScreeningTaskReq struct {
 	// 支持的维度包括: skill(技能,默认0.35), responsibility(职责,默认0.20), experience(经验,默认0.20),
 	// education(教育,默认0.15), industry(行业,默认0.07), basic(基本信息,默认0.03)
 	// 如果不提供，系统将使用默认权重配置
+	// 岗位自定义维度默认使用维度自身权重，也可按维度标识覆盖，如 {"open_source": 0.1}
 	// 示例: {"skill": 0.4, "responsibility": 0.25, "experience": 0.2, "education": 0.1, "industry": 0.05, "basic": 0.0}
 	DimensionWeights map[string]float64 `json:"dimension_weights,omitempty"`
 	// LLMConfig 用户自定义LLM配置，支持OpenAI等模型配置
@@ -86,6 +140,16 @@ type CreateScreeningTaskReq struct {
 	// 必须与当前系统的匹配服务版本一致才能执行任务
 	// 示例: "1.0.0"
 	AgentVersion string `json:"agent_version,omitempty"`
+	// TokenBudget Token预算（输入+输出），处理下一份简历预计超出时暂停任务，可选
+	TokenBudget *int64 `json:"token_budget,omitempty" validate:"omitempty,gt=0"`
+	// CostBudget 费用预算，币种与系统计费配置一致，处理下一份简历预计超出时暂停任务，可选
+	CostBudget *float64 `json:"cost_budget,omitempty" validate:"omitempty,gt=0"`
+	// DisableCache 禁用筛选结果缓存，岗位画像、简历内容未变化时也重新调用模型
+	DisableCache bool `json:"disable_cache,omitempty"`
+	// KnockoutRules 硬性淘汰条件，调用模型前校验，不满足的简历直接判定为不匹配，可选
+	KnockoutRules *ScreeningKnockoutRules `json:"knockout_rules,omitempty"`
+	// BlindMode 盲筛模式，匹配前隐去姓名、性别、年龄、联系方式等个人信息，降低偏见
+	BlindMode bool `json:"blind_mode,omitempty"`
 }
 
 // CreateScreeningTaskResp 创建筛选任务响应
@@ -124,6 +188,24 @@ type CancelScreeningTaskResp struct {
 	Message string `json:"message"`
 }
 
+// ContinueScreeningTaskReq 继续已暂停筛选任务请求
+type ContinueScreeningTaskReq struct {
+	// TaskID 要继续的筛选任务ID
+	TaskID uuid.UUID `json:"-"`
+	// TokenBudget 新的Token预算，不传则沿用原预算
+	TokenBudget *int64 `json:"token_budget,omitempty" validate:"omitempty,gt=0"`
+	// CostBudget 新的费用预算，不传则沿用原预算
+	CostBudget *float64 `json:"cost_budget,omitempty" validate:"omitempty,gt=0"`
+}
+
+// ContinueScreeningTaskResp 继续筛选任务响应
+type ContinueScreeningTaskResp struct {
+	// TaskID 已继续的筛选任务ID
+	TaskID uuid.UUID `json:"task_id"`
+	// PendingCount 重新投递的待处理简历数量
+	PendingCount int `json:"pending_count"`
+}
+
 // DeleteScreeningTaskReq 删除筛选任务请求
 type DeleteScreeningTaskReq struct {
 	// TaskID 要删除的筛选任务ID
@@ -334,6 +416,24 @@ type ScreeningTask struct {
 	ResumeFailed int `json:"resume_failed"`
 	// AgentVersion 智能匹配代理版本号，可选
 	AgentVersion string `json:"agent_version,omitempty"`
+	// TokenBudget Token预算，可选
+	TokenBudget *int64 `json:"token_budget,omitempty"`
+	// CostBudget 费用预算，可选
+	CostBudget *float64 `json:"cost_budget,omitempty"`
+	// TokensInput 累计输入Token数
+	TokensInput int64 `json:"tokens_input"`
+	// TokensOutput 累计输出Token数
+	TokensOutput int64 `json:"tokens_output"`
+	// TotalCost 累计模型调用费用
+	TotalCost float64 `json:"total_cost"`
+	// DisableCache 是否禁用筛选结果缓存
+	DisableCache bool `json:"disable_cache"`
+	// KnockoutRules 硬性淘汰条件，可选
+	KnockoutRules *ScreeningKnockoutRules `json:"knockout_rules,omitempty"`
+	// BlindMode 是否为盲筛模式
+	BlindMode bool `json:"blind_mode"`
+	// RedactionPolicy 盲筛脱敏策略，仅盲筛模式下存在
+	RedactionPolicy *RedactionPolicy `json:"redaction_policy,omitempty"`
 	// StartedAt 任务开始时间，可选
 	StartedAt *time.Time `json:"started_at,omitempty"`
 	// FinishedAt 任务完成时间，可选
@@ -378,6 +478,15 @@ func (st *ScreeningTask) From(dbTask *db.ScreeningTask) *ScreeningTask {
 	st.ResumeSucceeded = dbTask.ResumeSucceeded
 	st.ResumeFailed = dbTask.ResumeFailed
 	st.AgentVersion = dbTask.AgentVersion
+	st.TokenBudget = dbTask.TokenBudget
+	st.CostBudget = dbTask.CostBudget
+	st.TokensInput = dbTask.TokensInput
+	st.TokensOutput = dbTask.TokensOutput
+	st.TotalCost = dbTask.TotalCost
+	st.DisableCache = dbTask.DisableCache
+	st.KnockoutRules = ParseScreeningKnockoutRules(dbTask.KnockoutRules)
+	st.BlindMode = dbTask.BlindMode
+	st.RedactionPolicy = ParseRedactionPolicy(dbTask.RedactionPolicy)
 
 	// 处理时间指针类型
 	if !dbTask.StartedAt.IsZero() {
@@ -409,6 +518,12 @@ type ScreeningTaskResume struct {
 	ErrorMessage string `json:"error_message,omitempty"`
 	// ProcessedAt 处理完成时间，可选
 	ProcessedAt *time.Time `json:"processed_at,omitempty"`
+	// TokensInput 输入Token数
+	TokensInput int64 `json:"tokens_input"`
+	// TokensOutput 输出Token数
+	TokensOutput int64 `json:"tokens_output"`
+	// TotalCost 模型调用费用
+	TotalCost float64 `json:"total_cost"`
 	// CreatedAt 记录创建时间
 	CreatedAt time.Time `json:"created_at"`
 	// UpdatedAt 记录更新时间
@@ -436,6 +551,9 @@ func (str *ScreeningTaskResume) From(dbTaskResume *db.ScreeningTaskResume) *Scre
 	if !dbTaskResume.ProcessedAt.IsZero() {
 		str.ProcessedAt = &dbTaskResume.ProcessedAt
 	}
+	str.TokensInput = dbTaskResume.TokensInput
+	str.TokensOutput = dbTaskResume.TokensOutput
+	str.TotalCost = dbTaskResume.TotalCost
 
 	str.CreatedAt = dbTaskResume.CreatedAt
 	str.UpdatedAt = dbTaskResume.UpdatedAt
@@ -469,14 +587,22 @@ type ScreeningResult struct {
 	Responsibility *ResponsibilityMatchDetail `json:"responsibility_detail,omitempty"`
 	// SkillDetail 技能匹配详情，包含技术技能、工具使用等技能信息的匹配分析，可选
 	SkillDetail *SkillMatchDetail `json:"skill_detail,omitempty"`
+	// CustomDetails 岗位自定义维度匹配详情，按维度标识索引，可选
+	CustomDetails map[string]*CustomMatchDetail `json:"custom_details,omitempty"`
 	// Recommendations 推荐建议列表，可选
 	Recommendations []string `json:"recommendations,omitempty"`
+	// KnockoutReasons 未通过的硬性淘汰条件，非空表示未调用模型直接判定为不匹配
+	KnockoutReasons []string `json:"knockout_reasons,omitempty"`
 	// TraceID 追踪ID，用于调试和日志关联，可选
 	TraceID string `json:"trace_id,omitempty"`
 	// RuntimeMetadata 运行时元数据，可选
 	RuntimeMetadata map[string]any `json:"runtime_metadata,omitempty"`
 	// SubAgentVersions 子代理版本信息，可选
 	SubAgentVersions map[string]any `json:"sub_agent_versions,omitempty"`
+	// CachedFromID 复用的历史筛选结果ID，为空表示本次调用了模型
+	CachedFromID *uuid.UUID `json:"cached_from_id,omitempty"`
+	// Override 人工校准信息，未校准时为空，模型原始输出保留在上述字段中
+	Override *ScreeningResultOverride `json:"override,omitempty"`
 	// MatchedAt 匹配完成时间
 	MatchedAt time.Time `json:"matched_at"`
 	// CreatedAt 记录创建时间
@@ -514,11 +640,37 @@ func (sr *ScreeningResult) From(dbResult *db.ScreeningResult) *ScreeningResult {
 	sr.ExperienceDetail = convertToExperienceMatchDetail(dbResult.ExperienceDetail)
 	sr.EducationDetail = convertToEducationMatchDetail(dbResult.EducationDetail)
 	sr.IndustryDetail = convertToIndustryMatchDetail(dbResult.IndustryDetail)
+	sr.CustomDetails = ParseCustomMatchDetails(dbResult.CustomDetails)
 
 	sr.Recommendations = dbResult.Recommendations
+	sr.KnockoutReasons = dbResult.KnockoutReasons
 	sr.TraceID = dbResult.TraceID
 	sr.RuntimeMetadata = dbResult.RuntimeMetadata
 	sr.SubAgentVersions = dbResult.SubAgentVersions
+	sr.CachedFromID = dbResult.CachedFromID
+	if dbResult.OverriddenAt != nil {
+		override := &ScreeningResultOverride{
+			OverallScore: dbResult.HumanOverallScore,
+			Reason:       dbResult.OverrideReason,
+			OverriddenAt: *dbResult.OverriddenAt,
+		}
+		if dbResult.HumanMatchLevel != nil {
+			level := consts.MatchLevel(*dbResult.HumanMatchLevel)
+			override.MatchLevel = &level
+		}
+		if dbResult.OverriddenBy != nil {
+			override.OverriddenBy = *dbResult.OverriddenBy
+		}
+		if len(dbResult.HumanDimensionScores) > 0 {
+			override.DimensionScores = make(map[string]float64, len(dbResult.HumanDimensionScores))
+			for k, v := range dbResult.HumanDimensionScores {
+				if f, ok := v.(float64); ok {
+					override.DimensionScores[k] = f
+				}
+			}
+		}
+		sr.Override = override
+	}
 	sr.MatchedAt = dbResult.MatchedAt
 	sr.CreatedAt = dbResult.CreatedAt
 	sr.UpdatedAt = dbResult.UpdatedAt
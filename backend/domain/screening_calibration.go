@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+)
+
+// ScreeningCalibrationOverall 校准报表中代表综合得分的维度名
+const ScreeningCalibrationOverall = "overall"
+
+// ScreeningDimensionAgents 匹配维度与负责该维度的子Agent节点的对应关系
+var ScreeningDimensionAgents = map[string]string{
+	"skill":                     SkillAgent,
+	"responsibility":            ResponsibilityAgent,
+	"experience":                ExperienceAgent,
+	"education":                 EducationAgent,
+	"industry":                  IndustryAgent,
+	"basic":                     BasicInfoAgent,
+	ScreeningCalibrationOverall: AggregatorAgent,
+}
+
+// ScreeningDimensionAgent 返回负责该维度的子Agent节点，内置维度之外的维度视为岗位自定义维度
+func ScreeningDimensionAgent(dimension string) string {
+	if agent, ok := ScreeningDimensionAgents[dimension]; ok {
+		return agent
+	}
+	return CustomDimensionNodeKey(dimension)
+}
+
+// ScreeningResultOverride 招聘人员对筛选结果的人工校准，与模型原始输出分开保存
+type ScreeningResultOverride struct {
+	// OverallScore 校准后的综合得分，未校准时为空
+	OverallScore *float64 `json:"overall_score,omitempty"`
+	// MatchLevel 校准后的匹配等级，未校准时为空
+	MatchLevel *consts.MatchLevel `json:"match_level,omitempty"`
+	// DimensionScores 校准后的各维度得分，仅包含被修改的维度
+	DimensionScores map[string]float64 `json:"dimension_scores,omitempty"`
+	// Reason 校准原因
+	Reason string `json:"reason"`
+	// OverriddenBy 校准人ID
+	OverriddenBy uuid.UUID `json:"overridden_by"`
+	// OverriddenAt 校准时间
+	OverriddenAt time.Time `json:"overridden_at"`
+}
+
+// OverrideScreeningResultReq 人工校准筛选结果请求
+type OverrideScreeningResultReq struct {
+	// TaskID 筛选任务ID，来自路径参数
+	TaskID uuid.UUID `json:"-"`
+	// ResumeID 简历ID，来自路径参数
+	ResumeID uuid.UUID `json:"-"`
+	// UserID 校准人ID，来自登录用户
+	UserID uuid.UUID `json:"-"`
+	// OverallScore 校准后的综合得分 (0-100)，可选
+	OverallScore *float64 `json:"overall_score,omitempty" validate:"omitempty,gte=0,lte=100"`
+	// MatchLevel 校准后的匹配等级：excellent/good/fair/poor/no_match，可选
+	MatchLevel *consts.MatchLevel `json:"match_level,omitempty" validate:"omitempty,oneof=excellent good fair poor no_match"`
+	// DimensionScores 校准后的维度得分 (0-100)，键为 skill/responsibility/experience/education/industry/basic 或岗位自定义维度标识，
+	// 只能校准该筛选结果实际产出的维度，可选
+	DimensionScores map[string]float64 `json:"dimension_scores,omitempty" validate:"omitempty,dive,gte=0,lte=100"`
+	// Reason 校准原因
+	Reason string `json:"reason" validate:"required,max=1000"`
+}
+
+// OverrideScreeningResultResp 人工校准筛选结果响应
+type OverrideScreeningResultResp struct {
+	// Result 校准后的筛选结果，包含模型原始输出与校准信息
+	Result *ScreeningResult `json:"result"`
+}
+
+// GetScreeningCalibrationReq 校准报表请求
+type GetScreeningCalibrationReq struct {
+	// JobPositionID 岗位ID过滤条件，可选
+	JobPositionID *uuid.UUID `json:"job_position_id,omitempty" query:"job_position_id"`
+	// StartDate 校准时间起始日期，格式 YYYY-MM-DD，可选
+	StartDate string `json:"start_date,omitempty" query:"start_date"`
+	// EndDate 校准时间截止日期（含），格式 YYYY-MM-DD，可选
+	EndDate string `json:"end_date,omitempty" query:"end_date"`
+}
+
+// ScreeningCalibrationFilter 校准报表查询条件
+type ScreeningCalibrationFilter struct {
+	JobPositionID *uuid.UUID
+	Start         *time.Time
+	End           *time.Time
+}
+
+// ScreeningCalibrationItem 单个维度、单个子Agent版本的校准统计
+type ScreeningCalibrationItem struct {
+	// Dimension 维度：skill/responsibility/experience/education/industry/basic/overall
+	Dimension string `json:"dimension"`
+	// Agent 负责该维度的子Agent
+	Agent string `json:"agent"`
+	// AgentVersion 子Agent版本
+	AgentVersion string `json:"agent_version"`
+	// Count 人工修改了得分的结果数
+	Count int `json:"count"`
+	// MeanDelta 平均偏差（人工 - 模型），为正表示模型打分偏低
+	MeanDelta float64 `json:"mean_delta"`
+	// MeanAbsDelta 平均绝对偏差
+	MeanAbsDelta float64 `json:"mean_abs_delta"`
+	// RaisedCount 人工调高得分的次数
+	RaisedCount int `json:"raised_count"`
+	// LoweredCount 人工调低得分的次数
+	LoweredCount int `json:"lowered_count"`
+	// LevelChangedCount 人工修改匹配等级的次数，仅 overall 维度统计
+	LevelChangedCount int `json:"level_changed_count,omitempty"`
+}
+
+// GetScreeningCalibrationResp 校准报表响应
+type GetScreeningCalibrationResp struct {
+	// TotalOverrides 统计范围内被人工校准的结果数
+	TotalOverrides int `json:"total_overrides"`
+	// Items 按维度、子Agent版本分组的统计，按平均绝对偏差降序
+	Items []*ScreeningCalibrationItem `json:"items"`
+}
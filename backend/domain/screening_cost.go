@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// ScreeningCostGroupBy 费用报表分组维度
+type ScreeningCostGroupBy string
+
+const (
+	ScreeningCostGroupByDepartment ScreeningCostGroupBy = "department" // 按部门
+	ScreeningCostGroupByUser       ScreeningCostGroupBy = "user"       // 按任务创建人
+	ScreeningCostGroupByJob        ScreeningCostGroupBy = "job"        // 按岗位（招聘需求）
+)
+
+// ScreeningTaskUsage 单个筛选任务在统计区间内的用量汇总
+type ScreeningTaskUsage struct {
+	TaskID         uuid.UUID
+	JobPositionID  uuid.UUID
+	JobName        string
+	DepartmentID   uuid.UUID
+	DepartmentName string
+	CreatedBy      uuid.UUID
+	CreatorName    string
+	ResumeCount    int
+	TokensInput    int64
+	TokensOutput   int64
+	TotalCost      float64
+}
+
+// GetScreeningCostReportReq 月度筛选费用报表请求
+type GetScreeningCostReportReq struct {
+	// Month 统计月份，格式 YYYY-MM，默认当前月
+	Month string `json:"month" query:"month"`
+	// GroupBy 分组维度：department/user/job，默认 department
+	GroupBy ScreeningCostGroupBy `json:"group_by" query:"group_by" validate:"omitempty,oneof=department user job"`
+}
+
+// ScreeningCostReportItem 费用报表分组条目
+type ScreeningCostReportItem struct {
+	// GroupID 分组ID（部门/用户/岗位ID）
+	GroupID uuid.UUID `json:"group_id"`
+	// GroupName 分组名称
+	GroupName string `json:"group_name"`
+	// TaskCount 涉及的筛选任务数
+	TaskCount int `json:"task_count"`
+	// ResumeCount 已处理的简历数
+	ResumeCount int `json:"resume_count"`
+	// TokensInput 输入Token数
+	TokensInput int64 `json:"tokens_input"`
+	// TokensOutput 输出Token数
+	TokensOutput int64 `json:"tokens_output"`
+	// TotalCost 模型调用费用
+	TotalCost float64 `json:"total_cost"`
+}
+
+// GetScreeningCostReportResp 月度筛选费用报表响应
+type GetScreeningCostReportResp struct {
+	// Month 统计月份
+	Month string `json:"month"`
+	// GroupBy 分组维度
+	GroupBy ScreeningCostGroupBy `json:"group_by"`
+	// Currency 计费币种
+	Currency string `json:"currency"`
+	// Items 分组明细，按费用降序
+	Items []*ScreeningCostReportItem `json:"items"`
+	// Total 全部分组合计
+	Total *ScreeningCostReportItem `json:"total"`
+}
@@ -0,0 +1,264 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/db"
+)
+
+const (
+	// CustomDimensionAgentPrefix 自定义维度节点键前缀，完整节点键为前缀加维度标识
+	CustomDimensionAgentPrefix = "CustomDimensionAgent:"
+	// MaxCustomDimensionsPerJob 单个岗位最多可定义的自定义维度数量
+	MaxCustomDimensionsPerJob = 5
+)
+
+var customDimensionKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,49}$`)
+
+// builtinDimensionKeys 内置维度标识，自定义维度不能与之重名；overall 为校准报表中的综合得分维度
+var builtinDimensionKeys = map[string]struct{}{
+	"skill":                     {},
+	"responsibility":            {},
+	"experience":                {},
+	"education":                 {},
+	"industry":                  {},
+	"basic":                     {},
+	ScreeningCalibrationOverall: {},
+}
+
+// CustomDimensionNodeKey 返回自定义维度在筛选图中的节点键
+func CustomDimensionNodeKey(key string) string {
+	return CustomDimensionAgentPrefix + key
+}
+
+// CustomDimensionKeyFromNode 从节点键解析自定义维度标识，非自定义维度节点返回 false
+func CustomDimensionKeyFromNode(nodeKey string) (string, bool) {
+	if !strings.HasPrefix(nodeKey, CustomDimensionAgentPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(nodeKey, CustomDimensionAgentPrefix), true
+}
+
+// ValidateCustomDimensionKey 校验维度标识：小写字母开头，仅含小写字母、数字和下划线，且不与内置维度重名
+func ValidateCustomDimensionKey(key string) error {
+	if !customDimensionKeyPattern.MatchString(key) {
+		return fmt.Errorf("维度标识须以小写字母开头，仅包含小写字母、数字和下划线，长度 2-50")
+	}
+	if _, ok := builtinDimensionKeys[key]; ok {
+		return fmt.Errorf("维度标识 %s 与内置维度重名", key)
+	}
+	return nil
+}
+
+// CustomDimension 岗位自定义匹配维度，例如开源贡献、管理经验等
+type CustomDimension struct {
+	// ID 维度ID
+	ID uuid.UUID `json:"id"`
+	// JobPositionID 所属岗位ID
+	JobPositionID uuid.UUID `json:"job_position_id"`
+	// Key 维度标识，同一岗位内唯一，用于权重配置与结果索引
+	Key string `json:"key"`
+	// Name 维度名称
+	Name string `json:"name"`
+	// Description 维度说明
+	Description string `json:"description,omitempty"`
+	// Prompt 维度评估提示词，描述该维度关注什么、如何打分
+	Prompt string `json:"prompt"`
+	// Weight 维度权重，与内置维度权重一起归一化计算综合得分
+	Weight float64 `json:"weight"`
+	// CreatedBy 创建者ID
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	// CreatedAt 创建时间
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt 更新时间
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// From 方法用于从 db 实体转换
+func (d *CustomDimension) From(entity *db.ScreeningDimension) *CustomDimension {
+	if entity == nil {
+		return nil
+	}
+	d.ID = entity.ID
+	d.JobPositionID = entity.JobPositionID
+	d.Key = entity.Key
+	d.Name = entity.Name
+	d.Description = entity.Description
+	d.Prompt = entity.Prompt
+	d.Weight = entity.Weight
+	d.CreatedBy = entity.CreatedBy
+	d.CreatedAt = entity.CreatedAt
+	d.UpdatedAt = entity.UpdatedAt
+	return d
+}
+
+// Signature 返回维度定义摘要，不含权重。定义变化时 Agent 版本随之变化，历史结果不再被复用
+func (d *CustomDimension) Signature() string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%s\x00%s", d.Key, d.Name, d.Description, d.Prompt)
+	return hex.EncodeToString(h.Sum(nil))[:8]
+}
+
+// CustomDimensionsToSnapshot 将自定义维度序列化为任务快照
+func CustomDimensionsToSnapshot(dims []*CustomDimension) []map[string]any {
+	if len(dims) == 0 {
+		return nil
+	}
+	snapshot := make([]map[string]any, 0, len(dims))
+	for _, dim := range dims {
+		data, err := json.Marshal(dim)
+		if err != nil {
+			continue
+		}
+		var m map[string]any
+		if err := json.Unmarshal(data, &m); err != nil {
+			continue
+		}
+		snapshot = append(snapshot, m)
+	}
+	return snapshot
+}
+
+// ParseCustomDimensions 从任务快照解析自定义维度
+func ParseCustomDimensions(snapshot []map[string]any) []*CustomDimension {
+	if len(snapshot) == 0 {
+		return nil
+	}
+	dims := make([]*CustomDimension, 0, len(snapshot))
+	for _, m := range snapshot {
+		data, err := json.Marshal(m)
+		if err != nil {
+			continue
+		}
+		dim := &CustomDimension{}
+		if err := json.Unmarshal(data, dim); err != nil || dim.Key == "" {
+			continue
+		}
+		dims = append(dims, dim)
+	}
+	return dims
+}
+
+// CustomDimensionData 自定义维度Agent数据
+type CustomDimensionData struct {
+	Dimension  *CustomDimension  `json:"dimension"`
+	JobProfile *JobProfileDetail `json:"job_profile"`
+	Resume     *ResumeDetail     `json:"resume"`
+	BlindMode  bool              `json:"blind_mode"` // 盲筛模式，不向模型提供个人身份信息
+}
+
+// CustomMatchDetail 自定义维度匹配详情
+// @Description 候选人在岗位自定义维度上的匹配分析结果
+type CustomMatchDetail struct {
+	// Key 维度标识
+	Key string `json:"key" example:"open_source"`
+	// Name 维度名称
+	Name string `json:"name" example:"开源贡献"`
+	// Score 维度得分 (0-100)
+	Score float64 `json:"score" example:"78.0"`
+	// Evidence 支撑得分的简历证据
+	Evidence []string `json:"evidence,omitempty"`
+	// Gaps 与维度要求的差距
+	Gaps []string `json:"gaps,omitempty"`
+	// Analysis 综合分析说明
+	Analysis string `json:"analysis,omitempty"`
+}
+
+// CustomMatchDetailsToMap 将自定义维度匹配详情序列化为数据库 JSON 对象
+func CustomMatchDetailsToMap(details map[string]*CustomMatchDetail) map[string]any {
+	if len(details) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(details)
+	if err != nil {
+		return nil
+	}
+	var m map[string]any
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil
+	}
+	return m
+}
+
+// ParseCustomMatchDetails 从数据库存储的 JSON 对象解析自定义维度匹配详情
+func ParseCustomMatchDetails(m map[string]any) map[string]*CustomMatchDetail {
+	if len(m) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil
+	}
+	details := make(map[string]*CustomMatchDetail)
+	if err := json.Unmarshal(data, &details); err != nil {
+		return nil
+	}
+	return details
+}
+
+// CreateScreeningDimensionReq 创建自定义维度请求
+type CreateScreeningDimensionReq struct {
+	// JobPositionID 所属岗位ID
+	JobPositionID uuid.UUID `json:"job_position_id" validate:"required"`
+	// Key 维度标识，小写字母开头，仅含小写字母、数字和下划线，不可与内置维度重名，创建后不可修改
+	Key string `json:"key" validate:"required,max=50" example:"open_source"`
+	// Name 维度名称
+	Name string `json:"name" validate:"required,max=100" example:"开源贡献"`
+	// Description 维度说明，可选
+	Description string `json:"description,omitempty" validate:"max=500"`
+	// Prompt 维度评估提示词，说明该维度关注什么、如何打分
+	Prompt string `json:"prompt" validate:"required,max=4000" example:"评估候选人参与开源项目的深度与影响力，包括维护的项目、提交的PR、社区角色等"`
+	// Weight 维度权重 (0-1)，与内置维度权重一起归一化
+	Weight float64 `json:"weight" validate:"gte=0,lte=1" example:"0.1"`
+	// CreatedBy 创建者ID，从登录态获取
+	CreatedBy uuid.UUID `json:"-"`
+}
+
+// UpdateScreeningDimensionReq 更新自定义维度请求，维度标识不可修改
+type UpdateScreeningDimensionReq struct {
+	// ID 维度ID，来自路径参数
+	ID uuid.UUID `json:"-"`
+	// Name 维度名称
+	Name string `json:"name" validate:"required,max=100"`
+	// Description 维度说明，可选
+	Description string `json:"description,omitempty" validate:"max=500"`
+	// Prompt 维度评估提示词
+	Prompt string `json:"prompt" validate:"required,max=4000"`
+	// Weight 维度权重 (0-1)
+	Weight float64 `json:"weight" validate:"gte=0,lte=1"`
+}
+
+// ListScreeningDimensionsReq 查询岗位自定义维度请求
+type ListScreeningDimensionsReq struct {
+	// JobPositionID 岗位ID
+	JobPositionID uuid.UUID `json:"job_position_id" query:"job_position_id" validate:"required"`
+}
+
+// ListScreeningDimensionsResp 查询岗位自定义维度响应
+type ListScreeningDimensionsResp struct {
+	// Items 自定义维度列表
+	Items []*CustomDimension `json:"items"`
+}
+
+// ScreeningDimensionRepo 自定义维度数据访问接口
+type ScreeningDimensionRepo interface {
+	// Create 创建维度
+	Create(ctx context.Context, dim *db.ScreeningDimension) (*db.ScreeningDimension, error)
+	// GetByID 根据 ID 获取维度
+	GetByID(ctx context.Context, id uuid.UUID) (*db.ScreeningDimension, error)
+	// ListByJobPosition 查询岗位下的全部维度，按创建时间升序
+	ListByJobPosition(ctx context.Context, jobPositionID uuid.UUID) ([]*db.ScreeningDimension, error)
+	// Update 更新维度
+	Update(ctx context.Context, id uuid.UUID, updates map[string]any) (*db.ScreeningDimension, error)
+	// Delete 删除维度（软删除）
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+)
+
+// ExportScreeningResultsReq 导出筛选结果请求
+type ExportScreeningResultsReq struct {
+	// TaskID 筛选任务ID，来自路径参数
+	TaskID uuid.UUID `json:"-"`
+	// Format 导出格式：xlsx/csv 为排名表，pdf 为逐个候选人的匹配报告
+	Format consts.ScreeningExportFormat `json:"format" validate:"required,oneof=xlsx csv pdf" example:"xlsx"`
+	// UserID 发起导出的用户ID，从登录态获取
+	UserID uuid.UUID `json:"-"`
+}
+
+// ExportScreeningResultsResp 导出筛选结果响应。
+// 结果数不超过异步导出阈值时直接返回文件内容；否则返回异步导出记录，通过导出ID查询下载链接
+type ExportScreeningResultsResp struct {
+	// Export 异步导出记录，同步导出时为空
+	Export *ScreeningExport `json:"export,omitempty"`
+	// File 同步导出的文件，由处理器以附件形式返回
+	File *ScreeningExportFile `json:"-"`
+}
+
+// ScreeningExportFile 导出文件
+type ScreeningExportFile struct {
+	// Filename 文件名
+	Filename string
+	// ContentType MIME 类型
+	ContentType string
+	// Content 文件内容
+	Content []byte
+}
+
+// ScreeningExport 筛选结果异步导出记录，保存在 Redis 中，24 小时后过期
+type ScreeningExport struct {
+	// ID 导出ID
+	ID uuid.UUID `json:"id"`
+	// TaskID 筛选任务ID
+	TaskID uuid.UUID `json:"task_id"`
+	// Format 导出格式
+	Format consts.ScreeningExportFormat `json:"format"`
+	// Status 导出状态：pending/running/completed/failed
+	Status consts.ScreeningExportStatus `json:"status"`
+	// Filename 导出文件名
+	Filename string `json:"filename"`
+	// ResultCount 导出的筛选结果数
+	ResultCount int `json:"result_count"`
+	// ObjectKey 导出文件在对象存储中的键
+	ObjectKey string `json:"object_key,omitempty" swaggerignore:"true"`
+	// DownloadURL 下载链接，导出完成后查询时生成，1 小时内有效
+	DownloadURL string `json:"download_url,omitempty"`
+	// ErrorMessage 失败原因
+	ErrorMessage string `json:"error_message,omitempty"`
+	// CreatedBy 发起导出的用户ID
+	CreatedBy uuid.UUID `json:"created_by"`
+	// CreatedAt 创建时间
+	CreatedAt time.Time `json:"created_at"`
+	// CompletedAt 完成时间
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// ScreeningReport 筛选结果报告数据，供各导出格式渲染
+type ScreeningReport struct {
+	// TaskID 筛选任务ID
+	TaskID uuid.UUID
+	// JobName 岗位名称
+	JobName string
+	// Dimensions 报告中展示的维度，依次为内置维度与岗位自定义维度
+	Dimensions []*ScreeningReportDimension
+	// SkillNames 岗位技能ID到技能名称的映射，用于展示匹配与缺失技能
+	SkillNames map[string]string
+	// Responsibilities 岗位职责ID到职责描述的映射
+	Responsibilities map[string]string
+	// Candidates 按综合得分降序排列的候选人
+	Candidates []*ScreeningReportCandidate
+	// GeneratedAt 报告生成时间
+	GeneratedAt time.Time
+}
+
+// ScreeningReportDimension 报告维度
+type ScreeningReportDimension struct {
+	// Key 维度标识
+	Key string
+	// Name 维度展示名称
+	Name string
+}
+
+// ScreeningReportCandidate 报告中的候选人
+type ScreeningReportCandidate struct {
+	// Rank 排名，从 1 开始
+	Rank int
+	// Name 候选人姓名，盲筛任务中为匿名编号
+	Name string
+	// OverallScore 综合得分，人工校准过时为校准后的得分
+	OverallScore float64
+	// MatchLevel 匹配等级，人工校准过时为校准后的等级
+	MatchLevel consts.MatchLevel
+	// DimensionScores 各维度得分，包含自定义维度，人工校准过的维度为校准后的得分
+	DimensionScores map[string]float64
+	// Result 筛选结果详情
+	Result *ScreeningResult
+}
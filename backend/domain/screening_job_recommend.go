@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// 岗位推荐数量限制，模型精排对每个岗位调用技能、职责两个匹配 Agent
+const (
+	DefaultJobRecommendTopK    = 10
+	MaxJobRecommendTopK        = 20
+	DefaultJobRecommendLLMTopK = 5
+	MaxJobRecommendLLMTopK     = 10
+)
+
+// RecommendJobPositionsReq 为简历推荐在招岗位请求
+type RecommendJobPositionsReq struct {
+	// ResumeID 简历ID，来自路径参数
+	ResumeID uuid.UUID `json:"-"`
+	// TopK 返回的岗位数量，默认 10，最大 20
+	TopK int `json:"top_k,omitempty" validate:"omitempty,min=1,max=20"`
+	// UseLLM 是否使用匹配 Agent 对粗排靠前的岗位精排
+	UseLLM bool `json:"use_llm,omitempty"`
+	// LLMTopK 参与模型精排的岗位数量，默认 5，最大 10，不超过 TopK
+	LLMTopK int `json:"llm_top_k,omitempty" validate:"omitempty,min=1,max=10"`
+	// IncludeApplied 是否包含简历已投递的岗位，默认排除
+	IncludeApplied bool `json:"include_applied,omitempty"`
+	// LLMConfig 用户自定义LLM配置，不提供时使用系统默认配置
+	LLMConfig map[string]any `json:"llm_config,omitempty"`
+}
+
+// RecommendJobPositionsResp 岗位推荐响应
+type RecommendJobPositionsResp struct {
+	// ResumeID 简历ID
+	ResumeID uuid.UUID `json:"resume_id"`
+	// TotalPositions 参与粗排的在招岗位数量
+	TotalPositions int `json:"total_positions"`
+	// Items 按契合度排序的推荐岗位
+	Items []*JobRecommendation `json:"items"`
+	// AgentVersion 模型精排使用的匹配 Agent 版本，未精排时为空
+	AgentVersion string `json:"agent_version,omitempty"`
+	// TokenUsage 模型精排的 token 用量，未精排时为空
+	TokenUsage map[string]int64 `json:"token_usage,omitempty"`
+}
+
+// JobRecommendation 推荐岗位
+type JobRecommendation struct {
+	// Rank 推荐位次，从 1 开始
+	Rank int `json:"rank"`
+	// JobPosition 岗位基本信息
+	JobPosition *JobProfile `json:"job_position"`
+	// Score 最终契合度 (0-100)，经模型精排的岗位为粗排分与模型分的加权
+	Score float64 `json:"score"`
+	// PrefilterScore 结构化粗排得分 (0-100)
+	PrefilterScore float64 `json:"prefilter_score"`
+	// Prefilter 粗排各维度得分，岗位未设置对应要求或简历缺少对应信息时为空，不参与加权
+	Prefilter *JobPrefilterBreakdown `json:"prefilter"`
+	// LLMScore 模型精排得分 (0-100)，未精排或精排失败时为空
+	LLMScore *float64 `json:"llm_score,omitempty"`
+	// SkillScore 技能匹配 Agent 得分
+	SkillScore *float64 `json:"skill_score,omitempty"`
+	// ResponsibilityScore 职责匹配 Agent 得分
+	ResponsibilityScore *float64 `json:"responsibility_score,omitempty"`
+	// MatchedSkills 简历命中的岗位技能
+	MatchedSkills []string `json:"matched_skills"`
+	// MissingSkills 简历缺少的岗位必需技能
+	MissingSkills []string `json:"missing_skills"`
+	// Reasons 推荐理由
+	Reasons []string `json:"reasons"`
+	// LLMError 模型精排失败原因，失败时按粗排得分排序
+	LLMError string `json:"llm_error,omitempty"`
+}
+
+// JobPrefilterBreakdown 结构化粗排各维度得分 (0-100)
+type JobPrefilterBreakdown struct {
+	Skill          *float64 `json:"skill,omitempty"`
+	Responsibility *float64 `json:"responsibility,omitempty"`
+	Experience     *float64 `json:"experience,omitempty"`
+	Education      *float64 `json:"education,omitempty"`
+	Location       *float64 `json:"location,omitempty"`
+}
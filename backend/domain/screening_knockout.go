@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"encoding/json"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+)
+
+// ScreeningKnockoutRules 硬性淘汰条件，在调用匹配模型前对简历做确定性校验，
+// 不满足任一条件的简历直接判定为不匹配，不消耗 Token。简历缺少对应信息时不淘汰，交由模型判断
+type ScreeningKnockoutRules struct {
+	// MinDegree 最低学历：junior_college/bachelor/master/doctor，unlimited 或为空表示不限
+	MinDegree consts.JobEducationType `json:"min_degree,omitempty" validate:"omitempty,oneof=unlimited junior_college bachelor master doctor"`
+	// MinYearsExperience 最低工作年限
+	MinYearsExperience *float64 `json:"min_years_experience,omitempty" validate:"omitempty,gte=0"`
+	// Locations 可接受的城市，候选人当前城市或期望城市命中任一即可
+	Locations []string `json:"locations,omitempty"`
+	// RequiredCertificates 必须具备的证书或资质，需全部在简历中出现
+	RequiredCertificates []string `json:"required_certificates,omitempty"`
+}
+
+// IsEmpty 是否未配置任何条件
+func (r *ScreeningKnockoutRules) IsEmpty() bool {
+	if r == nil {
+		return true
+	}
+	return (r.MinDegree == "" || r.MinDegree == consts.JobEducationTypeUnlimited) &&
+		r.MinYearsExperience == nil &&
+		len(r.Locations) == 0 &&
+		len(r.RequiredCertificates) == 0
+}
+
+// ToMap 转换为数据库存储的 JSON 对象
+func (r *ScreeningKnockoutRules) ToMap() map[string]any {
+	if r.IsEmpty() {
+		return nil
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil
+	}
+	var m map[string]any
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil
+	}
+	return m
+}
+
+// ParseScreeningKnockoutRules 从数据库存储的 JSON 对象解析淘汰条件，未配置时返回 nil
+func ParseScreeningKnockoutRules(m map[string]any) *ScreeningKnockoutRules {
+	if len(m) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil
+	}
+	rules := &ScreeningKnockoutRules{}
+	if err := json.Unmarshal(data, rules); err != nil {
+		return nil
+	}
+	if rules.IsEmpty() {
+		return nil
+	}
+	return rules
+}
@@ -28,6 +28,8 @@ type ScreeningNodeRunRepo interface {
 	List(ctx context.Context, req *ListNodeRunsRepoReq) ([]*db.ScreeningNodeRun, *db.PageInfo, error)
 	GetByTaskResumeID(ctx context.Context, taskResumeID uuid.UUID) ([]*db.ScreeningNodeRun, error)
 	GetByTaskResumeAndNode(ctx context.Context, taskResumeID uuid.UUID, nodeKey string, attemptNo int) (*db.ScreeningNodeRun, error)
+	// GetLatestCompletedByCacheKey 按复用键查询最近一次成功且有输出的节点运行记录
+	GetLatestCompletedByCacheKey(ctx context.Context, cacheKey string) (*db.ScreeningNodeRun, error)
 }
 
 // ScreeningNodeRun 筛选节点运行信息
@@ -83,6 +85,8 @@ type ScreeningNodeRun struct {
 	FinishedAt *time.Time `json:"finished_at,omitempty"`
 	// DurationMs 执行耗时(毫秒)
 	DurationMs *int `json:"duration_ms,omitempty"`
+	// ReusedFromID 复用的历史节点运行ID，为空表示本次调用了模型
+	ReusedFromID *uuid.UUID `json:"reused_from_id,omitempty"`
 	// CreatedAt 创建时间
 	CreatedAt time.Time `json:"created_at"`
 	// UpdatedAt 更新时间
@@ -117,6 +121,8 @@ type CreateNodeRunRepoReq struct {
 	LLMParams     map[string]interface{}
 	InputPayload  map[string]interface{}
 	OutputPayload map[string]interface{}
+	CacheKey      string
+	ReusedFromID  *uuid.UUID
 }
 
 // UpdateNodeRunReq 更新节点运行请求
@@ -252,6 +258,7 @@ func (s *ScreeningNodeRun) From(e *db.ScreeningNodeRun) *ScreeningNodeRun {
 	if e.DurationMs != 0 {
 		s.DurationMs = &e.DurationMs
 	}
+	s.ReusedFromID = e.ReusedFromID
 
 	// 处理时间字段的指针转换
 	if !e.StartedAt.IsZero() {
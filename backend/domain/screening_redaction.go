@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// RedactionPolicyVersion 当前盲筛脱敏策略版本，字段范围变化时递增，便于合规审计追溯
+const RedactionPolicyVersion = "v2"
+
+// 可脱敏的简历字段
+const (
+	RedactFieldName          = "name"
+	RedactFieldGender        = "gender"
+	RedactFieldBirthday      = "birthday"
+	RedactFieldAge           = "age"
+	RedactFieldEmail         = "email"
+	RedactFieldPhone         = "phone"
+	RedactFieldResumeFileURL = "resume_file_url"
+	RedactFieldLogs          = "logs"
+	// 自由文本字段，候选人常在其中自述年龄、性别、籍贯等信息
+	RedactFieldPersonalSummary    = "personal_summary"
+	RedactFieldOtherInfo          = "other_info"
+	RedactFieldHonorsCertificates = "honors_certificates"
+)
+
+// redactedName 脱敏后的姓名占位
+const redactedName = "候选人"
+
+// redactedText 自由文本中被移除内容的占位
+const redactedText = "[已脱敏]"
+
+// 自由文本中的联系方式
+var (
+	emailPattern = regexp.MustCompile(`[\w.+-]+@[\w-]+(\.[\w-]+)+`)
+	phonePattern = regexp.MustCompile(`(\+?86[- ]?)?1[3-9]\d{9}`)
+)
+
+// RedactionPolicy 盲筛脱敏策略，随任务保存用于合规审计
+type RedactionPolicy struct {
+	// Version 策略版本
+	Version string `json:"version"`
+	// Fields 匹配前从简历中移除的字段
+	Fields []string `json:"fields"`
+}
+
+// DefaultRedactionPolicy 默认盲筛策略：移除姓名、性别、出生日期、年龄、联系方式、可能包含照片的原始简历文件，
+// 以及个人总结、其他信息等无法可靠脱敏的自由文本；荣誉证书对评估有价值，仅移除其中的姓名与联系方式
+func DefaultRedactionPolicy() *RedactionPolicy {
+	return &RedactionPolicy{
+		Version: RedactionPolicyVersion,
+		Fields: []string{
+			RedactFieldName,
+			RedactFieldGender,
+			RedactFieldBirthday,
+			RedactFieldAge,
+			RedactFieldEmail,
+			RedactFieldPhone,
+			RedactFieldResumeFileURL,
+			RedactFieldLogs,
+			RedactFieldPersonalSummary,
+			RedactFieldOtherInfo,
+			RedactFieldHonorsCertificates,
+		},
+	}
+}
+
+// ToMap 转换为数据库存储的 JSON 对象
+func (p *RedactionPolicy) ToMap() map[string]any {
+	if p == nil {
+		return nil
+	}
+	fields := make([]any, 0, len(p.Fields))
+	for _, f := range p.Fields {
+		fields = append(fields, f)
+	}
+	return map[string]any{
+		"version": p.Version,
+		"fields":  fields,
+	}
+}
+
+// ParseRedactionPolicy 从数据库存储的 JSON 对象解析脱敏策略，未开启盲筛时返回 nil
+func ParseRedactionPolicy(m map[string]any) *RedactionPolicy {
+	if len(m) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil
+	}
+	policy := &RedactionPolicy{}
+	if err := json.Unmarshal(data, policy); err != nil {
+		return nil
+	}
+	return policy
+}
+
+// RedactResume 按策略返回脱敏后的简历副本，不修改原简历。
+// 教育、工作、项目等经历原样保留，仅替换基本信息结构体与需移除的关联数据
+func (p *RedactionPolicy) RedactResume(detail *ResumeDetail) *ResumeDetail {
+	if p == nil || detail == nil || detail.Resume == nil {
+		return detail
+	}
+
+	resume := *detail.Resume
+	redacted := *detail
+	redacted.Resume = &resume
+
+	// 按原始值脱敏自由文本，避免姓名已被替换后无法识别
+	name := detail.Name
+	for _, field := range p.Fields {
+		switch field {
+		case RedactFieldName:
+			resume.Name = redactedName
+			resume.UploaderName = ""
+		case RedactFieldGender:
+			resume.Gender = ""
+		case RedactFieldBirthday:
+			resume.Birthday = nil
+		case RedactFieldAge:
+			resume.Age = nil
+		case RedactFieldEmail:
+			resume.Email = ""
+		case RedactFieldPhone:
+			resume.Phone = ""
+		case RedactFieldResumeFileURL:
+			resume.ResumeFileURL = ""
+			// 合并简历的历史文件同样可能包含照片与联系方式
+			redacted.FileHistory = nil
+		case RedactFieldLogs:
+			redacted.Logs = nil
+		case RedactFieldPersonalSummary:
+			resume.PersonalSummary = ""
+		case RedactFieldOtherInfo:
+			resume.OtherInfo = ""
+		case RedactFieldHonorsCertificates:
+			resume.HonorsCertificates = scrubIdentity(resume.HonorsCertificates, name)
+		}
+	}
+	return &redacted
+}
+
+// scrubIdentity 移除自由文本中的候选人姓名、邮箱与手机号
+func scrubIdentity(text, name string) string {
+	if text == "" {
+		return text
+	}
+	if name = strings.TrimSpace(name); name != "" {
+		text = strings.ReplaceAll(text, name, redactedText)
+	}
+	text = emailPattern.ReplaceAllString(text, redactedText)
+	return phonePattern.ReplaceAllString(text, redactedText)
+}
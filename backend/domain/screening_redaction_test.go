@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func redactionTestResume() *ResumeDetail {
+	age := 30
+	birthday := time.Date(1995, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &ResumeDetail{
+		Resume: &Resume{
+			ID:                 "resume-1",
+			UploaderName:       "李HR",
+			Name:               "张三",
+			Gender:             "男",
+			Birthday:           &birthday,
+			Age:                &age,
+			Email:              "zhangsan@example.com",
+			Phone:              "13800138000",
+			PersonalSummary:    "张三，30岁男性，湖南人，已婚",
+			OtherInfo:          "爱好：篮球；政治面貌：党员",
+			HonorsCertificates: "PMP 证书（持证人：张三）；联系 zhangsan@example.com 或 +86 13800138000 核验",
+			ResumeFileURL:      "https://files.example.com/resume.pdf",
+			HighestEducation:   "本科",
+		},
+		Experiences: []*ResumeExperience{{Position: "后端工程师"}},
+		Logs:        []*ResumeLog{{Action: "upload"}},
+		FileHistory: []*ResumeFileHistory{{ResumeID: "resume-0", ResumeFileURL: "https://files.example.com/old.pdf", Source: "email"}},
+	}
+}
+
+func TestRedactResumeDefaultPolicyCoverage(t *testing.T) {
+	resume := redactionTestResume()
+	redacted := DefaultRedactionPolicy().RedactResume(resume)
+	require.NotSame(t, resume.Resume, redacted.Resume)
+
+	assert.Equal(t, redactedName, redacted.Name)
+	assert.Empty(t, redacted.UploaderName)
+	assert.Empty(t, redacted.Gender)
+	assert.Nil(t, redacted.Birthday)
+	assert.Nil(t, redacted.Age)
+	assert.Empty(t, redacted.Email)
+	assert.Empty(t, redacted.Phone)
+	assert.Empty(t, redacted.ResumeFileURL)
+	assert.Nil(t, redacted.Logs)
+	assert.Nil(t, redacted.FileHistory)
+	assert.Empty(t, redacted.PersonalSummary)
+	assert.Empty(t, redacted.OtherInfo)
+	assert.Equal(t, "PMP 证书（持证人：[已脱敏]）；联系 [已脱敏] 或 [已脱敏] 核验", redacted.HonorsCertificates)
+
+	// 评估所需的经历与学历原样保留
+	assert.Equal(t, "本科", redacted.HighestEducation)
+	assert.Equal(t, resume.Experiences, redacted.Experiences)
+
+	// 原简历不应被修改
+	assert.Equal(t, redactionTestResume(), resume)
+}
+
+func TestRedactResumeHonorsPolicyFields(t *testing.T) {
+	resume := redactionTestResume()
+	policy := &RedactionPolicy{Version: "v1", Fields: []string{RedactFieldName, RedactFieldPhone}}
+
+	redacted := policy.RedactResume(resume)
+	assert.Equal(t, redactedName, redacted.Name)
+	assert.Empty(t, redacted.Phone)
+	// 按任务创建时保存的策略脱敏，策略外的字段保持不变
+	assert.Equal(t, resume.Email, redacted.Email)
+	assert.Equal(t, resume.PersonalSummary, redacted.PersonalSummary)
+	assert.Equal(t, resume.HonorsCertificates, redacted.HonorsCertificates)
+
+	var nilPolicy *RedactionPolicy
+	assert.Same(t, resume, nilPolicy.RedactResume(resume))
+}
+
+func TestRedactionPolicyRoundTrip(t *testing.T) {
+	policy := DefaultRedactionPolicy()
+	parsed := ParseRedactionPolicy(policy.ToMap())
+	assert.Equal(t, policy, parsed)
+	assert.Nil(t, ParseRedactionPolicy(nil))
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db"
+)
+
+// ScreeningSchedule 岗位自动筛选配置。
+// instant 模式下新投递的简历解析完成后随即发起筛选；digest 模式下每日定时汇总筛选一次并发送筛选完成通知
+type ScreeningSchedule struct {
+	// ID 配置ID
+	ID uuid.UUID `json:"id"`
+	// JobPositionID 岗位ID
+	JobPositionID uuid.UUID `json:"job_position_id"`
+	// Mode 自动筛选模式：instant/digest
+	Mode consts.ScreeningScheduleMode `json:"mode"`
+	// WeightTemplateID 岗位默认权重模板ID，为空时使用系统默认权重
+	WeightTemplateID *uuid.UUID `json:"weight_template_id,omitempty"`
+	// LLMConfig 自动发起任务使用的LLM配置，为空时使用系统默认配置
+	LLMConfig map[string]any `json:"llm_config,omitempty"`
+	// KnockoutRules 自动发起任务使用的硬性淘汰条件
+	KnockoutRules *ScreeningKnockoutRules `json:"knockout_rules,omitempty"`
+	// BlindMode 自动发起的任务是否为盲筛模式
+	BlindMode bool `json:"blind_mode"`
+	// DigestHour 每日汇总筛选的执行时刻（0-23 时），仅 digest 模式生效
+	DigestHour int `json:"digest_hour"`
+	// Enabled 是否启用
+	Enabled bool `json:"enabled"`
+	// CreatedBy 创建者ID，自动发起的筛选任务归属该用户
+	CreatedBy uuid.UUID `json:"created_by"`
+	// LastRunAt 最近一次执行时间
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	// CreatedAt 创建时间，仅筛选该时间之后的投递
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt 更新时间
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// From 方法用于从 db 实体转换
+func (s *ScreeningSchedule) From(entity *db.ScreeningSchedule) *ScreeningSchedule {
+	if entity == nil {
+		return nil
+	}
+	s.ID = entity.ID
+	s.JobPositionID = entity.JobPositionID
+	s.Mode = entity.Mode
+	s.WeightTemplateID = entity.WeightTemplateID
+	s.LLMConfig = entity.LlmConfig
+	s.KnockoutRules = ParseScreeningKnockoutRules(entity.KnockoutRules)
+	s.BlindMode = entity.BlindMode
+	s.DigestHour = entity.DigestHour
+	s.Enabled = entity.Enabled
+	s.CreatedBy = entity.CreatedBy
+	s.LastRunAt = entity.LastRunAt
+	s.CreatedAt = entity.CreatedAt
+	s.UpdatedAt = entity.UpdatedAt
+	return s
+}
+
+// IsDue 判断配置在 now 时刻是否需要执行：instant 模式每次巡检都执行；
+// digest 模式在当天执行时刻之后、且当天尚未执行过时执行一次
+func (s *ScreeningSchedule) IsDue(now time.Time) bool {
+	if !s.Enabled {
+		return false
+	}
+	if s.Mode != consts.ScreeningScheduleModeDigest {
+		return true
+	}
+	slot := time.Date(now.Year(), now.Month(), now.Day(), s.DigestHour, 0, 0, 0, now.Location())
+	if now.Before(slot) {
+		return false
+	}
+	return s.LastRunAt == nil || s.LastRunAt.Before(slot)
+}
+
+// UpsertScreeningScheduleReq 创建或更新岗位自动筛选配置请求
+type UpsertScreeningScheduleReq struct {
+	// JobPositionID 岗位ID
+	JobPositionID uuid.UUID `json:"job_position_id" validate:"required"`
+	// Mode 自动筛选模式：instant（解析完成后随即筛选）/digest（每日定时汇总筛选）
+	Mode consts.ScreeningScheduleMode `json:"mode" validate:"required,oneof=instant digest" example:"digest"`
+	// WeightTemplateID 岗位默认权重模板ID，可选，为空时使用系统默认权重
+	WeightTemplateID *uuid.UUID `json:"weight_template_id,omitempty"`
+	// LLMConfig 自动发起任务使用的LLM配置，可选，与手动创建任务的 llm_config 一致
+	LLMConfig map[string]any `json:"llm_config,omitempty"`
+	// KnockoutRules 自动发起任务使用的硬性淘汰条件，可选
+	KnockoutRules *ScreeningKnockoutRules `json:"knockout_rules,omitempty"`
+	// BlindMode 自动发起的任务是否为盲筛模式
+	BlindMode bool `json:"blind_mode,omitempty"`
+	// DigestHour 每日汇总筛选的执行时刻（0-23 时），仅 digest 模式生效
+	DigestHour int `json:"digest_hour" validate:"gte=0,lte=23" example:"2"`
+	// Enabled 是否启用
+	Enabled bool `json:"enabled" example:"true"`
+	// CreatedBy 创建者ID，从登录态获取
+	CreatedBy uuid.UUID `json:"-"`
+}
+
+// GetScreeningScheduleReq 查询岗位自动筛选配置请求
+type GetScreeningScheduleReq struct {
+	// JobPositionID 岗位ID
+	JobPositionID uuid.UUID `json:"job_position_id" query:"job_position_id" validate:"required"`
+}
+
+// ScreeningScheduleRepo 岗位自动筛选配置数据访问接口
+type ScreeningScheduleRepo interface {
+	// Upsert 按岗位创建或更新配置
+	Upsert(ctx context.Context, schedule *db.ScreeningSchedule) (*db.ScreeningSchedule, error)
+	// GetByJobPosition 查询岗位的配置
+	GetByJobPosition(ctx context.Context, jobPositionID uuid.UUID) (*db.ScreeningSchedule, error)
+	// ListEnabled 查询全部启用的配置
+	ListEnabled(ctx context.Context) ([]*db.ScreeningSchedule, error)
+	// UpdateLastRunAt 记录最近一次执行时间
+	UpdateLastRunAt(ctx context.Context, id uuid.UUID, runAt time.Time) error
+	// DeleteByJobPosition 删除岗位的配置
+	DeleteByJobPosition(ctx context.Context, jobPositionID uuid.UUID) error
+	// ListUnscreenedResumeIDs 查询岗位在 since 之后投递、已解析完成且尚未加入该岗位任何筛选任务的简历
+	ListUnscreenedResumeIDs(ctx context.Context, jobPositionID uuid.UUID, since time.Time) ([]uuid.UUID, error)
+	// WithScheduleLock 在集群内互斥地执行自动筛选巡检，未获取到锁时返回 false
+	WithScheduleLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error)
+}
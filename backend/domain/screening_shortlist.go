@@ -0,0 +1,162 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+)
+
+// ShortlistMethodRoundRobin 候选人两两循环比较
+const ShortlistMethodRoundRobin = "round_robin"
+
+// 短名单候选人数量限制，循环比较次数为 n*(n-1)/2
+const (
+	DefaultShortlistTopN = 5
+	MaxShortlistTopN     = 10
+)
+
+// 两两比较的候选人标签
+const (
+	PairwiseCandidateA = "A"
+	PairwiseCandidateB = "B"
+)
+
+// ShortlistCandidate 参与两两比较的候选人，仅使用匹配结果，不包含候选人个人信息
+type ShortlistCandidate struct {
+	// ResumeID 简历ID
+	ResumeID uuid.UUID `json:"resume_id"`
+	// Result 该候选人的筛选结果
+	Result *ScreeningResult `json:"result"`
+}
+
+// PairwiseCompareInput 两两比较Agent输入
+type PairwiseCompareInput struct {
+	JobProfile *JobProfileDetail   `json:"job_profile"`
+	CandidateA *ShortlistCandidate `json:"candidate_a"`
+	CandidateB *ShortlistCandidate `json:"candidate_b"`
+}
+
+// PairwiseCompareResult 两两比较Agent输出
+type PairwiseCompareResult struct {
+	// Winner 更匹配岗位的候选人：A 或 B
+	Winner string `json:"winner"`
+	// Confidence 判断置信度 (0-1)
+	Confidence float64 `json:"confidence"`
+	// Reason 判断理由
+	Reason string `json:"reason"`
+}
+
+// ShortlistComparison 一次两两比较的记录
+type ShortlistComparison struct {
+	// WinnerID 胜出的简历ID
+	WinnerID uuid.UUID `json:"winner_id"`
+	// LoserID 落败的简历ID
+	LoserID uuid.UUID `json:"loser_id"`
+	// Confidence 判断置信度
+	Confidence float64 `json:"confidence"`
+	// Reason 判断理由
+	Reason string `json:"reason"`
+	// Fallback 模型比较失败时按原始得分判定
+	Fallback bool `json:"fallback,omitempty"`
+}
+
+// ScreeningShortlistItem 短名单中的一个位次
+type ScreeningShortlistItem struct {
+	// Position 短名单位次，从 1 开始
+	Position int `json:"position"`
+	// ResumeID 简历ID
+	ResumeID uuid.UUID `json:"resume_id"`
+	// OriginalRanking 按综合得分的原始排名
+	OriginalRanking int `json:"original_ranking"`
+	// OverallScore 综合得分
+	OverallScore float64 `json:"overall_score"`
+	// MatchLevel 匹配等级
+	MatchLevel consts.MatchLevel `json:"match_level"`
+	// Wins 两两比较胜场数
+	Wins int `json:"wins"`
+	// Losses 两两比较负场数
+	Losses int `json:"losses"`
+	// Justification 位次说明
+	Justification string `json:"justification"`
+}
+
+// ScreeningShortlist 基于两两比较生成的短名单排名，独立于按得分的任务排名保存
+type ScreeningShortlist struct {
+	// Method 排名方式
+	Method string `json:"method"`
+	// TopN 参与比较的候选人数量
+	TopN int `json:"top_n"`
+	// AgentVersion 两两比较Agent版本
+	AgentVersion string `json:"agent_version"`
+	// Items 重新排序后的短名单
+	Items []*ScreeningShortlistItem `json:"items"`
+	// Comparisons 全部两两比较记录
+	Comparisons []*ShortlistComparison `json:"comparisons,omitempty"`
+	// TokenUsage Token使用情况
+	TokenUsage map[string]int64 `json:"token_usage,omitempty"`
+	// GeneratedBy 生成人用户ID
+	GeneratedBy uuid.UUID `json:"generated_by"`
+	// GeneratedAt 生成时间
+	GeneratedAt time.Time `json:"generated_at"`
+}
+
+// ToMap 转换为数据库存储的 JSON 对象
+func (s *ScreeningShortlist) ToMap() map[string]any {
+	if s == nil {
+		return nil
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil
+	}
+	var m map[string]any
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil
+	}
+	return m
+}
+
+// ParseScreeningShortlist 从数据库存储的 JSON 对象解析短名单，未生成时返回 nil
+func ParseScreeningShortlist(m map[string]any) *ScreeningShortlist {
+	if len(m) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil
+	}
+	shortlist := &ScreeningShortlist{}
+	if err := json.Unmarshal(data, shortlist); err != nil {
+		return nil
+	}
+	return shortlist
+}
+
+// GenerateScreeningShortlistReq 生成短名单请求
+type GenerateScreeningShortlistReq struct {
+	// TaskID 筛选任务ID，来自路径参数
+	TaskID uuid.UUID `json:"-"`
+	// UserID 操作人ID，来自登录用户
+	UserID uuid.UUID `json:"-"`
+	// TopN 参与两两比较的候选人数量，默认 5，最大 10
+	TopN int `json:"top_n,omitempty" validate:"omitempty,min=2,max=10"`
+	// LLMConfig 用户自定义LLM配置，不提供时使用任务配置
+	LLMConfig map[string]any `json:"llm_config,omitempty"`
+}
+
+// GetScreeningShortlistReq 查询短名单请求
+type GetScreeningShortlistReq struct {
+	// TaskID 筛选任务ID
+	TaskID uuid.UUID `json:"-"`
+}
+
+// ScreeningShortlistResp 短名单响应
+type ScreeningShortlistResp struct {
+	// TaskID 筛选任务ID
+	TaskID uuid.UUID `json:"task_id"`
+	// Shortlist 短名单，未生成时为空
+	Shortlist *ScreeningShortlist `json:"shortlist,omitempty"`
+}
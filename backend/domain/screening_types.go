@@ -16,29 +16,34 @@ const (
 	EducationAgent      = "EducationAgent"
 	IndustryAgent       = "IndustryAgent"
 	AggregatorAgent     = "AggregatorAgent"
+	ReusedResultsNode   = "ReusedResultsNode"
 )
 
 // MatchInput 匹配输入结构
 type MatchInput struct {
-	JobProfile       *JobProfileDetail `json:"job_profile"`
-	Resume           *ResumeDetail     `json:"resume"`
-	DimensionWeights *DimensionWeights `json:"weights"`
-	MatchTaskID      string            `json:"match_task_id"`
+	JobProfile       *JobProfileDetail  `json:"job_profile"`
+	Resume           *ResumeDetail      `json:"resume"`
+	DimensionWeights *DimensionWeights  `json:"weights"`
+	MatchTaskID      string             `json:"match_task_id"`
+	BlindMode        bool               `json:"blind_mode"`                  // 盲筛模式，简历已脱敏
+	CustomDimensions []*CustomDimension `json:"custom_dimensions,omitempty"` // 岗位自定义匹配维度
+	ReusedResults    map[string]any     `json:"-"`                           // 复用的历史维度结果，按节点键索引，对应节点不再调用模型
 }
 
 // JobResumeMatch 工作简历匹配结果
 // @Description 候选人简历与岗位要求的综合匹配分析结果，包含各维度匹配详情和最终综合评分
 type JobResumeMatch struct {
-	TaskMetaData        *TaskMetaData              `json:"task_meta_data"`
-	OverallScore        float64                    `json:"overall_score"`        // 综合匹配度 (0-100)
-	SkillMatch          *SkillMatchDetail          `json:"skill_match"`          // 技能匹配详情
-	ResponsibilityMatch *ResponsibilityMatchDetail `json:"responsibility_match"` // 职责匹配详情
-	ExperienceMatch     *ExperienceMatchDetail     `json:"experience_match"`     // 经验匹配详情
-	EducationMatch      *EducationMatchDetail      `json:"education_match"`      // 教育匹配详情
-	IndustryMatch       *IndustryMatchDetail       `json:"industry_match"`       // 行业匹配详情
-	BasicMatch          *BasicMatchDetail          `json:"basic_match"`          // 基本信息匹配
-	MatchedAt           time.Time                  `json:"matched_at"`           // 匹配时间
-	Recommendations     []string                   `json:"recommendations"`      // 匹配建议
+	TaskMetaData        *TaskMetaData                 `json:"task_meta_data"`
+	OverallScore        float64                       `json:"overall_score"`            // 综合匹配度 (0-100)
+	SkillMatch          *SkillMatchDetail             `json:"skill_match"`              // 技能匹配详情
+	ResponsibilityMatch *ResponsibilityMatchDetail    `json:"responsibility_match"`     // 职责匹配详情
+	ExperienceMatch     *ExperienceMatchDetail        `json:"experience_match"`         // 经验匹配详情
+	EducationMatch      *EducationMatchDetail         `json:"education_match"`          // 教育匹配详情
+	IndustryMatch       *IndustryMatchDetail          `json:"industry_match"`           // 行业匹配详情
+	BasicMatch          *BasicMatchDetail             `json:"basic_match"`              // 基本信息匹配
+	CustomMatches       map[string]*CustomMatchDetail `json:"custom_matches,omitempty"` // 自定义维度匹配详情，按维度标识索引
+	MatchedAt           time.Time                     `json:"matched_at"`               // 匹配时间
+	Recommendations     []string                      `json:"recommendations"`          // 匹配建议
 }
 
 // BasicMatchDetail 基本信息匹配详情
@@ -363,6 +368,8 @@ type DimensionWeights struct {
 	Industry float64 `json:"industry"`
 	// Basic 基本信息权重，默认3%
 	Basic float64 `json:"basic" `
+	// Custom 岗位自定义维度权重，按维度标识索引，存在时综合得分按全部权重归一化
+	Custom map[string]float64 `json:"custom,omitempty"`
 }
 
 // DefaultDimensionWeights 默认维度权重
@@ -420,6 +427,7 @@ type WeightInferenceResult struct {
 type BasicInfoData struct {
 	JobProfile *JobProfileDetail `json:"job_profile"`
 	Resume     *ResumeDetail     `json:"resume"`
+	BlindMode  bool              `json:"blind_mode"` // 盲筛模式，不向模型提供个人身份信息
 }
 
 // SkillData 技能Agent数据
@@ -65,5 +65,7 @@ func (JobPosition) Edges() []ent.Edge {
 		edge.To("resume_applications", ResumeJobApplication.Type),
 		edge.To("screening_tasks", ScreeningTask.Type),
 		edge.To("screening_results", ScreeningResult.Type),
+		edge.To("screening_dimensions", ScreeningDimension.Type),
+		edge.To("screening_schedule", ScreeningSchedule.Type).Unique(),
 	}
 }
@@ -56,6 +56,10 @@ func (NotificationEvent) Fields() []ent.Field {
 		field.Int("timeout").Default(300).Comment("超时时间(秒)"),
 		field.Text("last_error").Optional(),
 		field.String("trace_id").Optional(),
+		field.UUID("setting_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("投递的通知设置ID，为空时按渠道广播"),
 		field.Time("scheduled_at").Optional().Comment("计划投递时间"),
 		field.Time("delivered_at").Optional().Comment("实际投递时间"),
 		field.Time("created_at").Default(time.Now).Immutable(),
@@ -75,6 +79,7 @@ func (NotificationEvent) Indexes() []ent.Index {
 		index.Fields("channel"),
 		index.Fields("status"),
 		index.Fields("trace_id"),
+		index.Fields("setting_id"),
 		index.Fields("scheduled_at"),
 		index.Fields("created_at"),
 		// 复合索引用于查询待处理的事件
@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/ent/types"
 	"github.com/chaitin/WhaleHire/backend/pkg/entx"
 )
 
@@ -49,6 +50,15 @@ func (NotificationSetting) Fields() []ent.Field {
 		field.JSON("dingtalk_config", map[string]interface{}{}).
 			Optional().
 			Comment("钉钉通知配置"),
+		field.JSON("email_config", map[string]interface{}{}).
+			Optional().
+			Comment("邮件通知配置"),
+		field.JSON("webhook_config", map[string]interface{}{}).
+			Optional().
+			Comment("Webhook通知配置"),
+		field.JSON("routing_rules", []*types.NotificationRoutingRule{}).
+			Optional().
+			Comment("路由规则，为空时接收所有事件"),
 		field.Int("max_retry").
 			Default(3).
 			Comment("最大重试次数"),
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 
 	"github.com/chaitin/WhaleHire/backend/consts"
@@ -54,7 +55,14 @@ func (Resume) Fields() []ent.Field {
 		field.Text("honors_certificates").Optional(),                                     // 荣誉与资格证书
 		field.Text("other_info").Optional(),                                              // 其它信息
 		field.String("resume_file_url").Optional(),
-		field.String("status").Default("pending"), // pending, processing, completed, failed, archived
+		field.String("source").Optional(),                               // 简历来源：email、manual，或邮箱规则标记的渠道
+		field.String("file_hash").Optional().MaxLen(64),                 // 简历文件内容SHA-256，用于识别重复上传
+		field.String("normalized_phone").Optional(),                     // 归一化手机号，用于重复检测
+		field.String("normalized_email").Optional(),                     // 归一化邮箱，用于重复检测
+		field.String("normalized_name").Optional(),                      // 归一化姓名，用于重复检测
+		field.UUID("merged_into_id", uuid.UUID{}).Optional().Nillable(), // 合并后的主简历ID，非空表示该简历已合并，仅作为文件历史保留
+		field.Time("merged_at").Optional().Nillable(),                   // 合并时间
+		field.String("status").Default("pending"),                       // pending, processing, completed, failed, archived
 		field.String("error_message").Optional(),
 		field.Time("parsed_at").Optional(),
 		field.Time("created_at").Default(time.Now),
@@ -75,5 +83,19 @@ func (Resume) Edges() []ent.Edge {
 		edge.To("job_applications", ResumeJobApplication.Type),
 		edge.To("screening_task_resumes", ScreeningTaskResume.Type),
 		edge.To("screening_results", ScreeningResult.Type),
+		edge.To("duplicate_flags", ResumeDuplicate.Type),
+		edge.To("duplicated_by", ResumeDuplicate.Type),
+		edge.To("chunks", ResumeChunk.Type),
+	}
+}
+
+// Indexes of the Resume.
+func (Resume) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("file_hash"),
+		index.Fields("normalized_phone"),
+		index.Fields("normalized_email"),
+		index.Fields("normalized_name"),
+		index.Fields("merged_into_id"),
 	}
 }
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+)
+
+// ResumeChunk holds the schema definition for the ResumeChunk entity.
+type ResumeChunk struct {
+	ent.Schema
+}
+
+func (ResumeChunk) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "resume_chunks",
+		},
+	}
+}
+
+// Fields of the ResumeChunk.
+func (ResumeChunk) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("resume_id", uuid.UUID{}),
+		field.String("chunk_type").GoType(consts.ResumeChunkType("")), // summary, experience, project
+		field.UUID("source_id", uuid.UUID{}).Optional().Nillable(),    // 对应的工作经历或项目ID，个人简介为空
+		field.Int("chunk_index").Default(0),                           // 同一简历内的分块顺序
+		field.Text("content"),                                         // 用于向量化与片段展示的分块文本
+		field.Other("vector", &pgvector.Vector{}).Optional().SchemaType(map[string]string{
+			"postgres": "vector",
+		}).Comment("向量嵌入（需要在迁移中执行 CREATE EXTENSION IF NOT EXISTS vector;）"),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ResumeChunk.
+func (ResumeChunk) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("resume", Resume.Type).Ref("chunks").Field("resume_id").Unique().Required(),
+	}
+}
+
+// Indexes of the ResumeChunk.
+func (ResumeChunk) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resume_id"),
+		// 向量相似度索引 (IVFFLAT)，迁移脚本中需额外指定 WITH (lists = 100)
+		index.Fields("vector").Annotations(
+			entsql.IndexType("ivfflat"),
+			entsql.OpClass("vector_cosine_ops"),
+		),
+	}
+}
@@ -37,14 +37,15 @@ func (ResumeDocumentParse) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New),
 		field.UUID("resume_id", uuid.UUID{}),
-		field.String("file_id").Optional(),        // docparser返回的文件ID
-		field.Text("content").Optional(),          // docparser解析的原始文本内容
-		field.String("file_type").Optional(),      // docparser识别的文件类型
-		field.String("filename").Optional(),       // docparser返回的文件名
-		field.String("title").Optional(),          // docparser解析的文档标题
-		field.Time("upload_at").Optional(),        // docparser上传时间
-		field.String("status").Default("pending"), // pending, success, failed
-		field.String("error_message").Optional(),  // 解析错误信息
+		field.String("file_id").Optional(),                  // docparser返回的文件ID
+		field.Text("content").Optional(),                    // docparser解析的原始文本内容
+		field.String("file_type").Optional(),                // docparser识别的文件类型
+		field.String("filename").Optional(),                 // docparser返回的文件名
+		field.String("title").Optional(),                    // docparser解析的文档标题
+		field.Time("upload_at").Optional(),                  // docparser上传时间
+		field.String("status").Default("pending"),           // pending, success, failed
+		field.String("error_message").Optional(),            // 解析错误信息
+		field.Float("ocr_confidence").Optional().Nillable(), // OCR识别平均置信度（0~1），未经过OCR时为空
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+)
+
+// ResumeDuplicate holds the schema definition for the ResumeDuplicate entity.
+type ResumeDuplicate struct {
+	ent.Schema
+}
+
+func (ResumeDuplicate) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "resume_duplicates",
+		},
+	}
+}
+
+// Fields of the ResumeDuplicate.
+func (ResumeDuplicate) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("resume_id", uuid.UUID{}).Comment("触发检测的简历ID"),
+		field.UUID("duplicate_resume_id", uuid.UUID{}).Comment("疑似重复的已有简历ID"),
+		field.Float("score").Comment("重复相似度 0-1"),
+		field.JSON("reasons", []string{}).Optional().Comment("判定依据：file_hash/phone/email/name/education/experience"),
+		field.String("status").GoType(consts.ResumeDuplicateStatus("")).Default(string(consts.ResumeDuplicateStatusPending)).Comment("处理状态：pending/merged/dismissed"),
+		field.UUID("reviewed_by", uuid.UUID{}).Optional().Nillable().Comment("处理人ID"),
+		field.Time("reviewed_at").Optional().Nillable().Comment("处理时间"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("创建时间"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("更新时间"),
+	}
+}
+
+// Edges of the ResumeDuplicate.
+func (ResumeDuplicate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("resume", Resume.Type).
+			Ref("duplicate_flags").
+			Field("resume_id").
+			Unique().
+			Required(),
+		edge.From("duplicate_resume", Resume.Type).
+			Ref("duplicated_by").
+			Field("duplicate_resume_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ResumeDuplicate.
+func (ResumeDuplicate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resume_id", "duplicate_resume_id").Unique(),
+		index.Fields("duplicate_resume_id"),
+		index.Fields("status"),
+	}
+}
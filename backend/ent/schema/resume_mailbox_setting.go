@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 
+	"github.com/chaitin/WhaleHire/backend/ent/types"
 	"github.com/chaitin/WhaleHire/backend/pkg/entx"
 )
 
@@ -48,6 +49,9 @@ func (ResumeMailboxSetting) Fields() []ent.Field {
 		field.JSON("encrypted_credential", map[string]interface{}{}).Comment("加密后的凭证信息"),
 		field.UUID("uploader_id", uuid.UUID{}).Comment("上传人用户ID"),
 		field.JSON("job_profile_ids", []uuid.UUID{}).Optional().Comment("岗位画像ID列表"),
+		field.String("extraction_mode").MaxLen(32).Default("attachments").Comment("简历提取方式：attachments/attachments_body/attachments_links"),
+		field.JSON("link_allowed_domains", []string{}).Optional().Comment("允许跟随下载简历链接的域名白名单"),
+		field.JSON("rules", []*types.ResumeMailboxRule{}).Optional().Comment("邮件过滤规则，按顺序匹配"),
 		field.Int("sync_interval_minutes").Optional().Nillable().Comment("自定义同步频率(分钟)，为空则使用平台默认"),
 		field.String("status").Default("enabled").Comment("状态"),
 		field.Time("last_synced_at").Optional().Nillable().Comment("最后同步时间"),
@@ -42,6 +42,8 @@ func (ResumeMailboxStatistic) Fields() []ent.Field {
 		field.Int("synced_emails").Default(0).Comment("当日成功同步邮件数"),
 		field.Int("parsed_resumes").Default(0).Comment("成功解析入库的简历附件数量"),
 		field.Int("failed_resumes").Default(0).Comment("解析失败数量"),
+		field.Int("body_resumes").Default(0).Comment("从邮件正文提取入库的简历数量"),
+		field.Int("link_resumes").Default(0).Comment("从邮件链接下载入库的简历数量"),
 		field.Int("skipped_attachments").Default(0).Comment("被去重或过滤的附件数量"),
 		field.Int("last_sync_duration_ms").Default(0).Comment("最近一次同步耗时(毫秒)"),
 		field.Time("created_at").Default(time.Now).Immutable(),
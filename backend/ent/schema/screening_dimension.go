@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/pkg/entx"
+)
+
+// ScreeningDimension holds the schema definition for the ScreeningDimension entity.
+type ScreeningDimension struct {
+	ent.Schema
+}
+
+func (ScreeningDimension) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "screening_dimensions",
+		},
+	}
+}
+
+func (ScreeningDimension) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		entx.SoftDeleteMixin{},
+	}
+}
+
+// Fields of the ScreeningDimension.
+func (ScreeningDimension) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("job_position_id", uuid.UUID{}).Comment("所属岗位ID"),
+		field.String("key").MaxLen(50).Comment("维度标识，同一岗位内唯一"),
+		field.String("name").MaxLen(100).Comment("维度名称"),
+		field.String("description").MaxLen(500).Optional().Comment("维度说明"),
+		field.Text("prompt").Comment("维度评估提示词"),
+		field.Float("weight").Default(0).Comment("维度权重"),
+		field.UUID("created_by", uuid.UUID{}).Optional().Nillable().Comment("创建者ID"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("创建时间"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("更新时间"),
+	}
+}
+
+// Edges of the ScreeningDimension.
+func (ScreeningDimension) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("job_position", JobPosition.Type).
+			Ref("screening_dimensions").
+			Field("job_position_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ScreeningDimension.
+func (ScreeningDimension) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("job_position_id"),
+	}
+}
@@ -57,6 +57,8 @@ func (ScreeningNodeRun) Fields() []ent.Field {
 		field.Time("started_at").Optional().Comment("开始时间"),
 		field.Time("finished_at").Optional().Comment("结束时间"),
 		field.Int("duration_ms").Optional().Comment("耗时(毫秒)"),
+		field.String("cache_key").Optional().MaxLen(64).Comment("维度结果复用键，由节点输入内容、Agent版本与模型计算"),
+		field.UUID("reused_from_id", uuid.UUID{}).Optional().Nillable().Comment("复用的历史节点运行ID"),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -89,5 +91,6 @@ func (ScreeningNodeRun) Indexes() []ent.Index {
 		index.Fields("task_resume_id", "node_key", "attempt_no").Unique(),
 		index.Fields("task_id", "node_key"),
 		index.Fields("node_key", "status"),
+		index.Fields("cache_key"),
 	}
 }
@@ -51,15 +51,33 @@ func (ScreeningResult) Fields() []ent.Field {
 		).Optional().Comment("匹配等级"),
 		field.JSON("dimension_scores", map[string]interface{}{}).Optional().Comment("各维度分数"),
 		field.JSON("skill_detail", map[string]interface{}{}).Optional().Comment("技能匹配详情"),
+		field.JSON("custom_details", map[string]interface{}{}).Optional().Comment("自定义维度匹配详情，按维度标识索引"),
 		field.JSON("responsibility_detail", map[string]interface{}{}).Optional().Comment("职责匹配详情"),
 		field.JSON("experience_detail", map[string]interface{}{}).Optional().Comment("经验匹配详情"),
 		field.JSON("education_detail", map[string]interface{}{}).Optional().Comment("教育匹配详情"),
 		field.JSON("industry_detail", map[string]interface{}{}).Optional().Comment("行业匹配详情"),
 		field.JSON("basic_detail", map[string]interface{}{}).Optional().Comment("基本信息匹配详情"),
 		field.JSON("recommendations", []string{}).Optional().Comment("匹配建议"),
+		field.JSON("knockout_reasons", []string{}).Optional().Comment("未通过的硬性淘汰条件，非空表示未调用模型直接判定为不匹配"),
 		field.String("trace_id").Optional().MaxLen(100).Comment("链路追踪ID"),
 		field.JSON("runtime_metadata", map[string]interface{}{}).Optional().Comment("运行时元数据"),
 		field.JSON("sub_agent_versions", map[string]interface{}{}).Optional().Comment("各Agent版本快照"),
+		field.String("job_hash").Optional().MaxLen(64).Comment("岗位画像内容哈希"),
+		field.String("resume_hash").Optional().MaxLen(64).Comment("简历内容哈希"),
+		field.String("agent_hash").Optional().MaxLen(64).Comment("子Agent版本与模型指纹"),
+		field.UUID("cached_from_id", uuid.UUID{}).Optional().Nillable().Comment("复用的历史筛选结果ID"),
+		field.Float("human_overall_score").Optional().Nillable().Comment("人工校准的综合得分"),
+		field.Enum("human_match_level").Values(
+			string(consts.MatchLevelExcellent),
+			string(consts.MatchLevelGood),
+			string(consts.MatchLevelFair),
+			string(consts.MatchLevelPoor),
+			string(consts.MatchLevelNoMatch),
+		).Optional().Nillable().Comment("人工校准的匹配等级"),
+		field.JSON("human_dimension_scores", map[string]interface{}{}).Optional().Comment("人工校准的各维度得分"),
+		field.Text("override_reason").Optional().Comment("人工校准原因"),
+		field.UUID("overridden_by", uuid.UUID{}).Optional().Nillable().Comment("校准人ID"),
+		field.Time("overridden_at").Optional().Nillable().Comment("校准时间"),
 		field.Time("matched_at").Default(time.Now).Comment("匹配时间"),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
@@ -96,5 +114,7 @@ func (ScreeningResult) Indexes() []ent.Index {
 		index.Fields("task_id", "resume_id").Unique(),
 		index.Fields("match_level"),
 		index.Fields("matched_at"),
+		index.Fields("job_hash", "resume_hash", "agent_hash"),
+		index.Fields("overridden_at"),
 	}
 }
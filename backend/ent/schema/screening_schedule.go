@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+)
+
+// ScreeningSchedule holds the schema definition for the ScreeningSchedule entity.
+type ScreeningSchedule struct {
+	ent.Schema
+}
+
+func (ScreeningSchedule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "screening_schedules",
+		},
+	}
+}
+
+// Fields of the ScreeningSchedule.
+func (ScreeningSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("job_position_id", uuid.UUID{}).Comment("岗位ID，每个岗位一条配置"),
+		field.String("mode").GoType(consts.ScreeningScheduleMode("")).Default(string(consts.ScreeningScheduleModeInstant)).Comment("自动筛选模式：instant/digest"),
+		field.UUID("weight_template_id", uuid.UUID{}).Optional().Nillable().Comment("岗位默认权重模板ID，为空时使用系统默认权重"),
+		field.JSON("llm_config", map[string]interface{}{}).Optional().Comment("自动发起任务使用的LLM配置，为空时使用系统默认配置"),
+		field.JSON("knockout_rules", map[string]interface{}{}).Optional().Comment("自动发起任务使用的硬性淘汰条件"),
+		field.Bool("blind_mode").Default(false).Comment("自动发起的任务是否为盲筛模式"),
+		field.Int("digest_hour").Default(2).Min(0).Max(23).Comment("每日汇总筛选的执行时刻（0-23 时）"),
+		field.Bool("enabled").Default(true).Comment("是否启用"),
+		field.UUID("created_by", uuid.UUID{}).Comment("创建者ID，自动发起的筛选任务归属该用户"),
+		field.Time("last_run_at").Optional().Nillable().Comment("最近一次执行时间"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("创建时间"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("更新时间"),
+	}
+}
+
+// Edges of the ScreeningSchedule.
+func (ScreeningSchedule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("job_position", JobPosition.Type).
+			Ref("screening_schedule").
+			Field("job_position_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ScreeningSchedule.
+func (ScreeningSchedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("job_position_id").Unique(),
+		index.Fields("enabled"),
+	}
+}
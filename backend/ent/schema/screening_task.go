@@ -49,6 +49,17 @@ func (ScreeningTask) Fields() []ent.Field {
 		field.Int("resume_succeeded").Default(0).Comment("成功匹配数"),
 		field.Int("resume_failed").Default(0).Comment("失败数"),
 		field.String("agent_version").Optional().MaxLen(50).Comment("智能匹配Agent版本号"),
+		field.Int64("token_budget").Optional().Nillable().Comment("Token预算，超出时暂停任务"),
+		field.Float("cost_budget").Optional().Nillable().Comment("费用预算，超出时暂停任务"),
+		field.Int64("tokens_input").Default(0).Comment("累计输入Token数"),
+		field.Int64("tokens_output").Default(0).Comment("累计输出Token数"),
+		field.Float("total_cost").Default(0).Comment("累计模型调用费用"),
+		field.Bool("disable_cache").Default(false).Comment("禁用筛选结果缓存，强制重新调用模型"),
+		field.JSON("knockout_rules", map[string]interface{}{}).Optional().Comment("硬性淘汰条件，调用模型前校验"),
+		field.Bool("blind_mode").Default(false).Comment("盲筛模式，匹配前隐去候选人个人信息"),
+		field.JSON("redaction_policy", map[string]interface{}{}).Optional().Comment("盲筛脱敏策略快照，用于合规审计"),
+		field.JSON("shortlist_ranking", map[string]interface{}{}).Optional().Comment("两两比较生成的短名单排名"),
+		field.JSON("custom_dimensions", []map[string]interface{}{}).Optional().Comment("创建任务时岗位自定义匹配维度快照"),
 		field.Time("started_at").Optional().Comment("任务开始时间"),
 		field.Time("finished_at").Optional().Comment("任务完成时间"),
 		field.Time("created_at").Default(time.Now).Immutable(),
@@ -45,6 +45,9 @@ func (ScreeningTaskResume) Fields() []ent.Field {
 		field.Int("ranking").Optional().Comment("排名（任务内）"),
 		field.Float("score").Optional().Comment("综合分快照（任务维度）"),
 		field.Time("processed_at").Optional().Comment("处理完成时间"),
+		field.Int64("tokens_input").Default(0).Comment("输入Token数"),
+		field.Int64("tokens_output").Default(0).Comment("输出Token数"),
+		field.Float("total_cost").Default(0).Comment("模型调用费用"),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -75,5 +78,6 @@ func (ScreeningTaskResume) Indexes() []ent.Index {
 		index.Fields("score").Annotations(entsql.Desc()),
 		index.Fields("task_id", "resume_id").Unique(),
 		index.Fields("task_id", "ranking"),
+		index.Fields("processed_at"),
 	}
 }
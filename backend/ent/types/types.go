@@ -24,3 +24,22 @@ type CustomOAuth struct {
 	AvatarField    string   `json:"avatar_field"`     // 用户信息回包中的头像URL字段名`
 	EmailField     string   `json:"email_field"`      // 用户信息回包中的邮箱字段名
 }
+
+// NotificationRoutingRule 通知路由规则，各条件为空时表示不限
+type NotificationRoutingRule struct {
+	EventTypes     []string `json:"event_types,omitempty"`      // 订阅的事件类型
+	DepartmentIDs  []string `json:"department_ids,omitempty"`   // 限定的部门ID
+	JobPositionIDs []string `json:"job_position_ids,omitempty"` // 限定的岗位ID
+}
+
+// ResumeMailboxRule 邮箱过滤规则，各匹配条件之间为"与"关系，条件为空时表示不限
+type ResumeMailboxRule struct {
+	Name              string   `json:"name"`                         // 规则名称
+	SenderDomains     []string `json:"sender_domains,omitempty"`     // 发件人域名，包含子域名
+	SubjectPattern    string   `json:"subject_pattern,omitempty"`    // 主题正则
+	RecipientAliases  []string `json:"recipient_aliases,omitempty"`  // 收件别名，完整地址或@前的本地部分
+	AttachmentPattern string   `json:"attachment_pattern,omitempty"` // 附件名正则，任一附件匹配即可
+	Action            string   `json:"action"`                       // 动作：skip/assign/tag/screen
+	JobPositionIDs    []string `json:"job_position_ids,omitempty"`   // assign/screen 动作关联的岗位ID
+	Source            string   `json:"source,omitempty"`             // tag 动作设置的投递来源
+}
@@ -31,7 +31,10 @@ var (
 	ErrUserLimit           = web.NewBadRequestBusinessErr(20011, "err-user-limit")
 
 	// ========== 简历管理模块 (30000-39999) ==========
-	// 预留简历相关错误码
+	ErrResumeNotFound                  = web.NewBadRequestBusinessErr(30000, "err-resume-not-found")
+	ErrResumeDuplicateNotFound         = web.NewBadRequestBusinessErr(30001, "err-resume-duplicate-not-found")
+	ErrResumeMerged                    = web.NewBadRequestBusinessErr(30002, "err-resume-merged")
+	ErrResumeSemanticSearchUnavailable = web.NewBadRequestBusinessErr(30003, "err-resume-semantic-search-unavailable")
 
 	// ========== 职位管理模块 (40000-49999) ==========
 	ErrJobProfileRequired        = web.NewBadRequestBusinessErr(40000, "err-jobprofile-required")
@@ -46,9 +49,13 @@ var (
 	ErrDepartmentRequired = web.NewBadRequestBusinessErr(60000, "err-department-required")
 
 	// ========== 筛选任务模块 (70000-79999) ==========
-	ErrScreeningTaskNotFound     = web.NewBadRequestBusinessErr(70000, "err-screening-task-not-found")
-	ErrScreeningTaskRunning      = web.NewBadRequestBusinessErr(70001, "err-screening-task-running")
-	ErrScreeningTaskDeleteFailed = web.NewBadRequestBusinessErr(70002, "err-screening-task-delete-failed")
+	ErrScreeningTaskNotFound      = web.NewBadRequestBusinessErr(70000, "err-screening-task-not-found")
+	ErrScreeningTaskRunning       = web.NewBadRequestBusinessErr(70001, "err-screening-task-running")
+	ErrScreeningTaskDeleteFailed  = web.NewBadRequestBusinessErr(70002, "err-screening-task-delete-failed")
+	ErrScreeningResultNotFound    = web.NewBadRequestBusinessErr(70003, "err-screening-result-not-found")
+	ErrScreeningDimensionNotFound = web.NewBadRequestBusinessErr(70004, "err-screening-dimension-not-found")
+	ErrScreeningScheduleNotFound  = web.NewBadRequestBusinessErr(70005, "err-screening-schedule-not-found")
+	ErrScreeningExportNotFound    = web.NewBadRequestBusinessErr(70006, "err-screening-export-not-found")
 
 	// ========== 通知设置模块 (80000-89999) ==========
 	ErrNotificationSettingCreateFailed = web.NewBadRequestBusinessErr(80000, "err-notification-setting-create-failed")
@@ -75,4 +82,9 @@ var (
 	// ========== 权重模板模块 (110000-119999) ==========
 	ErrWeightTemplateCreateFailed = web.NewBadRequestBusinessErr(110000, "err-weight-template-create-failed")
 	ErrWeightTemplateGetFailed    = web.NewBadRequestBusinessErr(110001, "err-weight-template-get-failed")
+
+	// ========== 死信队列模块 (120000-129999) ==========
+	ErrDeadLetterStreamInvalid = web.NewBadRequestBusinessErr(120000, "err-dead-letter-stream-invalid")
+	ErrDeadLetterNotFound      = web.NewBadRequestBusinessErr(120001, "err-dead-letter-not-found")
+	ErrDeadLetterReplayFailed  = web.NewBadRequestBusinessErr(120002, "err-dead-letter-replay-failed")
 )
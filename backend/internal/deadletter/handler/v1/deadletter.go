@@ -0,0 +1,151 @@
+package v1
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/errcode"
+	"github.com/chaitin/WhaleHire/backend/internal/middleware"
+	"github.com/chaitin/WhaleHire/backend/pkg/web"
+)
+
+// DeadLetterHandler 死信队列管理 HTTP 处理器
+type DeadLetterHandler struct {
+	usecase domain.DeadLetterUsecase
+	logger  *slog.Logger
+}
+
+// NewDeadLetterHandler 创建死信队列处理器并注册路由，所有操作均需管理员权限并由审计中间件记录
+func NewDeadLetterHandler(
+	w *web.Web,
+	usecase domain.DeadLetterUsecase,
+	auth *middleware.AuthMiddleware,
+	active *middleware.ActiveMiddleware,
+	readonly *middleware.ReadOnlyMiddleware,
+	logger *slog.Logger,
+) *DeadLetterHandler {
+	h := &DeadLetterHandler{
+		usecase: usecase,
+		logger:  logger.With("module", "dead_letter_handler"),
+	}
+
+	g := w.Group("/api/v1/dead-letters")
+	// 清理与重放会删除或重新投递消息，与其他管理端变更接口一致，需管理员登录且受只读模式限制
+	g.Use(auth.Auth(), active.Active("admin"), readonly.Guard())
+
+	g.GET("", web.BindHandler(h.List))
+	g.DELETE("", web.BindHandler(h.Purge))
+	g.POST("/replay", web.BindHandler(h.BatchReplay))
+	g.GET("/:id", web.BindHandler(h.Get))
+	g.POST("/:id/replay", web.BindHandler(h.Replay))
+
+	return h
+}
+
+// List 获取死信消息列表
+//
+//	@Tags			DeadLetter
+//	@Summary		获取死信消息列表
+//	@Description	按消息ID游标分页获取指定流的死信消息，包含失败原因与重试次数
+//	@ID				list-dead-letters
+//	@Accept			json
+//	@Produce		json
+//	@Param			stream		query		string	true	"原始流名称，如 notification:events"
+//	@Param			after_id	query		string	false	"翻页游标"
+//	@Param			limit		query		int		false	"每页数量，默认50，最大200"
+//	@Success		200			{object}	web.Resp{data=domain.ListDeadLetterResp}
+//	@Router			/api/v1/dead-letters [get]
+func (h *DeadLetterHandler) List(c *web.Context, req domain.ListDeadLetterReq) error {
+	resp, err := h.usecase.List(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("list dead letters failed", "stream", req.Stream, "error", err)
+		return err
+	}
+	return c.Success(resp)
+}
+
+// Get 获取死信消息详情
+//
+//	@Tags			DeadLetter
+//	@Summary		获取死信消息详情
+//	@Description	查看单条死信消息的完整负载
+//	@ID				get-dead-letter
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string	true	"死信消息ID"
+//	@Param			stream	query		string	true	"原始流名称"
+//	@Success		200		{object}	web.Resp{data=domain.DeadLetterMessage}
+//	@Router			/api/v1/dead-letters/{id} [get]
+func (h *DeadLetterHandler) Get(c *web.Context, req domain.GetDeadLetterReq) error {
+	msg, err := h.usecase.Get(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("get dead letter failed", "stream", req.Stream, "id", req.ID, "error", err)
+		return err
+	}
+	return c.Success(msg)
+}
+
+// Replay 重放单条死信消息
+//
+//	@Tags			DeadLetter
+//	@Summary		重放死信消息
+//	@Description	重置业务状态后将死信消息重新投递到原始流
+//	@ID				replay-dead-letter
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"死信消息ID"
+//	@Param			param	body		domain.ReplayDeadLetterReq	true	"重放参数"
+//	@Success		200		{object}	web.Resp{}
+//	@Router			/api/v1/dead-letters/{id}/replay [post]
+func (h *DeadLetterHandler) Replay(c *web.Context, req domain.ReplayDeadLetterReq) error {
+	if err := h.usecase.Replay(c.Request().Context(), &req); err != nil {
+		h.logger.Error("replay dead letter failed", "stream", req.Stream, "id", req.ID, "error", err)
+		var businessErr *web.BusinessErr
+		if errors.As(err, &businessErr) {
+			return err
+		}
+		return errcode.ErrDeadLetterReplayFailed.WithData("message", err.Error())
+	}
+	return c.Success(nil)
+}
+
+// BatchReplay 批量重放死信消息
+//
+//	@Tags			DeadLetter
+//	@Summary		批量重放死信消息
+//	@Description	按ID列表或全部重放死信消息，单条失败不影响其余消息
+//	@ID				batch-replay-dead-letters
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.BatchReplayDeadLetterReq	true	"批量重放参数"
+//	@Success		200		{object}	web.Resp{data=domain.BatchReplayDeadLetterResp}
+//	@Router			/api/v1/dead-letters/replay [post]
+func (h *DeadLetterHandler) BatchReplay(c *web.Context, req domain.BatchReplayDeadLetterReq) error {
+	resp, err := h.usecase.BatchReplay(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("batch replay dead letters failed", "stream", req.Stream, "error", err)
+		return err
+	}
+	return c.Success(resp)
+}
+
+// Purge 清空死信队列
+//
+//	@Tags			DeadLetter
+//	@Summary		清空死信队列
+//	@Description	删除指定流的全部死信消息
+//	@ID				purge-dead-letters
+//	@Accept			json
+//	@Produce		json
+//	@Param			stream	query		string	true	"原始流名称"
+//	@Success		200		{object}	web.Resp{data=domain.PurgeDeadLetterResp}
+//	@Router			/api/v1/dead-letters [delete]
+func (h *DeadLetterHandler) Purge(c *web.Context, req domain.PurgeDeadLetterReq) error {
+	resp, err := h.usecase.Purge(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("purge dead letters failed", "stream", req.Stream, "error", err)
+		return err
+	}
+	return c.Success(resp)
+}
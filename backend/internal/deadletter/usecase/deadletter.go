@@ -0,0 +1,279 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/errcode"
+	"github.com/chaitin/WhaleHire/backend/internal/queue"
+)
+
+const (
+	// defaultDeadLetterPageSize 死信列表默认每页数量
+	defaultDeadLetterPageSize = 50
+	// deadLetterScanBatch 全量重放时每批读取的消息数量
+	deadLetterScanBatch = 200
+)
+
+// deadLetterMetaKeys 死信队列附加的元数据字段，不属于原始消息负载
+var deadLetterMetaKeys = []string{"original_stream", "original_id", "dead_letter_reason", "dead_letter_time", "timestamp"}
+
+// DeadLetterUsecase 死信队列管理用例实现
+type DeadLetterUsecase struct {
+	deadLetter    queue.DeadLetterQueue
+	eventRepo     domain.NotificationEventRepo
+	screeningRepo domain.ScreeningRepo
+	logger        *slog.Logger
+}
+
+// NewDeadLetterUsecase 创建死信队列管理用例
+func NewDeadLetterUsecase(
+	deadLetter queue.DeadLetterQueue,
+	eventRepo domain.NotificationEventRepo,
+	screeningRepo domain.ScreeningRepo,
+	logger *slog.Logger,
+) domain.DeadLetterUsecase {
+	return &DeadLetterUsecase{
+		deadLetter:    deadLetter,
+		eventRepo:     eventRepo,
+		screeningRepo: screeningRepo,
+		logger:        logger.With("module", "dead_letter_usecase"),
+	}
+}
+
+// List 分页列出指定流的死信消息
+func (u *DeadLetterUsecase) List(ctx context.Context, req *domain.ListDeadLetterReq) (*domain.ListDeadLetterResp, error) {
+	if err := validateStream(req.Stream); err != nil {
+		return nil, err
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultDeadLetterPageSize
+	}
+
+	messages, err := u.deadLetter.GetDeadLetterMessages(ctx, req.Stream, req.AfterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("获取死信消息失败: %w", err)
+	}
+	total, err := u.deadLetter.CountDeadLetterMessages(ctx, req.Stream)
+	if err != nil {
+		return nil, fmt.Errorf("统计死信消息失败: %w", err)
+	}
+
+	resp := &domain.ListDeadLetterResp{
+		Items: make([]*domain.DeadLetterMessage, 0, len(messages)),
+		Total: total,
+	}
+	for _, msg := range messages {
+		resp.Items = append(resp.Items, toDeadLetterMessage(req.Stream, msg))
+	}
+	if int64(len(messages)) == limit {
+		resp.NextID = messages[len(messages)-1].ID
+	}
+	return resp, nil
+}
+
+// Get 查看单条死信消息
+func (u *DeadLetterUsecase) Get(ctx context.Context, req *domain.GetDeadLetterReq) (*domain.DeadLetterMessage, error) {
+	if err := validateStream(req.Stream); err != nil {
+		return nil, err
+	}
+	msg, err := u.deadLetter.GetDeadLetterMessage(ctx, req.Stream, req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("获取死信消息失败: %w", err)
+	}
+	if msg == nil {
+		return nil, errcode.ErrDeadLetterNotFound
+	}
+	return toDeadLetterMessage(req.Stream, *msg), nil
+}
+
+// Replay 重放单条死信消息
+func (u *DeadLetterUsecase) Replay(ctx context.Context, req *domain.ReplayDeadLetterReq) error {
+	if err := validateStream(req.Stream); err != nil {
+		return err
+	}
+	return u.replay(ctx, req.Stream, req.ID)
+}
+
+// BatchReplay 批量重放死信消息，单条失败不影响其余消息
+func (u *DeadLetterUsecase) BatchReplay(ctx context.Context, req *domain.BatchReplayDeadLetterReq) (*domain.BatchReplayDeadLetterResp, error) {
+	if err := validateStream(req.Stream); err != nil {
+		return nil, err
+	}
+
+	ids := req.IDs
+	if len(ids) == 0 {
+		if !req.All {
+			return nil, fmt.Errorf("请指定要重放的消息ID或选择全部重放")
+		}
+		var err error
+		if ids, err = u.collectIDs(ctx, req.Stream); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &domain.BatchReplayDeadLetterResp{}
+	for _, id := range ids {
+		if err := u.replay(ctx, req.Stream, id); err != nil {
+			if resp.Failed == nil {
+				resp.Failed = make(map[string]string)
+			}
+			resp.Failed[id] = err.Error()
+			continue
+		}
+		resp.Replayed++
+	}
+
+	u.logger.InfoContext(ctx, "批量重放死信消息完成",
+		slog.String("stream", req.Stream),
+		slog.Int("replayed", resp.Replayed),
+		slog.Int("failed", len(resp.Failed)),
+	)
+	return resp, nil
+}
+
+// Purge 清空指定流的死信队列
+func (u *DeadLetterUsecase) Purge(ctx context.Context, req *domain.PurgeDeadLetterReq) (*domain.PurgeDeadLetterResp, error) {
+	if err := validateStream(req.Stream); err != nil {
+		return nil, err
+	}
+	purged, err := u.deadLetter.PurgeDeadLetter(ctx, req.Stream)
+	if err != nil {
+		return nil, fmt.Errorf("清空死信队列失败: %w", err)
+	}
+
+	u.logger.WarnContext(ctx, "死信队列已清空", slog.String("stream", req.Stream), slog.Int64("purged", purged))
+	return &domain.PurgeDeadLetterResp{Purged: purged}, nil
+}
+
+// replay 重置业务状态后将死信消息重新投递到原始流
+func (u *DeadLetterUsecase) replay(ctx context.Context, stream, id string) error {
+	msg, err := u.deadLetter.GetDeadLetterMessage(ctx, stream, id)
+	if err != nil {
+		return fmt.Errorf("获取死信消息失败: %w", err)
+	}
+	if msg == nil {
+		return errcode.ErrDeadLetterNotFound
+	}
+
+	if err := u.prepareReplay(ctx, stream, msg); err != nil {
+		return err
+	}
+	if err := u.deadLetter.ReprocessDeadLetter(ctx, queue.DeadLetterStream(stream), id, stream); err != nil {
+		return fmt.Errorf("重新投递死信消息失败: %w", err)
+	}
+
+	u.logger.InfoContext(ctx, "死信消息已重放", slog.String("stream", stream), slog.String("message_id", id))
+	return nil
+}
+
+// prepareReplay 按流重置业务实体状态，避免重放后因重试次数已耗尽立即再次进入死信队列
+func (u *DeadLetterUsecase) prepareReplay(ctx context.Context, stream string, msg *queue.Message) error {
+	switch consts.QueueStream(stream) {
+	case consts.QueueStreamNotificationEvents:
+		eventID, _ := msg.Data["event_id"].(string)
+		id, err := uuid.Parse(eventID)
+		if err != nil {
+			return fmt.Errorf("死信消息缺少有效的 event_id: %q", eventID)
+		}
+		if err := u.eventRepo.ResetForReplay(ctx, id); err != nil {
+			return fmt.Errorf("重置通知事件状态失败: %w", err)
+		}
+	case consts.QueueStreamScreeningTaskResumes:
+		rawTaskID, _ := msg.Data["task_id"].(string)
+		taskID, err := uuid.Parse(rawTaskID)
+		if err != nil {
+			return fmt.Errorf("死信消息缺少有效的 task_id: %q", rawTaskID)
+		}
+		rawResumeID, _ := msg.Data["resume_id"].(string)
+		resumeID, err := uuid.Parse(rawResumeID)
+		if err != nil {
+			return fmt.Errorf("死信消息缺少有效的 resume_id: %q", rawResumeID)
+		}
+		item, err := u.screeningRepo.GetScreeningTaskResume(ctx, taskID, resumeID)
+		if err != nil {
+			return fmt.Errorf("获取任务简历失败: %w", err)
+		}
+		// 处理者失联后遗留的运行中状态需重置，否则重放的消息会一直被视为正在处理
+		if item.Status == string(consts.ScreeningTaskResumeStatusRunning) {
+			if err := u.screeningRepo.UpdateScreeningTaskResume(ctx, taskID, resumeID, map[string]any{
+				"status": consts.ScreeningTaskResumeStatusPending,
+			}); err != nil {
+				return fmt.Errorf("重置任务简历状态失败: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// collectIDs 读取死信队列中的全部消息ID
+func (u *DeadLetterUsecase) collectIDs(ctx context.Context, stream string) ([]string, error) {
+	var ids []string
+	afterID := ""
+	for {
+		messages, err := u.deadLetter.GetDeadLetterMessages(ctx, stream, afterID, deadLetterScanBatch)
+		if err != nil {
+			return nil, fmt.Errorf("获取死信消息失败: %w", err)
+		}
+		for _, msg := range messages {
+			ids = append(ids, msg.ID)
+		}
+		if len(messages) < deadLetterScanBatch {
+			return ids, nil
+		}
+		afterID = messages[len(messages)-1].ID
+	}
+}
+
+// validateStream 只允许访问已登记的队列流
+func validateStream(stream string) error {
+	if !consts.QueueStream(stream).IsValid() {
+		return errcode.ErrDeadLetterStreamInvalid
+	}
+	return nil
+}
+
+// toDeadLetterMessage 拆分死信元数据与原始负载
+func toDeadLetterMessage(stream string, msg queue.Message) *domain.DeadLetterMessage {
+	result := &domain.DeadLetterMessage{
+		ID:         msg.ID,
+		Stream:     stream,
+		TraceID:    msg.TraceID,
+		RetryCount: int(toInt64(msg.Data["retry_count"])),
+		Payload:    make(map[string]interface{}, len(msg.Data)),
+	}
+	result.OriginalID, _ = msg.Data["original_id"].(string)
+	result.Reason, _ = msg.Data["dead_letter_reason"].(string)
+	if ts := toInt64(msg.Data["dead_letter_time"]); ts > 0 {
+		failedAt := time.Unix(ts, 0)
+		result.FailedAt = &failedAt
+	}
+
+	for k, v := range msg.Data {
+		result.Payload[k] = v
+	}
+	for _, k := range deadLetterMetaKeys {
+		delete(result.Payload, k)
+	}
+	return result
+}
+
+// toInt64 兼容 JSON 反序列化得到的数值类型
+func toInt64(v interface{}) int64 {
+	switch n := v.(type) {
+	case int:
+		return int64(n)
+	case int64:
+		return n
+	case float64:
+		return int64(n)
+	}
+	return 0
+}
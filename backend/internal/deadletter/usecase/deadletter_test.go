@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/errcode"
+	"github.com/chaitin/WhaleHire/backend/internal/queue"
+)
+
+type fakeDeadLetterQueue struct {
+	queue.DeadLetterQueue
+	messages    []queue.Message
+	reprocessed []string
+}
+
+func (f *fakeDeadLetterQueue) GetDeadLetterMessages(_ context.Context, _ string, afterID string, limit int64) ([]queue.Message, error) {
+	start := 0
+	if afterID != "" {
+		for i, msg := range f.messages {
+			if msg.ID == afterID {
+				start = i + 1
+			}
+		}
+	}
+	end := min(start+int(limit), len(f.messages))
+	return f.messages[start:end], nil
+}
+
+func (f *fakeDeadLetterQueue) GetDeadLetterMessage(_ context.Context, _ string, messageID string) (*queue.Message, error) {
+	for _, msg := range f.messages {
+		if msg.ID == messageID {
+			return &msg, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeDeadLetterQueue) CountDeadLetterMessages(context.Context, string) (int64, error) {
+	return int64(len(f.messages)), nil
+}
+
+func (f *fakeDeadLetterQueue) ReprocessDeadLetter(_ context.Context, deadLetterStream string, messageID string, _ string) error {
+	f.reprocessed = append(f.reprocessed, deadLetterStream+"/"+messageID)
+	return nil
+}
+
+type fakeNotificationEventRepo struct {
+	domain.NotificationEventRepo
+	reset []uuid.UUID
+}
+
+func (f *fakeNotificationEventRepo) ResetForReplay(_ context.Context, id uuid.UUID) error {
+	f.reset = append(f.reset, id)
+	return nil
+}
+
+func TestDeadLetterUsecase_List(t *testing.T) {
+	stream := string(consts.QueueStreamNotificationEvents)
+	dlq := &fakeDeadLetterQueue{messages: []queue.Message{
+		{ID: "1-0", Data: map[string]interface{}{
+			"event_id":           uuid.NewString(),
+			"retry_count":        float64(3),
+			"original_id":        "100-0",
+			"dead_letter_reason": "webhook 502",
+			"dead_letter_time":   float64(1700000000),
+		}},
+		{ID: "2-0", Data: map[string]interface{}{"event_id": uuid.NewString()}},
+		{ID: "3-0", Data: map[string]interface{}{"event_id": uuid.NewString()}},
+	}}
+	u := NewDeadLetterUsecase(dlq, &fakeNotificationEventRepo{}, nil, slog.Default())
+
+	resp, err := u.List(context.Background(), &domain.ListDeadLetterReq{Stream: stream, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 2)
+	assert.Equal(t, int64(3), resp.Total)
+	assert.Equal(t, "2-0", resp.NextID)
+
+	first := resp.Items[0]
+	assert.Equal(t, "webhook 502", first.Reason)
+	assert.Equal(t, 3, first.RetryCount)
+	assert.Equal(t, "100-0", first.OriginalID)
+	require.NotNil(t, first.FailedAt)
+	assert.NotContains(t, first.Payload, "dead_letter_reason")
+	assert.Contains(t, first.Payload, "event_id")
+
+	resp, err = u.List(context.Background(), &domain.ListDeadLetterReq{Stream: stream, AfterID: "2-0", Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.Empty(t, resp.NextID)
+
+	_, err = u.List(context.Background(), &domain.ListDeadLetterReq{Stream: "unknown:stream"})
+	assert.ErrorIs(t, err, errcode.ErrDeadLetterStreamInvalid)
+}
+
+func TestDeadLetterUsecase_BatchReplay(t *testing.T) {
+	stream := string(consts.QueueStreamNotificationEvents)
+	eventID := uuid.New()
+	dlq := &fakeDeadLetterQueue{messages: []queue.Message{
+		{ID: "1-0", Data: map[string]interface{}{"event_id": eventID.String()}},
+		{ID: "2-0", Data: map[string]interface{}{"event_id": "broken"}},
+	}}
+	repo := &fakeNotificationEventRepo{}
+	u := NewDeadLetterUsecase(dlq, repo, nil, slog.Default())
+
+	resp, err := u.BatchReplay(context.Background(), &domain.BatchReplayDeadLetterReq{Stream: stream, All: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Replayed)
+	assert.Contains(t, resp.Failed, "2-0")
+	assert.Equal(t, []uuid.UUID{eventID}, repo.reset)
+	assert.Equal(t, []string{queue.DeadLetterStream(stream) + "/1-0"}, dlq.reprocessed)
+
+	err = u.Replay(context.Background(), &domain.ReplayDeadLetterReq{ID: "9-0", Stream: stream})
+	assert.ErrorIs(t, err, errcode.ErrDeadLetterNotFound)
+}
@@ -248,6 +248,35 @@ func (r *JobProfileRepo) GetByIDs(ctx context.Context, ids []string) ([]*db.JobP
 		All(ctx)
 }
 
+// ListPublished 获取全部已发布岗位及其职责、技能、学历、经验、行业要求
+func (r *JobProfileRepo) ListPublished(ctx context.Context) ([]*db.JobPosition, error) {
+	return r.db.JobPosition.Query().
+		Where(
+			jobposition.Status(consts.JobPositionStatusPublished),
+			jobposition.DeletedAtIsNil(),
+		).
+		WithDepartment(func(q *db.DepartmentQuery) {
+			q.Where(department.DeletedAtIsNil())
+		}).
+		WithResponsibilities(func(q *db.JobResponsibilityQuery) {
+			q.Where(jobresponsibility.DeletedAtIsNil())
+		}).
+		WithSkills(func(q *db.JobSkillQuery) {
+			q.Where(jobskill.DeletedAtIsNil()).WithSkill()
+		}).
+		WithEducationRequirements(func(q *db.JobEducationRequirementQuery) {
+			q.Where(jobeducationrequirement.DeletedAtIsNil())
+		}).
+		WithExperienceRequirements(func(q *db.JobExperienceRequirementQuery) {
+			q.Where(jobexperiencerequirement.DeletedAtIsNil())
+		}).
+		WithIndustryRequirements(func(q *db.JobIndustryRequirementQuery) {
+			q.Where(jobindustryrequirement.DeletedAtIsNil())
+		}).
+		Order(jobposition.ByUpdatedAt(sql.OrderDesc())).
+		All(ctx)
+}
+
 func (r *JobProfileRepo) List(ctx context.Context, req *domain.ListJobProfileRepoReq) ([]*db.JobPosition, *db.PageInfo, error) {
 	query := r.db.JobPosition.Query().
 		Where(jobposition.DeletedAtIsNil()).
@@ -366,6 +366,20 @@ func (u *JobProfileUsecase) GetByIDs(ctx context.Context, ids []string) ([]*doma
 	return result, nil
 }
 
+// ListPublished 获取全部已发布岗位的完整画像
+func (u *JobProfileUsecase) ListPublished(ctx context.Context) ([]*domain.JobProfileDetail, error) {
+	items, err := u.repo.ListPublished(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list published job profiles: %w", err)
+	}
+
+	result := make([]*domain.JobProfileDetail, 0, len(items))
+	for _, item := range items {
+		result = append(result, toJobProfileDetail(item))
+	}
+	return result, nil
+}
+
 func (u *JobProfileUsecase) List(ctx context.Context, req *domain.ListJobProfileReq) (*domain.ListJobProfileResp, error) {
 	items, pageInfo, err := u.repo.List(ctx, &domain.ListJobProfileRepoReq{ListJobProfileReq: req})
 	if err != nil {
@@ -60,6 +60,7 @@ var (
 		"/api/v1/notification-settings",
 		"/api/v1/resume-mailbox-settings",
 		"/api/v1/resume-mailbox-statistics",
+		"/api/v1/dead-letters",
 	}
 )
 
@@ -556,6 +557,8 @@ func (m *AuditMiddleware) parseResourceInfo(c echo.Context) (consts.ResourceType
 		return m.parseScreeningResource(c, path)
 	case strings.HasPrefix(path, "/api/v1/notification-settings"):
 		return m.parseNotificationSettingResource(c, path)
+	case strings.HasPrefix(path, "/api/v1/dead-letters"):
+		return m.parseDeadLetterResource(c, path)
 	case strings.HasPrefix(path, "/api/v1/general-agent/conversations"):
 		return m.parseGeneralAgentConversationResource(c, path)
 	case strings.HasPrefix(path, "/api/v1/general-agent"):
@@ -709,6 +712,20 @@ func (m *AuditMiddleware) parseResumeMailboxSettingResource(c echo.Context, path
 	return consts.ResourceTypeResumeMailboxSetting, nil, nil
 }
 
+// parseDeadLetterResource 解析死信消息资源，资源名称记录所属的原始流
+func (m *AuditMiddleware) parseDeadLetterResource(c echo.Context, path string) (consts.ResourceType, *string, *string) {
+	var resourceID, resourceName *string
+	if id := c.Param("id"); id != "" {
+		idCopy := id
+		resourceID = &idCopy
+	}
+	if stream := c.QueryParam("stream"); stream != "" {
+		streamCopy := stream
+		resourceName = &streamCopy
+	}
+	return consts.ResourceTypeDeadLetter, resourceID, resourceName
+}
+
 // parseResumeMailboxStatisticResource 解析简历邮箱统计资源
 func (m *AuditMiddleware) parseResumeMailboxStatisticResource(c echo.Context, path string) (consts.ResourceType, *string, *string) {
 	if mailboxID := c.Param("mailbox_id"); mailboxID != "" {
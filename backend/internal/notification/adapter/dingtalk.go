@@ -91,9 +91,9 @@ func (d *DingTalkAdapter) SendNotification(ctx context.Context, event *domain.No
 	}
 }
 
-// getDingTalkClients 获取所有启用的钉钉客户端
-func (d *DingTalkAdapter) getDingTalkClients(ctx context.Context) ([]*dingtalk.DingTalk, error) {
-	settings, err := d.settingUsecase.GetSettingsByChannel(ctx, consts.NotificationChannelDingTalk)
+// getDingTalkClients 获取事件需要投递的所有启用的钉钉客户端
+func (d *DingTalkAdapter) getDingTalkClients(ctx context.Context, event *domain.NotificationEvent) ([]*dingtalk.DingTalk, error) {
+	settings, err := resolveChannelSettings(ctx, d.settingUsecase, consts.NotificationChannelDingTalk, event.SettingID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get dingtalk settings: %w", err)
 	}
@@ -122,8 +122,8 @@ func (d *DingTalkAdapter) getDingTalkClients(ctx context.Context) ([]*dingtalk.D
 }
 
 // broadcastMessage 群发消息到所有钉钉群
-func (d *DingTalkAdapter) broadcastMessage(ctx context.Context, title, content string) error {
-	clients, err := d.getDingTalkClients(ctx)
+func (d *DingTalkAdapter) broadcastMessage(ctx context.Context, event *domain.NotificationEvent, title, content string) error {
+	clients, err := d.getDingTalkClients(ctx, event)
 	if err != nil {
 		return fmt.Errorf("failed to get dingtalk clients: %w", err)
 	}
@@ -265,7 +265,7 @@ func (d *DingTalkAdapter) sendResumeParseCompletedNotification(ctx context.Conte
 	}
 
 	// 群发消息到所有钉钉群
-	if err := d.broadcastMessage(ctx, title, content); err != nil {
+	if err := d.broadcastMessage(ctx, event, title, content); err != nil {
 		d.logger.ErrorContext(ctx, "Failed to broadcast resume parse notification",
 			slog.String("error", err.Error()),
 			slog.String("event_id", event.ID.String()),
@@ -326,7 +326,7 @@ func (d *DingTalkAdapter) sendBatchResumeParseCompletedNotification(ctx context.
 	}
 
 	// 群发消息到所有钉钉群
-	if err := d.broadcastMessage(ctx, title, content); err != nil {
+	if err := d.broadcastMessage(ctx, event, title, content); err != nil {
 		d.logger.ErrorContext(ctx, "Failed to broadcast batch resume parse notification",
 			slog.String("error", err.Error()),
 			slog.String("event_id", event.ID.String()),
@@ -373,7 +373,7 @@ func (d *DingTalkAdapter) sendMatchingCompletedNotification(ctx context.Context,
 	}
 
 	// 群发消息到所有钉钉群
-	if err := d.broadcastMessage(ctx, title, content); err != nil {
+	if err := d.broadcastMessage(ctx, event, title, content); err != nil {
 		d.logger.ErrorContext(ctx, "Failed to broadcast matching notification",
 			slog.String("error", err.Error()),
 			slog.String("event_id", event.ID.String()),
@@ -423,7 +423,7 @@ func (d *DingTalkAdapter) sendScreeningTaskCompletedNotification(ctx context.Con
 	}
 
 	// 群发消息到所有钉钉群
-	if err := d.broadcastMessage(ctx, title, content); err != nil {
+	if err := d.broadcastMessage(ctx, event, title, content); err != nil {
 		d.logger.ErrorContext(ctx, "Failed to broadcast screening notification",
 			slog.String("error", err.Error()),
 			slog.String("event_id", event.ID.String()),
@@ -0,0 +1,444 @@
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+const (
+	// emailTemplateDir 邮件模板目录
+	emailTemplateDir = "templates/notification/email"
+	// emailSendTimeout 单次 SMTP 会话的超时时间
+	emailSendTimeout = 30 * time.Second
+)
+
+// EmailAdapter 邮件(SMTP)发送适配器
+type EmailAdapter struct {
+	settingUsecase domain.NotificationSettingUsecase
+	logger         *slog.Logger
+	htmlTemplates  map[string]*htmltemplate.Template
+	textTemplates  map[string]*template.Template
+}
+
+// NewEmailAdapter 创建邮件适配器
+func NewEmailAdapter(settingUsecase domain.NotificationSettingUsecase, logger *slog.Logger) *EmailAdapter {
+	return newEmailAdapter(settingUsecase, logger, emailTemplateDir)
+}
+
+func newEmailAdapter(settingUsecase domain.NotificationSettingUsecase, logger *slog.Logger, templateDir string) *EmailAdapter {
+	adapter := &EmailAdapter{
+		settingUsecase: settingUsecase,
+		logger:         logger,
+		htmlTemplates:  make(map[string]*htmltemplate.Template),
+		textTemplates:  make(map[string]*template.Template),
+	}
+
+	// 初始化模板
+	adapter.initTemplates(templateDir)
+
+	return adapter
+}
+
+// initTemplates 初始化 HTML 与纯文本邮件模板
+func (e *EmailAdapter) initTemplates(templateDir string) {
+	templateNames := []string{
+		"resume_parse_success",
+		"resume_parse_failure",
+		"batch_resume_parse_completed",
+		"job_matching_completed",
+		"screening_task_completed",
+	}
+
+	for _, name := range templateNames {
+		htmlPath := filepath.Join(templateDir, name+".html.tmpl")
+		htmlTmpl, err := htmltemplate.ParseFiles(htmlPath)
+		if err != nil {
+			e.logger.Error("Failed to parse html email template", slog.String("template", name), slog.String("error", err.Error()))
+		} else {
+			e.htmlTemplates[name] = htmlTmpl
+		}
+
+		textPath := filepath.Join(templateDir, name+".txt.tmpl")
+		textTmpl, err := template.ParseFiles(textPath)
+		if err != nil {
+			e.logger.Error("Failed to parse text email template", slog.String("template", name), slog.String("error", err.Error()))
+		} else {
+			e.textTemplates[name] = textTmpl
+		}
+	}
+}
+
+// renderTemplate 渲染 HTML 与纯文本两种格式的邮件正文
+func (e *EmailAdapter) renderTemplate(templateName string, data interface{}) (string, string, error) {
+	htmlTmpl, exists := e.htmlTemplates[templateName]
+	if !exists {
+		return "", "", fmt.Errorf("html template %s not found", templateName)
+	}
+	textTmpl, exists := e.textTemplates[templateName]
+	if !exists {
+		return "", "", fmt.Errorf("text template %s not found", templateName)
+	}
+
+	var htmlBuf bytes.Buffer
+	if err := htmlTmpl.Execute(&htmlBuf, data); err != nil {
+		return "", "", fmt.Errorf("failed to execute html template %s: %w", templateName, err)
+	}
+
+	var textBuf bytes.Buffer
+	if err := textTmpl.Execute(&textBuf, data); err != nil {
+		return "", "", fmt.Errorf("failed to execute text template %s: %w", templateName, err)
+	}
+
+	return htmlBuf.String(), textBuf.String(), nil
+}
+
+// SendNotification 发送通知
+func (e *EmailAdapter) SendNotification(ctx context.Context, event *domain.NotificationEvent) error {
+	subject, templateName, templateData, err := e.buildContent(event)
+	if err != nil {
+		return err
+	}
+
+	htmlBody, textBody, err := e.renderTemplate(templateName, templateData)
+	if err != nil {
+		return fmt.Errorf("failed to render email template: %w", err)
+	}
+
+	configs, err := e.getEmailConfigs(ctx, event)
+	if err != nil {
+		return fmt.Errorf("failed to get email configs: %w", err)
+	}
+
+	var errors []error
+	for _, cfg := range configs {
+		if err := e.sendMail(ctx, cfg, subject, htmlBody, textBody); err != nil {
+			e.logger.ErrorContext(ctx, "Failed to send notification email",
+				slog.String("event_id", event.ID.String()),
+				slog.String("smtp_host", cfg.SMTPHost),
+				slog.String("error", err.Error()),
+			)
+			errors = append(errors, err)
+		}
+	}
+
+	// 所有邮件配置都发送失败时返回错误，部分失败仅记录警告
+	if len(errors) == len(configs) {
+		return fmt.Errorf("all email configurations failed to send message: %w", errors[0])
+	}
+	if len(errors) > 0 {
+		e.logger.WarnContext(ctx, "Partial success in sending notification email",
+			slog.Int("success_configs", len(configs)-len(errors)),
+			slog.Int("failed_configs", len(errors)),
+		)
+	} else {
+		e.logger.InfoContext(ctx, "Successfully sent notification email",
+			slog.String("event_id", event.ID.String()),
+			slog.Int("total_configs", len(configs)),
+		)
+	}
+
+	return nil
+}
+
+// buildContent 根据事件类型构建邮件主题、模板名称与模板数据
+func (e *EmailAdapter) buildContent(event *domain.NotificationEvent) (string, string, interface{}, error) {
+	switch event.EventType {
+	case consts.NotificationEventTypeResumeParseCompleted:
+		var payload domain.ResumeParseCompletedPayload
+		if err := decodeEventPayload(event, &payload); err != nil {
+			return "", "", nil, err
+		}
+		data := struct {
+			ResumeID    string
+			UserID      string
+			FileName    string
+			ErrorMsg    string
+			ProcessedAt string
+		}{
+			ResumeID:    payload.ResumeID.String(),
+			UserID:      payload.UserID.String(),
+			FileName:    payload.FileName,
+			ErrorMsg:    payload.ErrorMsg,
+			ProcessedAt: payload.ParsedAt.In(time.Local).Format("2006-01-02 15:04:05"),
+		}
+		if payload.Success {
+			return "[WhaleHire] 简历解析成功", "resume_parse_success", data, nil
+		}
+		return "[WhaleHire] 简历解析失败", "resume_parse_failure", data, nil
+
+	case consts.NotificationEventTypeBatchResumeParseCompleted:
+		var payload domain.BatchResumeParseCompletedPayload
+		if err := decodeEventPayload(event, &payload); err != nil {
+			return "", "", nil, err
+		}
+		successRate := float64(0)
+		if payload.TotalCount > 0 {
+			successRate = float64(payload.SuccessCount) / float64(payload.TotalCount) * 100
+		}
+		data := struct {
+			TaskID       string
+			UploaderName string
+			TotalCount   int
+			SuccessCount int
+			FailedCount  int
+			SuccessRate  float64
+			Source       string
+			CompletedAt  string
+		}{
+			TaskID:       payload.TaskID,
+			UploaderName: payload.UploaderName,
+			TotalCount:   payload.TotalCount,
+			SuccessCount: payload.SuccessCount,
+			FailedCount:  payload.FailedCount,
+			SuccessRate:  successRate,
+			Source:       payload.Source,
+			CompletedAt:  payload.CompletedAt.In(time.Local).Format("2006-01-02 15:04:05"),
+		}
+		return "[WhaleHire] 批量简历解析任务完成", "batch_resume_parse_completed", data, nil
+
+	case consts.NotificationEventTypeJobMatchingCompleted:
+		var payload domain.JobMatchingCompletedPayload
+		if err := decodeEventPayload(event, &payload); err != nil {
+			return "", "", nil, err
+		}
+		data := struct {
+			JobID       string
+			ResumeID    string
+			UserID      string
+			MatchScore  float64
+			ProcessedAt string
+		}{
+			JobID:       payload.JobID.String(),
+			ResumeID:    payload.ResumeID.String(),
+			UserID:      payload.UserID.String(),
+			MatchScore:  payload.MatchScore,
+			ProcessedAt: payload.MatchedAt.In(time.Local).Format("2006-01-02 15:04:05"),
+		}
+		return "[WhaleHire] 职位匹配分析完成", "job_matching_completed", data, nil
+
+	case consts.NotificationEventTypeScreeningTaskCompleted:
+		var payload domain.ScreeningTaskCompletedPayload
+		if err := decodeEventPayload(event, &payload); err != nil {
+			return "", "", nil, err
+		}
+		data := struct {
+			TaskID      string
+			JobName     string
+			UserName    string
+			TotalCount  int
+			PassedCount int
+			CompletedAt string
+		}{
+			TaskID:      payload.TaskID.String(),
+			JobName:     payload.JobName,
+			UserName:    payload.UserName,
+			TotalCount:  payload.TotalCount,
+			PassedCount: payload.PassedCount,
+			CompletedAt: payload.CompletedAt.In(time.Local).Format("2006-01-02 15:04:05"),
+		}
+		return "[WhaleHire] 智能匹配任务完成", "screening_task_completed", data, nil
+
+	default:
+		return "", "", nil, fmt.Errorf("unsupported event type: %s", event.EventType)
+	}
+}
+
+// decodeEventPayload 将事件 Payload 反序列化为具体的载荷结构体
+func decodeEventPayload(event *domain.NotificationEvent, out interface{}) error {
+	payloadBytes, err := json.Marshal(event.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	if err := json.Unmarshal(payloadBytes, out); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+	return nil
+}
+
+// getEmailConfigs 获取事件需要投递的所有启用的邮件配置
+func (e *EmailAdapter) getEmailConfigs(ctx context.Context, event *domain.NotificationEvent) ([]*domain.NotificationEmailConfig, error) {
+	settings, err := resolveChannelSettings(ctx, e.settingUsecase, consts.NotificationChannelEmail, event.SettingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get email settings: %w", err)
+	}
+
+	var configs []*domain.NotificationEmailConfig
+	for _, setting := range settings {
+		// 只处理启用的配置
+		if !setting.Enabled || setting.EmailConfig == nil {
+			continue
+		}
+		configs = append(configs, setting.EmailConfig)
+	}
+
+	if len(configs) == 0 {
+		return nil, fmt.Errorf("no enabled email configurations found")
+	}
+
+	return configs, nil
+}
+
+// sendMail 通过 SMTP 发送一封 multipart/alternative 邮件
+func (e *EmailAdapter) sendMail(ctx context.Context, cfg *domain.NotificationEmailConfig, subject, htmlBody, textBody string) error {
+	msg, err := buildMIMEMessage(cfg, subject, htmlBody, textBody)
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(cfg.SMTPHost, strconv.Itoa(cfg.SMTPPort))
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	tlsConfig := &tls.Config{ServerName: cfg.SMTPHost}
+
+	var conn net.Conn
+	if cfg.UseTLS {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect smtp server %s: %w", addr, err)
+	}
+
+	deadline := time.Now().Add(emailSendTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = conn.SetDeadline(deadline)
+
+	client, err := smtp.NewClient(conn, cfg.SMTPHost)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to create smtp client: %w", err)
+	}
+	defer client.Close()
+
+	// 非隐式 TLS 时，服务器支持则升级为 STARTTLS
+	secure := cfg.UseTLS
+	if !secure {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(tlsConfig); err != nil {
+				return fmt.Errorf("failed to start tls: %w", err)
+			}
+			secure = true
+		}
+	}
+
+	if cfg.Username != "" {
+		// PLAIN 认证会明文传输密码，smtp.PlainAuth 拒绝在非本机的未加密连接上认证
+		if !secure && !isLocalSMTPHost(cfg.SMTPHost) {
+			return fmt.Errorf("smtp server %s does not offer STARTTLS; enable use_tls or remove credentials", addr)
+		}
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return fmt.Errorf("smtp server does not support AUTH")
+		}
+		if err := client.Auth(smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.SMTPHost)); err != nil {
+			return fmt.Errorf("smtp auth failed: %w", err)
+		}
+	}
+
+	if err := client.Mail(cfg.From); err != nil {
+		return fmt.Errorf("smtp MAIL FROM failed: %w", err)
+	}
+	for _, recipient := range cfg.Recipients {
+		if err := client.Rcpt(recipient); err != nil {
+			return fmt.Errorf("smtp RCPT TO %s failed: %w", recipient, err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp DATA failed: %w", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("failed to write email body: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to finish email body: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// isLocalSMTPHost 判断是否为本机地址，与 smtp.PlainAuth 允许明文认证的范围保持一致
+func isLocalSMTPHost(host string) bool {
+	return host == "localhost" || host == "127.0.0.1" || host == "::1"
+}
+
+// buildMIMEMessage 构建包含纯文本与 HTML 两个部分的 MIME 邮件
+func buildMIMEMessage(cfg *domain.NotificationEmailConfig, subject, htmlBody, textBody string) ([]byte, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+
+	from := (&mail.Address{Name: cfg.FromName, Address: cfg.From}).String()
+	domainPart := cfg.SMTPHost
+	if at := strings.LastIndex(cfg.From, "@"); at >= 0 {
+		domainPart = cfg.From[at+1:]
+	}
+
+	header := []string{
+		"From: " + from,
+		"To: " + strings.Join(cfg.Recipients, ", "),
+		"Subject: " + mime.BEncoding.Encode("UTF-8", subject),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		fmt.Sprintf("Message-ID: <%s@%s>", uuid.New().String(), domainPart),
+		"MIME-Version: 1.0",
+		fmt.Sprintf("Content-Type: multipart/alternative; boundary=%q", mw.Boundary()),
+	}
+	headerBytes := []byte(strings.Join(header, "\r\n") + "\r\n\r\n")
+
+	parts := []struct {
+		contentType string
+		body        string
+	}{
+		{contentType: "text/plain; charset=UTF-8", body: textBody},
+		{contentType: "text/html; charset=UTF-8", body: htmlBody},
+	}
+	for _, part := range parts {
+		pw, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create mime part: %w", err)
+		}
+		qw := quotedprintable.NewWriter(pw)
+		if _, err := qw.Write([]byte(part.body)); err != nil {
+			return nil, fmt.Errorf("failed to write mime part: %w", err)
+		}
+		if err := qw.Close(); err != nil {
+			return nil, fmt.Errorf("failed to close mime part: %w", err)
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close mime writer: %w", err)
+	}
+
+	return append(headerBytes, buf.Bytes()...), nil
+}
+
+// GetChannelType 获取通道类型
+func (e *EmailAdapter) GetChannelType() consts.NotificationChannel {
+	return consts.NotificationChannelEmail
+}
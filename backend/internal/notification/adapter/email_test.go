@@ -0,0 +1,284 @@
+package adapter
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+// fakeSettingUsecase 仅实现测试所需的 GetSettingsByChannel 与 GetSetting
+type fakeSettingUsecase struct {
+	domain.NotificationSettingUsecase
+	settings []*domain.NotificationSetting
+}
+
+func (f *fakeSettingUsecase) GetSettingsByChannel(ctx context.Context, channel consts.NotificationChannel) ([]*domain.NotificationSetting, error) {
+	var result []*domain.NotificationSetting
+	for _, s := range f.settings {
+		if s.Channel == channel {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeSettingUsecase) GetSetting(ctx context.Context, id uuid.UUID) (*domain.NotificationSetting, error) {
+	for _, s := range f.settings {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return &domain.NotificationSetting{}, nil
+}
+
+// smtpEnvelope 本地 SMTP 替身收到的一封邮件
+type smtpEnvelope struct {
+	From string
+	To   []string
+	Data string
+}
+
+// startFakeSMTPServer 启动一个只支持最小指令集的本地 SMTP 服务，用于替代真实邮件服务器
+func startFakeSMTPServer(t *testing.T) (string, int, <-chan smtpEnvelope) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	received := make(chan smtpEnvelope, 4)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveFakeSMTP(conn, received)
+		}
+	}()
+
+	addr := ln.Addr().(*net.TCPAddr)
+	return addr.IP.String(), addr.Port, received
+}
+
+func serveFakeSMTP(conn net.Conn, received chan<- smtpEnvelope) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = io.WriteString(conn, line+"\r\n") }
+
+	var env smtpEnvelope
+	reply("220 localhost fake smtp")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.TrimRight(line, "\r\n")
+		upper := strings.ToUpper(cmd)
+		switch {
+		case strings.HasPrefix(upper, "EHLO"), strings.HasPrefix(upper, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(upper, "MAIL FROM:"):
+			env.From = strings.Trim(cmd[len("MAIL FROM:"):], "<> ")
+			reply("250 OK")
+		case strings.HasPrefix(upper, "RCPT TO:"):
+			env.To = append(env.To, strings.Trim(cmd[len("RCPT TO:"):], "<> "))
+			reply("250 OK")
+		case upper == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(l)
+			}
+			env.Data = data.String()
+			received <- env
+			reply("250 OK queued")
+		case upper == "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+func TestEmailAdapterSendNotification(t *testing.T) {
+	host, port, received := startFakeSMTPServer(t)
+
+	settings := &fakeSettingUsecase{settings: []*domain.NotificationSetting{
+		{
+			ID:      uuid.New(),
+			Name:    "hr-mail",
+			Channel: consts.NotificationChannelEmail,
+			Enabled: true,
+			EmailConfig: &domain.NotificationEmailConfig{
+				SMTPHost:   host,
+				SMTPPort:   port,
+				From:       "noreply@whalehire.local",
+				FromName:   "WhaleHire",
+				Recipients: []string{"hr@whalehire.local", "lead@whalehire.local"},
+			},
+		},
+	}}
+	a := newEmailAdapter(settings, slog.Default(), "../../../templates/notification/email")
+	assert.Equal(t, consts.NotificationChannelEmail, a.GetChannelType())
+
+	payload := domain.ScreeningTaskCompletedPayload{
+		TaskID:      uuid.New(),
+		UserName:    "张三",
+		JobName:     "后端工程师",
+		TotalCount:  12,
+		PassedCount: 5,
+		CompletedAt: time.Now(),
+	}
+	event := &domain.NotificationEvent{
+		ID:        uuid.New(),
+		EventType: payload.GetEventType(),
+		Channel:   consts.NotificationChannelEmail,
+		Payload:   payload.GetPayload(),
+	}
+
+	require.NoError(t, a.SendNotification(context.Background(), event))
+
+	var env smtpEnvelope
+	select {
+	case env = <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("fake smtp server did not receive message")
+	}
+
+	assert.Equal(t, "noreply@whalehire.local", env.From)
+	assert.Equal(t, []string{"hr@whalehire.local", "lead@whalehire.local"}, env.To)
+
+	msg, err := mail.ReadMessage(strings.NewReader(env.Data))
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "[WhaleHire] 智能匹配任务完成", subject)
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	// multipart.Reader 会自动解码 quoted-printable 内容
+	parts := map[string]string{}
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		body, err := io.ReadAll(part)
+		require.NoError(t, err)
+		parts[part.Header.Get("Content-Type")] = string(body)
+	}
+	require.Len(t, parts, 2)
+	assert.Contains(t, parts["text/plain; charset=UTF-8"], "匹配任务ID: "+payload.TaskID.String())
+	assert.Contains(t, parts["text/html; charset=UTF-8"], "<td style=\"padding: 6px 12px; color: #333333;\">后端工程师</td>")
+}
+
+func TestEmailAdapterSendNotificationErrors(t *testing.T) {
+	event := &domain.NotificationEvent{
+		ID:        uuid.New(),
+		EventType: consts.NotificationEventTypeResumeParseCompleted,
+		Payload: domain.ResumeParseCompletedPayload{
+			ResumeID: uuid.New(),
+			FileName: "resume.pdf",
+			Success:  true,
+		}.GetPayload(),
+	}
+
+	t.Run("no enabled email settings", func(t *testing.T) {
+		a := newEmailAdapter(&fakeSettingUsecase{}, slog.Default(), "../../../templates/notification/email")
+		err := a.SendNotification(context.Background(), event)
+		assert.ErrorContains(t, err, "no enabled email configurations found")
+	})
+
+	t.Run("smtp server unreachable", func(t *testing.T) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		port := ln.Addr().(*net.TCPAddr).Port
+		require.NoError(t, ln.Close())
+
+		settings := &fakeSettingUsecase{settings: []*domain.NotificationSetting{
+			{
+				Channel: consts.NotificationChannelEmail,
+				Enabled: true,
+				EmailConfig: &domain.NotificationEmailConfig{
+					SMTPHost:   "127.0.0.1",
+					SMTPPort:   port,
+					From:       "noreply@whalehire.local",
+					Recipients: []string{"hr@whalehire.local"},
+				},
+			},
+		}}
+		a := newEmailAdapter(settings, slog.Default(), "../../../templates/notification/email")
+		err = a.SendNotification(context.Background(), event)
+		assert.ErrorContains(t, err, "127.0.0.1:"+strconv.Itoa(port))
+	})
+
+	t.Run("credentials without tls on remote host", func(t *testing.T) {
+		// 127.0.0.2 同样指向本机回环，但 smtp.PlainAuth 不视其为 localhost
+		ln, err := net.Listen("tcp", "127.0.0.2:0")
+		if err != nil {
+			t.Skipf("loopback alias unavailable: %v", err)
+		}
+		t.Cleanup(func() { _ = ln.Close() })
+		go func() {
+			for {
+				conn, err := ln.Accept()
+				if err != nil {
+					return
+				}
+				go serveFakeSMTP(conn, make(chan smtpEnvelope, 1))
+			}
+		}()
+
+		settings := &fakeSettingUsecase{settings: []*domain.NotificationSetting{
+			{
+				Channel: consts.NotificationChannelEmail,
+				Enabled: true,
+				EmailConfig: &domain.NotificationEmailConfig{
+					SMTPHost:   "127.0.0.2",
+					SMTPPort:   ln.Addr().(*net.TCPAddr).Port,
+					Username:   "noreply@whalehire.local",
+					Password:   "secret",
+					From:       "noreply@whalehire.local",
+					Recipients: []string{"hr@whalehire.local"},
+				},
+			},
+		}}
+		a := newEmailAdapter(settings, slog.Default(), "../../../templates/notification/email")
+		err = a.SendNotification(context.Background(), event)
+		assert.ErrorContains(t, err, "does not offer STARTTLS")
+	})
+
+	t.Run("unsupported event type", func(t *testing.T) {
+		a := newEmailAdapter(&fakeSettingUsecase{}, slog.Default(), "../../../templates/notification/email")
+		err := a.SendNotification(context.Background(), &domain.NotificationEvent{EventType: "unknown"})
+		assert.ErrorContains(t, err, "unsupported event type")
+	})
+}
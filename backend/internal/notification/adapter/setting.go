@@ -0,0 +1,34 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+// resolveChannelSettings 获取事件应投递的通知设置。
+// 事件绑定了通知设置时只投递到该设置，否则沿用旧行为，投递到该渠道下的所有设置。
+func resolveChannelSettings(
+	ctx context.Context,
+	settingUsecase domain.NotificationSettingUsecase,
+	channel consts.NotificationChannel,
+	settingID *uuid.UUID,
+) ([]*domain.NotificationSetting, error) {
+	if settingID == nil || *settingID == uuid.Nil {
+		return settingUsecase.GetSettingsByChannel(ctx, channel)
+	}
+
+	setting, err := settingUsecase.GetSetting(ctx, *settingID)
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil || setting.ID == uuid.Nil || setting.Channel != channel {
+		return nil, fmt.Errorf("notification setting %s not found for channel %s", settingID.String(), channel)
+	}
+
+	return []*domain.NotificationSetting{setting}, nil
+}
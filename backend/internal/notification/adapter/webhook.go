@@ -0,0 +1,210 @@
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+// WebhookDeliveryError Webhook 投递失败错误，携带响应状态码
+type WebhookDeliveryError struct {
+	URL        string
+	StatusCode int
+	Body       string
+}
+
+func (e *WebhookDeliveryError) Error() string {
+	return fmt.Sprintf("webhook %s responded with status %d: %s", e.URL, e.StatusCode, e.Body)
+}
+
+// Retryable 5xx、408 与 429 视为可重试，其余 4xx 说明请求本身被拒绝，重试无意义
+func (e *WebhookDeliveryError) Retryable() bool {
+	return e.StatusCode >= http.StatusInternalServerError ||
+		e.StatusCode == http.StatusRequestTimeout ||
+		e.StatusCode == http.StatusTooManyRequests
+}
+
+// WebhookAdapter 通用 Webhook 发送适配器
+type WebhookAdapter struct {
+	settingUsecase domain.NotificationSettingUsecase
+	logger         *slog.Logger
+	client         *http.Client
+}
+
+// NewWebhookAdapter 创建 Webhook 适配器
+func NewWebhookAdapter(settingUsecase domain.NotificationSettingUsecase, logger *slog.Logger) *WebhookAdapter {
+	return &WebhookAdapter{
+		settingUsecase: settingUsecase,
+		logger:         logger,
+		client:         &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// SignWebhookPayload 计算 Webhook 签名：HMAC-SHA256(secret, "<timestamp>.<body>")，返回 sha256=<hex>
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// SendNotification 发送通知
+func (w *WebhookAdapter) SendNotification(ctx context.Context, event *domain.NotificationEvent) error {
+	switch event.EventType {
+	case consts.NotificationEventTypeResumeParseCompleted,
+		consts.NotificationEventTypeBatchResumeParseCompleted,
+		consts.NotificationEventTypeJobMatchingCompleted,
+		consts.NotificationEventTypeScreeningTaskCompleted:
+	default:
+		return fmt.Errorf("unsupported event type: %s", event.EventType)
+	}
+
+	configs, err := w.getWebhookConfigs(ctx, event)
+	if err != nil {
+		return fmt.Errorf("failed to get webhook configs: %w", err)
+	}
+
+	targets := w.resolveTargets(event, configs)
+
+	var errs []error
+	for _, target := range targets {
+		if err := w.deliver(ctx, event, target); err != nil {
+			w.logger.ErrorContext(ctx, "Failed to deliver webhook",
+				slog.String("event_id", event.ID.String()),
+				slog.String("url", target.URL),
+				slog.String("error", err.Error()),
+			)
+			errs = append(errs, err)
+		}
+	}
+
+	// 所有目标都投递失败时返回错误，交由 Worker 进入重试/失败流程
+	if len(errs) == len(targets) {
+		return fmt.Errorf("all webhook targets failed: %w", errors.Join(errs...))
+	}
+	if len(errs) > 0 {
+		w.logger.WarnContext(ctx, "Partial success in delivering webhook",
+			slog.Int("success_targets", len(targets)-len(errs)),
+			slog.Int("failed_targets", len(errs)),
+		)
+	} else {
+		w.logger.InfoContext(ctx, "Successfully delivered webhook",
+			slog.String("event_id", event.ID.String()),
+			slog.Int("total_targets", len(targets)),
+		)
+	}
+
+	return nil
+}
+
+// getWebhookConfigs 获取事件需要投递的所有启用的 Webhook 配置
+func (w *WebhookAdapter) getWebhookConfigs(ctx context.Context, event *domain.NotificationEvent) ([]*domain.NotificationWebhookConfig, error) {
+	settings, err := resolveChannelSettings(ctx, w.settingUsecase, consts.NotificationChannelWebhook, event.SettingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook settings: %w", err)
+	}
+
+	var configs []*domain.NotificationWebhookConfig
+	for _, setting := range settings {
+		// 只处理启用的配置
+		if !setting.Enabled || setting.WebhookConfig == nil {
+			continue
+		}
+		configs = append(configs, setting.WebhookConfig)
+	}
+
+	if len(configs) == 0 {
+		return nil, fmt.Errorf("no enabled webhook configurations found")
+	}
+
+	return configs, nil
+}
+
+// resolveTargets 确定投递目标：只投递到启用配置中的地址，并使用该配置自身的密钥签名。
+// 事件负载中携带的回调地址不在配置中时忽略，避免未经配置的地址收到带签名的事件。
+func (w *WebhookAdapter) resolveTargets(event *domain.NotificationEvent, configs []*domain.NotificationWebhookConfig) []*domain.NotificationWebhookConfig {
+	targets := make([]*domain.NotificationWebhookConfig, 0, len(configs))
+	seen := make(map[string]bool, len(configs))
+	for _, cfg := range configs {
+		if seen[cfg.URL] {
+			continue
+		}
+		seen[cfg.URL] = true
+		targets = append(targets, cfg)
+	}
+
+	if event.Target != "" && !seen[event.Target] {
+		w.logger.Warn("Ignoring webhook target not present in notification settings",
+			slog.String("event_id", event.ID.String()),
+			slog.String("target", event.Target),
+		)
+	}
+
+	return targets
+}
+
+// deliver 向单个目标投递签名后的事件信封
+func (w *WebhookAdapter) deliver(ctx context.Context, event *domain.NotificationEvent, target *domain.NotificationWebhookConfig) error {
+	timestamp := time.Now().Unix()
+	envelope := domain.WebhookEnvelope{
+		Version:   consts.WebhookEnvelopeVersion,
+		EventID:   event.ID.String(),
+		EventType: event.EventType,
+		TraceID:   event.TraceID,
+		Timestamp: timestamp,
+		Payload:   event.Payload,
+	}
+
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook envelope: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "WhaleHire-Webhook/"+consts.WebhookEnvelopeVersion)
+	req.Header.Set(consts.WebhookHeaderEvent, string(event.EventType))
+	req.Header.Set(consts.WebhookHeaderEventID, event.ID.String())
+	req.Header.Set(consts.WebhookHeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(consts.WebhookHeaderSignature, SignWebhookPayload(target.Secret, timestamp, body))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return &WebhookDeliveryError{
+			URL:        target.URL,
+			StatusCode: resp.StatusCode,
+			Body:       string(respBody),
+		}
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
+
+// GetChannelType 获取通道类型
+func (w *WebhookAdapter) GetChannelType() consts.NotificationChannel {
+	return consts.NotificationChannelWebhook
+}
@@ -0,0 +1,161 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+func webhookSettings(url, secret string) *fakeSettingUsecase {
+	return &fakeSettingUsecase{settings: []*domain.NotificationSetting{
+		{
+			Channel:       consts.NotificationChannelWebhook,
+			Enabled:       true,
+			WebhookConfig: &domain.NotificationWebhookConfig{URL: url, Secret: secret},
+		},
+	}}
+}
+
+func TestWebhookAdapterSendNotification(t *testing.T) {
+	const secret = "0123456789abcdef-secret"
+
+	var (
+		gotEnvelope domain.WebhookEnvelope
+		gotHeader   http.Header
+		gotBody     []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Clone()
+		gotBody, _ = io.ReadAll(r.Body)
+		_ = json.Unmarshal(gotBody, &gotEnvelope)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	payload := domain.ResumeParseCompletedPayload{
+		ResumeID: uuid.New(),
+		FileName: "resume.pdf",
+		Success:  true,
+	}
+	event := &domain.NotificationEvent{
+		ID:        uuid.New(),
+		EventType: payload.GetEventType(),
+		Channel:   consts.NotificationChannelWebhook,
+		Payload:   payload.GetPayload(),
+		TraceID:   "resume_parse_" + payload.ResumeID.String(),
+	}
+
+	a := NewWebhookAdapter(webhookSettings(server.URL, secret), slog.Default())
+	require.NoError(t, a.SendNotification(context.Background(), event))
+
+	assert.Equal(t, consts.WebhookEnvelopeVersion, gotEnvelope.Version)
+	assert.Equal(t, event.ID.String(), gotEnvelope.EventID)
+	assert.Equal(t, event.EventType, gotEnvelope.EventType)
+	assert.Equal(t, event.TraceID, gotEnvelope.TraceID)
+	assert.Equal(t, payload.ResumeID.String(), gotEnvelope.Payload["resume_id"])
+
+	assert.Equal(t, string(event.EventType), gotHeader.Get(consts.WebhookHeaderEvent))
+	assert.Equal(t, event.ID.String(), gotHeader.Get(consts.WebhookHeaderEventID))
+	timestamp, err := strconv.ParseInt(gotHeader.Get(consts.WebhookHeaderTimestamp), 10, 64)
+	require.NoError(t, err)
+	assert.Equal(t, gotEnvelope.Timestamp, timestamp)
+	assert.Equal(t, SignWebhookPayload(secret, timestamp, gotBody), gotHeader.Get(consts.WebhookHeaderSignature))
+}
+
+func TestWebhookAdapterNon2xx(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		retryable bool
+	}{
+		{name: "server error is retryable", status: http.StatusBadGateway, retryable: true},
+		{name: "rate limited is retryable", status: http.StatusTooManyRequests, retryable: true},
+		{name: "client error is permanent", status: http.StatusUnauthorized, retryable: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			event := &domain.NotificationEvent{
+				ID:        uuid.New(),
+				EventType: consts.NotificationEventTypeScreeningTaskCompleted,
+				Payload:   map[string]interface{}{"task_id": uuid.New().String()},
+			}
+
+			a := NewWebhookAdapter(webhookSettings(server.URL, "0123456789abcdef-secret"), slog.Default())
+			err := a.SendNotification(context.Background(), event)
+			require.Error(t, err)
+
+			var deliveryErr *WebhookDeliveryError
+			require.True(t, errors.As(err, &deliveryErr))
+			assert.Equal(t, tt.status, deliveryErr.StatusCode)
+			assert.Equal(t, tt.retryable, deliveryErr.Retryable())
+		})
+	}
+}
+
+func TestWebhookAdapterRoutedEvent(t *testing.T) {
+	var hitA, hitB int
+	serverA := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { hitA++ }))
+	defer serverA.Close()
+	serverB := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { hitB++ }))
+	defer serverB.Close()
+
+	settingB := uuid.New()
+	settings := &fakeSettingUsecase{settings: []*domain.NotificationSetting{
+		{
+			ID:            uuid.New(),
+			Channel:       consts.NotificationChannelWebhook,
+			Enabled:       true,
+			WebhookConfig: &domain.NotificationWebhookConfig{URL: serverA.URL, Secret: "0123456789abcdef-a"},
+		},
+		{
+			ID:            settingB,
+			Channel:       consts.NotificationChannelWebhook,
+			Enabled:       true,
+			WebhookConfig: &domain.NotificationWebhookConfig{URL: serverB.URL, Secret: "0123456789abcdef-b"},
+		},
+	}}
+	a := NewWebhookAdapter(settings, slog.Default())
+
+	event := &domain.NotificationEvent{
+		ID:        uuid.New(),
+		EventType: consts.NotificationEventTypeScreeningTaskCompleted,
+		Payload:   map[string]interface{}{"task_id": uuid.New().String()},
+		SettingID: &settingB,
+	}
+	require.NoError(t, a.SendNotification(context.Background(), event))
+	assert.Equal(t, 0, hitA)
+	assert.Equal(t, 1, hitB)
+
+	// 负载指定的未配置地址不投递，也不会用配置密钥签名
+	var hitAdHoc int
+	adHoc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { hitAdHoc++ }))
+	defer adHoc.Close()
+	event.Target = adHoc.URL
+	require.NoError(t, a.SendNotification(context.Background(), event))
+	assert.Equal(t, 0, hitAdHoc)
+	assert.Equal(t, 2, hitB)
+	event.Target = ""
+
+	missing := uuid.New()
+	event.SettingID = &missing
+	assert.ErrorContains(t, a.SendNotification(context.Background(), event), "not found")
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/chaitin/WhaleHire/backend/consts"
 	"github.com/chaitin/WhaleHire/backend/domain"
 	"github.com/chaitin/WhaleHire/backend/errcode"
 	"github.com/chaitin/WhaleHire/backend/internal/middleware"
@@ -51,7 +52,7 @@ func NewNotificationSettingHandler(
 //
 //	@Tags			NotificationSetting
 //	@Summary		创建通知设置
-//	@Description	创建新的通知设置配置，支持钉钉等多种通知渠道
+//	@Description	创建新的通知设置配置，支持钉钉、邮件、Webhook等多种通知渠道
 //	@Security		ApiKeyAuth
 //	@Accept			json
 //	@Produce		json
@@ -63,14 +64,15 @@ func NewNotificationSettingHandler(
 //	@Router			/api/v1/notification-settings [post]
 func (h *NotificationSettingHandler) CreateSetting(ctx *web.Context, req domain.CreateSettingRequest) error {
 	setting := &domain.NotificationSetting{
-		Name:           req.Name,
-		Channel:        req.Channel,
-		Enabled:        req.Enabled,
-		DingTalkConfig: &req.DingTalkConfig,
-		MaxRetry:       req.MaxRetry,
-		Timeout:        req.Timeout,
-		Description:    req.Description,
+		Name:         req.Name,
+		Channel:      req.Channel,
+		Enabled:      req.Enabled,
+		MaxRetry:     req.MaxRetry,
+		Timeout:      req.Timeout,
+		Description:  req.Description,
+		RoutingRules: req.RoutingRules,
 	}
+	setChannelConfig(setting, &req.DingTalkConfig, &req.EmailConfig, &req.WebhookConfig)
 
 	createdSetting, err := h.usecase.CreateSetting(ctx.Request().Context(), setting)
 	if err != nil {
@@ -139,15 +141,16 @@ func (h *NotificationSettingHandler) UpdateSetting(ctx *web.Context, req domain.
 	}
 
 	setting := &domain.NotificationSetting{
-		ID:             id,
-		Name:           req.Name,
-		Channel:        req.Channel,
-		Enabled:        req.Enabled,
-		DingTalkConfig: &req.DingTalkConfig,
-		MaxRetry:       req.MaxRetry,
-		Timeout:        req.Timeout,
-		Description:    req.Description,
+		ID:           id,
+		Name:         req.Name,
+		Channel:      req.Channel,
+		Enabled:      req.Enabled,
+		MaxRetry:     req.MaxRetry,
+		Timeout:      req.Timeout,
+		Description:  req.Description,
+		RoutingRules: req.RoutingRules,
 	}
+	setChannelConfig(setting, &req.DingTalkConfig, &req.EmailConfig, &req.WebhookConfig)
 
 	if err := h.usecase.UpdateSetting(ctx.Request().Context(), setting); err != nil {
 		h.logger.Error("update notification setting failed", "id", idStr, "error", err)
@@ -300,3 +303,20 @@ func (h *NotificationSettingHandler) GetSettingsByChannel(ctx *web.Context, req
 		Settings: settings,
 	})
 }
+
+// setChannelConfig 根据通知渠道只保留对应渠道的配置
+func setChannelConfig(
+	setting *domain.NotificationSetting,
+	dingTalkConfig *domain.NotificationDingTalkConfig,
+	emailConfig *domain.NotificationEmailConfig,
+	webhookConfig *domain.NotificationWebhookConfig,
+) {
+	switch setting.Channel {
+	case consts.NotificationChannelDingTalk:
+		setting.DingTalkConfig = dingTalkConfig
+	case consts.NotificationChannelEmail:
+		setting.EmailConfig = emailConfig
+	case consts.NotificationChannelWebhook:
+		setting.WebhookConfig = webhookConfig
+	}
+}
@@ -39,6 +39,7 @@ func (r *notificationEventRepo) Create(ctx context.Context, event *domain.Notifi
 		SetMaxRetry(event.MaxRetry).
 		SetNillableLastError(&event.LastError).
 		SetNillableTraceID(&event.TraceID).
+		SetNillableSettingID(event.SettingID).
 		SetNillableScheduledAt(event.ScheduledAt).
 		SetCreatedAt(event.CreatedAt).
 		SetUpdatedAt(event.UpdatedAt).
@@ -69,6 +70,21 @@ func (r *notificationEventRepo) GetByTraceID(ctx context.Context, traceID string
 	return event, nil
 }
 
+// GetByTraceIDAndSetting 根据TraceID与通知设置获取通知事件
+func (r *notificationEventRepo) GetByTraceIDAndSetting(ctx context.Context, traceID string, settingID uuid.UUID) (*db.NotificationEvent, error) {
+	event, err := r.client.NotificationEvent.Query().
+		Where(
+			notificationevent.TraceID(traceID),
+			notificationevent.SettingID(settingID),
+		).
+		First(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
 // GetPendingEvents 获取待处理的通知事件
 func (r *notificationEventRepo) GetPendingEvents(ctx context.Context, limit int) ([]*db.NotificationEvent, error) {
 	events, err := r.client.NotificationEvent.Query().
@@ -175,6 +191,17 @@ func (r *notificationEventRepo) IncrementRetryCount(ctx context.Context, id uuid
 	return err
 }
 
+// ResetForReplay 重置状态与重试次数，用于从死信队列重放
+func (r *notificationEventRepo) ResetForReplay(ctx context.Context, id uuid.UUID) error {
+	_, err := r.client.NotificationEvent.UpdateOneID(id).
+		SetStatus(consts.NotificationStatusPending).
+		SetRetryCount(0).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+
+	return err
+}
+
 // GetEventsByType 根据事件类型获取事件列表
 func (r *notificationEventRepo) GetEventsByType(ctx context.Context, eventType consts.NotificationEventType, limit int) ([]*db.NotificationEvent, error) {
 	events, err := r.client.NotificationEvent.Query().
@@ -205,6 +232,11 @@ func (r *notificationEventRepo) GetEventsByStatus(ctx context.Context, status co
 	return events, nil
 }
 
+// Delete 删除通知事件
+func (r *notificationEventRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.client.NotificationEvent.DeleteOneID(id).Exec(ctx)
+}
+
 // DeleteOldEvents 删除旧事件
 func (r *notificationEventRepo) DeleteOldEvents(ctx context.Context, before time.Time) (int, error) {
 	return r.client.NotificationEvent.Delete().
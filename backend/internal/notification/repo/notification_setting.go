@@ -12,6 +12,7 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db"
 	"github.com/chaitin/WhaleHire/backend/db/notificationsetting"
 	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/ent/types"
 )
 
 // notificationSettingRepo 通知设置仓储实现
@@ -47,6 +48,32 @@ func (r *notificationSettingRepo) Create(ctx context.Context, setting *domain.No
 		create.SetDingtalkConfig(configMap)
 	}
 
+	if setting.EmailConfig != nil {
+		configMap := map[string]interface{}{
+			"smtp_host":  setting.EmailConfig.SMTPHost,
+			"smtp_port":  setting.EmailConfig.SMTPPort,
+			"username":   setting.EmailConfig.Username,
+			"password":   setting.EmailConfig.Password,
+			"from":       setting.EmailConfig.From,
+			"from_name":  setting.EmailConfig.FromName,
+			"recipients": setting.EmailConfig.Recipients,
+			"use_tls":    setting.EmailConfig.UseTLS,
+		}
+		create.SetEmailConfig(configMap)
+	}
+
+	if setting.WebhookConfig != nil {
+		configMap := map[string]interface{}{
+			"url":    setting.WebhookConfig.URL,
+			"secret": setting.WebhookConfig.Secret,
+		}
+		create.SetWebhookConfig(configMap)
+	}
+
+	if len(setting.RoutingRules) > 0 {
+		create.SetRoutingRules(toRoutingRuleTypes(setting.RoutingRules))
+	}
+
 	entity, err := create.Save(ctx)
 	if err != nil {
 		return nil, err
@@ -173,6 +200,38 @@ func (r *notificationSettingRepo) Update(ctx context.Context, setting *domain.No
 		update.ClearDingtalkConfig()
 	}
 
+	if setting.EmailConfig != nil {
+		configMap := map[string]interface{}{
+			"smtp_host":  setting.EmailConfig.SMTPHost,
+			"smtp_port":  setting.EmailConfig.SMTPPort,
+			"username":   setting.EmailConfig.Username,
+			"password":   setting.EmailConfig.Password,
+			"from":       setting.EmailConfig.From,
+			"from_name":  setting.EmailConfig.FromName,
+			"recipients": setting.EmailConfig.Recipients,
+			"use_tls":    setting.EmailConfig.UseTLS,
+		}
+		update.SetEmailConfig(configMap)
+	} else {
+		update.ClearEmailConfig()
+	}
+
+	if setting.WebhookConfig != nil {
+		configMap := map[string]interface{}{
+			"url":    setting.WebhookConfig.URL,
+			"secret": setting.WebhookConfig.Secret,
+		}
+		update.SetWebhookConfig(configMap)
+	} else {
+		update.ClearWebhookConfig()
+	}
+
+	if len(setting.RoutingRules) > 0 {
+		update.SetRoutingRules(toRoutingRuleTypes(setting.RoutingRules))
+	} else {
+		update.ClearRoutingRules()
+	}
+
 	entity, err := update.Save(ctx)
 	if err != nil {
 		return nil, err
@@ -198,3 +257,25 @@ func (r *notificationSettingRepo) GetEnabledSettings(ctx context.Context) ([]*db
 
 	return entities, nil
 }
+
+// toRoutingRuleTypes 将领域路由规则转换为持久化结构
+func toRoutingRuleTypes(rules []*domain.NotificationRoutingRule) []*types.NotificationRoutingRule {
+	result := make([]*types.NotificationRoutingRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+		r := &types.NotificationRoutingRule{}
+		for _, eventType := range rule.EventTypes {
+			r.EventTypes = append(r.EventTypes, string(eventType))
+		}
+		for _, id := range rule.DepartmentIDs {
+			r.DepartmentIDs = append(r.DepartmentIDs, id.String())
+		}
+		for _, id := range rule.JobPositionIDs {
+			r.JobPositionIDs = append(r.JobPositionIDs, id.String())
+		}
+		result = append(result, r)
+	}
+	return result
+}
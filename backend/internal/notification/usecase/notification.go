@@ -2,8 +2,10 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,8 +17,9 @@ import (
 
 // notificationUsecase 通知用例实现
 type notificationUsecase struct {
-	repo        domain.NotificationEventRepo
-	settingRepo domain.NotificationSettingUsecase
+	repo           domain.NotificationEventRepo
+	settingRepo    domain.NotificationSettingUsecase
+	jobProfileRepo domain.JobProfileRepo
 
 	producer queue.Producer
 	logger   *slog.Logger
@@ -26,14 +29,16 @@ type notificationUsecase struct {
 func NewNotificationUsecase(
 	repo domain.NotificationEventRepo,
 	settingRepo domain.NotificationSettingUsecase,
+	jobProfileRepo domain.JobProfileRepo,
 	producer queue.Producer,
 	logger *slog.Logger,
 ) domain.NotificationUsecase {
 	return &notificationUsecase{
-		repo:        repo,
-		settingRepo: settingRepo,
-		producer:    producer,
-		logger:      logger,
+		repo:           repo,
+		settingRepo:    settingRepo,
+		jobProfileRepo: jobProfileRepo,
+		producer:       producer,
+		logger:         logger,
 	}
 }
 
@@ -47,15 +52,6 @@ func (u *notificationUsecase) PublishEventWithDelay(ctx context.Context, payload
 	// 生成幂等键
 	idempotencyKey := u.generateIdempotencyKey(payload)
 
-	// 检查是否已存在相同的事件
-	if existingEvent, err := u.repo.GetByTraceID(ctx, idempotencyKey); err == nil && existingEvent != nil {
-		u.logger.InfoContext(ctx, "Event already exists, skipping",
-			slog.String("trace_id", idempotencyKey),
-			slog.String("event_id", existingEvent.ID.String()),
-		)
-		return nil
-	}
-
 	// 获取启用的通知设置
 	enabledSettings, err := u.settingRepo.GetEnabledSettings(ctx)
 	if err != nil {
@@ -73,17 +69,72 @@ func (u *notificationUsecase) PublishEventWithDelay(ctx context.Context, payload
 		return nil
 	}
 
-	// 使用第一个启用的通知设置（后续可以根据业务需求选择特定的通道）
-	setting := enabledSettings[0]
+	// 按路由规则筛选需要接收该事件的通知设置
+	routingCtx := u.buildRoutingContext(ctx, payload)
+	var matchedSettings []*domain.NotificationSetting
+	for _, setting := range enabledSettings {
+		if setting.MatchesEvent(routingCtx) {
+			matchedSettings = append(matchedSettings, setting)
+		}
+	}
 
+	if len(matchedSettings) == 0 {
+		u.logger.InfoContext(ctx, "No notification settings matched routing rules, skipping event",
+			slog.String("trace_id", idempotencyKey),
+			slog.String("event_type", string(payload.GetEventType())),
+		)
+		return nil
+	}
+
+	// 为每个匹配的通知设置创建一条事件，由 Worker 按渠道分别投递。
+	// 同一事件在各设置下分别判断幂等，部分设置发布失败时返回错误，重试只会补发未发布的设置
+	var publishErrs []error
+	for _, setting := range matchedSettings {
+		if existingEvent, err := u.repo.GetByTraceIDAndSetting(ctx, idempotencyKey, setting.ID); err == nil && existingEvent != nil {
+			u.logger.InfoContext(ctx, "Event already exists for setting, skipping",
+				slog.String("trace_id", idempotencyKey),
+				slog.String("setting_id", setting.ID.String()),
+				slog.String("event_id", existingEvent.ID.String()),
+			)
+			continue
+		}
+		if err := u.publishToSetting(ctx, payload, setting, idempotencyKey, delay); err != nil {
+			publishErrs = append(publishErrs, err)
+		}
+	}
+
+	return errors.Join(publishErrs...)
+}
+
+// publishToSetting 为单个通知设置创建事件并投递到消息队列
+func (u *notificationUsecase) publishToSetting(
+	ctx context.Context,
+	payload domain.NotificationPayload,
+	setting *domain.NotificationSetting,
+	idempotencyKey string,
+	delay time.Duration,
+) error {
 	// 根据不同类型的 Channel 确定 Target
 	var target string
 	switch setting.Channel {
 	case consts.NotificationChannelDingTalk:
-		target = setting.DingTalkConfig.WebhookURL
+		if setting.DingTalkConfig != nil {
+			target = setting.DingTalkConfig.WebhookURL
+		}
+	case consts.NotificationChannelEmail:
+		if setting.EmailConfig != nil {
+			target = strings.Join(setting.EmailConfig.Recipients, ",")
+		}
+	case consts.NotificationChannelWebhook:
+		// 只投递到配置中的地址，负载中的回调地址不能作为投递目标，否则会用配置密钥为任意地址签名
+		if setting.WebhookConfig != nil {
+			target = setting.WebhookConfig.URL
+		}
 	default:
 	}
 
+	settingID := setting.ID
+
 	// 创建通知事件
 	event := &domain.NotificationEvent{
 		ID:         uuid.New(),
@@ -97,6 +148,7 @@ func (u *notificationUsecase) PublishEventWithDelay(ctx context.Context, payload
 		MaxRetry:   setting.MaxRetry,
 		Timeout:    setting.Timeout,
 		TraceID:    idempotencyKey,
+		SettingID:  &settingID,
 		CreatedAt:  time.Now(),
 		UpdatedAt:  time.Now(),
 	}
@@ -134,13 +186,20 @@ func (u *notificationUsecase) PublishEventWithDelay(ctx context.Context, payload
 		queueData["scheduled_at"] = event.ScheduledAt.Unix()
 	}
 
-	streamName := "notification:events"
+	streamName := string(consts.QueueStreamNotificationEvents)
 	if err := u.producer.Publish(ctx, streamName, queueData); err != nil {
 		u.logger.ErrorContext(ctx, "Failed to publish event to queue",
 			slog.String("error", err.Error()),
 			slog.String("event_id", event.ID.String()),
 			slog.String("stream", streamName),
 		)
+		// 删除未投递的事件记录，避免重试时被幂等检查跳过
+		if delErr := u.repo.Delete(ctx, event.ID); delErr != nil {
+			u.logger.ErrorContext(ctx, "Failed to delete unpublished notification event",
+				slog.String("error", delErr.Error()),
+				slog.String("event_id", event.ID.String()),
+			)
+		}
 		return fmt.Errorf("failed to publish event to queue: %w", err)
 	}
 
@@ -148,12 +207,49 @@ func (u *notificationUsecase) PublishEventWithDelay(ctx context.Context, payload
 		slog.String("event_id", event.ID.String()),
 		slog.String("event_type", string(event.EventType)),
 		slog.String("channel", string(event.Channel)),
+		slog.String("setting_id", settingID.String()),
 		slog.String("trace_id", event.TraceID),
 	)
 
 	return nil
 }
 
+// buildRoutingContext 根据负载关联的岗位构建路由上下文，并解析岗位所属部门
+func (u *notificationUsecase) buildRoutingContext(ctx context.Context, payload domain.NotificationPayload) *domain.NotificationRoutingContext {
+	rc := &domain.NotificationRoutingContext{EventType: payload.GetEventType()}
+
+	jobPayload, ok := payload.(domain.NotificationJobPayload)
+	if !ok {
+		return rc
+	}
+	rc.JobPositionIDs = jobPayload.GetJobPositionIDs()
+	if len(rc.JobPositionIDs) == 0 {
+		return rc
+	}
+
+	ids := make([]string, 0, len(rc.JobPositionIDs))
+	for _, id := range rc.JobPositionIDs {
+		ids = append(ids, id.String())
+	}
+	jobs, err := u.jobProfileRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		u.logger.WarnContext(ctx, "Failed to resolve job departments for notification routing",
+			slog.String("error", err.Error()),
+		)
+		return rc
+	}
+
+	seen := make(map[uuid.UUID]bool, len(jobs))
+	for _, job := range jobs {
+		if !seen[job.DepartmentID] {
+			seen[job.DepartmentID] = true
+			rc.DepartmentIDs = append(rc.DepartmentIDs, job.DepartmentID)
+		}
+	}
+
+	return rc
+}
+
 // MarkAsDelivered 标记事件为已投递
 func (u *notificationUsecase) MarkAsDelivered(ctx context.Context, eventID uuid.UUID) error {
 	if err := u.repo.MarkAsDelivered(ctx, eventID); err != nil {
@@ -215,7 +311,7 @@ func (u *notificationUsecase) RetryEvent(ctx context.Context, eventID uuid.UUID)
 		"retry_count": dbEvent.RetryCount + 1,
 	}
 
-	streamName := "notification:events"
+	streamName := string(consts.QueueStreamNotificationEvents)
 	if err := u.producer.Publish(ctx, streamName, queueData); err != nil {
 		return fmt.Errorf("failed to republish event to queue: %w", err)
 	}
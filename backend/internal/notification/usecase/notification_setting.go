@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/mail"
+	"net/url"
 
 	"github.com/google/uuid"
 
@@ -215,9 +217,83 @@ func (u *notificationSettingUsecase) ValidateConfig(ctx context.Context, setting
 		if setting.DingTalkConfig.WebhookURL == "" {
 			return fmt.Errorf("钉钉Webhook URL不能为空")
 		}
+	case consts.NotificationChannelEmail:
+		if err := u.validateEmailConfig(setting.EmailConfig); err != nil {
+			return err
+		}
+	case consts.NotificationChannelWebhook:
+		if err := u.validateWebhookConfig(setting.WebhookConfig); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("不支持的通知通道: %s", setting.Channel)
 	}
 
+	return u.validateRoutingRules(setting.RoutingRules)
+}
+
+// validateRoutingRules 验证路由规则，空规则表示接收所有事件
+func (u *notificationSettingUsecase) validateRoutingRules(rules []*domain.NotificationRoutingRule) error {
+	for i, rule := range rules {
+		if rule == nil {
+			return fmt.Errorf("第%d条路由规则不能为空", i+1)
+		}
+		for _, eventType := range rule.EventTypes {
+			switch eventType {
+			case consts.NotificationEventTypeResumeParseCompleted,
+				consts.NotificationEventTypeBatchResumeParseCompleted,
+				consts.NotificationEventTypeJobMatchingCompleted,
+				consts.NotificationEventTypeScreeningTaskCompleted:
+			default:
+				return fmt.Errorf("第%d条路由规则包含不支持的事件类型: %s", i+1, eventType)
+			}
+		}
+	}
+	return nil
+}
+
+// validateEmailConfig 验证邮件通知配置
+func (u *notificationSettingUsecase) validateEmailConfig(cfg *domain.NotificationEmailConfig) error {
+	if cfg == nil {
+		return fmt.Errorf("邮件通知配置不能为空")
+	}
+	if cfg.SMTPHost == "" {
+		return fmt.Errorf("SMTP服务器地址不能为空")
+	}
+	if cfg.SMTPPort <= 0 || cfg.SMTPPort > 65535 {
+		return fmt.Errorf("SMTP端口无效: %d", cfg.SMTPPort)
+	}
+	if cfg.Username != "" && cfg.Password == "" {
+		return fmt.Errorf("配置了SMTP用户名时密码不能为空")
+	}
+	if _, err := mail.ParseAddress(cfg.From); err != nil {
+		return fmt.Errorf("发件人邮箱地址无效: %s", cfg.From)
+	}
+	if len(cfg.Recipients) == 0 {
+		return fmt.Errorf("收件人列表不能为空")
+	}
+	for _, recipient := range cfg.Recipients {
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			return fmt.Errorf("收件人邮箱地址无效: %s", recipient)
+		}
+	}
+	return nil
+}
+
+// validateWebhookConfig 验证 Webhook 通知配置
+func (u *notificationSettingUsecase) validateWebhookConfig(cfg *domain.NotificationWebhookConfig) error {
+	if cfg == nil {
+		return fmt.Errorf("Webhook通知配置不能为空")
+	}
+	if cfg.URL == "" {
+		return fmt.Errorf("Webhook URL不能为空")
+	}
+	parsed, err := url.Parse(cfg.URL)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return fmt.Errorf("Webhook URL无效，仅支持 http/https: %s", cfg.URL)
+	}
+	if len(cfg.Secret) < 16 {
+		return fmt.Errorf("Webhook签名密钥长度不能少于16个字符")
+	}
 	return nil
 }
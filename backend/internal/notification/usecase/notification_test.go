@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+type fakeEventRepo struct {
+	domain.NotificationEventRepo
+	events []*domain.NotificationEvent
+}
+
+func (f *fakeEventRepo) Create(_ context.Context, event *domain.NotificationEvent) error {
+	f.events = append(f.events, event)
+	return nil
+}
+
+func (f *fakeEventRepo) Delete(_ context.Context, id uuid.UUID) error {
+	for i, event := range f.events {
+		if event.ID == id {
+			f.events = append(f.events[:i], f.events[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (f *fakeEventRepo) GetByTraceIDAndSetting(_ context.Context, traceID string, settingID uuid.UUID) (*db.NotificationEvent, error) {
+	for _, event := range f.events {
+		if event.TraceID == traceID && event.SettingID != nil && *event.SettingID == settingID {
+			return &db.NotificationEvent{ID: event.ID, TraceID: event.TraceID}, nil
+		}
+	}
+	return nil, &db.NotFoundError{}
+}
+
+type fakeSettingUsecase struct {
+	domain.NotificationSettingUsecase
+	settings []*domain.NotificationSetting
+}
+
+func (f *fakeSettingUsecase) GetEnabledSettings(context.Context) ([]*domain.NotificationSetting, error) {
+	return f.settings, nil
+}
+
+type fakeJobProfileRepo struct {
+	domain.JobProfileRepo
+	jobs []*db.JobPosition
+}
+
+func (f *fakeJobProfileRepo) GetByIDs(context.Context, []string) ([]*db.JobPosition, error) {
+	return f.jobs, nil
+}
+
+// fakeProducer 前 okBeforeFailure 次投递成功，随后 failures 次投递失败
+type fakeProducer struct {
+	okBeforeFailure int
+	failures        int
+	published       int
+}
+
+func (f *fakeProducer) Publish(context.Context, string, map[string]interface{}) error {
+	if f.okBeforeFailure > 0 {
+		f.okBeforeFailure--
+		f.published++
+		return nil
+	}
+	if f.failures > 0 {
+		f.failures--
+		return errors.New("queue unavailable")
+	}
+	f.published++
+	return nil
+}
+
+func (f *fakeProducer) PublishWithID(ctx context.Context, stream, _ string, data map[string]interface{}) error {
+	return f.Publish(ctx, stream, data)
+}
+
+func (f *fakeProducer) PublishBatch(ctx context.Context, stream string, messages []map[string]interface{}) error {
+	for _, data := range messages {
+		if err := f.Publish(ctx, stream, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeProducer) Close() error { return nil }
+
+func TestBuildRoutingContextAndMatchesEvent(t *testing.T) {
+	jobID, deptID, otherDeptID := uuid.New(), uuid.New(), uuid.New()
+	u := &notificationUsecase{
+		jobProfileRepo: &fakeJobProfileRepo{jobs: []*db.JobPosition{{ID: jobID, DepartmentID: deptID}}},
+		logger:         slog.Default(),
+	}
+
+	rc := u.buildRoutingContext(context.Background(), domain.ScreeningTaskCompletedPayload{TaskID: uuid.New(), JobID: jobID})
+	assert.Equal(t, consts.NotificationEventTypeScreeningTaskCompleted, rc.EventType)
+	assert.Equal(t, []uuid.UUID{jobID}, rc.JobPositionIDs)
+	assert.Equal(t, []uuid.UUID{deptID}, rc.DepartmentIDs)
+
+	// 未配置路由规则时接收所有事件
+	assert.True(t, (&domain.NotificationSetting{}).MatchesEvent(rc))
+
+	byDept := &domain.NotificationSetting{RoutingRules: []*domain.NotificationRoutingRule{{DepartmentIDs: []uuid.UUID{deptID}}}}
+	assert.True(t, byDept.MatchesEvent(rc))
+
+	otherDept := &domain.NotificationSetting{RoutingRules: []*domain.NotificationRoutingRule{{DepartmentIDs: []uuid.UUID{otherDeptID}}}}
+	assert.False(t, otherDept.MatchesEvent(rc))
+
+	// 规则之间为"或"关系，规则内条件为"与"关系
+	mixed := &domain.NotificationSetting{RoutingRules: []*domain.NotificationRoutingRule{
+		{EventTypes: []consts.NotificationEventType{consts.NotificationEventTypeResumeParseCompleted}, DepartmentIDs: []uuid.UUID{deptID}},
+		{EventTypes: []consts.NotificationEventType{consts.NotificationEventTypeScreeningTaskCompleted}, JobPositionIDs: []uuid.UUID{jobID}},
+	}}
+	assert.True(t, mixed.MatchesEvent(rc))
+
+	// 无法关联岗位的事件不匹配限定了部门的规则
+	resumeRC := u.buildRoutingContext(context.Background(), domain.ResumeParseCompletedPayload{ResumeID: uuid.New()})
+	assert.Empty(t, resumeRC.DepartmentIDs)
+	assert.False(t, byDept.MatchesEvent(resumeRC))
+}
+
+func TestPublishEventRetriesOnlyUnpublishedSettings(t *testing.T) {
+	settingA := &domain.NotificationSetting{ID: uuid.New(), Channel: consts.NotificationChannelWebhook}
+	settingB := &domain.NotificationSetting{ID: uuid.New(), Channel: consts.NotificationChannelWebhook}
+	repo := &fakeEventRepo{}
+	// 设置 A 投递成功，设置 B 投递失败
+	producer := &fakeProducer{okBeforeFailure: 1, failures: 1}
+	u := &notificationUsecase{
+		repo:           repo,
+		settingRepo:    &fakeSettingUsecase{settings: []*domain.NotificationSetting{settingA, settingB}},
+		jobProfileRepo: &fakeJobProfileRepo{},
+		producer:       producer,
+		logger:         slog.Default(),
+	}
+	payload := domain.ScreeningTaskCompletedPayload{TaskID: uuid.New(), JobID: uuid.New()}
+
+	// 部分设置发布失败时返回错误，未投递的事件记录被删除
+	require.Error(t, u.PublishEvent(context.Background(), payload))
+	require.Len(t, repo.events, 1)
+	assert.Equal(t, settingA.ID, *repo.events[0].SettingID)
+
+	// 重试只补发设置 B，不重复发布设置 A
+	require.NoError(t, u.PublishEvent(context.Background(), payload))
+	require.Len(t, repo.events, 2)
+	assert.Equal(t, settingB.ID, *repo.events[1].SettingID)
+	assert.Equal(t, 2, producer.published)
+
+	// 全部设置已发布时再次发布为空操作
+	require.NoError(t, u.PublishEvent(context.Background(), payload))
+	assert.Len(t, repo.events, 2)
+	assert.Equal(t, 2, producer.published)
+}
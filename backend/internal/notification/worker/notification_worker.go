@@ -2,6 +2,7 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -16,22 +17,34 @@ import (
 // NotificationWorker 通知工作器
 type NotificationWorker struct {
 	consumer        queue.Consumer
+	producer        queue.Producer
+	deadLetter      queue.DeadLetterQueue
 	repo            domain.NotificationEventRepo
 	dingTalkAdapter *adapter.DingTalkAdapter
+	emailAdapter    *adapter.EmailAdapter
+	webhookAdapter  *adapter.WebhookAdapter
 	logger          *slog.Logger
 }
 
 // NewNotificationWorker 创建通知工作器
 func NewNotificationWorker(
 	consumer queue.Consumer,
+	producer queue.Producer,
+	deadLetter queue.DeadLetterQueue,
 	repo domain.NotificationEventRepo,
 	dingTalkAdapter *adapter.DingTalkAdapter,
+	emailAdapter *adapter.EmailAdapter,
+	webhookAdapter *adapter.WebhookAdapter,
 	logger *slog.Logger,
 ) *NotificationWorker {
 	return &NotificationWorker{
 		consumer:        consumer,
+		producer:        producer,
+		deadLetter:      deadLetter,
 		repo:            repo,
 		dingTalkAdapter: dingTalkAdapter,
+		emailAdapter:    emailAdapter,
+		webhookAdapter:  webhookAdapter,
 		logger:          logger,
 	}
 }
@@ -41,7 +54,7 @@ func (w *NotificationWorker) Start(ctx context.Context) error {
 	w.logger.InfoContext(ctx, "Starting notification worker")
 
 	// 订阅通知事件流
-	streamName := "notification:events"
+	streamName := string(consts.QueueStreamNotificationEvents)
 	consumerGroup := "notification-worker"
 	consumerName := "worker-1"
 
@@ -90,6 +103,7 @@ func (w *NotificationWorker) handleMessage(ctx context.Context, msg queue.Messag
 	w.logger.InfoContext(ctx, "Processing notification message",
 		slog.String("message_id", msg.ID),
 		slog.String("stream", msg.Stream),
+		slog.Int64("delivery_count", msg.DeliveryCount),
 	)
 
 	// 解析消息数据
@@ -181,6 +195,10 @@ func (w *NotificationWorker) sendNotification(ctx context.Context, event *domain
 	switch event.Channel {
 	case consts.NotificationChannelDingTalk:
 		return w.dingTalkAdapter.SendNotification(ctx, event)
+	case consts.NotificationChannelEmail:
+		return w.emailAdapter.SendNotification(ctx, event)
+	case consts.NotificationChannelWebhook:
+		return w.webhookAdapter.SendNotification(ctx, event)
 	default:
 		return fmt.Errorf("unsupported notification channel: %s", event.Channel)
 	}
@@ -196,6 +214,14 @@ func (w *NotificationWorker) handleSendError(ctx context.Context, msg queue.Mess
 		slog.String("error", sendErr.Error()),
 	)
 
+	// 不可重试的错误（如 Webhook 返回 4xx）直接标记为失败
+	var retryable interface{ Retryable() bool }
+	if errors.As(sendErr, &retryable) && !retryable.Retryable() {
+		w.markAsFailed(ctx, msg, event, sendErr.Error())
+		w.ackMessage(ctx, msg)
+		return
+	}
+
 	// 增加重试次数
 	if err := w.repo.IncrementRetryCount(ctx, event.ID, sendErr.Error()); err != nil {
 		w.logger.ErrorContext(ctx, "Failed to increment retry count",
@@ -205,13 +231,85 @@ func (w *NotificationWorker) handleSendError(ctx context.Context, msg queue.Mess
 		)
 	}
 
-	// 确认消息，避免无限重试
+	// 未超过最大重试次数时重新投递，否则标记为最终失败
+	if event.RetryCount+1 < event.MaxRetry {
+		w.requeue(ctx, event)
+	} else {
+		w.markAsFailed(ctx, msg, event, fmt.Sprintf("exceeded max retry (%d): %s", event.MaxRetry, sendErr.Error()))
+	}
+
+	// 确认当前消息，重试通过重新投递的新消息进行
 	w.ackMessage(ctx, msg)
 }
 
+// markAsFailed 将事件标记为最终失败，并投递到死信队列供运维排查与重放
+func (w *NotificationWorker) markAsFailed(ctx context.Context, msg queue.Message, event *domain.NotificationEvent, errorMsg string) {
+	if err := w.repo.UpdateStatus(ctx, event.ID, consts.NotificationStatusFailed, errorMsg); err != nil {
+		w.logger.ErrorContext(ctx, "Failed to mark event as failed",
+			slog.String("message_id", msg.ID),
+			slog.String("event_id", event.ID.String()),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	deadMsg := queue.Message{
+		ID:      msg.ID,
+		Stream:  msg.Stream,
+		Data:    eventQueueData(event, event.RetryCount+1),
+		TraceID: event.TraceID,
+	}
+	if err := w.deadLetter.SendToDeadLetter(ctx, string(consts.QueueStreamNotificationEvents), deadMsg, errorMsg); err != nil {
+		w.logger.ErrorContext(ctx, "Failed to send notification event to dead letter queue",
+			slog.String("message_id", msg.ID),
+			slog.String("event_id", event.ID.String()),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	w.logger.WarnContext(ctx, "Notification event moved to dead letter queue",
+		slog.String("event_id", event.ID.String()),
+		slog.String("channel", string(event.Channel)),
+		slog.String("reason", errorMsg),
+	)
+}
+
+// requeue 重新投递事件到通知队列
+func (w *NotificationWorker) requeue(ctx context.Context, event *domain.NotificationEvent) {
+	queueData := eventQueueData(event, event.RetryCount+1)
+
+	if err := w.producer.Publish(ctx, string(consts.QueueStreamNotificationEvents), queueData); err != nil {
+		w.logger.ErrorContext(ctx, "Failed to requeue notification event",
+			slog.String("event_id", event.ID.String()),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	w.logger.InfoContext(ctx, "Notification event requeued for retry",
+		slog.String("event_id", event.ID.String()),
+		slog.Int("retry_count", event.RetryCount+1),
+		slog.Int("max_retry", event.MaxRetry),
+	)
+}
+
+// eventQueueData 构建通知事件的队列消息体
+func eventQueueData(event *domain.NotificationEvent, retryCount int) map[string]interface{} {
+	return map[string]interface{}{
+		"event_id":    event.ID.String(),
+		"event_type":  string(event.EventType),
+		"channel":     string(event.Channel),
+		"payload":     event.Payload,
+		"template_id": event.TemplateID,
+		"target":      event.Target,
+		"trace_id":    event.TraceID,
+		"retry_count": retryCount,
+	}
+}
+
 // ackMessage 确认消息
 func (w *NotificationWorker) ackMessage(ctx context.Context, msg queue.Message) {
-	streamName := "notification:events"
+	streamName := string(consts.QueueStreamNotificationEvents)
 	consumerGroup := "notification-worker"
 
 	if err := w.consumer.Ack(ctx, streamName, consumerGroup, msg.ID); err != nil {
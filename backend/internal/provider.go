@@ -7,6 +7,8 @@ import (
 	auditV1 "github.com/chaitin/WhaleHire/backend/internal/audit/handler/v1"
 	auditrepo "github.com/chaitin/WhaleHire/backend/internal/audit/repo"
 	auditusecase "github.com/chaitin/WhaleHire/backend/internal/audit/usecase"
+	deadletterV1 "github.com/chaitin/WhaleHire/backend/internal/deadletter/handler/v1"
+	deadletterusecase "github.com/chaitin/WhaleHire/backend/internal/deadletter/usecase"
 	departmentV1 "github.com/chaitin/WhaleHire/backend/internal/department/handler/v1"
 	departmentrepo "github.com/chaitin/WhaleHire/backend/internal/department/repo"
 	departmentusecase "github.com/chaitin/WhaleHire/backend/internal/department/usecase"
@@ -41,6 +43,7 @@ import (
 	screeningrepo "github.com/chaitin/WhaleHire/backend/internal/screening/repo"
 	screeningservice "github.com/chaitin/WhaleHire/backend/internal/screening/service"
 	screeningusecase "github.com/chaitin/WhaleHire/backend/internal/screening/usecase"
+	screeningworker "github.com/chaitin/WhaleHire/backend/internal/screening/worker"
 	universityV1 "github.com/chaitin/WhaleHire/backend/internal/university/handler/v1"
 	universityrepo "github.com/chaitin/WhaleHire/backend/internal/university/repo"
 	universityservice "github.com/chaitin/WhaleHire/backend/internal/university/service"
@@ -94,6 +97,7 @@ var Provider = wire.NewSet(
 	resumeusecase.NewResumeUsecase,
 	resumeservice.NewParserService,
 	resumeservice.NewStorageService,
+	resumeservice.NewEmbeddingService,
 	jobprofileV1.NewJobProfileHandler,
 	jobprofilerepo.NewJobProfileRepo,
 	jobprofilerepo.NewJobSkillMetaRepo,
@@ -111,10 +115,16 @@ var Provider = wire.NewSet(
 	screeningrepo.NewScreeningRepo,
 	screeningrepo.NewScreeningNodeRunRepo,
 	screeningrepo.NewWeightTemplateRepo,
+	screeningrepo.NewScreeningDimensionRepo,
+	screeningrepo.NewScreeningScheduleRepo,
 	screeningservice.NewMatchingService,
 	screeningservice.NewWeightPreviewService,
+	screeningservice.NewShortlistService,
+	screeningservice.NewJobRecommendService,
+	screeningservice.NewReportService,
 	screeningusecase.NewScreeningUsecase,
 	screeningV1.NewScreeningHandler,
+	screeningworker.NewScreeningWorker,
 	universityV1.NewUniversityHandler,
 	universityrepo.NewUniversityRepo,
 	universityusecase.NewUniversityUsecase,
@@ -125,7 +135,11 @@ var Provider = wire.NewSet(
 	notificationusecase.NewNotificationUsecase,
 	notificationusecase.NewNotificationSettingUsecase,
 	notificationadapter.NewDingTalkAdapter,
+	notificationadapter.NewEmailAdapter,
+	notificationadapter.NewWebhookAdapter,
 	notificationworker.NewNotificationWorker,
+	deadletterusecase.NewDeadLetterUsecase,
+	deadletterV1.NewDeadLetterHandler,
 	resumemailboxadapter.NewAdapterFactory,
 	resumemailboxsettingrepo.NewResumeMailboxSettingRepo,
 	resumemailboxsettingrepo.NewResumeMailboxCursorRepo,
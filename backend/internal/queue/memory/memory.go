@@ -0,0 +1,667 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chaitin/WhaleHire/backend/internal/queue"
+)
+
+const (
+	// defaultPendingTimeout 已投递未确认消息重新投递前的默认空闲时间
+	defaultPendingTimeout = 5 * time.Minute
+	// readBatchSize 每次读取的消息数量，与 Redis 实现保持一致
+	readBatchSize = 10
+	// subscribeBufferSize 订阅通道缓冲区大小
+	subscribeBufferSize = 100
+)
+
+// Options 进程内队列选项
+type Options struct {
+	PendingTimeout time.Duration // 已投递未确认消息重新投递前的空闲时间
+	PollInterval   time.Duration // 无新消息时检查待确认消息的间隔
+	MaxDeliveries  int64         // 最大投递次数，超过后自动转入死信队列，0 表示不限制
+}
+
+// MemoryQueue 进程内队列实现，语义对齐 Redis Streams：
+// 支持消费者组、消息确认、超时未确认消息重新投递以及死信队列。
+// 消息仅保存在内存中，进程重启后丢失，适用于单实例部署与集成测试。
+type MemoryQueue struct {
+	mu        sync.Mutex
+	streams   map[string]*stream
+	opts      *Options
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// stream 单个消息流
+type stream struct {
+	entries []entry
+	groups  map[string]*group
+	lastID  messageID
+	created time.Time
+	// notify 有新消息写入时关闭并替换，用于唤醒等待中的消费者
+	notify chan struct{}
+}
+
+// entry 流中的一条消息
+type entry struct {
+	id      messageID
+	data    map[string]interface{}
+	traceID string
+}
+
+// group 消费者组
+type group struct {
+	lastDelivered messageID
+	pending       map[messageID]*pendingEntry
+	consumers     map[string]struct{}
+}
+
+// pendingEntry 已投递但未确认的消息
+type pendingEntry struct {
+	consumer      string
+	deliveredAt   time.Time
+	deliveryCount int64
+}
+
+// messageID 与 Redis Stream 相同的 "<毫秒>-<序号>" 消息ID
+type messageID struct {
+	ms  int64
+	seq int64
+}
+
+// NewMemoryQueue 创建进程内队列实例
+func NewMemoryQueue(opts *Options) *MemoryQueue {
+	if opts == nil {
+		opts = &Options{}
+	}
+	if opts.PendingTimeout <= 0 {
+		opts.PendingTimeout = defaultPendingTimeout
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = time.Second
+	}
+
+	return &MemoryQueue{
+		streams: make(map[string]*stream),
+		opts:    opts,
+		closed:  make(chan struct{}),
+	}
+}
+
+// Publish 发布消息到指定流
+func (m *MemoryQueue) Publish(ctx context.Context, stream string, data map[string]interface{}) error {
+	return m.PublishWithID(ctx, stream, "*", data)
+}
+
+// PublishWithID 发布消息到指定流，指定消息ID
+func (m *MemoryQueue) PublishWithID(ctx context.Context, stream string, id string, data map[string]interface{}) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.appendLocked(stream, id, data)
+}
+
+// PublishBatch 批量发布消息
+func (m *MemoryQueue) PublishBatch(ctx context.Context, stream string, messages []map[string]interface{}) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, data := range messages {
+		if err := m.appendLocked(stream, "*", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Subscribe 订阅指定流，消费者组不存在时从流的起始位置创建
+func (m *MemoryQueue) Subscribe(ctx context.Context, stream string, group string, consumer string) (<-chan queue.Message, error) {
+	m.mu.Lock()
+	m.groupLocked(m.streamLocked(stream), group)
+	m.mu.Unlock()
+
+	msgChan := make(chan queue.Message, subscribeBufferSize)
+
+	go func() {
+		defer close(msgChan)
+
+		ticker := time.NewTicker(m.opts.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			messages, notify := m.read(stream, group, consumer)
+			for _, msg := range messages {
+				select {
+				case msgChan <- msg:
+				case <-ctx.Done():
+					return
+				case <-m.closed:
+					return
+				}
+			}
+			if len(messages) > 0 {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-m.closed:
+				return
+			case <-notify:
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return msgChan, nil
+}
+
+// Ack 确认消息处理完成
+func (m *MemoryQueue) Ack(ctx context.Context, stream string, group string, messageID string) error {
+	id, err := parseID(messageID)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.streams[stream]
+	if !ok {
+		return nil
+	}
+	g, ok := s.groups[group]
+	if !ok {
+		return nil
+	}
+	delete(g.pending, id)
+	s.compact()
+	return nil
+}
+
+// CreateStream 创建流
+func (m *MemoryQueue) CreateStream(ctx context.Context, stream string, options *queue.ProducerOptions) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s := m.streamLocked(stream)
+	if options != nil && options.MaxLen > 0 && int64(len(s.entries)) > options.MaxLen {
+		s.entries = append([]entry(nil), s.entries[int64(len(s.entries))-options.MaxLen:]...)
+	}
+	return nil
+}
+
+// DeleteStream 删除流
+func (m *MemoryQueue) DeleteStream(ctx context.Context, stream string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.streams, stream)
+	return nil
+}
+
+// StreamExists 检查流是否存在
+func (m *MemoryQueue) StreamExists(ctx context.Context, stream string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, ok := m.streams[stream]
+	return ok, nil
+}
+
+// GetStreamInfo 获取流信息
+func (m *MemoryQueue) GetStreamInfo(ctx context.Context, stream string) (*queue.StreamInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.streams[stream]
+	if !ok {
+		return nil, fmt.Errorf("stream not found: %s", stream)
+	}
+
+	info := &queue.StreamInfo{
+		Name:      stream,
+		Length:    int64(len(s.entries)),
+		LastID:    s.lastID.String(),
+		CreatedAt: s.created,
+		Groups:    make([]queue.GroupInfo, 0, len(s.groups)),
+	}
+	if len(s.entries) > 0 {
+		info.FirstID = s.entries[0].id.String()
+	}
+
+	names := make([]string, 0, len(s.groups))
+	for name := range s.groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		g := s.groups[name]
+		info.Pending += int64(len(g.pending))
+		info.Groups = append(info.Groups, queue.GroupInfo{
+			Name:            name,
+			Consumers:       int64(len(g.consumers)),
+			Pending:         int64(len(g.pending)),
+			Lag:             int64(len(s.entries) - s.search(messageID{ms: g.lastDelivered.ms, seq: g.lastDelivered.seq + 1})),
+			LastDeliveredID: g.lastDelivered.String(),
+		})
+	}
+	return info, nil
+}
+
+// SendToDeadLetter 发送消息到死信队列
+func (m *MemoryQueue) SendToDeadLetter(ctx context.Context, originalStream string, message queue.Message, reason string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.deadLetterLocked(originalStream, message, reason)
+}
+
+// deadLetterLocked 写入死信消息，调用方需持有锁
+func (m *MemoryQueue) deadLetterLocked(originalStream string, message queue.Message, reason string) error {
+	data := make(map[string]interface{}, len(message.Data)+5)
+	for k, v := range message.Data {
+		data[k] = v
+	}
+	data["original_stream"] = originalStream
+	data["original_id"] = message.ID
+	data["dead_letter_reason"] = reason
+	data["dead_letter_time"] = time.Now().Unix()
+	if message.TraceID != "" {
+		data["trace_id"] = message.TraceID
+	}
+
+	return m.appendLocked(queue.DeadLetterStream(originalStream), "*", data)
+}
+
+// GetDeadLetterMessages 获取死信消息
+func (m *MemoryQueue) GetDeadLetterMessages(ctx context.Context, stream string, afterID string, limit int64) ([]queue.Message, error) {
+	var after *messageID
+	if afterID != "" {
+		id, err := parseID(afterID)
+		if err != nil {
+			return nil, err
+		}
+		after = &id
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	deadLetterStream := queue.DeadLetterStream(stream)
+	s, ok := m.streams[deadLetterStream]
+	if !ok {
+		return []queue.Message{}, nil
+	}
+
+	start := 0
+	if after != nil {
+		start = s.search(*after)
+		if start < len(s.entries) && s.entries[start].id == *after {
+			start++
+		}
+	}
+
+	messages := make([]queue.Message, 0)
+	for i := start; i < len(s.entries) && (limit <= 0 || int64(len(messages)) < limit); i++ {
+		messages = append(messages, s.entries[i].toMessage(deadLetterStream))
+	}
+	return messages, nil
+}
+
+// GetDeadLetterMessage 获取单条死信消息
+func (m *MemoryQueue) GetDeadLetterMessage(ctx context.Context, stream string, messageID string) (*queue.Message, error) {
+	id, err := parseID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	deadLetterStream := queue.DeadLetterStream(stream)
+	s, ok := m.streams[deadLetterStream]
+	if !ok {
+		return nil, nil
+	}
+	e, _ := s.find(id)
+	if e == nil {
+		return nil, nil
+	}
+	msg := e.toMessage(deadLetterStream)
+	return &msg, nil
+}
+
+// CountDeadLetterMessages 统计死信消息数量
+func (m *MemoryQueue) CountDeadLetterMessages(ctx context.Context, stream string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.streams[queue.DeadLetterStream(stream)]
+	if !ok {
+		return 0, nil
+	}
+	return int64(len(s.entries)), nil
+}
+
+// ReprocessDeadLetter 重新处理死信消息
+func (m *MemoryQueue) ReprocessDeadLetter(ctx context.Context, deadLetterStream string, messageID string, targetStream string) error {
+	id, err := parseID(messageID)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.streams[deadLetterStream]
+	if !ok {
+		return fmt.Errorf("message not found: %s", messageID)
+	}
+	e, idx := s.find(id)
+	if e == nil {
+		return fmt.Errorf("message not found: %s", messageID)
+	}
+
+	message := e.toMessage(deadLetterStream)
+	data := message.Data
+
+	// 跳过死信相关字段
+	for _, k := range []string{"dead_letter_reason", "dead_letter_time", "original_stream", "original_id", "timestamp"} {
+		delete(data, k)
+	}
+	if message.TraceID != "" {
+		data["trace_id"] = message.TraceID
+	}
+
+	if err := m.appendLocked(targetStream, "*", data); err != nil {
+		return err
+	}
+
+	// 删除死信消息
+	s.entries = append(s.entries[:idx], s.entries[idx+1:]...)
+	return nil
+}
+
+// PurgeDeadLetter 清空死信队列
+func (m *MemoryQueue) PurgeDeadLetter(ctx context.Context, stream string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	deadLetterStream := queue.DeadLetterStream(stream)
+	s, ok := m.streams[deadLetterStream]
+	if !ok {
+		return 0, nil
+	}
+	count := int64(len(s.entries))
+	delete(m.streams, deadLetterStream)
+	return count, nil
+}
+
+// Close 关闭队列，停止所有订阅。生产者与消费者共享同一实例，重复关闭是安全的
+func (m *MemoryQueue) Close() error {
+	m.closeOnce.Do(func() {
+		close(m.closed)
+	})
+	return nil
+}
+
+// read 为消费者读取一批消息：优先认领超时未确认的消息，其次读取新消息。
+// 没有可读消息时返回用于等待新消息的通知通道
+func (m *MemoryQueue) read(streamName, groupName, consumer string) ([]queue.Message, <-chan struct{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s := m.streamLocked(streamName)
+	g := m.groupLocked(s, groupName)
+	g.consumers[consumer] = struct{}{}
+	now := time.Now()
+
+	messages := make([]queue.Message, 0, readBatchSize)
+
+	// 认领超时未确认的消息，对齐 XAUTOCLAIM 语义
+	claimIDs := make([]messageID, 0)
+	for id, p := range g.pending {
+		if now.Sub(p.deliveredAt) >= m.opts.PendingTimeout {
+			claimIDs = append(claimIDs, id)
+		}
+	}
+	sort.Slice(claimIDs, func(i, j int) bool { return claimIDs[i].less(claimIDs[j]) })
+	for _, id := range claimIDs {
+		if len(messages) >= readBatchSize {
+			break
+		}
+		e, _ := s.find(id)
+		if e == nil {
+			// 消息已被删除，对齐 XAUTOCLAIM 清理已删除条目的行为
+			delete(g.pending, id)
+			continue
+		}
+		p := g.pending[id]
+		p.consumer = consumer
+		p.deliveredAt = now
+		p.deliveryCount++
+
+		msg := e.toMessage(streamName)
+		msg.DeliveryCount = p.deliveryCount
+		if m.opts.MaxDeliveries > 0 && p.deliveryCount > m.opts.MaxDeliveries {
+			if err := m.deadLetterLocked(streamName, msg, fmt.Sprintf("exceeded max deliveries: %d", m.opts.MaxDeliveries)); err == nil {
+				delete(g.pending, id)
+			}
+			continue
+		}
+		messages = append(messages, msg)
+	}
+
+	// 读取新消息，对齐 XREADGROUP ">" 语义
+	start := s.search(g.lastDelivered)
+	for i := start; i < len(s.entries) && len(messages) < readBatchSize; i++ {
+		e := s.entries[i]
+		if !g.lastDelivered.less(e.id) {
+			continue
+		}
+		g.lastDelivered = e.id
+		g.pending[e.id] = &pendingEntry{consumer: consumer, deliveredAt: now, deliveryCount: 1}
+
+		msg := e.toMessage(streamName)
+		msg.DeliveryCount = 1
+		messages = append(messages, msg)
+	}
+	s.compact()
+
+	return messages, s.notify
+}
+
+// appendLocked 写入消息，调用方需持有锁
+func (m *MemoryQueue) appendLocked(streamName string, rawID string, data map[string]interface{}) error {
+	s := m.streamLocked(streamName)
+
+	id, err := s.nextID(rawID)
+	if err != nil {
+		return err
+	}
+
+	e, err := newEntry(id, data)
+	if err != nil {
+		return err
+	}
+	s.entries = append(s.entries, e)
+	s.lastID = id
+
+	close(s.notify)
+	s.notify = make(chan struct{})
+	return nil
+}
+
+// streamLocked 获取或创建流，调用方需持有锁
+func (m *MemoryQueue) streamLocked(name string) *stream {
+	s, ok := m.streams[name]
+	if !ok {
+		s = &stream{
+			groups:  make(map[string]*group),
+			created: time.Now(),
+			notify:  make(chan struct{}),
+		}
+		m.streams[name] = s
+	}
+	return s
+}
+
+// groupLocked 获取或创建消费者组，调用方需持有锁
+func (m *MemoryQueue) groupLocked(s *stream, name string) *group {
+	g, ok := s.groups[name]
+	if !ok {
+		g = &group{
+			pending:   make(map[messageID]*pendingEntry),
+			consumers: make(map[string]struct{}),
+		}
+		s.groups[name] = g
+	}
+	return g
+}
+
+// nextID 生成或校验消息ID，规则与 XADD 一致：新ID必须大于流中最后一个ID
+func (s *stream) nextID(rawID string) (messageID, error) {
+	if rawID == "" || rawID == "*" {
+		ms := time.Now().UnixMilli()
+		if ms <= s.lastID.ms {
+			return messageID{ms: s.lastID.ms, seq: s.lastID.seq + 1}, nil
+		}
+		return messageID{ms: ms}, nil
+	}
+
+	id, err := parseID(rawID)
+	if err != nil {
+		return messageID{}, err
+	}
+	if !s.lastID.less(id) {
+		return messageID{}, fmt.Errorf("message id %s is equal or smaller than the stream top item", rawID)
+	}
+	return id, nil
+}
+
+// search 返回第一个ID不小于 id 的消息下标
+func (s *stream) search(id messageID) int {
+	return sort.Search(len(s.entries), func(i int) bool {
+		return !s.entries[i].id.less(id)
+	})
+}
+
+// find 按ID查找消息
+func (s *stream) find(id messageID) (*entry, int) {
+	idx := s.search(id)
+	if idx < len(s.entries) && s.entries[idx].id == id {
+		return &s.entries[idx], idx
+	}
+	return nil, -1
+}
+
+// compact 清理所有消费者组均已投递且确认的消息，避免内存无限增长。
+// 没有消费者组的流（如死信流）保留全部消息
+func (s *stream) compact() {
+	if len(s.groups) == 0 {
+		return
+	}
+
+	n := 0
+	for ; n < len(s.entries); n++ {
+		id := s.entries[n].id
+		consumed := true
+		for _, g := range s.groups {
+			if g.lastDelivered.less(id) {
+				consumed = false
+				break
+			}
+			if _, ok := g.pending[id]; ok {
+				consumed = false
+				break
+			}
+		}
+		if !consumed {
+			break
+		}
+	}
+	if n > 0 {
+		s.entries = append([]entry(nil), s.entries[n:]...)
+	}
+}
+
+// newEntry 按 Redis 实现的编码规则保存消息：字段值经 JSON 往返，trace_id 单独保存
+func newEntry(id messageID, data map[string]interface{}) (entry, error) {
+	e := entry{
+		id:   id,
+		data: make(map[string]interface{}, len(data)+1),
+	}
+
+	for k, v := range data {
+		if k == "trace_id" {
+			e.traceID = fmt.Sprintf("%v", v)
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			e.data[k] = fmt.Sprintf("%v", v)
+			continue
+		}
+		var parsed interface{}
+		if err := json.Unmarshal(b, &parsed); err != nil {
+			return entry{}, fmt.Errorf("encode field %s: %w", k, err)
+		}
+		e.data[k] = parsed
+	}
+	e.data["timestamp"] = float64(time.Now().Unix())
+
+	return e, nil
+}
+
+// toMessage 转换为队列消息，返回数据副本避免消费者修改影响队列内容
+func (e *entry) toMessage(stream string) queue.Message {
+	data := make(map[string]interface{}, len(e.data))
+	for k, v := range e.data {
+		data[k] = v
+	}
+	return queue.Message{
+		ID:      e.id.String(),
+		Stream:  stream,
+		Data:    data,
+		TraceID: e.traceID,
+	}
+}
+
+// parseID 解析 "<毫秒>-<序号>" 格式的消息ID，省略序号时视为 0
+func parseID(raw string) (messageID, error) {
+	msPart, seqPart, hasSeq := strings.Cut(raw, "-")
+	ms, err := strconv.ParseInt(msPart, 10, 64)
+	if err != nil {
+		return messageID{}, fmt.Errorf("invalid message id: %s", raw)
+	}
+	var seq int64
+	if hasSeq {
+		if seq, err = strconv.ParseInt(seqPart, 10, 64); err != nil {
+			return messageID{}, fmt.Errorf("invalid message id: %s", raw)
+		}
+	}
+	return messageID{ms: ms, seq: seq}, nil
+}
+
+// String 返回 Redis 格式的消息ID
+func (id messageID) String() string {
+	return fmt.Sprintf("%d-%d", id.ms, id.seq)
+}
+
+// less 比较消息ID先后顺序
+func (id messageID) less(other messageID) bool {
+	if id.ms != other.ms {
+		return id.ms < other.ms
+	}
+	return id.seq < other.seq
+}
@@ -0,0 +1,139 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/internal/queue"
+)
+
+func receive(t *testing.T, ch <-chan queue.Message) queue.Message {
+	t.Helper()
+	select {
+	case msg, ok := <-ch:
+		require.True(t, ok, "channel closed")
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for message")
+		return queue.Message{}
+	}
+}
+
+func TestMemoryQueue_ConsumerGroup(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q := NewMemoryQueue(&Options{PendingTimeout: 50 * time.Millisecond, PollInterval: 10 * time.Millisecond})
+	defer q.Close()
+
+	require.NoError(t, q.Publish(ctx, "events", map[string]interface{}{"event_id": "a", "retry_count": 1, "trace_id": "t-1"}))
+
+	ch, err := q.Subscribe(ctx, "events", "workers", "worker-1")
+	require.NoError(t, err)
+
+	first := receive(t, ch)
+	assert.Equal(t, "a", first.Data["event_id"])
+	assert.Equal(t, float64(1), first.Data["retry_count"])
+	assert.Equal(t, "t-1", first.TraceID)
+	assert.NotContains(t, first.Data, "trace_id")
+
+	// 未确认的消息在超时后重新投递
+	redelivered := receive(t, ch)
+	assert.Equal(t, first.ID, redelivered.ID)
+	require.NoError(t, q.Ack(ctx, "events", "workers", redelivered.ID))
+
+	require.NoError(t, q.Publish(ctx, "events", map[string]interface{}{"event_id": "b"}))
+	second := receive(t, ch)
+	assert.Equal(t, "b", second.Data["event_id"])
+	require.NoError(t, q.Ack(ctx, "events", "workers", second.ID))
+
+	info, err := q.GetStreamInfo(ctx, "events")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), info.Length, "acked messages should be compacted")
+}
+
+func TestMemoryQueue_DeadLetter(t *testing.T) {
+	ctx := context.Background()
+	q := NewMemoryQueue(nil)
+	defer q.Close()
+
+	for _, id := range []string{"a", "b", "c"} {
+		msg := queue.Message{ID: "1-0", Data: map[string]interface{}{"event_id": id}, TraceID: "t-" + id}
+		require.NoError(t, q.SendToDeadLetter(ctx, "events", msg, "boom"))
+	}
+
+	count, err := q.CountDeadLetterMessages(ctx, "events")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	page, err := q.GetDeadLetterMessages(ctx, "events", "", 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, "boom", page[0].Data["dead_letter_reason"])
+
+	rest, err := q.GetDeadLetterMessages(ctx, "events", page[1].ID, 2)
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	assert.Equal(t, "c", rest[0].Data["event_id"])
+
+	require.NoError(t, q.ReprocessDeadLetter(ctx, queue.DeadLetterStream("events"), page[0].ID, "events"))
+	missing, err := q.GetDeadLetterMessage(ctx, "events", page[0].ID)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	ch, err := q.Subscribe(ctx, "events", "workers", "worker-1")
+	require.NoError(t, err)
+	replayed := receive(t, ch)
+	assert.Equal(t, "a", replayed.Data["event_id"])
+	assert.Equal(t, "t-a", replayed.TraceID)
+	assert.NotContains(t, replayed.Data, "dead_letter_reason")
+
+	purged, err := q.PurgeDeadLetter(ctx, "events")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), purged)
+}
+
+func TestMemoryQueue_MaxDeliveries(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q := NewMemoryQueue(&Options{PendingTimeout: 30 * time.Millisecond, PollInterval: 10 * time.Millisecond, MaxDeliveries: 2})
+	defer q.Close()
+
+	require.NoError(t, q.Publish(ctx, "events", map[string]interface{}{"event_id": "a"}))
+	require.NoError(t, q.Publish(ctx, "events", map[string]interface{}{"event_id": "b"}))
+
+	ch, err := q.Subscribe(ctx, "events", "workers", "worker-1")
+	require.NoError(t, err)
+
+	a := receive(t, ch)
+	b := receive(t, ch)
+	assert.Equal(t, int64(1), a.DeliveryCount)
+	require.NoError(t, q.Ack(ctx, "events", "workers", b.ID))
+
+	info, err := q.GetStreamInfo(ctx, "events")
+	require.NoError(t, err)
+	require.Len(t, info.Groups, 1)
+	assert.Equal(t, int64(1), info.Pending)
+	assert.Equal(t, int64(0), info.Groups[0].Lag)
+	assert.Equal(t, int64(1), info.Groups[0].Consumers)
+
+	// 第二次投递仍在上限内，第三次转入死信队列
+	again := receive(t, ch)
+	assert.Equal(t, a.ID, again.ID)
+	assert.Equal(t, int64(2), again.DeliveryCount)
+
+	assert.Eventually(t, func() bool {
+		count, err := q.CountDeadLetterMessages(ctx, "events")
+		return err == nil && count == 1
+	}, time.Second, 10*time.Millisecond)
+
+	info, err = q.GetStreamInfo(ctx, "events")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), info.Pending)
+	assert.Equal(t, int64(0), info.Length)
+}
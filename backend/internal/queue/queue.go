@@ -7,10 +7,11 @@ import (
 
 // Message 队列消息
 type Message struct {
-	ID      string                 `json:"id"`
-	Stream  string                 `json:"stream"`
-	Data    map[string]interface{} `json:"data"`
-	TraceID string                 `json:"trace_id"`
+	ID            string                 `json:"id"`
+	Stream        string                 `json:"stream"`
+	Data          map[string]interface{} `json:"data"`
+	TraceID       string                 `json:"trace_id"`
+	DeliveryCount int64                  `json:"delivery_count"` // 投递次数，首次投递为 1，被重新认领时递增
 }
 
 // Producer 消息生产者接口
@@ -66,20 +67,51 @@ type QueueManager interface {
 
 // StreamInfo 流信息
 type StreamInfo struct {
-	Name         string    `json:"name"`
-	Length       int64     `json:"length"`
-	LastID       string    `json:"last_id"`
-	FirstID      string    `json:"first_id"`
-	MaxDeletedID string    `json:"max_deleted_id"`
-	CreatedAt    time.Time `json:"created_at"`
+	Name         string      `json:"name"`
+	Length       int64       `json:"length"`
+	LastID       string      `json:"last_id"`
+	FirstID      string      `json:"first_id"`
+	MaxDeletedID string      `json:"max_deleted_id"`
+	CreatedAt    time.Time   `json:"created_at"`
+	Pending      int64       `json:"pending"` // 所有消费者组已投递未确认的消息总数
+	Groups       []GroupInfo `json:"groups"`  // 消费者组信息
+}
+
+// GroupInfo 消费者组信息
+type GroupInfo struct {
+	Name            string `json:"name"`
+	Consumers       int64  `json:"consumers"`         // 消费者数量
+	Pending         int64  `json:"pending"`           // 已投递未确认的消息数量
+	Lag             int64  `json:"lag"`               // 尚未投递给该组的消息数量，-1 表示无法确定
+	LastDeliveredID string `json:"last_delivered_id"` // 最后投递的消息ID
+}
+
+// DeadLetterStreamSuffix 死信流名称后缀
+const DeadLetterStreamSuffix = ":deadletter"
+
+// DeadLetterStream 返回原始流对应的死信流名称
+func DeadLetterStream(stream string) string {
+	return stream + DeadLetterStreamSuffix
 }
 
 // DeadLetterQueue 死信队列接口
 type DeadLetterQueue interface {
 	// SendToDeadLetter 发送消息到死信队列
 	SendToDeadLetter(ctx context.Context, originalStream string, message Message, reason string) error
-	// GetDeadLetterMessages 获取死信消息
-	GetDeadLetterMessages(ctx context.Context, stream string, limit int64) ([]Message, error)
+	// GetDeadLetterMessages 获取死信消息，afterID 为空时从头读取，否则读取该ID之后的消息
+	GetDeadLetterMessages(ctx context.Context, stream string, afterID string, limit int64) ([]Message, error)
+	// GetDeadLetterMessage 获取单条死信消息，不存在时返回 nil
+	GetDeadLetterMessage(ctx context.Context, stream string, messageID string) (*Message, error)
+	// CountDeadLetterMessages 统计死信消息数量
+	CountDeadLetterMessages(ctx context.Context, stream string) (int64, error)
 	// ReprocessDeadLetter 重新处理死信消息
 	ReprocessDeadLetter(ctx context.Context, deadLetterStream string, messageID string, targetStream string) error
+	// PurgeDeadLetter 清空死信队列，返回清除的消息数量
+	PurgeDeadLetter(ctx context.Context, stream string) (int64, error)
+}
+
+// Backend 队列后端，同时提供消息收发与死信管理能力
+type Backend interface {
+	QueueManager
+	DeadLetterQueue
 }
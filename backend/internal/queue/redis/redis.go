@@ -11,6 +11,15 @@ import (
 	"github.com/chaitin/WhaleHire/backend/internal/queue"
 )
 
+const (
+	// defaultClaimIdle 待确认消息被重新认领前的默认空闲时间
+	defaultClaimIdle = 5 * time.Minute
+	// defaultClaimInterval 默认的待确认消息回收间隔
+	defaultClaimInterval = 30 * time.Second
+	// claimBatchSize 每次 XAUTOCLAIM 认领的消息数量
+	claimBatchSize = 10
+)
+
 // RedisQueue Redis队列实现
 type RedisQueue struct {
 	client *redis.Client
@@ -28,6 +37,10 @@ type Options struct {
 	DialTimeout  time.Duration
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
+
+	ClaimIdle     time.Duration // 待确认消息空闲超过该时间后被重新认领
+	ClaimInterval time.Duration // 回收待确认消息的间隔
+	MaxDeliveries int64         // 最大投递次数，超过后自动转入死信队列，0 表示不限制
 }
 
 // NewRedisQueue 创建Redis队列实例
@@ -43,6 +56,12 @@ func NewRedisQueue(opts *Options) *RedisQueue {
 			WriteTimeout: 3 * time.Second,
 		}
 	}
+	if opts.ClaimIdle <= 0 {
+		opts.ClaimIdle = defaultClaimIdle
+	}
+	if opts.ClaimInterval <= 0 {
+		opts.ClaimInterval = defaultClaimInterval
+	}
 
 	client := redis.NewClient(&redis.Options{
 		Addr:         opts.Addr,
@@ -120,7 +139,9 @@ func (r *RedisQueue) PublishBatch(ctx context.Context, stream string, messages [
 	return err
 }
 
-// Subscribe 订阅指定流
+// Subscribe 订阅指定流。
+// 除读取新消息外，还会周期性通过 XAUTOCLAIM 认领空闲超时的待确认消息，
+// 使崩溃或重启前未确认的消息得以重新投递；超过最大投递次数的消息转入死信队列。
 func (r *RedisQueue) Subscribe(ctx context.Context, stream string, group string, consumer string) (<-chan queue.Message, error) {
 	// 创建消费者组（如果不存在）
 	r.client.XGroupCreateMkStream(ctx, stream, group, "0")
@@ -130,61 +151,58 @@ func (r *RedisQueue) Subscribe(ctx context.Context, stream string, group string,
 	go func() {
 		defer close(msgChan)
 
+		// 启动时立即回收一次，处理上次进程退出前遗留的消息
+		claimCursor := "0-0"
+		var nextClaim time.Time
+
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			default:
-				// 读取消息
-				streams, err := r.client.XReadGroup(ctx, &redis.XReadGroupArgs{
-					Group:    group,
-					Consumer: consumer,
-					Streams:  []string{stream, ">"},
-					Count:    10,
-					Block:    time.Second,
-				}).Result()
-
-				if err != nil {
-					if err == redis.Nil {
-						continue
+			}
+
+			if !time.Now().Before(nextClaim) {
+				claimed, next := r.reclaim(ctx, stream, group, consumer, claimCursor)
+				for _, msg := range claimed {
+					select {
+					case msgChan <- msg:
+					case <-ctx.Done():
+						return
 					}
-					// 记录错误但继续运行
+				}
+				claimCursor = next
+				if claimCursor == "0-0" {
+					nextClaim = time.Now().Add(r.opts.ClaimInterval)
+				}
+			}
+
+			// 读取消息
+			streams, err := r.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+				Group:    group,
+				Consumer: consumer,
+				Streams:  []string{stream, ">"},
+				Count:    10,
+				Block:    time.Second,
+			}).Result()
+
+			if err != nil {
+				if err == redis.Nil {
 					continue
 				}
+				// 记录错误但继续运行
+				continue
+			}
+
+			for _, stream := range streams {
+				for _, message := range stream.Messages {
+					msg := decodeMessage(stream.Stream, message)
+					msg.DeliveryCount = 1
 
-				for _, stream := range streams {
-					for _, message := range stream.Messages {
-						msg := queue.Message{
-							ID:     message.ID,
-							Stream: stream.Stream,
-							Data:   make(map[string]interface{}),
-						}
-
-						// 解析消息数据
-						for k, v := range message.Values {
-							if k == "trace_id" {
-								msg.TraceID = fmt.Sprintf("%v", v)
-								continue
-							}
-
-							// 尝试JSON解析
-							var parsed interface{}
-							if str, ok := v.(string); ok {
-								if err := json.Unmarshal([]byte(str), &parsed); err == nil {
-									msg.Data[k] = parsed
-								} else {
-									msg.Data[k] = str
-								}
-							} else {
-								msg.Data[k] = v
-							}
-						}
-
-						select {
-						case msgChan <- msg:
-						case <-ctx.Done():
-							return
-						}
+					select {
+					case msgChan <- msg:
+					case <-ctx.Done():
+						return
 					}
 				}
 			}
@@ -194,6 +212,76 @@ func (r *RedisQueue) Subscribe(ctx context.Context, stream string, group string,
 	return msgChan, nil
 }
 
+// reclaim 从 start 开始认领一批空闲超时的待确认消息，返回需要重新投递的消息与下一次的游标。
+// 游标为 "0-0" 表示本轮已扫描完整个待确认列表
+func (r *RedisQueue) reclaim(ctx context.Context, stream, group, consumer, start string) ([]queue.Message, string) {
+	entries, next, err := r.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   stream,
+		Group:    group,
+		Consumer: consumer,
+		MinIdle:  r.opts.ClaimIdle,
+		Start:    start,
+		Count:    claimBatchSize,
+	}).Result()
+	if err != nil {
+		return nil, "0-0"
+	}
+	if next == "" {
+		next = "0-0"
+	}
+	if len(entries) == 0 {
+		return nil, next
+	}
+
+	counts := r.deliveryCounts(ctx, stream, group, entries)
+	messages := make([]queue.Message, 0, len(entries))
+	for _, entry := range entries {
+		msg := decodeMessage(stream, entry)
+		msg.DeliveryCount = counts[entry.ID]
+
+		if r.opts.MaxDeliveries > 0 && msg.DeliveryCount > r.opts.MaxDeliveries {
+			reason := fmt.Sprintf("exceeded max deliveries: %d", r.opts.MaxDeliveries)
+			// 转入死信失败时保留在待确认列表中，下一轮继续处理
+			if err := r.SendToDeadLetter(ctx, stream, msg, reason); err != nil {
+				continue
+			}
+			r.client.XAck(ctx, stream, group, entry.ID)
+			continue
+		}
+		messages = append(messages, msg)
+	}
+
+	return messages, next
+}
+
+// deliveryCounts 查询消息的投递次数，XAUTOCLAIM 认领时已将计数加一
+func (r *RedisQueue) deliveryCounts(ctx context.Context, stream, group string, entries []redis.XMessage) map[string]int64 {
+	pipe := r.client.Pipeline()
+	cmds := make([]*redis.XPendingExtCmd, 0, len(entries))
+	for _, entry := range entries {
+		cmds = append(cmds, pipe.XPendingExt(ctx, &redis.XPendingExtArgs{
+			Stream: stream,
+			Group:  group,
+			Start:  entry.ID,
+			End:    entry.ID,
+			Count:  1,
+		}))
+	}
+	_, _ = pipe.Exec(ctx)
+
+	counts := make(map[string]int64, len(entries))
+	for _, cmd := range cmds {
+		pending, err := cmd.Result()
+		if err != nil {
+			continue
+		}
+		for _, p := range pending {
+			counts[p.ID] = p.RetryCount
+		}
+	}
+	return counts
+}
+
 // Ack 确认消息处理完成
 func (r *RedisQueue) Ack(ctx context.Context, stream string, group string, messageID string) error {
 	_, err := r.client.XAck(ctx, stream, group, messageID).Result()
@@ -231,74 +319,92 @@ func (r *RedisQueue) GetStreamInfo(ctx context.Context, stream string) (*queue.S
 		return nil, err
 	}
 
-	return &queue.StreamInfo{
+	groups, err := r.client.XInfoGroups(ctx, stream).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &queue.StreamInfo{
 		Name:         stream,
 		Length:       info.Length,
 		LastID:       info.LastGeneratedID,
 		FirstID:      info.FirstEntry.ID,
 		MaxDeletedID: info.MaxDeletedEntryID,
 		CreatedAt:    time.Now(), // Redis不提供创建时间，使用当前时间
-	}, nil
+		Groups:       make([]queue.GroupInfo, 0, len(groups)),
+	}
+	for _, g := range groups {
+		result.Pending += g.Pending
+		result.Groups = append(result.Groups, queue.GroupInfo{
+			Name:            g.Name,
+			Consumers:       g.Consumers,
+			Pending:         g.Pending,
+			Lag:             g.Lag,
+			LastDeliveredID: g.LastDeliveredID,
+		})
+	}
+
+	return result, nil
 }
 
 // SendToDeadLetter 发送消息到死信队列
 func (r *RedisQueue) SendToDeadLetter(ctx context.Context, originalStream string, message queue.Message, reason string) error {
-	deadLetterStream := originalStream + ":deadletter"
+	deadLetterStream := queue.DeadLetterStream(originalStream)
 
 	data := message.Data
 	data["original_stream"] = originalStream
 	data["original_id"] = message.ID
 	data["dead_letter_reason"] = reason
 	data["dead_letter_time"] = time.Now().Unix()
+	if message.TraceID != "" {
+		data["trace_id"] = message.TraceID
+	}
 
 	return r.Publish(ctx, deadLetterStream, data)
 }
 
-// GetDeadLetterMessages 获取死信消息
-func (r *RedisQueue) GetDeadLetterMessages(ctx context.Context, stream string, limit int64) ([]queue.Message, error) {
-	deadLetterStream := stream + ":deadletter"
+// GetDeadLetterMessages 获取死信消息。
+// 使用 XRANGE 而非 XREAD，避免死信流为空时阻塞。
+func (r *RedisQueue) GetDeadLetterMessages(ctx context.Context, stream string, afterID string, limit int64) ([]queue.Message, error) {
+	deadLetterStream := queue.DeadLetterStream(stream)
 
-	streams, err := r.client.XRead(ctx, &redis.XReadArgs{
-		Streams: []string{deadLetterStream, "0"},
-		Count:   limit,
-	}).Result()
+	start := "-"
+	if afterID != "" {
+		start = "(" + afterID
+	}
 
+	entries, err := r.client.XRangeN(ctx, deadLetterStream, start, "+", limit).Result()
 	if err != nil {
 		return nil, err
 	}
 
-	var messages []queue.Message
-	for _, stream := range streams {
-		for _, msg := range stream.Messages {
-			message := queue.Message{
-				ID:     msg.ID,
-				Stream: stream.Stream,
-				Data:   make(map[string]interface{}),
-			}
+	messages := make([]queue.Message, 0, len(entries))
+	for _, entry := range entries {
+		messages = append(messages, decodeMessage(deadLetterStream, entry))
+	}
 
-			for k, v := range msg.Values {
-				if k == "trace_id" {
-					message.TraceID = fmt.Sprintf("%v", v)
-					continue
-				}
+	return messages, nil
+}
 
-				var parsed interface{}
-				if str, ok := v.(string); ok {
-					if err := json.Unmarshal([]byte(str), &parsed); err == nil {
-						message.Data[k] = parsed
-					} else {
-						message.Data[k] = str
-					}
-				} else {
-					message.Data[k] = v
-				}
-			}
+// GetDeadLetterMessage 获取单条死信消息
+func (r *RedisQueue) GetDeadLetterMessage(ctx context.Context, stream string, messageID string) (*queue.Message, error) {
+	deadLetterStream := queue.DeadLetterStream(stream)
 
-			messages = append(messages, message)
-		}
+	entries, err := r.client.XRange(ctx, deadLetterStream, messageID, messageID).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, nil
 	}
 
-	return messages, nil
+	msg := decodeMessage(deadLetterStream, entries[0])
+	return &msg, nil
+}
+
+// CountDeadLetterMessages 统计死信消息数量
+func (r *RedisQueue) CountDeadLetterMessages(ctx context.Context, stream string) (int64, error) {
+	return r.client.XLen(ctx, queue.DeadLetterStream(stream)).Result()
 }
 
 // ReprocessDeadLetter 重新处理死信消息
@@ -313,35 +419,68 @@ func (r *RedisQueue) ReprocessDeadLetter(ctx context.Context, deadLetterStream s
 		return fmt.Errorf("message not found: %s", messageID)
 	}
 
-	message := streams[0]
-	data := make(map[string]interface{})
+	message := decodeMessage(deadLetterStream, streams[0])
+	data := message.Data
+
+	// 跳过死信相关字段
+	for _, k := range []string{"dead_letter_reason", "dead_letter_time", "original_stream", "original_id", "timestamp"} {
+		delete(data, k)
+	}
+	if message.TraceID != "" {
+		data["trace_id"] = message.TraceID
+	}
 
-	for k, v := range message.Values {
-		// 跳过死信相关字段
-		if k == "dead_letter_reason" || k == "dead_letter_time" || k == "original_stream" || k == "original_id" {
+	// 重新发布到目标流
+	if err := r.Publish(ctx, targetStream, data); err != nil {
+		return err
+	}
+
+	// 删除死信消息
+	_, err = r.client.XDel(ctx, deadLetterStream, messageID).Result()
+	return err
+}
+
+// PurgeDeadLetter 清空死信队列
+func (r *RedisQueue) PurgeDeadLetter(ctx context.Context, stream string) (int64, error) {
+	deadLetterStream := queue.DeadLetterStream(stream)
+
+	count, err := r.client.XLen(ctx, deadLetterStream).Result()
+	if err != nil {
+		return 0, err
+	}
+	if _, err := r.client.Del(ctx, deadLetterStream).Result(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// decodeMessage 将 Redis Stream 消息还原为队列消息，字段值按 JSON 尝试解析
+func decodeMessage(stream string, entry redis.XMessage) queue.Message {
+	msg := queue.Message{
+		ID:     entry.ID,
+		Stream: stream,
+		Data:   make(map[string]interface{}),
+	}
+
+	for k, v := range entry.Values {
+		if k == "trace_id" {
+			msg.TraceID = fmt.Sprintf("%v", v)
 			continue
 		}
 
 		var parsed interface{}
 		if str, ok := v.(string); ok {
 			if err := json.Unmarshal([]byte(str), &parsed); err == nil {
-				data[k] = parsed
+				msg.Data[k] = parsed
 			} else {
-				data[k] = str
+				msg.Data[k] = str
 			}
 		} else {
-			data[k] = v
+			msg.Data[k] = v
 		}
 	}
 
-	// 重新发布到目标流
-	if err := r.Publish(ctx, targetStream, data); err != nil {
-		return err
-	}
-
-	// 删除死信消息
-	_, err = r.client.XDel(ctx, deadLetterStream, messageID).Result()
-	return err
+	return msg
 }
 
 // Close 关闭连接
@@ -0,0 +1,60 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReclaimMovesToDeadLetterAfterMaxDeliveries(t *testing.T) {
+	mr := miniredis.RunT(t)
+	now := time.Now()
+	mr.SetTime(now)
+	q := NewRedisQueue(&Options{Addr: mr.Addr(), ClaimIdle: time.Minute, MaxDeliveries: 2})
+	defer q.Close()
+
+	ctx := context.Background()
+	const stream, group = "screening:tasks", "workers"
+	require.NoError(t, q.client.XGroupCreateMkStream(ctx, stream, group, "0").Err())
+	require.NoError(t, q.Publish(ctx, stream, map[string]interface{}{"task_id": "task-1"}))
+
+	// 首次投递后未确认
+	_, err := q.client.XReadGroup(ctx, &redis.XReadGroupArgs{Group: group, Consumer: "c1", Streams: []string{stream, ">"}, Count: 1}).Result()
+	require.NoError(t, err)
+
+	// 空闲未超时时不认领
+	claimed, _ := q.reclaim(ctx, stream, group, "c2", "0-0")
+	assert.Empty(t, claimed)
+
+	// 第二次投递：未超过上限，重新投递并携带投递次数
+	mr.SetTime(now.Add(2 * time.Minute))
+	claimed, _ = q.reclaim(ctx, stream, group, "c2", "0-0")
+	require.Len(t, claimed, 1)
+	assert.Equal(t, int64(2), claimed[0].DeliveryCount)
+
+	// 第三次投递超过上限，转入死信队列并从待确认列表移除
+	mr.SetTime(now.Add(4 * time.Minute))
+	claimed, _ = q.reclaim(ctx, stream, group, "c2", "0-0")
+	assert.Empty(t, claimed)
+
+	count, err := q.CountDeadLetterMessages(ctx, stream)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	dead, err := q.GetDeadLetterMessages(ctx, stream, "", 10)
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+	assert.Contains(t, dead[0].Data["dead_letter_reason"], "exceeded max deliveries")
+
+	// 原消息仍保留在流中，但已从待确认列表移除
+	info, err := q.GetStreamInfo(ctx, stream)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), info.Length)
+	assert.Zero(t, info.Pending)
+	require.Len(t, info.Groups, 1)
+	assert.Zero(t, info.Groups[0].Pending)
+}
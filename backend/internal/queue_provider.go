@@ -1,35 +1,60 @@
 package internal
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/google/wire"
 	"github.com/redis/go-redis/v9"
 
 	"github.com/chaitin/WhaleHire/backend/config"
+	"github.com/chaitin/WhaleHire/backend/consts"
 	"github.com/chaitin/WhaleHire/backend/internal/queue"
+	queuememory "github.com/chaitin/WhaleHire/backend/internal/queue/memory"
 	queueredis "github.com/chaitin/WhaleHire/backend/internal/queue/redis"
 )
 
-// NewQueueProducer 创建队列生产者
-func NewQueueProducer(redisClient *redis.Client, cfg *config.Config) queue.Producer {
-	opts := &queueredis.Options{
-		Addr:     redisClient.Options().Addr,
-		Password: redisClient.Options().Password,
-		DB:       redisClient.Options().DB,
+// NewQueueBackend 按配置创建队列后端，生产者、消费者与死信队列共享同一实例
+func NewQueueBackend(redisClient *redis.Client, cfg *config.Config) (queue.Backend, error) {
+	switch consts.QueueBackend(cfg.Queue.Backend) {
+	case consts.QueueBackendRedis, "":
+		opts := &queueredis.Options{
+			Addr:          redisClient.Options().Addr,
+			Password:      redisClient.Options().Password,
+			DB:            redisClient.Options().DB,
+			ClaimIdle:     time.Duration(cfg.Queue.PendingTimeout) * time.Second,
+			ClaimInterval: time.Duration(cfg.Queue.ClaimInterval) * time.Second,
+			MaxDeliveries: cfg.Queue.MaxDeliveries,
+		}
+		return queueredis.NewRedisQueue(opts), nil
+	case consts.QueueBackendMemory:
+		return queuememory.NewMemoryQueue(&queuememory.Options{
+			PendingTimeout: time.Duration(cfg.Queue.PendingTimeout) * time.Second,
+			MaxDeliveries:  cfg.Queue.MaxDeliveries,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unsupported queue backend: %s", cfg.Queue.Backend)
 	}
-	return queueredis.NewRedisQueue(opts)
+}
+
+// NewQueueProducer 创建队列生产者
+func NewQueueProducer(backend queue.Backend) queue.Producer {
+	return backend
 }
 
 // NewQueueConsumer 创建队列消费者
-func NewQueueConsumer(redisClient *redis.Client, cfg *config.Config) queue.Consumer {
-	opts := &queueredis.Options{
-		Addr:     redisClient.Options().Addr,
-		Password: redisClient.Options().Password,
-		DB:       redisClient.Options().DB,
-	}
-	return queueredis.NewRedisQueue(opts)
+func NewQueueConsumer(backend queue.Backend) queue.Consumer {
+	return backend
+}
+
+// NewDeadLetterQueue 创建死信队列
+func NewDeadLetterQueue(backend queue.Backend) queue.DeadLetterQueue {
+	return backend
 }
 
 var QueueProvider = wire.NewSet(
+	NewQueueBackend,
 	NewQueueProducer,
 	NewQueueConsumer,
+	NewDeadLetterQueue,
 )
@@ -27,6 +27,8 @@ func NewResumeHandler(
 	jobApplicationUsecase domain.JobApplicationUsecase,
 	redis *redis.Client,
 	auth *middleware.AuthMiddleware,
+	active *middleware.ActiveMiddleware,
+	readonly *middleware.ReadOnlyMiddleware,
 	logger *slog.Logger,
 ) *ResumeHandler {
 	h := &ResumeHandler{
@@ -48,10 +50,19 @@ func NewResumeHandler(
 	g.GET("/:id", web.BaseHandler(h.GetByID))
 	g.GET("/list", web.BindHandler(h.List, web.WithPage()))
 	g.GET("/search", web.BindHandler(h.Search, web.WithPage()))
+	g.GET("/semantic-search", web.BindHandler(h.SemanticSearch))
 	g.PUT("/:id", web.BindHandler(h.Update))
 	g.DELETE("/:id", web.BaseHandler(h.Delete))
 	g.POST("/:id/reparse", web.BaseHandler(h.Reparse))
 	g.GET("/:id/progress", web.BaseHandler(h.GetParseProgress))
+	g.GET("/:id/duplicates", web.BaseHandler(h.ListDuplicates))
+	g.POST("/:id/merge", web.BindHandler(h.Merge))
+	g.POST("/duplicates/:id/dismiss", web.BaseHandler(h.DismissDuplicate))
+
+	// 重建语义检索分块会批量调用嵌入模型，仅管理员可用
+	admin := w.Group("/api/v1/resume/semantic-index")
+	admin.Use(auth.Auth(), active.Active("admin"), readonly.Guard())
+	admin.POST("/rebuild", web.BindHandler(h.ReindexChunks))
 
 	return h
 }
@@ -234,6 +245,48 @@ func (h *ResumeHandler) Search(c *web.Context, req domain.SearchResumeReq) error
 	return c.Success(resp)
 }
 
+// SemanticSearch 语义检索简历
+//
+//	@Tags			Resume
+//	@Summary		语义检索简历
+//	@Description	按自然语言描述检索简历（如"做过支付系统的Go工程师"），基于个人简介、工作经历与项目经验的向量相似度召回，可叠加结构化筛选条件，返回高亮的命中片段
+//	@ID				semantic-search-resume
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	query		domain.SemanticSearchResumeReq	true	"检索参数"
+//	@Success		200		{object}	web.Resp{data=domain.SemanticSearchResumeResp}
+//	@Router			/api/v1/resume/semantic-search [get]
+func (h *ResumeHandler) SemanticSearch(c *web.Context, req domain.SemanticSearchResumeReq) error {
+	resp, err := h.usecase.SemanticSearch(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("failed to semantic search resumes", "error", err, "query", req.Query)
+		return err
+	}
+
+	return c.Success(resp)
+}
+
+// ReindexChunks 重建语义检索分块
+//
+//	@Tags			Resume
+//	@Summary		重建语义检索分块
+//	@Description	按简历ID游标分批为已解析的简历重新生成向量分块，用于回填语义检索上线前的存量简历或更换嵌入模型后重建。将响应中的 next_after_id 作为下一批的 after_id，直到其为空
+//	@ID				rebuild-resume-semantic-index
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.ReindexResumeChunksReq	true	"重建参数"
+//	@Success		200		{object}	web.Resp{data=domain.ReindexResumeChunksResp}
+//	@Router			/api/v1/resume/semantic-index/rebuild [post]
+func (h *ResumeHandler) ReindexChunks(c *web.Context, req domain.ReindexResumeChunksReq) error {
+	resp, err := h.usecase.ReindexChunks(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("failed to reindex resume chunks", "error", err, "after_id", req.AfterID)
+		return err
+	}
+
+	return c.Success(resp)
+}
+
 // Update 更新简历
 //
 //	@Tags			Resume
@@ -369,6 +422,105 @@ func (h *ResumeHandler) GetParseProgress(c *web.Context) error {
 	return c.Success(progress)
 }
 
+// ListDuplicates 获取疑似重复简历
+//
+//	@Tags			Resume
+//	@Summary		获取疑似重复简历
+//	@Description	获取与当前简历疑似为同一候选人的待确认简历列表，按相似度降序
+//	@ID				list-resume-duplicates
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string	true	"简历ID"
+//	@Success		200	{object}	web.Resp{data=[]domain.ResumeDuplicate}
+//	@Router			/api/v1/resume/{id}/duplicates [get]
+func (h *ResumeHandler) ListDuplicates(c *web.Context) error {
+	id := c.Param("id")
+	if id == "" {
+		return web.NewBadRequestErr("简历ID不能为空")
+	}
+
+	duplicates, err := h.usecase.ListDuplicates(c.Request().Context(), id)
+	if err != nil {
+		h.logger.Error("failed to list resume duplicates", "error", err, "resume_id", id)
+		return err
+	}
+
+	return c.Success(duplicates)
+}
+
+// Merge 合并重复简历
+//
+//	@Tags			Resume
+//	@Summary		合并重复简历
+//	@Description	将重复简历合并到当前简历，岗位投递、筛选记录与操作日志迁移到当前简历，重复简历文件保留为文件历史
+//	@ID				merge-resume
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string					true	"主简历ID"
+//	@Param			param	body		domain.MergeResumeReq	true	"待合并的重复简历"
+//	@Success		200		{object}	web.Resp{data=domain.ResumeDetail}
+//	@Router			/api/v1/resume/{id}/merge [post]
+func (h *ResumeHandler) Merge(c *web.Context, req domain.MergeResumeReq) error {
+	id := c.Param("id")
+	if id == "" {
+		return web.NewBadRequestErr("简历ID不能为空")
+	}
+
+	// 获取当前用户
+	user := middleware.GetUser(c)
+	if user == nil {
+		return errcode.ErrPermission.Wrap(fmt.Errorf("user not found"))
+	}
+
+	req.ID = id
+	req.OperatorID = user.ID
+
+	resume, err := h.usecase.Merge(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("failed to merge resumes", "error", err, "resume_id", id, "user_id", user.ID)
+		return err
+	}
+
+	h.logger.Info("resumes merged successfully", "resume_id", id, "duplicate_ids", req.DuplicateIDs, "user_id", user.ID)
+	return c.Success(resume)
+}
+
+// DismissDuplicate 忽略疑似重复
+//
+//	@Tags			Resume
+//	@Summary		忽略疑似重复
+//	@Description	确认两份简历不是同一候选人，忽略该疑似重复记录
+//	@ID				dismiss-resume-duplicate
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string	true	"疑似重复记录ID"
+//	@Success		200	{object}	web.Resp{}
+//	@Router			/api/v1/resume/duplicates/{id}/dismiss [post]
+func (h *ResumeHandler) DismissDuplicate(c *web.Context) error {
+	id := c.Param("id")
+	if id == "" {
+		return web.NewBadRequestErr("疑似重复记录ID不能为空")
+	}
+
+	// 获取当前用户
+	user := middleware.GetUser(c)
+	if user == nil {
+		return errcode.ErrPermission.Wrap(fmt.Errorf("user not found"))
+	}
+
+	err := h.usecase.DismissDuplicate(c.Request().Context(), &domain.DismissResumeDuplicateReq{
+		ID:         id,
+		OperatorID: user.ID,
+	})
+	if err != nil {
+		h.logger.Error("failed to dismiss resume duplicate", "error", err, "duplicate_id", id, "user_id", user.ID)
+		return err
+	}
+
+	h.logger.Info("resume duplicate dismissed", "duplicate_id", id, "user_id", user.ID)
+	return c.Success(nil)
+}
+
 // BatchUpload 批量上传简历
 //
 //	@Tags			Resume
@@ -0,0 +1,141 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumechunk"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/pkg/entx"
+)
+
+// ReplaceChunks 重建简历的语义检索分块
+func (r *ResumeRepo) ReplaceChunks(ctx context.Context, resumeID string, chunks []*db.ResumeChunk) error {
+	resumeUUID, err := uuid.Parse(resumeID)
+	if err != nil {
+		return fmt.Errorf("invalid resume ID: %w", err)
+	}
+
+	return entx.WithTx(ctx, r.db, func(tx *db.Tx) error {
+		if _, err := tx.ResumeChunk.Delete().
+			Where(resumechunk.ResumeID(resumeUUID)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to clear chunks: %w", err)
+		}
+		if len(chunks) == 0 {
+			return nil
+		}
+
+		builders := make([]*db.ResumeChunkCreate, 0, len(chunks))
+		for i, chunk := range chunks {
+			builders = append(builders, tx.ResumeChunk.Create().
+				SetResumeID(resumeUUID).
+				SetChunkType(chunk.ChunkType).
+				SetNillableSourceID(chunk.SourceID).
+				SetChunkIndex(i).
+				SetContent(chunk.Content).
+				SetVector(chunk.Vector))
+		}
+		if err := tx.ResumeChunk.CreateBulk(builders...).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create chunks: %w", err)
+		}
+		return nil
+	})
+}
+
+// SearchChunks 在满足结构化筛选条件的简历中按余弦距离检索最相似的分块，距离越小越相似
+func (r *ResumeRepo) SearchChunks(ctx context.Context, filter *domain.SearchResumeReq, vector pgvector.Vector, limit int) ([]*domain.ResumeChunkMatch, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	predicates, err := searchPredicates(filter)
+	if err != nil {
+		return nil, err
+	}
+	// 边查询不会应用软删除拦截器，需显式排除已删除简历
+	predicates = append(predicates, resume.DeletedAtIsNil(), resume.MergedIntoIDIsNil())
+
+	var matches []*domain.ResumeChunkMatch
+	err = r.db.ResumeChunk.Query().
+		Where(
+			resumechunk.VectorNotNil(),
+			resumechunk.HasResumeWith(predicates...),
+		).
+		Modify(func(s *sql.Selector) {
+			s.Select(
+				s.C(resumechunk.FieldID),
+				s.C(resumechunk.FieldResumeID),
+				s.C(resumechunk.FieldChunkType),
+				s.C(resumechunk.FieldSourceID),
+				s.C(resumechunk.FieldContent),
+			).
+				AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+					b.WriteString(s.C(resumechunk.FieldVector)).WriteString(" <=> ").Arg(vector)
+				}), "distance").
+				// 按距离别名排序，仍可命中 ivfflat 向量索引
+				OrderBy(sql.Asc("distance")).
+				Limit(limit)
+		}).
+		Scan(ctx, &matches)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search resume chunks: %w", err)
+	}
+	return matches, nil
+}
+
+// ListByIDs 批量获取简历
+func (r *ResumeRepo) ListByIDs(ctx context.Context, ids []string) ([]*db.Resume, error) {
+	resumeIDs := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		resumeID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resume ID: %w", err)
+		}
+		resumeIDs = append(resumeIDs, resumeID)
+	}
+
+	return r.db.Resume.Query().
+		Where(resume.IDIn(resumeIDs...)).
+		WithUser().
+		All(ctx)
+}
+
+// ListIndexableResumeIDs 按ID升序分页获取解析完成且未合并的简历ID，用于重建语义检索分块。
+// onlyMissing 为 true 时仅返回尚无分块的简历
+func (r *ResumeRepo) ListIndexableResumeIDs(ctx context.Context, afterID string, onlyMissing bool, limit int) ([]string, error) {
+	query := r.db.Resume.Query().
+		Where(
+			resume.Status(string(domain.ResumeStatusCompleted)),
+			resume.MergedIntoIDIsNil(),
+		)
+	if afterID != "" {
+		after, err := uuid.Parse(afterID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resume ID: %w", err)
+		}
+		query = query.Where(resume.IDGT(after))
+	}
+	if onlyMissing {
+		query = query.Where(resume.Not(resume.HasChunks()))
+	}
+
+	ids, err := query.
+		Order(resume.ByID()).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexable resumes: %w", err)
+	}
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id.String())
+	}
+	return result, nil
+}
@@ -0,0 +1,423 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/db/predicate"
+	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumeduplicate"
+	"github.com/chaitin/WhaleHire/backend/db/resumeeducation"
+	"github.com/chaitin/WhaleHire/backend/db/resumeexperience"
+	"github.com/chaitin/WhaleHire/backend/db/resumejobapplication"
+	"github.com/chaitin/WhaleHire/backend/db/resumelog"
+	"github.com/chaitin/WhaleHire/backend/db/screeningresult"
+	"github.com/chaitin/WhaleHire/backend/db/screeningtaskresume"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/pkg/entx"
+)
+
+// maxDuplicateCandidates 单次重复检测最多比对的候选简历数
+const maxDuplicateCandidates = 50
+
+// FindDuplicateCandidates 按文件哈希、归一化手机号、邮箱与姓名精确召回可能重复的未合并简历，
+// 并召回姓名首字相同且有相同学校或公司的简历，交由相似度计算识别姓名写法不一致的情况
+func (r *ResumeRepo) FindDuplicateCandidates(ctx context.Context, query *domain.DuplicateCandidateQuery) ([]*db.Resume, error) {
+	var keys []predicate.Resume
+	if query.FileHash != "" {
+		keys = append(keys, resume.FileHash(query.FileHash))
+	}
+	if query.Phone != "" {
+		keys = append(keys, resume.NormalizedPhone(query.Phone))
+	}
+	if query.Email != "" {
+		keys = append(keys, resume.NormalizedEmail(query.Email))
+	}
+	if query.Name != "" {
+		keys = append(keys, resume.NormalizedName(query.Name))
+	}
+	if query.NamePrefix != "" {
+		// 学校与公司名称在库中未归一化，按归一化名称做包含匹配以兼容"有限公司"等后缀差异
+		var orgs []predicate.Resume
+		if len(query.Schools) > 0 {
+			schools := make([]predicate.ResumeEducation, 0, len(query.Schools))
+			for _, school := range query.Schools {
+				schools = append(schools, resumeeducation.SchoolContainsFold(school))
+			}
+			orgs = append(orgs, resume.HasEducationsWith(resumeeducation.Or(schools...)))
+		}
+		if len(query.Companies) > 0 {
+			companies := make([]predicate.ResumeExperience, 0, len(query.Companies))
+			for _, company := range query.Companies {
+				companies = append(companies, resumeexperience.CompanyContainsFold(company))
+			}
+			orgs = append(orgs, resume.HasExperiencesWith(resumeexperience.Or(companies...)))
+		}
+		if len(orgs) > 0 {
+			keys = append(keys, resume.And(resume.NormalizedNameHasPrefix(query.NamePrefix), resume.Or(orgs...)))
+		}
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	return r.db.Resume.Query().
+		Where(
+			resume.IDNEQ(query.ResumeID),
+			resume.MergedIntoIDIsNil(),
+			resume.Or(keys...),
+		).
+		WithUser().
+		WithEducations().
+		WithExperiences().
+		Order(resume.ByCreatedAt(sql.OrderDesc())).
+		Limit(maxDuplicateCandidates).
+		All(ctx)
+}
+
+// SaveDuplicate 记录疑似重复简历，同一对简历（不区分方向）只保留一条记录，
+// 待确认的记录更新相似度，已处理的记录保持不变
+func (r *ResumeRepo) SaveDuplicate(ctx context.Context, duplicate *db.ResumeDuplicate) error {
+	existing, err := r.db.ResumeDuplicate.Query().
+		Where(pairPredicate(duplicate.ResumeID, duplicate.DuplicateResumeID)).
+		First(ctx)
+	if err != nil && !db.IsNotFound(err) {
+		return fmt.Errorf("query resume duplicate failed: %w", err)
+	}
+	if existing != nil {
+		if existing.Status != consts.ResumeDuplicateStatusPending {
+			return nil
+		}
+		return r.db.ResumeDuplicate.UpdateOneID(existing.ID).
+			SetScore(duplicate.Score).
+			SetReasons(duplicate.Reasons).
+			Exec(ctx)
+	}
+
+	return r.db.ResumeDuplicate.Create().
+		SetResumeID(duplicate.ResumeID).
+		SetDuplicateResumeID(duplicate.DuplicateResumeID).
+		SetScore(duplicate.Score).
+		SetReasons(duplicate.Reasons).
+		OnConflictColumns(resumeduplicate.FieldResumeID, resumeduplicate.FieldDuplicateResumeID).
+		DoNothing().
+		Exec(ctx)
+}
+
+// ListDuplicates 获取简历待确认的疑似重复记录，按相似度降序
+func (r *ResumeRepo) ListDuplicates(ctx context.Context, resumeID string) ([]*db.ResumeDuplicate, error) {
+	id, err := uuid.Parse(resumeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resume ID: %w", err)
+	}
+
+	return r.db.ResumeDuplicate.Query().
+		Where(
+			resumeduplicate.Or(resumeduplicate.ResumeID(id), resumeduplicate.DuplicateResumeID(id)),
+			resumeduplicate.StatusEQ(consts.ResumeDuplicateStatusPending),
+		).
+		WithResume(func(q *db.ResumeQuery) { q.WithUser() }).
+		WithDuplicateResume(func(q *db.ResumeQuery) { q.WithUser() }).
+		Order(resumeduplicate.ByScore(sql.OrderDesc())).
+		All(ctx)
+}
+
+// GetDuplicate 获取疑似重复记录
+func (r *ResumeRepo) GetDuplicate(ctx context.Context, id string) (*db.ResumeDuplicate, error) {
+	duplicateID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resume duplicate ID: %w", err)
+	}
+	return r.db.ResumeDuplicate.Get(ctx, duplicateID)
+}
+
+// UpdateDuplicateStatus 更新疑似重复记录的处理状态
+func (r *ResumeRepo) UpdateDuplicateStatus(ctx context.Context, id string, status consts.ResumeDuplicateStatus, reviewerID string) error {
+	duplicateID, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid resume duplicate ID: %w", err)
+	}
+	reviewer, err := uuid.Parse(reviewerID)
+	if err != nil {
+		return fmt.Errorf("invalid reviewer ID: %w", err)
+	}
+
+	return r.db.ResumeDuplicate.UpdateOneID(duplicateID).
+		SetStatus(status).
+		SetReviewedBy(reviewer).
+		SetReviewedAt(time.Now()).
+		Exec(ctx)
+}
+
+// ListMergedResumes 获取已合并到主简历的历史简历，按上传时间降序
+func (r *ResumeRepo) ListMergedResumes(ctx context.Context, canonicalID string) ([]*db.Resume, error) {
+	id, err := uuid.Parse(canonicalID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resume ID: %w", err)
+	}
+
+	return r.db.Resume.Query().
+		Where(resume.MergedIntoID(id)).
+		Order(resume.ByCreatedAt(sql.OrderDesc())).
+		All(ctx)
+}
+
+// Merge 将重复简历合并到主简历：岗位投递、筛选记录与操作日志迁移到主简历，
+// 重复简历及其解析数据保留并标记为已合并，作为主简历的文件历史
+func (r *ResumeRepo) Merge(ctx context.Context, canonicalID string, duplicateIDs []string, operatorID string) error {
+	canonical, err := uuid.Parse(canonicalID)
+	if err != nil {
+		return fmt.Errorf("invalid resume ID: %w", err)
+	}
+	operator, err := uuid.Parse(operatorID)
+	if err != nil {
+		return fmt.Errorf("invalid operator ID: %w", err)
+	}
+	duplicates := make([]uuid.UUID, 0, len(duplicateIDs))
+	for _, id := range duplicateIDs {
+		duplicateID, err := uuid.Parse(id)
+		if err != nil {
+			return fmt.Errorf("invalid duplicate resume ID: %w", err)
+		}
+		duplicates = append(duplicates, duplicateID)
+	}
+
+	now := time.Now()
+	return entx.WithTx(ctx, r.db, func(tx *db.Tx) error {
+		for _, duplicateID := range duplicates {
+			if err := mergeJobApplications(ctx, tx, canonical, duplicateID); err != nil {
+				return err
+			}
+			if err := mergeScreeningTaskResumes(ctx, tx, canonical, duplicateID); err != nil {
+				return err
+			}
+			if err := mergeScreeningResults(ctx, tx, canonical, duplicateID); err != nil {
+				return err
+			}
+
+			if _, err := tx.ResumeLog.Update().
+				Where(resumelog.ResumeID(duplicateID)).
+				SetResumeID(canonical).
+				Save(ctx); err != nil {
+				return fmt.Errorf("failed to move resume logs: %w", err)
+			}
+
+			// 之前合并到重复简历的历史文件一并归入主简历
+			if _, err := tx.Resume.Update().
+				Where(resume.MergedIntoID(duplicateID)).
+				SetMergedIntoID(canonical).
+				Save(ctx); err != nil {
+				return fmt.Errorf("failed to move merged resume history: %w", err)
+			}
+
+			if err := tx.Resume.UpdateOneID(duplicateID).
+				SetMergedIntoID(canonical).
+				SetMergedAt(now).
+				Exec(ctx); err != nil {
+				return fmt.Errorf("failed to mark resume merged: %w", err)
+			}
+		}
+
+		if err := mergeDuplicateFlags(ctx, tx, canonical, duplicates, operator, now); err != nil {
+			return err
+		}
+
+		ids := make([]string, 0, len(duplicates))
+		for _, id := range duplicates {
+			ids = append(ids, id.String())
+		}
+		if _, err := tx.ResumeLog.Create().
+			SetResumeID(canonical).
+			SetAction("merge").
+			SetMessage(fmt.Sprintf("合并重复简历：%s", strings.Join(ids, ", "))).
+			Save(ctx); err != nil {
+			return fmt.Errorf("failed to create merge log: %w", err)
+		}
+		return nil
+	})
+}
+
+// mergeJobApplications 迁移岗位投递记录，主简历已投递同一岗位时保留主简历的记录
+func mergeJobApplications(ctx context.Context, tx *db.Tx, canonical, duplicateID uuid.UUID) error {
+	apps, err := tx.ResumeJobApplication.Query().
+		Where(resumejobapplication.ResumeID(duplicateID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query job applications: %w", err)
+	}
+
+	for _, app := range apps {
+		// 唯一索引包含已软删除的记录，需一并检查
+		existing, err := tx.ResumeJobApplication.Query().
+			Where(
+				resumejobapplication.ResumeID(canonical),
+				resumejobapplication.JobPositionID(app.JobPositionID),
+			).
+			Only(entx.SkipSoftDelete(ctx))
+		switch {
+		case db.IsNotFound(err):
+		case err != nil:
+			return fmt.Errorf("failed to query canonical job application: %w", err)
+		case existing.DeletedAt.IsZero():
+			if err := tx.ResumeJobApplication.DeleteOneID(app.ID).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to remove duplicate job application: %w", err)
+			}
+			continue
+		default:
+			if err := tx.ResumeJobApplication.DeleteOneID(existing.ID).Exec(entx.SkipSoftDelete(ctx)); err != nil {
+				return fmt.Errorf("failed to purge deleted job application: %w", err)
+			}
+		}
+
+		if err := tx.ResumeJobApplication.UpdateOneID(app.ID).SetResumeID(canonical).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to move job application: %w", err)
+		}
+	}
+	return nil
+}
+
+// mergeScreeningTaskResumes 迁移筛选任务关联，主简历已在同一任务中时保留主简历的记录
+func mergeScreeningTaskResumes(ctx context.Context, tx *db.Tx, canonical, duplicateID uuid.UUID) error {
+	items, err := tx.ScreeningTaskResume.Query().
+		Where(screeningtaskresume.ResumeID(duplicateID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query screening task resumes: %w", err)
+	}
+
+	for _, item := range items {
+		existing, err := tx.ScreeningTaskResume.Query().
+			Where(
+				screeningtaskresume.ResumeID(canonical),
+				screeningtaskresume.TaskID(item.TaskID),
+			).
+			Only(entx.SkipSoftDelete(ctx))
+		switch {
+		case db.IsNotFound(err):
+		case err != nil:
+			return fmt.Errorf("failed to query canonical screening task resume: %w", err)
+		case existing.DeletedAt.IsZero():
+			if err := tx.ScreeningTaskResume.DeleteOneID(item.ID).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to remove duplicate screening task resume: %w", err)
+			}
+			continue
+		default:
+			if err := tx.ScreeningTaskResume.DeleteOneID(existing.ID).Exec(entx.SkipSoftDelete(ctx)); err != nil {
+				return fmt.Errorf("failed to purge deleted screening task resume: %w", err)
+			}
+		}
+
+		if err := tx.ScreeningTaskResume.UpdateOneID(item.ID).SetResumeID(canonical).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to move screening task resume: %w", err)
+		}
+	}
+	return nil
+}
+
+// mergeScreeningResults 迁移筛选结果，主简历在同一任务中已有结果时保留主简历的结果
+func mergeScreeningResults(ctx context.Context, tx *db.Tx, canonical, duplicateID uuid.UUID) error {
+	results, err := tx.ScreeningResult.Query().
+		Where(screeningresult.ResumeID(duplicateID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query screening results: %w", err)
+	}
+
+	for _, result := range results {
+		existing, err := tx.ScreeningResult.Query().
+			Where(
+				screeningresult.ResumeID(canonical),
+				screeningresult.TaskID(result.TaskID),
+			).
+			Only(entx.SkipSoftDelete(ctx))
+		switch {
+		case db.IsNotFound(err):
+		case err != nil:
+			return fmt.Errorf("failed to query canonical screening result: %w", err)
+		case existing.DeletedAt.IsZero():
+			if err := tx.ScreeningResult.DeleteOneID(result.ID).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to remove duplicate screening result: %w", err)
+			}
+			continue
+		default:
+			if err := tx.ScreeningResult.DeleteOneID(existing.ID).Exec(entx.SkipSoftDelete(ctx)); err != nil {
+				return fmt.Errorf("failed to purge deleted screening result: %w", err)
+			}
+		}
+
+		if err := tx.ScreeningResult.UpdateOneID(result.ID).SetResumeID(canonical).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to move screening result: %w", err)
+		}
+	}
+	return nil
+}
+
+// mergeDuplicateFlags 合并组内的疑似重复记录标记为已合并；重复简历与组外简历的待确认记录转移到主简历
+func mergeDuplicateFlags(ctx context.Context, tx *db.Tx, canonical uuid.UUID, duplicates []uuid.UUID, operator uuid.UUID, now time.Time) error {
+	group := map[uuid.UUID]bool{canonical: true}
+	for _, id := range duplicates {
+		group[id] = true
+	}
+
+	flags, err := tx.ResumeDuplicate.Query().
+		Where(
+			resumeduplicate.Or(
+				resumeduplicate.ResumeIDIn(duplicates...),
+				resumeduplicate.DuplicateResumeIDIn(duplicates...),
+			),
+			resumeduplicate.StatusEQ(consts.ResumeDuplicateStatusPending),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query resume duplicates: %w", err)
+	}
+
+	for _, flag := range flags {
+		if !group[flag.ResumeID] || !group[flag.DuplicateResumeID] {
+			other := flag.ResumeID
+			if group[other] {
+				other = flag.DuplicateResumeID
+			}
+			exists, err := tx.ResumeDuplicate.Query().
+				Where(pairPredicate(canonical, other)).
+				Exist(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to query resume duplicates: %w", err)
+			}
+			if !exists {
+				if err := tx.ResumeDuplicate.Create().
+					SetResumeID(canonical).
+					SetDuplicateResumeID(other).
+					SetScore(flag.Score).
+					SetReasons(flag.Reasons).
+					Exec(ctx); err != nil {
+					return fmt.Errorf("failed to move resume duplicate: %w", err)
+				}
+			}
+		}
+
+		if err := tx.ResumeDuplicate.UpdateOneID(flag.ID).
+			SetStatus(consts.ResumeDuplicateStatusMerged).
+			SetReviewedBy(operator).
+			SetReviewedAt(now).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update resume duplicate: %w", err)
+		}
+	}
+	return nil
+}
+
+// pairPredicate 匹配两份简历之间的疑似重复记录（不区分方向）
+func pairPredicate(a, b uuid.UUID) predicate.ResumeDuplicate {
+	return resumeduplicate.Or(
+		resumeduplicate.And(resumeduplicate.ResumeID(a), resumeduplicate.DuplicateResumeID(b)),
+		resumeduplicate.And(resumeduplicate.ResumeID(b), resumeduplicate.DuplicateResumeID(a)),
+	)
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/db/predicate"
 	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumechunk"
 	"github.com/chaitin/WhaleHire/backend/db/resumedocumentparse"
 	"github.com/chaitin/WhaleHire/backend/db/resumeeducation"
 	"github.com/chaitin/WhaleHire/backend/db/resumeexperience"
@@ -55,6 +57,12 @@ func (r *ResumeRepo) Create(ctx context.Context, resume *db.Resume) (*db.Resume,
 	if resume.ErrorMessage != "" {
 		creator = creator.SetErrorMessage(resume.ErrorMessage)
 	}
+	if resume.Source != "" {
+		creator = creator.SetSource(resume.Source)
+	}
+	if resume.FileHash != "" {
+		creator = creator.SetFileHash(resume.FileHash)
+	}
 	if !resume.ParsedAt.IsZero() {
 		creator = creator.SetParsedAt(resume.ParsedAt)
 	}
@@ -180,7 +188,9 @@ func (r *ResumeRepo) Delete(ctx context.Context, id string) error {
 
 // List 获取简历列表
 func (r *ResumeRepo) List(ctx context.Context, req *domain.ListResumeReq) ([]*db.Resume, *db.PageInfo, error) {
+	// 已合并的简历仅作为主简历的文件历史保留，不在列表中展示
 	query := r.db.Resume.Query().
+		Where(resume.MergedIntoIDIsNil()).
 		WithUser().
 		WithJobApplications(func(q *db.ResumeJobApplicationQuery) {
 			q.WithJobPosition()
@@ -221,7 +231,10 @@ func (r *ResumeRepo) List(ctx context.Context, req *domain.ListResumeReq) ([]*db
 
 	// 按申请来源筛选
 	if req.Source != nil {
-		query = query.Where(resume.HasJobApplicationsWith(resumejobapplication.SourceEQ(*req.Source)))
+		query = query.Where(resume.Or(
+			resume.SourceEQ(*req.Source),
+			resume.HasJobApplicationsWith(resumejobapplication.SourceEQ(*req.Source)),
+		))
 	}
 
 	if req.DateFrom != nil {
@@ -241,21 +254,39 @@ func (r *ResumeRepo) List(ctx context.Context, req *domain.ListResumeReq) ([]*db
 
 // Search 搜索简历
 func (r *ResumeRepo) Search(ctx context.Context, req *domain.SearchResumeReq) ([]*db.Resume, *db.PageInfo, error) {
-	query := r.db.Resume.Query().WithUser()
+	predicates, err := searchPredicates(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	query := r.db.Resume.Query().
+		Where(resume.MergedIntoIDIsNil()).
+		Where(predicates...).
+		WithUser()
+
+	// 排序
+	query = query.Order(resume.ByCreatedAt(sql.OrderDesc()))
+
+	// 分页
+	return query.Page(ctx, req.Pagination.Page, req.Pagination.Size)
+}
+
+// searchPredicates 构建简历搜索的结构化筛选条件
+func searchPredicates(req *domain.SearchResumeReq) ([]predicate.Resume, error) {
+	var predicates []predicate.Resume
 
 	// 用户ID过滤
 	if req.UploaderID != nil {
 		uploaderID, err := uuid.Parse(*req.UploaderID)
 		if err != nil {
-			return nil, nil, fmt.Errorf("invalid uploader ID: %w", err)
+			return nil, fmt.Errorf("invalid uploader ID: %w", err)
 		}
-		query = query.Where(resume.UploaderID(uploaderID))
+		predicates = append(predicates, resume.UploaderID(uploaderID))
 	}
 
 	// 关键词搜索
 	if req.Keywords != nil && *req.Keywords != "" {
 		keywords := strings.Fields(*req.Keywords)
-		var predicates []func(*sql.Selector)
 
 		for _, keyword := range keywords {
 			keyword = "%" + keyword + "%"
@@ -268,15 +299,11 @@ func (r *ResumeRepo) Search(ctx context.Context, req *domain.SearchResumeReq) ([
 				))
 			})
 		}
-
-		for _, predicate := range predicates {
-			query = query.Where(predicate)
-		}
 	}
 
 	// 技能搜索
 	if len(req.Skills) > 0 {
-		query = query.Where(
+		predicates = append(predicates,
 			resume.HasSkillsWith(
 				resumeskill.SkillNameIn(req.Skills...),
 			),
@@ -285,22 +312,22 @@ func (r *ResumeRepo) Search(ctx context.Context, req *domain.SearchResumeReq) ([
 
 	// 教育背景
 	if req.Education != nil && *req.Education != "" {
-		query = query.Where(resume.HighestEducationContains(*req.Education))
+		predicates = append(predicates, resume.HighestEducationContains(*req.Education))
 	}
 
 	// 工作年限
 	if req.YearsExperience != nil {
-		query = query.Where(resume.YearsExperienceGTE(*req.YearsExperience))
+		predicates = append(predicates, resume.YearsExperienceGTE(*req.YearsExperience))
 	}
 
 	// 城市
 	if req.CurrentCity != nil && *req.CurrentCity != "" {
-		query = query.Where(resume.CurrentCityContains(*req.CurrentCity))
+		predicates = append(predicates, resume.CurrentCityContains(*req.CurrentCity))
 	}
 
 	// 性别
 	if req.Gender != nil && *req.Gender != "" {
-		query = query.Where(resume.Gender(*req.Gender))
+		predicates = append(predicates, resume.Gender(*req.Gender))
 	}
 
 	// 年龄范围（基于生日计算）
@@ -312,11 +339,7 @@ func (r *ResumeRepo) Search(ctx context.Context, req *domain.SearchResumeReq) ([
 		_ = req.AgeTo   // 避免未使用变量警告
 	}
 
-	// 排序
-	query = query.Order(resume.ByCreatedAt(sql.OrderDesc()))
-
-	// 分页
-	return query.Page(ctx, req.Pagination.Page, req.Pagination.Size)
+	return predicates, nil
 }
 
 // CreateEducation 创建教育经历
@@ -505,7 +528,8 @@ func (r *ResumeRepo) CreateDocumentParse(ctx context.Context, documentParse *db.
 		SetFileType(documentParse.FileType).
 		SetFilename(documentParse.Filename).
 		SetTitle(documentParse.Title).
-		SetStatus(documentParse.Status)
+		SetStatus(documentParse.Status).
+		SetNillableOcrConfidence(documentParse.OcrConfidence)
 
 	if !documentParse.UploadAt.IsZero() {
 		creator = creator.SetUploadAt(documentParse.UploadAt)
@@ -566,6 +590,13 @@ func (r *ResumeRepo) ClearParsedData(ctx context.Context, resumeID string) error
 			return fmt.Errorf("failed to clear projects: %w", err)
 		}
 
+		// 删除语义检索分块
+		if _, err := tx.ResumeChunk.Delete().
+			Where(resumechunk.ResumeID(resumeUUID)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to clear chunks: %w", err)
+		}
+
 		return nil
 	})
 }
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	einoembedding "github.com/cloudwego/eino/components/embedding"
+	"github.com/pgvector/pgvector-go"
+
+	"github.com/chaitin/WhaleHire/backend/config"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/pkg/eino/components/embedding"
+)
+
+// embeddingBatchSize 单次调用嵌入模型的最大文本数
+const embeddingBatchSize = 16
+
+type embeddingService struct {
+	config *config.Config
+
+	// mu 保护嵌入模型的懒加载，初始化失败时不缓存错误，下次调用重试
+	mu       sync.Mutex
+	embedder einoembedding.Embedder
+}
+
+// NewEmbeddingService 创建简历分块向量化服务，嵌入模型在首次使用时初始化
+func NewEmbeddingService(cfg *config.Config) domain.ResumeEmbeddingService {
+	return &embeddingService{
+		config: cfg,
+	}
+}
+
+// Enabled 是否已配置嵌入模型
+func (s *embeddingService) Enabled() bool {
+	return s.config.Embedding.APIEndpoint != "" && s.config.Embedding.APIKey != ""
+}
+
+// EmbedTexts 批量生成文本向量，返回顺序与输入一致
+func (s *embeddingService) EmbedTexts(ctx context.Context, texts []string) ([]pgvector.Vector, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+
+	embedder, err := s.getEmbedder(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("创建嵌入模型失败: %w", err)
+	}
+
+	result := make([]pgvector.Vector, 0, len(texts))
+	for start := 0; start < len(texts); start += embeddingBatchSize {
+		end := min(start+embeddingBatchSize, len(texts))
+		vectors, err := embedder.EmbedStrings(ctx, texts[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("批量生成向量失败: %w", err)
+		}
+		if len(vectors) != end-start {
+			return nil, fmt.Errorf("生成的向量数量不匹配: 期望 %d，实际 %d", end-start, len(vectors))
+		}
+
+		for i, vector := range vectors {
+			if len(vector) == 0 {
+				return nil, fmt.Errorf("生成的向量为空 (索引: %d)", start+i)
+			}
+			vec := make([]float32, len(vector))
+			for j, v := range vector {
+				vec[j] = float32(v)
+			}
+			result = append(result, pgvector.NewVector(vec))
+		}
+	}
+
+	return result, nil
+}
+
+// getEmbedder 返回已初始化的嵌入模型，未初始化或上次初始化失败时重新创建
+func (s *embeddingService) getEmbedder(ctx context.Context) (einoembedding.Embedder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.embedder != nil {
+		return s.embedder, nil
+	}
+	embedder, _, err := embedding.NewEmbedding(ctx, s.config)
+	if err != nil {
+		return nil, err
+	}
+	s.embedder = embedder
+	return embedder, nil
+}
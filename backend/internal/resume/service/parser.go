@@ -24,11 +24,14 @@ import (
 	"github.com/google/uuid"
 )
 
+// lowOCRConfidence 低于该置信度的OCR结果记录告警
+const lowOCRConfidence = 0.6
+
 type ParserService struct {
 	modelFactory        *models.ModelFactory
 	config              *config.Config
 	logger              *slog.Logger
-	documentParser      *docparser.DocumentParserService
+	documentParser      docparser.Extractor
 	resumeRepo          domain.ResumeRepo
 	resumeParseRunnable compose.Runnable[*resumeparser.ResumeParseInput, *resumeparser.ResumeParseResult]
 	resumeParseGraph    *resumeparsergraph.ResumeParseGraph
@@ -48,10 +51,11 @@ func NewParserService(config *config.Config, logger *slog.Logger, resumeRepo dom
 	openaiManager := models.NewOpenAIModelManager(openaiConfig)
 	factory.Register(models.ModelTypeOpenAI, config.GeneralAgent.LLM.ModelName, openaiManager)
 
-	// 创建文档解析服务
-	var documentParser *docparser.DocumentParserService
-	if config.DocumentParser.APIKey != "" {
-		documentParser = docparser.NewDocumentParserServiceFromConfig(config)
+	// 按配置创建文档文本提取后端（local 或 remote）
+	documentParser, err := docparser.NewExtractorFromConfig(config)
+	if err != nil {
+		logger.Error("failed to create document extractor", "backend", config.DocumentParser.Backend, "error", err)
+		return nil, err
 	}
 
 	// 创建并初始化简历解析图
@@ -135,35 +139,14 @@ func (s *ParserService) downloadFile(ctx context.Context, fileURL string, fileTy
 
 	// 如果无法从URL获取文件名，使用fileType参数确定扩展名
 	if fileName == "." || fileName == "/" || fileName == "" {
-		// 根据fileType确定默认扩展名
-		var defaultExt string
-		switch fileType {
-		case "pdf":
-			defaultExt = ".pdf"
-		case "doc":
-			defaultExt = ".doc"
-		case "docx":
-			defaultExt = ".docx"
-		default:
-			defaultExt = ".pdf" // 默认为pdf
-		}
-		fileName = "resume_file" + defaultExt
+		fileName = "resume_file" + resumeFileExt(fileType)
 	}
 
 	// 确保文件有正确的扩展名
 	ext := filepath.Ext(fileName)
 	if ext == "" {
 		// 如果文件名没有扩展名，根据fileType添加
-		switch fileType {
-		case "pdf":
-			fileName += ".pdf"
-		case "doc":
-			fileName += ".doc"
-		case "docx":
-			fileName += ".docx"
-		default:
-			fileName += ".pdf"
-		}
+		fileName += resumeFileExt(fileType)
 	}
 
 	// 确保文件名不超过合理长度
@@ -226,41 +209,41 @@ func (s *ParserService) ParseResume(ctx context.Context, resumeID string, fileUR
 
 	var resumeContent string
 
-	// 如果配置了文档解析服务，则下载并解析文件内容
-	if s.documentParser != nil {
-		// 下载文件到临时目录
-		tempFilePath, downloadErr := s.downloadFile(ctx, fileURL, fileType)
-		if downloadErr != nil {
-			s.logger.Error("下载文件失败", "error", downloadErr, "fileURL", fileURL)
-			return nil, fmt.Errorf("下载文件失败: %w", downloadErr)
-		}
+	// 下载文件到临时目录
+	tempFilePath, downloadErr := s.downloadFile(ctx, fileURL, fileType)
+	if downloadErr != nil {
+		s.logger.Error("下载文件失败", "error", downloadErr, "fileURL", fileURL)
+		return nil, fmt.Errorf("下载文件失败: %w", downloadErr)
+	}
 
-		// 确保清理临时文件
-		defer func() {
-			if removeErr := os.Remove(tempFilePath); removeErr != nil {
-				s.logger.Warn("清理临时文件失败", "tempFile", tempFilePath, "error", removeErr)
-			}
-		}()
-
-		// 使用docparser解析文件内容
-		parseResult, parseErr := s.documentParser.ParseDocument(ctx, tempFilePath)
-		if parseErr != nil {
-			s.logger.Error("解析文档内容失败", "error", parseErr, "tempFile", tempFilePath)
-			return nil, fmt.Errorf("解析文档内容失败: %w", parseErr)
+	// 确保清理临时文件
+	defer func() {
+		if removeErr := os.Remove(tempFilePath); removeErr != nil {
+			s.logger.Warn("清理临时文件失败", "tempFile", tempFilePath, "error", removeErr)
 		}
+	}()
 
-		resumeContent = parseResult.Content
-		s.logger.Info("文档解析成功", "fileURL", fileURL, "contentLength", len(resumeContent))
+	// 使用配置的文本提取后端解析文件内容
+	parseResult, parseErr := s.documentParser.ParseDocument(ctx, tempFilePath)
+	if parseErr != nil {
+		s.logger.Error("解析文档内容失败", "error", parseErr, "tempFile", tempFilePath)
+		return nil, fmt.Errorf("解析文档内容失败: %w", parseErr)
+	}
+	if strings.TrimSpace(parseResult.Content) == "" {
+		s.logger.Error("未从简历文件中提取到文本内容", "fileURL", fileURL)
+		return nil, fmt.Errorf("未从简历文件中提取到文本内容")
+	}
 
-		// 保存docparser的解析结果到数据库
-		if err := s.saveDocumentParseResult(ctx, resumeID, parseResult); err != nil {
-			s.logger.Error("保存文档解析结果失败", "error", err, "resumeID", resumeID)
-			// 这里不返回错误，因为主要的解析流程应该继续
-		}
-	} else {
-		// 如果没有配置文档解析服务，直接返回错误
-		s.logger.Error("文档解析服务未配置，无法解析简历文件", "fileURL", fileURL)
-		return nil, fmt.Errorf("文档解析服务未配置，无法解析简历文件")
+	resumeContent = parseResult.Content
+	s.logger.Info("文档解析成功", "fileURL", fileURL, "contentLength", len(resumeContent))
+	if parseResult.OCRConfidence != nil && *parseResult.OCRConfidence < lowOCRConfidence {
+		s.logger.Warn("简历OCR识别置信度较低，解析结果可能不准确", "resumeID", resumeID, "confidence", *parseResult.OCRConfidence)
+	}
+
+	// 保存文本提取结果到数据库
+	if err := s.saveDocumentParseResult(ctx, resumeID, parseResult); err != nil {
+		s.logger.Error("保存文档解析结果失败", "error", err, "resumeID", resumeID)
+		// 这里不返回错误，因为主要的解析流程应该继续
 	}
 
 	// 准备输入
@@ -283,14 +266,15 @@ func (s *ParserService) ParseResume(ctx context.Context, resumeID string, fileUR
 func (s *ParserService) saveDocumentParseResult(ctx context.Context, resumeID string, parseResult *docparser.ParseDocumentResult) error {
 	// 将docparser结果转换为数据库实体
 	docParse := &db.ResumeDocumentParse{
-		ResumeID: uuid.MustParse(resumeID),
-		FileID:   parseResult.FileID,
-		Content:  parseResult.Content,
-		FileType: parseResult.FileType,
-		Filename: parseResult.Filename,
-		Title:    parseResult.Title,
-		UploadAt: parseResult.UploadAt,
-		Status:   "success",
+		ResumeID:      uuid.MustParse(resumeID),
+		FileID:        parseResult.FileID,
+		Content:       parseResult.Content,
+		FileType:      parseResult.FileType,
+		Filename:      parseResult.Filename,
+		Title:         parseResult.Title,
+		UploadAt:      parseResult.UploadAt,
+		Status:        "success",
+		OcrConfidence: parseResult.OCRConfidence,
 	}
 
 	// 保存到数据库
@@ -302,3 +286,13 @@ func (s *ParserService) saveDocumentParseResult(ctx context.Context, resumeID st
 	s.logger.Info("文档解析结果已保存", "resumeID", resumeID, "fileID", parseResult.FileID)
 	return nil
 }
+
+// resumeFileExt 根据文件类型返回扩展名，未知类型默认为pdf
+func resumeFileExt(fileType string) string {
+	switch fileType {
+	case "pdf", "doc", "docx", "txt", "html", "htm", "rtf", "jpg", "jpeg", "png":
+		return "." + fileType
+	default:
+		return ".pdf"
+	}
+}
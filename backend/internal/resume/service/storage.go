@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log/slog"
@@ -53,8 +55,11 @@ func (s *StorageService) Upload(ctx context.Context, file io.Reader, filename st
 	now := time.Now()
 	objectName := filepath.Join("resumes", now.Format("2006"), now.Format("01"), storedName)
 
+	// 上传的同时计算文件内容哈希，用于识别重复简历
+	hasher := sha256.New()
+
 	// 上传文件到MinIO
-	info, err := s.minioClient.Client.PutObject(ctx, s.bucket, objectName, file, -1, minio.PutObjectOptions{
+	info, err := s.minioClient.Client.PutObject(ctx, s.bucket, objectName, io.TeeReader(file, hasher), -1, minio.PutObjectOptions{
 		ContentType: s.getContentType(filename),
 		UserMetadata: map[string]string{
 			"originalname": filename,
@@ -87,6 +92,7 @@ func (s *StorageService) Upload(ctx context.Context, file io.Reader, filename st
 		FileURL:      fileURL,
 		Size:         info.Size,
 		ContentType:  s.getContentType(filename),
+		ContentHash:  hex.EncodeToString(hasher.Sum(nil)),
 	}, nil
 }
 
@@ -195,6 +201,14 @@ func (s *StorageService) getContentType(filename string) string {
 		return "application/msword"
 	case ".txt":
 		return "text/plain"
+	case ".html", ".htm":
+		return "text/html"
+	case ".rtf":
+		return "application/rtf"
+	case ".jpg", ".jpeg":
+		return "image/jpeg"
+	case ".png":
+		return "image/png"
 	default:
 		return "application/octet-stream"
 	}
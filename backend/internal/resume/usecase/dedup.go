@@ -0,0 +1,348 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/errcode"
+)
+
+const (
+	// duplicateThreshold 相似度达到该值时标记为疑似重复
+	duplicateThreshold = 0.75
+	// nameSimilarityThreshold 姓名相似度达到该值时才比较教育与工作经历
+	nameSimilarityThreshold = 0.8
+	// minCandidateOrgRunes 参与候选召回的学校或公司名称最少字符数
+	minCandidateOrgRunes = 2
+)
+
+// orgSuffixPattern 比较学校与公司时忽略的常见后缀
+var orgSuffixPattern = regexp.MustCompile(`(有限责任公司|股份有限公司|有限公司|集团|公司|co\.?,?ltd\.?|inc\.?|ltd\.?|corp\.?|limited)$`)
+
+// ListDuplicates 获取简历待确认的疑似重复列表
+func (u *ResumeUsecase) ListDuplicates(ctx context.Context, id string) ([]*domain.ResumeDuplicate, error) {
+	flags, err := u.repo.ListDuplicates(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resume duplicates: %w", err)
+	}
+
+	result := make([]*domain.ResumeDuplicate, 0, len(flags))
+	for _, flag := range flags {
+		duplicate := (&domain.ResumeDuplicate{}).From(flag)
+		// 返回相对当前简历的另一份简历
+		other := flag.Edges.DuplicateResume
+		if flag.DuplicateResumeID.String() == id {
+			other = flag.Edges.Resume
+		}
+		if other != nil {
+			duplicate.Resume = (&domain.Resume{}).From(other)
+		}
+		result = append(result, duplicate)
+	}
+	return result, nil
+}
+
+// Merge 将重复简历合并到主简历
+func (u *ResumeUsecase) Merge(ctx context.Context, req *domain.MergeResumeReq) (*domain.ResumeDetail, error) {
+	canonical, err := u.repo.GetByID(ctx, req.ID)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errcode.ErrResumeNotFound
+		}
+		return nil, fmt.Errorf("failed to get resume: %w", err)
+	}
+	if canonical.MergedIntoID != nil {
+		return nil, errcode.ErrResumeMerged
+	}
+
+	seen := make(map[string]bool, len(req.DuplicateIDs))
+	for _, duplicateID := range req.DuplicateIDs {
+		if duplicateID == req.ID {
+			return nil, errcode.ErrInvalidParam.WithData("message", "不能将简历合并到自身")
+		}
+		if seen[duplicateID] {
+			return nil, errcode.ErrInvalidParam.WithData("message", "重复简历ID不能重复")
+		}
+		seen[duplicateID] = true
+
+		duplicate, err := u.repo.GetByID(ctx, duplicateID)
+		if err != nil {
+			if db.IsNotFound(err) {
+				return nil, errcode.ErrResumeNotFound
+			}
+			return nil, fmt.Errorf("failed to get duplicate resume: %w", err)
+		}
+		if duplicate.MergedIntoID != nil {
+			return nil, errcode.ErrResumeMerged
+		}
+	}
+
+	if err := u.repo.Merge(ctx, req.ID, req.DuplicateIDs, req.OperatorID); err != nil {
+		return nil, fmt.Errorf("failed to merge resumes: %w", err)
+	}
+
+	u.logger.Info("Resumes merged", "resume_id", req.ID, "duplicate_ids", req.DuplicateIDs, "operator_id", req.OperatorID)
+	return u.GetByID(ctx, req.ID)
+}
+
+// DismissDuplicate 忽略疑似重复记录，确认两份简历不是同一候选人
+func (u *ResumeUsecase) DismissDuplicate(ctx context.Context, req *domain.DismissResumeDuplicateReq) error {
+	flag, err := u.repo.GetDuplicate(ctx, req.ID)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return errcode.ErrResumeDuplicateNotFound
+		}
+		return fmt.Errorf("failed to get resume duplicate: %w", err)
+	}
+	if flag.Status != consts.ResumeDuplicateStatusPending {
+		return errcode.ErrInvalidParam.WithData("message", "该疑似重复记录已处理")
+	}
+
+	if err := u.repo.UpdateDuplicateStatus(ctx, req.ID, consts.ResumeDuplicateStatusDismissed, req.OperatorID); err != nil {
+		return fmt.Errorf("failed to dismiss resume duplicate: %w", err)
+	}
+	return nil
+}
+
+// detectDuplicates 简历解析完成后检测疑似重复简历，检测失败不影响解析结果
+func (u *ResumeUsecase) detectDuplicates(ctx context.Context, resumeID string) {
+	res, err := u.repo.GetByID(ctx, resumeID)
+	if err != nil {
+		u.logger.Error("Failed to get resume for duplicate detection", "error", err, "resume_id", resumeID)
+		return
+	}
+	if res.MergedIntoID != nil {
+		return
+	}
+
+	candidates, err := u.repo.FindDuplicateCandidates(ctx, duplicateCandidateQuery(res))
+	if err != nil {
+		u.logger.Error("Failed to find duplicate candidates", "error", err, "resume_id", resumeID)
+		return
+	}
+
+	flagged := 0
+	for _, candidate := range candidates {
+		score, reasons := scoreDuplicate(res, candidate)
+		if score < duplicateThreshold {
+			continue
+		}
+
+		reasonValues := make([]string, 0, len(reasons))
+		for _, reason := range reasons {
+			reasonValues = append(reasonValues, string(reason))
+		}
+		if err := u.repo.SaveDuplicate(ctx, &db.ResumeDuplicate{
+			ResumeID:          res.ID,
+			DuplicateResumeID: candidate.ID,
+			Score:             score,
+			Reasons:           reasonValues,
+		}); err != nil {
+			u.logger.Error("Failed to save resume duplicate", "error", err, "resume_id", resumeID, "duplicate_resume_id", candidate.ID)
+			continue
+		}
+		flagged++
+	}
+
+	if flagged == 0 {
+		return
+	}
+	u.logger.Info("Duplicate resumes detected", "resume_id", resumeID, "count", flagged)
+	if _, err := u.repo.CreateLog(ctx, &db.ResumeLog{
+		ResumeID: res.ID,
+		Action:   "duplicate_detected",
+		Message:  fmt.Sprintf("检测到 %d 份疑似重复简历", flagged),
+	}); err != nil {
+		u.logger.Error("Failed to create duplicate log", "error", err, "resume_id", resumeID)
+	}
+}
+
+// duplicateCandidateQuery 构造候选召回条件：联系方式与姓名精确匹配，
+// 姓名首字相同且学校或公司重叠时也召回，以便姓名写法不一致的简历进入相似度比对
+func duplicateCandidateQuery(res *db.Resume) *domain.DuplicateCandidateQuery {
+	query := &domain.DuplicateCandidateQuery{
+		ResumeID: res.ID,
+		FileHash: res.FileHash,
+		Phone:    res.NormalizedPhone,
+		Email:    res.NormalizedEmail,
+		Name:     res.NormalizedName,
+	}
+	if name := []rune(res.NormalizedName); len(name) > 0 {
+		query.NamePrefix = string(name[0])
+	}
+	query.Schools = candidateOrgs(educationSchools(res.Edges.Educations))
+	query.Companies = candidateOrgs(experienceCompanies(res.Edges.Experiences))
+	return query
+}
+
+// candidateOrgs 返回排序后用于召回的机构名称，过短的名称区分度太低，不参与召回
+func candidateOrgs(orgs map[string]struct{}) []string {
+	result := make([]string, 0, len(orgs))
+	for org := range orgs {
+		if len([]rune(org)) >= minCandidateOrgRunes {
+			result = append(result, org)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// scoreDuplicate 计算两份简历的重复相似度：文件、手机号或邮箱相同直接判定，
+// 否则按姓名、教育经历与工作经历加权计算
+func scoreDuplicate(a, b *db.Resume) (float64, []consts.ResumeDuplicateReason) {
+	var (
+		score   float64
+		reasons []consts.ResumeDuplicateReason
+	)
+	if a.FileHash != "" && a.FileHash == b.FileHash {
+		score = 1
+		reasons = append(reasons, consts.ResumeDuplicateReasonFileHash)
+	}
+	if a.NormalizedPhone != "" && a.NormalizedPhone == b.NormalizedPhone {
+		score = max(score, 0.95)
+		reasons = append(reasons, consts.ResumeDuplicateReasonPhone)
+	}
+	if a.NormalizedEmail != "" && a.NormalizedEmail == b.NormalizedEmail {
+		score = max(score, 0.95)
+		reasons = append(reasons, consts.ResumeDuplicateReasonEmail)
+	}
+
+	name := nameSimilarity(a.NormalizedName, b.NormalizedName)
+	if name < nameSimilarityThreshold {
+		return score, reasons
+	}
+	edu := setSimilarity(educationSchools(a.Edges.Educations), educationSchools(b.Edges.Educations))
+	exp := setSimilarity(experienceCompanies(a.Edges.Experiences), experienceCompanies(b.Edges.Experiences))
+	if edu == 0 && exp == 0 {
+		return score, reasons
+	}
+
+	reasons = append(reasons, consts.ResumeDuplicateReasonName)
+	if edu > 0 {
+		reasons = append(reasons, consts.ResumeDuplicateReasonEducation)
+	}
+	if exp > 0 {
+		reasons = append(reasons, consts.ResumeDuplicateReasonExperience)
+	}
+	return max(score, 0.5*name+0.25*edu+0.25*exp), reasons
+}
+
+// normalizePhone 仅保留数字并去除国家区号，过短的号码视为无效
+func normalizePhone(phone string) string {
+	var sb strings.Builder
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			sb.WriteRune(r)
+		}
+	}
+	digits := sb.String()
+	switch {
+	case strings.HasPrefix(digits, "0086"):
+		digits = digits[4:]
+	case strings.HasPrefix(digits, "86") && len(digits) == 13:
+		digits = digits[2:]
+	}
+	if len(digits) < 7 {
+		return ""
+	}
+	return digits
+}
+
+// normalizeEmail 转为小写并去除 "+" 之后的别名部分
+func normalizeEmail(email string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+	local, domainPart, ok := strings.Cut(email, "@")
+	if !ok || local == "" || domainPart == "" {
+		return ""
+	}
+	if i := strings.Index(local, "+"); i > 0 {
+		local = local[:i]
+	}
+	return local + "@" + domainPart
+}
+
+// normalizeName 转为小写，仅保留字母、数字与汉字
+func normalizeName(name string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// normalizeOrg 归一化学校或公司名称
+func normalizeOrg(org string) string {
+	return orgSuffixPattern.ReplaceAllString(normalizeName(org), "")
+}
+
+// nameSimilarity 基于编辑距离计算姓名相似度
+func nameSimilarity(a, b string) float64 {
+	if a == "" || b == "" {
+		return 0
+	}
+	ra, rb := []rune(a), []rune(b)
+	return 1 - float64(levenshtein(ra, rb))/float64(max(len(ra), len(rb)))
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// setSimilarity 计算两个集合的 Jaccard 相似度
+func setSimilarity(a, b map[string]struct{}) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	inter := 0
+	for k := range a {
+		if _, ok := b[k]; ok {
+			inter++
+		}
+	}
+	return float64(inter) / float64(len(a)+len(b)-inter)
+}
+
+func educationSchools(educations []*db.ResumeEducation) map[string]struct{} {
+	schools := make(map[string]struct{}, len(educations))
+	for _, edu := range educations {
+		if school := normalizeOrg(edu.School); school != "" {
+			schools[school] = struct{}{}
+		}
+	}
+	return schools
+}
+
+func experienceCompanies(experiences []*db.ResumeExperience) map[string]struct{} {
+	companies := make(map[string]struct{}, len(experiences))
+	for _, exp := range experiences {
+		if company := normalizeOrg(exp.Company); company != "" {
+			companies[company] = struct{}{}
+		}
+	}
+	return companies
+}
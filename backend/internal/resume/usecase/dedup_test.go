@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+func TestNormalizeContact(t *testing.T) {
+	assert.Equal(t, "13800138000", normalizePhone("+86 138-0013-8000"))
+	assert.Equal(t, "13800138000", normalizePhone("0086 13800138000"))
+	assert.Equal(t, "13800138000", normalizePhone("138 0013 8000"))
+	assert.Equal(t, "", normalizePhone("12345"))
+
+	assert.Equal(t, "zhangsan@example.com", normalizeEmail(" ZhangSan+jobs@Example.com "))
+	assert.Equal(t, "", normalizeEmail("not-an-email"))
+
+	assert.Equal(t, "张三", normalizeName(" 张 三 "))
+	assert.Equal(t, "johnsmith", normalizeName("John Smith"))
+	assert.Equal(t, "字节跳动", normalizeOrg("字节跳动有限公司"))
+}
+
+func TestScoreDuplicate(t *testing.T) {
+	newResume := func(name, phone, school, company string) *db.Resume {
+		return &db.Resume{
+			NormalizedName:  normalizeName(name),
+			NormalizedPhone: normalizePhone(phone),
+			Edges: db.ResumeEdges{
+				Educations:  []*db.ResumeEducation{{School: school}},
+				Experiences: []*db.ResumeExperience{{Company: company}},
+			},
+		}
+	}
+
+	t.Run("same phone", func(t *testing.T) {
+		score, reasons := scoreDuplicate(
+			newResume("张三", "13800138000", "清华大学", "字节跳动"),
+			newResume("李四", "+86 138 0013 8000", "北京大学", "腾讯"),
+		)
+		assert.InDelta(t, 0.95, score, 1e-9)
+		assert.Equal(t, []consts.ResumeDuplicateReason{consts.ResumeDuplicateReasonPhone}, reasons)
+	})
+
+	t.Run("same file", func(t *testing.T) {
+		a, b := newResume("张三", "", "", ""), newResume("", "", "", "")
+		a.FileHash, b.FileHash = "abc", "abc"
+		score, reasons := scoreDuplicate(a, b)
+		assert.Equal(t, 1.0, score)
+		assert.Equal(t, []consts.ResumeDuplicateReason{consts.ResumeDuplicateReasonFileHash}, reasons)
+	})
+
+	t.Run("fuzzy match", func(t *testing.T) {
+		score, reasons := scoreDuplicate(
+			newResume("张三", "", "清华大学", "字节跳动有限公司"),
+			newResume("张 三", "13900139000", "清华大学", "字节跳动"),
+		)
+		assert.InDelta(t, 1.0, score, 1e-9)
+		assert.Equal(t, []consts.ResumeDuplicateReason{
+			consts.ResumeDuplicateReasonName,
+			consts.ResumeDuplicateReasonEducation,
+			consts.ResumeDuplicateReasonExperience,
+		}, reasons)
+	})
+
+	t.Run("same name only", func(t *testing.T) {
+		score, _ := scoreDuplicate(
+			newResume("张三", "", "清华大学", "字节跳动"),
+			newResume("张三", "", "北京大学", "腾讯"),
+		)
+		assert.Less(t, score, duplicateThreshold)
+	})
+
+	t.Run("same name and school", func(t *testing.T) {
+		score, _ := scoreDuplicate(
+			newResume("张三", "", "清华大学", "字节跳动"),
+			newResume("张三", "", "清华大学", "腾讯"),
+		)
+		assert.GreaterOrEqual(t, score, duplicateThreshold)
+	})
+}
+
+type fakeDedupRepo struct {
+	domain.ResumeRepo
+	resume     *db.Resume
+	candidates []*db.Resume
+	query      *domain.DuplicateCandidateQuery
+	saved      []*db.ResumeDuplicate
+}
+
+func (f *fakeDedupRepo) GetByID(context.Context, string) (*db.Resume, error) {
+	return f.resume, nil
+}
+
+func (f *fakeDedupRepo) FindDuplicateCandidates(_ context.Context, query *domain.DuplicateCandidateQuery) ([]*db.Resume, error) {
+	f.query = query
+	return f.candidates, nil
+}
+
+func (f *fakeDedupRepo) SaveDuplicate(_ context.Context, duplicate *db.ResumeDuplicate) error {
+	f.saved = append(f.saved, duplicate)
+	return nil
+}
+
+func (f *fakeDedupRepo) CreateLog(_ context.Context, log *db.ResumeLog) (*db.ResumeLog, error) {
+	return log, nil
+}
+
+func TestDetectDuplicatesWithDifferentName(t *testing.T) {
+	newResume := func(name, phone, school, company string) *db.Resume {
+		return &db.Resume{
+			ID:              uuid.New(),
+			NormalizedName:  normalizeName(name),
+			NormalizedPhone: normalizePhone(phone),
+			Edges: db.ResumeEdges{
+				Educations:  []*db.ResumeEducation{{School: school}, {School: "某"}},
+				Experiences: []*db.ResumeExperience{{Company: company}},
+			},
+		}
+	}
+	res := newResume("Zhang San", "13800138000", "清华大学", "字节跳动有限公司")
+	other := newResume("Zhang Shan", "13900139000", "清华大学", "字节跳动")
+	repo := &fakeDedupRepo{resume: res, candidates: []*db.Resume{other}}
+	u := &ResumeUsecase{repo: repo, logger: slog.Default()}
+
+	u.detectDuplicates(context.Background(), res.ID.String())
+
+	// 姓名不一致时按姓名首字与相同学校或公司召回，过短的机构名称不参与召回
+	require.NotNil(t, repo.query)
+	assert.Equal(t, "zhangsan", repo.query.Name)
+	assert.Equal(t, "z", repo.query.NamePrefix)
+	assert.Equal(t, []string{"清华大学"}, repo.query.Schools)
+	assert.Equal(t, []string{"字节跳动"}, repo.query.Companies)
+
+	require.Len(t, repo.saved, 1)
+	assert.Equal(t, other.ID, repo.saved[0].DuplicateResumeID)
+	assert.GreaterOrEqual(t, repo.saved[0].Score, duplicateThreshold)
+	assert.Equal(t, []string{
+		string(consts.ResumeDuplicateReasonName),
+		string(consts.ResumeDuplicateReasonEducation),
+		string(consts.ResumeDuplicateReasonExperience),
+	}, repo.saved[0].Reasons)
+}
@@ -22,6 +22,7 @@ type ResumeUsecase struct {
 	repo                  domain.ResumeRepo
 	parserService         domain.ParserService
 	storageService        domain.StorageService
+	embeddingService      domain.ResumeEmbeddingService
 	jobApplicationUsecase domain.JobApplicationUsecase
 	notificationUsecase   domain.NotificationUsecase
 	redis                 *redis.Client
@@ -34,6 +35,7 @@ func NewResumeUsecase(
 	repo domain.ResumeRepo,
 	parserService domain.ParserService,
 	storageService domain.StorageService,
+	embeddingService domain.ResumeEmbeddingService,
 	jobApplicationUsecase domain.JobApplicationUsecase,
 	notificationUsecase domain.NotificationUsecase,
 	redis *redis.Client,
@@ -44,6 +46,7 @@ func NewResumeUsecase(
 		repo:                  repo,
 		parserService:         parserService,
 		storageService:        storageService,
+		embeddingService:      embeddingService,
 		jobApplicationUsecase: jobApplicationUsecase,
 		notificationUsecase:   notificationUsecase,
 		redis:                 redis,
@@ -53,7 +56,7 @@ func NewResumeUsecase(
 
 // Upload 上传简历
 func (u *ResumeUsecase) Upload(ctx context.Context, req *domain.UploadResumeReq) (*domain.Resume, error) {
-	return u.uploadWithOptions(ctx, req, false)
+	return u.uploadWithOptions(ctx, req, req.WaitForParsing)
 }
 
 // uploadWithOptions 内部上传函数，支持控制是否等待解析完成
@@ -75,10 +78,14 @@ func (u *ResumeUsecase) uploadWithOptions(ctx context.Context, req *domain.Uploa
 	resume := &db.Resume{
 		UploaderID:    uploaderID,
 		ResumeFileURL: fileInfo.FileURL,
+		FileHash:      fileInfo.ContentHash,
 		Status:        string(domain.ResumeStatusPending),
 		CreatedAt:     time.Now(),
 		UpdatedAt:     time.Now(),
 	}
+	if req.Source != nil {
+		resume.Source = *req.Source
+	}
 
 	// 保存到数据库
 	createdResume, err := u.repo.Create(ctx, resume)
@@ -145,6 +152,11 @@ func (u *ResumeUsecase) GetByID(ctx context.Context, id string) (*domain.ResumeD
 		u.logger.Error("Failed to get logs", "error", err, "resume_id", id)
 	}
 
+	fileHistory, err := u.getFileHistoryByResumeID(ctx, id)
+	if err != nil {
+		u.logger.Error("Failed to get file history", "error", err, "resume_id", id)
+	}
+
 	// 转换为domain对象
 	domainResume := (&domain.Resume{}).From(resume)
 
@@ -165,6 +177,7 @@ func (u *ResumeUsecase) GetByID(ctx context.Context, id string) (*domain.ResumeD
 		Skills:      skills,
 		Projects:    projects,
 		Logs:        logs,
+		FileHistory: fileHistory,
 	}
 
 	return result, nil
@@ -228,6 +241,7 @@ func (u *ResumeUsecase) Update(ctx context.Context, req *domain.UpdateResumeReq)
 		// 更新简历主表字段
 		if req.Name != nil {
 			updateOne.SetName(*req.Name)
+			updateOne.SetNormalizedName(normalizeName(*req.Name))
 		}
 		if req.Gender != nil {
 			updateOne.SetGender(*req.Gender)
@@ -240,9 +254,11 @@ func (u *ResumeUsecase) Update(ctx context.Context, req *domain.UpdateResumeReq)
 		}
 		if req.Email != nil {
 			updateOne.SetEmail(*req.Email)
+			updateOne.SetNormalizedEmail(normalizeEmail(*req.Email))
 		}
 		if req.Phone != nil {
 			updateOne.SetPhone(*req.Phone)
+			updateOne.SetNormalizedPhone(normalizePhone(*req.Phone))
 		}
 		if req.CurrentCity != nil {
 			updateOne.SetCurrentCity(*req.CurrentCity)
@@ -308,6 +324,11 @@ func (u *ResumeUsecase) Update(ctx context.Context, req *domain.UpdateResumeReq)
 		return nil, fmt.Errorf("failed to update resume: %w", err)
 	}
 
+	// 简介、工作经历或项目经验变更后重建语义检索分块
+	if req.PersonalSummary != nil || req.Experiences != nil || req.Projects != nil {
+		go u.indexChunks(context.Background(), req.ID)
+	}
+
 	// 处理岗位关联更新（在事务外执行，因为JobApplicationUsecase有自己的事务管理）
 	if req.JobPositionIDs != nil || req.JobApplications != nil {
 		updateJobReq := &domain.UpdateJobApplicationsReq{
@@ -463,6 +484,8 @@ func (u *ResumeUsecase) parseResumeAsync(ctx context.Context, resumeID string) {
 	}
 
 	u.logger.Info("Resume parsed successfully", "resume_id", resumeID)
+	u.detectDuplicates(ctx, resumeID)
+	u.indexChunks(ctx, resumeID)
 }
 
 // parseResumeSync 同步解析简历，用于批量上传场景
@@ -504,6 +527,8 @@ func (u *ResumeUsecase) parseResumeSync(ctx context.Context, resumeID string) er
 	}
 
 	u.logger.Info("Resume parsed successfully", "resume_id", resumeID)
+	u.detectDuplicates(ctx, resumeID)
+	u.indexChunks(ctx, resumeID)
 	return nil
 }
 
@@ -581,12 +606,15 @@ func (u *ResumeUsecase) updateParsedData(ctx context.Context, resumeID string, d
 		if data.BasicInfo != nil {
 			if data.BasicInfo.Name != "" {
 				updateOne.SetName(data.BasicInfo.Name)
+				updateOne.SetNormalizedName(normalizeName(data.BasicInfo.Name))
 			}
 			if data.BasicInfo.Phone != "" {
 				updateOne.SetPhone(data.BasicInfo.Phone)
+				updateOne.SetNormalizedPhone(normalizePhone(data.BasicInfo.Phone))
 			}
 			if data.BasicInfo.Email != "" {
 				updateOne.SetEmail(data.BasicInfo.Email)
+				updateOne.SetNormalizedEmail(normalizeEmail(data.BasicInfo.Email))
 			}
 			if data.BasicInfo.Gender != "" {
 				updateOne.SetGender(data.BasicInfo.Gender)
@@ -819,6 +847,22 @@ func (u *ResumeUsecase) getLogsByResumeID(ctx context.Context, resumeID string)
 	return logs, nil
 }
 
+// getFileHistoryByResumeID 获取合并到简历的历史简历文件
+func (u *ResumeUsecase) getFileHistoryByResumeID(ctx context.Context, resumeID string) ([]*domain.ResumeFileHistory, error) {
+	merged, err := u.repo.ListMergedResumes(ctx, resumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*domain.ResumeFileHistory, 0, len(merged))
+	for _, resume := range merged {
+		item := &domain.ResumeFileHistory{}
+		history = append(history, item.From(resume))
+	}
+
+	return history, nil
+}
+
 // updateEducations 更新教育经历
 func (u *ResumeUsecase) updateEducations(ctx context.Context, tx *db.Tx, resumeID uuid.UUID, educations []*domain.UpdateResumeEducation) error {
 	// 删除需要删除的教育经历
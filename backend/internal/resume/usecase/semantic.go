@@ -0,0 +1,437 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/errcode"
+)
+
+const (
+	// defaultSemanticSearchLimit 语义检索默认返回的简历数量
+	defaultSemanticSearchLimit = 20
+	// chunkCandidateFactor 每份简历预取的候选分块数，用于聚合后仍能凑满结果
+	chunkCandidateFactor = 5
+	// maxSnippetsPerResume 每份简历最多返回的命中片段数
+	maxSnippetsPerResume = 3
+	// maxChunkRunes 单个分块的最大字符数，过长的经历按行拆分
+	maxChunkRunes = 800
+	// snippetRunes 片段展示的最大字符数
+	snippetRunes = 160
+	// vectorWeight 综合得分中向量相似度的权重，其余为关键词命中率
+	vectorWeight = 0.8
+	// defaultReindexBatchSize 重建分块时每批默认处理的简历数量
+	defaultReindexBatchSize = 100
+)
+
+// queryStopChars 拆分中文查询时作为分隔的虚词
+var queryStopChars = map[rune]bool{
+	'的': true, '了': true, '过': true, '和': true, '与': true, '及': true, '或': true,
+	'在': true, '是': true, '有': true, '做': true, '会': true, '能': true, '找': true,
+}
+
+// queryStopWords 英文查询中忽略的常见词
+var queryStopWords = map[string]bool{
+	"a": true, "an": true, "the": true, "in": true, "on": true, "of": true, "and": true, "or": true,
+	"with": true, "for": true, "to": true, "who": true, "that": true, "have": true, "has": true,
+	"find": true, "people": true, "person": true, "candidates": true,
+}
+
+// SemanticSearch 语义检索简历：在满足结构化筛选条件的简历中按分块向量相似度召回，
+// 结合关键词命中率排序，并返回高亮的命中片段
+func (u *ResumeUsecase) SemanticSearch(ctx context.Context, req *domain.SemanticSearchResumeReq) (*domain.SemanticSearchResumeResp, error) {
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return nil, errcode.ErrInvalidParam.WithData("message", "查询内容不能为空")
+	}
+	if u.embeddingService == nil || !u.embeddingService.Enabled() {
+		return nil, errcode.ErrResumeSemanticSearchUnavailable
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSemanticSearchLimit
+	}
+
+	vectors, err := u.embeddingService.EmbedTexts(ctx, []string{query})
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed query: %w", err)
+	}
+	if len(vectors) == 0 {
+		return nil, fmt.Errorf("failed to embed query: empty vector")
+	}
+
+	matches, err := u.repo.SearchChunks(ctx, req.Filter(), vectors[0], limit*chunkCandidateFactor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search resume chunks: %w", err)
+	}
+
+	hits := rankChunkMatches(matches, queryTerms(query), limit)
+	if len(hits) == 0 {
+		return &domain.SemanticSearchResumeResp{Results: []*domain.SemanticResumeHit{}}, nil
+	}
+
+	ids := make([]string, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.resumeID)
+	}
+	resumes, err := u.repo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resumes: %w", err)
+	}
+	byID := make(map[string]*db.Resume, len(resumes))
+	for _, resume := range resumes {
+		byID[resume.ID.String()] = resume
+	}
+
+	results := make([]*domain.SemanticResumeHit, 0, len(hits))
+	for _, hit := range hits {
+		resume, ok := byID[hit.resumeID]
+		if !ok {
+			continue
+		}
+		results = append(results, &domain.SemanticResumeHit{
+			Resume:   (&domain.Resume{}).From(resume),
+			Score:    hit.score,
+			Snippets: hit.snippets,
+		})
+	}
+
+	return &domain.SemanticSearchResumeResp{Results: results}, nil
+}
+
+// indexChunks 将简历个人简介、工作经历与项目经验分块向量化，失败时仅记录日志
+func (u *ResumeUsecase) indexChunks(ctx context.Context, resumeID string) {
+	if u.embeddingService == nil || !u.embeddingService.Enabled() {
+		return
+	}
+
+	count, err := u.rebuildChunks(ctx, resumeID)
+	if err != nil {
+		u.logger.Error("Failed to index resume chunks", "error", err, "resume_id", resumeID)
+		return
+	}
+	u.logger.Info("Resume chunks indexed", "resume_id", resumeID, "count", count)
+}
+
+// rebuildChunks 重新生成并保存简历分块，返回分块数量
+func (u *ResumeUsecase) rebuildChunks(ctx context.Context, resumeID string) (int, error) {
+	resume, err := u.repo.GetByID(ctx, resumeID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get resume: %w", err)
+	}
+
+	chunks := buildResumeChunks(resume)
+	if len(chunks) > 0 {
+		contents := make([]string, 0, len(chunks))
+		for _, chunk := range chunks {
+			contents = append(contents, chunk.Content)
+		}
+		vectors, err := u.embeddingService.EmbedTexts(ctx, contents)
+		if err != nil {
+			return 0, fmt.Errorf("failed to embed chunks: %w", err)
+		}
+		for i := range chunks {
+			chunks[i].Vector = &vectors[i]
+		}
+	}
+
+	if err := u.repo.ReplaceChunks(ctx, resumeID, chunks); err != nil {
+		return 0, fmt.Errorf("failed to save chunks: %w", err)
+	}
+	return len(chunks), nil
+}
+
+// ReindexChunks 按简历ID游标分批重建语义检索分块，用于回填功能上线前的存量简历或更换嵌入模型后重建。
+// 单份简历失败不影响本批其余简历，失败的ID随响应返回
+func (u *ResumeUsecase) ReindexChunks(ctx context.Context, req *domain.ReindexResumeChunksReq) (*domain.ReindexResumeChunksResp, error) {
+	if u.embeddingService == nil || !u.embeddingService.Enabled() {
+		return nil, errcode.ErrResumeSemanticSearchUnavailable
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultReindexBatchSize
+	}
+
+	ids, err := u.repo.ListIndexableResumeIDs(ctx, req.AfterID, req.OnlyMissing, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexable resumes: %w", err)
+	}
+
+	resp := &domain.ReindexResumeChunksResp{Failed: []string{}}
+	for _, id := range ids {
+		if _, err := u.rebuildChunks(ctx, id); err != nil {
+			u.logger.Error("Failed to reindex resume chunks", "error", err, "resume_id", id)
+			resp.Failed = append(resp.Failed, id)
+			continue
+		}
+		resp.Processed++
+	}
+	// 本批未取满说明已无后续简历
+	if len(ids) == limit {
+		resp.NextAfterID = ids[len(ids)-1]
+	}
+
+	u.logger.Info("Resume chunks reindexed", "processed", resp.Processed, "failed", len(resp.Failed), "next_after_id", resp.NextAfterID)
+	return resp, nil
+}
+
+// buildResumeChunks 按个人简介、工作经历与项目经验拆分简历文本
+func buildResumeChunks(resume *db.Resume) []*db.ResumeChunk {
+	var chunks []*db.ResumeChunk
+	add := func(chunkType consts.ResumeChunkType, sourceID *uuid.UUID, lines ...string) {
+		text := joinNonEmpty("\n", lines...)
+		for _, part := range splitChunkText(text, maxChunkRunes) {
+			chunks = append(chunks, &db.ResumeChunk{ChunkType: chunkType, SourceID: sourceID, Content: part})
+		}
+	}
+
+	add(consts.ResumeChunkTypeSummary, nil, resume.PersonalSummary)
+
+	for _, exp := range resume.Edges.Experiences {
+		if strings.TrimSpace(exp.Description) == "" {
+			continue
+		}
+		id := exp.ID
+		add(consts.ResumeChunkTypeExperience, &id,
+			joinNonEmpty(" · ", exp.Company, exp.Position, exp.Title),
+			exp.Description,
+		)
+	}
+
+	for _, project := range resume.Edges.Projects {
+		body := joinNonEmpty("\n", project.Description, project.Responsibilities, project.Achievements)
+		if strings.TrimSpace(body) == "" {
+			continue
+		}
+		id := project.ID
+		add(consts.ResumeChunkTypeProject, &id,
+			joinNonEmpty(" · ", project.Name, project.Role, project.Company),
+			body,
+			project.Technologies,
+		)
+	}
+
+	return chunks
+}
+
+// splitChunkText 按行拆分过长文本，单行超长时按字符截断
+func splitChunkText(text string, maxRunes int) []string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil
+	}
+
+	var (
+		parts   []string
+		current []rune
+	)
+	flush := func() {
+		if s := strings.TrimSpace(string(current)); s != "" {
+			parts = append(parts, s)
+		}
+		current = current[:0]
+	}
+	for _, line := range strings.Split(text, "\n") {
+		runes := []rune(line)
+		for len(runes) > maxRunes {
+			flush()
+			current = append(current, runes[:maxRunes]...)
+			flush()
+			runes = runes[maxRunes:]
+		}
+		if len(current)+len(runes)+1 > maxRunes {
+			flush()
+		}
+		if len(current) > 0 {
+			current = append(current, '\n')
+		}
+		current = append(current, runes...)
+	}
+	flush()
+	return parts
+}
+
+func joinNonEmpty(sep string, values ...string) string {
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			parts = append(parts, v)
+		}
+	}
+	return strings.Join(parts, sep)
+}
+
+// semanticHit 按简历聚合后的检索结果
+type semanticHit struct {
+	resumeID string
+	score    float64
+	snippets []*domain.ResumeSnippet
+}
+
+// rankChunkMatches 按简历聚合命中分块：综合得分 = 最佳分块相似度 * vectorWeight + 关键词命中率 * (1 - vectorWeight)
+func rankChunkMatches(matches []*domain.ResumeChunkMatch, terms []string, limit int) []*semanticHit {
+	var (
+		order   []string
+		grouped = make(map[string][]*domain.ResumeChunkMatch)
+	)
+	for _, match := range matches {
+		id := match.ResumeID.String()
+		if _, ok := grouped[id]; !ok {
+			order = append(order, id)
+		}
+		grouped[id] = append(grouped[id], match)
+	}
+
+	hits := make([]*semanticHit, 0, len(order))
+	for _, id := range order {
+		chunks := grouped[id]
+		sort.SliceStable(chunks, func(i, j int) bool { return chunks[i].Distance < chunks[j].Distance })
+
+		matched := make(map[string]bool, len(terms))
+		snippets := make([]*domain.ResumeSnippet, 0, maxSnippetsPerResume)
+		for _, chunk := range chunks {
+			for _, term := range terms {
+				if containsFold(chunk.Content, term) {
+					matched[term] = true
+				}
+			}
+			if len(snippets) == maxSnippetsPerResume {
+				continue
+			}
+			snippet := &domain.ResumeSnippet{
+				ChunkType:  chunk.ChunkType,
+				Content:    highlightSnippet(chunk.Content, terms, snippetRunes),
+				Similarity: 1 - chunk.Distance,
+			}
+			if chunk.SourceID != nil {
+				sourceID := chunk.SourceID.String()
+				snippet.SourceID = &sourceID
+			}
+			snippets = append(snippets, snippet)
+		}
+
+		score := vectorWeight * (1 - chunks[0].Distance)
+		if len(terms) > 0 {
+			score += (1 - vectorWeight) * float64(len(matched)) / float64(len(terms))
+		}
+		hits = append(hits, &semanticHit{resumeID: id, score: score, snippets: snippets})
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].score > hits[j].score })
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
+
+// queryTerms 从自然语言查询中提取用于关键词命中与高亮的词
+func queryTerms(query string) []string {
+	var (
+		terms   []string
+		seen    = make(map[string]bool)
+		current []rune
+	)
+	flush := func() {
+		term := string(current)
+		current = current[:0]
+		if term == "" || seen[term] || queryStopWords[term] {
+			return
+		}
+		// 单个英文字母或单个汉字区分度太低
+		if len([]rune(term)) < 2 {
+			return
+		}
+		seen[term] = true
+		terms = append(terms, term)
+	}
+
+	var prevHan bool
+	for _, r := range strings.ToLower(query) {
+		isHan := unicode.Is(unicode.Han, r)
+		switch {
+		case queryStopChars[r] || !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '+' || r == '#'):
+			flush()
+		case len(current) > 0 && isHan != prevHan:
+			// 中英文混排时在边界处切分，如 "Go工程师"
+			flush()
+			current = append(current, r)
+		default:
+			current = append(current, r)
+		}
+		prevHan = isHan
+	}
+	flush()
+	return terms
+}
+
+// highlightSnippet 截取首个命中词附近的文本，并以 <em></em> 标记命中的词，其余文本做 HTML 转义
+func highlightSnippet(content string, terms []string, maxRunes int) string {
+	runes := []rune(content)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	marked := make([]bool, len(runes))
+	first := -1
+	for _, term := range terms {
+		t := []rune(term)
+		for i := 0; i+len(t) <= len(lower); i++ {
+			if string(lower[i:i+len(t)]) != term {
+				continue
+			}
+			for j := i; j < i+len(t); j++ {
+				marked[j] = true
+			}
+			if first < 0 || i < first {
+				first = i
+			}
+		}
+	}
+
+	start := 0
+	if first > maxRunes/4 {
+		start = first - maxRunes/4
+	}
+	end := min(start+maxRunes, len(runes))
+	if end-start < maxRunes {
+		start = max(0, end-maxRunes)
+	}
+
+	var sb strings.Builder
+	if start > 0 {
+		sb.WriteString("…")
+	}
+	inMark := false
+	for i := start; i < end; i++ {
+		if marked[i] && !inMark {
+			sb.WriteString("<em>")
+			inMark = true
+		} else if !marked[i] && inMark {
+			sb.WriteString("</em>")
+			inMark = false
+		}
+		sb.WriteString(html.EscapeString(string(runes[i])))
+	}
+	if inMark {
+		sb.WriteString("</em>")
+	}
+	if end < len(runes) {
+		sb.WriteString("…")
+	}
+	return sb.String()
+}
+
+// containsFold 大小写不敏感的子串判断，term 需已转为小写
+func containsFold(s, term string) bool {
+	return strings.Contains(strings.ToLower(s), term)
+}
@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/errcode"
+)
+
+type fakeEmbeddingService struct {
+	enabled bool
+	texts   []string
+}
+
+func (f *fakeEmbeddingService) Enabled() bool { return f.enabled }
+
+func (f *fakeEmbeddingService) EmbedTexts(_ context.Context, texts []string) ([]pgvector.Vector, error) {
+	f.texts = append(f.texts, texts...)
+	vectors := make([]pgvector.Vector, len(texts))
+	for i := range texts {
+		vectors[i] = pgvector.NewVector([]float32{float32(i), 1})
+	}
+	return vectors, nil
+}
+
+type fakeSemanticRepo struct {
+	domain.ResumeRepo
+	resume  *db.Resume
+	matches []*domain.ResumeChunkMatch
+	filter  *domain.SearchResumeReq
+	limit   int
+	chunks  []*db.ResumeChunk
+}
+
+func (f *fakeSemanticRepo) GetByID(context.Context, string) (*db.Resume, error) {
+	return f.resume, nil
+}
+
+func (f *fakeSemanticRepo) ReplaceChunks(_ context.Context, _ string, chunks []*db.ResumeChunk) error {
+	f.chunks = chunks
+	return nil
+}
+
+func (f *fakeSemanticRepo) SearchChunks(_ context.Context, filter *domain.SearchResumeReq, _ pgvector.Vector, limit int) ([]*domain.ResumeChunkMatch, error) {
+	f.filter, f.limit = filter, limit
+	return f.matches, nil
+}
+
+func (f *fakeSemanticRepo) ListByIDs(_ context.Context, ids []string) ([]*db.Resume, error) {
+	resumes := make([]*db.Resume, 0, len(ids))
+	for _, id := range ids {
+		resumes = append(resumes, &db.Resume{ID: uuid.MustParse(id), Name: "候选人" + id[:4]})
+	}
+	return resumes, nil
+}
+
+func TestQueryTerms(t *testing.T) {
+	assert.Equal(t, []string{"支付系统", "go", "工程师"}, queryTerms("做过支付系统的Go工程师"))
+	assert.Equal(t, []string{"built", "payment", "systems", "go"}, queryTerms("find people who built payment systems in Go"))
+	assert.Equal(t, []string{"c++", "分布式存储"}, queryTerms("C++ 分布式存储"))
+}
+
+func TestHighlightSnippet(t *testing.T) {
+	assert.Equal(t, "负责<em>支付系统</em>核心链路，使用 <em>Go</em> &amp; gRPC",
+		highlightSnippet("负责支付系统核心链路，使用 Go & gRPC", []string{"支付系统", "go"}, 160))
+
+	long := strings.Repeat("前", 100) + "支付" + strings.Repeat("后", 100)
+	snippet := highlightSnippet(long, []string{"支付"}, 40)
+	assert.True(t, strings.HasPrefix(snippet, "…"))
+	assert.True(t, strings.HasSuffix(snippet, "…"))
+	assert.Contains(t, snippet, "<em>支付</em>")
+
+	assert.Equal(t, "无命中", highlightSnippet("无命中", []string{"go"}, 160))
+}
+
+func TestBuildResumeChunks(t *testing.T) {
+	expID, projectID := uuid.New(), uuid.New()
+	resume := &db.Resume{
+		PersonalSummary: "五年后端开发经验",
+		Edges: db.ResumeEdges{
+			Experiences: []*db.ResumeExperience{
+				{ID: expID, Company: "某支付公司", Position: "后端工程师", Description: "负责支付网关开发"},
+				{ID: uuid.New(), Company: "无描述公司"},
+			},
+			Projects: []*db.ResumeProject{
+				{ID: projectID, Name: "清结算系统", Role: "负责人", Description: "日均千万笔交易", Technologies: "Go, Kafka"},
+			},
+		},
+	}
+
+	chunks := buildResumeChunks(resume)
+	require.Len(t, chunks, 3)
+	assert.Equal(t, consts.ResumeChunkTypeSummary, chunks[0].ChunkType)
+	assert.Nil(t, chunks[0].SourceID)
+	assert.Equal(t, consts.ResumeChunkTypeExperience, chunks[1].ChunkType)
+	assert.Equal(t, expID, *chunks[1].SourceID)
+	assert.Equal(t, "某支付公司 · 后端工程师\n负责支付网关开发", chunks[1].Content)
+	assert.Equal(t, consts.ResumeChunkTypeProject, chunks[2].ChunkType)
+	assert.Equal(t, "清结算系统 · 负责人\n日均千万笔交易\nGo, Kafka", chunks[2].Content)
+
+	parts := splitChunkText(strings.Repeat("a", 25)+"\n"+strings.Repeat("b", 5)+"\n"+strings.Repeat("c", 8), 11)
+	assert.Equal(t, []string{"aaaaaaaaaaa", "aaaaaaaaaaa", "aaa\nbbbbb", "cccccccc"}, parts)
+}
+
+func TestIndexChunks(t *testing.T) {
+	repo := &fakeSemanticRepo{resume: &db.Resume{PersonalSummary: "熟悉支付业务"}}
+	embedder := &fakeEmbeddingService{enabled: true}
+	u := &ResumeUsecase{repo: repo, embeddingService: embedder, logger: slog.Default()}
+
+	u.indexChunks(context.Background(), uuid.NewString())
+	require.Len(t, repo.chunks, 1)
+	assert.Equal(t, []string{"熟悉支付业务"}, embedder.texts)
+	require.NotNil(t, repo.chunks[0].Vector)
+
+	// 未配置嵌入模型时跳过
+	repo.chunks = nil
+	embedder.enabled = false
+	u.indexChunks(context.Background(), uuid.NewString())
+	assert.Nil(t, repo.chunks)
+}
+
+func TestSemanticSearch(t *testing.T) {
+	strong, weak := uuid.New(), uuid.New()
+	city := "上海"
+	repo := &fakeSemanticRepo{matches: []*domain.ResumeChunkMatch{
+		{ResumeID: weak, ChunkType: consts.ResumeChunkTypeSummary, Content: "做过电商后台", Distance: 0.2},
+		{ResumeID: strong, ChunkType: consts.ResumeChunkTypeExperience, Content: "用 Go 搭建支付系统", Distance: 0.25},
+		{ResumeID: strong, ChunkType: consts.ResumeChunkTypeProject, Content: "支付对账平台", Distance: 0.4},
+	}}
+	u := &ResumeUsecase{repo: repo, embeddingService: &fakeEmbeddingService{enabled: true}, logger: slog.Default()}
+
+	resp, err := u.SemanticSearch(context.Background(), &domain.SemanticSearchResumeReq{
+		Query:       "做过支付系统的Go工程师",
+		Limit:       10,
+		CurrentCity: &city,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &city, repo.filter.CurrentCity)
+	assert.Equal(t, 10*chunkCandidateFactor, repo.limit)
+
+	require.Len(t, resp.Results, 2)
+	top := resp.Results[0]
+	assert.Equal(t, strong.String(), top.Resume.ID)
+	// 0.8 * (1 - 0.25) + 0.2 * 2/3
+	assert.InDelta(t, 0.7333, top.Score, 1e-3)
+	require.Len(t, top.Snippets, 2)
+	assert.Equal(t, "用 <em>Go</em> 搭建<em>支付系统</em>", top.Snippets[0].Content)
+	assert.InDelta(t, 0.75, top.Snippets[0].Similarity, 1e-9)
+	assert.Equal(t, weak.String(), resp.Results[1].Resume.ID)
+
+	u.embeddingService = &fakeEmbeddingService{}
+	_, err = u.SemanticSearch(context.Background(), &domain.SemanticSearchResumeReq{Query: "Go"})
+	assert.ErrorIs(t, err, errcode.ErrResumeSemanticSearchUnavailable)
+}
+
+type fakeReindexRepo struct {
+	fakeSemanticRepo
+	ids      []string
+	afterID  string
+	missing  bool
+	limit    int
+	failing  string
+	replaced []string
+}
+
+func (f *fakeReindexRepo) ListIndexableResumeIDs(_ context.Context, afterID string, onlyMissing bool, limit int) ([]string, error) {
+	f.afterID, f.missing, f.limit = afterID, onlyMissing, limit
+	return f.ids, nil
+}
+
+func (f *fakeReindexRepo) GetByID(_ context.Context, id string) (*db.Resume, error) {
+	if id == f.failing {
+		return nil, errors.New("resume not found")
+	}
+	return &db.Resume{PersonalSummary: "存量简历" + id}, nil
+}
+
+func (f *fakeReindexRepo) ReplaceChunks(_ context.Context, resumeID string, _ []*db.ResumeChunk) error {
+	f.replaced = append(f.replaced, resumeID)
+	return nil
+}
+
+func TestReindexChunks(t *testing.T) {
+	ids := []string{uuid.NewString(), uuid.NewString(), uuid.NewString()}
+	repo := &fakeReindexRepo{ids: ids, failing: ids[1]}
+	embedder := &fakeEmbeddingService{enabled: true}
+	u := &ResumeUsecase{repo: repo, embeddingService: embedder, logger: slog.Default()}
+
+	// 取满一批时返回下一批游标，单份失败不影响其余简历
+	resp, err := u.ReindexChunks(context.Background(), &domain.ReindexResumeChunksReq{AfterID: "start", Limit: 3, OnlyMissing: true})
+	require.NoError(t, err)
+	assert.Equal(t, "start", repo.afterID)
+	assert.True(t, repo.missing)
+	assert.Equal(t, 2, resp.Processed)
+	assert.Equal(t, []string{ids[1]}, resp.Failed)
+	assert.Equal(t, ids[2], resp.NextAfterID)
+	assert.Equal(t, []string{ids[0], ids[2]}, repo.replaced)
+	assert.Len(t, embedder.texts, 2)
+
+	// 未取满说明已处理完毕
+	resp, err = u.ReindexChunks(context.Background(), &domain.ReindexResumeChunksReq{})
+	require.NoError(t, err)
+	assert.Equal(t, defaultReindexBatchSize, repo.limit)
+	assert.Empty(t, resp.NextAfterID)
+
+	u.embeddingService = &fakeEmbeddingService{}
+	_, err = u.ReindexChunks(context.Background(), &domain.ReindexResumeChunksReq{})
+	assert.ErrorIs(t, err, errcode.ErrResumeSemanticSearchUnavailable)
+}
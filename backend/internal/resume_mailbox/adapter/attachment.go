@@ -0,0 +1,108 @@
+package adapter
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/emersion/go-message/mail"
+
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+// maxInlineBodySize 单个正文分段读取上限，避免超大正文占用过多内存
+const maxInlineBodySize = 2 << 20
+
+// extractMailContent 读取邮件中的全部附件与正文，附件按 messageKey+内容哈希 去重，供各协议适配器复用
+func extractMailContent(logger *slog.Logger, mailbox, messageKey string, reader *mail.Reader, seen map[string]struct{}, email *domain.MailboxEmail) {
+	attachments := []*domain.MailboxAttachment{}
+	defer func() {
+		email.Attachments = attachments
+	}()
+	for {
+		part, errNext := reader.NextPart()
+		if errNext == io.EOF {
+			break
+		}
+		if errNext != nil {
+			logger.Warn("读取邮件分段失败",
+				slog.String("mailbox", mailbox),
+				slog.String("message_key", messageKey),
+				slog.String("error", errNext.Error()),
+			)
+			break
+		}
+
+		if inline, ok := part.Header.(*mail.InlineHeader); ok {
+			readInlineBody(inline, part.Body, email)
+			continue
+		}
+
+		header, ok := part.Header.(*mail.AttachmentHeader)
+		if !ok {
+			continue
+		}
+
+		filename, _ := header.Filename()
+		filename = strings.TrimSpace(filename)
+		if filename == "" {
+			continue
+		}
+
+		contentType, _, _ := header.ContentType()
+
+		buf := &bytes.Buffer{}
+		size, copyErr := io.Copy(buf, part.Body)
+		if copyErr != nil {
+			logger.Warn("读取附件内容失败",
+				slog.String("mailbox", mailbox),
+				slog.String("message_key", messageKey),
+				slog.String("filename", filename),
+				slog.String("error", copyErr.Error()),
+			)
+			continue
+		}
+
+		data := buf.Bytes()
+		hash := sha256.Sum256(data)
+		hashStr := hex.EncodeToString(hash[:])
+
+		dedupKey := fmt.Sprintf("%s:%s", messageKey, hashStr)
+		if _, exists := seen[dedupKey]; exists {
+			continue
+		}
+		seen[dedupKey] = struct{}{}
+
+		attachments = append(attachments, &domain.MailboxAttachment{
+			Filename:    filename,
+			ContentType: contentType,
+			Content:     data,
+			Size:        size,
+			Hash:        hashStr,
+			Reader:      bytes.NewReader(data),
+		})
+	}
+}
+
+// readInlineBody 读取正文分段，同类型正文只保留第一段
+func readInlineBody(header *mail.InlineHeader, body io.Reader, email *domain.MailboxEmail) {
+	contentType, _, _ := header.ContentType()
+	switch {
+	case strings.EqualFold(contentType, "text/html") && email.HTMLBody == "":
+		data, _ := io.ReadAll(io.LimitReader(body, maxInlineBodySize))
+		email.HTMLBody = string(data)
+	case (contentType == "" || strings.EqualFold(contentType, "text/plain")) && email.TextBody == "":
+		data, _ := io.ReadAll(io.LimitReader(body, maxInlineBodySize))
+		email.TextBody = string(data)
+	}
+}
+
+// hasExtractableContent 邮件包含附件或正文时才需要交给同步流程处理，
+// 正文可能在正文/链接提取模式下产出简历
+func hasExtractableContent(email *domain.MailboxEmail) bool {
+	return len(email.Attachments) > 0 || strings.TrimSpace(email.HTMLBody) != "" || strings.TrimSpace(email.TextBody) != ""
+}
@@ -24,6 +24,8 @@ func NewAdapterFactory(logger *slog.Logger) domain.MailboxAdapterFactory {
 
 	// 注册内置适配器
 	f.RegisterAdapter(NewIMAPAdapter(logger))
+	f.RegisterAdapter(NewPOP3Adapter(logger))
+	f.RegisterAdapter(NewGraphAdapter(logger))
 
 	return f
 }
@@ -0,0 +1,513 @@
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+const (
+	defaultGraphTimeout       = 30 * time.Second
+	defaultGraphAuthorityHost = "https://login.microsoftonline.com"
+	defaultGraphScope         = "https://graph.microsoft.com/.default"
+	// graphTokenRefreshSkew 令牌过期前提前刷新的时间
+	graphTokenRefreshSkew = 2 * time.Minute
+	// maxGraphSeenIDs 游标中保留的已处理邮件ID数量上限，超出时丢弃最早的记录
+	maxGraphSeenIDs = 2000
+	// graphSinceSkew 首次同步时间的容差，避免邮件服务器与本地时钟偏差导致漏收新邮件
+	graphSinceSkew = 5 * time.Minute
+)
+
+// graphCursor Graph 增量查询游标，Link 为 deltaLink（本轮已完成）或 nextLink（本轮未拉取完）。
+// 增量查询除新邮件外还会返回已读状态、移动、分类等变更的旧邮件，
+// 因此记录已处理的邮件ID，并以首次同步时间为界跳过开始监控前收到的邮件
+type graphCursor struct {
+	Link    string    `json:"link"`
+	SeenIDs []string  `json:"seen_ids,omitempty"`
+	Since   time.Time `json:"since,omitempty"`
+}
+
+type graphToken struct {
+	accessToken string
+	expiresAt   time.Time
+}
+
+type graphRecipient struct {
+	EmailAddress struct {
+		Address string `json:"address"`
+	} `json:"emailAddress"`
+}
+
+type graphMessage struct {
+	ID                string            `json:"id"`
+	Subject           string            `json:"subject"`
+	From              *graphRecipient   `json:"from,omitempty"`
+	ToRecipients      []*graphRecipient `json:"toRecipients,omitempty"`
+	CcRecipients      []*graphRecipient `json:"ccRecipients,omitempty"`
+	InternetMessageID string            `json:"internetMessageId"`
+	ReceivedDateTime  time.Time         `json:"receivedDateTime"`
+	HasAttachments    bool              `json:"hasAttachments"`
+	Body              *struct {
+		ContentType string `json:"contentType"`
+		Content     string `json:"content"`
+	} `json:"body,omitempty"`
+	Removed *struct {
+		Reason string `json:"reason"`
+	} `json:"@removed,omitempty"`
+}
+
+type graphDeltaPage struct {
+	Value     []*graphMessage `json:"value"`
+	NextLink  string          `json:"@odata.nextLink"`
+	DeltaLink string          `json:"@odata.deltaLink"`
+}
+
+type graphAttachment struct {
+	ODataType    string `json:"@odata.type"`
+	Name         string `json:"name"`
+	ContentType  string `json:"contentType"`
+	Size         int64  `json:"size"`
+	IsInline     bool   `json:"isInline"`
+	ContentBytes string `json:"contentBytes"`
+}
+
+// GraphAdapter Microsoft Graph 邮件API适配器，使用 OAuth2 客户端凭据访问 Exchange Online 邮箱
+type GraphAdapter struct {
+	logger        *slog.Logger
+	client        *http.Client
+	authorityHost string
+
+	mu     sync.Mutex
+	tokens map[string]*graphToken
+	// fetches 合并同一租户与应用的并发令牌请求，令牌请求不持有 mu，避免阻塞其他邮箱
+	fetches singleflight.Group
+}
+
+// NewGraphAdapter 创建 Microsoft Graph 协议适配器
+func NewGraphAdapter(logger *slog.Logger) domain.MailboxProtocolAdapter {
+	return newGraphAdapter(logger, &http.Client{Timeout: defaultGraphTimeout}, defaultGraphAuthorityHost)
+}
+
+func newGraphAdapter(logger *slog.Logger, client *http.Client, authorityHost string) *GraphAdapter {
+	return &GraphAdapter{
+		logger:        logger,
+		client:        client,
+		authorityHost: strings.TrimRight(authorityHost, "/"),
+		tokens:        make(map[string]*graphToken),
+	}
+}
+
+// GetProtocol 返回协议标识
+func (a *GraphAdapter) GetProtocol() string {
+	return string(consts.MailboxProtocolGraph)
+}
+
+// TestConnection 测试连接是否可用：获取令牌并读取目标邮件文件夹
+func (a *GraphAdapter) TestConnection(ctx context.Context, config *domain.MailboxConnectionConfig) error {
+	if config == nil {
+		return fmt.Errorf("缺少邮箱连接配置")
+	}
+
+	folderURL := a.mailboxURL(config) + "/mailFolders/" + url.PathEscape(a.folder(config))
+	if err := a.getJSON(ctx, config, folderURL, &struct{}{}); err != nil {
+		return fmt.Errorf("读取Graph邮件文件夹失败: %w", err)
+	}
+	return nil
+}
+
+// Fetch 通过增量查询拉取新邮件
+func (a *GraphAdapter) Fetch(ctx context.Context, config *domain.MailboxConnectionConfig, req *domain.MailboxFetchRequest) (*domain.MailboxFetchResult, error) {
+	if config == nil {
+		return nil, fmt.Errorf("缺少邮箱连接配置")
+	}
+	if req == nil {
+		req = &domain.MailboxFetchRequest{}
+	}
+	if req.Limit <= 0 {
+		req.Limit = defaultFetchLimit
+	}
+
+	firstSync := strings.TrimSpace(req.Cursor) == ""
+	currentCursor := a.parseCursor(req.Cursor)
+	link := currentCursor.Link
+	if link == "" {
+		firstSync = true
+		link = a.deltaURL(config)
+	}
+	since := currentCursor.Since
+	if firstSync {
+		since = time.Now().Add(-graphSinceSkew)
+	}
+	seenIDs := append([]string(nil), currentCursor.SeenIDs...)
+	seenMessages := make(map[string]struct{}, len(seenIDs))
+	for _, id := range seenIDs {
+		seenMessages[id] = struct{}{}
+	}
+
+	a.logger.Info("开始执行Graph同步请求",
+		slog.String("mailbox", config.EmailAddress),
+		slog.Bool("first_sync", firstSync),
+	)
+
+	result := &domain.MailboxFetchResult{
+		Messages: []*domain.MailboxEmail{},
+	}
+
+	seen := make(map[string]struct{})
+	var (
+		fetched       int
+		lastMessageID string
+		nextLink      string
+	)
+	for link != "" {
+		var page graphDeltaPage
+		if err := a.getJSON(ctx, config, link, &page); err != nil {
+			return nil, fmt.Errorf("Graph增量查询失败: %w", err)
+		}
+
+		// 与IMAP保持一致：首次同步只遍历到 deltaLink 记录当前位置，不回溯历史邮件
+		if !firstSync {
+			for _, msg := range page.Value {
+				if msg == nil || msg.Removed != nil || msg.ID == "" {
+					continue
+				}
+				// 已处理过的邮件或开始监控前收到的邮件属于变更通知，不作为新邮件处理
+				if _, ok := seenMessages[msg.ID]; ok {
+					continue
+				}
+				seenMessages[msg.ID] = struct{}{}
+				seenIDs = append(seenIDs, msg.ID)
+				if !since.IsZero() && !msg.ReceivedDateTime.IsZero() && msg.ReceivedDateTime.Before(since) {
+					continue
+				}
+				fetched++
+
+				email, err := a.buildEmail(ctx, config, msg, seen)
+				if err != nil {
+					return nil, err
+				}
+				if hasExtractableContent(email) {
+					if email.MessageID != "" {
+						lastMessageID = email.MessageID
+					} else {
+						lastMessageID = msg.ID
+					}
+					result.Messages = append(result.Messages, email)
+				}
+			}
+		}
+
+		if page.DeltaLink != "" {
+			nextLink = page.DeltaLink
+			break
+		}
+		nextLink = page.NextLink
+		link = page.NextLink
+		// 超过单次拉取上限时保存 nextLink，剩余邮件留到下次同步
+		if !firstSync && fetched >= req.Limit {
+			break
+		}
+	}
+
+	if nextLink == "" {
+		return nil, fmt.Errorf("Graph增量查询未返回deltaLink或nextLink")
+	}
+
+	nextCursor, err := a.buildCursorString(graphCursor{Link: nextLink, SeenIDs: seenIDs, Since: since})
+	if err != nil {
+		return nil, err
+	}
+
+	result.NextCursor = nextCursor
+	result.LastMessageID = lastMessageID
+	a.logger.Info("Graph同步完成，准备返回游标",
+		slog.String("mailbox", config.EmailAddress),
+		slog.Int("fetched_count", fetched),
+		slog.Int("message_count", len(result.Messages)),
+		slog.String("last_message_id", lastMessageID),
+	)
+
+	return result, nil
+}
+
+// buildEmail 构建邮件摘要，并下载文件类附件
+func (a *GraphAdapter) buildEmail(ctx context.Context, config *domain.MailboxConnectionConfig, msg *graphMessage, seen map[string]struct{}) (*domain.MailboxEmail, error) {
+	email := &domain.MailboxEmail{
+		MessageID:   strings.TrimSpace(msg.InternetMessageID),
+		Subject:     msg.Subject,
+		ReceivedAt:  msg.ReceivedDateTime,
+		Attachments: []*domain.MailboxAttachment{},
+	}
+	if msg.From != nil {
+		email.From = msg.From.EmailAddress.Address
+	}
+	for _, r := range append(msg.ToRecipients, msg.CcRecipients...) {
+		if r != nil && r.EmailAddress.Address != "" {
+			email.Recipients = append(email.Recipients, r.EmailAddress.Address)
+		}
+	}
+	if msg.Body != nil {
+		if strings.EqualFold(msg.Body.ContentType, "html") {
+			email.HTMLBody = msg.Body.Content
+		} else {
+			email.TextBody = msg.Body.Content
+		}
+	}
+	if !msg.HasAttachments {
+		return email, nil
+	}
+
+	var resp struct {
+		Value []*graphAttachment `json:"value"`
+	}
+	attachmentsURL := a.mailboxURL(config) + "/messages/" + url.PathEscape(msg.ID) + "/attachments"
+	if err := a.getJSON(ctx, config, attachmentsURL, &resp); err != nil {
+		return nil, fmt.Errorf("下载Graph邮件附件失败: %w", err)
+	}
+
+	messageKey := email.MessageID
+	if messageKey == "" {
+		messageKey = msg.ID
+	}
+	for _, att := range resp.Value {
+		// 只处理文件附件，忽略内嵌图片、邮件项与引用附件
+		if att == nil || att.IsInline || att.ODataType != "#microsoft.graph.fileAttachment" {
+			continue
+		}
+		filename := strings.TrimSpace(att.Name)
+		if filename == "" {
+			continue
+		}
+
+		data, err := base64.StdEncoding.DecodeString(att.ContentBytes)
+		if err != nil {
+			a.logger.Warn("解码Graph附件内容失败",
+				slog.String("mailbox", config.EmailAddress),
+				slog.String("message_key", messageKey),
+				slog.String("filename", filename),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+
+		hash := sha256.Sum256(data)
+		hashStr := hex.EncodeToString(hash[:])
+		dedupKey := fmt.Sprintf("%s:%s", messageKey, hashStr)
+		if _, exists := seen[dedupKey]; exists {
+			continue
+		}
+		seen[dedupKey] = struct{}{}
+
+		email.RawSize += int64(len(data))
+		email.Attachments = append(email.Attachments, &domain.MailboxAttachment{
+			Filename:    filename,
+			ContentType: att.ContentType,
+			Content:     data,
+			Size:        int64(len(data)),
+			Hash:        hashStr,
+			Reader:      bytes.NewReader(data),
+		})
+	}
+
+	return email, nil
+}
+
+// getJSON 携带访问令牌请求 Graph API 并解析响应
+func (a *GraphAdapter) getJSON(ctx context.Context, config *domain.MailboxConnectionConfig, rawURL string, out interface{}) error {
+	token, err := a.accessToken(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return fmt.Errorf("创建Graph请求失败: %w", err)
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+token)
+	httpReq.Header.Set("Accept", "application/json")
+
+	resp, err := a.client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("请求Graph API失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		// 令牌可能已被吊销，清除缓存以便下次重新获取
+		a.invalidateToken(config)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("Graph API返回状态码 %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("解析Graph响应失败: %w", err)
+	}
+	return nil
+}
+
+// accessToken 使用客户端凭据获取访问令牌，按租户与应用缓存至过期前
+func (a *GraphAdapter) accessToken(ctx context.Context, config *domain.MailboxConnectionConfig) (string, error) {
+	if !strings.EqualFold(config.AuthType, string(consts.MailboxAuthTypeClientCredentials)) {
+		return "", fmt.Errorf("不支持的认证类型: %s", config.AuthType)
+	}
+
+	tenantID, _ := config.Credential["tenant_id"].(string)
+	clientID, _ := config.Credential["client_id"].(string)
+	clientSecret, _ := config.Credential["client_secret"].(string)
+	if tenantID == "" || clientID == "" || clientSecret == "" {
+		return "", fmt.Errorf("缺少Graph客户端凭据")
+	}
+
+	key := tenantID + "/" + clientID
+	if token, ok := a.cachedToken(key); ok {
+		return token, nil
+	}
+
+	token, err, _ := a.fetches.Do(key, func() (any, error) {
+		if token, ok := a.cachedToken(key); ok {
+			return token, nil
+		}
+		token, err := a.requestToken(ctx, tenantID, clientID, clientSecret)
+		if err != nil {
+			return "", err
+		}
+		a.mu.Lock()
+		a.tokens[key] = token
+		a.mu.Unlock()
+		return token.accessToken, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return token.(string), nil
+}
+
+func (a *GraphAdapter) cachedToken(key string) (string, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if cached, ok := a.tokens[key]; ok && time.Now().Before(cached.expiresAt) {
+		return cached.accessToken, true
+	}
+	return "", false
+}
+
+// requestToken 向 OAuth2 令牌端点请求访问令牌
+func (a *GraphAdapter) requestToken(ctx context.Context, tenantID, clientID, clientSecret string) (*graphToken, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", clientID)
+	form.Set("client_secret", clientSecret)
+	form.Set("scope", defaultGraphScope)
+
+	tokenURL := a.authorityHost + "/" + url.PathEscape(tenantID) + "/oauth2/v2.0/token"
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("创建令牌请求失败: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := a.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("获取Graph访问令牌失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var tokenResp struct {
+		AccessToken      string `json:"access_token"`
+		ExpiresIn        int64  `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return nil, fmt.Errorf("解析Graph令牌响应失败: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK || tokenResp.AccessToken == "" {
+		return nil, fmt.Errorf("Graph OAuth2认证失败: %s %s", tokenResp.Error, tokenResp.ErrorDescription)
+	}
+
+	return &graphToken{
+		accessToken: tokenResp.AccessToken,
+		expiresAt:   time.Now().Add(time.Duration(tokenResp.ExpiresIn)*time.Second - graphTokenRefreshSkew),
+	}, nil
+}
+
+func (a *GraphAdapter) invalidateToken(config *domain.MailboxConnectionConfig) {
+	tenantID, _ := config.Credential["tenant_id"].(string)
+	clientID, _ := config.Credential["client_id"].(string)
+	a.mu.Lock()
+	delete(a.tokens, tenantID+"/"+clientID)
+	a.mu.Unlock()
+}
+
+// mailboxURL 目标邮箱的 Graph 资源地址，Host/Port 指向 Graph API 服务（通常为 graph.microsoft.com:443）
+func (a *GraphAdapter) mailboxURL(config *domain.MailboxConnectionConfig) string {
+	scheme := "https"
+	if !config.UseSSL {
+		scheme = "http"
+	}
+	host := config.Host
+	if config.Port > 0 && config.Port != 443 {
+		host = net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
+	}
+	return fmt.Sprintf("%s://%s/v1.0/users/%s", scheme, host, url.PathEscape(config.EmailAddress))
+}
+
+func (a *GraphAdapter) deltaURL(config *domain.MailboxConnectionConfig) string {
+	query := url.Values{}
+	query.Set("$select", "subject,from,toRecipients,ccRecipients,internetMessageId,receivedDateTime,hasAttachments,body")
+	return a.mailboxURL(config) + "/mailFolders/" + url.PathEscape(a.folder(config)) + "/messages/delta?" + query.Encode()
+}
+
+func (a *GraphAdapter) folder(config *domain.MailboxConnectionConfig) string {
+	folder := strings.TrimSpace(config.Folder)
+	if folder == "" || strings.EqualFold(folder, "INBOX") {
+		return "inbox"
+	}
+	return folder
+}
+
+func (a *GraphAdapter) parseCursor(cursor string) graphCursor {
+	if cursor == "" {
+		return graphCursor{}
+	}
+	var value graphCursor
+	if err := json.Unmarshal([]byte(cursor), &value); err != nil {
+		a.logger.Warn("解析Graph游标失败，使用默认游标",
+			slog.String("error", err.Error()),
+		)
+		return graphCursor{}
+	}
+	return value
+}
+
+// buildCursorString 生成下次同步的游标，已处理邮件ID只保留最近的 maxGraphSeenIDs 条
+func (a *GraphAdapter) buildCursorString(cursor graphCursor) (string, error) {
+	if len(cursor.SeenIDs) > maxGraphSeenIDs {
+		cursor.SeenIDs = cursor.SeenIDs[len(cursor.SeenIDs)-maxGraphSeenIDs:]
+	}
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("构建游标数据失败: %w", err)
+	}
+	return string(data), nil
+}
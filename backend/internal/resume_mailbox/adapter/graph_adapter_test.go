@@ -0,0 +1,252 @@
+package adapter
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+// newFakeGraphServer 本地 Graph 替身：令牌端点、增量查询（首轮 deltaLink 之后返回一封带附件的新邮件，
+// 随后返回该邮件及历史邮件的变更通知）与附件下载
+func newFakeGraphServer(t *testing.T) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var tokenRequests int32
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	receivedAt := time.Now().UTC().Format(time.RFC3339)
+	newMessage := map[string]interface{}{
+		"id":                "msg-1",
+		"subject":           "应聘后端工程师",
+		"internetMessageId": "<new1@example.com>",
+		"receivedDateTime":  receivedAt,
+		"hasAttachments":    true,
+	}
+	writeJSON := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	authorized := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return false
+		}
+		return true
+	}
+
+	mux.HandleFunc("/tenant-1/oauth2/v2.0/token", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&tokenRequests, 1)
+		require.NoError(t, r.ParseForm())
+		if r.PostForm.Get("grant_type") != "client_credentials" || r.PostForm.Get("client_secret") != "s3cret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			writeJSON(w, map[string]string{"error": "invalid_client"})
+			return
+		}
+		writeJSON(w, map[string]interface{}{"access_token": "test-token", "expires_in": 3600})
+	})
+	mux.HandleFunc("/v1.0/users/hr@example.com/mailFolders/inbox", func(w http.ResponseWriter, r *http.Request) {
+		if authorized(w, r) {
+			writeJSON(w, map[string]string{"id": "inbox"})
+		}
+	})
+	mux.HandleFunc("/v1.0/users/hr@example.com/mailFolders/inbox/messages/delta", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		switch r.URL.Query().Get("$deltatoken") {
+		case "":
+			if r.URL.Query().Get("$skiptoken") == "" {
+				writeJSON(w, map[string]interface{}{
+					"value":           []map[string]interface{}{{"id": "old-1", "hasAttachments": true}},
+					"@odata.nextLink": server.URL + "/v1.0/users/hr@example.com/mailFolders/inbox/messages/delta?$skiptoken=page2",
+				})
+				return
+			}
+			writeJSON(w, map[string]interface{}{
+				"value":            []map[string]interface{}{},
+				"@odata.deltaLink": server.URL + "/v1.0/users/hr@example.com/mailFolders/inbox/messages/delta?$deltatoken=d1",
+			})
+		case "d1":
+			// 同一页内新邮件出现两次（先创建后标记已读），首次同步前收到的旧邮件因移动被再次返回
+			writeJSON(w, map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"id": "removed-1", "@removed": map[string]string{"reason": "deleted"}},
+					newMessage,
+					newMessage,
+					{"id": "old-1", "receivedDateTime": "2024-05-01T08:00:00Z", "hasAttachments": true},
+				},
+				"@odata.deltaLink": server.URL + "/v1.0/users/hr@example.com/mailFolders/inbox/messages/delta?$deltatoken=d2",
+			})
+		case "d2":
+			// 已处理的邮件被添加分类，再次出现在增量结果中
+			writeJSON(w, map[string]interface{}{
+				"value":            []map[string]interface{}{newMessage},
+				"@odata.deltaLink": server.URL + "/v1.0/users/hr@example.com/mailFolders/inbox/messages/delta?$deltatoken=d3",
+			})
+		default:
+			writeJSON(w, map[string]interface{}{
+				"value":            []map[string]interface{}{},
+				"@odata.deltaLink": r.URL.String(),
+			})
+		}
+	})
+	mux.HandleFunc("/v1.0/users/hr@example.com/messages/msg-1/attachments", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		writeJSON(w, map[string]interface{}{
+			"value": []map[string]interface{}{
+				{
+					"@odata.type":  "#microsoft.graph.fileAttachment",
+					"name":         "张三.pdf",
+					"contentType":  "application/pdf",
+					"contentBytes": base64.StdEncoding.EncodeToString([]byte("resume content")),
+				},
+				{
+					"@odata.type":  "#microsoft.graph.fileAttachment",
+					"name":         "logo.png",
+					"isInline":     true,
+					"contentBytes": base64.StdEncoding.EncodeToString([]byte("png")),
+				},
+				{
+					"@odata.type": "#microsoft.graph.itemAttachment",
+					"name":        "forwarded",
+				},
+			},
+		})
+	})
+
+	return server, &tokenRequests
+}
+
+func graphTestConfig(t *testing.T, server *httptest.Server, secret string) *domain.MailboxConnectionConfig {
+	t.Helper()
+	host, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+
+	return &domain.MailboxConnectionConfig{
+		Host:         host,
+		Port:         port,
+		UseSSL:       false,
+		EmailAddress: "hr@example.com",
+		AuthType:     string(consts.MailboxAuthTypeClientCredentials),
+		Credential: map[string]interface{}{
+			"tenant_id":     "tenant-1",
+			"client_id":     "app-1",
+			"client_secret": secret,
+		},
+	}
+}
+
+func TestGraphAdapterFetch(t *testing.T) {
+	server, tokenRequests := newFakeGraphServer(t)
+	config := graphTestConfig(t, server, "s3cret")
+
+	a := newGraphAdapter(slog.Default(), server.Client(), server.URL)
+	assert.Equal(t, string(consts.MailboxProtocolGraph), a.GetProtocol())
+	require.NoError(t, a.TestConnection(context.Background(), config))
+
+	// 首次同步遍历到 deltaLink，不回溯历史邮件
+	first, err := a.Fetch(context.Background(), config, &domain.MailboxFetchRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, first.Messages)
+	assert.Contains(t, first.NextCursor, "deltatoken=d1")
+
+	second, err := a.Fetch(context.Background(), config, &domain.MailboxFetchRequest{Cursor: first.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, second.Messages, 1)
+	email := second.Messages[0]
+	assert.Equal(t, "<new1@example.com>", email.MessageID)
+	assert.Equal(t, "应聘后端工程师", email.Subject)
+	require.Len(t, email.Attachments, 1)
+	assert.Equal(t, "张三.pdf", email.Attachments[0].Filename)
+	assert.Equal(t, "resume content", string(email.Attachments[0].Content))
+	assert.Equal(t, "<new1@example.com>", second.LastMessageID)
+	assert.Contains(t, second.NextCursor, "deltatoken=d2")
+
+	// 已处理邮件的变更通知不再作为新邮件返回
+	third, err := a.Fetch(context.Background(), config, &domain.MailboxFetchRequest{Cursor: second.NextCursor})
+	require.NoError(t, err)
+	assert.Empty(t, third.Messages)
+	assert.Contains(t, third.NextCursor, "deltatoken=d3")
+
+	// 令牌在有效期内复用
+	assert.Equal(t, int32(1), atomic.LoadInt32(tokenRequests))
+}
+
+func TestGraphAdapterInvalidCredentials(t *testing.T) {
+	server, _ := newFakeGraphServer(t)
+	config := graphTestConfig(t, server, "wrong")
+
+	a := newGraphAdapter(slog.Default(), server.Client(), server.URL)
+	err := a.TestConnection(context.Background(), config)
+	assert.ErrorContains(t, err, "invalid_client")
+}
+
+func TestGraphAdapterSlowTokenDoesNotBlockOtherTenants(t *testing.T) {
+	release := make(chan struct{})
+	var slowRequests int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/tenant-slow/oauth2/v2.0/token", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&slowRequests, 1)
+		<-release
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "slow-token", "expires_in": 3600})
+	})
+	mux.HandleFunc("/tenant-fast/oauth2/v2.0/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "fast-token", "expires_in": 3600})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	defer close(release)
+
+	configFor := func(tenant string) *domain.MailboxConnectionConfig {
+		return &domain.MailboxConnectionConfig{
+			AuthType: string(consts.MailboxAuthTypeClientCredentials),
+			Credential: map[string]interface{}{
+				"tenant_id":     tenant,
+				"client_id":     "app-1",
+				"client_secret": "s3cret",
+			},
+		}
+	}
+
+	a := newGraphAdapter(slog.Default(), server.Client(), server.URL)
+	slowDone := make(chan string, 1)
+	go func() {
+		token, _ := a.accessToken(context.Background(), configFor("tenant-slow"))
+		slowDone <- token
+	}()
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&slowRequests) == 1 }, time.Second, 10*time.Millisecond)
+
+	// 慢速令牌请求进行中，其他租户仍可获取令牌
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	token, err := a.accessToken(ctx, configFor("tenant-fast"))
+	require.NoError(t, err)
+	assert.Equal(t, "fast-token", token)
+
+	release <- struct{}{}
+	assert.Equal(t, "slow-token", <-slowDone)
+	token, err = a.accessToken(context.Background(), configFor("tenant-slow"))
+	require.NoError(t, err)
+	assert.Equal(t, "slow-token", token)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&slowRequests))
+}
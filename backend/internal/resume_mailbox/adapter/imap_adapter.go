@@ -1,14 +1,10 @@
 package adapter
 
 import (
-	"bytes"
 	"context"
-	"crypto/sha256"
 	"crypto/tls"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"io"
 	"log/slog"
 	"math"
 	"net"
@@ -420,6 +416,14 @@ loop:
 			if msg.Envelope != nil {
 				email.Subject = msg.Envelope.Subject
 				email.MessageID = strings.TrimSpace(msg.Envelope.MessageId)
+				if len(msg.Envelope.From) > 0 && msg.Envelope.From[0] != nil {
+					email.From = msg.Envelope.From[0].Address()
+				}
+				for _, addr := range append(msg.Envelope.To, msg.Envelope.Cc...) {
+					if addr != nil {
+						email.Recipients = append(email.Recipients, addr.Address())
+					}
+				}
 				if !msg.Envelope.Date.IsZero() {
 					email.ReceivedAt = msg.Envelope.Date
 				}
@@ -435,68 +439,13 @@ loop:
 				continue
 			}
 
-			for {
-				part, errNext := reader.NextPart()
-				if errNext == io.EOF {
-					break
-				}
-				if errNext != nil {
-					a.logger.Warn("读取邮件分段失败",
-						slog.String("mailbox", config.EmailAddress),
-						slog.Uint64("uid", uint64(msg.Uid)),
-						slog.String("error", errNext.Error()),
-					)
-					break
-				}
-
-				switch header := part.Header.(type) {
-				case *mail.AttachmentHeader:
-					filename, _ := header.Filename()
-					filename = strings.TrimSpace(filename)
-					if filename == "" {
-						continue
-					}
-
-					contentType, _, _ := header.ContentType()
-
-					buf := &bytes.Buffer{}
-					size, copyErr := io.Copy(buf, part.Body)
-					if copyErr != nil {
-						a.logger.Warn("读取附件内容失败",
-							slog.String("mailbox", config.EmailAddress),
-							slog.Uint64("uid", uint64(msg.Uid)),
-							slog.String("filename", filename),
-							slog.String("error", copyErr.Error()),
-						)
-						continue
-					}
-
-					data := buf.Bytes()
-					hash := sha256.Sum256(data)
-					hashStr := hex.EncodeToString(hash[:])
-
-					messageKey := email.MessageID
-					if messageKey == "" {
-						messageKey = strconv.FormatUint(uint64(msg.Uid), 10)
-					}
-					dedupKey := fmt.Sprintf("%s:%s", messageKey, hashStr)
-					if _, exists := seen[dedupKey]; exists {
-						continue
-					}
-					seen[dedupKey] = struct{}{}
-
-					email.Attachments = append(email.Attachments, &domain.MailboxAttachment{
-						Filename:    filename,
-						ContentType: contentType,
-						Content:     data,
-						Size:        size,
-						Hash:        hashStr,
-						Reader:      bytes.NewReader(data),
-					})
-				}
+			messageKey := email.MessageID
+			if messageKey == "" {
+				messageKey = strconv.FormatUint(uint64(msg.Uid), 10)
 			}
+			extractMailContent(a.logger, config.EmailAddress, messageKey, reader, seen, email)
 
-			if len(email.Attachments) > 0 {
+			if hasExtractableContent(email) {
 				if email.MessageID != "" {
 					lastMessageID = email.MessageID
 				} else {
@@ -0,0 +1,431 @@
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emersion/go-message/mail"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/pkg/netutil"
+)
+
+const defaultPOP3Timeout = 30 * time.Second
+
+// pop3Cursor POP3 没有递增的 UID，只能记录已处理过的 UIDL 集合。
+// 每次同步后只保留服务器上仍存在的 UIDL，集合大小不会超过邮箱内的邮件数量。
+type pop3Cursor struct {
+	SeenUIDLs []string `json:"seen_uidls"`
+}
+
+// pop3Message UIDL 列表中的一项
+type pop3Message struct {
+	Num  int
+	UIDL string
+}
+
+// POP3Adapter POP3协议适配器
+type POP3Adapter struct {
+	logger      *slog.Logger
+	dialTimeout time.Duration
+}
+
+// NewPOP3Adapter 创建POP3协议适配器
+func NewPOP3Adapter(logger *slog.Logger) domain.MailboxProtocolAdapter {
+	return &POP3Adapter{
+		logger:      logger,
+		dialTimeout: defaultPOP3Timeout,
+	}
+}
+
+// GetProtocol 返回协议标识
+func (a *POP3Adapter) GetProtocol() string {
+	return string(consts.MailboxProtocolPOP3)
+}
+
+// TestConnection 测试连接是否可用
+func (a *POP3Adapter) TestConnection(ctx context.Context, config *domain.MailboxConnectionConfig) error {
+	c, err := a.openMailbox(ctx, config)
+	if err != nil {
+		return err
+	}
+	defer c.quit()
+
+	if _, err := c.cmd(false, "STAT"); err != nil {
+		return fmt.Errorf("POP3 STAT 命令失败: %w", err)
+	}
+	return nil
+}
+
+// Fetch 拉取新邮件
+func (a *POP3Adapter) Fetch(ctx context.Context, config *domain.MailboxConnectionConfig, req *domain.MailboxFetchRequest) (*domain.MailboxFetchResult, error) {
+	if req == nil {
+		req = &domain.MailboxFetchRequest{}
+	}
+	if req.Limit <= 0 {
+		req.Limit = defaultFetchLimit
+	}
+
+	c, err := a.openMailbox(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	defer c.quit()
+
+	messages, err := c.uidl()
+	if err != nil {
+		return nil, fmt.Errorf("获取POP3邮件UIDL列表失败: %w", err)
+	}
+
+	firstSync := strings.TrimSpace(req.Cursor) == ""
+	currentCursor := a.parseCursor(req.Cursor)
+	seenUIDLs := make(map[string]struct{}, len(currentCursor.SeenUIDLs))
+	for _, uidl := range currentCursor.SeenUIDLs {
+		seenUIDLs[uidl] = struct{}{}
+	}
+
+	a.logger.Info("开始执行POP3同步请求",
+		slog.String("mailbox", config.EmailAddress),
+		slog.Int("server_message_count", len(messages)),
+		slog.Int("seen_uidl_count", len(seenUIDLs)),
+		slog.Bool("first_sync", firstSync),
+	)
+
+	if firstSync {
+		// 与IMAP保持一致：首次同步只记录当前邮箱位置，不回溯历史邮件
+		nextCursor, errBuild := a.buildCursorString(messages, nil)
+		if errBuild != nil {
+			return nil, errBuild
+		}
+		a.logger.Info("首次同步完成，设置POP3游标",
+			slog.String("mailbox", config.EmailAddress),
+			slog.Int("cursor_uidl_count", len(messages)),
+		)
+		return &domain.MailboxFetchResult{
+			Messages:      []*domain.MailboxEmail{},
+			NextCursor:    nextCursor,
+			LastMessageID: "",
+		}, nil
+	}
+
+	// 按邮件编号顺序挑选未处理的邮件，超出 Limit 的留到下次同步
+	var pending []pop3Message
+	for _, msg := range messages {
+		if _, ok := seenUIDLs[msg.UIDL]; ok {
+			continue
+		}
+		pending = append(pending, msg)
+		if len(pending) >= req.Limit {
+			break
+		}
+	}
+
+	result := &domain.MailboxFetchResult{
+		Messages: []*domain.MailboxEmail{},
+	}
+
+	seen := make(map[string]struct{})
+	var lastMessageID string
+	for _, msg := range pending {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		raw, errRetr := c.retr(msg.Num)
+		if errRetr != nil {
+			return nil, fmt.Errorf("拉取POP3邮件失败: %w", errRetr)
+		}
+		seenUIDLs[msg.UIDL] = struct{}{}
+
+		email, errParse := a.parseMessage(config, msg, raw, seen)
+		if errParse != nil {
+			a.logger.Warn("解析POP3邮件失败",
+				slog.String("mailbox", config.EmailAddress),
+				slog.String("uidl", msg.UIDL),
+				slog.String("error", errParse.Error()),
+			)
+			continue
+		}
+
+		if hasExtractableContent(email) {
+			if email.MessageID != "" {
+				lastMessageID = email.MessageID
+			} else {
+				lastMessageID = msg.UIDL
+			}
+			result.Messages = append(result.Messages, email)
+		}
+	}
+
+	nextCursor, err := a.buildCursorString(messages, seenUIDLs)
+	if err != nil {
+		return nil, err
+	}
+
+	result.NextCursor = nextCursor
+	result.LastMessageID = lastMessageID
+	a.logger.Info("POP3同步完成，准备返回游标",
+		slog.String("mailbox", config.EmailAddress),
+		slog.Int("fetched_count", len(pending)),
+		slog.Int("message_count", len(result.Messages)),
+		slog.String("last_message_id", lastMessageID),
+	)
+
+	return result, nil
+}
+
+// parseMessage 解析 RETR 返回的原始邮件并提取附件
+func (a *POP3Adapter) parseMessage(config *domain.MailboxConnectionConfig, msg pop3Message, raw []byte, seen map[string]struct{}) (*domain.MailboxEmail, error) {
+	reader, err := mail.CreateReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	email := &domain.MailboxEmail{
+		Attachments: []*domain.MailboxAttachment{},
+		RawSize:     int64(len(raw)),
+	}
+	if subject, errSubject := reader.Header.Subject(); errSubject == nil {
+		email.Subject = subject
+	}
+	if messageID, errID := reader.Header.MessageID(); errID == nil {
+		email.MessageID = strings.TrimSpace(messageID)
+	}
+	if from, errFrom := reader.Header.AddressList("From"); errFrom == nil && len(from) > 0 {
+		email.From = from[0].Address
+	}
+	for _, key := range []string{"To", "Cc"} {
+		if addrs, errAddr := reader.Header.AddressList(key); errAddr == nil {
+			for _, addr := range addrs {
+				email.Recipients = append(email.Recipients, addr.Address)
+			}
+		}
+	}
+	if date, errDate := reader.Header.Date(); errDate == nil && !date.IsZero() {
+		email.ReceivedAt = date
+	}
+
+	messageKey := email.MessageID
+	if messageKey == "" {
+		messageKey = msg.UIDL
+	}
+	extractMailContent(a.logger, config.EmailAddress, messageKey, reader, seen, email)
+
+	return email, nil
+}
+
+func (a *POP3Adapter) openMailbox(ctx context.Context, config *domain.MailboxConnectionConfig) (*pop3Client, error) {
+	if config == nil {
+		return nil, fmt.Errorf("缺少邮箱连接配置")
+	}
+
+	addr := net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
+	dialTimeout := a.dialTimeout
+	if dialTimeout <= 0 {
+		dialTimeout = defaultPOP3Timeout
+	}
+
+	// 使用支持代理的拨号器
+	proxyDialer := netutil.NewProxyDialer(dialTimeout, 30*time.Second)
+
+	var conn net.Conn
+	if config.UseSSL {
+		tlsConfig := &tls.Config{
+			ServerName: config.Host,
+		}
+		tlsConn, dialErr := proxyDialer.DialTLSContext(ctx, "tcp", addr, tlsConfig)
+		if dialErr != nil {
+			return nil, fmt.Errorf("无法建立POP3 TLS连接: %w", dialErr)
+		}
+		conn = tlsConn
+	} else {
+		netConn, dialErr := proxyDialer.DialContext(ctx, "tcp", addr)
+		if dialErr != nil {
+			return nil, fmt.Errorf("无法连接POP3服务器: %w", dialErr)
+		}
+		conn = netConn
+	}
+
+	c := newPOP3Client(conn, dialTimeout)
+
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.Close()
+	})
+	c.stop = stop
+
+	if _, err := c.readResponse(false); err != nil {
+		c.close()
+		return nil, fmt.Errorf("POP3服务器握手失败: %w", err)
+	}
+
+	if err := a.authenticate(c, config); err != nil {
+		c.quit()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (a *POP3Adapter) authenticate(c *pop3Client, config *domain.MailboxConnectionConfig) error {
+	username := ""
+	if v, ok := config.Credential["username"].(string); ok && v != "" {
+		username = v
+	}
+	if username == "" {
+		username = config.EmailAddress
+	}
+
+	switch strings.ToLower(config.AuthType) {
+	case string(consts.MailboxAuthTypePassword):
+		password, _ := config.Credential["password"].(string)
+		if username == "" || password == "" {
+			return fmt.Errorf("缺少用户名或密码")
+		}
+		if _, err := c.cmd(false, "USER %s", username); err != nil {
+			return fmt.Errorf("POP3 登录失败: %w", err)
+		}
+		if _, err := c.cmd(false, "PASS %s", password); err != nil {
+			return fmt.Errorf("POP3 登录失败: %w", err)
+		}
+		return nil
+	case string(consts.MailboxAuthTypeOAuth):
+		token, _ := config.Credential["access_token"].(string)
+		if username == "" || token == "" {
+			return fmt.Errorf("缺少OAuth凭证")
+		}
+		ir := fmt.Sprintf("user=%s\x01auth=Bearer %s\x01\x01", username, token)
+		if _, err := c.cmd(false, "AUTH XOAUTH2 %s", base64.StdEncoding.EncodeToString([]byte(ir))); err != nil {
+			return fmt.Errorf("POP3 OAuth2认证失败: %w", err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("不支持的认证类型: %s", config.AuthType)
+	}
+}
+
+func (a *POP3Adapter) parseCursor(cursor string) pop3Cursor {
+	if cursor == "" {
+		return pop3Cursor{}
+	}
+	var value pop3Cursor
+	if err := json.Unmarshal([]byte(cursor), &value); err != nil {
+		a.logger.Warn("解析POP3游标失败，使用默认游标",
+			slog.String("error", err.Error()),
+		)
+		return pop3Cursor{}
+	}
+	return value
+}
+
+// buildCursorString 生成下次同步的游标。seen 为 nil 时视为服务器上的邮件全部已处理。
+func (a *POP3Adapter) buildCursorString(messages []pop3Message, seen map[string]struct{}) (string, error) {
+	uidls := make([]string, 0, len(messages))
+	for _, msg := range messages {
+		if seen != nil {
+			if _, ok := seen[msg.UIDL]; !ok {
+				continue
+			}
+		}
+		uidls = append(uidls, msg.UIDL)
+	}
+
+	data, err := json.Marshal(pop3Cursor{SeenUIDLs: uidls})
+	if err != nil {
+		return "", fmt.Errorf("构建游标数据失败: %w", err)
+	}
+	return string(data), nil
+}
+
+// pop3Client 最小化的 POP3 客户端（RFC 1939），仅实现同步所需的命令
+type pop3Client struct {
+	conn    net.Conn
+	text    *textproto.Conn
+	timeout time.Duration
+	stop    func() bool
+}
+
+func newPOP3Client(conn net.Conn, timeout time.Duration) *pop3Client {
+	return &pop3Client{
+		conn:    conn,
+		text:    textproto.NewConn(conn),
+		timeout: timeout,
+	}
+}
+
+// cmd 发送命令并读取响应，multiline 为 true 时读取以 "." 结尾的多行数据
+func (c *pop3Client) cmd(multiline bool, format string, args ...interface{}) ([]byte, error) {
+	_ = c.conn.SetDeadline(time.Now().Add(c.timeout))
+	if err := c.text.PrintfLine(format, args...); err != nil {
+		return nil, err
+	}
+	return c.readResponse(multiline)
+}
+
+func (c *pop3Client) readResponse(multiline bool) ([]byte, error) {
+	_ = c.conn.SetDeadline(time.Now().Add(c.timeout))
+	line, err := c.text.ReadLine()
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(line, "-ERR") {
+		return nil, fmt.Errorf("POP3服务器返回错误: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+	}
+	if !strings.HasPrefix(line, "+OK") {
+		return nil, fmt.Errorf("POP3服务器返回异常响应: %s", line)
+	}
+	if !multiline {
+		return []byte(line), nil
+	}
+	return c.text.ReadDotBytes()
+}
+
+// uidl 获取邮箱内所有邮件的编号与唯一标识
+func (c *pop3Client) uidl() ([]pop3Message, error) {
+	data, err := c.cmd(true, "UIDL")
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []pop3Message
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		num, errNum := strconv.Atoi(fields[0])
+		if errNum != nil {
+			return nil, fmt.Errorf("无效的UIDL响应: %s", line)
+		}
+		messages = append(messages, pop3Message{Num: num, UIDL: fields[1]})
+	}
+	return messages, nil
+}
+
+// retr 拉取指定编号邮件的完整内容
+func (c *pop3Client) retr(num int) ([]byte, error) {
+	return c.cmd(true, "RETR %d", num)
+}
+
+// quit 结束会话。POP3 只读取不删除，QUIT 失败不影响同步结果
+func (c *pop3Client) quit() {
+	_, _ = c.cmd(false, "QUIT")
+	c.close()
+}
+
+func (c *pop3Client) close() {
+	if c.stop != nil {
+		c.stop()
+	}
+	_ = c.text.Close()
+}
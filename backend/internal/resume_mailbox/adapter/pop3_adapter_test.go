@@ -0,0 +1,193 @@
+package adapter
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+// fakePOP3Mailbox 本地 POP3 替身，messages 按编号顺序保存 UIDL 与原始邮件
+type fakePOP3Mailbox struct {
+	mu       sync.Mutex
+	uidls    []string
+	messages []string
+}
+
+func (m *fakePOP3Mailbox) add(uidl, raw string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.uidls = append(m.uidls, uidl)
+	m.messages = append(m.messages, raw)
+}
+
+func startFakePOP3Server(t *testing.T, mailbox *fakePOP3Mailbox) (string, int) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveFakePOP3(conn, mailbox)
+		}
+	}()
+
+	addr := ln.Addr().(*net.TCPAddr)
+	return addr.IP.String(), addr.Port
+}
+
+func serveFakePOP3(conn net.Conn, mailbox *fakePOP3Mailbox) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = io.WriteString(conn, line+"\r\n") }
+
+	reply("+OK fake pop3 ready")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(strings.TrimRight(line, "\r\n"))
+		if len(fields) == 0 {
+			continue
+		}
+
+		mailbox.mu.Lock()
+		switch strings.ToUpper(fields[0]) {
+		case "USER":
+			reply("+OK")
+		case "PASS":
+			if len(fields) > 1 && fields[1] == "secret" {
+				reply("+OK logged in")
+			} else {
+				reply("-ERR invalid password")
+			}
+		case "STAT":
+			reply(fmt.Sprintf("+OK %d 0", len(mailbox.messages)))
+		case "UIDL":
+			reply("+OK")
+			for i, uidl := range mailbox.uidls {
+				reply(fmt.Sprintf("%d %s", i+1, uidl))
+			}
+			reply(".")
+		case "RETR":
+			var num int
+			_, _ = fmt.Sscanf(fields[1], "%d", &num)
+			reply("+OK")
+			for _, l := range strings.Split(mailbox.messages[num-1], "\r\n") {
+				if strings.HasPrefix(l, ".") {
+					l = "." + l
+				}
+				reply(l)
+			}
+			reply(".")
+		case "QUIT":
+			reply("+OK bye")
+			mailbox.mu.Unlock()
+			return
+		default:
+			reply("-ERR unknown command")
+		}
+		mailbox.mu.Unlock()
+	}
+}
+
+func buildResumeMail(messageID, filename, content string) string {
+	return strings.Join([]string{
+		"From: candidate@example.com",
+		"To: hr@example.com",
+		"Subject: 应聘后端工程师",
+		"Message-ID: <" + messageID + ">",
+		"Date: Mon, 02 Jan 2006 15:04:05 +0800",
+		"MIME-Version: 1.0",
+		`Content-Type: multipart/mixed; boundary="b1"`,
+		"",
+		"--b1",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		"您好，附件是我的简历。",
+		"--b1",
+		"Content-Type: application/pdf",
+		`Content-Disposition: attachment; filename="` + filename + `"`,
+		"",
+		content,
+		"--b1--",
+		"",
+	}, "\r\n")
+}
+
+func TestPOP3AdapterFetch(t *testing.T) {
+	mailbox := &fakePOP3Mailbox{}
+	mailbox.add("uidl-old", buildResumeMail("old@example.com", "old.pdf", "old resume"))
+	host, port := startFakePOP3Server(t, mailbox)
+
+	config := &domain.MailboxConnectionConfig{
+		Host:         host,
+		Port:         port,
+		EmailAddress: "hr@example.com",
+		AuthType:     string(consts.MailboxAuthTypePassword),
+		Credential:   map[string]interface{}{"password": "secret"},
+	}
+	a := NewPOP3Adapter(slog.Default())
+	assert.Equal(t, string(consts.MailboxProtocolPOP3), a.GetProtocol())
+	require.NoError(t, a.TestConnection(context.Background(), config))
+
+	// 首次同步只记录游标，不回溯历史邮件
+	first, err := a.Fetch(context.Background(), config, &domain.MailboxFetchRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, first.Messages)
+	assert.JSONEq(t, `{"seen_uidls":["uidl-old"]}`, first.NextCursor)
+
+	mailbox.add("uidl-new-1", buildResumeMail("new1@example.com", "张三.pdf", "resume one"))
+	mailbox.add("uidl-new-2", buildResumeMail("new2@example.com", "李四.pdf", "resume two"))
+
+	second, err := a.Fetch(context.Background(), config, &domain.MailboxFetchRequest{Cursor: first.NextCursor, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, second.Messages, 1)
+	assert.Equal(t, "new1@example.com", second.Messages[0].MessageID)
+	assert.Equal(t, "应聘后端工程师", second.Messages[0].Subject)
+	assert.Equal(t, "candidate@example.com", second.Messages[0].From)
+	assert.Equal(t, []string{"hr@example.com"}, second.Messages[0].Recipients)
+	require.Len(t, second.Messages[0].Attachments, 1)
+	assert.Equal(t, "张三.pdf", second.Messages[0].Attachments[0].Filename)
+	assert.Equal(t, "resume one", string(second.Messages[0].Attachments[0].Content))
+	assert.Contains(t, second.Messages[0].TextBody, "附件是我的简历")
+	assert.Equal(t, "new1@example.com", second.LastMessageID)
+
+	third, err := a.Fetch(context.Background(), config, &domain.MailboxFetchRequest{Cursor: second.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, third.Messages, 1)
+	assert.Equal(t, "李四.pdf", third.Messages[0].Attachments[0].Filename)
+	assert.JSONEq(t, `{"seen_uidls":["uidl-old","uidl-new-1","uidl-new-2"]}`, third.NextCursor)
+}
+
+func TestPOP3AdapterAuthFailure(t *testing.T) {
+	host, port := startFakePOP3Server(t, &fakePOP3Mailbox{})
+
+	a := NewPOP3Adapter(slog.Default())
+	err := a.TestConnection(context.Background(), &domain.MailboxConnectionConfig{
+		Host:         host,
+		Port:         port,
+		EmailAddress: "hr@example.com",
+		AuthType:     string(consts.MailboxAuthTypePassword),
+		Credential:   map[string]interface{}{"password": "wrong"},
+	})
+	assert.ErrorContains(t, err, "invalid password")
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db"
 	"github.com/chaitin/WhaleHire/backend/db/resumemailboxsetting"
 	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/ent/types"
 	"github.com/google/uuid"
 )
 
@@ -50,6 +51,16 @@ func (r *ResumeMailboxSettingRepo) Create(ctx context.Context, req *domain.Creat
 		builder = builder.SetSyncIntervalMinutes(*req.SyncIntervalMinutes)
 	}
 
+	if req.ExtractionMode != nil {
+		builder = builder.SetExtractionMode(*req.ExtractionMode)
+	}
+	if len(req.LinkAllowedDomains) > 0 {
+		builder = builder.SetLinkAllowedDomains(req.LinkAllowedDomains)
+	}
+	if len(req.Rules) > 0 {
+		builder = builder.SetRules(toMailboxRuleTypes(req.Rules))
+	}
+
 	entity, err := builder.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create resume mailbox setting: %w", err)
@@ -123,6 +134,19 @@ func (r *ResumeMailboxSettingRepo) Update(ctx context.Context, id uuid.UUID, req
 	if req.SyncIntervalMinutes != nil {
 		builder = builder.SetSyncIntervalMinutes(*req.SyncIntervalMinutes)
 	}
+	if req.ExtractionMode != nil {
+		builder = builder.SetExtractionMode(*req.ExtractionMode)
+	}
+	if req.LinkAllowedDomains != nil {
+		builder = builder.SetLinkAllowedDomains(req.LinkAllowedDomains)
+	}
+	if req.Rules != nil {
+		if len(req.Rules) > 0 {
+			builder = builder.SetRules(toMailboxRuleTypes(req.Rules))
+		} else {
+			builder = builder.ClearRules()
+		}
+	}
 	if req.Status != nil {
 		builder = builder.SetStatus(*req.Status)
 	}
@@ -238,3 +262,26 @@ func (r *ResumeMailboxSettingRepo) GetActiveSettings(ctx context.Context) ([]*db
 
 	return entities, nil
 }
+
+func toMailboxRuleTypes(rules []*domain.ResumeMailboxRule) []*types.ResumeMailboxRule {
+	result := make([]*types.ResumeMailboxRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+		r := &types.ResumeMailboxRule{
+			Name:              rule.Name,
+			SenderDomains:     rule.SenderDomains,
+			SubjectPattern:    rule.SubjectPattern,
+			RecipientAliases:  rule.RecipientAliases,
+			AttachmentPattern: rule.AttachmentPattern,
+			Action:            string(rule.Action),
+			Source:            rule.Source,
+		}
+		for _, id := range rule.JobPositionIDs {
+			r.JobPositionIDs = append(r.JobPositionIDs, id.String())
+		}
+		result = append(result, r)
+	}
+	return result
+}
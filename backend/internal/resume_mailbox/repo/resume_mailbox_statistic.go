@@ -137,6 +137,12 @@ func (r *ResumeMailboxStatisticRepo) Upsert(ctx context.Context, req *domain.Ups
 		if req.SkippedAttachments != nil {
 			builder = builder.AddSkippedAttachments(*req.SkippedAttachments)
 		}
+		if req.BodyResumes != nil {
+			builder = builder.AddBodyResumes(*req.BodyResumes)
+		}
+		if req.LinkResumes != nil {
+			builder = builder.AddLinkResumes(*req.LinkResumes)
+		}
 		// 最后同步耗时使用覆盖逻辑，因为这是最新的耗时记录
 		if req.LastSyncDurationMs != nil {
 			builder = builder.SetLastSyncDurationMs(*req.LastSyncDurationMs)
@@ -175,6 +181,12 @@ func (r *ResumeMailboxStatisticRepo) Upsert(ctx context.Context, req *domain.Ups
 		if req.SkippedAttachments != nil {
 			builder = builder.SetSkippedAttachments(*req.SkippedAttachments)
 		}
+		if req.BodyResumes != nil {
+			builder = builder.SetBodyResumes(*req.BodyResumes)
+		}
+		if req.LinkResumes != nil {
+			builder = builder.SetLinkResumes(*req.LinkResumes)
+		}
 		if req.LastSyncDurationMs != nil {
 			builder = builder.SetLastSyncDurationMs(*req.LastSyncDurationMs)
 		}
@@ -269,6 +281,8 @@ func (r *ResumeMailboxStatisticRepo) GetSummary(ctx context.Context, req *domain
 		summary.TotalParsedResumes += stat.ParsedResumes
 		summary.TotalFailedResumes += stat.FailedResumes
 		summary.TotalSkippedAttachments += stat.SkippedAttachments
+		summary.TotalBodyResumes += stat.BodyResumes
+		summary.TotalLinkResumes += stat.LinkResumes
 
 		if stat.LastSyncDurationMs > 0 {
 			totalDuration += stat.LastSyncDurationMs
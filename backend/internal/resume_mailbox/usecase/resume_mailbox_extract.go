@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	// minBodyResumeRunes 正文少于该字数时不视为简历，避免把通知类短邮件入库
+	minBodyResumeRunes = 100
+	// maxLinkedResumeSize 链接简历的下载上限
+	maxLinkedResumeSize = 20 << 20
+	// maxLinksPerEmail 单封邮件最多跟随的链接数量
+	maxLinksPerEmail = 5
+)
+
+var (
+	plainURLPattern = regexp.MustCompile(`https?://[^\s"'<>]+`)
+	// unsafeFilenameChars 文件名中需要替换的字符
+	unsafeFilenameChars = regexp.MustCompile(`[\\/:*?"<>|\s]+`)
+
+	linkedResumeContentTypes = map[string]string{
+		"application/pdf":    ".pdf",
+		"application/msword": ".doc",
+		"application/vnd.openxmlformats-officedocument.wordprocessingml.document": ".docx",
+		"application/rtf": ".rtf",
+		"text/rtf":        ".rtf",
+		"text/plain":      ".txt",
+	}
+)
+
+// renderBodyDocument 将邮件正文渲染为纯文本文档，优先使用 HTML 正文
+func renderBodyDocument(htmlBody, textBody string) string {
+	text := ""
+	if strings.TrimSpace(htmlBody) != "" {
+		text = renderHTMLText(htmlBody)
+	}
+	if strings.TrimSpace(text) == "" {
+		text = textBody
+	}
+	text = strings.TrimSpace(text)
+	if utf8.RuneCountInString(text) < minBodyResumeRunes {
+		return ""
+	}
+	return text
+}
+
+// renderHTMLText 提取 HTML 中的可见文本，块级元素换行，表格单元格以制表符分隔
+func renderHTMLText(src string) string {
+	root, err := html.Parse(strings.NewReader(src))
+	if err != nil {
+		return ""
+	}
+
+	var b strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch n.DataAtom {
+			case atom.Script, atom.Style, atom.Head, atom.Title, atom.Noscript:
+				return
+			case atom.Br:
+				b.WriteString("\n")
+			case atom.Td, atom.Th:
+				b.WriteString("\t")
+			}
+		}
+		if n.Type == html.TextNode {
+			if t := strings.Join(strings.Fields(n.Data), " "); t != "" {
+				b.WriteString(t)
+				b.WriteString(" ")
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+		if n.Type == html.ElementNode {
+			switch n.DataAtom {
+			case atom.P, atom.Div, atom.Tr, atom.Li, atom.Table, atom.Ul, atom.Ol,
+				atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Section:
+				b.WriteString("\n")
+			}
+		}
+	}
+	walk(root)
+
+	lines := strings.Split(b.String(), "\n")
+	out := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			out = append(out, line)
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// bodyDocumentFilename 根据邮件主题生成正文文档文件名
+func bodyDocumentFilename(subject string) string {
+	name := strings.Trim(unsafeFilenameChars.ReplaceAllString(strings.TrimSpace(subject), "_"), "_")
+	if name == "" {
+		name = "email_body"
+	}
+	if utf8.RuneCountInString(name) > 80 {
+		name = string([]rune(name)[:80])
+	}
+	return name + ".txt"
+}
+
+// extractAllowedLinks 提取正文中属于白名单域名的 http/https 链接，按出现顺序去重
+func extractAllowedLinks(htmlBody, textBody string, allowedDomains []string) []string {
+	var candidates []string
+	if strings.TrimSpace(htmlBody) != "" {
+		if root, err := html.Parse(strings.NewReader(htmlBody)); err == nil {
+			var walk func(n *html.Node)
+			walk = func(n *html.Node) {
+				if n.Type == html.ElementNode && n.DataAtom == atom.A {
+					for _, attr := range n.Attr {
+						if attr.Key == "href" {
+							candidates = append(candidates, strings.TrimSpace(attr.Val))
+						}
+					}
+				}
+				for c := n.FirstChild; c != nil; c = c.NextSibling {
+					walk(c)
+				}
+			}
+			walk(root)
+		}
+	}
+	candidates = append(candidates, plainURLPattern.FindAllString(textBody, -1)...)
+
+	seen := make(map[string]struct{})
+	var links []string
+	for _, raw := range candidates {
+		u, err := url.Parse(raw)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.User != nil {
+			continue
+		}
+		if !isAllowedLinkHost(u.Hostname(), allowedDomains) {
+			continue
+		}
+		u.Fragment = ""
+		link := u.String()
+		if _, ok := seen[link]; ok {
+			continue
+		}
+		seen[link] = struct{}{}
+		links = append(links, link)
+		if len(links) >= maxLinksPerEmail {
+			break
+		}
+	}
+	return links
+}
+
+// isAllowedLinkHost 主机名等于白名单域名或为其子域名时允许访问
+func isAllowedLinkHost(host string, allowedDomains []string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "" {
+		return false
+	}
+	for _, d := range allowedDomains {
+		d = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(d), "."))
+		if d == "" {
+			continue
+		}
+		if host == d || strings.HasSuffix(host, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// downloadLinkedResume 下载链接指向的简历文件，返回文件名与内容。
+// 重定向目标同样需要在白名单内，避免被引导到内网地址。
+func downloadLinkedResume(ctx context.Context, client *http.Client, link string, allowedDomains []string) (string, []byte, error) {
+	c := *client
+	c.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= 5 {
+			return fmt.Errorf("too many redirects")
+		}
+		if !isAllowedLinkHost(req.URL.Hostname(), allowedDomains) {
+			return fmt.Errorf("redirect to non-allowed host: %s", req.URL.Hostname())
+		}
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("创建下载请求失败: %w", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return "", nil, fmt.Errorf("下载简历链接失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, fmt.Errorf("下载简历链接返回状态码 %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxLinkedResumeSize+1))
+	if err != nil {
+		return "", nil, fmt.Errorf("读取简历链接内容失败: %w", err)
+	}
+	if len(data) > maxLinkedResumeSize {
+		return "", nil, fmt.Errorf("简历链接内容超过 %d 字节上限", maxLinkedResumeSize)
+	}
+
+	return linkedResumeFilename(resp), data, nil
+}
+
+// linkedResumeFilename 依次从 Content-Disposition、URL 路径与 Content-Type 推断文件名
+func linkedResumeFilename(resp *http.Response) string {
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil {
+		if name := strings.TrimSpace(params["filename"]); name != "" {
+			return path.Base(name)
+		}
+	}
+
+	name := path.Base(resp.Request.URL.Path)
+	if name == "." || name == "/" {
+		name = "linked_resume"
+	}
+	if path.Ext(name) == "" {
+		mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		name += linkedResumeContentTypes[strings.ToLower(mediaType)]
+	}
+	return name
+}
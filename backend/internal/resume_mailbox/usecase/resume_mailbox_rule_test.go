@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+func TestBuildIngestPlan(t *testing.T) {
+	backendJob := uuid.New()
+	defaultJob := uuid.New().String()
+	setting := &domain.ResumeMailboxSetting{
+		EmailAddress: "hr@example.com",
+		Rules: []*domain.ResumeMailboxRule{
+			{Name: "屏蔽营销", SenderDomains: []string{"spam.example.org"}, Action: consts.MailboxRuleActionSkip},
+			{Name: "后端岗位", SubjectPattern: `^投递-后端工程师-`, Action: consts.MailboxRuleActionAssign, JobPositionIDs: []uuid.UUID{backendJob}},
+			{Name: "内推", RecipientAliases: []string{"referral"}, Action: consts.MailboxRuleActionTag, Source: "referral"},
+			{Name: "自动筛选", AttachmentPattern: `(?i)\.pdf$`, SubjectPattern: `^投递-`, Action: consts.MailboxRuleActionScreen},
+		},
+	}
+	u := &ResumeMailboxSyncUsecase{logger: slog.Default()}
+
+	skipped := u.buildIngestPlan(setting, []string{defaultJob}, &domain.MailboxEmail{
+		From:    "promo@news.spam.example.org",
+		Subject: "投递-后端工程师-张三",
+	})
+	assert.Nil(t, skipped)
+
+	plan := u.buildIngestPlan(setting, []string{defaultJob}, &domain.MailboxEmail{
+		From:        "zhangsan@example.com",
+		Subject:     "投递-后端工程师-张三",
+		Recipients:  []string{"Referral@example.com"},
+		Attachments: []*domain.MailboxAttachment{{Filename: "张三.PDF"}},
+	})
+	require.NotNil(t, plan)
+	assert.Equal(t, []string{backendJob.String()}, plan.jobPositionIDs)
+	assert.Equal(t, "referral", plan.source)
+	assert.True(t, plan.screen)
+
+	// 未命中任何规则时沿用邮箱默认岗位与来源
+	plan = u.buildIngestPlan(setting, []string{defaultJob}, &domain.MailboxEmail{
+		From:        "lisi@example.com",
+		Subject:     "应聘前端工程师",
+		Recipients:  []string{"hr@example.com"},
+		Attachments: []*domain.MailboxAttachment{{Filename: "李四.docx"}},
+	})
+	require.NotNil(t, plan)
+	assert.Equal(t, []string{defaultJob}, plan.jobPositionIDs)
+	assert.Equal(t, string(consts.ResumeSourceTypeEmail), plan.source)
+	assert.False(t, plan.screen)
+}
+
+func TestValidateMailboxRules(t *testing.T) {
+	assert.NoError(t, validateMailboxRules(nil))
+	assert.ErrorContains(t, validateMailboxRules([]*domain.ResumeMailboxRule{
+		{Name: "bad", SubjectPattern: "([", Action: consts.MailboxRuleActionSkip},
+	}), "主题正则无效")
+	assert.ErrorContains(t, validateMailboxRules([]*domain.ResumeMailboxRule{
+		{Name: "assign", Action: consts.MailboxRuleActionAssign},
+	}), "必须指定岗位")
+	assert.ErrorContains(t, validateMailboxRules([]*domain.ResumeMailboxRule{
+		{Name: "unknown", Action: "forward"},
+	}), "动作无效")
+}
+
+type recordingResumeUsecase struct {
+	domain.ResumeUsecase
+	uploads []*domain.UploadResumeReq
+}
+
+func (r *recordingResumeUsecase) Upload(_ context.Context, req *domain.UploadResumeReq) (*domain.Resume, error) {
+	r.uploads = append(r.uploads, req)
+	return &domain.Resume{ID: uuid.New().String()}, nil
+}
+
+type recordingJobApplicationUsecase struct {
+	domain.JobApplicationUsecase
+	reqs []*domain.CreateJobApplicationsReq
+}
+
+func (r *recordingJobApplicationUsecase) CreateJobApplications(_ context.Context, req *domain.CreateJobApplicationsReq) ([]*domain.JobApplication, error) {
+	r.reqs = append(r.reqs, req)
+	return nil, nil
+}
+
+func TestIngestResumeKeepsEmailSourceForTaggedMail(t *testing.T) {
+	resumes := &recordingResumeUsecase{}
+	applications := &recordingJobApplicationUsecase{}
+	u := &ResumeMailboxSyncUsecase{resumeUsecase: resumes, jobApplicationUsecase: applications, logger: slog.Default()}
+	setting := &domain.ResumeMailboxSetting{EmailAddress: "hr@example.com", UploaderID: uuid.New()}
+	jobID := uuid.NewString()
+
+	// 标记规则只影响岗位关联的来源，简历仍按邮箱来源列出
+	plan := &mailIngestPlan{jobPositionIDs: []string{jobID}, source: "referral"}
+	_, err := u.ingestResume(context.Background(), setting, plan, "张三.pdf", []byte("%PDF"), &domain.ResumeMailboxSyncResult{})
+	require.NoError(t, err)
+	require.Len(t, resumes.uploads, 1)
+	require.NotNil(t, resumes.uploads[0].Source)
+	assert.Equal(t, string(consts.ResumeSourceTypeEmail), *resumes.uploads[0].Source)
+
+	require.Len(t, applications.reqs, 1)
+	assert.Equal(t, []string{jobID}, applications.reqs[0].JobPositionIDs)
+	require.NotNil(t, applications.reqs[0].Source)
+	assert.Equal(t, "referral", *applications.reqs[0].Source)
+}
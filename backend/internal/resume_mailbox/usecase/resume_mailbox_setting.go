@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/chaitin/WhaleHire/backend/consts"
@@ -42,6 +43,17 @@ func NewResumeMailboxSettingUsecase(
 
 // Create 创建邮箱设置
 func (u *ResumeMailboxSettingUsecase) Create(ctx context.Context, req *domain.CreateResumeMailboxSettingRequest) (*domain.ResumeMailboxSetting, error) {
+	mode := string(consts.MailboxExtractionModeAttachments)
+	if req.ExtractionMode != nil {
+		mode = *req.ExtractionMode
+	}
+	if err := validateExtractionConfig(mode, req.LinkAllowedDomains); err != nil {
+		return nil, err
+	}
+	if err := validateMailboxRules(req.Rules); err != nil {
+		return nil, err
+	}
+
 	// 加密凭证信息
 	encryptedCredential, err := u.credentialVault.Encrypt(ctx, req.EncryptedCredential)
 	if err != nil {
@@ -149,6 +161,26 @@ func (u *ResumeMailboxSettingUsecase) fillJobProfileNames(ctx context.Context, s
 
 // Update 更新邮箱设置
 func (u *ResumeMailboxSettingUsecase) Update(ctx context.Context, id uuid.UUID, req *domain.UpdateResumeMailboxSettingRequest) (*domain.ResumeMailboxSetting, error) {
+	if req.ExtractionMode != nil || req.LinkAllowedDomains != nil {
+		existing, err := u.repo.GetByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get resume mailbox setting: %w", err)
+		}
+		mode, domains := existing.ExtractionMode, existing.LinkAllowedDomains
+		if req.ExtractionMode != nil {
+			mode = *req.ExtractionMode
+		}
+		if req.LinkAllowedDomains != nil {
+			domains = req.LinkAllowedDomains
+		}
+		if err := validateExtractionConfig(mode, domains); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateMailboxRules(req.Rules); err != nil {
+		return nil, err
+	}
+
 	// 如果需要更新凭证，先加密
 	updateReq := *req
 	if req.EncryptedCredential != nil {
@@ -275,6 +307,12 @@ func (u *ResumeMailboxSettingUsecase) TestConnection(ctx context.Context, req *d
 		if _, ok := credential["access_token"]; !ok {
 			return domain.ErrInvalidCredentials
 		}
+	} else if req.AuthType == string(consts.MailboxAuthTypeClientCredentials) {
+		for _, key := range []string{"tenant_id", "client_id", "client_secret"} {
+			if _, ok := credential[key]; !ok {
+				return domain.ErrInvalidCredentials
+			}
+		}
 	}
 
 	adapter, err := u.adapterFactory.GetAdapter(strings.ToLower(req.Protocol))
@@ -304,6 +342,58 @@ func (u *ResumeMailboxSettingUsecase) TestConnection(ctx context.Context, req *d
 	return nil
 }
 
+// validateExtractionConfig 校验简历提取方式，链接模式必须配置域名白名单
+func validateExtractionConfig(mode string, domains []string) error {
+	if !consts.MailboxExtractionMode(mode).IsValid() {
+		return fmt.Errorf("invalid extraction mode: %s", mode)
+	}
+	if consts.MailboxExtractionMode(mode) != consts.MailboxExtractionModeAttachmentsLinks {
+		return nil
+	}
+	if len(domains) == 0 {
+		return fmt.Errorf("link_allowed_domains is required when extraction mode is %s", mode)
+	}
+	for _, d := range domains {
+		d = strings.TrimSpace(d)
+		if d == "" || strings.ContainsAny(d, "/:*? ") {
+			return fmt.Errorf("invalid link allowed domain: %q", d)
+		}
+	}
+	return nil
+}
+
+// validateMailboxRules 校验邮件过滤规则的动作、正则与动作参数
+func validateMailboxRules(rules []*domain.ResumeMailboxRule) error {
+	for i, rule := range rules {
+		if rule == nil {
+			return fmt.Errorf("第 %d 条规则为空", i+1)
+		}
+		if strings.TrimSpace(rule.Name) == "" {
+			return fmt.Errorf("第 %d 条规则缺少名称", i+1)
+		}
+		if !rule.Action.IsValid() {
+			return fmt.Errorf("规则 %q 的动作无效: %s", rule.Name, rule.Action)
+		}
+		if _, err := regexp.Compile(rule.SubjectPattern); err != nil {
+			return fmt.Errorf("规则 %q 的主题正则无效: %w", rule.Name, err)
+		}
+		if _, err := regexp.Compile(rule.AttachmentPattern); err != nil {
+			return fmt.Errorf("规则 %q 的附件名正则无效: %w", rule.Name, err)
+		}
+		switch rule.Action {
+		case consts.MailboxRuleActionAssign:
+			if len(rule.JobPositionIDs) == 0 {
+				return fmt.Errorf("规则 %q 的动作为 %s 时必须指定岗位", rule.Name, rule.Action)
+			}
+		case consts.MailboxRuleActionTag:
+			if strings.TrimSpace(rule.Source) == "" {
+				return fmt.Errorf("规则 %q 的动作为 %s 时必须指定来源标记", rule.Name, rule.Action)
+			}
+		}
+	}
+	return nil
+}
+
 // UpdateStatus 启用/禁用邮箱设置
 func (u *ResumeMailboxSettingUsecase) UpdateStatus(ctx context.Context, id uuid.UUID, status string) error {
 	// 验证状态值
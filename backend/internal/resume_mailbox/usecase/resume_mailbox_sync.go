@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"path/filepath"
 	"strings"
 	"time"
@@ -34,6 +35,8 @@ type ResumeMailboxSyncUsecase struct {
 	adapterFactory        domain.MailboxAdapterFactory
 	resumeUsecase         domain.ResumeUsecase
 	jobApplicationUsecase domain.JobApplicationUsecase
+	screeningUsecase      domain.ScreeningUsecase
+	httpClient            *http.Client
 	logger                *slog.Logger
 }
 
@@ -46,6 +49,7 @@ func NewResumeMailboxSyncUsecase(
 	adapterFactory domain.MailboxAdapterFactory,
 	resumeUsecase domain.ResumeUsecase,
 	jobApplicationUsecase domain.JobApplicationUsecase,
+	screeningUsecase domain.ScreeningUsecase,
 	logger *slog.Logger,
 ) domain.ResumeMailboxSyncUsecase {
 	return &ResumeMailboxSyncUsecase{
@@ -56,10 +60,20 @@ func NewResumeMailboxSyncUsecase(
 		adapterFactory:        adapterFactory,
 		resumeUsecase:         resumeUsecase,
 		jobApplicationUsecase: jobApplicationUsecase,
+		screeningUsecase:      screeningUsecase,
+		httpClient:            &http.Client{Timeout: 30 * time.Second},
 		logger:                logger,
 	}
 }
 
+// mailIngestPlan 单封邮件的入库方案，由邮箱默认配置与命中的规则共同决定
+type mailIngestPlan struct {
+	jobPositionIDs []string
+	// source 岗位关联的来源，默认为 email，可由标记规则覆盖；简历自身的来源始终为 email
+	source string
+	screen bool
+}
+
 // SyncNow 手动触发邮箱同步
 func (u *ResumeMailboxSyncUsecase) SyncNow(ctx context.Context, mailboxID uuid.UUID) (*domain.ResumeMailboxSyncResult, error) {
 	start := time.Now()
@@ -133,7 +147,36 @@ func (u *ResumeMailboxSyncUsecase) SyncNow(ctx context.Context, mailboxID uuid.U
 		}
 	}
 
+	extractionMode := consts.MailboxExtractionMode(setting.ExtractionMode)
+	// screen 规则入库的简历按岗位汇总，同步结束后每个岗位发起一个筛选任务
+	screeningBatches := make(map[string][]uuid.UUID)
+	var screeningOrder []string
 	for _, mailItem := range fetchResult.Messages {
+		plan := u.buildIngestPlan(setting, jobPositionIDs, mailItem)
+		if plan == nil {
+			result.RuleSkippedEmails++
+			continue
+		}
+		ingest := func(filename string, content []byte) error {
+			resume, err := u.ingestResume(ctx, setting, plan, filename, content, result)
+			if err != nil {
+				return err
+			}
+			if plan.screen {
+				resumeID, parseErr := uuid.Parse(resume.ID)
+				if parseErr != nil {
+					return nil
+				}
+				for _, jobPositionID := range plan.jobPositionIDs {
+					if _, ok := screeningBatches[jobPositionID]; !ok {
+						screeningOrder = append(screeningOrder, jobPositionID)
+					}
+					screeningBatches[jobPositionID] = append(screeningBatches[jobPositionID], resumeID)
+				}
+			}
+			return nil
+		}
+
 		for _, attachment := range mailItem.Attachments {
 			if attachment == nil {
 				continue
@@ -144,49 +187,55 @@ func (u *ResumeMailboxSyncUsecase) SyncNow(ctx context.Context, mailboxID uuid.U
 				continue
 			}
 
-			reader := bytes.NewReader(attachment.Content)
-			req := &domain.UploadResumeReq{
-				UploaderID:     setting.UploaderID.String(),
-				File:           reader,
-				Filename:       attachment.Filename,
-				JobPositionIDs: jobPositionIDs,
-			}
-			sourceStr := string(consts.ResumeSourceTypeEmail)
-			req.Source = &sourceStr
-
-			resume, uploadErr := u.resumeUsecase.Upload(ctx, req)
-			if uploadErr != nil {
-				result.FailedAttachments++
-				result.Errors = append(result.Errors, fmt.Sprintf("上传附件失败 %s: %s", attachment.Filename, uploadErr.Error()))
-				u.logger.Warn("上传邮件附件失败",
-					slog.String("mailbox", setting.EmailAddress),
-					slog.String("filename", attachment.Filename),
-					slog.String("error", uploadErr.Error()),
-				)
+			if err := ingest(attachment.Filename, attachment.Content); err != nil {
 				continue
 			}
+			result.SuccessAttachments++
+		}
 
-			// 创建岗位关联
-			if len(jobPositionIDs) > 0 {
-				jobReq := &domain.CreateJobApplicationsReq{
-					ResumeID:       resume.ID,
-					JobPositionIDs: jobPositionIDs,
-					Source:         &sourceStr,
-				}
-				if _, jobErr := u.jobApplicationUsecase.CreateJobApplications(ctx, jobReq); jobErr != nil {
-					result.Errors = append(result.Errors, fmt.Sprintf("创建岗位关联失败 %s: %s", resume.ID, jobErr.Error()))
-					u.logger.Warn("邮箱简历创建岗位关联失败",
+		switch extractionMode {
+		case consts.MailboxExtractionModeAttachmentsBody:
+			// 正文渲染为文本文档后按简历上传
+			document := renderBodyDocument(mailItem.HTMLBody, mailItem.TextBody)
+			if document == "" {
+				continue
+			}
+			if err := ingest(bodyDocumentFilename(mailItem.Subject), []byte(document)); err != nil {
+				continue
+			}
+			result.SuccessAttachments++
+			result.BodyResumes++
+		case consts.MailboxExtractionModeAttachmentsLinks:
+			// 仅跟随白名单域名内的链接下载简历
+			for _, link := range extractAllowedLinks(mailItem.HTMLBody, mailItem.TextBody, setting.LinkAllowedDomains) {
+				filename, content, err := downloadLinkedResume(ctx, u.httpClient, link, setting.LinkAllowedDomains)
+				if err != nil {
+					result.FailedAttachments++
+					result.Errors = append(result.Errors, fmt.Sprintf("下载简历链接失败 %s: %s", link, err.Error()))
+					u.logger.Warn("下载邮件简历链接失败",
 						slog.String("mailbox", setting.EmailAddress),
-						slog.String("resume_id", resume.ID),
-						slog.String("error", jobErr.Error()),
+						slog.String("link", link),
+						slog.String("error", err.Error()),
 					)
+					continue
+				}
+				if !u.isSupportedAttachment(filename) {
+					result.SkippedAttachments++
+					continue
 				}
+				if err := ingest(filename, content); err != nil {
+					continue
+				}
+				result.SuccessAttachments++
+				result.LinkResumes++
 			}
-
-			result.SuccessAttachments++
 		}
 	}
 
+	for _, jobPositionID := range screeningOrder {
+		u.startScreening(ctx, setting, jobPositionID, screeningBatches[jobPositionID], result)
+	}
+
 	// 更新游标
 	if fetchResult.NextCursor != "" {
 		if _, err := u.cursorRepo.Upsert(ctx, mailboxID, fetchResult.NextCursor, fetchResult.LastMessageID); err != nil {
@@ -226,6 +275,8 @@ func (u *ResumeMailboxSyncUsecase) SyncNow(ctx context.Context, mailboxID uuid.U
 		ParsedResumes:      &result.SuccessAttachments,
 		FailedResumes:      &result.FailedAttachments,
 		SkippedAttachments: &result.SkippedAttachments,
+		BodyResumes:        &result.BodyResumes,
+		LinkResumes:        &result.LinkResumes,
 		LastSyncDurationMs: &durationMs,
 	}
 
@@ -239,6 +290,130 @@ func (u *ResumeMailboxSyncUsecase) SyncNow(ctx context.Context, mailboxID uuid.U
 	return result, nil
 }
 
+// buildIngestPlan 评估邮箱规则并生成入库方案，命中 skip 规则时返回 nil
+func (u *ResumeMailboxSyncUsecase) buildIngestPlan(setting *domain.ResumeMailboxSetting, defaultJobPositionIDs []string, email *domain.MailboxEmail) *mailIngestPlan {
+	decision := setting.EvaluateRules(email)
+	if len(decision.MatchedRules) > 0 {
+		u.logger.Debug("邮件命中过滤规则",
+			slog.String("mailbox", setting.EmailAddress),
+			slog.String("message_id", email.MessageID),
+			slog.Any("rules", decision.MatchedRules),
+		)
+	}
+	if decision.Skip {
+		return nil
+	}
+
+	plan := &mailIngestPlan{
+		jobPositionIDs: defaultJobPositionIDs,
+		source:         string(consts.ResumeSourceTypeEmail),
+		screen:         decision.Screen,
+	}
+	if len(decision.JobPositionIDs) > 0 {
+		plan.jobPositionIDs = make([]string, 0, len(decision.JobPositionIDs))
+		for _, id := range decision.JobPositionIDs {
+			plan.jobPositionIDs = append(plan.jobPositionIDs, id.String())
+		}
+	}
+	if decision.Source != "" {
+		plan.source = decision.Source
+	}
+	return plan
+}
+
+// startScreening 为 screen 规则入库的简历创建并启动筛选任务，失败时记入同步结果
+func (u *ResumeMailboxSyncUsecase) startScreening(
+	ctx context.Context,
+	setting *domain.ResumeMailboxSetting,
+	jobPositionID string,
+	resumeIDs []uuid.UUID,
+	result *domain.ResumeMailboxSyncResult,
+) {
+	fail := func(err error) {
+		result.Errors = append(result.Errors, fmt.Sprintf("自动发起筛选失败 %s: %s", jobPositionID, err.Error()))
+		u.logger.Warn("邮箱规则自动发起筛选失败",
+			slog.String("mailbox", setting.EmailAddress),
+			slog.String("job_position_id", jobPositionID),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	jobID, err := uuid.Parse(jobPositionID)
+	if err != nil {
+		fail(err)
+		return
+	}
+	createResp, err := u.screeningUsecase.CreateScreeningTask(ctx, &domain.CreateScreeningTaskReq{
+		JobPositionID: jobID,
+		ResumeIDs:     resumeIDs,
+		CreatedBy:     setting.UploaderID,
+		Notes:         fmt.Sprintf("邮箱 %s 规则自动发起", setting.EmailAddress),
+	})
+	if err != nil {
+		fail(err)
+		return
+	}
+	if _, err := u.screeningUsecase.StartScreeningTask(ctx, &domain.StartScreeningTaskReq{TaskID: createResp.TaskID}); err != nil {
+		fail(err)
+		return
+	}
+	result.ScreeningTaskIDs = append(result.ScreeningTaskIDs, createResp.TaskID)
+}
+
+// ingestResume 以邮箱来源上传简历并按入库方案创建岗位关联，上传失败时记入同步结果。
+// 需要自动筛选时同步等待解析完成，保证筛选任务读取到解析结果。
+func (u *ResumeMailboxSyncUsecase) ingestResume(
+	ctx context.Context,
+	setting *domain.ResumeMailboxSetting,
+	plan *mailIngestPlan,
+	filename string,
+	content []byte,
+	result *domain.ResumeMailboxSyncResult,
+) (*domain.Resume, error) {
+	jobPositionIDs := plan.jobPositionIDs
+	req := &domain.UploadResumeReq{
+		UploaderID:     setting.UploaderID.String(),
+		File:           bytes.NewReader(content),
+		Filename:       filename,
+		JobPositionIDs: jobPositionIDs,
+		WaitForParsing: plan.screen,
+	}
+	// 简历来源始终为邮箱，规则标记只记录在岗位关联上，避免简历脱离来源筛选
+	emailSource := string(consts.ResumeSourceTypeEmail)
+	req.Source = &emailSource
+
+	resume, uploadErr := u.resumeUsecase.Upload(ctx, req)
+	if uploadErr != nil {
+		result.FailedAttachments++
+		result.Errors = append(result.Errors, fmt.Sprintf("上传附件失败 %s: %s", filename, uploadErr.Error()))
+		u.logger.Warn("上传邮件附件失败",
+			slog.String("mailbox", setting.EmailAddress),
+			slog.String("filename", filename),
+			slog.String("error", uploadErr.Error()),
+		)
+		return nil, uploadErr
+	}
+
+	// 创建岗位关联
+	if len(jobPositionIDs) > 0 {
+		jobReq := &domain.CreateJobApplicationsReq{
+			ResumeID:       resume.ID,
+			JobPositionIDs: jobPositionIDs,
+			Source:         &plan.source,
+		}
+		if _, jobErr := u.jobApplicationUsecase.CreateJobApplications(ctx, jobReq); jobErr != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("创建岗位关联失败 %s: %s", resume.ID, jobErr.Error()))
+			u.logger.Warn("邮箱简历创建岗位关联失败",
+				slog.String("mailbox", setting.EmailAddress),
+				slog.String("resume_id", resume.ID),
+				slog.String("error", jobErr.Error()),
+			)
+		}
+	}
+
+	return resume, nil
+}
+
 func (u *ResumeMailboxSyncUsecase) isSupportedAttachment(filename string) bool {
 	ext := strings.ToLower(filepath.Ext(filename))
 	_, ok := supportedExtensions[ext]
@@ -3,6 +3,8 @@ package v1
 import (
 	"fmt"
 	"log/slog"
+	"net/http"
+	"net/url"
 
 	"github.com/google/uuid"
 
@@ -35,16 +37,26 @@ func NewScreeningHandler(
 	group.POST("/tasks", web.BindHandler(handler.CreateTask))
 	group.POST("/tasks/:id/start", web.BaseHandler(handler.StartTask))
 	group.POST("/tasks/:id/cancel", web.BaseHandler(handler.CancelTask))
+	group.POST("/tasks/:id/continue", web.BindHandler(handler.ContinueTask))
 	group.DELETE("/tasks/:id", web.BaseHandler(handler.DeleteTask))
 	group.GET("/tasks", web.BindHandler(handler.ListTasks, web.WithPage()))
 	group.GET("/tasks/:id", web.BaseHandler(handler.GetTask))
 	group.GET("/tasks/:id/progress", web.BaseHandler(handler.GetTaskProgress))
 	group.GET("/tasks/:id/metrics", web.BaseHandler(handler.GetMetrics))
+	group.POST("/tasks/:id/shortlist", web.BindHandler(handler.GenerateShortlist))
+	group.GET("/tasks/:id/shortlist", web.BaseHandler(handler.GetShortlist))
+	group.POST("/resumes/:resume_id/job-recommendations", web.BindHandler(handler.RecommendJobPositions))
 	group.GET("/tasks/:task_id/results/:resume_id", web.BaseHandler(handler.GetResult))
+	group.PUT("/tasks/:task_id/results/:resume_id/override", web.BindHandler(handler.OverrideResult))
+	group.DELETE("/tasks/:task_id/results/:resume_id/override", web.BaseHandler(handler.ClearResultOverride))
 	group.GET("/tasks/:task_id/resumes/:resume_id/progress", web.BaseHandler(handler.GetResumeProgress))
 	group.GET("/tasks/:task_id/resumes/:resume_id/node-runs", web.BaseHandler(handler.GetNodeRuns))
 	group.GET("/results", web.BindHandler(handler.ListResults, web.WithPage()))
+	group.POST("/tasks/:id/export", web.BindHandler(handler.ExportResults))
+	group.GET("/exports/:id", web.BaseHandler(handler.GetExport))
 	group.POST("/weights/preview", web.BindHandler(handler.PreviewWeights))
+	group.GET("/cost-report", web.BindHandler(handler.GetCostReport))
+	group.GET("/calibration-report", web.BindHandler(handler.GetCalibrationReport))
 
 	// Weight template routes
 	group.POST("/weights/templates", web.BindHandler(handler.CreateWeightTemplate))
@@ -53,6 +65,17 @@ func NewScreeningHandler(
 	group.PUT("/weights/templates/:id", web.BindHandler(handler.UpdateWeightTemplate))
 	group.DELETE("/weights/templates/:id", web.BaseHandler(handler.DeleteWeightTemplate))
 
+	// Custom dimension routes
+	group.POST("/dimensions", web.BindHandler(handler.CreateDimension))
+	group.GET("/dimensions", web.BindHandler(handler.ListDimensions))
+	group.PUT("/dimensions/:id", web.BindHandler(handler.UpdateDimension))
+	group.DELETE("/dimensions/:id", web.BaseHandler(handler.DeleteDimension))
+
+	// Automatic screening schedule routes
+	group.PUT("/schedules", web.BindHandler(handler.UpsertSchedule))
+	group.GET("/schedules", web.BindHandler(handler.GetSchedule))
+	group.DELETE("/schedules/:job_position_id", web.BaseHandler(handler.DeleteSchedule))
+
 	return handler
 }
 
@@ -144,6 +167,33 @@ func (h *ScreeningHandler) CancelTask(c *web.Context) error {
 	return c.Success(resp)
 }
 
+// ContinueTask 继续已暂停的筛选任务
+//
+//	@Tags			Screening
+//	@Summary		继续筛选任务
+//	@Description	继续因预算耗尽而暂停的筛选任务，可同时提高Token或费用预算，剩余简历将重新投递处理
+//	@ID				continue-screening-task
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"任务ID"
+//	@Param			param	body		domain.ContinueScreeningTaskReq	false	"新的预算"
+//	@Success		200		{object}	web.Resp{data=domain.ContinueScreeningTaskResp}
+//	@Router			/api/v1/screening/tasks/{id}/continue [post]
+func (h *ScreeningHandler) ContinueTask(c *web.Context, req domain.ContinueScreeningTaskReq) error {
+	taskID, err := parseUUIDParam(c.Param("id"))
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "任务ID格式不正确")
+	}
+	req.TaskID = taskID
+
+	resp, err := h.usecase.ContinueScreeningTask(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("继续筛选任务失败", slog.Any("err", err), slog.Any("task_id", taskID))
+		return err
+	}
+	return c.Success(resp)
+}
+
 // DeleteTask 删除筛选任务
 //
 //	@Tags			Screening
@@ -231,6 +281,137 @@ func (h *ScreeningHandler) ListTasks(c *web.Context, req domain.ListScreeningTas
 	return c.Success(resp)
 }
 
+// GetCostReport 查询月度筛选费用报表
+//
+//	@Tags			Screening
+//	@Summary		月度筛选费用报表
+//	@Description	按部门、任务创建人或岗位汇总指定月份的智能筛选模型调用费用与Token用量
+//	@ID				get-screening-cost-report
+//	@Accept			json
+//	@Produce		json
+//	@Param			month		query		string	false	"统计月份，格式 YYYY-MM，默认当前月"
+//	@Param			group_by	query		string	false	"分组维度：department/user/job，默认 department"
+//	@Success		200			{object}	web.Resp{data=domain.GetScreeningCostReportResp}
+//	@Router			/api/v1/screening/cost-report [get]
+func (h *ScreeningHandler) GetCostReport(c *web.Context, req domain.GetScreeningCostReportReq) error {
+	resp, err := h.usecase.GetScreeningCostReport(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("获取筛选费用报表失败", slog.Any("err", err), slog.String("month", req.Month))
+		return err
+	}
+	return c.Success(resp)
+}
+
+// GetCalibrationReport 查询人工校准报表
+//
+//	@Tags			Screening
+//	@Summary		人工校准报表
+//	@Description	对比人工校准与模型输出，按维度和子Agent版本统计平均偏差，用于发现打分漂移的Agent
+//	@ID				get-screening-calibration-report
+//	@Accept			json
+//	@Produce		json
+//	@Param			job_position_id	query		string	false	"岗位ID"
+//	@Param			start_date		query		string	false	"校准起始日期，格式 YYYY-MM-DD"
+//	@Param			end_date		query		string	false	"校准截止日期，格式 YYYY-MM-DD"
+//	@Success		200				{object}	web.Resp{data=domain.GetScreeningCalibrationResp}
+//	@Router			/api/v1/screening/calibration-report [get]
+func (h *ScreeningHandler) GetCalibrationReport(c *web.Context, req domain.GetScreeningCalibrationReq) error {
+	resp, err := h.usecase.GetScreeningCalibrationReport(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("获取人工校准报表失败", slog.Any("err", err))
+		return err
+	}
+	return c.Success(resp)
+}
+
+// GenerateShortlist 生成短名单排名
+//
+//	@Tags			Screening
+//	@Summary		生成短名单排名
+//	@Description	对任务综合得分最高的 N 位候选人逐对比较，生成带位次说明的短名单排名，独立于按得分的排名保存，重复生成会覆盖上一次结果
+//	@ID				generate-screening-shortlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string								true	"任务ID"
+//	@Param			param	body		domain.GenerateScreeningShortlistReq	false	"短名单参数"
+//	@Success		200		{object}	web.Resp{data=domain.ScreeningShortlistResp}
+//	@Router			/api/v1/screening/tasks/{id}/shortlist [post]
+func (h *ScreeningHandler) GenerateShortlist(c *web.Context, req domain.GenerateScreeningShortlistReq) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return errcode.ErrPermission
+	}
+	userID, err := uuid.Parse(user.ID)
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "当前用户ID格式不正确")
+	}
+	taskID, err := parseUUIDParam(c.Param("id"))
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "任务ID格式不正确")
+	}
+	req.TaskID = taskID
+	req.UserID = userID
+
+	resp, err := h.usecase.GenerateScreeningShortlist(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("生成短名单失败", slog.Any("err", err), slog.Any("task_id", taskID))
+		return err
+	}
+	return c.Success(resp)
+}
+
+// GetShortlist 获取短名单排名
+//
+//	@Tags			Screening
+//	@Summary		获取短名单排名
+//	@Description	获取任务最近一次生成的短名单排名，未生成时 shortlist 为空
+//	@ID				get-screening-shortlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string	true	"任务ID"
+//	@Success		200	{object}	web.Resp{data=domain.ScreeningShortlistResp}
+//	@Router			/api/v1/screening/tasks/{id}/shortlist [get]
+func (h *ScreeningHandler) GetShortlist(c *web.Context) error {
+	taskID, err := parseUUIDParam(c.Param("id"))
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "任务ID格式不正确")
+	}
+
+	resp, err := h.usecase.GetScreeningShortlist(c.Request().Context(), &domain.GetScreeningShortlistReq{TaskID: taskID})
+	if err != nil {
+		h.logger.Error("获取短名单失败", slog.Any("err", err), slog.Any("task_id", taskID))
+		return err
+	}
+	return c.Success(resp)
+}
+
+// RecommendJobPositions 为简历推荐在招岗位
+//
+//	@Tags			Screening
+//	@Summary		为简历推荐在招岗位
+//	@Description	按技能、职责关键词、工作年限、学历、城市对已发布岗位做结构化粗排，可选使用技能、职责匹配 Agent 对靠前岗位精排，返回按契合度排序的岗位，默认排除简历已投递的岗位
+//	@ID				recommend-job-positions
+//	@Accept			json
+//	@Produce		json
+//	@Param			resume_id	path		string								true	"简历ID"
+//	@Param			param		body		domain.RecommendJobPositionsReq	false	"推荐参数"
+//	@Success		200			{object}	web.Resp{data=domain.RecommendJobPositionsResp}
+//	@Router			/api/v1/screening/resumes/{resume_id}/job-recommendations [post]
+func (h *ScreeningHandler) RecommendJobPositions(c *web.Context, req domain.RecommendJobPositionsReq) error {
+	resumeID, err := parseUUIDParam(c.Param("resume_id"))
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "简历ID格式不正确")
+	}
+	req.ResumeID = resumeID
+
+	resp, err := h.usecase.RecommendJobPositions(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("推荐岗位失败", slog.Any("err", err), slog.Any("resume_id", resumeID))
+		return err
+	}
+	return c.Success(resp)
+}
+
 // ListResults 分页查询筛选结果
 //
 //	@Tags			Screening
@@ -296,6 +477,81 @@ func (h *ScreeningHandler) GetResult(c *web.Context) error {
 	return c.Success(resp)
 }
 
+// OverrideResult 人工校准筛选结果
+//
+//	@Tags			Screening
+//	@Summary		人工校准筛选结果
+//	@Description	招聘人员修改筛选结果的综合得分、匹配等级或维度得分并填写原因，模型原始输出保持不变
+//	@ID				override-screening-result
+//	@Accept			json
+//	@Produce		json
+//	@Param			task_id		path		string								true	"任务ID"
+//	@Param			resume_id	path		string								true	"简历ID"
+//	@Param			param		body		domain.OverrideScreeningResultReq	true	"校准内容"
+//	@Success		200			{object}	web.Resp{data=domain.OverrideScreeningResultResp}
+//	@Router			/api/v1/screening/tasks/{task_id}/results/{resume_id}/override [put]
+func (h *ScreeningHandler) OverrideResult(c *web.Context, req domain.OverrideScreeningResultReq) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return errcode.ErrPermission
+	}
+	userID, err := uuid.Parse(user.ID)
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "当前用户ID格式不正确")
+	}
+	taskID, err := parseUUIDParam(c.Param("task_id"))
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "任务ID格式不正确")
+	}
+	resumeID, err := parseUUIDParam(c.Param("resume_id"))
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "简历ID格式不正确")
+	}
+	req.TaskID = taskID
+	req.ResumeID = resumeID
+	req.UserID = userID
+
+	resp, err := h.usecase.OverrideScreeningResult(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("人工校准筛选结果失败", slog.Any("err", err), slog.Any("task_id", taskID), slog.Any("resume_id", resumeID))
+		return err
+	}
+	return c.Success(resp)
+}
+
+// ClearResultOverride 撤销人工校准
+//
+//	@Tags			Screening
+//	@Summary		撤销人工校准
+//	@Description	清除筛选结果的人工校准信息，恢复使用模型输出
+//	@ID				clear-screening-result-override
+//	@Accept			json
+//	@Produce		json
+//	@Param			task_id		path		string	true	"任务ID"
+//	@Param			resume_id	path		string	true	"简历ID"
+//	@Success		200			{object}	web.Resp{data=domain.OverrideScreeningResultResp}
+//	@Router			/api/v1/screening/tasks/{task_id}/results/{resume_id}/override [delete]
+func (h *ScreeningHandler) ClearResultOverride(c *web.Context) error {
+	taskID, err := parseUUIDParam(c.Param("task_id"))
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "任务ID格式不正确")
+	}
+	resumeID, err := parseUUIDParam(c.Param("resume_id"))
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "简历ID格式不正确")
+	}
+
+	resp, err := h.usecase.ClearScreeningResultOverride(c.Request().Context(), &domain.GetScreeningResultReq{
+		TaskID:   taskID,
+		ResumeID: resumeID,
+	})
+	if err != nil {
+		h.logger.Error("撤销人工校准失败", slog.Any("err", err), slog.Any("task_id", taskID), slog.Any("resume_id", resumeID))
+		return err
+	}
+	return c.Success(resp)
+}
+
 // GetTaskProgress 查询任务进度
 //
 //	@Tags			Screening
@@ -612,3 +868,245 @@ func (h *ScreeningHandler) DeleteWeightTemplate(c *web.Context) error {
 	}
 	return c.Success(nil)
 }
+
+// CreateDimension 创建岗位自定义匹配维度
+//
+//	@Tags			Screening
+//	@Summary		创建岗位自定义匹配维度
+//	@Description	为岗位新增自定义匹配维度（如开源贡献、管理经验），每个维度带独立的评估提示词和权重，之后创建的筛选任务会将其作为额外分支评估并参与综合得分
+//	@ID				create-screening-dimension
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.CreateScreeningDimensionReq	true	"自定义维度参数"
+//	@Success		200		{object}	web.Resp{data=domain.CustomDimension}
+//	@Router			/api/v1/screening/dimensions [post]
+func (h *ScreeningHandler) CreateDimension(c *web.Context, req domain.CreateScreeningDimensionReq) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return errcode.ErrPermission
+	}
+	userID, err := uuid.Parse(user.ID)
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "当前用户ID格式不正确")
+	}
+	req.CreatedBy = userID
+
+	resp, err := h.usecase.CreateScreeningDimension(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("创建自定义维度失败", slog.Any("err", err), slog.Any("job_position_id", req.JobPositionID))
+		return err
+	}
+	return c.Success(resp)
+}
+
+// ListDimensions 查询岗位自定义匹配维度
+//
+//	@Tags			Screening
+//	@Summary		查询岗位自定义匹配维度
+//	@Description	查询指定岗位下的全部自定义匹配维度
+//	@ID				list-screening-dimensions
+//	@Accept			json
+//	@Produce		json
+//	@Param			job_position_id	query		string	true	"岗位ID"
+//	@Success		200				{object}	web.Resp{data=domain.ListScreeningDimensionsResp}
+//	@Router			/api/v1/screening/dimensions [get]
+func (h *ScreeningHandler) ListDimensions(c *web.Context, req domain.ListScreeningDimensionsReq) error {
+	resp, err := h.usecase.ListScreeningDimensions(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("查询自定义维度失败", slog.Any("err", err), slog.Any("job_position_id", req.JobPositionID))
+		return err
+	}
+	return c.Success(resp)
+}
+
+// UpdateDimension 更新岗位自定义匹配维度
+//
+//	@Tags			Screening
+//	@Summary		更新岗位自定义匹配维度
+//	@Description	更新自定义维度的名称、提示词和权重，维度标识不可修改；已创建的任务使用创建时的维度快照，不受影响
+//	@ID				update-screening-dimension
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string								true	"维度ID"
+//	@Param			param	body		domain.UpdateScreeningDimensionReq	true	"自定义维度参数"
+//	@Success		200		{object}	web.Resp{data=domain.CustomDimension}
+//	@Router			/api/v1/screening/dimensions/{id} [put]
+func (h *ScreeningHandler) UpdateDimension(c *web.Context, req domain.UpdateScreeningDimensionReq) error {
+	dimensionID, err := parseUUIDParam(c.Param("id"))
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "维度ID格式不正确")
+	}
+	req.ID = dimensionID
+
+	resp, err := h.usecase.UpdateScreeningDimension(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("更新自定义维度失败", slog.Any("err", err), slog.Any("dimension_id", dimensionID))
+		return err
+	}
+	return c.Success(resp)
+}
+
+// DeleteDimension 删除岗位自定义匹配维度
+//
+//	@Tags			Screening
+//	@Summary		删除岗位自定义匹配维度
+//	@Description	删除自定义维度，已创建的任务不受影响
+//	@ID				delete-screening-dimension
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string	true	"维度ID"
+//	@Success		200	{object}	web.Resp
+//	@Router			/api/v1/screening/dimensions/{id} [delete]
+func (h *ScreeningHandler) DeleteDimension(c *web.Context) error {
+	dimensionID, err := parseUUIDParam(c.Param("id"))
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "维度ID格式不正确")
+	}
+
+	if err := h.usecase.DeleteScreeningDimension(c.Request().Context(), dimensionID); err != nil {
+		h.logger.Error("删除自定义维度失败", slog.Any("err", err), slog.Any("dimension_id", dimensionID))
+		return err
+	}
+	return c.Success(nil)
+}
+
+// UpsertSchedule 创建或更新岗位自动筛选配置
+//
+//	@Tags			Screening
+//	@Summary		创建或更新岗位自动筛选配置
+//	@Description	为岗位开启自动筛选：instant 模式下新投递的简历解析完成后随即使用岗位默认权重模板发起筛选；digest 模式下每日在指定时刻汇总筛选上次执行以来的新投递，任务完成后发送筛选完成通知。仅筛选配置创建之后的投递
+//	@ID				upsert-screening-schedule
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.UpsertScreeningScheduleReq	true	"自动筛选配置参数"
+//	@Success		200		{object}	web.Resp{data=domain.ScreeningSchedule}
+//	@Router			/api/v1/screening/schedules [put]
+func (h *ScreeningHandler) UpsertSchedule(c *web.Context, req domain.UpsertScreeningScheduleReq) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return errcode.ErrPermission
+	}
+	userID, err := uuid.Parse(user.ID)
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "当前用户ID格式不正确")
+	}
+	req.CreatedBy = userID
+
+	resp, err := h.usecase.UpsertScreeningSchedule(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("保存自动筛选配置失败", slog.Any("err", err), slog.Any("job_position_id", req.JobPositionID))
+		return err
+	}
+	return c.Success(resp)
+}
+
+// GetSchedule 查询岗位自动筛选配置
+//
+//	@Tags			Screening
+//	@Summary		查询岗位自动筛选配置
+//	@Description	查询指定岗位的自动筛选配置及最近一次执行时间
+//	@ID				get-screening-schedule
+//	@Accept			json
+//	@Produce		json
+//	@Param			job_position_id	query		string	true	"岗位ID"
+//	@Success		200				{object}	web.Resp{data=domain.ScreeningSchedule}
+//	@Router			/api/v1/screening/schedules [get]
+func (h *ScreeningHandler) GetSchedule(c *web.Context, req domain.GetScreeningScheduleReq) error {
+	resp, err := h.usecase.GetScreeningSchedule(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("查询自动筛选配置失败", slog.Any("err", err), slog.Any("job_position_id", req.JobPositionID))
+		return err
+	}
+	return c.Success(resp)
+}
+
+// DeleteSchedule 删除岗位自动筛选配置
+//
+//	@Tags			Screening
+//	@Summary		删除岗位自动筛选配置
+//	@Description	删除岗位自动筛选配置，已发起的筛选任务不受影响
+//	@ID				delete-screening-schedule
+//	@Accept			json
+//	@Produce		json
+//	@Param			job_position_id	path		string	true	"岗位ID"
+//	@Success		200				{object}	web.Resp
+//	@Router			/api/v1/screening/schedules/{job_position_id} [delete]
+func (h *ScreeningHandler) DeleteSchedule(c *web.Context) error {
+	jobPositionID, err := parseUUIDParam(c.Param("job_position_id"))
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "岗位ID格式不正确")
+	}
+
+	if err := h.usecase.DeleteScreeningSchedule(c.Request().Context(), jobPositionID); err != nil {
+		h.logger.Error("删除自动筛选配置失败", slog.Any("err", err), slog.Any("job_position_id", jobPositionID))
+		return err
+	}
+	return c.Success(nil)
+}
+
+// ExportResults 导出筛选结果
+//
+//	@Tags			Screening
+//	@Summary		导出筛选结果
+//	@Description	导出任务筛选结果，xlsx/csv 为含各维度得分的排名表，pdf 为逐个候选人的匹配报告。结果数不超过异步导出阈值时直接返回文件；否则返回异步导出记录，通过导出ID查询下载链接
+//	@ID				export-screening-results
+//	@Accept			json
+//	@Produce		json,application/octet-stream
+//	@Param			id		path		string								true	"任务ID"
+//	@Param			param	body		domain.ExportScreeningResultsReq	true	"导出参数"
+//	@Success		200		{object}	web.Resp{data=domain.ExportScreeningResultsResp}
+//	@Router			/api/v1/screening/tasks/{id}/export [post]
+func (h *ScreeningHandler) ExportResults(c *web.Context, req domain.ExportScreeningResultsReq) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return errcode.ErrPermission
+	}
+	userID, err := uuid.Parse(user.ID)
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "当前用户ID格式不正确")
+	}
+	taskID, err := parseUUIDParam(c.Param("id"))
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "任务ID格式不正确")
+	}
+	req.TaskID = taskID
+	req.UserID = userID
+
+	resp, err := h.usecase.ExportScreeningResults(c.Request().Context(), &req)
+	if err != nil {
+		h.logger.Error("导出筛选结果失败", slog.Any("err", err), slog.Any("task_id", taskID), slog.String("format", string(req.Format)))
+		return err
+	}
+	if resp.File == nil {
+		return c.Success(resp)
+	}
+
+	c.Response().Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename*=UTF-8''%s", url.PathEscape(resp.File.Filename)))
+	return c.Blob(http.StatusOK, resp.File.ContentType, resp.File.Content)
+}
+
+// GetExport 查询筛选结果导出状态
+//
+//	@Tags			Screening
+//	@Summary		查询筛选结果导出状态
+//	@Description	查询异步导出状态，导出完成后返回 1 小时内有效的下载链接，导出记录保留 24 小时
+//	@ID				get-screening-export
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string	true	"导出ID"
+//	@Success		200	{object}	web.Resp{data=domain.ScreeningExport}
+//	@Router			/api/v1/screening/exports/{id} [get]
+func (h *ScreeningHandler) GetExport(c *web.Context) error {
+	exportID, err := parseUUIDParam(c.Param("id"))
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", "导出ID格式不正确")
+	}
+
+	resp, err := h.usecase.GetScreeningExport(c.Request().Context(), exportID)
+	if err != nil {
+		h.logger.Error("查询筛选结果导出状态失败", slog.Any("err", err), slog.Any("export_id", exportID))
+		return err
+	}
+	return c.Success(resp)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db/screeningtask"
 	"github.com/chaitin/WhaleHire/backend/db/screeningtaskresume"
 	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/pkg/entx"
 )
 
 // ScreeningRepo 智能筛选仓储实现
@@ -56,6 +57,20 @@ func (r *ScreeningRepo) CreateScreeningTask(ctx context.Context, task *db.Screen
 	if task.AgentVersion != "" {
 		builder = builder.SetAgentVersion(task.AgentVersion)
 	}
+	builder = builder.
+		SetNillableTokenBudget(task.TokenBudget).
+		SetNillableCostBudget(task.CostBudget).
+		SetDisableCache(task.DisableCache).
+		SetBlindMode(task.BlindMode)
+	if len(task.KnockoutRules) > 0 {
+		builder = builder.SetKnockoutRules(task.KnockoutRules)
+	}
+	if len(task.RedactionPolicy) > 0 {
+		builder = builder.SetRedactionPolicy(task.RedactionPolicy)
+	}
+	if len(task.CustomDimensions) > 0 {
+		builder = builder.SetCustomDimensions(task.CustomDimensions)
+	}
 	if !task.StartedAt.IsZero() {
 		builder = builder.SetStartedAt(task.StartedAt)
 	}
@@ -136,7 +151,7 @@ func (r *ScreeningRepo) UpdateScreeningTask(ctx context.Context, id uuid.UUID, u
 		return nil
 	}
 	builder := r.db.ScreeningTask.UpdateOneID(id)
-	if err := applyTaskUpdates(builder, updates); err != nil {
+	if err := applyTaskUpdates(builder.Mutation(), updates); err != nil {
 		return err
 	}
 	if err := builder.Exec(ctx); err != nil {
@@ -145,6 +160,66 @@ func (r *ScreeningRepo) UpdateScreeningTask(ctx context.Context, id uuid.UUID, u
 	return nil
 }
 
+// UpdateScreeningTaskIfStatus 仅当任务处于指定状态时更新，多副本并发收尾时只有一个能成功
+func (r *ScreeningRepo) UpdateScreeningTaskIfStatus(ctx context.Context, id uuid.UUID, status consts.ScreeningTaskStatus, updates map[string]any) (bool, error) {
+	builder := r.db.ScreeningTask.Update().
+		Where(
+			screeningtask.ID(id),
+			screeningtask.StatusEQ(string(status)),
+		)
+	if err := applyTaskUpdates(builder.Mutation(), updates); err != nil {
+		return false, err
+	}
+	affected, err := builder.Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("update screening task failed: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// ListScreeningTaskIDsByStatus 查询指定状态的全部任务ID
+func (r *ScreeningRepo) ListScreeningTaskIDsByStatus(ctx context.Context, status consts.ScreeningTaskStatus) ([]uuid.UUID, error) {
+	ids, err := r.db.ScreeningTask.Query().
+		Where(screeningtask.StatusEQ(string(status))).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list screening task ids failed: %w", err)
+	}
+	return ids, nil
+}
+
+// screeningRecoveryLockKey 任务恢复使用的 PostgreSQL 事务级咨询锁键
+const screeningRecoveryLockKey int64 = 0x5C12EE01
+
+// WithScreeningRecoveryLock 尝试获取任务恢复的咨询锁，获取成功时在持有锁期间执行 fn。
+// 锁随事务结束自动释放，其他副本获取失败时直接返回 false，不等待
+func (r *ScreeningRepo) WithScreeningRecoveryLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error) {
+	return withAdvisoryXactLock(ctx, r.db, screeningRecoveryLockKey, fn)
+}
+
+// withAdvisoryXactLock 在事务内尝试获取 PostgreSQL 事务级咨询锁，获取成功时执行 fn
+func withAdvisoryXactLock(ctx context.Context, client *db.Client, key int64, fn func(ctx context.Context) error) (bool, error) {
+	acquired := false
+	err := entx.WithTx(ctx, client, func(tx *db.Tx) error {
+		rows, err := tx.QueryContext(ctx, "SELECT pg_try_advisory_xact_lock($1)", key)
+		if err != nil {
+			return fmt.Errorf("acquire advisory lock %d failed: %w", key, err)
+		}
+		if rows.Next() {
+			if err := rows.Scan(&acquired); err != nil {
+				rows.Close()
+				return fmt.Errorf("scan advisory lock %d failed: %w", key, err)
+			}
+		}
+		rows.Close()
+		if !acquired {
+			return nil
+		}
+		return fn(ctx)
+	})
+	return acquired, err
+}
+
 // DeleteScreeningTask 删除任务
 func (r *ScreeningRepo) DeleteScreeningTask(ctx context.Context, id uuid.UUID) error {
 	// 使用事务确保数据一致性
@@ -297,6 +372,158 @@ func (r *ScreeningRepo) UpdateScreeningTaskResume(ctx context.Context, taskID, r
 	return nil
 }
 
+// ListAllScreeningTaskResumes 查询任务下的全部简历关联
+func (r *ScreeningRepo) ListAllScreeningTaskResumes(ctx context.Context, taskID uuid.UUID) ([]*db.ScreeningTaskResume, error) {
+	items, err := r.db.ScreeningTaskResume.Query().
+		Where(screeningtaskresume.TaskID(taskID)).
+		Order(screeningtaskresume.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list screening task resumes failed: %w", err)
+	}
+	return items, nil
+}
+
+// ClaimScreeningTaskResume 抢占任务简历，保证同一简历在多副本间只被一个消费者处理
+func (r *ScreeningRepo) ClaimScreeningTaskResume(ctx context.Context, taskID, resumeID uuid.UUID, staleBefore time.Time) (bool, error) {
+	affected, err := r.db.ScreeningTaskResume.Update().
+		Where(
+			screeningtaskresume.TaskID(taskID),
+			screeningtaskresume.ResumeID(resumeID),
+			screeningtaskresume.Or(
+				screeningtaskresume.StatusEQ(string(consts.ScreeningTaskResumeStatusPending)),
+				screeningtaskresume.And(
+					screeningtaskresume.StatusEQ(string(consts.ScreeningTaskResumeStatusRunning)),
+					screeningtaskresume.UpdatedAtLT(staleBefore),
+				),
+			),
+		).
+		SetStatus(string(consts.ScreeningTaskResumeStatusRunning)).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("claim screening task resume failed: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// TouchPendingScreeningTaskResumes 刷新重新投递的待处理任务简历的更新时间，避免下一轮恢复重复投递
+func (r *ScreeningRepo) TouchPendingScreeningTaskResumes(ctx context.Context, taskID uuid.UUID, resumeIDs []uuid.UUID) error {
+	if len(resumeIDs) == 0 {
+		return nil
+	}
+	_, err := r.db.ScreeningTaskResume.Update().
+		Where(
+			screeningtaskresume.TaskID(taskID),
+			screeningtaskresume.ResumeIDIn(resumeIDs...),
+			screeningtaskresume.StatusEQ(string(consts.ScreeningTaskResumeStatusPending)),
+		).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("touch pending screening task resumes failed: %w", err)
+	}
+	return nil
+}
+
+// TouchScreeningTaskResume 刷新运行中任务简历的更新时间，作为处理心跳；简历已结束时不做修改
+func (r *ScreeningRepo) TouchScreeningTaskResume(ctx context.Context, taskID, resumeID uuid.UUID) error {
+	_, err := r.db.ScreeningTaskResume.Update().
+		Where(
+			screeningtaskresume.TaskID(taskID),
+			screeningtaskresume.ResumeID(resumeID),
+			screeningtaskresume.StatusEQ(string(consts.ScreeningTaskResumeStatusRunning)),
+		).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("touch screening task resume failed: %w", err)
+	}
+	return nil
+}
+
+// ListScreeningTaskUsage 按任务汇总处理时间落在 [start, end) 内的简历用量。
+// 费用统计需包含已删除的任务，因此跳过软删除过滤
+func (r *ScreeningRepo) ListScreeningTaskUsage(ctx context.Context, start, end time.Time) ([]*domain.ScreeningTaskUsage, error) {
+	ctx = entx.SkipSoftDelete(ctx)
+
+	var rows []struct {
+		TaskID       uuid.UUID `json:"task_id"`
+		Count        int       `json:"count"`
+		TokensInput  int64     `json:"tokens_input"`
+		TokensOutput int64     `json:"tokens_output"`
+		TotalCost    float64   `json:"total_cost"`
+	}
+	err := r.db.ScreeningTaskResume.Query().
+		Where(
+			screeningtaskresume.ProcessedAtGTE(start),
+			screeningtaskresume.ProcessedAtLT(end),
+			screeningtaskresume.StatusIn(
+				string(consts.ScreeningTaskResumeStatusCompleted),
+				string(consts.ScreeningTaskResumeStatusFailed),
+			),
+		).
+		GroupBy(screeningtaskresume.FieldTaskID).
+		Aggregate(
+			db.Count(),
+			db.As(db.Sum(screeningtaskresume.FieldTokensInput), "tokens_input"),
+			db.As(db.Sum(screeningtaskresume.FieldTokensOutput), "tokens_output"),
+			db.As(db.Sum(screeningtaskresume.FieldTotalCost), "total_cost"),
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate screening usage failed: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	taskIDs := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		taskIDs = append(taskIDs, row.TaskID)
+	}
+	tasks, err := r.db.ScreeningTask.Query().
+		Where(screeningtask.IDIn(taskIDs...)).
+		WithCreator().
+		WithJobPosition(func(q *db.JobPositionQuery) {
+			q.WithDepartment()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query screening tasks failed: %w", err)
+	}
+	taskMap := make(map[uuid.UUID]*db.ScreeningTask, len(tasks))
+	for _, task := range tasks {
+		taskMap[task.ID] = task
+	}
+
+	usages := make([]*domain.ScreeningTaskUsage, 0, len(rows))
+	for _, row := range rows {
+		usage := &domain.ScreeningTaskUsage{
+			TaskID:       row.TaskID,
+			ResumeCount:  row.Count,
+			TokensInput:  row.TokensInput,
+			TokensOutput: row.TokensOutput,
+			TotalCost:    row.TotalCost,
+		}
+		if task, ok := taskMap[row.TaskID]; ok {
+			usage.JobPositionID = task.JobPositionID
+			usage.CreatedBy = task.CreatedBy
+			if creator := task.Edges.Creator; creator != nil {
+				usage.CreatorName = creator.Username
+			}
+			if job := task.Edges.JobPosition; job != nil {
+				usage.JobName = job.Name
+				usage.DepartmentID = job.DepartmentID
+				if dept := job.Edges.Department; dept != nil {
+					usage.DepartmentName = dept.Name
+				}
+			}
+		}
+		usages = append(usages, usage)
+	}
+	return usages, nil
+}
+
 // BatchUpdateScreeningTaskResumeRankings 批量更新任务简历排名
 func (r *ScreeningRepo) BatchUpdateScreeningTaskResumeRankings(ctx context.Context, taskID uuid.UUID, rankings map[uuid.UUID]int) error {
 	if len(rankings) == 0 {
@@ -372,9 +599,15 @@ func (r *ScreeningRepo) CreateScreeningResult(ctx context.Context, result *db.Sc
 	if len(result.SkillDetail) > 0 {
 		builder = builder.SetSkillDetail(result.SkillDetail)
 	}
+	if len(result.CustomDetails) > 0 {
+		builder = builder.SetCustomDetails(result.CustomDetails)
+	}
 	if len(result.Recommendations) > 0 {
 		builder = builder.SetRecommendations(result.Recommendations)
 	}
+	if len(result.KnockoutReasons) > 0 {
+		builder = builder.SetKnockoutReasons(result.KnockoutReasons)
+	}
 	if result.TraceID != "" {
 		builder = builder.SetTraceID(result.TraceID)
 	}
@@ -384,6 +617,16 @@ func (r *ScreeningRepo) CreateScreeningResult(ctx context.Context, result *db.Sc
 	if len(result.SubAgentVersions) > 0 {
 		builder = builder.SetSubAgentVersions(result.SubAgentVersions)
 	}
+	if result.JobHash != "" {
+		builder = builder.SetJobHash(result.JobHash)
+	}
+	if result.ResumeHash != "" {
+		builder = builder.SetResumeHash(result.ResumeHash)
+	}
+	if result.AgentHash != "" {
+		builder = builder.SetAgentHash(result.AgentHash)
+	}
+	builder = builder.SetNillableCachedFromID(result.CachedFromID)
 	if !result.MatchedAt.IsZero() {
 		builder = builder.SetMatchedAt(result.MatchedAt)
 	}
@@ -395,6 +638,104 @@ func (r *ScreeningRepo) CreateScreeningResult(ctx context.Context, result *db.Sc
 	return entity, nil
 }
 
+// DeleteScreeningResult 物理删除筛选结果，(task_id, resume_id) 唯一索引包含软删除记录，需跳过软删除
+func (r *ScreeningRepo) DeleteScreeningResult(ctx context.Context, taskID, resumeID uuid.UUID) error {
+	if _, err := r.db.ScreeningResult.Delete().
+		Where(
+			screeningresult.TaskID(taskID),
+			screeningresult.ResumeID(resumeID),
+		).
+		Exec(entx.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("delete screening result failed: %w", err)
+	}
+	return nil
+}
+
+// GetLatestScreeningResultByFingerprint 按内容指纹查询最近一次的筛选结果
+func (r *ScreeningRepo) GetLatestScreeningResultByFingerprint(ctx context.Context, jobHash, resumeHash, agentHash string) (*db.ScreeningResult, error) {
+	return r.db.ScreeningResult.Query().
+		Where(
+			screeningresult.JobHash(jobHash),
+			screeningresult.ResumeHash(resumeHash),
+			screeningresult.AgentHash(agentHash),
+		).
+		Order(db.Desc(screeningresult.FieldMatchedAt)).
+		First(ctx)
+}
+
+// UpdateScreeningResultOverride 保存或清除筛选结果的人工校准信息，不修改模型原始输出
+func (r *ScreeningRepo) UpdateScreeningResultOverride(ctx context.Context, taskID, resumeID uuid.UUID, override *domain.ScreeningResultOverride) (*db.ScreeningResult, error) {
+	entity, err := r.GetScreeningResult(ctx, taskID, resumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := entity.Update()
+	if override == nil {
+		builder = builder.
+			ClearHumanOverallScore().
+			ClearHumanMatchLevel().
+			ClearHumanDimensionScores().
+			ClearOverrideReason().
+			ClearOverriddenBy().
+			ClearOverriddenAt()
+	} else {
+		if override.OverallScore != nil {
+			builder = builder.SetHumanOverallScore(*override.OverallScore)
+		} else {
+			builder = builder.ClearHumanOverallScore()
+		}
+		if override.MatchLevel != nil {
+			builder = builder.SetHumanMatchLevel(screeningresult.HumanMatchLevel(*override.MatchLevel))
+		} else {
+			builder = builder.ClearHumanMatchLevel()
+		}
+		if len(override.DimensionScores) > 0 {
+			scores := make(map[string]interface{}, len(override.DimensionScores))
+			for k, v := range override.DimensionScores {
+				scores[k] = v
+			}
+			builder = builder.SetHumanDimensionScores(scores)
+		} else {
+			builder = builder.ClearHumanDimensionScores()
+		}
+		builder = builder.
+			SetOverrideReason(override.Reason).
+			SetOverriddenBy(override.OverriddenBy).
+			SetOverriddenAt(override.OverriddenAt)
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("update screening result override failed: %w", err)
+	}
+	return updated, nil
+}
+
+// ListOverriddenScreeningResults 查询被人工校准过的筛选结果
+func (r *ScreeningRepo) ListOverriddenScreeningResults(ctx context.Context, filter *domain.ScreeningCalibrationFilter) ([]*db.ScreeningResult, error) {
+	query := r.db.ScreeningResult.Query().
+		Where(screeningresult.OverriddenAtNotNil())
+
+	if filter != nil {
+		if filter.JobPositionID != nil {
+			query = query.Where(screeningresult.JobPositionID(*filter.JobPositionID))
+		}
+		if filter.Start != nil {
+			query = query.Where(screeningresult.OverriddenAtGTE(*filter.Start))
+		}
+		if filter.End != nil {
+			query = query.Where(screeningresult.OverriddenAtLT(*filter.End))
+		}
+	}
+
+	results, err := query.Order(db.Desc(screeningresult.FieldOverriddenAt)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list overridden screening results failed: %w", err)
+	}
+	return results, nil
+}
+
 // GetScreeningResult 查询筛选结果
 func (r *ScreeningRepo) GetScreeningResult(ctx context.Context, taskID, resumeID uuid.UUID) (*db.ScreeningResult, error) {
 	entity, err := r.db.ScreeningResult.Query().
@@ -449,6 +790,19 @@ func (r *ScreeningRepo) ListScreeningResults(ctx context.Context, filter *domain
 	return items, pageInfo, nil
 }
 
+// ListTaskResultsWithResume 查询任务全部筛选结果并预加载简历，按综合得分降序，用于导出报告
+func (r *ScreeningRepo) ListTaskResultsWithResume(ctx context.Context, taskID uuid.UUID) ([]*db.ScreeningResult, error) {
+	items, err := r.db.ScreeningResult.Query().
+		Where(screeningresult.TaskID(taskID)).
+		WithResume().
+		Order(screeningresult.ByOverallScore(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list task screening results failed: %w", err)
+	}
+	return items, nil
+}
+
 // CreateScreeningRunMetric 新建运行指标
 func (r *ScreeningRepo) CreateScreeningRunMetric(ctx context.Context, metric *db.ScreeningRunMetric) (*db.ScreeningRunMetric, error) {
 	if metric == nil {
@@ -499,7 +853,8 @@ func floatMapToAny(input map[string]float64) map[string]any {
 	return result
 }
 
-func applyTaskUpdates(builder *db.ScreeningTaskUpdateOne, updates map[string]any) error {
+// applyTaskUpdates 将更新字段写入变更集，同时适用于单条与批量更新
+func applyTaskUpdates(builder *db.ScreeningTaskMutation, updates map[string]any) error {
 	for key, value := range updates {
 		switch key {
 		case "status":
@@ -519,6 +874,14 @@ func applyTaskUpdates(builder *db.ScreeningTaskUpdateOne, updates map[string]any
 			if cfg, ok := value.(map[string]any); ok {
 				builder.SetLlmConfig(cfg)
 			}
+		case "shortlist_ranking":
+			if value == nil {
+				builder.ClearShortlistRanking()
+				continue
+			}
+			if ranking, ok := value.(map[string]any); ok {
+				builder.SetShortlistRanking(ranking)
+			}
 		case "notes":
 			if str, ok := value.(string); ok {
 				builder.SetNotes(str)
@@ -535,6 +898,28 @@ func applyTaskUpdates(builder *db.ScreeningTaskUpdateOne, updates map[string]any
 			if str, ok := value.(string); ok {
 				builder.SetAgentVersion(str)
 			}
+		case "token_budget":
+			if value == nil {
+				builder.ClearTokenBudget()
+				continue
+			}
+			builder.SetTokenBudget(int64(asInt(value)))
+		case "cost_budget":
+			if value == nil {
+				builder.ClearCostBudget()
+				continue
+			}
+			if f, ok := value.(float64); ok {
+				builder.SetCostBudget(f)
+			}
+		case "tokens_input":
+			builder.SetTokensInput(int64(asInt(value)))
+		case "tokens_output":
+			builder.SetTokensOutput(int64(asInt(value)))
+		case "total_cost":
+			if f, ok := value.(float64); ok {
+				builder.SetTotalCost(f)
+			}
 		case "started_at":
 			switch v := value.(type) {
 			case time.Time:
@@ -599,6 +984,14 @@ func applyTaskResumeUpdates(builder *db.ScreeningTaskResumeUpdate, updates map[s
 					builder.SetProcessedAt(*v)
 				}
 			}
+		case "tokens_input":
+			builder.SetTokensInput(int64(asInt(value)))
+		case "tokens_output":
+			builder.SetTokensOutput(int64(asInt(value)))
+		case "total_cost":
+			if f, ok := value.(float64); ok {
+				builder.SetTotalCost(f)
+			}
 		}
 	}
 	return nil
@@ -0,0 +1,105 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/db/screeningdimension"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+// ScreeningDimensionRepo 岗位自定义匹配维度仓储实现
+type ScreeningDimensionRepo struct {
+	db *db.Client
+}
+
+// NewScreeningDimensionRepo 构建仓储
+func NewScreeningDimensionRepo(client *db.Client) domain.ScreeningDimensionRepo {
+	return &ScreeningDimensionRepo{db: client}
+}
+
+// Create 创建维度
+func (r *ScreeningDimensionRepo) Create(ctx context.Context, dim *db.ScreeningDimension) (*db.ScreeningDimension, error) {
+	if dim == nil {
+		return nil, fmt.Errorf("screening dimension is nil")
+	}
+
+	entity, err := r.db.ScreeningDimension.Create().
+		SetJobPositionID(dim.JobPositionID).
+		SetKey(dim.Key).
+		SetName(dim.Name).
+		SetDescription(dim.Description).
+		SetPrompt(dim.Prompt).
+		SetWeight(dim.Weight).
+		SetNillableCreatedBy(dim.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create screening dimension failed: %w", err)
+	}
+
+	return entity, nil
+}
+
+// GetByID 根据 ID 获取维度
+func (r *ScreeningDimensionRepo) GetByID(ctx context.Context, id uuid.UUID) (*db.ScreeningDimension, error) {
+	return r.db.ScreeningDimension.Query().
+		Where(screeningdimension.ID(id)).
+		Only(ctx)
+}
+
+// ListByJobPosition 查询岗位下的全部维度，按创建时间升序
+func (r *ScreeningDimensionRepo) ListByJobPosition(ctx context.Context, jobPositionID uuid.UUID) ([]*db.ScreeningDimension, error) {
+	items, err := r.db.ScreeningDimension.Query().
+		Where(screeningdimension.JobPositionID(jobPositionID)).
+		Order(screeningdimension.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list screening dimensions failed: %w", err)
+	}
+	return items, nil
+}
+
+// Update 更新维度
+func (r *ScreeningDimensionRepo) Update(ctx context.Context, id uuid.UUID, updates map[string]any) (*db.ScreeningDimension, error) {
+	builder := r.db.ScreeningDimension.UpdateOneID(id)
+
+	for key, value := range updates {
+		switch key {
+		case "name":
+			if v, ok := value.(string); ok {
+				builder = builder.SetName(v)
+			}
+		case "description":
+			if v, ok := value.(string); ok {
+				builder = builder.SetDescription(v)
+			}
+		case "prompt":
+			if v, ok := value.(string); ok {
+				builder = builder.SetPrompt(v)
+			}
+		case "weight":
+			if v, ok := value.(float64); ok {
+				builder = builder.SetWeight(v)
+			}
+		}
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("update screening dimension failed: %w", err)
+	}
+	return entity, nil
+}
+
+// Delete 删除维度（软删除）
+func (r *ScreeningDimensionRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	// SoftDeleteMixin 会自动处理软删除
+	if err := r.db.ScreeningDimension.DeleteOneID(id).Exec(ctx); err != nil {
+		return fmt.Errorf("delete screening dimension failed: %w", err)
+	}
+	return nil
+}
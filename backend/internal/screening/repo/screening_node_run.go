@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 
+	"github.com/chaitin/WhaleHire/backend/consts"
 	"github.com/chaitin/WhaleHire/backend/db"
 	"github.com/chaitin/WhaleHire/backend/db/screeningnoderun"
 	"github.com/chaitin/WhaleHire/backend/domain"
@@ -58,6 +59,12 @@ func (r *ScreeningNodeRunRepo) Create(ctx context.Context, req *domain.CreateNod
 	if req.OutputPayload != nil {
 		builder = builder.SetOutputPayload(req.OutputPayload)
 	}
+	if req.CacheKey != "" {
+		builder = builder.SetCacheKey(req.CacheKey)
+	}
+	if req.ReusedFromID != nil {
+		builder = builder.SetReusedFromID(*req.ReusedFromID)
+	}
 
 	entity, err := builder.Save(ctx)
 	if err != nil {
@@ -117,6 +124,18 @@ func (r *ScreeningNodeRunRepo) GetByTaskResumeAndNode(ctx context.Context, taskR
 	return entity, nil
 }
 
+// GetLatestCompletedByCacheKey 按复用键查询最近一次成功且有输出的节点运行记录
+func (r *ScreeningNodeRunRepo) GetLatestCompletedByCacheKey(ctx context.Context, cacheKey string) (*db.ScreeningNodeRun, error) {
+	return r.db.ScreeningNodeRun.Query().
+		Where(
+			screeningnoderun.CacheKey(cacheKey),
+			screeningnoderun.Status(string(consts.ScreeningNodeRunStatusCompleted)),
+			screeningnoderun.OutputPayloadNotNil(),
+		).
+		Order(db.Desc(screeningnoderun.FieldCreatedAt)).
+		First(ctx)
+}
+
 // GetByID 根据ID查询节点运行记录
 func (r *ScreeningNodeRunRepo) GetByID(ctx context.Context, id uuid.UUID) (*db.ScreeningNodeRun, error) {
 	entity, err := r.db.ScreeningNodeRun.Get(ctx, id)
@@ -0,0 +1,161 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/db/resume"
+	"github.com/chaitin/WhaleHire/backend/db/resumejobapplication"
+	"github.com/chaitin/WhaleHire/backend/db/screeningschedule"
+	"github.com/chaitin/WhaleHire/backend/db/screeningtask"
+	"github.com/chaitin/WhaleHire/backend/db/screeningtaskresume"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+// screeningScheduleLockKey 自动筛选巡检使用的 PostgreSQL 事务级咨询锁键
+const screeningScheduleLockKey int64 = 0x5C12EE02
+
+// ScreeningScheduleRepo 岗位自动筛选配置仓储实现
+type ScreeningScheduleRepo struct {
+	db *db.Client
+}
+
+// NewScreeningScheduleRepo 构建仓储
+func NewScreeningScheduleRepo(client *db.Client) domain.ScreeningScheduleRepo {
+	return &ScreeningScheduleRepo{db: client}
+}
+
+// Upsert 按岗位创建或更新配置
+func (r *ScreeningScheduleRepo) Upsert(ctx context.Context, schedule *db.ScreeningSchedule) (*db.ScreeningSchedule, error) {
+	if schedule == nil {
+		return nil, fmt.Errorf("screening schedule is nil")
+	}
+
+	existing, err := r.GetByJobPosition(ctx, schedule.JobPositionID)
+	if err != nil && !db.IsNotFound(err) {
+		return nil, fmt.Errorf("get screening schedule failed: %w", err)
+	}
+
+	if existing == nil {
+		entity, err := r.db.ScreeningSchedule.Create().
+			SetJobPositionID(schedule.JobPositionID).
+			SetMode(schedule.Mode).
+			SetNillableWeightTemplateID(schedule.WeightTemplateID).
+			SetLlmConfig(schedule.LlmConfig).
+			SetKnockoutRules(schedule.KnockoutRules).
+			SetBlindMode(schedule.BlindMode).
+			SetDigestHour(schedule.DigestHour).
+			SetEnabled(schedule.Enabled).
+			SetCreatedBy(schedule.CreatedBy).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("create screening schedule failed: %w", err)
+		}
+		return entity, nil
+	}
+
+	builder := r.db.ScreeningSchedule.UpdateOneID(existing.ID).
+		SetMode(schedule.Mode).
+		SetBlindMode(schedule.BlindMode).
+		SetDigestHour(schedule.DigestHour).
+		SetEnabled(schedule.Enabled)
+	if schedule.WeightTemplateID != nil {
+		builder = builder.SetWeightTemplateID(*schedule.WeightTemplateID)
+	} else {
+		builder = builder.ClearWeightTemplateID()
+	}
+	if len(schedule.LlmConfig) > 0 {
+		builder = builder.SetLlmConfig(schedule.LlmConfig)
+	} else {
+		builder = builder.ClearLlmConfig()
+	}
+	if len(schedule.KnockoutRules) > 0 {
+		builder = builder.SetKnockoutRules(schedule.KnockoutRules)
+	} else {
+		builder = builder.ClearKnockoutRules()
+	}
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("update screening schedule failed: %w", err)
+	}
+	return entity, nil
+}
+
+// GetByJobPosition 查询岗位的配置
+func (r *ScreeningScheduleRepo) GetByJobPosition(ctx context.Context, jobPositionID uuid.UUID) (*db.ScreeningSchedule, error) {
+	return r.db.ScreeningSchedule.Query().
+		Where(screeningschedule.JobPositionID(jobPositionID)).
+		Only(ctx)
+}
+
+// ListEnabled 查询全部启用的配置
+func (r *ScreeningScheduleRepo) ListEnabled(ctx context.Context) ([]*db.ScreeningSchedule, error) {
+	items, err := r.db.ScreeningSchedule.Query().
+		Where(screeningschedule.Enabled(true)).
+		Order(screeningschedule.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list enabled screening schedules failed: %w", err)
+	}
+	return items, nil
+}
+
+// UpdateLastRunAt 记录最近一次执行时间
+func (r *ScreeningScheduleRepo) UpdateLastRunAt(ctx context.Context, id uuid.UUID, runAt time.Time) error {
+	if err := r.db.ScreeningSchedule.UpdateOneID(id).SetLastRunAt(runAt).Exec(ctx); err != nil {
+		return fmt.Errorf("update screening schedule last run failed: %w", err)
+	}
+	return nil
+}
+
+// DeleteByJobPosition 删除岗位的配置
+func (r *ScreeningScheduleRepo) DeleteByJobPosition(ctx context.Context, jobPositionID uuid.UUID) error {
+	if _, err := r.db.ScreeningSchedule.Delete().
+		Where(screeningschedule.JobPositionID(jobPositionID)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("delete screening schedule failed: %w", err)
+	}
+	return nil
+}
+
+// ListUnscreenedResumeIDs 查询岗位在 since 之后投递、已解析完成且尚未加入该岗位任何筛选任务的简历，按投递时间升序。
+// 以是否已加入任务判断，而非游标，解析较慢的简历会在解析完成后的下一次巡检被选中
+func (r *ScreeningScheduleRepo) ListUnscreenedResumeIDs(ctx context.Context, jobPositionID uuid.UUID, since time.Time) ([]uuid.UUID, error) {
+	applications, err := r.db.ResumeJobApplication.Query().
+		Where(
+			resumejobapplication.JobPositionID(jobPositionID),
+			resumejobapplication.CreatedAtGTE(since),
+			resumejobapplication.HasResumeWith(
+				resume.Status(string(domain.ResumeStatusCompleted)),
+				resume.Not(resume.HasScreeningTaskResumesWith(
+					screeningtaskresume.HasTaskWith(screeningtask.JobPositionID(jobPositionID)),
+				)),
+			),
+		).
+		Order(resumejobapplication.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list unscreened applications failed: %w", err)
+	}
+
+	seen := make(map[uuid.UUID]struct{}, len(applications))
+	resumeIDs := make([]uuid.UUID, 0, len(applications))
+	for _, app := range applications {
+		if _, ok := seen[app.ResumeID]; ok {
+			continue
+		}
+		seen[app.ResumeID] = struct{}{}
+		resumeIDs = append(resumeIDs, app.ResumeID)
+	}
+	return resumeIDs, nil
+}
+
+// WithScheduleLock 尝试获取自动筛选巡检的咨询锁，获取成功时在持有锁期间执行 fn
+func (r *ScreeningScheduleRepo) WithScheduleLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error) {
+	return withAdvisoryXactLock(ctx, r.db, screeningScheduleLockKey, fn)
+}
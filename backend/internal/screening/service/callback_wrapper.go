@@ -12,6 +12,7 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db"
 	"github.com/chaitin/WhaleHire/backend/domain"
 	screening "github.com/chaitin/WhaleHire/backend/pkg/eino/graphs/screening"
+	"github.com/chaitin/WhaleHire/backend/pkg/eino/models"
 	"github.com/cloudwego/eino/callbacks"
 	"github.com/cloudwego/eino/components/model"
 	"github.com/cloudwego/eino/compose"
@@ -26,6 +27,9 @@ type CallbackCollectorWrapper struct {
 	taskID         uuid.UUID
 	resumeID       uuid.UUID
 	traceID        string
+	modelKey       models.ModelKey
+	prices         models.PriceTable
+	cacheKeys      map[string]string // 各维度节点的复用键，随节点运行记录保存
 	logger         *slog.Logger
 }
 
@@ -37,11 +41,17 @@ func NewCallbackCollectorWrapper(
 	screeningGraph *screening.ScreeningChatGraph,
 	taskID, resumeID uuid.UUID,
 	traceID string,
+	modelKey models.ModelKey,
+	prices models.PriceTable,
+	cacheKeys map[string]string,
 	logger *slog.Logger,
 ) *CallbackCollectorWrapper {
 	if collector == nil {
 		collector = screening.NewAgentCallbackCollector()
 	}
+	if screeningGraph != nil {
+		collector.RegisterCustomNodes(screeningGraph.CustomNodeKeys()...)
+	}
 	return &CallbackCollectorWrapper{
 		AgentCallbackCollector: collector,
 		nodeRunRepo:            nodeRunRepo,
@@ -50,6 +60,9 @@ func NewCallbackCollectorWrapper(
 		taskID:                 taskID,
 		resumeID:               resumeID,
 		traceID:                traceID,
+		modelKey:               modelKey,
+		prices:                 prices,
+		cacheKeys:              cacheKeys,
 		logger:                 logger,
 	}
 }
@@ -71,6 +84,9 @@ func (w *CallbackCollectorWrapper) ComposeOptions() []compose.Option {
 		domain.SkillAgent,
 		domain.AggregatorAgent,
 	}
+	if w.screeningGraph != nil {
+		nodeKeys = append(nodeKeys, w.screeningGraph.CustomNodeKeys()...)
+	}
 
 	dbOptions := make([]compose.Option, 0, len(nodeKeys))
 	for _, nodeKey := range nodeKeys {
@@ -127,6 +143,10 @@ func (w *CallbackCollectorWrapper) newDatabaseCallbackHandler(nodeKey string) ca
 					if data, ok := input.(map[string]any); ok {
 						inputData = data
 					}
+				default:
+					if data, ok := input.(*domain.CustomDimensionData); ok {
+						inputData = data
+					}
 				}
 				// 只有当输入数据类型正确时才保存到数据库
 				if inputData != nil {
@@ -243,6 +263,18 @@ func (w *CallbackCollectorWrapper) newDatabaseCallbackHandler(nodeKey string) ca
 					if usage, ok := w.AgentCallbackCollector.AggregatorTokenUsage(); ok {
 						tokenUsage = usage
 					}
+				default:
+					if result, ok := output.(*domain.CustomMatchDetail); ok {
+						outputData = result
+					} else {
+						w.logger.Warn("CustomDimensionAgent输出类型断言失败",
+							slog.String("nodeKey", nodeKey),
+							slog.String("actualType", fmt.Sprintf("%T", output)),
+						)
+					}
+					if usage, ok := w.AgentCallbackCollector.TokenUsageOf(nodeKey); ok {
+						tokenUsage = usage
+					}
 				}
 
 				w.updateNodeRunInDB(ctx, nodeKey, outputData, nil, tokenUsage, consts.ScreeningNodeRunStatusCompleted)
@@ -283,7 +315,13 @@ func (w *CallbackCollectorWrapper) newDatabaseCallbackHandler(nodeKey string) ca
 					if usage, ok := w.AgentCallbackCollector.AggregatorTokenUsage(); ok {
 						tokenUsage = usage
 					}
+				default:
 					// TaskMetaDataNode 和 DispatcherNode 通常不消耗token，无需处理
+					if _, ok := domain.CustomDimensionKeyFromNode(nodeKey); ok {
+						if usage, ok := w.AgentCallbackCollector.TokenUsageOf(nodeKey); ok {
+							tokenUsage = usage
+						}
+					}
 				}
 
 				go w.updateNodeRunInDB(ctx, nodeKey, nil, err, tokenUsage, consts.ScreeningNodeRunStatusFailed)
@@ -382,6 +420,7 @@ func (w *CallbackCollectorWrapper) updateNodeRunInDB(
 			if tokenUsage.CompletionTokens > 0 {
 				updater.SetTokensOutput(int64(tokenUsage.CompletionTokens))
 			}
+			updater.SetTotalCost(w.prices.Cost(w.modelKey, int64(tokenUsage.PromptTokens), int64(tokenUsage.CompletionTokens)))
 		}
 
 		return nil
@@ -478,6 +517,12 @@ func (w *CallbackCollectorWrapper) saveNodeRunToDB(
 		AttemptNo:    1, // 默认尝试次数为1
 		TraceID:      &w.traceID,
 		AgentVersion: agentVersion,
+		CacheKey:     w.cacheKeys[nodeKey],
+	}
+	if w.modelKey.Name != "" {
+		req.ModelName = &w.modelKey.Name
+		provider := string(w.modelKey.Type)
+		req.ModelProvider = &provider
 	}
 
 	if inputPayload != nil {
@@ -504,6 +549,59 @@ func (w *CallbackCollectorWrapper) saveNodeRunToDB(
 	}
 }
 
+// SaveReusedNodeRuns 为复用历史结果的维度写入已完成的节点运行记录，输出沿用来源记录且不计令牌与费用
+func (w *CallbackCollectorWrapper) SaveReusedNodeRuns(ctx context.Context, sources map[string]*db.ScreeningNodeRun) {
+	if w.nodeRunRepo == nil || len(sources) == 0 {
+		return
+	}
+
+	taskResumeID, err := w.getTaskResumeID(ctx)
+	if err != nil {
+		w.logger.Error("获取TaskResumeID失败",
+			slog.String("traceID", w.traceID),
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	for nodeKey, source := range sources {
+		// 来源记录本身也可能是复用的，始终指向最初调用模型的记录
+		sourceID := source.ID
+		if source.ReusedFromID != nil {
+			sourceID = *source.ReusedFromID
+		}
+		req := &domain.CreateNodeRunRepoReq{
+			TaskID:        w.taskID,
+			TaskResumeID:  taskResumeID,
+			NodeKey:       nodeKey,
+			Status:        consts.ScreeningNodeRunStatusCompleted,
+			AttemptNo:     1,
+			TraceID:       &w.traceID,
+			InputPayload:  source.InputPayload,
+			OutputPayload: source.OutputPayload,
+			CacheKey:      source.CacheKey,
+			ReusedFromID:  &sourceID,
+		}
+		if source.AgentVersion != "" {
+			req.AgentVersion = &source.AgentVersion
+		}
+		if source.ModelName != "" {
+			req.ModelName = &source.ModelName
+		}
+		if source.ModelProvider != "" {
+			req.ModelProvider = &source.ModelProvider
+		}
+		if _, err := w.nodeRunRepo.Create(ctx, req); err != nil {
+			w.logger.Error("保存复用节点运行数据失败",
+				slog.String("nodeKey", nodeKey),
+				slog.String("taskResumeID", taskResumeID.String()),
+				slog.String("traceID", w.traceID),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
 // getTaskResumeID 根据TaskID和ResumeID获取TaskResumeID
 func (w *CallbackCollectorWrapper) getTaskResumeID(ctx context.Context) (uuid.UUID, error) {
 	taskResume, err := w.screeningRepo.GetScreeningTaskResume(ctx, w.taskID, w.resumeID)
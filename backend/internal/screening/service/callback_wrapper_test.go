@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/pkg/eino/models"
+)
+
+type recordingNodeRunRepo struct {
+	domain.ScreeningNodeRunRepo
+	created []*domain.CreateNodeRunRepoReq
+}
+
+func (r *recordingNodeRunRepo) Create(_ context.Context, req *domain.CreateNodeRunRepoReq) (*db.ScreeningNodeRun, error) {
+	r.created = append(r.created, req)
+	return &db.ScreeningNodeRun{}, nil
+}
+
+type taskResumeScreeningRepo struct {
+	domain.ScreeningRepo
+}
+
+func (taskResumeScreeningRepo) GetScreeningTaskResume(context.Context, uuid.UUID, uuid.UUID) (*db.ScreeningTaskResume, error) {
+	return &db.ScreeningTaskResume{ID: uuid.New()}, nil
+}
+
+func TestBlindNodeRunPayloadOmitsMergedFileURLs(t *testing.T) {
+	resume := &domain.ResumeDetail{
+		Resume: &domain.Resume{ID: "resume-1", Name: "张三", ResumeFileURL: "https://files.example.com/current.pdf"},
+		FileHistory: []*domain.ResumeFileHistory{
+			{ResumeID: "resume-0", ResumeFileURL: "https://files.example.com/merged.pdf", Source: "email"},
+		},
+	}
+	redacted := domain.DefaultRedactionPolicy().RedactResume(resume)
+
+	repo := &recordingNodeRunRepo{}
+	w := NewCallbackCollectorWrapper(nil, repo, taskResumeScreeningRepo{}, nil, uuid.New(), uuid.New(), "trace", models.ModelKey{}, nil, nil, slog.Default())
+	w.saveNodeRunToDB(context.Background(), domain.DispatcherNode, &domain.MatchInput{Resume: redacted, BlindMode: true}, nil, nil, nil, consts.ScreeningNodeRunStatusRunning)
+	w.saveNodeRunToDB(context.Background(), domain.BasicInfoAgent, &domain.BasicInfoData{Resume: redacted, BlindMode: true}, nil, nil, nil, consts.ScreeningNodeRunStatusRunning)
+
+	require.Len(t, repo.created, 2)
+	for _, req := range repo.created {
+		require.NotEmpty(t, req.InputPayload)
+		data, err := json.Marshal(req.InputPayload)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "files.example.com", req.NodeKey)
+	}
+	// 原简历仍保留文件历史，供非盲筛场景使用
+	assert.Len(t, resume.FileHistory, 1)
+}
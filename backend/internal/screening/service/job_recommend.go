@@ -0,0 +1,632 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/compose"
+
+	"github.com/chaitin/WhaleHire/backend/config"
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/pkg/eino/graphs/screening/matching/responsibility"
+	"github.com/chaitin/WhaleHire/backend/pkg/eino/graphs/screening/matching/skill"
+	"github.com/chaitin/WhaleHire/backend/pkg/eino/models"
+)
+
+// 粗排各维度权重，岗位未设置对应要求或简历缺少对应信息的维度不参与加权
+const (
+	prefilterSkillWeight          = 0.40
+	prefilterResponsibilityWeight = 0.30
+	prefilterExperienceWeight     = 0.15
+	prefilterEducationWeight      = 0.10
+	prefilterLocationWeight       = 0.05
+)
+
+// bonusSkillWeight 加分技能相对必需技能的权重
+const bonusSkillWeight = 0.5
+
+// responsibilityCoverageSaturation 职责描述与经历措辞差异较大，关键词覆盖率达到该值即视为完全匹配
+const responsibilityCoverageSaturation = 0.5
+
+// llmScoreWeight 精排岗位最终得分中模型得分的占比
+const llmScoreWeight = 0.7
+
+// jobRecommendConcurrency 模型精排的并发岗位数
+const jobRecommendConcurrency = 4
+
+// JobRecommendService 为简历推荐在招岗位的服务接口
+type JobRecommendService interface {
+	// Recommend 对岗位做结构化粗排，按需使用技能、职责匹配 Agent 对靠前岗位精排，返回前 TopK 个岗位
+	Recommend(ctx context.Context, resume *domain.ResumeDetail, jobs []*domain.JobProfileDetail, req *domain.RecommendJobPositionsReq) (*domain.RecommendJobPositionsResp, error)
+}
+
+type jobRecommendService struct {
+	cfg                    *config.Config
+	factory                *models.ModelFactory
+	logger                 *slog.Logger
+	version                string
+	skillRunnable          compose.Runnable[*domain.SkillData, *domain.SkillMatchDetail]
+	responsibilityRunnable compose.Runnable[*domain.ResponsibilityData, *domain.ResponsibilityMatchDetail]
+	currentModelType       models.ModelType
+	currentModelName       string
+	compileMutex           sync.Mutex
+}
+
+// jobCandidate 参与排序的岗位及其推荐结果
+type jobCandidate struct {
+	job  *domain.JobProfileDetail
+	item *domain.JobRecommendation
+}
+
+// NewJobRecommendService 创建岗位推荐服务
+func NewJobRecommendService(cfg *config.Config, logger *slog.Logger) (JobRecommendService, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("config is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &jobRecommendService{
+		cfg:     cfg,
+		factory: models.NewModelFactory(),
+		logger:  logger,
+	}, nil
+}
+
+// Recommend 粗排不调用模型；精排单个岗位失败时保留粗排得分，全部失败时返回错误
+func (s *jobRecommendService) Recommend(ctx context.Context, resume *domain.ResumeDetail, jobs []*domain.JobProfileDetail, req *domain.RecommendJobPositionsReq) (*domain.RecommendJobPositionsResp, error) {
+	if resume == nil || resume.Resume == nil {
+		return nil, fmt.Errorf("简历不能为空")
+	}
+
+	candidates := prefilterJobs(resume, jobs)
+	if len(candidates) > req.TopK {
+		candidates = candidates[:req.TopK]
+	}
+
+	resp := &domain.RecommendJobPositionsResp{TotalPositions: len(jobs)}
+	if req.UseLLM && len(candidates) > 0 {
+		refined := candidates[:min(req.LLMTopK, len(candidates))]
+		version, usage, err := s.refine(ctx, resume, refined, req.LLMConfig)
+		if err != nil {
+			return nil, err
+		}
+		sortRefined(refined)
+		resp.AgentVersion = version
+		resp.TokenUsage = usage
+	}
+
+	resp.Items = make([]*domain.JobRecommendation, 0, len(candidates))
+	for i, c := range candidates {
+		c.item.Rank = i + 1
+		resp.Items = append(resp.Items, c.item)
+	}
+	return resp, nil
+}
+
+// refine 并发调用技能、职责匹配 Agent 评估岗位，模型得分按默认维度权重加权
+func (s *jobRecommendService) refine(ctx context.Context, resume *domain.ResumeDetail, candidates []*jobCandidate, llmConfig map[string]any) (string, map[string]int64, error) {
+	modelType, modelName, err := s.setupModel(llmConfig)
+	if err != nil {
+		return "", nil, fmt.Errorf("设置模型失败: %w", err)
+	}
+	skillRunnable, responsibilityRunnable, version, err := s.ensureCompiled(ctx, modelType, modelName)
+	if err != nil {
+		return "", nil, fmt.Errorf("确保链已编译失败: %w", err)
+	}
+
+	var (
+		mu           sync.Mutex
+		wg           sync.WaitGroup
+		failed       int
+		inputTokens  int64
+		outputTokens int64
+	)
+	sem := make(chan struct{}, jobRecommendConcurrency)
+
+	handler := callbacks.NewHandlerBuilder().
+		OnEndFn(func(ctx context.Context, info *callbacks.RunInfo, output callbacks.CallbackOutput) context.Context {
+			if callbackOutput := model.ConvCallbackOutput(output); callbackOutput != nil && callbackOutput.TokenUsage != nil {
+				mu.Lock()
+				inputTokens += int64(callbackOutput.TokenUsage.PromptTokens)
+				outputTokens += int64(callbackOutput.TokenUsage.CompletionTokens)
+				mu.Unlock()
+			}
+			return ctx
+		}).Build()
+
+	for _, c := range candidates {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(c *jobCandidate) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			var (
+				skillMatch          *domain.SkillMatchDetail
+				responsibilityMatch *domain.ResponsibilityMatchDetail
+				errs                []string
+				err                 error
+			)
+			if len(c.job.Skills) > 0 {
+				skillMatch, err = skillRunnable.Invoke(ctx, &domain.SkillData{
+					JobSkills:      c.job.Skills,
+					ResumeSkills:   nonNil(resume.Skills),
+					ResumeProjects: nonNil(resume.Projects),
+				}, compose.WithCallbacks(handler))
+				if err != nil {
+					errs = append(errs, fmt.Sprintf("技能匹配失败: %v", err))
+				}
+			}
+			if len(c.job.Responsibilities) > 0 {
+				responsibilityMatch, err = responsibilityRunnable.Invoke(ctx, &domain.ResponsibilityData{
+					JobResponsibilities: c.job.Responsibilities,
+					ResumeExperiences:   nonNil(resume.Experiences),
+					ResumeProjects:      nonNil(resume.Projects),
+				}, compose.WithCallbacks(handler))
+				if err != nil {
+					errs = append(errs, fmt.Sprintf("职责匹配失败: %v", err))
+				}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			applyLLMResult(c.item, skillMatch, responsibilityMatch)
+			if len(errs) > 0 && c.item.LLMScore == nil {
+				failed++
+				c.item.LLMError = strings.Join(errs, "；")
+				s.logger.Warn("岗位精排失败，按粗排得分排序",
+					slog.String("job_id", c.item.JobPosition.ID),
+					slog.String("resume_id", resume.ID),
+					slog.String("err", c.item.LLMError))
+			}
+		}(c)
+	}
+	wg.Wait()
+
+	if failed > 0 && failed == len(candidates) {
+		return "", nil, fmt.Errorf("全部 %d 个岗位精排均失败", failed)
+	}
+
+	return version, map[string]int64{
+		"input_tokens":  inputTokens,
+		"output_tokens": outputTokens,
+		"total_tokens":  inputTokens + outputTokens,
+	}, nil
+}
+
+// applyLLMResult 合并 Agent 结果，仅一个 Agent 成功时以其得分作为模型得分
+func applyLLMResult(item *domain.JobRecommendation, skillMatch *domain.SkillMatchDetail, responsibilityMatch *domain.ResponsibilityMatchDetail) {
+	var total, weight float64
+	if skillMatch != nil {
+		score := roundScore(skillMatch.Score)
+		item.SkillScore = &score
+		total += skillMatch.Score * domain.DefaultDimensionWeights.Skill
+		weight += domain.DefaultDimensionWeights.Skill
+		if analysis := skillMatch.LLMAnalysis; analysis != nil {
+			if len(analysis.StrengthAreas) > 0 {
+				item.Reasons = append(item.Reasons, "技能优势："+strings.Join(analysis.StrengthAreas, "、"))
+			}
+			if len(analysis.GapAreas) > 0 {
+				item.Reasons = append(item.Reasons, "技能缺口："+strings.Join(analysis.GapAreas, "、"))
+			}
+		}
+	}
+	if responsibilityMatch != nil {
+		score := roundScore(responsibilityMatch.Score)
+		item.ResponsibilityScore = &score
+		total += responsibilityMatch.Score * domain.DefaultDimensionWeights.Responsibility
+		weight += domain.DefaultDimensionWeights.Responsibility
+		matched := len(responsibilityMatch.MatchedResponsibilities)
+		if all := matched + len(responsibilityMatch.UnmatchedResponsibilities); all > 0 {
+			item.Reasons = append(item.Reasons, fmt.Sprintf("经历覆盖 %d/%d 项岗位职责", matched, all))
+		}
+	}
+	if weight == 0 {
+		return
+	}
+
+	llmScore := roundScore(total / weight)
+	item.LLMScore = &llmScore
+	item.Score = roundScore((1-llmScoreWeight)*item.PrefilterScore + llmScoreWeight*llmScore)
+}
+
+// sortRefined 精排岗位按最终得分重新排序，精排失败的岗位以粗排得分参与排序
+func sortRefined(candidates []*jobCandidate) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].item.Score > candidates[j].item.Score
+	})
+}
+
+// prefilterJobs 按技能、职责关键词、工作年限、学历、城市为岗位打分，降序排列，同分时保持岗位原有顺序
+func prefilterJobs(resume *domain.ResumeDetail, jobs []*domain.JobProfileDetail) []*jobCandidate {
+	profile := newResumeProfile(resume)
+	candidates := make([]*jobCandidate, 0, len(jobs))
+	for _, job := range jobs {
+		if job == nil || job.JobProfile == nil {
+			continue
+		}
+		candidates = append(candidates, &jobCandidate{job: job, item: profile.score(job)})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].item.PrefilterScore > candidates[j].item.PrefilterScore
+	})
+	return candidates
+}
+
+// resumeProfile 粗排使用的简历特征，对全部岗位只计算一次
+type resumeProfile struct {
+	skills     []string
+	terms      map[string]struct{}
+	years      float64
+	degreeRank int
+	cities     []string
+}
+
+func newResumeProfile(resume *domain.ResumeDetail) *resumeProfile {
+	p := &resumeProfile{
+		years:      resume.YearsExperience,
+		degreeRank: resumeDegreeRank(resume),
+		cities:     []string{normalizeCity(resume.CurrentCity), normalizeCity(resume.ExpectedCity)},
+	}
+
+	seen := make(map[string]struct{})
+	addSkill := func(name string) {
+		name = normalizeSkill(name)
+		if _, ok := seen[name]; ok || name == "" {
+			return
+		}
+		seen[name] = struct{}{}
+		p.skills = append(p.skills, name)
+	}
+	texts := []string{resume.PersonalSummary}
+	for _, s := range resume.Skills {
+		if s != nil {
+			addSkill(s.SkillName)
+		}
+	}
+	for _, project := range resume.Projects {
+		if project == nil {
+			continue
+		}
+		for _, tech := range strings.FieldsFunc(project.Technologies, isSkillSeparator) {
+			addSkill(tech)
+		}
+		texts = append(texts, project.Name, project.Role, project.Description, project.Responsibilities)
+	}
+	for _, exp := range resume.Experiences {
+		if exp != nil {
+			texts = append(texts, exp.Position, exp.Title, exp.Description)
+		}
+	}
+	p.terms = termSet(strings.Join(texts, "\n"))
+	return p
+}
+
+// score 计算单个岗位的粗排得分及推荐理由
+func (p *resumeProfile) score(job *domain.JobProfileDetail) *domain.JobRecommendation {
+	item := &domain.JobRecommendation{
+		JobPosition:   job.JobProfile,
+		Prefilter:     &domain.JobPrefilterBreakdown{},
+		MatchedSkills: []string{},
+		MissingSkills: []string{},
+		Reasons:       []string{},
+	}
+
+	var total, weight float64
+	add := func(dst **float64, score, w float64) {
+		score = roundScore(score)
+		*dst = &score
+		total += score * w
+		weight += w
+	}
+
+	if score, ok := p.skillScore(job, item); ok {
+		add(&item.Prefilter.Skill, score, prefilterSkillWeight)
+	}
+	if score, ok := p.responsibilityScore(job); ok {
+		add(&item.Prefilter.Responsibility, score, prefilterResponsibilityWeight)
+	}
+	if score, ok := p.experienceScore(job, item); ok {
+		add(&item.Prefilter.Experience, score, prefilterExperienceWeight)
+	}
+	if score, ok := p.educationScore(job, item); ok {
+		add(&item.Prefilter.Education, score, prefilterEducationWeight)
+	}
+	if score, ok := p.locationScore(job, item); ok {
+		add(&item.Prefilter.Location, score, prefilterLocationWeight)
+	}
+
+	if weight == 0 {
+		item.Reasons = append(item.Reasons, "岗位画像缺少可比较的要求")
+		return item
+	}
+	item.PrefilterScore = roundScore(total / weight)
+	item.Score = item.PrefilterScore
+	return item
+}
+
+// skillScore 按命中的岗位技能计算得分，加分技能权重减半
+func (p *resumeProfile) skillScore(job *domain.JobProfileDetail, item *domain.JobRecommendation) (float64, bool) {
+	var matched, total float64
+	for _, s := range job.Skills {
+		if s == nil || normalizeSkill(s.Skill) == "" {
+			continue
+		}
+		w := 1.0
+		if s.Type == string(consts.JobSkillTypeBonus) {
+			w = bonusSkillWeight
+		}
+		total += w
+		if p.hasSkill(s.Skill) {
+			matched += w
+			item.MatchedSkills = append(item.MatchedSkills, s.Skill)
+		} else if s.Type != string(consts.JobSkillTypeBonus) {
+			item.MissingSkills = append(item.MissingSkills, s.Skill)
+		}
+	}
+	if total == 0 {
+		return 0, false
+	}
+
+	if len(item.MatchedSkills) > 0 {
+		item.Reasons = append(item.Reasons, "命中技能："+strings.Join(item.MatchedSkills, "、"))
+	}
+	if len(item.MissingSkills) > 0 {
+		item.Reasons = append(item.Reasons, "缺少必需技能："+strings.Join(item.MissingSkills, "、"))
+	}
+	return matched / total * 100, true
+}
+
+// hasSkill 技能名相同即命中；三个字符以上的技能名互相包含也视为命中，避免 C 命中 C++ 之类的误判
+func (p *resumeProfile) hasSkill(name string) bool {
+	name = normalizeSkill(name)
+	for _, s := range p.skills {
+		if s == name {
+			return true
+		}
+		shorter, longer := s, name
+		if len([]rune(shorter)) > len([]rune(longer)) {
+			shorter, longer = longer, shorter
+		}
+		if len([]rune(shorter)) >= 3 && strings.Contains(longer, shorter) {
+			return true
+		}
+	}
+	return false
+}
+
+// responsibilityScore 按岗位名称与职责的关键词在简历经历、项目中的覆盖率计算得分
+func (p *resumeProfile) responsibilityScore(job *domain.JobProfileDetail) (float64, bool) {
+	texts := []string{job.Name}
+	for _, r := range job.Responsibilities {
+		if r != nil {
+			texts = append(texts, r.Responsibility)
+		}
+	}
+	jobTerms := termSet(strings.Join(texts, "\n"))
+	if len(jobTerms) == 0 || len(p.terms) == 0 {
+		return 0, false
+	}
+
+	var hit int
+	for term := range jobTerms {
+		if _, ok := p.terms[term]; ok {
+			hit++
+		}
+	}
+	coverage := float64(hit) / float64(len(jobTerms))
+	return min(coverage/responsibilityCoverageSaturation, 1) * 100, true
+}
+
+// experienceScore 达到最低年限得 80 分，达到理想年限得满分，不足最低年限按比例给分
+func (p *resumeProfile) experienceScore(job *domain.JobProfileDetail, item *domain.JobRecommendation) (float64, bool) {
+	var minYears, idealYears int
+	for _, r := range job.ExperienceRequirements {
+		if r != nil && r.MinYears > minYears {
+			minYears, idealYears = r.MinYears, r.IdealYears
+		}
+	}
+	// 工作年限为 0 时无法区分未解析与确无经验，与硬性条件一致按缺失处理
+	if minYears == 0 || p.years <= 0 {
+		return 0, false
+	}
+
+	if p.years < float64(minYears) {
+		item.Reasons = append(item.Reasons, fmt.Sprintf("工作年限 %.1f 年，低于 %d 年要求", p.years, minYears))
+		return 80 * p.years / float64(minYears), true
+	}
+	item.Reasons = append(item.Reasons, fmt.Sprintf("工作年限 %.1f 年，满足 %d 年要求", p.years, minYears))
+	if idealYears <= minYears || p.years >= float64(idealYears) {
+		return 100, true
+	}
+	return 80 + 20*(p.years-float64(minYears))/float64(idealYears-minYears), true
+}
+
+// educationScore 满足学历要求得满分，每低一个层级扣 40 分
+func (p *resumeProfile) educationScore(job *domain.JobProfileDetail, item *domain.JobRecommendation) (float64, bool) {
+	var required consts.JobEducationType
+	for _, r := range job.EducationRequirements {
+		if r == nil {
+			continue
+		}
+		t := consts.JobEducationType(r.EducationType)
+		if degreeRanks[t] > degreeRanks[required] {
+			required = t
+		}
+	}
+	if degreeRanks[required] == 0 || p.degreeRank == 0 {
+		return 0, false
+	}
+
+	gap := degreeRanks[required] - p.degreeRank
+	if gap <= 0 {
+		item.Reasons = append(item.Reasons, fmt.Sprintf("学历满足%s要求", degreeLabels[required]))
+		return 100, true
+	}
+	item.Reasons = append(item.Reasons, fmt.Sprintf("学历低于%s要求", degreeLabels[required]))
+	return max(0, 100-40*float64(gap)), true
+}
+
+// locationScore 所在或期望城市与岗位地点一致得满分，否则为 0
+func (p *resumeProfile) locationScore(job *domain.JobProfileDetail, item *domain.JobRecommendation) (float64, bool) {
+	if job.Location == nil || (p.cities[0] == "" && p.cities[1] == "") {
+		return 0, false
+	}
+	locations := strings.FieldsFunc(*job.Location, isSkillSeparator)
+	if len(locations) == 0 {
+		return 0, false
+	}
+
+	if matchAnyCity(p.cities, locations) {
+		item.Reasons = append(item.Reasons, fmt.Sprintf("所在或期望城市与岗位地点 %s 一致", *job.Location))
+		return 100, true
+	}
+	item.Reasons = append(item.Reasons, fmt.Sprintf("所在及期望城市不在岗位地点 %s", *job.Location))
+	return 0, true
+}
+
+func normalizeSkill(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), ""))
+}
+
+func isSkillSeparator(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune(",，、/;；|", r)
+}
+
+// termSet 提取文本关键词：英文与数字按连续串取词，中文按相邻两字切分
+func termSet(text string) map[string]struct{} {
+	terms := make(map[string]struct{})
+	var word []rune
+	var prevHan rune
+	flush := func() {
+		if len(word) >= 2 {
+			terms[strings.ToLower(string(word))] = struct{}{}
+		}
+		word = word[:0]
+	}
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flush()
+			if prevHan != 0 {
+				terms[string([]rune{prevHan, r})] = struct{}{}
+			}
+			prevHan = r
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("+#.", r):
+			word = append(word, r)
+		default:
+			flush()
+		}
+		prevHan = 0
+	}
+	flush()
+	return terms
+}
+
+func nonNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+	return items
+}
+
+// ensureCompiled 确保技能、职责匹配链已编译并可复用，同时返回编译时的Agent版本
+func (s *jobRecommendService) ensureCompiled(ctx context.Context, modelType models.ModelType, modelName string) (
+	compose.Runnable[*domain.SkillData, *domain.SkillMatchDetail],
+	compose.Runnable[*domain.ResponsibilityData, *domain.ResponsibilityMatchDetail],
+	string,
+	error,
+) {
+	s.compileMutex.Lock()
+	defer s.compileMutex.Unlock()
+
+	if s.skillRunnable != nil && s.currentModelType == modelType && s.currentModelName == modelName {
+		return s.skillRunnable, s.responsibilityRunnable, s.version, nil
+	}
+
+	chatModel, err := s.factory.GetModel(ctx, modelType, modelName)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("获取对话模型失败: %w", err)
+	}
+
+	skillAgent, err := skill.NewSkillAgent(ctx, chatModel)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("创建技能匹配Agent失败: %w", err)
+	}
+	skillRunnable, err := skillAgent.Compile(ctx)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("编译技能匹配链失败: %w", err)
+	}
+
+	responsibilityAgent, err := responsibility.NewResponsibilityAgent(ctx, chatModel)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("创建职责匹配Agent失败: %w", err)
+	}
+	responsibilityRunnable, err := responsibilityAgent.Compile(ctx)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("编译职责匹配链失败: %w", err)
+	}
+
+	s.skillRunnable = skillRunnable
+	s.responsibilityRunnable = responsibilityRunnable
+	s.currentModelType = modelType
+	s.currentModelName = modelName
+	s.version = fmt.Sprintf("skill:%s,responsibility:%s", skillAgent.GetVersion(), responsibilityAgent.GetVersion())
+
+	return skillRunnable, responsibilityRunnable, s.version, nil
+}
+
+// setupModel 根据LLM配置设置模型
+func (s *jobRecommendService) setupModel(llmConfig map[string]any) (models.ModelType, string, error) {
+	// 如果没有提供LLM配置，使用系统默认配置
+	if len(llmConfig) == 0 {
+		modelName := s.cfg.GeneralAgent.LLM.ModelName
+		if modelName == "" {
+			modelName = "gpt-4o-mini"
+		}
+
+		s.factory.Register(models.ModelTypeOpenAI, modelName, models.NewOpenAIModelManager(&models.OpenAIConfig{
+			APIKey:         s.cfg.GeneralAgent.LLM.APIKey,
+			BaseURL:        s.cfg.GeneralAgent.LLM.BaseURL,
+			Model:          modelName,
+			ResponseFormat: "json_object",
+		}))
+		return models.ModelTypeOpenAI, modelName, nil
+	}
+
+	modelTypeStr, _ := llmConfig["type"].(string)
+	if modelTypeStr != "openai" {
+		return "", "", fmt.Errorf("不支持的模型类型: %s", modelTypeStr)
+	}
+	modelName, ok := llmConfig["model"].(string)
+	if !ok || modelName == "" {
+		return "", "", fmt.Errorf("模型名称不能为空")
+	}
+	apiKey, ok := llmConfig["api_key"].(string)
+	if !ok {
+		return "", "", fmt.Errorf("OpenAI模型需要api_key")
+	}
+	baseURL, _ := llmConfig["base_url"].(string)
+	if baseURL == "" {
+		baseURL = "https://api.openai.com/v1"
+	}
+
+	s.factory.Register(models.ModelTypeOpenAI, modelName, models.NewOpenAIModelManager(&models.OpenAIConfig{
+		APIKey:         apiKey,
+		BaseURL:        baseURL,
+		Model:          modelName,
+		ResponseFormat: "json_object",
+	}))
+	return models.ModelTypeOpenAI, modelName, nil
+}
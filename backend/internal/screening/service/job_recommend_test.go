@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+func recommendTestResume() *domain.ResumeDetail {
+	return &domain.ResumeDetail{
+		Resume: &domain.Resume{
+			ID:               "resume-1",
+			HighestEducation: "本科",
+			YearsExperience:  4,
+			CurrentCity:      "北京市",
+		},
+		Skills: []*domain.ResumeSkill{{SkillName: "Golang"}, {SkillName: "MySQL"}},
+		Experiences: []*domain.ResumeExperience{
+			{Position: "后端工程师", Description: "负责支付系统的设计与开发，维护高并发交易链路"},
+		},
+		Projects: []*domain.ResumeProject{
+			{Name: "清结算平台", Technologies: "Kafka、Redis"},
+		},
+	}
+}
+
+func recommendTestJob(id, name, location string, skills map[string]consts.JobSkillType, responsibilities ...string) *domain.JobProfileDetail {
+	job := &domain.JobProfileDetail{JobProfile: &domain.JobProfile{ID: id, Name: name, Location: &location}}
+	for skill, typ := range skills {
+		job.Skills = append(job.Skills, &domain.JobSkill{Skill: skill, Type: string(typ)})
+	}
+	for _, r := range responsibilities {
+		job.Responsibilities = append(job.Responsibilities, &domain.JobResponsibility{Responsibility: r})
+	}
+	return job
+}
+
+func TestTermSet(t *testing.T) {
+	terms := termSet("负责Go支付系统, C++ 开发")
+	for _, term := range []string{"负责", "go", "支付", "付系", "系统", "c++", "开发"} {
+		assert.Contains(t, terms, term)
+	}
+	// 英文单词会截断中文的相邻切分
+	assert.NotContains(t, terms, "责支")
+	assert.Len(t, terms, 7)
+}
+
+func TestResumeProfileHasSkill(t *testing.T) {
+	p := newResumeProfile(&domain.ResumeDetail{
+		Resume:   &domain.Resume{},
+		Skills:   []*domain.ResumeSkill{{SkillName: "Golang"}, {SkillName: "C++"}, {SkillName: "Spring Boot"}},
+		Projects: []*domain.ResumeProject{{Technologies: "Kafka, Redis / k8s"}},
+	})
+
+	assert.True(t, p.hasSkill("golang"))
+	assert.True(t, p.hasSkill("springboot"))
+	assert.True(t, p.hasSkill("Kafka"))
+	assert.True(t, p.hasSkill("K8S"))
+	assert.False(t, p.hasSkill("C"))
+	assert.False(t, p.hasSkill("Java"))
+}
+
+func TestPrefilterJobs(t *testing.T) {
+	backend := recommendTestJob("backend", "支付后端工程师", "北京",
+		map[string]consts.JobSkillType{"Golang": consts.JobSkillTypeRequired, "Kafka": consts.JobSkillTypeBonus},
+		"负责支付系统的设计与开发")
+	backend.ExperienceRequirements = []*domain.JobExperienceRequirement{{MinYears: 3, IdealYears: 5}}
+	backend.EducationRequirements = []*domain.JobEducationRequirement{{EducationType: string(consts.JobEducationTypeBachelor)}}
+
+	frontend := recommendTestJob("frontend", "前端工程师", "上海",
+		map[string]consts.JobSkillType{"React": consts.JobSkillTypeRequired},
+		"负责管理后台页面开发")
+	frontend.EducationRequirements = []*domain.JobEducationRequirement{{EducationType: string(consts.JobEducationTypeMaster)}}
+
+	empty := &domain.JobProfileDetail{JobProfile: &domain.JobProfile{ID: "empty"}}
+
+	candidates := prefilterJobs(recommendTestResume(), []*domain.JobProfileDetail{empty, frontend, nil, backend})
+	require.Len(t, candidates, 3)
+	assert.Equal(t, "backend", candidates[0].item.JobPosition.ID)
+	assert.Equal(t, "frontend", candidates[1].item.JobPosition.ID)
+	assert.Equal(t, "empty", candidates[2].item.JobPosition.ID)
+
+	top := candidates[0].item
+	require.NotNil(t, top.Prefilter.Skill)
+	assert.Equal(t, 100.0, *top.Prefilter.Skill)
+	assert.ElementsMatch(t, []string{"Golang", "Kafka"}, top.MatchedSkills)
+	assert.Empty(t, top.MissingSkills)
+	// 达到最低 3 年、未达理想 5 年：80 + 20 * (4-3)/(5-3)
+	assert.Equal(t, 90.0, *top.Prefilter.Experience)
+	assert.Equal(t, 100.0, *top.Prefilter.Education)
+	assert.Equal(t, 100.0, *top.Prefilter.Location)
+	assert.Equal(t, top.PrefilterScore, top.Score)
+	assert.Contains(t, top.Reasons, "工作年限 4.0 年，满足 3 年要求")
+
+	second := candidates[1].item
+	assert.Equal(t, 0.0, *second.Prefilter.Skill)
+	assert.Equal(t, []string{"React"}, second.MissingSkills)
+	assert.Equal(t, 60.0, *second.Prefilter.Education)
+	assert.Equal(t, 0.0, *second.Prefilter.Location)
+	// 岗位未设置经验要求时该维度不参与加权
+	assert.Nil(t, second.Prefilter.Experience)
+	assert.Contains(t, second.Reasons, "学历低于硕士要求")
+
+	last := candidates[2].item
+	assert.Equal(t, 0.0, last.PrefilterScore)
+	assert.Equal(t, []string{"岗位画像缺少可比较的要求"}, last.Reasons)
+}
+
+func TestExperienceScoreBelowMinimum(t *testing.T) {
+	resume := recommendTestResume()
+	resume.YearsExperience = 2
+	job := &domain.JobProfileDetail{
+		JobProfile:             &domain.JobProfile{ID: "senior"},
+		ExperienceRequirements: []*domain.JobExperienceRequirement{{MinYears: 5}},
+	}
+
+	item := prefilterJobs(resume, []*domain.JobProfileDetail{job})[0].item
+	assert.Equal(t, 32.0, *item.Prefilter.Experience)
+	assert.Equal(t, []string{"工作年限 2.0 年，低于 5 年要求"}, item.Reasons)
+
+	// 工作年限未解析时不参与加权
+	resume.YearsExperience = 0
+	item = prefilterJobs(resume, []*domain.JobProfileDetail{job})[0].item
+	assert.Nil(t, item.Prefilter.Experience)
+}
+
+func TestApplyLLMResultAndSortRefined(t *testing.T) {
+	a := &jobCandidate{item: &domain.JobRecommendation{PrefilterScore: 80, Score: 80}}
+	b := &jobCandidate{item: &domain.JobRecommendation{PrefilterScore: 60, Score: 60}}
+	failed := &jobCandidate{item: &domain.JobRecommendation{PrefilterScore: 70, Score: 70}}
+
+	applyLLMResult(a.item, &domain.SkillMatchDetail{Score: 40}, &domain.ResponsibilityMatchDetail{
+		Score:                     40,
+		MatchedResponsibilities:   []*domain.MatchedResponsibility{{}},
+		UnmatchedResponsibilities: []*domain.JobResponsibility{{}, {}},
+	})
+	require.NotNil(t, a.item.LLMScore)
+	assert.Equal(t, 40.0, *a.item.LLMScore)
+	// 0.3 * 80 + 0.7 * 40
+	assert.Equal(t, 52.0, a.item.Score)
+	assert.Equal(t, []string{"经历覆盖 1/3 项岗位职责"}, a.item.Reasons)
+
+	applyLLMResult(b.item, &domain.SkillMatchDetail{
+		Score:       90,
+		LLMAnalysis: &domain.SkillLLMAnalysis{StrengthAreas: []string{"后端开发"}},
+	}, nil)
+	assert.Equal(t, 90.0, *b.item.LLMScore)
+	assert.Equal(t, 81.0, b.item.Score)
+	assert.Nil(t, b.item.ResponsibilityScore)
+	assert.Equal(t, []string{"技能优势：后端开发"}, b.item.Reasons)
+
+	applyLLMResult(failed.item, nil, nil)
+	assert.Nil(t, failed.item.LLMScore)
+	assert.Equal(t, 70.0, failed.item.Score)
+
+	candidates := []*jobCandidate{a, failed, b}
+	sortRefined(candidates)
+	assert.Equal(t, []*jobCandidate{b, failed, a}, candidates)
+}
+
+func TestRecommendWithoutLLM(t *testing.T) {
+	svc := &jobRecommendService{}
+	jobs := []*domain.JobProfileDetail{
+		recommendTestJob("a", "前端工程师", "上海", map[string]consts.JobSkillType{"React": consts.JobSkillTypeRequired}),
+		recommendTestJob("b", "后端工程师", "北京", map[string]consts.JobSkillType{"Golang": consts.JobSkillTypeRequired}),
+		recommendTestJob("c", "数据工程师", "北京", map[string]consts.JobSkillType{"Kafka": consts.JobSkillTypeRequired, "Spark": consts.JobSkillTypeRequired}),
+	}
+
+	resp, err := svc.Recommend(context.Background(), recommendTestResume(), jobs, &domain.RecommendJobPositionsReq{TopK: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.TotalPositions)
+	require.Len(t, resp.Items, 2)
+	assert.Equal(t, "b", resp.Items[0].JobPosition.ID)
+	assert.Equal(t, 1, resp.Items[0].Rank)
+	assert.Equal(t, "c", resp.Items[1].JobPosition.ID)
+	assert.Equal(t, 2, resp.Items[1].Rank)
+	assert.Empty(t, resp.AgentVersion)
+	assert.Nil(t, resp.TokenUsage)
+}
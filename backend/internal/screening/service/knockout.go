@@ -0,0 +1,162 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	screening "github.com/chaitin/WhaleHire/backend/pkg/eino/graphs/screening"
+)
+
+// degreeRanks 学历层级，数值越大学历越高
+var degreeRanks = map[consts.JobEducationType]int{
+	consts.JobEducationTypeJunior:   1,
+	consts.JobEducationTypeBachelor: 2,
+	consts.JobEducationTypeMaster:   3,
+	consts.JobEducationTypeDoctor:   4,
+}
+
+var degreeLabels = map[consts.JobEducationType]string{
+	consts.JobEducationTypeJunior:   "大专",
+	consts.JobEducationTypeBachelor: "本科",
+	consts.JobEducationTypeMaster:   "硕士",
+	consts.JobEducationTypeDoctor:   "博士",
+}
+
+// evaluateKnockout 校验简历是否满足硬性淘汰条件，返回未通过的原因，全部通过时返回空
+func evaluateKnockout(rules *domain.ScreeningKnockoutRules, resume *domain.ResumeDetail) []string {
+	if rules.IsEmpty() || resume == nil || resume.Resume == nil {
+		return nil
+	}
+
+	var reasons []string
+
+	if required, ok := degreeRanks[rules.MinDegree]; ok {
+		if actual := resumeDegreeRank(resume); actual > 0 && actual < required {
+			reasons = append(reasons, fmt.Sprintf("学历低于%s要求", degreeLabels[rules.MinDegree]))
+		}
+	}
+
+	// 工作年限为 0 时无法区分未解析与确无经验，与学历、城市一致按缺失处理
+	if rules.MinYearsExperience != nil && resume.YearsExperience > 0 && resume.YearsExperience < *rules.MinYearsExperience {
+		reasons = append(reasons, fmt.Sprintf("工作年限 %.1f 年，低于 %.1f 年要求", resume.YearsExperience, *rules.MinYearsExperience))
+	}
+
+	if len(rules.Locations) > 0 {
+		cities := []string{normalizeCity(resume.CurrentCity), normalizeCity(resume.ExpectedCity)}
+		if cities[0] != "" || cities[1] != "" {
+			if !matchAnyCity(cities, rules.Locations) {
+				reasons = append(reasons, fmt.Sprintf("所在及期望城市不在 %s 范围内", strings.Join(rules.Locations, "/")))
+			}
+		}
+	}
+
+	if len(rules.RequiredCertificates) > 0 {
+		text := strings.ToLower(certificateText(resume))
+		var missing []string
+		for _, cert := range rules.RequiredCertificates {
+			cert = strings.TrimSpace(cert)
+			if cert != "" && !strings.Contains(text, strings.ToLower(cert)) {
+				missing = append(missing, cert)
+			}
+		}
+		if len(missing) > 0 {
+			reasons = append(reasons, fmt.Sprintf("缺少必需证书：%s", strings.Join(missing, "、")))
+		}
+	}
+
+	return reasons
+}
+
+// knockoutResult 构建未通过硬性条件的匹配结果，不调用模型
+func (s *matchingService) knockoutResult(req *MatchRequest, weights *domain.DimensionWeights, reasons []string) *MatchResult {
+	recommendations := make([]string, 0, len(reasons))
+	for _, reason := range reasons {
+		recommendations = append(recommendations, "未通过硬性条件："+reason)
+	}
+
+	return &MatchResult{
+		Match: &domain.JobResumeMatch{
+			TaskMetaData: &domain.TaskMetaData{
+				JobID:            req.JobProfile.ID,
+				ResumeID:         req.ResumeID.String(),
+				MatchTaskID:      fmt.Sprintf("%s:%s", req.TaskID.String(), req.ResumeID.String()),
+				DimensionWeights: weights,
+			},
+			OverallScore:    0,
+			MatchedAt:       time.Now(),
+			Recommendations: recommendations,
+		},
+		Version:         s.version,
+		SubAgentVersion: s.sub_agent_version,
+		DimensionMap:    weightsToMap(weights),
+		Collector:       screening.NewAgentCallbackCollector(),
+		KnockoutReasons: reasons,
+	}
+}
+
+// resumeDegreeRank 取简历中的最高学历层级，无法识别时返回 0
+func resumeDegreeRank(resume *domain.ResumeDetail) int {
+	rank := degreeRankOf(resume.HighestEducation)
+	for _, edu := range resume.Educations {
+		if edu == nil {
+			continue
+		}
+		if r := degreeRankOf(edu.Degree); r > rank {
+			rank = r
+		}
+	}
+	return rank
+}
+
+func degreeRankOf(degree string) int {
+	d := strings.ToLower(degree)
+	switch {
+	case d == "":
+		return 0
+	case strings.Contains(d, "博士") || strings.Contains(d, "doctor") || strings.Contains(d, "phd"):
+		return degreeRanks[consts.JobEducationTypeDoctor]
+	case strings.Contains(d, "硕士") || strings.Contains(d, "研究生") || strings.Contains(d, "master") || strings.Contains(d, "mba"):
+		return degreeRanks[consts.JobEducationTypeMaster]
+	case strings.Contains(d, "本科") || strings.Contains(d, "学士") || strings.Contains(d, "bachelor"):
+		return degreeRanks[consts.JobEducationTypeBachelor]
+	case strings.Contains(d, "专科") || strings.Contains(d, "大专") || strings.Contains(d, "associate") || strings.Contains(d, "junior"):
+		return degreeRanks[consts.JobEducationTypeJunior]
+	default:
+		return 0
+	}
+}
+
+func normalizeCity(city string) string {
+	city = strings.TrimSpace(city)
+	city = strings.TrimSuffix(city, "市")
+	return strings.ToLower(city)
+}
+
+func matchAnyCity(cities []string, locations []string) bool {
+	for _, loc := range locations {
+		loc = normalizeCity(loc)
+		if loc == "" {
+			continue
+		}
+		for _, city := range cities {
+			if city != "" && (strings.Contains(city, loc) || strings.Contains(loc, city)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// certificateText 汇总简历中可能出现证书信息的文本
+func certificateText(resume *domain.ResumeDetail) string {
+	parts := []string{resume.HonorsCertificates, resume.OtherInfo, resume.PersonalSummary}
+	for _, skill := range resume.Skills {
+		if skill != nil {
+			parts = append(parts, skill.SkillName, skill.Description)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
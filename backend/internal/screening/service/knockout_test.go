@@ -0,0 +1,58 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+func TestEvaluateKnockout(t *testing.T) {
+	years := 3.0
+	rules := &domain.ScreeningKnockoutRules{
+		MinDegree:            consts.JobEducationTypeBachelor,
+		MinYearsExperience:   &years,
+		Locations:            []string{"北京", "上海"},
+		RequiredCertificates: []string{"PMP"},
+	}
+
+	passed := &domain.ResumeDetail{
+		Resume: &domain.Resume{
+			HighestEducation:   "硕士",
+			YearsExperience:    5,
+			CurrentCity:        "北京市",
+			HonorsCertificates: "PMP, CET-6",
+		},
+	}
+	assert.Empty(t, evaluateKnockout(rules, passed))
+
+	failed := &domain.ResumeDetail{
+		Resume: &domain.Resume{
+			HighestEducation: "大专",
+			YearsExperience:  1,
+			CurrentCity:      "成都",
+			ExpectedCity:     "杭州",
+		},
+	}
+	reasons := evaluateKnockout(rules, failed)
+	require.Len(t, reasons, 4)
+	assert.Contains(t, reasons[0], "本科")
+	assert.Contains(t, reasons[3], "PMP")
+
+	// 缺少学历、城市信息时不淘汰，交由模型判断
+	unknown := &domain.ResumeDetail{
+		Resume: &domain.Resume{YearsExperience: 4, OtherInfo: "持有 pmp 证书"},
+	}
+	assert.Empty(t, evaluateKnockout(rules, unknown))
+
+	// 工作年限未解析（为 0）时同样不淘汰
+	unparsedYears := &domain.ResumeDetail{
+		Resume: &domain.Resume{HighestEducation: "本科", OtherInfo: "持有 pmp 证书"},
+	}
+	assert.Empty(t, evaluateKnockout(rules, unparsedYears))
+
+	assert.Empty(t, evaluateKnockout(nil, failed))
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	screening "github.com/chaitin/WhaleHire/backend/pkg/eino/graphs/screening"
+	"github.com/chaitin/WhaleHire/backend/pkg/eino/graphs/screening/matching/aggregator"
+	"github.com/chaitin/WhaleHire/backend/pkg/eino/graphs/screening/matching/dispatcher"
+	"github.com/chaitin/WhaleHire/backend/pkg/eino/models"
+)
+
+// volatileHashFields 计算内容哈希时忽略的字段：时间戳、状态、日志等不影响匹配结果的信息
+var volatileHashFields = map[string]struct{}{
+	"created_at":      {},
+	"updated_at":      {},
+	"parsed_at":       {},
+	"status":          {},
+	"error_message":   {},
+	"logs":            {},
+	"job_positions":   {},
+	"uploader_id":     {},
+	"uploader_name":   {},
+	"resume_file_url": {},
+	"source":          {},
+	"created_by":      {},
+	"creator_name":    {},
+}
+
+// MatchFingerprint 匹配输入指纹，岗位画像、简历内容、Agent版本与维度权重均一致时可整体复用历史结果
+type MatchFingerprint struct {
+	JobHash    string
+	ResumeHash string
+	AgentHash  string
+}
+
+// buildMatchFingerprint 计算本次匹配的输入指纹；聚合节点版本与维度权重决定综合分和建议，一并计入 AgentHash
+func buildMatchFingerprint(job *domain.JobProfileDetail, resume *domain.ResumeDetail, subAgentVersions map[string]string, modelKey models.ModelKey, weights *domain.DimensionWeights) (*MatchFingerprint, error) {
+	jobHash, err := contentHash(job)
+	if err != nil {
+		return nil, fmt.Errorf("hash job profile failed: %w", err)
+	}
+	resumeHash, err := contentHash(resume)
+	if err != nil {
+		return nil, fmt.Errorf("hash resume failed: %w", err)
+	}
+	weightsHash, err := contentHash(weights)
+	if err != nil {
+		return nil, fmt.Errorf("hash dimension weights failed: %w", err)
+	}
+
+	names := make([]string, 0, len(subAgentVersions))
+	for name := range subAgentVersions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	agent := sha256.New()
+	fmt.Fprintf(agent, "%s/%s", modelKey.Type, modelKey.Name)
+	for _, name := range names {
+		fmt.Fprintf(agent, ";%s=%s", name, subAgentVersions[name])
+	}
+	fmt.Fprintf(agent, ";weights=%s", weightsHash)
+
+	return &MatchFingerprint{
+		JobHash:    jobHash,
+		ResumeHash: resumeHash,
+		AgentHash:  hex.EncodeToString(agent.Sum(nil)),
+	}, nil
+}
+
+// buildNodeCacheKeys 为每个维度节点计算复用键：节点实际输入、节点版本与模型均一致时该维度结果可复用
+// input 不应包含 ReusedResults，否则已复用的维度不会出现在分发数据中
+func buildNodeCacheKeys(input *domain.MatchInput, subAgentVersions map[string]string, modelKey models.ModelKey) (map[string]string, error) {
+	data := dispatcher.NewDispatchData(input)
+	keys := make(map[string]string, len(data))
+	for nodeKey, nodeInput := range data {
+		if nodeKey == domain.TaskMetaDataNode || nodeKey == domain.ReusedResultsNode {
+			continue
+		}
+		inputHash, err := contentHash(nodeInput)
+		if err != nil {
+			return nil, fmt.Errorf("hash %s input failed: %w", nodeKey, err)
+		}
+		sum := sha256.Sum256([]byte(fmt.Sprintf("%s;%s;%s/%s;%s", nodeKey, subAgentVersions[nodeKey], modelKey.Type, modelKey.Name, inputHash)))
+		keys[nodeKey] = hex.EncodeToString(sum[:])
+	}
+	return keys, nil
+}
+
+// contentHash 对结构体的 JSON 表示去除易变字段后计算 SHA-256，map 序列化时按键排序，结果稳定
+func contentHash(v any) (string, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	var generic any
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return "", err
+	}
+	normalized, err := json.Marshal(stripVolatileFields(generic))
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(normalized)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func stripVolatileFields(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for key, item := range val {
+			if _, ok := volatileHashFields[key]; ok {
+				delete(val, key)
+				continue
+			}
+			val[key] = stripVolatileFields(item)
+		}
+		return val
+	case []any:
+		for i, item := range val {
+			val[i] = stripVolatileFields(item)
+		}
+		return val
+	default:
+		return v
+	}
+}
+
+// matchFromCache 查找指纹一致的历史筛选结果，指纹包含权重与聚合节点版本，命中时综合分与建议均可直接复用
+func (s *matchingService) matchFromCache(ctx context.Context, req *MatchRequest, compiled *compiledScreeningGraph, fingerprint *MatchFingerprint, weights *domain.DimensionWeights) (*MatchResult, bool) {
+	cached, err := s.screeningRepo.GetLatestScreeningResultByFingerprint(ctx, fingerprint.JobHash, fingerprint.ResumeHash, fingerprint.AgentHash)
+	if err != nil {
+		if !db.IsNotFound(err) {
+			s.logger.Warn("查询筛选结果缓存失败", slog.Any("task_id", req.TaskID), slog.Any("resume_id", req.ResumeID), slog.Any("err", err))
+		}
+		return nil, false
+	}
+
+	detail := (&domain.ScreeningResult{}).From(cached)
+	match := &domain.JobResumeMatch{
+		TaskMetaData: &domain.TaskMetaData{
+			JobID:            req.JobProfile.ID,
+			ResumeID:         req.ResumeID.String(),
+			MatchTaskID:      fmt.Sprintf("%s:%s", req.TaskID.String(), req.ResumeID.String()),
+			DimensionWeights: weights,
+		},
+		SkillMatch:          detail.SkillDetail,
+		ResponsibilityMatch: detail.Responsibility,
+		ExperienceMatch:     detail.ExperienceDetail,
+		EducationMatch:      detail.EducationDetail,
+		IndustryMatch:       detail.IndustryDetail,
+		BasicMatch:          detail.BasicDetail,
+		CustomMatches:       detail.CustomDetails,
+		MatchedAt:           time.Now(),
+		Recommendations:     detail.Recommendations,
+	}
+	match.OverallScore = aggregator.RecalculateOverallScore(match, weights)
+
+	// 历史结果本身也可能来自缓存，始终指向最初调用模型生成的结果
+	sourceID := cached.ID
+	if cached.CachedFromID != nil {
+		sourceID = *cached.CachedFromID
+	}
+
+	s.logger.Info("复用历史筛选结果",
+		slog.Any("task_id", req.TaskID),
+		slog.Any("resume_id", req.ResumeID),
+		slog.Any("cached_from", sourceID),
+	)
+
+	return &MatchResult{
+		Match:           match,
+		Version:         compiled.version,
+		SubAgentVersion: compiled.subAgentVersions,
+		DimensionMap:    weightsToMap(weights),
+		Collector:       screening.NewAgentCallbackCollector(),
+		Fingerprint:     fingerprint,
+		CachedFromID:    &sourceID,
+	}, true
+}
+
+// reusableNodeResults 按复用键查找各维度最近一次成功的节点运行，返回解码后的维度结果及其来源记录
+func (s *matchingService) reusableNodeResults(ctx context.Context, req *MatchRequest, cacheKeys map[string]string) (map[string]any, map[string]*db.ScreeningNodeRun) {
+	outputs := make(map[string]any)
+	sources := make(map[string]*db.ScreeningNodeRun)
+	for nodeKey, cacheKey := range cacheKeys {
+		run, err := s.nodeRunRepo.GetLatestCompletedByCacheKey(ctx, cacheKey)
+		if err != nil {
+			if !db.IsNotFound(err) {
+				s.logger.Warn("查询维度结果缓存失败", slog.Any("task_id", req.TaskID), slog.String("node_key", nodeKey), slog.Any("err", err))
+			}
+			continue
+		}
+		output, err := decodeNodeOutput(nodeKey, run.OutputPayload)
+		if err != nil {
+			s.logger.Warn("解析历史维度结果失败", slog.Any("node_run_id", run.ID), slog.String("node_key", nodeKey), slog.Any("err", err))
+			continue
+		}
+		outputs[nodeKey] = output
+		sources[nodeKey] = run
+	}
+	return outputs, sources
+}
+
+// decodeNodeOutput 将节点运行记录中的输出快照还原为对应维度的结果类型
+func decodeNodeOutput(nodeKey string, payload map[string]any) (any, error) {
+	var output any
+	switch nodeKey {
+	case domain.BasicInfoAgent:
+		output = &domain.BasicMatchDetail{}
+	case domain.EducationAgent:
+		output = &domain.EducationMatchDetail{}
+	case domain.ExperienceAgent:
+		output = &domain.ExperienceMatchDetail{}
+	case domain.IndustryAgent:
+		output = &domain.IndustryMatchDetail{}
+	case domain.ResponsibilityAgent:
+		output = &domain.ResponsibilityMatchDetail{}
+	case domain.SkillAgent:
+		output = &domain.SkillMatchDetail{}
+	default:
+		if _, ok := domain.CustomDimensionKeyFromNode(nodeKey); !ok {
+			return nil, fmt.Errorf("node %s does not support reuse", nodeKey)
+		}
+		output = &domain.CustomMatchDetail{}
+	}
+	if len(payload) == 0 {
+		return nil, fmt.Errorf("empty output payload")
+	}
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, output); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
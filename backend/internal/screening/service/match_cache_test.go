@@ -0,0 +1,136 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/pkg/eino/models"
+)
+
+func TestBuildMatchFingerprint(t *testing.T) {
+	job := &domain.JobProfileDetail{
+		JobProfile: &domain.JobProfile{ID: "job-1", Name: "后端工程师", UpdatedAtUnix: 100},
+		Skills:     []*domain.JobSkill{{ID: "s1", SkillID: "go"}},
+	}
+	resume := &domain.ResumeDetail{
+		Resume: &domain.Resume{ID: "resume-1", Name: "张三", YearsExperience: 3, UpdatedAt: 100},
+	}
+	versions := map[string]string{domain.SkillAgent: "1.0.0", domain.AggregatorAgent: "1.1.0"}
+	key := models.ModelKey{Type: models.ModelTypeOpenAI, Name: "gpt-4o-mini"}
+	weights := domain.DefaultDimensionWeights
+
+	base, err := buildMatchFingerprint(job, resume, versions, key, &weights)
+	require.NoError(t, err)
+
+	// 时间戳变化不影响指纹
+	job.UpdatedAtUnix = 200
+	resume.UpdatedAt = 200
+	same, err := buildMatchFingerprint(job, resume, versions, key, &weights)
+	require.NoError(t, err)
+	assert.Equal(t, base, same)
+
+	// 聚合节点版本或权重变化时综合分与建议需要重新生成
+	versions[domain.AggregatorAgent] = "1.2.0"
+	aggregated, err := buildMatchFingerprint(job, resume, versions, key, &weights)
+	require.NoError(t, err)
+	assert.NotEqual(t, base.AgentHash, aggregated.AgentHash)
+
+	reweighted := weights
+	reweighted.Skill += 0.1
+	reweighted.Basic -= 0.1
+	weighted, err := buildMatchFingerprint(job, resume, versions, key, &reweighted)
+	require.NoError(t, err)
+	assert.NotEqual(t, aggregated.AgentHash, weighted.AgentHash)
+
+	// 简历内容变化
+	resume.YearsExperience = 4
+	changed, err := buildMatchFingerprint(job, resume, versions, key, &weights)
+	require.NoError(t, err)
+	assert.Equal(t, base.JobHash, changed.JobHash)
+	assert.NotEqual(t, base.ResumeHash, changed.ResumeHash)
+
+	// 子Agent版本或模型变化
+	versions[domain.SkillAgent] = "1.0.1"
+	upgraded, err := buildMatchFingerprint(job, resume, versions, key, &weights)
+	require.NoError(t, err)
+	assert.NotEqual(t, changed.AgentHash, upgraded.AgentHash)
+
+	key.Name = "gpt-4o"
+	switched, err := buildMatchFingerprint(job, resume, versions, key, &weights)
+	require.NoError(t, err)
+	assert.NotEqual(t, upgraded.AgentHash, switched.AgentHash)
+}
+
+func TestBuildNodeCacheKeys(t *testing.T) {
+	input := &domain.MatchInput{
+		JobProfile: &domain.JobProfileDetail{
+			JobProfile: &domain.JobProfile{ID: "job-1", Name: "后端工程师"},
+			Skills:     []*domain.JobSkill{{ID: "s1", SkillID: "go"}},
+		},
+		Resume:      &domain.ResumeDetail{Resume: &domain.Resume{ID: "resume-1", YearsExperience: 3}},
+		MatchTaskID: "task-1:resume-1",
+	}
+	versions := map[string]string{domain.SkillAgent: "1.0.0", domain.ExperienceAgent: "1.0.0"}
+	key := models.ModelKey{Type: models.ModelTypeOpenAI, Name: "gpt-4o-mini"}
+
+	base, err := buildNodeCacheKeys(input, versions, key)
+	require.NoError(t, err)
+	assert.Len(t, base, 6)
+	assert.NotContains(t, base, domain.TaskMetaDataNode)
+
+	// 工作年限只影响经验维度，其余维度结果可以复用
+	input.Resume.YearsExperience = 5
+	changed, err := buildNodeCacheKeys(input, versions, key)
+	require.NoError(t, err)
+	assert.NotEqual(t, base[domain.ExperienceAgent], changed[domain.ExperienceAgent])
+	assert.Equal(t, base[domain.SkillAgent], changed[domain.SkillAgent])
+	assert.Equal(t, base[domain.EducationAgent], changed[domain.EducationAgent])
+
+	// 单个 Agent 升级只使该维度失效
+	versions[domain.SkillAgent] = "1.0.1"
+	upgraded, err := buildNodeCacheKeys(input, versions, key)
+	require.NoError(t, err)
+	assert.NotEqual(t, changed[domain.SkillAgent], upgraded[domain.SkillAgent])
+	assert.Equal(t, changed[domain.IndustryAgent], upgraded[domain.IndustryAgent])
+}
+
+func TestDecodeNodeOutput(t *testing.T) {
+	output, err := decodeNodeOutput(domain.SkillAgent, map[string]any{"score": 72.5})
+	require.NoError(t, err)
+	skill, ok := output.(*domain.SkillMatchDetail)
+	require.True(t, ok)
+	assert.Equal(t, 72.5, skill.Score)
+
+	custom, err := decodeNodeOutput(domain.CustomDimensionNodeKey("english"), map[string]any{"score": 60})
+	require.NoError(t, err)
+	assert.IsType(t, &domain.CustomMatchDetail{}, custom)
+
+	_, err = decodeNodeOutput(domain.AggregatorAgent, map[string]any{"overall_score": 80})
+	assert.Error(t, err)
+	_, err = decodeNodeOutput(domain.SkillAgent, nil)
+	assert.Error(t, err)
+}
+
+func TestBlindFingerprintDiffers(t *testing.T) {
+	age := 30
+	resume := &domain.ResumeDetail{
+		Resume: &domain.Resume{ID: "resume-1", Name: "张三", Gender: "男", Age: &age, Phone: "13800138000"},
+	}
+	job := &domain.JobProfileDetail{JobProfile: &domain.JobProfile{ID: "job-1"}}
+	key := models.ModelKey{Type: models.ModelTypeOpenAI, Name: "gpt-4o-mini"}
+
+	redacted := domain.DefaultRedactionPolicy().RedactResume(resume)
+	assert.Equal(t, "张三", resume.Name, "原简历不应被修改")
+	assert.Empty(t, redacted.Gender)
+	assert.Nil(t, redacted.Age)
+	assert.Empty(t, redacted.Phone)
+
+	plain, err := buildMatchFingerprint(job, resume, nil, key, nil)
+	require.NoError(t, err)
+	blind, err := buildMatchFingerprint(job, redacted, nil, key, nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, plain.ResumeHash, blind.ResumeHash)
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/chaitin/WhaleHire/backend/config"
+	"github.com/chaitin/WhaleHire/backend/db"
 	"github.com/chaitin/WhaleHire/backend/domain"
 	screening "github.com/chaitin/WhaleHire/backend/pkg/eino/graphs/screening"
 	"github.com/chaitin/WhaleHire/backend/pkg/eino/models"
@@ -21,6 +24,8 @@ import (
 type MatchingService interface {
 	Match(ctx context.Context, req *MatchRequest) (*MatchResult, error)
 	Version() string
+	// ModelPriced 判断 LLM 配置对应的模型是否配置了价格，未配置价格的模型费用恒为 0，无法执行费用预算
+	ModelPriced(llmConfig map[string]any) (models.ModelKey, bool, error)
 }
 
 // MatchRequest 匹配请求
@@ -31,6 +36,10 @@ type MatchRequest struct {
 	Resume           *domain.ResumeDetail
 	DimensionWeights map[string]float64
 	LLMConfig        map[string]any
+	DisableCache     bool // 为 true 时不复用历史结果，强制调用模型
+	KnockoutRules    *domain.ScreeningKnockoutRules
+	Redaction        *domain.RedactionPolicy   // 盲筛脱敏策略，为空表示非盲筛
+	CustomDimensions []*domain.CustomDimension // 岗位自定义匹配维度，作为额外分支加入筛选图
 }
 
 // MatchResult 匹配结果
@@ -42,6 +51,11 @@ type MatchResult struct {
 	Duration        time.Duration
 	DimensionMap    map[string]float64
 	Collector       *screening.AgentCallbackCollector
+	Model           models.ModelKey // 本次匹配使用的模型
+	TotalCost       float64         // 按模型单价计算的调用费用
+	Fingerprint     *MatchFingerprint
+	CachedFromID    *uuid.UUID // 复用的历史筛选结果ID，为空表示本次调用了模型
+	KnockoutReasons []string   // 未通过的硬性淘汰条件，非空时未调用模型
 }
 
 type matchingService struct {
@@ -52,15 +66,26 @@ type matchingService struct {
 	sub_agent_version map[string]string
 	nodeRunRepo       domain.ScreeningNodeRunRepo
 	screeningRepo     domain.ScreeningRepo
+	prices            models.PriceTable
 
-	// 图编译缓存相关
-	compiledGraph    compose.Runnable[*domain.MatchInput, *domain.JobResumeMatch]
-	currentGraph     *screening.ScreeningChatGraph
-	currentModelType models.ModelType
-	currentModelName string
-	compileMutex     sync.RWMutex
+	// 图编译缓存，按模型与自定义维度组合索引
+	compiledGraphs map[string]*compiledScreeningGraph
+	compileMutex   sync.RWMutex
 }
 
+// compiledScreeningGraph 编译后的筛选图及其版本信息
+type compiledScreeningGraph struct {
+	runnable         compose.Runnable[*domain.MatchInput, *domain.JobResumeMatch]
+	graph            *screening.ScreeningChatGraph
+	modelType        models.ModelType
+	modelName        string
+	version          string
+	subAgentVersions map[string]string
+}
+
+// maxCompiledGraphs 编译图缓存上限，自定义维度组合较多时超出上限整体清空重建
+const maxCompiledGraphs = 32
+
 // NewMatchingService 创建匹配服务
 func NewMatchingService(cfg *config.Config, logger *slog.Logger, nodeRunRepo domain.ScreeningNodeRunRepo, screeningRepo domain.ScreeningRepo) (MatchingService, error) {
 	if cfg == nil {
@@ -76,6 +101,11 @@ func NewMatchingService(cfg *config.Config, logger *slog.Logger, nodeRunRepo dom
 		return nil, fmt.Errorf("screeningRepo is required")
 	}
 
+	prices, err := models.ParsePriceTable(cfg.LLMPricing.Models)
+	if err != nil {
+		return nil, fmt.Errorf("parse llm pricing failed: %w", err)
+	}
+
 	factory := models.NewModelFactory()
 
 	return &matchingService{
@@ -85,6 +115,7 @@ func NewMatchingService(cfg *config.Config, logger *slog.Logger, nodeRunRepo dom
 		version:       "1.1.0",
 		nodeRunRepo:   nodeRunRepo,
 		screeningRepo: screeningRepo,
+		prices:        prices,
 	}, nil
 }
 
@@ -100,6 +131,11 @@ func (s *matchingService) Match(ctx context.Context, req *MatchRequest) (*MatchR
 		return nil, fmt.Errorf("resume detail is required")
 	}
 
+	// 硬性条件不满足时直接判定为不匹配，不调用模型
+	if reasons := evaluateKnockout(req.KnockoutRules, req.Resume); len(reasons) > 0 {
+		return s.knockoutResult(req, buildDimensionWeights(req.DimensionWeights, req.CustomDimensions), reasons), nil
+	}
+
 	// 动态配置模型
 	modelType, modelName, err := s.setupModel(req.LLMConfig)
 	if err != nil {
@@ -107,41 +143,100 @@ func (s *matchingService) Match(ctx context.Context, req *MatchRequest) (*MatchR
 	}
 
 	// 获取编译后的图（支持并发复用）
-	compiledGraph, err := s.ensureCompiledGraph(ctx, modelType, modelName)
+	compiled, err := s.ensureCompiledGraph(ctx, modelType, modelName, req.CustomDimensions)
 	if err != nil {
 		return nil, fmt.Errorf("ensure compiled graph failed: %w", err)
 	}
 
-	weights := buildDimensionWeights(req.DimensionWeights)
+	weights := buildDimensionWeights(req.DimensionWeights, req.CustomDimensions)
+	modelKey := models.ModelKey{Type: modelType, Name: modelName}
+
+	// 盲筛模式下先脱敏再计算指纹，避免与非盲筛结果互相复用
+	resume := req.Resume
+	if req.Redaction != nil {
+		resume = req.Redaction.RedactResume(req.Resume)
+	}
+
+	// 岗位画像、简历内容、Agent版本与权重均未变化时直接复用历史结果
+	fingerprint, err := buildMatchFingerprint(req.JobProfile, resume, compiled.subAgentVersions, modelKey, weights)
+	if err != nil {
+		s.logger.Warn("计算匹配指纹失败", slog.Any("task_id", req.TaskID), slog.Any("resume_id", req.ResumeID), slog.Any("err", err))
+	} else if !req.DisableCache {
+		if result, ok := s.matchFromCache(ctx, req, compiled, fingerprint, weights); ok {
+			return result, nil
+		}
+	}
+
 	matchInput := &domain.MatchInput{
 		JobProfile:       req.JobProfile,
-		Resume:           req.Resume,
+		Resume:           resume,
 		DimensionWeights: weights,
 		MatchTaskID:      fmt.Sprintf("%s:%s", req.TaskID.String(), req.ResumeID.String()),
+		BlindMode:        req.Redaction != nil,
+		CustomDimensions: req.CustomDimensions,
+	}
+
+	// 否则按维度复用输入未变化的子Agent结果，只运行受影响的Agent；聚合节点始终重新运行，综合分与建议与本次结果一致
+	cacheKeys, err := buildNodeCacheKeys(matchInput, compiled.subAgentVersions, modelKey)
+	if err != nil {
+		s.logger.Warn("计算维度复用键失败", slog.Any("task_id", req.TaskID), slog.Any("resume_id", req.ResumeID), slog.Any("err", err))
+	}
+	var reusedRuns map[string]*db.ScreeningNodeRun
+	if len(cacheKeys) > 0 && !req.DisableCache {
+		matchInput.ReusedResults, reusedRuns = s.reusableNodeResults(ctx, req, cacheKeys)
 	}
 
 	// 创建回调收集器并传入包装器，复用同一份回调状态
 	collector := screening.NewAgentCallbackCollector()
-	wrapper := NewCallbackCollectorWrapper(collector, s.nodeRunRepo, s.screeningRepo, s.currentGraph, req.TaskID, req.ResumeID, req.TaskID.String(), s.logger)
+	wrapper := NewCallbackCollectorWrapper(collector, s.nodeRunRepo, s.screeningRepo, compiled.graph, req.TaskID, req.ResumeID, req.TaskID.String(), modelKey, s.prices, cacheKeys, s.logger)
 
 	// 包装器内部已包含收集器和数据库回调
 	allOptions := wrapper.ComposeOptions()
 
 	start := time.Now()
-	match, err := compiledGraph.Invoke(ctx, matchInput, allOptions...)
+	match, err := compiled.runnable.Invoke(ctx, matchInput, allOptions...)
 	duration := time.Since(start)
 	if err != nil {
 		return nil, fmt.Errorf("invoke screening graph failed: %w", err)
 	}
+	if len(reusedRuns) > 0 {
+		wrapper.SaveReusedNodeRuns(ctx, reusedRuns)
+		s.logger.Info("复用历史维度结果",
+			slog.Any("task_id", req.TaskID),
+			slog.Any("resume_id", req.ResumeID),
+			slog.Int("reused_nodes", len(reusedRuns)),
+		)
+	}
+
+	tokenUsages := collector.TokenUsages()
+	var tokensInput, tokensOutput int64
+	for _, usage := range tokenUsages {
+		if usage == nil {
+			continue
+		}
+		tokensInput += int64(usage.PromptTokens)
+		tokensOutput += int64(usage.CompletionTokens)
+	}
+
+	if _, ok := s.prices.Lookup(modelKey); !ok && tokensInput+tokensOutput > 0 {
+		s.logger.Warn("模型未配置价格，本次调用费用按 0 计算",
+			slog.String("model_type", string(modelKey.Type)),
+			slog.String("model_name", modelKey.Name),
+			slog.Any("task_id", req.TaskID),
+		)
+	}
 
 	result := &MatchResult{
 		Match:           match,
-		TokenUsages:     collector.TokenUsages(),
-		Version:         s.version,
-		SubAgentVersion: s.sub_agent_version,
+		TokenUsages:     tokenUsages,
+		Version:         compiled.version,
+		SubAgentVersion: compiled.subAgentVersions,
 		Duration:        duration,
 		DimensionMap:    weightsToMap(weights),
 		Collector:       collector,
+		Model:           modelKey,
+		TotalCost:       s.prices.Cost(modelKey, tokensInput, tokensOutput),
+		Fingerprint:     fingerprint,
 	}
 
 	return result, nil
@@ -152,14 +247,33 @@ func (s *matchingService) Version() string {
 	return s.version
 }
 
+// ModelPriced 解析 LLM 配置对应的模型并判断价格表中是否有其价格
+func (s *matchingService) ModelPriced(llmConfig map[string]any) (models.ModelKey, bool, error) {
+	key := models.ModelKey{Type: models.ModelTypeOpenAI, Name: s.defaultModelName()}
+	if len(llmConfig) > 0 {
+		modelType, modelName, err := s.parseLLMConfig(llmConfig)
+		if err != nil {
+			return models.ModelKey{}, false, err
+		}
+		key = models.ModelKey{Type: modelType, Name: modelName}
+	}
+	_, ok := s.prices.Lookup(key)
+	return key, ok, nil
+}
+
+// defaultModelName 未提供LLM配置时使用的系统默认模型
+func (s *matchingService) defaultModelName() string {
+	if s.cfg.GeneralAgent.LLM.ModelName != "" {
+		return s.cfg.GeneralAgent.LLM.ModelName
+	}
+	return "gpt-4o-mini"
+}
+
 // setupModel 根据LLM配置设置模型
 func (s *matchingService) setupModel(llmConfig map[string]any) (models.ModelType, string, error) {
 	// 如果没有提供LLM配置，使用系统默认配置
 	if len(llmConfig) == 0 {
-		modelName := s.cfg.GeneralAgent.LLM.ModelName
-		if modelName == "" {
-			modelName = "gpt-4o-mini"
-		}
+		modelName := s.defaultModelName()
 
 		openaiConfig := &models.OpenAIConfig{
 			APIKey:         s.cfg.GeneralAgent.LLM.APIKey,
@@ -236,14 +350,15 @@ func (s *matchingService) buildOpenAIConfig(llmConfig map[string]any, modelName
 	}, nil
 }
 
-// ensureCompiledGraph 确保图已编译并可复用
-func (s *matchingService) ensureCompiledGraph(ctx context.Context, modelType models.ModelType, modelName string) (compose.Runnable[*domain.MatchInput, *domain.JobResumeMatch], error) {
-	// 快速路径：如果已有编译好的图且模型配置未变化，直接返回
+// ensureCompiledGraph 确保图已编译并可复用，模型或自定义维度定义不同的任务使用各自的图
+func (s *matchingService) ensureCompiledGraph(ctx context.Context, modelType models.ModelType, modelName string, customDimensions []*domain.CustomDimension) (*compiledScreeningGraph, error) {
+	cacheKey := compiledGraphKey(modelType, modelName, customDimensions)
+
+	// 快速路径：已有相同模型与维度组合的编译结果，直接返回
 	s.compileMutex.RLock()
-	if s.compiledGraph != nil && s.currentModelType == modelType && s.currentModelName == modelName {
-		graph := s.compiledGraph
+	if compiled, ok := s.compiledGraphs[cacheKey]; ok {
 		s.compileMutex.RUnlock()
-		return graph, nil
+		return compiled, nil
 	}
 	s.compileMutex.RUnlock()
 
@@ -252,8 +367,8 @@ func (s *matchingService) ensureCompiledGraph(ctx context.Context, modelType mod
 	defer s.compileMutex.Unlock()
 
 	// 双重检查：可能在等待锁的过程中其他 goroutine 已经完成了编译
-	if s.compiledGraph != nil && s.currentModelType == modelType && s.currentModelName == modelName {
-		return s.compiledGraph, nil
+	if compiled, ok := s.compiledGraphs[cacheKey]; ok {
+		return compiled, nil
 	}
 
 	// 获取模型
@@ -263,26 +378,49 @@ func (s *matchingService) ensureCompiledGraph(ctx context.Context, modelType mod
 	}
 
 	// 构建图
-	graph, err := screening.NewScreeningChatGraph(ctx, chatModel, s.cfg)
+	graph, err := screening.NewScreeningChatGraph(ctx, chatModel, s.cfg, customDimensions...)
 	if err != nil {
 		return nil, fmt.Errorf("构建智能筛选图失败: %w", err)
 	}
 
 	// 编译图
-	compiledGraph, err := graph.Compile(ctx)
+	runnable, err := graph.Compile(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("编译智能筛选图失败: %w", err)
 	}
 
-	// 缓存编译结果和模型配置
-	s.compiledGraph = compiledGraph
-	s.currentGraph = graph
-	s.currentModelType = modelType
-	s.currentModelName = modelName
-	s.version = graph.GetVersion()
-	s.sub_agent_version = graph.GetSubAgentVersions()
+	compiled := &compiledScreeningGraph{
+		runnable:         runnable,
+		graph:            graph,
+		modelType:        modelType,
+		modelName:        modelName,
+		version:          graph.GetVersion(),
+		subAgentVersions: graph.GetSubAgentVersions(),
+	}
+
+	// 缓存编译结果；模型变化后旧图不再使用，超出上限时整体清空
+	if s.compiledGraphs == nil || len(s.compiledGraphs) >= maxCompiledGraphs {
+		s.compiledGraphs = make(map[string]*compiledScreeningGraph)
+	}
+	s.compiledGraphs[cacheKey] = compiled
+	s.version = compiled.version
+	if len(customDimensions) == 0 {
+		s.sub_agent_version = compiled.subAgentVersions
+	}
+
+	return compiled, nil
+}
 
-	return compiledGraph, nil
+// compiledGraphKey 由模型与自定义维度定义生成编译缓存键，维度权重不影响图结构
+func compiledGraphKey(modelType models.ModelType, modelName string, customDimensions []*domain.CustomDimension) string {
+	signatures := make([]string, 0, len(customDimensions))
+	for _, dim := range customDimensions {
+		if dim != nil {
+			signatures = append(signatures, dim.Key+"@"+dim.Signature())
+		}
+	}
+	sort.Strings(signatures)
+	return fmt.Sprintf("%s/%s|%s", modelType, modelName, strings.Join(signatures, ","))
 }
 
 // InvalidateCompiledGraph 使编译后的图失效，强制下次调用时重新编译
@@ -291,21 +429,37 @@ func (s *matchingService) InvalidateCompiledGraph() {
 	s.compileMutex.Lock()
 	defer s.compileMutex.Unlock()
 
-	s.compiledGraph = nil
-	s.currentModelType = ""
-	s.currentModelName = ""
+	s.compiledGraphs = nil
 }
 
-// GetCompiledGraphInfo 获取当前编译图的信息（用于调试和监控）
+// GetCompiledGraphInfo 获取最近编译的内置维度图信息（用于调试和监控）
 func (s *matchingService) GetCompiledGraphInfo() (modelType models.ModelType, modelName string, isCompiled bool) {
 	s.compileMutex.RLock()
 	defer s.compileMutex.RUnlock()
 
-	return s.currentModelType, s.currentModelName, s.compiledGraph != nil
+	for _, compiled := range s.compiledGraphs {
+		if len(compiled.graph.CustomNodeKeys()) == 0 {
+			return compiled.modelType, compiled.modelName, true
+		}
+	}
+	return "", "", false
 }
 
-func buildDimensionWeights(overrides map[string]float64) *domain.DimensionWeights {
+// buildDimensionWeights 以默认权重为基础应用任务覆盖值；自定义维度使用维度自身权重，任务中同名权重优先
+func buildDimensionWeights(overrides map[string]float64, customDimensions []*domain.CustomDimension) *domain.DimensionWeights {
 	weights := domain.DefaultDimensionWeights
+	if len(customDimensions) > 0 {
+		weights.Custom = make(map[string]float64, len(customDimensions))
+		for _, dim := range customDimensions {
+			if dim == nil {
+				continue
+			}
+			weights.Custom[dim.Key] = dim.Weight
+			if v, ok := overrides[dim.Key]; ok {
+				weights.Custom[dim.Key] = v
+			}
+		}
+	}
 	if overrides == nil {
 		return &weights
 	}
@@ -334,7 +488,7 @@ func weightsToMap(weights *domain.DimensionWeights) map[string]float64 {
 	if weights == nil {
 		return nil
 	}
-	return map[string]float64{
+	m := map[string]float64{
 		"skill":          weights.Skill,
 		"responsibility": weights.Responsibility,
 		"experience":     weights.Experience,
@@ -342,4 +496,8 @@ func weightsToMap(weights *domain.DimensionWeights) map[string]float64 {
 		"industry":       weights.Industry,
 		"basic":          weights.Basic,
 	}
+	for key, weight := range weights.Custom {
+		m[key] = weight
+	}
+	return m
 }
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/pkg/eino/graphs/screening/matching/aggregator"
+)
+
+func TestBuildDimensionWeightsWithCustomDimensions(t *testing.T) {
+	dims := []*domain.CustomDimension{
+		{Key: "open_source", Name: "开源贡献", Prompt: "评估开源贡献", Weight: 0.2},
+		{Key: "management", Name: "管理经验", Prompt: "评估管理经验", Weight: 0.1},
+	}
+
+	weights := buildDimensionWeights(map[string]float64{"skill": 0.5, "management": 0.3}, dims)
+	assert.Equal(t, 0.5, weights.Skill)
+	assert.Equal(t, 0.2, weights.Custom["open_source"])
+	// 任务中同名权重覆盖维度默认权重
+	assert.Equal(t, 0.3, weights.Custom["management"])
+
+	m := weightsToMap(weights)
+	assert.Equal(t, 0.3, m["management"])
+	assert.Equal(t, 0.5, m["skill"])
+
+	assert.Nil(t, buildDimensionWeights(nil, nil).Custom)
+}
+
+func TestRecalculateOverallScoreWithCustomDimensions(t *testing.T) {
+	match := &domain.JobResumeMatch{
+		SkillMatch:          &domain.SkillMatchDetail{Score: 80},
+		ResponsibilityMatch: &domain.ResponsibilityMatchDetail{Score: 80},
+		ExperienceMatch:     &domain.ExperienceMatchDetail{Score: 80},
+		EducationMatch:      &domain.EducationMatchDetail{Score: 80},
+		IndustryMatch:       &domain.IndustryMatchDetail{Score: 80},
+		BasicMatch:          &domain.BasicMatchDetail{Score: 80},
+	}
+	base := domain.DefaultDimensionWeights
+	require.Equal(t, 80.0, aggregator.RecalculateOverallScore(match, &base))
+
+	// 内置维度权重之和为 1，自定义维度权重 0.25，综合分按总权重 1.25 归一化
+	match.CustomMatches = map[string]*domain.CustomMatchDetail{
+		"open_source": {Key: "open_source", Score: 30},
+	}
+	weights := domain.DefaultDimensionWeights
+	weights.Custom = map[string]float64{"open_source": 0.25}
+	assert.Equal(t, 70.0, aggregator.RecalculateOverallScore(match, &weights))
+}
+
+func TestCompiledGraphKeyIgnoresWeight(t *testing.T) {
+	dim := &domain.CustomDimension{Key: "open_source", Name: "开源贡献", Prompt: "评估开源贡献", Weight: 0.2}
+	reweighted := *dim
+	reweighted.Weight = 0.5
+	edited := *dim
+	edited.Prompt = "评估开源项目维护经历"
+
+	key := compiledGraphKey("openai", "gpt-4o-mini", []*domain.CustomDimension{dim})
+	assert.Equal(t, key, compiledGraphKey("openai", "gpt-4o-mini", []*domain.CustomDimension{&reweighted}))
+	assert.NotEqual(t, key, compiledGraphKey("openai", "gpt-4o-mini", []*domain.CustomDimension{&edited}))
+	assert.NotEqual(t, key, compiledGraphKey("openai", "gpt-4o-mini", nil))
+}
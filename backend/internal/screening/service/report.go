@@ -0,0 +1,545 @@
+package service
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/xuri/excelize/v2"
+
+	"github.com/chaitin/WhaleHire/backend/config"
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+// ReportService 筛选结果报告渲染服务接口
+type ReportService interface {
+	// Render 按导出格式将报告写入 w
+	Render(w io.Writer, format consts.ScreeningExportFormat, report *domain.ScreeningReport) error
+}
+
+type reportService struct {
+	fontPath string
+	logger   *slog.Logger
+}
+
+// NewReportService 创建筛选结果报告渲染服务
+func NewReportService(cfg *config.Config, logger *slog.Logger) ReportService {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &reportService{
+		fontPath: cfg.ScreeningExport.FontPath,
+		logger:   logger.With("module", "screening_report_service"),
+	}
+}
+
+const (
+	reportSheetName = "筛选结果"
+	reportFontName  = "report"
+)
+
+// builtinDimensionNames 内置维度的展示名称
+var builtinDimensionNames = map[string]string{
+	"skill":          "技能",
+	"responsibility": "职责",
+	"experience":     "经验",
+	"education":      "教育",
+	"industry":       "行业",
+	"basic":          "基本信息",
+}
+
+// BuiltinDimensionName 返回内置维度的展示名称，非内置维度原样返回
+func BuiltinDimensionName(key string) string {
+	if name, ok := builtinDimensionNames[key]; ok {
+		return name
+	}
+	return key
+}
+
+var matchLevelNames = map[consts.MatchLevel]string{
+	consts.MatchLevelExcellent: "优秀",
+	consts.MatchLevelGood:      "良好",
+	consts.MatchLevelFair:      "一般",
+	consts.MatchLevelPoor:      "较差",
+	consts.MatchLevelNoMatch:   "不匹配",
+}
+
+func matchLevelName(level consts.MatchLevel) string {
+	if name, ok := matchLevelNames[level]; ok {
+		return name
+	}
+	return string(level)
+}
+
+// Render 按导出格式将报告写入 w
+func (s *reportService) Render(w io.Writer, format consts.ScreeningExportFormat, report *domain.ScreeningReport) error {
+	if report == nil {
+		return fmt.Errorf("报告数据不能为空")
+	}
+	s.logger.Debug("渲染筛选结果报告", slog.Any("task_id", report.TaskID), slog.String("format", string(format)), slog.Int("candidates", len(report.Candidates)))
+	switch format {
+	case consts.ScreeningExportFormatCSV:
+		return renderCSV(w, report)
+	case consts.ScreeningExportFormatXLSX:
+		return renderXLSX(w, report)
+	case consts.ScreeningExportFormatPDF:
+		return s.renderPDF(w, report)
+	default:
+		return fmt.Errorf("不支持的导出格式: %s", format)
+	}
+}
+
+// reportHeader 排名表表头，维度列依次为内置维度与岗位自定义维度
+func reportHeader(report *domain.ScreeningReport) []string {
+	header := []string{"排名", "候选人", "简历ID", "综合得分", "匹配等级"}
+	for _, dim := range report.Dimensions {
+		header = append(header, dim.Name)
+	}
+	return append(header, "人工校准", "校准原因", "淘汰原因", "匹配建议")
+}
+
+// reportRows 排名表数据行，得分列为数值，缺失的维度得分为空
+func reportRows(report *domain.ScreeningReport) [][]any {
+	rows := make([][]any, 0, len(report.Candidates))
+	for _, candidate := range report.Candidates {
+		row := []any{
+			candidate.Rank,
+			candidate.Name,
+			candidate.Result.ResumeID.String(),
+			roundScore(candidate.OverallScore),
+			matchLevelName(candidate.MatchLevel),
+		}
+		for _, dim := range report.Dimensions {
+			if score, ok := candidate.DimensionScores[dim.Key]; ok {
+				row = append(row, roundScore(score))
+			} else {
+				row = append(row, "")
+			}
+		}
+		overridden, reason := "否", ""
+		if candidate.Result.Override != nil {
+			overridden, reason = "是", candidate.Result.Override.Reason
+		}
+		row = append(row,
+			overridden,
+			reason,
+			strings.Join(candidate.Result.KnockoutReasons, "；"),
+			strings.Join(candidate.Result.Recommendations, "；"),
+		)
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func roundScore(score float64) float64 {
+	return math.Round(score*10) / 10
+}
+
+// renderCSV 输出带 UTF-8 BOM 的 CSV，保证 Excel 直接打开时中文不乱码
+func renderCSV(w io.Writer, report *domain.ScreeningReport) error {
+	if _, err := w.Write([]byte("\xEF\xBB\xBF")); err != nil {
+		return fmt.Errorf("写入 CSV 失败: %w", err)
+	}
+	writer := csv.NewWriter(w)
+	if err := writer.Write(reportHeader(report)); err != nil {
+		return fmt.Errorf("写入 CSV 失败: %w", err)
+	}
+	for _, row := range reportRows(report) {
+		record := make([]string, len(row))
+		for i, value := range row {
+			switch v := value.(type) {
+			case float64:
+				record[i] = strconv.FormatFloat(v, 'f', 1, 64)
+			default:
+				record[i] = fmt.Sprint(v)
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("写入 CSV 失败: %w", err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("写入 CSV 失败: %w", err)
+	}
+	return nil
+}
+
+// renderXLSX 以流式写入生成排名表，大任务导出时内存占用可控
+func renderXLSX(w io.Writer, report *domain.ScreeningReport) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetName("Sheet1", reportSheetName); err != nil {
+		return fmt.Errorf("创建工作表失败: %w", err)
+	}
+	sw, err := f.NewStreamWriter(reportSheetName)
+	if err != nil {
+		return fmt.Errorf("创建工作表失败: %w", err)
+	}
+
+	header := reportHeader(report)
+	if err := sw.SetColWidth(1, len(header), 14); err != nil {
+		return fmt.Errorf("设置列宽失败: %w", err)
+	}
+	if err := sw.SetPanes(&excelize.Panes{Freeze: true, YSplit: 1, TopLeftCell: "A2", ActivePane: "bottomLeft"}); err != nil {
+		return fmt.Errorf("冻结表头失败: %w", err)
+	}
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{Bold: true},
+		Fill: excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"DDEBF7"}},
+	})
+	if err != nil {
+		return fmt.Errorf("创建表头样式失败: %w", err)
+	}
+
+	headerRow := make([]any, len(header))
+	for i, title := range header {
+		headerRow[i] = excelize.Cell{StyleID: headerStyle, Value: title}
+	}
+	if err := sw.SetRow("A1", headerRow); err != nil {
+		return fmt.Errorf("写入表头失败: %w", err)
+	}
+	for i, row := range reportRows(report) {
+		cell, err := excelize.CoordinatesToCellName(1, i+2)
+		if err != nil {
+			return err
+		}
+		if err := sw.SetRow(cell, row); err != nil {
+			return fmt.Errorf("写入第 %d 行失败: %w", i+2, err)
+		}
+	}
+	if err := sw.Flush(); err != nil {
+		return fmt.Errorf("写入工作表失败: %w", err)
+	}
+	if err := f.Write(w); err != nil {
+		return fmt.Errorf("写入 Excel 失败: %w", err)
+	}
+	return nil
+}
+
+// renderPDF 生成 PDF 报告：首页为排名汇总，之后每位候选人一页匹配详情。
+// 中文需要 TrueType 字体，字体路径由 screening_export.font_path 配置
+func (s *reportService) renderPDF(w io.Writer, report *domain.ScreeningReport) error {
+	font, err := os.ReadFile(s.fontPath)
+	if err != nil {
+		return fmt.Errorf("PDF 报告字体不可用，请检查 screening_export.font_path 配置: %w", err)
+	}
+
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.AddUTF8FontFromBytes(reportFontName, "", font)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.AliasNbPages("")
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-12)
+		pdf.SetFont(reportFontName, "", 8)
+		pdf.SetTextColor(128, 128, 128)
+		pdf.CellFormat(0, 6, fmt.Sprintf("%d / {nb}", pdf.PageNo()), "", 0, "C", false, 0, "")
+		pdf.SetTextColor(0, 0, 0)
+	})
+
+	p := &pdfReport{pdf: pdf, report: report}
+	p.summaryPage()
+	for _, candidate := range report.Candidates {
+		p.candidatePage(candidate)
+	}
+
+	if err := pdf.Output(w); err != nil {
+		return fmt.Errorf("生成 PDF 失败: %w", err)
+	}
+	return nil
+}
+
+type pdfReport struct {
+	pdf    *gofpdf.Fpdf
+	report *domain.ScreeningReport
+}
+
+func (p *pdfReport) title(text string) {
+	p.pdf.SetFont(reportFontName, "", 16)
+	p.pdf.MultiCell(0, 9, text, "", "L", false)
+	p.pdf.Ln(2)
+}
+
+func (p *pdfReport) heading(text string) {
+	p.pdf.Ln(2)
+	p.pdf.SetFont(reportFontName, "", 12)
+	p.pdf.SetFillColor(221, 235, 247)
+	p.pdf.CellFormat(0, 7, text, "", 1, "L", true, 0, "")
+	p.pdf.Ln(1)
+}
+
+func (p *pdfReport) text(text string) {
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+	p.pdf.SetFont(reportFontName, "", 10)
+	p.pdf.MultiCell(0, 5.5, text, "", "L", false)
+}
+
+func (p *pdfReport) bullets(label string, items []string) {
+	if len(items) == 0 {
+		return
+	}
+	if label != "" {
+		p.text(label)
+	}
+	for _, item := range items {
+		p.text("• " + item)
+	}
+}
+
+func (p *pdfReport) table(widths []float64, header []string, rows [][]string) {
+	p.pdf.SetFont(reportFontName, "", 9)
+	p.pdf.SetFillColor(221, 235, 247)
+	for i, title := range header {
+		p.pdf.CellFormat(widths[i], 7, title, "1", 0, "C", true, 0, "")
+	}
+	p.pdf.Ln(-1)
+	for _, row := range rows {
+		for i, value := range row {
+			p.pdf.CellFormat(widths[i], 6.5, truncateToWidth(p.pdf, value, widths[i]-2), "1", 0, "C", false, 0, "")
+		}
+		p.pdf.Ln(-1)
+	}
+}
+
+// truncateToWidth 截断超出单元格宽度的文本
+func truncateToWidth(pdf *gofpdf.Fpdf, text string, width float64) string {
+	if pdf.GetStringWidth(text) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 && pdf.GetStringWidth(string(runes)+"…") > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "…"
+}
+
+func (p *pdfReport) summaryPage() {
+	p.pdf.AddPage()
+	p.title(fmt.Sprintf("%s 筛选结果报告", p.report.JobName))
+	p.text(fmt.Sprintf("筛选任务：%s", p.report.TaskID))
+	p.text(fmt.Sprintf("候选人数：%d", len(p.report.Candidates)))
+	p.text(fmt.Sprintf("生成时间：%s", p.report.GeneratedAt.Format("2006-01-02 15:04")))
+
+	p.heading("候选人排名")
+	rows := make([][]string, 0, len(p.report.Candidates))
+	for _, candidate := range p.report.Candidates {
+		rows = append(rows, []string{
+			strconv.Itoa(candidate.Rank),
+			candidate.Name,
+			formatScore(candidate.OverallScore),
+			matchLevelName(candidate.MatchLevel),
+		})
+	}
+	p.table([]float64{20, 80, 40, 40}, []string{"排名", "候选人", "综合得分", "匹配等级"}, rows)
+}
+
+func (p *pdfReport) candidatePage(candidate *domain.ScreeningReportCandidate) {
+	result := candidate.Result
+	p.pdf.AddPage()
+	p.title(fmt.Sprintf("第 %d 名  %s", candidate.Rank, candidate.Name))
+	p.text(fmt.Sprintf("综合得分：%s    匹配等级：%s", formatScore(candidate.OverallScore), matchLevelName(candidate.MatchLevel)))
+
+	if result.Override != nil {
+		p.text(fmt.Sprintf("人工校准：%s（校准时间 %s）", result.Override.Reason, result.Override.OverriddenAt.Format("2006-01-02 15:04")))
+	}
+
+	p.heading("维度得分")
+	headers := make([]string, 0, len(p.report.Dimensions))
+	scores := make([]string, 0, len(p.report.Dimensions))
+	for _, dim := range p.report.Dimensions {
+		headers = append(headers, dim.Name)
+		if score, ok := candidate.DimensionScores[dim.Key]; ok {
+			scores = append(scores, formatScore(score))
+		} else {
+			scores = append(scores, "-")
+		}
+	}
+	if len(headers) > 0 {
+		width := 180 / float64(len(headers))
+		widths := make([]float64, len(headers))
+		for i := range widths {
+			widths[i] = width
+		}
+		p.table(widths, headers, [][]string{scores})
+	}
+
+	if len(result.KnockoutReasons) > 0 {
+		p.heading("淘汰原因")
+		p.bullets("", result.KnockoutReasons)
+	}
+
+	p.skillSection(result.SkillDetail)
+	p.responsibilitySection(result.Responsibility)
+	p.experienceSection(result.ExperienceDetail)
+	p.educationSection(result.EducationDetail)
+	p.industrySection(result.IndustryDetail)
+	p.basicSection(result.BasicDetail)
+	p.customSection(result.CustomDetails)
+
+	if len(result.Recommendations) > 0 {
+		p.heading("匹配建议")
+		p.bullets("", result.Recommendations)
+	}
+}
+
+func (p *pdfReport) skillName(id string) string {
+	if name, ok := p.report.SkillNames[id]; ok && name != "" {
+		return name
+	}
+	return id
+}
+
+func (p *pdfReport) skillSection(detail *domain.SkillMatchDetail) {
+	if detail == nil {
+		return
+	}
+	p.heading(fmt.Sprintf("技能匹配（%s 分）", formatScore(detail.Score)))
+	if len(detail.MatchedSkills) > 0 {
+		p.text("匹配技能：")
+		for _, skill := range detail.MatchedSkills {
+			if skill == nil {
+				continue
+			}
+			line := fmt.Sprintf("• %s（%s，%s 分）", p.skillName(skill.JobSkillID), skill.MatchType, formatScore(skill.Score))
+			if skill.LLMAnalysis != nil && skill.LLMAnalysis.MatchReason != "" {
+				line += "：" + skill.LLMAnalysis.MatchReason
+			}
+			p.text(line)
+		}
+	}
+	if len(detail.MissingSkills) > 0 {
+		missing := make([]string, 0, len(detail.MissingSkills))
+		for _, skill := range detail.MissingSkills {
+			if skill == nil {
+				continue
+			}
+			name := skill.Skill
+			if name == "" {
+				name = p.skillName(skill.ID)
+			}
+			missing = append(missing, name)
+		}
+		p.text("缺失技能：" + strings.Join(missing, "、"))
+	}
+	if len(detail.ExtraSkills) > 0 {
+		p.text("额外技能：" + strings.Join(detail.ExtraSkills, "、"))
+	}
+	if detail.LLMAnalysis != nil {
+		p.text(detail.LLMAnalysis.AnalysisDetail)
+	}
+}
+
+func (p *pdfReport) responsibilitySection(detail *domain.ResponsibilityMatchDetail) {
+	if detail == nil {
+		return
+	}
+	p.heading(fmt.Sprintf("职责匹配（%s 分）", formatScore(detail.Score)))
+	for _, matched := range detail.MatchedResponsibilities {
+		if matched == nil {
+			continue
+		}
+		name := p.report.Responsibilities[matched.JobResponsibilityID]
+		if name == "" {
+			name = matched.JobResponsibilityID
+		}
+		line := fmt.Sprintf("• %s（%s 分）", name, formatScore(matched.MatchScore))
+		if matched.MatchReason != "" {
+			line += "：" + matched.MatchReason
+		}
+		p.text(line)
+	}
+	if len(detail.UnmatchedResponsibilities) > 0 {
+		unmatched := make([]string, 0, len(detail.UnmatchedResponsibilities))
+		for _, resp := range detail.UnmatchedResponsibilities {
+			if resp != nil {
+				unmatched = append(unmatched, resp.Responsibility)
+			}
+		}
+		p.bullets("未覆盖职责：", unmatched)
+	}
+}
+
+func (p *pdfReport) experienceSection(detail *domain.ExperienceMatchDetail) {
+	if detail == nil {
+		return
+	}
+	p.heading(fmt.Sprintf("经验匹配（%s 分）", formatScore(detail.Score)))
+	if detail.YearsMatch != nil {
+		p.text(fmt.Sprintf("工作年限：要求 %.1f 年，实际 %.1f 年。%s",
+			detail.YearsMatch.RequiredYears, detail.YearsMatch.ActualYears, detail.YearsMatch.Analysis))
+	}
+	for _, position := range detail.PositionMatches {
+		if position == nil {
+			continue
+		}
+		p.text(fmt.Sprintf("• %s %s（%s 分）：%s", position.Company, position.Position, formatScore(position.Score), position.Analysis))
+	}
+	p.text(detail.OverallAnalysis)
+}
+
+func (p *pdfReport) educationSection(detail *domain.EducationMatchDetail) {
+	if detail == nil {
+		return
+	}
+	p.heading(fmt.Sprintf("教育匹配（%s 分）", formatScore(detail.Score)))
+	if detail.DegreeMatch != nil {
+		p.text(fmt.Sprintf("学历：要求 %s，实际 %s", detail.DegreeMatch.RequiredDegree, detail.DegreeMatch.ActualDegree))
+	}
+	for _, school := range detail.SchoolMatches {
+		if school == nil {
+			continue
+		}
+		p.text(fmt.Sprintf("• %s %s %s：%s", school.School, school.Degree, school.Major, school.Analysis))
+	}
+	p.text(detail.OverallAnalysis)
+}
+
+func (p *pdfReport) industrySection(detail *domain.IndustryMatchDetail) {
+	if detail == nil {
+		return
+	}
+	p.heading(fmt.Sprintf("行业匹配（%s 分）", formatScore(detail.Score)))
+	for _, industry := range detail.IndustryMatches {
+		if industry == nil {
+			continue
+		}
+		p.text(fmt.Sprintf("• %s（%s）：%s", industry.Company, industry.Industry, industry.Analysis))
+	}
+	p.text(detail.OverallAnalysis)
+}
+
+func (p *pdfReport) basicSection(detail *domain.BasicMatchDetail) {
+	if detail == nil {
+		return
+	}
+	p.heading(fmt.Sprintf("基本信息匹配（%s 分）", formatScore(detail.Score)))
+	p.bullets("", detail.Evidence)
+	p.text(detail.Notes)
+}
+
+func (p *pdfReport) customSection(details map[string]*domain.CustomMatchDetail) {
+	for _, dim := range p.report.Dimensions {
+		detail, ok := details[dim.Key]
+		if !ok || detail == nil {
+			continue
+		}
+		p.heading(fmt.Sprintf("%s（%s 分）", dim.Name, formatScore(detail.Score)))
+		p.bullets("证据：", detail.Evidence)
+		p.bullets("差距：", detail.Gaps)
+		p.text(detail.Analysis)
+	}
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(roundScore(score), 'f', 1, 64)
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+func testScreeningReport() *domain.ScreeningReport {
+	return &domain.ScreeningReport{
+		TaskID:  uuid.New(),
+		JobName: "后端工程师",
+		Dimensions: []*domain.ScreeningReportDimension{
+			{Key: "skill", Name: "技能"},
+			{Key: "open_source", Name: "开源贡献"},
+		},
+		SkillNames: map[string]string{"s1": "Go", "s2": "Kubernetes"},
+		Candidates: []*domain.ScreeningReportCandidate{
+			{
+				Rank:            1,
+				Name:            "张三",
+				OverallScore:    88.26,
+				MatchLevel:      consts.MatchLevelExcellent,
+				DimensionScores: map[string]float64{"skill": 90, "open_source": 75.56},
+				Result: &domain.ScreeningResult{
+					ResumeID: uuid.New(),
+					SkillDetail: &domain.SkillMatchDetail{
+						Score:         90,
+						MatchedSkills: []*domain.MatchedSkill{{JobSkillID: "s1", MatchType: "exact", Score: 95}},
+						MissingSkills: []*domain.JobSkill{{ID: "s2", Skill: "Kubernetes"}},
+					},
+					CustomDetails: map[string]*domain.CustomMatchDetail{
+						"open_source": {Key: "open_source", Name: "开源贡献", Score: 75.56, Evidence: []string{"维护开源项目"}},
+					},
+					Recommendations: []string{"建议进入面试", "重点考察云原生经验"},
+				},
+			},
+			{
+				Rank:            2,
+				Name:            "李四",
+				OverallScore:    20,
+				MatchLevel:      consts.MatchLevelNoMatch,
+				DimensionScores: map[string]float64{"skill": 20},
+				Result: &domain.ScreeningResult{
+					ResumeID:        uuid.New(),
+					KnockoutReasons: []string{"学历不满足要求"},
+					Override:        &domain.ScreeningResultOverride{Reason: "人工复核", OverriddenAt: time.Now()},
+				},
+			},
+		},
+		GeneratedAt: time.Now(),
+	}
+}
+
+func TestRenderCSV(t *testing.T) {
+	report := testScreeningReport()
+	var buf bytes.Buffer
+	require.NoError(t, (&reportService{logger: slog.Default()}).Render(&buf, consts.ScreeningExportFormatCSV, report))
+
+	data := buf.Bytes()
+	require.True(t, bytes.HasPrefix(data, []byte("\xEF\xBB\xBF")))
+	records, err := csv.NewReader(bytes.NewReader(data[3:])).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	assert.Equal(t, []string{"排名", "候选人", "简历ID", "综合得分", "匹配等级", "技能", "开源贡献", "人工校准", "校准原因", "淘汰原因", "匹配建议"}, records[0])
+	assert.Equal(t, []string{"1", "张三", report.Candidates[0].Result.ResumeID.String(), "88.3", "优秀", "90.0", "75.6", "否", "", "", "建议进入面试；重点考察云原生经验"}, records[1])
+	// 缺失的维度得分留空
+	assert.Equal(t, "", records[2][6])
+	assert.Equal(t, "是", records[2][7])
+	assert.Equal(t, "学历不满足要求", records[2][9])
+}
+
+func TestRenderXLSX(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, (&reportService{logger: slog.Default()}).Render(&buf, consts.ScreeningExportFormatXLSX, testScreeningReport()))
+
+	f, err := excelize.OpenReader(&buf)
+	require.NoError(t, err)
+	defer f.Close()
+
+	rows, err := f.GetRows(reportSheetName)
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, "开源贡献", rows[0][6])
+	assert.Equal(t, "张三", rows[1][1])
+	assert.Equal(t, "88.3", rows[1][3])
+}
+
+func TestRenderPDF(t *testing.T) {
+	// 缺少字体时返回明确错误
+	err := (&reportService{fontPath: "/nonexistent/font.ttf", logger: slog.Default()}).Render(&bytes.Buffer{}, consts.ScreeningExportFormatPDF, testScreeningReport())
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "font_path"))
+
+	fontPath := "/usr/share/fonts/truetype/dejavu/DejaVuSans.ttf"
+	if _, err := os.Stat(fontPath); err != nil {
+		t.Skip("测试字体不可用")
+	}
+	var buf bytes.Buffer
+	require.NoError(t, (&reportService{fontPath: fontPath, logger: slog.Default()}).Render(&buf, consts.ScreeningExportFormatPDF, testScreeningReport()))
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF")))
+}
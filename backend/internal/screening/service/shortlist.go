@@ -0,0 +1,358 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/config"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/pkg/eino/graphs/screening/matching/shortlist"
+	"github.com/chaitin/WhaleHire/backend/pkg/eino/models"
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/compose"
+)
+
+// shortlistConcurrency 两两比较的并发调用数
+const shortlistConcurrency = 4
+
+// shortlistReasonsPerItem 每个位次说明中引用的比较理由条数
+const shortlistReasonsPerItem = 2
+
+// ShortlistService 短名单排名服务接口
+type ShortlistService interface {
+	// RankShortlist 对按综合得分排序的候选人做两两循环比较，返回重新排序的短名单
+	RankShortlist(ctx context.Context, jobProfile *domain.JobProfileDetail, candidates []*domain.ShortlistCandidate, llmConfig map[string]any) (*domain.ScreeningShortlist, error)
+	Version() string
+}
+
+type shortlistService struct {
+	cfg              *config.Config
+	factory          *models.ModelFactory
+	logger           *slog.Logger
+	version          string
+	compiledRunnable compose.Runnable[*domain.PairwiseCompareInput, *domain.PairwiseCompareResult]
+	currentModelType models.ModelType
+	currentModelName string
+	compileMutex     sync.Mutex
+}
+
+// NewShortlistService 创建短名单排名服务
+func NewShortlistService(cfg *config.Config, logger *slog.Logger) (ShortlistService, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("config is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &shortlistService{
+		cfg:     cfg,
+		factory: models.NewModelFactory(),
+		logger:  logger,
+		version: "1.0.0",
+	}, nil
+}
+
+// RankShortlist 对候选人做两两循环比较，单次比较失败时按综合得分判定胜负
+func (s *shortlistService) RankShortlist(ctx context.Context, jobProfile *domain.JobProfileDetail, candidates []*domain.ShortlistCandidate, llmConfig map[string]any) (*domain.ScreeningShortlist, error) {
+	if jobProfile == nil || jobProfile.JobProfile == nil {
+		return nil, fmt.Errorf("岗位画像不能为空")
+	}
+	if len(candidates) < 2 {
+		return nil, fmt.Errorf("至少需要两位候选人才能比较")
+	}
+
+	modelType, modelName, err := s.setupModel(llmConfig)
+	if err != nil {
+		return nil, fmt.Errorf("设置模型失败: %w", err)
+	}
+	runnable, version, err := s.ensureCompiled(ctx, modelType, modelName)
+	if err != nil {
+		return nil, fmt.Errorf("确保链已编译失败: %w", err)
+	}
+
+	pairs := roundRobinPairs(len(candidates))
+	comparisons := make([]*domain.ShortlistComparison, len(pairs))
+	var (
+		mu           sync.Mutex
+		wg           sync.WaitGroup
+		failed       int
+		inputTokens  int64
+		outputTokens int64
+	)
+	sem := make(chan struct{}, shortlistConcurrency)
+
+	for idx, pair := range pairs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(idx int, a, b *domain.ShortlistCandidate) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			var usage *model.TokenUsage
+			handler := callbacks.NewHandlerBuilder().
+				OnEndFn(func(ctx context.Context, info *callbacks.RunInfo, output callbacks.CallbackOutput) context.Context {
+					if callbackOutput := model.ConvCallbackOutput(output); callbackOutput != nil && callbackOutput.TokenUsage != nil {
+						usage = callbackOutput.TokenUsage
+					}
+					return ctx
+				}).Build()
+
+			result, err := runnable.Invoke(ctx, &domain.PairwiseCompareInput{
+				JobProfile: jobProfile,
+				CandidateA: a,
+				CandidateB: b,
+			}, compose.WithCallbacks(handler))
+
+			mu.Lock()
+			defer mu.Unlock()
+			if usage != nil {
+				inputTokens += int64(usage.PromptTokens)
+				outputTokens += int64(usage.CompletionTokens)
+			}
+			if err != nil {
+				failed++
+				s.logger.Warn("候选人两两比较失败，按综合得分判定",
+					slog.Any("resume_a", a.ResumeID),
+					slog.Any("resume_b", b.ResumeID),
+					slog.Any("err", err))
+				comparisons[idx] = fallbackComparison(a, b)
+				return
+			}
+			comparisons[idx] = toShortlistComparison(a, b, result)
+		}(idx, candidates[pair[0]], candidates[pair[1]])
+	}
+	wg.Wait()
+
+	if failed == len(pairs) {
+		return nil, fmt.Errorf("全部 %d 次两两比较均失败", failed)
+	}
+
+	return &domain.ScreeningShortlist{
+		Method:       domain.ShortlistMethodRoundRobin,
+		TopN:         len(candidates),
+		AgentVersion: version,
+		Items:        rankRoundRobin(candidates, comparisons),
+		Comparisons:  comparisons,
+		TokenUsage: map[string]int64{
+			"input_tokens":  inputTokens,
+			"output_tokens": outputTokens,
+			"total_tokens":  inputTokens + outputTokens,
+		},
+		GeneratedAt: time.Now(),
+	}, nil
+}
+
+// Version 返回当前Agent版本
+func (s *shortlistService) Version() string {
+	s.compileMutex.Lock()
+	defer s.compileMutex.Unlock()
+	return s.version
+}
+
+// roundRobinPairs 生成循环赛对阵，交替安排 A/B 位置以抵消模型的位置偏好
+func roundRobinPairs(n int) [][2]int {
+	pairs := make([][2]int, 0, n*(n-1)/2)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if (i+j)%2 == 0 {
+				pairs = append(pairs, [2]int{i, j})
+			} else {
+				pairs = append(pairs, [2]int{j, i})
+			}
+		}
+	}
+	return pairs
+}
+
+// toShortlistComparison 将模型输出转换为比较记录
+func toShortlistComparison(a, b *domain.ShortlistCandidate, result *domain.PairwiseCompareResult) *domain.ShortlistComparison {
+	winner, loser := a, b
+	if result.Winner == domain.PairwiseCandidateB {
+		winner, loser = b, a
+	}
+	return &domain.ShortlistComparison{
+		WinnerID:   winner.ResumeID,
+		LoserID:    loser.ResumeID,
+		Confidence: result.Confidence,
+		Reason:     result.Reason,
+	}
+}
+
+// fallbackComparison 模型比较失败时由综合得分较高者胜出
+func fallbackComparison(a, b *domain.ShortlistCandidate) *domain.ShortlistComparison {
+	winner, loser := a, b
+	if b.Result.OverallScore > a.Result.OverallScore {
+		winner, loser = b, a
+	}
+	return &domain.ShortlistComparison{
+		WinnerID: winner.ResumeID,
+		LoserID:  loser.ResumeID,
+		Reason:   "模型比较失败，按综合得分判定",
+		Fallback: true,
+	}
+}
+
+// rankRoundRobin 根据循环赛结果排序：胜场多者在前；胜场相同时比较同分组内的胜场，
+// 仍相同则保持原始得分排名。candidates 需已按综合得分降序排列
+func rankRoundRobin(candidates []*domain.ShortlistCandidate, comparisons []*domain.ShortlistComparison) []*domain.ScreeningShortlistItem {
+	original := make(map[uuid.UUID]int, len(candidates))
+	wins := make(map[uuid.UUID]int, len(candidates))
+	losses := make(map[uuid.UUID]int, len(candidates))
+	for i, c := range candidates {
+		original[c.ResumeID] = i + 1
+	}
+	for _, cmp := range comparisons {
+		wins[cmp.WinnerID]++
+		losses[cmp.LoserID]++
+	}
+
+	groupWins := make(map[uuid.UUID]int, len(candidates))
+	for _, cmp := range comparisons {
+		if wins[cmp.WinnerID] == wins[cmp.LoserID] {
+			groupWins[cmp.WinnerID]++
+		}
+	}
+
+	ordered := make([]*domain.ShortlistCandidate, len(candidates))
+	copy(ordered, candidates)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		a, b := ordered[i].ResumeID, ordered[j].ResumeID
+		if wins[a] != wins[b] {
+			return wins[a] > wins[b]
+		}
+		if groupWins[a] != groupWins[b] {
+			return groupWins[a] > groupWins[b]
+		}
+		return original[a] < original[b]
+	})
+
+	items := make([]*domain.ScreeningShortlistItem, 0, len(ordered))
+	for i, c := range ordered {
+		items = append(items, &domain.ScreeningShortlistItem{
+			Position:        i + 1,
+			ResumeID:        c.ResumeID,
+			OriginalRanking: original[c.ResumeID],
+			OverallScore:    c.Result.OverallScore,
+			MatchLevel:      c.Result.MatchLevel,
+			Wins:            wins[c.ResumeID],
+			Losses:          losses[c.ResumeID],
+			Justification:   shortlistJustification(c.ResumeID, original, wins[c.ResumeID], losses[c.ResumeID], comparisons),
+		})
+	}
+	return items
+}
+
+// shortlistJustification 汇总候选人的战绩及最有把握的胜出理由，无胜场时引用落败理由
+func shortlistJustification(resumeID uuid.UUID, original map[uuid.UUID]int, wins, losses int, comparisons []*domain.ShortlistComparison) string {
+	var won, lost []*domain.ShortlistComparison
+	for _, cmp := range comparisons {
+		switch resumeID {
+		case cmp.WinnerID:
+			won = append(won, cmp)
+		case cmp.LoserID:
+			lost = append(lost, cmp)
+		}
+	}
+	byConfidence := func(list []*domain.ShortlistComparison) {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].Confidence > list[j].Confidence })
+	}
+	byConfidence(won)
+	byConfidence(lost)
+
+	parts := []string{fmt.Sprintf("两两比较 %d 胜 %d 负（原综合得分排名第 %d）", wins, losses, original[resumeID])}
+	for i, cmp := range won {
+		if i >= shortlistReasonsPerItem {
+			break
+		}
+		parts = append(parts, fmt.Sprintf("胜原排名第 %d 位：%s", original[cmp.LoserID], cmp.Reason))
+	}
+	if len(won) == 0 && len(lost) > 0 {
+		parts = append(parts, fmt.Sprintf("负原排名第 %d 位：%s", original[lost[0].WinnerID], lost[0].Reason))
+	}
+	return strings.Join(parts, "；")
+}
+
+// ensureCompiled 确保链已编译并可复用，同时返回编译时的Agent版本
+func (s *shortlistService) ensureCompiled(ctx context.Context, modelType models.ModelType, modelName string) (compose.Runnable[*domain.PairwiseCompareInput, *domain.PairwiseCompareResult], string, error) {
+	s.compileMutex.Lock()
+	defer s.compileMutex.Unlock()
+
+	if s.compiledRunnable != nil && s.currentModelType == modelType && s.currentModelName == modelName {
+		return s.compiledRunnable, s.version, nil
+	}
+
+	chatModel, err := s.factory.GetModel(ctx, modelType, modelName)
+	if err != nil {
+		return nil, "", fmt.Errorf("获取对话模型失败: %w", err)
+	}
+
+	agent, err := shortlist.NewPairwiseCompareAgent(ctx, chatModel)
+	if err != nil {
+		return nil, "", fmt.Errorf("创建两两比较Agent失败: %w", err)
+	}
+
+	runnable, err := agent.Compile(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("编译两两比较链失败: %w", err)
+	}
+
+	s.compiledRunnable = runnable
+	s.currentModelType = modelType
+	s.currentModelName = modelName
+	s.version = agent.GetVersion()
+
+	return runnable, s.version, nil
+}
+
+// setupModel 根据LLM配置设置模型
+func (s *shortlistService) setupModel(llmConfig map[string]any) (models.ModelType, string, error) {
+	// 如果没有提供LLM配置，使用系统默认配置
+	if len(llmConfig) == 0 {
+		modelName := s.cfg.GeneralAgent.LLM.ModelName
+		if modelName == "" {
+			modelName = "gpt-4o-mini"
+		}
+
+		s.factory.Register(models.ModelTypeOpenAI, modelName, models.NewOpenAIModelManager(&models.OpenAIConfig{
+			APIKey:         s.cfg.GeneralAgent.LLM.APIKey,
+			BaseURL:        s.cfg.GeneralAgent.LLM.BaseURL,
+			Model:          modelName,
+			ResponseFormat: "json_object",
+		}))
+		return models.ModelTypeOpenAI, modelName, nil
+	}
+
+	modelTypeStr, _ := llmConfig["type"].(string)
+	if modelTypeStr != "openai" {
+		return "", "", fmt.Errorf("不支持的模型类型: %s", modelTypeStr)
+	}
+	modelName, ok := llmConfig["model"].(string)
+	if !ok || modelName == "" {
+		return "", "", fmt.Errorf("模型名称不能为空")
+	}
+	apiKey, ok := llmConfig["api_key"].(string)
+	if !ok {
+		return "", "", fmt.Errorf("OpenAI模型需要api_key")
+	}
+	baseURL, _ := llmConfig["base_url"].(string)
+	if baseURL == "" {
+		baseURL = "https://api.openai.com/v1"
+	}
+
+	s.factory.Register(models.ModelTypeOpenAI, modelName, models.NewOpenAIModelManager(&models.OpenAIConfig{
+		APIKey:         apiKey,
+		BaseURL:        baseURL,
+		Model:          modelName,
+		ResponseFormat: "json_object",
+	}))
+	return models.ModelTypeOpenAI, modelName, nil
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+func TestRoundRobinPairs(t *testing.T) {
+	pairs := roundRobinPairs(4)
+	require.Len(t, pairs, 6)
+
+	seen := make(map[[2]int]bool)
+	firstPosition := make(map[int]int)
+	for _, p := range pairs {
+		key := [2]int{min(p[0], p[1]), max(p[0], p[1])}
+		assert.False(t, seen[key], "对阵重复: %v", p)
+		seen[key] = true
+		firstPosition[p[0]]++
+	}
+	// 原排名第一的候选人不应总是处于 A 位
+	assert.Less(t, firstPosition[0], 3)
+}
+
+func TestRankRoundRobin(t *testing.T) {
+	candidates := make([]*domain.ShortlistCandidate, 4)
+	for i := range candidates {
+		candidates[i] = &domain.ShortlistCandidate{
+			ResumeID: uuid.New(),
+			Result:   &domain.ScreeningResult{OverallScore: float64(90 - i*5)},
+		}
+	}
+	id := func(i int) uuid.UUID { return candidates[i].ResumeID }
+	beat := func(w, l int, reason string) *domain.ShortlistComparison {
+		return &domain.ShortlistComparison{WinnerID: id(w), LoserID: id(l), Confidence: 0.8, Reason: reason}
+	}
+
+	// 原第三名全胜；第一、二名各 1 胜且第二名胜第一名；第四名全负
+	comparisons := []*domain.ShortlistComparison{
+		beat(2, 0, "核心职责覆盖更完整"),
+		beat(2, 1, "技能更贴合"),
+		beat(2, 3, "经验更丰富"),
+		beat(1, 0, "行业背景更契合"),
+		beat(0, 3, "技能更全面"),
+		beat(1, 3, "学历更匹配"),
+	}
+
+	items := rankRoundRobin(candidates, comparisons)
+	require.Len(t, items, 4)
+
+	assert.Equal(t, id(2), items[0].ResumeID)
+	assert.Equal(t, 3, items[0].Wins)
+	assert.Equal(t, 3, items[0].OriginalRanking)
+	assert.Contains(t, items[0].Justification, "核心职责覆盖更完整")
+
+	assert.Equal(t, id(1), items[1].ResumeID)
+	assert.Equal(t, id(0), items[2].ResumeID)
+
+	assert.Equal(t, id(3), items[3].ResumeID)
+	assert.Equal(t, 0, items[3].Wins)
+	assert.Contains(t, items[3].Justification, "负原排名第")
+
+	for i, item := range items {
+		assert.Equal(t, i+1, item.Position)
+	}
+}
+
+func TestFallbackComparison(t *testing.T) {
+	a := &domain.ShortlistCandidate{ResumeID: uuid.New(), Result: &domain.ScreeningResult{OverallScore: 70}}
+	b := &domain.ShortlistCandidate{ResumeID: uuid.New(), Result: &domain.ScreeningResult{OverallScore: 80}}
+
+	cmp := fallbackComparison(a, b)
+	assert.Equal(t, b.ResumeID, cmp.WinnerID)
+	assert.True(t, cmp.Fallback)
+}
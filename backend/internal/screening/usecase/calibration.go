@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/errcode"
+)
+
+// calibrationDateLayout 校准报表日期格式
+const calibrationDateLayout = "2006-01-02"
+
+// OverrideScreeningResult 人工校准筛选结果的综合得分、匹配等级或维度得分，模型原始输出保持不变
+func (u *ScreeningUsecase) OverrideScreeningResult(ctx context.Context, req *domain.OverrideScreeningResultReq) (*domain.OverrideScreeningResultResp, error) {
+	if req.OverallScore == nil && req.MatchLevel == nil && len(req.DimensionScores) == 0 {
+		return nil, errcode.ErrInvalidParam.WithData("message", "请至少校准综合得分、匹配等级或一个维度得分")
+	}
+
+	// 只允许校准结果中实际产出的维度，否则报表中模型得分会按 0 计算
+	if len(req.DimensionScores) > 0 {
+		existing, err := u.repo.GetScreeningResult(ctx, req.TaskID, req.ResumeID)
+		if err != nil {
+			if db.IsNotFound(err) {
+				return nil, errcode.ErrScreeningResultNotFound
+			}
+			return nil, fmt.Errorf("获取筛选结果失败: %w", err)
+		}
+		current, err := toScreeningResult(existing)
+		if err != nil {
+			return nil, fmt.Errorf("解析筛选结果失败: %w", err)
+		}
+		produced := agentDimensionScores(current)
+		for dimension := range req.DimensionScores {
+			if _, ok := produced[dimension]; !ok {
+				return nil, errcode.ErrInvalidParam.WithData("message", fmt.Sprintf("筛选结果中没有该维度的得分: %s", dimension))
+			}
+		}
+	}
+
+	entity, err := u.repo.UpdateScreeningResultOverride(ctx, req.TaskID, req.ResumeID, &domain.ScreeningResultOverride{
+		OverallScore:    req.OverallScore,
+		MatchLevel:      req.MatchLevel,
+		DimensionScores: req.DimensionScores,
+		Reason:          req.Reason,
+		OverriddenBy:    req.UserID,
+		OverriddenAt:    time.Now(),
+	})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errcode.ErrScreeningResultNotFound
+		}
+		return nil, fmt.Errorf("保存人工校准失败: %w", err)
+	}
+
+	result, err := toScreeningResult(entity)
+	if err != nil {
+		return nil, fmt.Errorf("解析筛选结果失败: %w", err)
+	}
+	return &domain.OverrideScreeningResultResp{Result: result}, nil
+}
+
+// ClearScreeningResultOverride 撤销人工校准，恢复使用模型输出
+func (u *ScreeningUsecase) ClearScreeningResultOverride(ctx context.Context, req *domain.GetScreeningResultReq) (*domain.OverrideScreeningResultResp, error) {
+	entity, err := u.repo.UpdateScreeningResultOverride(ctx, req.TaskID, req.ResumeID, nil)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errcode.ErrScreeningResultNotFound
+		}
+		return nil, fmt.Errorf("撤销人工校准失败: %w", err)
+	}
+
+	result, err := toScreeningResult(entity)
+	if err != nil {
+		return nil, fmt.Errorf("解析筛选结果失败: %w", err)
+	}
+	return &domain.OverrideScreeningResultResp{Result: result}, nil
+}
+
+// GetScreeningCalibrationReport 对比人工校准与模型输出，按维度和子Agent版本统计偏差
+func (u *ScreeningUsecase) GetScreeningCalibrationReport(ctx context.Context, req *domain.GetScreeningCalibrationReq) (*domain.GetScreeningCalibrationResp, error) {
+	filter := &domain.ScreeningCalibrationFilter{JobPositionID: req.JobPositionID}
+	if req.StartDate != "" {
+		start, err := time.ParseInLocation(calibrationDateLayout, req.StartDate, time.Local)
+		if err != nil {
+			return nil, errcode.ErrInvalidParam.WithData("message", "开始日期格式应为 YYYY-MM-DD")
+		}
+		filter.Start = &start
+	}
+	if req.EndDate != "" {
+		end, err := time.ParseInLocation(calibrationDateLayout, req.EndDate, time.Local)
+		if err != nil {
+			return nil, errcode.ErrInvalidParam.WithData("message", "截止日期格式应为 YYYY-MM-DD")
+		}
+		end = end.AddDate(0, 0, 1)
+		filter.End = &end
+	}
+
+	entities, err := u.repo.ListOverriddenScreeningResults(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("获取人工校准记录失败: %w", err)
+	}
+
+	results := make([]*domain.ScreeningResult, 0, len(entities))
+	for _, entity := range entities {
+		result, err := toScreeningResult(entity)
+		if err != nil || result == nil || result.Override == nil {
+			continue
+		}
+		results = append(results, result)
+	}
+
+	return &domain.GetScreeningCalibrationResp{
+		TotalOverrides: len(results),
+		Items:          aggregateCalibration(results),
+	}, nil
+}
+
+type calibrationKey struct {
+	dimension string
+	version   string
+}
+
+// aggregateCalibration 按维度与子Agent版本汇总人工得分与模型得分的偏差，结果按平均绝对偏差降序排列
+func aggregateCalibration(results []*domain.ScreeningResult) []*domain.ScreeningCalibrationItem {
+	groups := make(map[calibrationKey]*domain.ScreeningCalibrationItem)
+	sums := make(map[calibrationKey]*[2]float64)
+
+	record := func(result *domain.ScreeningResult, dimension string, agentScore float64, humanScore *float64, levelChanged bool) {
+		agent := domain.ScreeningDimensionAgent(dimension)
+		key := calibrationKey{dimension: dimension, version: subAgentVersion(result, agent)}
+		item, ok := groups[key]
+		if !ok {
+			item = &domain.ScreeningCalibrationItem{Dimension: dimension, Agent: agent, AgentVersion: key.version}
+			groups[key] = item
+			sums[key] = &[2]float64{}
+		}
+		if levelChanged {
+			item.LevelChangedCount++
+		}
+		if humanScore == nil {
+			return
+		}
+
+		delta := *humanScore - agentScore
+		item.Count++
+		sums[key][0] += delta
+		sums[key][1] += math.Abs(delta)
+		switch {
+		case delta > 0:
+			item.RaisedCount++
+		case delta < 0:
+			item.LoweredCount++
+		}
+	}
+
+	for _, result := range results {
+		override := result.Override
+		levelChanged := override.MatchLevel != nil && *override.MatchLevel != result.MatchLevel
+		if override.OverallScore != nil || levelChanged {
+			record(result, domain.ScreeningCalibrationOverall, result.OverallScore, override.OverallScore, levelChanged)
+		}
+
+		agentScores := agentDimensionScores(result)
+		for dimension, humanScore := range override.DimensionScores {
+			// 历史数据中可能存在结果未产出的维度，缺少模型得分时无法比较偏差
+			agentScore, ok := agentScores[dimension]
+			if !ok {
+				continue
+			}
+			humanScore := humanScore
+			record(result, dimension, agentScore, &humanScore, false)
+		}
+	}
+
+	items := make([]*domain.ScreeningCalibrationItem, 0, len(groups))
+	for key, item := range groups {
+		if item.Count > 0 {
+			item.MeanDelta = math.Round(sums[key][0]/float64(item.Count)*100) / 100
+			item.MeanAbsDelta = math.Round(sums[key][1]/float64(item.Count)*100) / 100
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].MeanAbsDelta != items[j].MeanAbsDelta {
+			return items[i].MeanAbsDelta > items[j].MeanAbsDelta
+		}
+		if items[i].Dimension != items[j].Dimension {
+			return items[i].Dimension < items[j].Dimension
+		}
+		return items[i].AgentVersion < items[j].AgentVersion
+	})
+	return items
+}
+
+// agentDimensionScores 提取模型输出的各维度得分，包括岗位自定义维度
+func agentDimensionScores(result *domain.ScreeningResult) map[string]float64 {
+	scores := make(map[string]float64)
+	if result == nil {
+		return scores
+	}
+	if result.SkillDetail != nil {
+		scores["skill"] = result.SkillDetail.Score
+	}
+	if result.Responsibility != nil {
+		scores["responsibility"] = result.Responsibility.Score
+	}
+	if result.ExperienceDetail != nil {
+		scores["experience"] = result.ExperienceDetail.Score
+	}
+	if result.EducationDetail != nil {
+		scores["education"] = result.EducationDetail.Score
+	}
+	if result.IndustryDetail != nil {
+		scores["industry"] = result.IndustryDetail.Score
+	}
+	if result.BasicDetail != nil {
+		scores["basic"] = result.BasicDetail.Score
+	}
+	for key, detail := range result.CustomDetails {
+		if detail != nil {
+			scores[key] = detail.Score
+		}
+	}
+	return scores
+}
+
+// subAgentVersion 从结果的版本快照中读取子Agent版本，缺失时返回 unknown
+func subAgentVersion(result *domain.ScreeningResult, agent string) string {
+	if version, ok := result.SubAgentVersions[agent].(string); ok && version != "" {
+		return version
+	}
+	return "unknown"
+}
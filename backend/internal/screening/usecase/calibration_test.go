@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+type calibrationRepo struct {
+	domain.ScreeningRepo
+	result *db.ScreeningResult
+	saved  *domain.ScreeningResultOverride
+}
+
+func (r *calibrationRepo) GetScreeningResult(context.Context, uuid.UUID, uuid.UUID) (*db.ScreeningResult, error) {
+	return r.result, nil
+}
+
+func (r *calibrationRepo) UpdateScreeningResultOverride(_ context.Context, _, _ uuid.UUID, override *domain.ScreeningResultOverride) (*db.ScreeningResult, error) {
+	r.saved = override
+	return r.result, nil
+}
+
+func TestAggregateCalibration(t *testing.T) {
+	overall := 60.0
+	level := consts.MatchLevelFair
+	results := []*domain.ScreeningResult{
+		{
+			OverallScore:     80,
+			MatchLevel:       consts.MatchLevelGood,
+			SkillDetail:      &domain.SkillMatchDetail{Score: 90},
+			SubAgentVersions: map[string]any{domain.SkillAgent: "1.0.0", domain.AggregatorAgent: "1.1.0"},
+			Override: &domain.ScreeningResultOverride{
+				OverallScore:    &overall,
+				MatchLevel:      &level,
+				DimensionScores: map[string]float64{"skill": 70},
+			},
+		},
+		{
+			SkillDetail:      &domain.SkillMatchDetail{Score: 50},
+			SubAgentVersions: map[string]any{domain.SkillAgent: "1.0.0"},
+			Override: &domain.ScreeningResultOverride{
+				DimensionScores: map[string]float64{"skill": 60},
+			},
+		},
+	}
+
+	items := aggregateCalibration(results)
+	require.Len(t, items, 2)
+
+	// overall: 60 - 80 = -20
+	assert.Equal(t, domain.ScreeningCalibrationOverall, items[0].Dimension)
+	assert.Equal(t, "1.1.0", items[0].AgentVersion)
+	assert.Equal(t, -20.0, items[0].MeanDelta)
+	assert.Equal(t, 1, items[0].LevelChangedCount)
+
+	// skill: (-20 + 10) / 2 = -5，平均绝对偏差 15
+	assert.Equal(t, "skill", items[1].Dimension)
+	assert.Equal(t, domain.SkillAgent, items[1].Agent)
+	assert.Equal(t, 2, items[1].Count)
+	assert.Equal(t, -5.0, items[1].MeanDelta)
+	assert.Equal(t, 15.0, items[1].MeanAbsDelta)
+	assert.Equal(t, 1, items[1].RaisedCount)
+	assert.Equal(t, 1, items[1].LoweredCount)
+}
+
+func TestAggregateCalibrationCustomAndMissingDimensions(t *testing.T) {
+	results := []*domain.ScreeningResult{
+		{
+			CustomDetails:    map[string]*domain.CustomMatchDetail{"open_source": {Score: 40}},
+			SubAgentVersions: map[string]any{domain.CustomDimensionNodeKey("open_source"): "1.0.0"},
+			Override: &domain.ScreeningResultOverride{
+				// basic 维度未产出结果，不应按模型得分 0 计入偏差
+				DimensionScores: map[string]float64{"open_source": 70, "basic": 90},
+			},
+		},
+	}
+
+	items := aggregateCalibration(results)
+	require.Len(t, items, 1)
+	assert.Equal(t, "open_source", items[0].Dimension)
+	assert.Equal(t, domain.CustomDimensionNodeKey("open_source"), items[0].Agent)
+	assert.Equal(t, "1.0.0", items[0].AgentVersion)
+	assert.Equal(t, 30.0, items[0].MeanDelta)
+}
+
+func TestOverrideScreeningResultDimensions(t *testing.T) {
+	repo := &calibrationRepo{result: &db.ScreeningResult{
+		SkillDetail:   map[string]interface{}{"score": 80.0},
+		CustomDetails: map[string]interface{}{"open_source": map[string]interface{}{"score": 40.0}},
+	}}
+	u := &ScreeningUsecase{repo: repo}
+
+	// 结果中没有基本信息维度，不能校准
+	_, err := u.OverrideScreeningResult(context.Background(), &domain.OverrideScreeningResultReq{
+		DimensionScores: map[string]float64{"basic": 90},
+		Reason:          "补充面试信息",
+	})
+	require.Error(t, err)
+	assert.Nil(t, repo.saved)
+
+	// 内置维度与岗位自定义维度均可校准
+	_, err = u.OverrideScreeningResult(context.Background(), &domain.OverrideScreeningResultReq{
+		DimensionScores: map[string]float64{"skill": 70, "open_source": 60},
+		Reason:          "面试确认",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, repo.saved)
+	assert.Equal(t, map[string]float64{"skill": 70, "open_source": 60}, repo.saved.DimensionScores)
+}
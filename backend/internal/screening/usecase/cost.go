@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/errcode"
+)
+
+// costReportMonthLayout 费用报表月份格式
+const costReportMonthLayout = "2006-01"
+
+// GetScreeningCostReport 按部门、创建人或岗位汇总指定月份的筛选模型费用
+func (u *ScreeningUsecase) GetScreeningCostReport(ctx context.Context, req *domain.GetScreeningCostReportReq) (*domain.GetScreeningCostReportResp, error) {
+	groupBy := req.GroupBy
+	if groupBy == "" {
+		groupBy = domain.ScreeningCostGroupByDepartment
+	}
+
+	month := req.Month
+	if month == "" {
+		month = time.Now().Format(costReportMonthLayout)
+	}
+	start, err := time.ParseInLocation(costReportMonthLayout, month, time.Local)
+	if err != nil {
+		return nil, errcode.ErrInvalidParam.WithData("message", "月份格式应为 YYYY-MM")
+	}
+	end := start.AddDate(0, 1, 0)
+
+	usages, err := u.repo.ListScreeningTaskUsage(ctx, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("获取筛选用量失败: %w", err)
+	}
+
+	items, total := aggregateScreeningCost(usages, groupBy)
+	return &domain.GetScreeningCostReportResp{
+		Month:    month,
+		GroupBy:  groupBy,
+		Currency: u.config.LLMPricing.Currency,
+		Items:    items,
+		Total:    total,
+	}, nil
+}
+
+// aggregateScreeningCost 将任务用量按维度分组合计，结果按费用降序排列
+func aggregateScreeningCost(usages []*domain.ScreeningTaskUsage, groupBy domain.ScreeningCostGroupBy) ([]*domain.ScreeningCostReportItem, *domain.ScreeningCostReportItem) {
+	total := &domain.ScreeningCostReportItem{GroupName: "合计"}
+	groups := make(map[uuid.UUID]*domain.ScreeningCostReportItem)
+	for _, usage := range usages {
+		var id uuid.UUID
+		var name string
+		switch groupBy {
+		case domain.ScreeningCostGroupByUser:
+			id, name = usage.CreatedBy, usage.CreatorName
+		case domain.ScreeningCostGroupByJob:
+			id, name = usage.JobPositionID, usage.JobName
+		default:
+			id, name = usage.DepartmentID, usage.DepartmentName
+		}
+
+		item, ok := groups[id]
+		if !ok {
+			item = &domain.ScreeningCostReportItem{GroupID: id, GroupName: name}
+			groups[id] = item
+		}
+		for _, acc := range []*domain.ScreeningCostReportItem{item, total} {
+			acc.TaskCount++
+			acc.ResumeCount += usage.ResumeCount
+			acc.TokensInput += usage.TokensInput
+			acc.TokensOutput += usage.TokensOutput
+			acc.TotalCost += usage.TotalCost
+		}
+	}
+
+	items := make([]*domain.ScreeningCostReportItem, 0, len(groups))
+	for _, item := range groups {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].TotalCost != items[j].TotalCost {
+			return items[i].TotalCost > items[j].TotalCost
+		}
+		return items[i].GroupName < items[j].GroupName
+	})
+	return items, total
+}
+
+// validateCostBudgetModel 校验任务使用的模型已配置价格。
+// 未配置价格的模型费用恒为 0，费用预算永远不会触发，因此拒绝为其设置费用预算
+func (u *ScreeningUsecase) validateCostBudgetModel(llmConfig map[string]any) error {
+	key, priced, err := u.matcher.ModelPriced(llmConfig)
+	if err != nil {
+		return errcode.ErrInvalidParam.WithData("message", fmt.Sprintf("LLM配置无效: %v", err))
+	}
+	if !priced {
+		return errcode.ErrInvalidParam.WithData("message",
+			fmt.Sprintf("模型 %s 未配置价格，无法使用费用预算，请在 llm_pricing 配置中添加该模型价格或改用 Token 预算", key.Name))
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/errcode"
+)
+
+// CreateScreeningDimension 为岗位新增自定义匹配维度，后续创建的筛选任务会额外执行该维度的评估
+func (u *ScreeningUsecase) CreateScreeningDimension(ctx context.Context, req *domain.CreateScreeningDimensionReq) (*domain.CustomDimension, error) {
+	key := strings.TrimSpace(req.Key)
+	if err := domain.ValidateCustomDimensionKey(key); err != nil {
+		return nil, errcode.ErrInvalidParam.WithData("message", err.Error())
+	}
+	if _, err := u.jobUsecase.GetByID(ctx, req.JobPositionID.String()); err != nil {
+		return nil, fmt.Errorf("获取岗位信息失败: %w", err)
+	}
+
+	existing, err := u.dimensionRepo.ListByJobPosition(ctx, req.JobPositionID)
+	if err != nil {
+		return nil, fmt.Errorf("获取岗位自定义维度失败: %w", err)
+	}
+	if len(existing) >= domain.MaxCustomDimensionsPerJob {
+		return nil, errcode.ErrInvalidParam.WithData("message", fmt.Sprintf("每个岗位最多定义 %d 个自定义维度", domain.MaxCustomDimensionsPerJob))
+	}
+	for _, dim := range existing {
+		if dim.Key == key {
+			return nil, errcode.ErrInvalidParam.WithData("message", fmt.Sprintf("维度标识 %s 已存在", key))
+		}
+	}
+
+	entity := &db.ScreeningDimension{
+		JobPositionID: req.JobPositionID,
+		Key:           key,
+		Name:          strings.TrimSpace(req.Name),
+		Description:   strings.TrimSpace(req.Description),
+		Prompt:        strings.TrimSpace(req.Prompt),
+		Weight:        req.Weight,
+	}
+	if req.CreatedBy != uuid.Nil {
+		entity.CreatedBy = &req.CreatedBy
+	}
+
+	created, err := u.dimensionRepo.Create(ctx, entity)
+	if err != nil {
+		return nil, fmt.Errorf("创建自定义维度失败: %w", err)
+	}
+
+	u.logger.Info("岗位自定义维度已创建",
+		slog.Any("job_position_id", req.JobPositionID),
+		slog.String("key", key))
+
+	return (&domain.CustomDimension{}).From(created), nil
+}
+
+// ListScreeningDimensions 查询岗位的自定义匹配维度
+func (u *ScreeningUsecase) ListScreeningDimensions(ctx context.Context, req *domain.ListScreeningDimensionsReq) (*domain.ListScreeningDimensionsResp, error) {
+	items, err := u.listCustomDimensions(ctx, req.JobPositionID)
+	if err != nil {
+		return nil, err
+	}
+	if items == nil {
+		items = []*domain.CustomDimension{}
+	}
+	return &domain.ListScreeningDimensionsResp{Items: items}, nil
+}
+
+// UpdateScreeningDimension 更新自定义维度，已创建的任务使用创建时的快照，不受影响
+func (u *ScreeningUsecase) UpdateScreeningDimension(ctx context.Context, req *domain.UpdateScreeningDimensionReq) (*domain.CustomDimension, error) {
+	if _, err := u.getScreeningDimension(ctx, req.ID); err != nil {
+		return nil, err
+	}
+
+	updated, err := u.dimensionRepo.Update(ctx, req.ID, map[string]any{
+		"name":        strings.TrimSpace(req.Name),
+		"description": strings.TrimSpace(req.Description),
+		"prompt":      strings.TrimSpace(req.Prompt),
+		"weight":      req.Weight,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("更新自定义维度失败: %w", err)
+	}
+	return (&domain.CustomDimension{}).From(updated), nil
+}
+
+// DeleteScreeningDimension 删除自定义维度
+func (u *ScreeningUsecase) DeleteScreeningDimension(ctx context.Context, id uuid.UUID) error {
+	if _, err := u.getScreeningDimension(ctx, id); err != nil {
+		return err
+	}
+	if err := u.dimensionRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("删除自定义维度失败: %w", err)
+	}
+	return nil
+}
+
+func (u *ScreeningUsecase) getScreeningDimension(ctx context.Context, id uuid.UUID) (*db.ScreeningDimension, error) {
+	entity, err := u.dimensionRepo.GetByID(ctx, id)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errcode.ErrScreeningDimensionNotFound
+		}
+		return nil, fmt.Errorf("获取自定义维度失败: %w", err)
+	}
+	return entity, nil
+}
+
+// listCustomDimensions 查询岗位自定义维度并转换为领域模型
+func (u *ScreeningUsecase) listCustomDimensions(ctx context.Context, jobPositionID uuid.UUID) ([]*domain.CustomDimension, error) {
+	entities, err := u.dimensionRepo.ListByJobPosition(ctx, jobPositionID)
+	if err != nil {
+		return nil, fmt.Errorf("获取岗位自定义维度失败: %w", err)
+	}
+	dims := make([]*domain.CustomDimension, 0, len(entities))
+	for _, entity := range entities {
+		dims = append(dims, (&domain.CustomDimension{}).From(entity))
+	}
+	return dims, nil
+}
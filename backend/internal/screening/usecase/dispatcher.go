@@ -0,0 +1,407 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db"
+)
+
+const (
+	// cancellationPollInterval 处理简历期间轮询任务状态的间隔，用于感知其他副本发起的取消，同时刷新简历的心跳时间
+	cancellationPollInterval = 3 * time.Second
+	// defaultResumeStaleTimeout 运行中的简历超过该时间未更新则视为处理者已退出，可被重新认领
+	defaultResumeStaleTimeout = 5 * time.Minute
+)
+
+// ErrTaskResumeBusy 简历正由其他 Worker 处理，消息不确认，等待稍后重新投递
+var ErrTaskResumeBusy = errors.New("screening task resume is being processed by another worker")
+
+// dispatchTaskResumes 将任务内待处理的简历逐份投递到筛选队列
+func (u *ScreeningUsecase) dispatchTaskResumes(ctx context.Context, taskID uuid.UUID, taskResumes []*db.ScreeningTaskResume) error {
+	messages := make([]map[string]interface{}, 0, len(taskResumes))
+	for _, item := range taskResumes {
+		if item.Status != string(consts.ScreeningTaskResumeStatusPending) &&
+			item.Status != string(consts.ScreeningTaskResumeStatusRunning) {
+			continue
+		}
+		messages = append(messages, map[string]interface{}{
+			"task_id":   taskID.String(),
+			"resume_id": item.ResumeID.String(),
+		})
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+
+	if err := u.producer.PublishBatch(ctx, string(consts.QueueStreamScreeningTaskResumes), messages); err != nil {
+		return fmt.Errorf("投递筛选任务失败: %w", err)
+	}
+	return nil
+}
+
+// ProcessTaskResume 处理队列投递的单份任务简历。
+// 返回 nil 表示消息可以确认（包括任务已结束、简历已处理等无需重复执行的情况）；
+// 返回错误时消息保留在待确认列表中，由队列在空闲超时后重新投递。
+func (u *ScreeningUsecase) ProcessTaskResume(ctx context.Context, taskID, resumeID uuid.UUID) error {
+	task, err := u.repo.GetScreeningTask(ctx, taskID)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("获取筛选任务失败: %w", err)
+	}
+	if task.Status != string(consts.ScreeningTaskStatusRunning) {
+		return nil
+	}
+
+	claimed, err := u.repo.ClaimScreeningTaskResume(ctx, taskID, resumeID, time.Now().Add(-u.resumeStaleTimeout()))
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		item, err := u.repo.GetScreeningTaskResume(ctx, taskID, resumeID)
+		if err != nil {
+			if db.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("获取任务简历失败: %w", err)
+		}
+		if item.Status == string(consts.ScreeningTaskResumeStatusRunning) {
+			return ErrTaskResumeBusy
+		}
+		// 简历已处理完成，可能是上一次确认消息前进程退出，补充检查任务是否结束
+		u.finalizeIfDone(ctx, taskID)
+		return nil
+	}
+
+	// 认领后再检查预算，避免重复投递的已完成简历误触发暂停
+	if reason := budgetExceededReason(task); reason != "" {
+		if err := u.repo.UpdateScreeningTaskResume(ctx, taskID, resumeID, map[string]any{
+			"status": consts.ScreeningTaskResumeStatusPending,
+		}); err != nil {
+			return fmt.Errorf("重置任务简历状态失败: %w", err)
+		}
+		u.pauseTask(ctx, taskID, reason)
+		return nil
+	}
+
+	item, err := u.repo.GetScreeningTaskResume(ctx, taskID, resumeID)
+	if err != nil {
+		return fmt.Errorf("获取任务简历失败: %w", err)
+	}
+
+	jobDetail, err := u.jobUsecase.GetByID(ctx, task.JobPositionID.String())
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if updateErr := u.repo.UpdateScreeningTaskResume(ctx, taskID, resumeID, map[string]any{
+			"status":        consts.ScreeningTaskResumeStatusFailed,
+			"error_message": fmt.Sprintf("获取岗位信息失败: %v", err),
+			"processed_at":  time.Now(),
+		}); updateErr != nil {
+			u.logger.Error("更新简历状态失败", slog.Any("task_id", taskID), slog.Any("resume_id", resumeID), slog.Any("err", updateErr))
+		}
+		u.finalizeIfDone(ctx, taskID)
+		return nil
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var cancelled atomic.Bool
+	go u.watchCancellation(runCtx, cancel, &cancelled, taskID, resumeID)
+
+	result := u.processResumeItem(runCtx, task, item, jobDetail)
+	if result.Interrupted {
+		if !cancelled.Load() {
+			// 进程退出导致中断，保留消息等待重新投递
+			return fmt.Errorf("筛选处理被中断: %w", context.Cause(runCtx))
+		}
+		if err := u.repo.UpdateScreeningTaskResume(ctx, taskID, resumeID, map[string]any{
+			"status": consts.ScreeningTaskResumeStatusCancelled,
+		}); err != nil {
+			u.logger.Warn("更新简历取消状态失败", slog.Any("task_id", taskID), slog.Any("resume_id", resumeID), slog.Any("err", err))
+		}
+		return nil
+	}
+
+	u.finalizeIfDone(ctx, taskID)
+	return nil
+}
+
+// watchCancellation 轮询任务状态，任务被取消或删除时中断当前简历的处理；
+// 同时刷新简历的更新时间，避免处理耗时较长时被其他 Worker 视为失联而重复认领
+func (u *ScreeningUsecase) watchCancellation(ctx context.Context, cancel context.CancelFunc, cancelled *atomic.Bool, taskID, resumeID uuid.UUID) {
+	ticker := time.NewTicker(cancellationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		task, err := u.repo.GetScreeningTask(ctx, taskID)
+		if err != nil && !db.IsNotFound(err) {
+			continue
+		}
+		// 预算暂停时允许正在处理的简历继续完成
+		if err != nil || (task.Status != string(consts.ScreeningTaskStatusRunning) &&
+			task.Status != string(consts.ScreeningTaskStatusPaused)) {
+			cancelled.Store(true)
+			cancel()
+			return
+		}
+
+		if err := u.repo.TouchScreeningTaskResume(ctx, taskID, resumeID); err != nil && ctx.Err() == nil {
+			u.logger.Warn("刷新简历处理心跳失败", slog.Any("task_id", taskID), slog.Any("resume_id", resumeID), slog.Any("err", err))
+		}
+	}
+}
+
+// finalizeIfDone 汇总任务进度，所有简历处理完毕后结束任务。
+// 多个 Worker 可能同时处理最后几份简历，通过条件更新保证只有一个 Worker 执行收尾逻辑
+func (u *ScreeningUsecase) finalizeIfDone(ctx context.Context, taskID uuid.UUID) {
+	taskResumes, err := u.repo.ListAllScreeningTaskResumes(ctx, taskID)
+	if err != nil {
+		u.logger.Warn("获取任务简历列表失败", slog.Any("task_id", taskID), slog.Any("err", err))
+		return
+	}
+
+	collector := NewResultCollector()
+	unfinished := 0
+	for _, item := range taskResumes {
+		switch item.Status {
+		case string(consts.ScreeningTaskResumeStatusCompleted):
+			collector.CollectResult(ProcessResult{
+				ResumeID:    item.ResumeID,
+				Success:     true,
+				Score:       item.Score,
+				MatchLevel:  toMatchLevel(item.Score),
+				TokenInput:  item.TokensInput,
+				TokenOutput: item.TokensOutput,
+				TotalCost:   item.TotalCost,
+			})
+		case string(consts.ScreeningTaskResumeStatusFailed):
+			collector.CollectResult(ProcessResult{
+				ResumeID:    item.ResumeID,
+				TokenInput:  item.TokensInput,
+				TokenOutput: item.TokensOutput,
+				TotalCost:   item.TotalCost,
+			})
+		case string(consts.ScreeningTaskResumeStatusPending), string(consts.ScreeningTaskResumeStatusRunning):
+			unfinished++
+		}
+	}
+
+	processed, succeeded, failed, scoreSum, scoreCnt, histogram, tokenInput, tokenOutput := collector.GetStats()
+	totalCost := collector.TotalCost()
+
+	if unfinished > 0 {
+		// 任务可能处于运行中或预算暂停，进度与用量均需更新，供预算检查使用
+		if err := u.repo.UpdateScreeningTask(ctx, taskID, map[string]any{
+			"resume_processed": processed,
+			"resume_succeeded": succeeded,
+			"resume_failed":    failed,
+			"tokens_input":     tokenInput,
+			"tokens_output":    tokenOutput,
+			"total_cost":       totalCost,
+		}); err != nil {
+			u.logger.Warn("更新任务进度失败", slog.Any("task_id", taskID), slog.Any("err", err))
+		}
+		return
+	}
+
+	finalStatus := consts.ScreeningTaskStatusCompleted
+	if failed > 0 {
+		finalStatus = consts.ScreeningTaskStatusFailed
+	}
+
+	finished, err := u.repo.UpdateScreeningTaskIfStatus(ctx, taskID, consts.ScreeningTaskStatusRunning, map[string]any{
+		"status":           finalStatus,
+		"finished_at":      time.Now(),
+		"resume_processed": processed,
+		"resume_succeeded": succeeded,
+		"resume_failed":    failed,
+		"tokens_input":     tokenInput,
+		"tokens_output":    tokenOutput,
+		"total_cost":       totalCost,
+		"agent_version":    u.matcher.Version(),
+	})
+	if err != nil {
+		u.logger.Warn("更新任务完成状态失败", slog.Any("task_id", taskID), slog.Any("err", err))
+		return
+	}
+	if !finished {
+		// 任务已被其他 Worker 结束或已取消
+		return
+	}
+
+	u.logger.Info("screening task processing completed",
+		"task_id", taskID,
+		"processed", processed,
+		"succeeded", succeeded,
+		"failed", failed,
+		"scoreSum", scoreSum,
+		"scoreCnt", scoreCnt,
+		"histogram", histogram,
+		"tokenInput", tokenInput,
+		"tokenOutput", tokenOutput,
+		"totalCost", totalCost,
+	)
+
+	if succeeded > 0 {
+		if err := u.updateResumeRankings(ctx, taskID); err != nil {
+			u.logger.Warn("更新简历排名失败", slog.Any("task_id", taskID), slog.Any("err", err))
+		}
+	}
+
+	if u.notificationUsecase != nil {
+		task, err := u.repo.GetScreeningTask(ctx, taskID)
+		if err != nil {
+			u.logger.Warn("获取筛选任务失败", slog.Any("task_id", taskID), slog.Any("err", err))
+		} else {
+			u.publishScreeningTaskCompletedNotification(ctx, task, processed, succeeded, safeAvg(scoreSum, scoreCnt))
+		}
+	}
+
+	metric := &db.ScreeningRunMetric{
+		TaskID:       taskID,
+		AvgScore:     safeAvg(scoreSum, scoreCnt),
+		Histogram:    convertHistogramToMap(histogram),
+		TokensInput:  tokenInput,
+		TokensOutput: tokenOutput,
+		TotalCost:    totalCost,
+	}
+	if _, err := u.repo.CreateScreeningRunMetric(ctx, metric); err != nil {
+		u.logger.Warn("保存运行指标失败", slog.Any("task_id", taskID), slog.Any("err", err))
+	}
+}
+
+// pauseTask 预算耗尽时暂停任务，未处理的简历保持待处理状态，继续任务后重新投递
+func (u *ScreeningUsecase) pauseTask(ctx context.Context, taskID uuid.UUID, reason string) {
+	paused, err := u.repo.UpdateScreeningTaskIfStatus(ctx, taskID, consts.ScreeningTaskStatusRunning, map[string]any{
+		"status": consts.ScreeningTaskStatusPaused,
+	})
+	if err != nil {
+		u.logger.Warn("暂停筛选任务失败", slog.Any("task_id", taskID), slog.Any("err", err))
+		return
+	}
+	if paused {
+		u.logger.Info("筛选任务预算耗尽，已暂停", slog.Any("task_id", taskID), slog.String("reason", reason))
+	}
+}
+
+// budgetExceededReason 判断处理下一份简历是否会超出任务预算。
+// 以已处理简历的平均用量估算下一份简历的消耗，返回超出原因，未超出时返回空字符串
+func budgetExceededReason(task *db.ScreeningTask) string {
+	processed := int64(task.ResumeProcessed)
+
+	if task.TokenBudget != nil {
+		used := task.TokensInput + task.TokensOutput
+		var next int64
+		if processed > 0 {
+			next = used / processed
+		}
+		if used+next > *task.TokenBudget {
+			return fmt.Sprintf("token 预算不足: 已使用 %d，预算 %d", used, *task.TokenBudget)
+		}
+	}
+
+	if task.CostBudget != nil {
+		var next float64
+		if processed > 0 {
+			next = task.TotalCost / float64(processed)
+		}
+		if task.TotalCost+next > *task.CostBudget {
+			return fmt.Sprintf("费用预算不足: 已使用 %.4f，预算 %.4f", task.TotalCost, *task.CostBudget)
+		}
+	}
+
+	return ""
+}
+
+// RecoverRunningTasks 恢复仍处于运行中的任务：重新投递心跳超时的简历，
+// 简历已全部处理完成但任务未结束的（收尾前进程退出）直接执行收尾。
+// 各副本启动及定期巡检时都会调用，通过咨询锁保证同一时刻只有一个副本执行；
+// 心跳未超时的简历仍由原处理者持有或消息仍在队列中，不重复投递，避免占用其投递次数
+func (u *ScreeningUsecase) RecoverRunningTasks(ctx context.Context) error {
+	acquired, err := u.repo.WithScreeningRecoveryLock(ctx, u.recoverRunningTasks)
+	if err != nil {
+		return err
+	}
+	if !acquired {
+		u.logger.Debug("其他副本正在恢复筛选任务，跳过本次恢复")
+	}
+	return nil
+}
+
+func (u *ScreeningUsecase) recoverRunningTasks(ctx context.Context) error {
+	taskIDs, err := u.repo.ListScreeningTaskIDsByStatus(ctx, consts.ScreeningTaskStatusRunning)
+	if err != nil {
+		return fmt.Errorf("获取运行中的筛选任务失败: %w", err)
+	}
+
+	staleBefore := time.Now().Add(-u.resumeStaleTimeout())
+	for _, taskID := range taskIDs {
+		taskResumes, err := u.repo.ListAllScreeningTaskResumes(ctx, taskID)
+		if err != nil {
+			u.logger.Warn("获取任务简历列表失败", slog.Any("task_id", taskID), slog.Any("err", err))
+			continue
+		}
+
+		stale := staleTaskResumes(taskResumes, staleBefore)
+		if err := u.dispatchTaskResumes(ctx, taskID, stale); err != nil {
+			u.logger.Warn("重新投递筛选任务失败", slog.Any("task_id", taskID), slog.Any("err", err))
+			continue
+		}
+		pendingIDs := make([]uuid.UUID, 0, len(stale))
+		for _, item := range stale {
+			if item.Status == string(consts.ScreeningTaskResumeStatusPending) {
+				pendingIDs = append(pendingIDs, item.ResumeID)
+			}
+		}
+		if err := u.repo.TouchPendingScreeningTaskResumes(ctx, taskID, pendingIDs); err != nil {
+			u.logger.Warn("刷新待处理简历投递时间失败", slog.Any("task_id", taskID), slog.Any("err", err))
+		}
+		u.finalizeIfDone(ctx, taskID)
+		if len(stale) > 0 {
+			u.logger.Info("已恢复运行中的筛选任务", slog.Any("task_id", taskID), slog.Int("redispatched", len(stale)))
+		}
+	}
+
+	return nil
+}
+
+// staleTaskResumes 筛选更新时间早于 staleBefore 的未完成简历：
+// 运行中的简历心跳超时说明处理者已退出，待处理的简历长时间未被认领说明消息可能已丢失
+func staleTaskResumes(items []*db.ScreeningTaskResume, staleBefore time.Time) []*db.ScreeningTaskResume {
+	stale := make([]*db.ScreeningTaskResume, 0)
+	for _, item := range items {
+		if item.Status != string(consts.ScreeningTaskResumeStatusPending) &&
+			item.Status != string(consts.ScreeningTaskResumeStatusRunning) {
+			continue
+		}
+		if item.UpdatedAt.Before(staleBefore) {
+			stale = append(stale, item)
+		}
+	}
+	return stale
+}
+
+// resumeStaleTimeout 运行中简历的失联判定时间，与队列的待确认消息超时保持一致
+func (u *ScreeningUsecase) resumeStaleTimeout() time.Duration {
+	if u.config != nil && u.config.Queue.PendingTimeout > 0 {
+		return time.Duration(u.config.Queue.PendingTimeout) * time.Second
+	}
+	return defaultResumeStaleTimeout
+}
@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/internal/screening/service"
+	"github.com/chaitin/WhaleHire/backend/pkg/eino/models"
+)
+
+type fakeScreeningRepo struct {
+	domain.ScreeningRepo
+	task    *db.ScreeningTask
+	resumes []*db.ScreeningTaskResume
+	metrics int
+}
+
+func (f *fakeScreeningRepo) GetScreeningTask(context.Context, uuid.UUID) (*db.ScreeningTask, error) {
+	task := *f.task
+	return &task, nil
+}
+
+func (f *fakeScreeningRepo) UpdateScreeningTaskIfStatus(_ context.Context, _ uuid.UUID, status consts.ScreeningTaskStatus, updates map[string]any) (bool, error) {
+	if f.task.Status != string(status) {
+		return false, nil
+	}
+	if s, ok := updates["status"].(consts.ScreeningTaskStatus); ok {
+		f.task.Status = string(s)
+	}
+	if n, ok := updates["resume_processed"].(int); ok {
+		f.task.ResumeProcessed = n
+	}
+	return true, nil
+}
+
+func (f *fakeScreeningRepo) UpdateScreeningTask(_ context.Context, _ uuid.UUID, updates map[string]any) error {
+	if n, ok := updates["resume_processed"].(int); ok {
+		f.task.ResumeProcessed = n
+	}
+	return nil
+}
+
+func (f *fakeScreeningRepo) UpdateScreeningTaskResume(_ context.Context, _, resumeID uuid.UUID, updates map[string]any) error {
+	for _, item := range f.resumes {
+		if item.ResumeID != resumeID {
+			continue
+		}
+		if s, ok := updates["status"].(consts.ScreeningTaskResumeStatus); ok {
+			item.Status = string(s)
+		}
+	}
+	return nil
+}
+
+func (f *fakeScreeningRepo) ClaimScreeningTaskResume(_ context.Context, _, resumeID uuid.UUID, staleBefore time.Time) (bool, error) {
+	for _, item := range f.resumes {
+		if item.ResumeID != resumeID {
+			continue
+		}
+		if item.Status == string(consts.ScreeningTaskResumeStatusPending) ||
+			(item.Status == string(consts.ScreeningTaskResumeStatusRunning) && item.UpdatedAt.Before(staleBefore)) {
+			item.Status = string(consts.ScreeningTaskResumeStatusRunning)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *fakeScreeningRepo) GetScreeningTaskResume(_ context.Context, _, resumeID uuid.UUID) (*db.ScreeningTaskResume, error) {
+	for _, item := range f.resumes {
+		if item.ResumeID == resumeID {
+			return item, nil
+		}
+	}
+	return nil, &db.NotFoundError{}
+}
+
+func (f *fakeScreeningRepo) ListAllScreeningTaskResumes(context.Context, uuid.UUID) ([]*db.ScreeningTaskResume, error) {
+	return f.resumes, nil
+}
+
+func (f *fakeScreeningRepo) ListScreeningResults(context.Context, *domain.ScreeningResultFilter) ([]*db.ScreeningResult, *db.PageInfo, error) {
+	return nil, nil, nil
+}
+
+func (f *fakeScreeningRepo) CreateScreeningRunMetric(_ context.Context, metric *db.ScreeningRunMetric) (*db.ScreeningRunMetric, error) {
+	f.metrics++
+	return metric, nil
+}
+
+type fakeMatcher struct {
+	service.MatchingService
+}
+
+func (fakeMatcher) Version() string { return "test" }
+
+func newDispatcherTestUsecase(repo *fakeScreeningRepo) *ScreeningUsecase {
+	return &ScreeningUsecase{repo: repo, matcher: fakeMatcher{}, logger: slog.Default()}
+}
+
+func TestProcessTaskResume_BusyAndSkipped(t *testing.T) {
+	busyID, doneID := uuid.New(), uuid.New()
+	repo := &fakeScreeningRepo{
+		task: &db.ScreeningTask{ID: uuid.New(), Status: string(consts.ScreeningTaskStatusRunning)},
+		resumes: []*db.ScreeningTaskResume{
+			{ResumeID: busyID, Status: string(consts.ScreeningTaskResumeStatusRunning), UpdatedAt: time.Now()},
+			{ResumeID: doneID, Status: string(consts.ScreeningTaskResumeStatusCompleted), Score: 80},
+		},
+	}
+	u := newDispatcherTestUsecase(repo)
+
+	// 其他 Worker 正在处理的简历不确认消息
+	err := u.ProcessTaskResume(context.Background(), repo.task.ID, busyID)
+	assert.ErrorIs(t, err, ErrTaskResumeBusy)
+
+	// 已处理完成的简历直接确认，只更新进度
+	require.NoError(t, u.ProcessTaskResume(context.Background(), repo.task.ID, doneID))
+	assert.Equal(t, string(consts.ScreeningTaskStatusRunning), repo.task.Status)
+	assert.Equal(t, 1, repo.task.ResumeProcessed)
+
+	// 任务已取消时不再处理
+	repo.task.Status = string(consts.ScreeningTaskStatusCancelled)
+	require.NoError(t, u.ProcessTaskResume(context.Background(), repo.task.ID, busyID))
+}
+
+func TestFinalizeIfDone_OnlyOnce(t *testing.T) {
+	repo := &fakeScreeningRepo{
+		task: &db.ScreeningTask{ID: uuid.New(), Status: string(consts.ScreeningTaskStatusRunning)},
+		resumes: []*db.ScreeningTaskResume{
+			{ResumeID: uuid.New(), Status: string(consts.ScreeningTaskResumeStatusCompleted), Score: 90},
+			{ResumeID: uuid.New(), Status: string(consts.ScreeningTaskResumeStatusFailed)},
+		},
+	}
+	u := newDispatcherTestUsecase(repo)
+
+	u.finalizeIfDone(context.Background(), repo.task.ID)
+	u.finalizeIfDone(context.Background(), repo.task.ID)
+
+	assert.Equal(t, string(consts.ScreeningTaskStatusFailed), repo.task.Status)
+	assert.Equal(t, 2, repo.task.ResumeProcessed)
+	assert.Equal(t, 1, repo.metrics)
+}
+
+func TestProcessTaskResume_PauseOnBudget(t *testing.T) {
+	resumeID := uuid.New()
+	budget := int64(1000)
+	repo := &fakeScreeningRepo{
+		task: &db.ScreeningTask{
+			ID:              uuid.New(),
+			Status:          string(consts.ScreeningTaskStatusRunning),
+			TokenBudget:     &budget,
+			ResumeProcessed: 2,
+			TokensInput:     600,
+			TokensOutput:    200,
+		},
+		resumes: []*db.ScreeningTaskResume{
+			{ResumeID: resumeID, Status: string(consts.ScreeningTaskResumeStatusPending)},
+		},
+	}
+	u := newDispatcherTestUsecase(repo)
+
+	// 已用 800，按平均每份 400 估算下一份会超出预算
+	require.NoError(t, u.ProcessTaskResume(context.Background(), repo.task.ID, resumeID))
+	assert.Equal(t, string(consts.ScreeningTaskStatusPaused), repo.task.Status)
+	assert.Equal(t, string(consts.ScreeningTaskResumeStatusPending), repo.resumes[0].Status)
+}
+
+func TestBudgetExceededReason(t *testing.T) {
+	cost := 1.0
+	task := &db.ScreeningTask{CostBudget: &cost, ResumeProcessed: 4, TotalCost: 0.6}
+	assert.Empty(t, budgetExceededReason(task))
+
+	task.TotalCost = 0.9
+	assert.Contains(t, budgetExceededReason(task), "费用预算不足")
+
+	assert.Empty(t, budgetExceededReason(&db.ScreeningTask{}))
+}
+
+func TestStaleTaskResumes(t *testing.T) {
+	now := time.Now()
+	staleBefore := now.Add(-5 * time.Minute)
+	stalePending := &db.ScreeningTaskResume{ResumeID: uuid.New(), Status: string(consts.ScreeningTaskResumeStatusPending), UpdatedAt: now.Add(-10 * time.Minute)}
+	staleRunning := &db.ScreeningTaskResume{ResumeID: uuid.New(), Status: string(consts.ScreeningTaskResumeStatusRunning), UpdatedAt: now.Add(-6 * time.Minute)}
+	items := []*db.ScreeningTaskResume{
+		stalePending,
+		staleRunning,
+		// 心跳仍在刷新的简历由原处理者持有，不重新投递
+		{ResumeID: uuid.New(), Status: string(consts.ScreeningTaskResumeStatusRunning), UpdatedAt: now.Add(-time.Minute)},
+		{ResumeID: uuid.New(), Status: string(consts.ScreeningTaskResumeStatusPending), UpdatedAt: now},
+		{ResumeID: uuid.New(), Status: string(consts.ScreeningTaskResumeStatusCompleted), UpdatedAt: now.Add(-time.Hour)},
+	}
+
+	assert.Equal(t, []*db.ScreeningTaskResume{stalePending, staleRunning}, staleTaskResumes(items, staleBefore))
+}
+
+type pricedMatcher struct {
+	fakeMatcher
+	priced bool
+}
+
+func (m pricedMatcher) ModelPriced(map[string]any) (models.ModelKey, bool, error) {
+	return models.ModelKey{Type: models.ModelTypeOpenAI, Name: "qwen-plus"}, m.priced, nil
+}
+
+func TestValidateCostBudgetModel(t *testing.T) {
+	u := &ScreeningUsecase{matcher: pricedMatcher{priced: false}, logger: slog.Default()}
+	// 未配置价格的模型不允许设置费用预算
+	assert.Error(t, u.validateCostBudgetModel(nil))
+
+	u.matcher = pricedMatcher{priced: true}
+	assert.NoError(t, u.validateCostBudgetModel(nil))
+}
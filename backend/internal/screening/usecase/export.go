@@ -0,0 +1,316 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/errcode"
+	"github.com/chaitin/WhaleHire/backend/internal/screening/service"
+)
+
+const (
+	// screeningExportTTL 异步导出记录与下载链接的有效期
+	screeningExportTTL = 24 * time.Hour
+	// screeningExportURLExpiry 下载链接有效期
+	screeningExportURLExpiry = time.Hour
+	// screeningExportTimeout 单次异步导出的最长执行时间
+	screeningExportTimeout = 30 * time.Minute
+)
+
+// filenameReplacer 替换岗位名称中不能出现在文件名里的字符
+var filenameReplacer = strings.NewReplacer("/", "_", "\\", "_", "\"", "_", "\n", " ", "\r", " ")
+
+// builtinReportDimensions 报告中内置维度的展示顺序
+var builtinReportDimensions = []string{"skill", "responsibility", "experience", "education", "industry", "basic"}
+
+// ExportScreeningResults 导出任务筛选结果。结果数不超过 screening_export.async_threshold 时同步生成文件返回，
+// 否则创建异步导出记录，后台生成文件上传到对象存储，通过 GetScreeningExport 获取下载链接
+func (u *ScreeningUsecase) ExportScreeningResults(ctx context.Context, req *domain.ExportScreeningResultsReq) (*domain.ExportScreeningResultsResp, error) {
+	if req == nil || req.TaskID == uuid.Nil {
+		return nil, fmt.Errorf("任务ID不能为空")
+	}
+	if !req.Format.IsValid() {
+		return nil, errcode.ErrInvalidParam.WithData("message", fmt.Sprintf("不支持的导出格式: %s", req.Format))
+	}
+
+	task, err := u.repo.GetScreeningTask(ctx, req.TaskID)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errcode.ErrScreeningTaskNotFound
+		}
+		return nil, fmt.Errorf("获取任务信息失败: %w", err)
+	}
+
+	_, pageInfo, err := u.repo.ListScreeningResults(ctx, &domain.ScreeningResultFilter{TaskID: &task.ID, Page: 1, PageSize: 1})
+	if err != nil {
+		return nil, fmt.Errorf("获取筛选结果失败: %w", err)
+	}
+	if pageInfo == nil || pageInfo.TotalCount == 0 {
+		return nil, errcode.ErrInvalidParam.WithData("message", "任务暂无筛选结果可导出")
+	}
+	resultCount := int(pageInfo.TotalCount)
+
+	if resultCount <= u.config.ScreeningExport.AsyncThreshold {
+		file, err := u.renderScreeningExport(ctx, task, req.Format)
+		if err != nil {
+			return nil, err
+		}
+		return &domain.ExportScreeningResultsResp{File: file}, nil
+	}
+
+	export := &domain.ScreeningExport{
+		ID:          uuid.New(),
+		TaskID:      task.ID,
+		Format:      req.Format,
+		Status:      consts.ScreeningExportStatusPending,
+		Filename:    screeningExportFilename(task, req.Format, time.Now()),
+		ResultCount: resultCount,
+		CreatedBy:   req.UserID,
+		CreatedAt:   time.Now(),
+	}
+	if err := u.saveScreeningExport(ctx, export); err != nil {
+		return nil, err
+	}
+
+	go u.processScreeningExportAsync(context.Background(), task, export)
+
+	u.logger.Info("已创建筛选结果异步导出",
+		slog.Any("task_id", task.ID),
+		slog.Any("export_id", export.ID),
+		slog.String("format", string(req.Format)),
+		slog.Int("result_count", resultCount))
+	return &domain.ExportScreeningResultsResp{Export: export}, nil
+}
+
+// GetScreeningExport 查询异步导出状态，导出完成时附带下载链接
+func (u *ScreeningUsecase) GetScreeningExport(ctx context.Context, exportID uuid.UUID) (*domain.ScreeningExport, error) {
+	data, err := u.redis.Get(ctx, fmt.Sprintf(consts.ScreeningExportKeyFmt, exportID)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, errcode.ErrScreeningExportNotFound
+		}
+		return nil, fmt.Errorf("获取导出记录失败: %w", err)
+	}
+
+	var export domain.ScreeningExport
+	if err := json.Unmarshal([]byte(data), &export); err != nil {
+		return nil, fmt.Errorf("解析导出记录失败: %w", err)
+	}
+
+	if export.Status == consts.ScreeningExportStatusCompleted && export.ObjectKey != "" {
+		url, err := u.s3Client.SignURL(ctx, domain.Bucket, export.ObjectKey, screeningExportURLExpiry)
+		if err != nil {
+			return nil, fmt.Errorf("生成下载链接失败: %w", err)
+		}
+		export.DownloadURL = url
+	}
+	export.ObjectKey = ""
+	return &export, nil
+}
+
+// processScreeningExportAsync 后台生成导出文件并上传到对象存储，结果写回导出记录
+func (u *ScreeningUsecase) processScreeningExportAsync(ctx context.Context, task *db.ScreeningTask, export *domain.ScreeningExport) {
+	ctx, cancel := context.WithTimeout(ctx, screeningExportTimeout)
+	defer cancel()
+
+	export.Status = consts.ScreeningExportStatusRunning
+	if err := u.saveScreeningExport(ctx, export); err != nil {
+		u.logger.Warn("更新导出状态失败", slog.Any("export_id", export.ID), slog.Any("err", err))
+	}
+
+	objectKey, err := u.uploadScreeningExport(ctx, task, export)
+	now := time.Now()
+	export.CompletedAt = &now
+	if err != nil {
+		u.logger.Error("筛选结果异步导出失败", slog.Any("task_id", task.ID), slog.Any("export_id", export.ID), slog.Any("err", err))
+		export.Status = consts.ScreeningExportStatusFailed
+		export.ErrorMessage = err.Error()
+	} else {
+		export.Status = consts.ScreeningExportStatusCompleted
+		export.ObjectKey = objectKey
+	}
+
+	if err := u.saveScreeningExport(ctx, export); err != nil {
+		u.logger.Error("保存导出结果失败", slog.Any("export_id", export.ID), slog.Any("err", err))
+	}
+}
+
+func (u *ScreeningUsecase) uploadScreeningExport(ctx context.Context, task *db.ScreeningTask, export *domain.ScreeningExport) (string, error) {
+	file, err := u.renderScreeningExport(ctx, task, export.Format)
+	if err != nil {
+		return "", err
+	}
+
+	objectKey := fmt.Sprintf("screening-exports/%s/%s.%s", task.ID, export.ID, export.Format)
+	_, err = u.s3Client.PutObject(ctx, domain.Bucket, objectKey, bytes.NewReader(file.Content), int64(len(file.Content)),
+		minio.PutObjectOptions{
+			ContentType: file.ContentType,
+			UserMetadata: map[string]string{
+				"originalname": file.Filename,
+			},
+		})
+	if err != nil {
+		return "", fmt.Errorf("上传导出文件失败: %w", err)
+	}
+	return objectKey, nil
+}
+
+func (u *ScreeningUsecase) saveScreeningExport(ctx context.Context, export *domain.ScreeningExport) error {
+	data, err := json.Marshal(export)
+	if err != nil {
+		return fmt.Errorf("序列化导出记录失败: %w", err)
+	}
+	if err := u.redis.Set(ctx, fmt.Sprintf(consts.ScreeningExportKeyFmt, export.ID), data, screeningExportTTL).Err(); err != nil {
+		return fmt.Errorf("保存导出记录失败: %w", err)
+	}
+	return nil
+}
+
+// renderScreeningExport 汇总任务全部筛选结果并按格式渲染为文件
+func (u *ScreeningUsecase) renderScreeningExport(ctx context.Context, task *db.ScreeningTask, format consts.ScreeningExportFormat) (*domain.ScreeningExportFile, error) {
+	job, err := u.jobUsecase.GetByID(ctx, task.JobPositionID.String())
+	if err != nil {
+		return nil, fmt.Errorf("获取岗位信息失败: %w", err)
+	}
+	entities, err := u.repo.ListTaskResultsWithResume(ctx, task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("获取筛选结果失败: %w", err)
+	}
+
+	now := time.Now()
+	report := buildScreeningReport(task, job, entities, now)
+
+	var buf bytes.Buffer
+	if err := u.reportService.Render(&buf, format, report); err != nil {
+		return nil, err
+	}
+	return &domain.ScreeningExportFile{
+		Filename:    screeningExportFilename(task, format, now),
+		ContentType: format.ContentType(),
+		Content:     buf.Bytes(),
+	}, nil
+}
+
+// buildScreeningReport 组装报告数据：人工校准过的得分优先，按综合得分重新排名；
+// 盲筛任务不导出候选人姓名，以匿名编号代替
+func buildScreeningReport(task *db.ScreeningTask, job *domain.JobProfileDetail, entities []*db.ScreeningResult, now time.Time) *domain.ScreeningReport {
+	report := &domain.ScreeningReport{
+		TaskID:           task.ID,
+		SkillNames:       make(map[string]string),
+		Responsibilities: make(map[string]string),
+		GeneratedAt:      now,
+	}
+	if job != nil {
+		if job.JobProfile != nil {
+			report.JobName = job.Name
+		}
+		for _, skill := range job.Skills {
+			if skill == nil {
+				continue
+			}
+			report.SkillNames[skill.ID] = skill.Skill
+			if skill.SkillID != "" {
+				report.SkillNames[skill.SkillID] = skill.Skill
+			}
+		}
+		for _, resp := range job.Responsibilities {
+			if resp != nil {
+				report.Responsibilities[resp.ID] = resp.Responsibility
+			}
+		}
+	}
+
+	present := make(map[string]bool)
+	customNames := make(map[string]string)
+	candidates := make([]*domain.ScreeningReportCandidate, 0, len(entities))
+	for _, entity := range entities {
+		result, err := toScreeningResult(entity)
+		if err != nil || result == nil {
+			continue
+		}
+		candidate := &domain.ScreeningReportCandidate{
+			OverallScore:    result.OverallScore,
+			MatchLevel:      result.MatchLevel,
+			DimensionScores: agentDimensionScores(result),
+			Result:          result,
+		}
+		if override := result.Override; override != nil {
+			if override.OverallScore != nil {
+				candidate.OverallScore = *override.OverallScore
+			}
+			if override.MatchLevel != nil {
+				candidate.MatchLevel = *override.MatchLevel
+			}
+			for key, score := range override.DimensionScores {
+				candidate.DimensionScores[key] = score
+			}
+		}
+		if !task.BlindMode && entity.Edges.Resume != nil {
+			candidate.Name = entity.Edges.Resume.Name
+		}
+		for key := range candidate.DimensionScores {
+			present[key] = true
+		}
+		for key, detail := range result.CustomDetails {
+			if detail != nil && detail.Name != "" {
+				customNames[key] = detail.Name
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].OverallScore > candidates[j].OverallScore
+	})
+	for i, candidate := range candidates {
+		candidate.Rank = i + 1
+		if task.BlindMode {
+			candidate.Name = fmt.Sprintf("候选人 %d", candidate.Rank)
+		} else if candidate.Name == "" {
+			candidate.Name = "未命名候选人"
+		}
+	}
+	report.Candidates = candidates
+
+	for _, key := range builtinReportDimensions {
+		if present[key] {
+			report.Dimensions = append(report.Dimensions, &domain.ScreeningReportDimension{Key: key, Name: service.BuiltinDimensionName(key)})
+			delete(present, key)
+		}
+	}
+	customKeys := make([]string, 0, len(present))
+	for key := range present {
+		customKeys = append(customKeys, key)
+	}
+	sort.Strings(customKeys)
+	for _, key := range customKeys {
+		name := customNames[key]
+		if name == "" {
+			name = key
+		}
+		report.Dimensions = append(report.Dimensions, &domain.ScreeningReportDimension{Key: key, Name: name})
+	}
+	return report
+}
+
+// screeningExportFilename 生成导出文件名，如 后端工程师-筛选结果-20250129.xlsx
+func screeningExportFilename(task *db.ScreeningTask, format consts.ScreeningExportFormat, now time.Time) string {
+	name := "筛选结果"
+	if task.Edges.JobPosition != nil && task.Edges.JobPosition.Name != "" {
+		name = filenameReplacer.Replace(task.Edges.JobPosition.Name) + "-" + name
+	}
+	return fmt.Sprintf("%s-%s.%s", name, now.Format("20060102"), format)
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+func TestBuildScreeningReport(t *testing.T) {
+	now := time.Now()
+	humanScore := 95.0
+	entities := []*db.ScreeningResult{
+		{
+			ResumeID:     uuid.New(),
+			OverallScore: 80,
+			MatchLevel:   "good",
+			SkillDetail:  map[string]any{"score": 80.0},
+			Edges:        db.ScreeningResultEdges{Resume: &db.Resume{Name: "张三"}},
+		},
+		{
+			ResumeID:      uuid.New(),
+			OverallScore:  60,
+			MatchLevel:    "fair",
+			SkillDetail:   map[string]any{"score": 60.0},
+			CustomDetails: map[string]any{"open_source": map[string]any{"key": "open_source", "name": "开源贡献", "score": 70.0}},
+			// 人工校准后排名上升
+			OverriddenAt:         &now,
+			HumanOverallScore:    &humanScore,
+			HumanDimensionScores: map[string]any{"skill": 90.0},
+			Edges:                db.ScreeningResultEdges{Resume: &db.Resume{}},
+		},
+	}
+	job := &domain.JobProfileDetail{
+		JobProfile: &domain.JobProfile{Name: "后端工程师"},
+		Skills:     []*domain.JobSkill{{ID: "s1", SkillID: "meta1", Skill: "Go"}},
+	}
+
+	report := buildScreeningReport(&db.ScreeningTask{ID: uuid.New()}, job, entities, now)
+	assert.Equal(t, "后端工程师", report.JobName)
+	assert.Equal(t, "Go", report.SkillNames["s1"])
+	assert.Equal(t, "Go", report.SkillNames["meta1"])
+
+	require.Len(t, report.Dimensions, 2)
+	assert.Equal(t, &domain.ScreeningReportDimension{Key: "skill", Name: "技能"}, report.Dimensions[0])
+	assert.Equal(t, &domain.ScreeningReportDimension{Key: "open_source", Name: "开源贡献"}, report.Dimensions[1])
+
+	require.Len(t, report.Candidates, 2)
+	first, second := report.Candidates[0], report.Candidates[1]
+	assert.Equal(t, 1, first.Rank)
+	assert.Equal(t, "未命名候选人", first.Name)
+	assert.Equal(t, humanScore, first.OverallScore)
+	assert.Equal(t, consts.MatchLevelFair, first.MatchLevel)
+	assert.Equal(t, map[string]float64{"skill": 90, "open_source": 70}, first.DimensionScores)
+	assert.Equal(t, 2, second.Rank)
+	assert.Equal(t, "张三", second.Name)
+
+	// 盲筛任务不导出候选人姓名
+	blind := buildScreeningReport(&db.ScreeningTask{ID: uuid.New(), BlindMode: true}, job, entities, now)
+	assert.Equal(t, "候选人 1", blind.Candidates[0].Name)
+	assert.Equal(t, "候选人 2", blind.Candidates[1].Name)
+}
+
+func TestScreeningExportFilename(t *testing.T) {
+	now := time.Date(2025, 1, 29, 10, 0, 0, 0, time.Local)
+	task := &db.ScreeningTask{Edges: db.ScreeningTaskEdges{JobPosition: &db.JobPosition{Name: "前端/后端工程师"}}}
+	assert.Equal(t, "前端_后端工程师-筛选结果-20250129.xlsx", screeningExportFilename(task, consts.ScreeningExportFormatXLSX, now))
+	assert.Equal(t, "筛选结果-20250129.pdf", screeningExportFilename(&db.ScreeningTask{}, consts.ScreeningExportFormatPDF, now))
+}
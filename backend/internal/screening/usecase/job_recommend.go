@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/errcode"
+)
+
+// RecommendJobPositions 对已发布岗位做结构化粗排，按需调用匹配 Agent 精排，
+// 用于候选人投错岗位时查看其他契合的在招岗位。结果不落库，不影响筛选任务
+func (u *ScreeningUsecase) RecommendJobPositions(ctx context.Context, req *domain.RecommendJobPositionsReq) (*domain.RecommendJobPositionsResp, error) {
+	resume, err := u.resumeUsecase.GetByID(ctx, req.ResumeID.String())
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errcode.ErrResumeNotFound
+		}
+		return nil, fmt.Errorf("获取简历信息失败: %w", err)
+	}
+	if resume.MergedIntoID != nil {
+		return nil, errcode.ErrResumeMerged
+	}
+	if resume.Status != domain.ResumeStatusCompleted {
+		return nil, errcode.ErrInvalidParam.WithData("message", "简历解析完成后才能推荐岗位")
+	}
+
+	normalizeRecommendReq(req)
+
+	jobs, err := u.jobUsecase.ListPublished(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取在招岗位失败: %w", err)
+	}
+	if !req.IncludeApplied {
+		jobs = excludeAppliedJobs(jobs, resume.JobPositions)
+	}
+
+	resp, err := u.jobRecommendService.Recommend(ctx, resume, jobs, req)
+	if err != nil {
+		return nil, fmt.Errorf("推荐岗位失败: %w", err)
+	}
+	resp.ResumeID = req.ResumeID
+
+	if req.UseLLM {
+		u.logger.Info("岗位推荐已完成模型精排",
+			slog.Any("resume_id", req.ResumeID),
+			slog.Int("total_positions", resp.TotalPositions),
+			slog.Any("token_usage", resp.TokenUsage))
+	}
+	return resp, nil
+}
+
+// normalizeRecommendReq 补全推荐数量默认值，精排数量不超过返回数量
+func normalizeRecommendReq(req *domain.RecommendJobPositionsReq) {
+	if req.TopK <= 0 {
+		req.TopK = domain.DefaultJobRecommendTopK
+	}
+	if req.TopK > domain.MaxJobRecommendTopK {
+		req.TopK = domain.MaxJobRecommendTopK
+	}
+	if req.LLMTopK <= 0 {
+		req.LLMTopK = domain.DefaultJobRecommendLLMTopK
+	}
+	if req.LLMTopK > domain.MaxJobRecommendLLMTopK {
+		req.LLMTopK = domain.MaxJobRecommendLLMTopK
+	}
+	req.LLMTopK = min(req.LLMTopK, req.TopK)
+}
+
+// excludeAppliedJobs 排除简历已投递的岗位
+func excludeAppliedJobs(jobs []*domain.JobProfileDetail, applications []*domain.JobApplication) []*domain.JobProfileDetail {
+	if len(applications) == 0 {
+		return jobs
+	}
+	applied := make(map[string]struct{}, len(applications))
+	for _, app := range applications {
+		if app != nil {
+			applied[app.JobPositionID] = struct{}{}
+		}
+	}
+
+	result := make([]*domain.JobProfileDetail, 0, len(jobs))
+	for _, job := range jobs {
+		if _, ok := applied[job.ID]; !ok {
+			result = append(result, job)
+		}
+	}
+	return result
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/errcode"
+)
+
+type fakeRecommendResumeUsecase struct {
+	domain.ResumeUsecase
+	resume *domain.ResumeDetail
+}
+
+func (f *fakeRecommendResumeUsecase) GetByID(context.Context, string) (*domain.ResumeDetail, error) {
+	return f.resume, nil
+}
+
+type fakeRecommendJobUsecase struct {
+	domain.JobProfileUsecase
+	jobs []*domain.JobProfileDetail
+}
+
+func (f *fakeRecommendJobUsecase) ListPublished(context.Context) ([]*domain.JobProfileDetail, error) {
+	return f.jobs, nil
+}
+
+type fakeJobRecommendService struct {
+	jobs []*domain.JobProfileDetail
+	req  *domain.RecommendJobPositionsReq
+}
+
+func (f *fakeJobRecommendService) Recommend(_ context.Context, _ *domain.ResumeDetail, jobs []*domain.JobProfileDetail, req *domain.RecommendJobPositionsReq) (*domain.RecommendJobPositionsResp, error) {
+	f.jobs, f.req = jobs, req
+	return &domain.RecommendJobPositionsResp{TotalPositions: len(jobs)}, nil
+}
+
+func TestRecommendJobPositions(t *testing.T) {
+	resume := &domain.ResumeDetail{Resume: &domain.Resume{
+		Status:       domain.ResumeStatusCompleted,
+		JobPositions: []*domain.JobApplication{{JobPositionID: "applied"}},
+	}}
+	jobs := []*domain.JobProfileDetail{
+		{JobProfile: &domain.JobProfile{ID: "applied"}},
+		{JobProfile: &domain.JobProfile{ID: "open"}},
+	}
+	recommender := &fakeJobRecommendService{}
+	u := &ScreeningUsecase{
+		resumeUsecase:       &fakeRecommendResumeUsecase{resume: resume},
+		jobUsecase:          &fakeRecommendJobUsecase{jobs: jobs},
+		jobRecommendService: recommender,
+		logger:              slog.Default(),
+	}
+
+	resumeID := uuid.New()
+	resp, err := u.RecommendJobPositions(context.Background(), &domain.RecommendJobPositionsReq{ResumeID: resumeID, TopK: 3})
+	require.NoError(t, err)
+	assert.Equal(t, resumeID, resp.ResumeID)
+	require.Len(t, recommender.jobs, 1)
+	assert.Equal(t, "open", recommender.jobs[0].ID)
+	// 精排数量不超过返回数量
+	assert.Equal(t, 3, recommender.req.TopK)
+	assert.Equal(t, 3, recommender.req.LLMTopK)
+
+	_, err = u.RecommendJobPositions(context.Background(), &domain.RecommendJobPositionsReq{ResumeID: resumeID, IncludeApplied: true})
+	require.NoError(t, err)
+	assert.Len(t, recommender.jobs, 2)
+	assert.Equal(t, domain.DefaultJobRecommendTopK, recommender.req.TopK)
+	assert.Equal(t, domain.DefaultJobRecommendLLMTopK, recommender.req.LLMTopK)
+
+	mergedInto := uuid.NewString()
+	resume.MergedIntoID = &mergedInto
+	_, err = u.RecommendJobPositions(context.Background(), &domain.RecommendJobPositionsReq{ResumeID: resumeID})
+	assert.ErrorIs(t, err, errcode.ErrResumeMerged)
+
+	resume.MergedIntoID = nil
+	resume.Status = domain.ResumeStatusProcessing
+	_, err = u.RecommendJobPositions(context.Background(), &domain.RecommendJobPositionsReq{ResumeID: resumeID})
+	assert.Error(t, err)
+}
@@ -15,7 +15,6 @@ import (
 	"github.com/chaitin/WhaleHire/backend/db/screeningresult"
 	"github.com/chaitin/WhaleHire/backend/domain"
 	"github.com/chaitin/WhaleHire/backend/internal/screening/service"
-	"github.com/chaitin/WhaleHire/backend/internal/screening/util"
 )
 
 // ProcessResult 单个简历处理结果
@@ -26,7 +25,10 @@ type ProcessResult struct {
 	MatchLevel   consts.MatchLevel
 	TokenInput   int64
 	TokenOutput  int64
+	TotalCost    float64
 	ErrorMessage string
+	// Interrupted 处理因上下文取消而中断（任务取消或进程退出），未写入失败状态
+	Interrupted bool
 }
 
 // ResultCollector 结果收集器，用于无锁并发处理
@@ -40,6 +42,7 @@ type ResultCollector struct {
 	histogram   map[string]float64
 	tokenInput  int64
 	tokenOutput int64
+	totalCost   float64
 }
 
 // NewResultCollector 创建新的结果收集器
@@ -68,6 +71,7 @@ func (rc *ResultCollector) CollectResult(result ProcessResult) {
 
 	rc.tokenInput += result.TokenInput
 	rc.tokenOutput += result.TokenOutput
+	rc.totalCost += result.TotalCost
 }
 
 // GetStats 获取当前统计信息
@@ -84,36 +88,11 @@ func (rc *ResultCollector) GetStats() (int, int, int, float64, float64, map[stri
 	return rc.processed, rc.succeeded, rc.failed, rc.scoreSum, rc.scoreCnt, histogramCopy, rc.tokenInput, rc.tokenOutput
 }
 
-func (u *ScreeningUsecase) processConcurrentResumes(
-	ctx context.Context,
-	task *db.ScreeningTask,
-	taskResumes []*db.ScreeningTaskResume,
-	jobDetail *domain.JobProfileDetail,
-	collector *ResultCollector,
-) {
-	executor := util.TaskExecutor[*db.ScreeningTaskResume, ProcessResult]{
-		MaxWorkers: 10,
-		TaskFunc: func(ctx context.Context, item *db.ScreeningTaskResume) (ProcessResult, error) {
-			return u.processResumeItem(ctx, task, item, jobDetail), nil
-		},
-		OnResult: func(item *db.ScreeningTaskResume, res ProcessResult, err error) {
-			collector.CollectResult(res)
-
-			processed, succeeded, failed, _, _, _, _, _ := collector.GetStats()
-			if dbErr := u.repo.UpdateScreeningTask(ctx, task.ID, map[string]any{
-				"resume_processed": processed,
-				"resume_succeeded": succeeded,
-				"resume_failed":    failed,
-			}); dbErr != nil {
-				u.logger.Warn("更新任务进度失败",
-					slog.Any("task_id", task.ID),
-					slog.Any("resume_id", item.ResumeID),
-					slog.Any("err", dbErr))
-			}
-		},
-	}
-
-	executor.Run(ctx, taskResumes)
+// TotalCost 获取累计模型调用费用
+func (rc *ResultCollector) TotalCost() float64 {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.totalCost
 }
 
 // processResumeItem 处理单个简历项
@@ -137,6 +116,10 @@ func (u *ScreeningUsecase) processResumeItem(
 
 	resumeDetail, err := u.resumeUsecase.GetByID(ctx, item.ResumeID.String())
 	if err != nil {
+		if ctx.Err() != nil {
+			result.Interrupted = true
+			return result
+		}
 		result.ErrorMessage = fmt.Sprintf("获取简历信息失败: %v", err)
 		if updateErr := u.repo.UpdateScreeningTaskResume(ctx, task.ID, item.ResumeID, map[string]any{
 			"status":        consts.ScreeningTaskResumeStatusFailed,
@@ -165,10 +148,18 @@ func (u *ScreeningUsecase) processResumeItem(
 		Resume:           resumeDetail,
 		DimensionWeights: dimensionWeights,
 		LLMConfig:        task.LlmConfig,
+		DisableCache:     task.DisableCache,
+		KnockoutRules:    domain.ParseScreeningKnockoutRules(task.KnockoutRules),
+		Redaction:        blindRedactionPolicy(task),
+		CustomDimensions: domain.ParseCustomDimensions(task.CustomDimensions),
 	}
 
 	matchResult, err := u.matcher.Match(ctx, matchReq)
 	if err != nil {
+		if ctx.Err() != nil {
+			result.Interrupted = true
+			return result
+		}
 		result.ErrorMessage = err.Error()
 		u.logger.Error("执行智能匹配失败", slog.Any("task_id", task.ID), slog.Any("resume_id", item.ResumeID), slog.Any("err", err))
 		if updateErr := u.repo.UpdateScreeningTaskResume(ctx, task.ID, item.ResumeID, map[string]any{
@@ -201,6 +192,7 @@ func (u *ScreeningUsecase) processResumeItem(
 		result.TokenInput += int64(usage.PromptTokens)
 		result.TokenOutput += int64(usage.CompletionTokens)
 	}
+	result.TotalCost = matchResult.TotalCost
 
 	result.Success = true
 	result.Score = matchResult.Match.OverallScore
@@ -210,15 +202,22 @@ func (u *ScreeningUsecase) processResumeItem(
 	if err != nil {
 		u.logger.Error("构建筛选结果失败", slog.Any("task_id", task.ID), slog.Any("resume_id", item.ResumeID), slog.Any("err", err))
 	} else {
+		// 消息重新投递时可能已存在上一次中断前写入的结果
+		if err := u.repo.DeleteScreeningResult(ctx, task.ID, item.ResumeID); err != nil {
+			u.logger.Warn("清理历史筛选结果失败", slog.Any("task_id", task.ID), slog.Any("resume_id", item.ResumeID), slog.Any("err", err))
+		}
 		if _, err := u.repo.CreateScreeningResult(ctx, resultEntity); err != nil {
 			u.logger.Error("保存筛选结果失败", slog.Any("task_id", task.ID), slog.Any("resume_id", item.ResumeID), slog.Any("err", err))
 		}
 	}
 
 	if err := u.repo.UpdateScreeningTaskResume(ctx, task.ID, item.ResumeID, map[string]any{
-		"status":       consts.ScreeningTaskResumeStatusCompleted,
-		"score":        matchResult.Match.OverallScore,
-		"processed_at": time.Now(),
+		"status":        consts.ScreeningTaskResumeStatusCompleted,
+		"score":         matchResult.Match.OverallScore,
+		"tokens_input":  result.TokenInput,
+		"tokens_output": result.TokenOutput,
+		"total_cost":    result.TotalCost,
+		"processed_at":  time.Now(),
 	}); err != nil {
 		u.logger.Warn("更新简历完成状态失败", slog.Any("task_id", task.ID), slog.Any("resume_id", item.ResumeID), slog.Any("err", err))
 	}
@@ -293,7 +292,7 @@ func buildResultEntity(task *db.ScreeningTask, resumeID uuid.UUID, result *servi
 		}
 	}
 
-	return &db.ScreeningResult{
+	entity := &db.ScreeningResult{
 		TaskID:               task.ID,
 		JobPositionID:        task.JobPositionID,
 		ResumeID:             resumeID,
@@ -306,12 +305,32 @@ func buildResultEntity(task *db.ScreeningTask, resumeID uuid.UUID, result *servi
 		IndustryDetail:       industryDetail,
 		ResponsibilityDetail: responsibilityDetail,
 		SkillDetail:          skillDetail,
+		CustomDetails:        domain.CustomMatchDetailsToMap(match.CustomMatches),
 		Recommendations:      match.Recommendations,
+		KnockoutReasons:      result.KnockoutReasons,
 		TraceID:              traceID,
 		RuntimeMetadata:      runtimeMetadata,
 		SubAgentVersions:     subAgentVersions,
 		MatchedAt:            match.MatchedAt,
-	}, nil
+		CachedFromID:         result.CachedFromID,
+	}
+	if result.Fingerprint != nil {
+		entity.JobHash = result.Fingerprint.JobHash
+		entity.ResumeHash = result.Fingerprint.ResumeHash
+		entity.AgentHash = result.Fingerprint.AgentHash
+	}
+	return entity, nil
+}
+
+// blindRedactionPolicy 盲筛任务使用创建时记录的脱敏策略，历史任务缺少快照时使用默认策略
+func blindRedactionPolicy(task *db.ScreeningTask) *domain.RedactionPolicy {
+	if !task.BlindMode {
+		return nil
+	}
+	if policy := domain.ParseRedactionPolicy(task.RedactionPolicy); policy != nil {
+		return policy
+	}
+	return domain.DefaultRedactionPolicy()
 }
 
 func structToMap(obj any) (map[string]any, error) {
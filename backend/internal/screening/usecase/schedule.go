@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/errcode"
+)
+
+// UpsertScreeningSchedule 创建或更新岗位自动筛选配置，仅筛选配置创建之后的新投递
+func (u *ScreeningUsecase) UpsertScreeningSchedule(ctx context.Context, req *domain.UpsertScreeningScheduleReq) (*domain.ScreeningSchedule, error) {
+	if !req.Mode.IsValid() {
+		return nil, errcode.ErrInvalidParam.WithData("message", fmt.Sprintf("不支持的自动筛选模式: %s", req.Mode))
+	}
+	if req.DigestHour < 0 || req.DigestHour > 23 {
+		return nil, errcode.ErrInvalidParam.WithData("message", "每日汇总筛选时刻须在 0-23 之间")
+	}
+	if _, err := u.jobUsecase.GetByID(ctx, req.JobPositionID.String()); err != nil {
+		return nil, fmt.Errorf("获取岗位信息失败: %w", err)
+	}
+	if req.WeightTemplateID != nil {
+		if _, err := u.weightTemplateRepo.GetByID(ctx, *req.WeightTemplateID); err != nil {
+			if db.IsNotFound(err) {
+				return nil, errcode.ErrInvalidParam.WithData("message", "权重模板不存在")
+			}
+			return nil, fmt.Errorf("获取权重模板失败: %w", err)
+		}
+	}
+
+	entity, err := u.scheduleRepo.Upsert(ctx, &db.ScreeningSchedule{
+		JobPositionID:    req.JobPositionID,
+		Mode:             req.Mode,
+		WeightTemplateID: req.WeightTemplateID,
+		LlmConfig:        req.LLMConfig,
+		KnockoutRules:    req.KnockoutRules.ToMap(),
+		BlindMode:        req.BlindMode,
+		DigestHour:       req.DigestHour,
+		Enabled:          req.Enabled,
+		CreatedBy:        req.CreatedBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("保存自动筛选配置失败: %w", err)
+	}
+
+	u.logger.Info("岗位自动筛选配置已保存",
+		slog.Any("job_position_id", req.JobPositionID),
+		slog.String("mode", string(req.Mode)),
+		slog.Bool("enabled", req.Enabled))
+
+	return (&domain.ScreeningSchedule{}).From(entity), nil
+}
+
+// GetScreeningSchedule 查询岗位自动筛选配置
+func (u *ScreeningUsecase) GetScreeningSchedule(ctx context.Context, req *domain.GetScreeningScheduleReq) (*domain.ScreeningSchedule, error) {
+	entity, err := u.scheduleRepo.GetByJobPosition(ctx, req.JobPositionID)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errcode.ErrScreeningScheduleNotFound
+		}
+		return nil, fmt.Errorf("获取自动筛选配置失败: %w", err)
+	}
+	return (&domain.ScreeningSchedule{}).From(entity), nil
+}
+
+// DeleteScreeningSchedule 删除岗位自动筛选配置，已发起的任务不受影响
+func (u *ScreeningUsecase) DeleteScreeningSchedule(ctx context.Context, jobPositionID uuid.UUID) error {
+	if _, err := u.GetScreeningSchedule(ctx, &domain.GetScreeningScheduleReq{JobPositionID: jobPositionID}); err != nil {
+		return err
+	}
+	if err := u.scheduleRepo.DeleteByJobPosition(ctx, jobPositionID); err != nil {
+		return fmt.Errorf("删除自动筛选配置失败: %w", err)
+	}
+	return nil
+}
+
+// RunScreeningSchedules 巡检全部启用的自动筛选配置，为到期的岗位发起筛选任务。
+// 由筛选 Worker 定期调用，通过咨询锁保证同一时刻只有一个副本执行
+func (u *ScreeningUsecase) RunScreeningSchedules(ctx context.Context, now time.Time) error {
+	acquired, err := u.scheduleRepo.WithScheduleLock(ctx, func(ctx context.Context) error {
+		return u.runScreeningSchedules(ctx, now)
+	})
+	if err != nil {
+		return err
+	}
+	if !acquired {
+		u.logger.Debug("其他副本正在执行自动筛选巡检，跳过本次巡检")
+	}
+	return nil
+}
+
+func (u *ScreeningUsecase) runScreeningSchedules(ctx context.Context, now time.Time) error {
+	entities, err := u.scheduleRepo.ListEnabled(ctx)
+	if err != nil {
+		return fmt.Errorf("获取自动筛选配置失败: %w", err)
+	}
+
+	for _, entity := range entities {
+		schedule := (&domain.ScreeningSchedule{}).From(entity)
+		if !schedule.IsDue(now) {
+			continue
+		}
+		if err := u.runScreeningSchedule(ctx, schedule, now); err != nil {
+			u.logger.Warn("自动筛选执行失败",
+				slog.Any("job_position_id", schedule.JobPositionID),
+				slog.String("mode", string(schedule.Mode)),
+				slog.Any("err", err))
+		}
+	}
+	return nil
+}
+
+// runScreeningSchedule 为岗位尚未筛选的新投递创建并启动筛选任务。
+// 任务完成后沿用任务收尾逻辑发送筛选完成通知；digest 模式无新投递时同样记录执行时间，当天不再重复巡检
+func (u *ScreeningUsecase) runScreeningSchedule(ctx context.Context, schedule *domain.ScreeningSchedule, now time.Time) error {
+	resumeIDs, err := u.scheduleRepo.ListUnscreenedResumeIDs(ctx, schedule.JobPositionID, schedule.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	if len(resumeIDs) > 0 {
+		notes := "新投递自动筛选"
+		if schedule.Mode == consts.ScreeningScheduleModeDigest {
+			notes = fmt.Sprintf("%s 每日汇总筛选", now.Format("2006-01-02"))
+		}
+		createResp, err := u.CreateScreeningTask(ctx, &domain.CreateScreeningTaskReq{
+			JobPositionID:    schedule.JobPositionID,
+			ResumeIDs:        resumeIDs,
+			CreatedBy:        schedule.CreatedBy,
+			Notes:            notes,
+			DimensionWeights: u.scheduleWeights(ctx, schedule),
+			LLMConfig:        schedule.LLMConfig,
+			KnockoutRules:    schedule.KnockoutRules,
+			BlindMode:        schedule.BlindMode,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := u.StartScreeningTask(ctx, &domain.StartScreeningTaskReq{TaskID: createResp.TaskID}); err != nil {
+			// 启动失败的任务不会再被执行，删除后其简历不再视为已加入任务，下次巡检重新发起筛选
+			if delErr := u.repo.DeleteScreeningTask(ctx, createResp.TaskID); delErr != nil {
+				u.logger.Error("删除启动失败的自动筛选任务失败", slog.Any("task_id", createResp.TaskID), slog.Any("err", delErr))
+			}
+			return err
+		}
+		u.logger.Info("已自动发起筛选任务",
+			slog.Any("job_position_id", schedule.JobPositionID),
+			slog.Any("task_id", createResp.TaskID),
+			slog.Int("resume_count", len(resumeIDs)))
+	} else if schedule.Mode != consts.ScreeningScheduleModeDigest {
+		return nil
+	}
+
+	return u.scheduleRepo.UpdateLastRunAt(ctx, schedule.ID, now)
+}
+
+// scheduleWeights 读取岗位默认权重模板，未配置或模板已删除时返回 nil，由创建任务时使用系统默认权重
+func (u *ScreeningUsecase) scheduleWeights(ctx context.Context, schedule *domain.ScreeningSchedule) map[string]float64 {
+	if schedule.WeightTemplateID == nil {
+		return nil
+	}
+	entity, err := u.weightTemplateRepo.GetByID(ctx, *schedule.WeightTemplateID)
+	if err != nil {
+		u.logger.Warn("获取岗位默认权重模板失败，使用系统默认权重",
+			slog.Any("job_position_id", schedule.JobPositionID),
+			slog.Any("weight_template_id", *schedule.WeightTemplateID),
+			slog.Any("err", err))
+		return nil
+	}
+
+	weights := make(map[string]float64, len(entity.Weights))
+	for key, value := range entity.Weights {
+		if v, ok := value.(float64); ok {
+			weights[key] = v
+		}
+	}
+	return weights
+}
@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+type fakeScheduleRepo struct {
+	domain.ScreeningScheduleRepo
+	schedules []*db.ScreeningSchedule
+	resumeIDs []uuid.UUID
+	lastRuns  map[uuid.UUID]time.Time
+	listed    []uuid.UUID
+}
+
+func (f *fakeScheduleRepo) ListEnabled(context.Context) ([]*db.ScreeningSchedule, error) {
+	return f.schedules, nil
+}
+
+func (f *fakeScheduleRepo) ListUnscreenedResumeIDs(_ context.Context, jobPositionID uuid.UUID, _ time.Time) ([]uuid.UUID, error) {
+	f.listed = append(f.listed, jobPositionID)
+	return f.resumeIDs, nil
+}
+
+func (f *fakeScheduleRepo) UpdateLastRunAt(_ context.Context, id uuid.UUID, runAt time.Time) error {
+	f.lastRuns[id] = runAt
+	return nil
+}
+
+type fakeWeightTemplateRepo struct {
+	domain.WeightTemplateRepo
+	templates map[uuid.UUID]*db.WeightTemplate
+}
+
+func (f *fakeWeightTemplateRepo) GetByID(_ context.Context, id uuid.UUID) (*db.WeightTemplate, error) {
+	if wt, ok := f.templates[id]; ok {
+		return wt, nil
+	}
+	return nil, &db.NotFoundError{}
+}
+
+type fakeScheduleTaskRepo struct {
+	domain.ScreeningRepo
+	task    *db.ScreeningTask
+	deleted []uuid.UUID
+}
+
+func (f *fakeScheduleTaskRepo) CreateScreeningTask(_ context.Context, task *db.ScreeningTask) (*db.ScreeningTask, error) {
+	f.task = task
+	return task, nil
+}
+
+func (f *fakeScheduleTaskRepo) CreateScreeningTaskResume(_ context.Context, taskResume *db.ScreeningTaskResume) (*db.ScreeningTaskResume, error) {
+	return taskResume, nil
+}
+
+func (f *fakeScheduleTaskRepo) GetScreeningTask(context.Context, uuid.UUID) (*db.ScreeningTask, error) {
+	return f.task, nil
+}
+
+func (f *fakeScheduleTaskRepo) UpdateScreeningTask(_ context.Context, _ uuid.UUID, updates map[string]any) error {
+	if status, ok := updates["status"].(consts.ScreeningTaskStatus); ok {
+		f.task.Status = string(status)
+	}
+	return nil
+}
+
+func (f *fakeScheduleTaskRepo) DeleteScreeningTask(_ context.Context, id uuid.UUID) error {
+	f.deleted = append(f.deleted, id)
+	return nil
+}
+
+type fakeScheduleDimensionRepo struct {
+	domain.ScreeningDimensionRepo
+}
+
+func (fakeScheduleDimensionRepo) ListByJobPosition(context.Context, uuid.UUID) ([]*db.ScreeningDimension, error) {
+	return nil, nil
+}
+
+type fakeMissingJobUsecase struct {
+	domain.JobProfileUsecase
+}
+
+func (fakeMissingJobUsecase) GetByID(context.Context, string) (*domain.JobProfileDetail, error) {
+	return nil, &db.NotFoundError{}
+}
+
+func TestScreeningScheduleIsDue(t *testing.T) {
+	now := time.Date(2025, 1, 29, 3, 30, 0, 0, time.Local)
+	yesterday := now.Add(-24 * time.Hour)
+	today := time.Date(2025, 1, 29, 2, 5, 0, 0, time.Local)
+
+	cases := []struct {
+		name     string
+		schedule domain.ScreeningSchedule
+		due      bool
+	}{
+		{"已停用", domain.ScreeningSchedule{Mode: consts.ScreeningScheduleModeInstant}, false},
+		{"即时模式每次巡检都执行", domain.ScreeningSchedule{Mode: consts.ScreeningScheduleModeInstant, Enabled: true, LastRunAt: &today}, true},
+		{"汇总模式从未执行", domain.ScreeningSchedule{Mode: consts.ScreeningScheduleModeDigest, Enabled: true, DigestHour: 2}, true},
+		{"汇总模式昨日已执行", domain.ScreeningSchedule{Mode: consts.ScreeningScheduleModeDigest, Enabled: true, DigestHour: 2, LastRunAt: &yesterday}, true},
+		{"汇总模式今日已执行", domain.ScreeningSchedule{Mode: consts.ScreeningScheduleModeDigest, Enabled: true, DigestHour: 2, LastRunAt: &today}, false},
+		{"未到汇总时刻", domain.ScreeningSchedule{Mode: consts.ScreeningScheduleModeDigest, Enabled: true, DigestHour: 22, LastRunAt: &yesterday}, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.due, tc.schedule.IsDue(now))
+		})
+	}
+}
+
+func TestRunScreeningSchedulesWithoutNewApplicants(t *testing.T) {
+	now := time.Date(2025, 1, 29, 3, 0, 0, 0, time.Local)
+	instant := &db.ScreeningSchedule{ID: uuid.New(), JobPositionID: uuid.New(), Mode: consts.ScreeningScheduleModeInstant, Enabled: true}
+	digest := &db.ScreeningSchedule{ID: uuid.New(), JobPositionID: uuid.New(), Mode: consts.ScreeningScheduleModeDigest, Enabled: true, DigestHour: 2}
+	notDue := &db.ScreeningSchedule{ID: uuid.New(), JobPositionID: uuid.New(), Mode: consts.ScreeningScheduleModeDigest, Enabled: true, DigestHour: 20}
+	repo := &fakeScheduleRepo{
+		schedules: []*db.ScreeningSchedule{instant, digest, notDue},
+		lastRuns:  make(map[uuid.UUID]time.Time),
+	}
+	u := &ScreeningUsecase{scheduleRepo: repo, logger: slog.Default()}
+
+	require.NoError(t, u.runScreeningSchedules(context.Background(), now))
+
+	// 未到时刻的配置不查询投递
+	assert.ElementsMatch(t, []uuid.UUID{instant.JobPositionID, digest.JobPositionID}, repo.listed)
+	// 汇总模式无新投递时同样记录执行时间，避免当天重复巡检；即时模式不记录
+	assert.Equal(t, map[uuid.UUID]time.Time{digest.ID: now}, repo.lastRuns)
+}
+
+func TestScheduleWeights(t *testing.T) {
+	templateID, missingID := uuid.New(), uuid.New()
+	u := &ScreeningUsecase{
+		weightTemplateRepo: &fakeWeightTemplateRepo{templates: map[uuid.UUID]*db.WeightTemplate{
+			templateID: {ID: templateID, Weights: map[string]interface{}{"skill": 0.5, "experience": 0.5, "note": "ignored"}},
+		}},
+		logger: slog.Default(),
+	}
+
+	assert.Nil(t, u.scheduleWeights(context.Background(), &domain.ScreeningSchedule{}))
+	assert.Equal(t, map[string]float64{"skill": 0.5, "experience": 0.5},
+		u.scheduleWeights(context.Background(), &domain.ScreeningSchedule{WeightTemplateID: &templateID}))
+	// 模板已删除时回退到系统默认权重
+	assert.Nil(t, u.scheduleWeights(context.Background(), &domain.ScreeningSchedule{WeightTemplateID: &missingID}))
+}
+
+func TestRunScreeningScheduleTaskSettings(t *testing.T) {
+	now := time.Date(2025, 1, 29, 3, 30, 0, 0, time.Local)
+	minYears := 3.0
+	schedule := &domain.ScreeningSchedule{
+		ID:            uuid.New(),
+		JobPositionID: uuid.New(),
+		Mode:          consts.ScreeningScheduleModeInstant,
+		LLMConfig:     map[string]any{"model_name": "gpt-4o-mini"},
+		KnockoutRules: &domain.ScreeningKnockoutRules{MinYearsExperience: &minYears},
+		BlindMode:     true,
+		Enabled:       true,
+		CreatedBy:     uuid.New(),
+	}
+	scheduleRepo := &fakeScheduleRepo{resumeIDs: []uuid.UUID{uuid.New()}, lastRuns: make(map[uuid.UUID]time.Time)}
+	taskRepo := &fakeScheduleTaskRepo{}
+	u := &ScreeningUsecase{
+		repo:          taskRepo,
+		scheduleRepo:  scheduleRepo,
+		dimensionRepo: fakeScheduleDimensionRepo{},
+		jobUsecase:    fakeMissingJobUsecase{},
+		logger:        slog.Default(),
+	}
+
+	// 岗位不存在时任务启动失败，但已按配置创建
+	require.Error(t, u.runScreeningSchedule(context.Background(), schedule, now))
+
+	// 自动发起的任务沿用配置中的模型、淘汰条件与盲筛设置
+	require.NotNil(t, taskRepo.task)
+	assert.Equal(t, schedule.LLMConfig, taskRepo.task.LlmConfig)
+	assert.Equal(t, schedule.KnockoutRules, domain.ParseScreeningKnockoutRules(taskRepo.task.KnockoutRules))
+	assert.True(t, taskRepo.task.BlindMode)
+	assert.NotEmpty(t, taskRepo.task.RedactionPolicy)
+
+	// 启动失败的任务被删除，简历在下次巡检时重新筛选，且不记录执行时间
+	assert.Equal(t, []uuid.UUID{taskRepo.task.ID}, taskRepo.deleted)
+	assert.Empty(t, scheduleRepo.lastRuns)
+}
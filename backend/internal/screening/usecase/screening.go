@@ -4,17 +4,19 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 
 	"github.com/chaitin/WhaleHire/backend/config"
 	"github.com/chaitin/WhaleHire/backend/consts"
 	"github.com/chaitin/WhaleHire/backend/db"
 	"github.com/chaitin/WhaleHire/backend/domain"
 	"github.com/chaitin/WhaleHire/backend/errcode"
+	"github.com/chaitin/WhaleHire/backend/internal/queue"
 	"github.com/chaitin/WhaleHire/backend/internal/screening/service"
+	"github.com/chaitin/WhaleHire/backend/pkg/store/s3"
 )
 
 // ScreeningUsecase 筛选业务实现
@@ -25,89 +27,18 @@ type ScreeningUsecase struct {
 	resumeUsecase        domain.ResumeUsecase
 	matcher              service.MatchingService
 	weightPreviewService service.WeightPreviewService
+	shortlistService     service.ShortlistService
+	jobRecommendService  service.JobRecommendService
+	reportService        service.ReportService
 	notificationUsecase  domain.NotificationUsecase
 	weightTemplateRepo   domain.WeightTemplateRepo
+	dimensionRepo        domain.ScreeningDimensionRepo
+	scheduleRepo         domain.ScreeningScheduleRepo
+	producer             queue.Producer
+	redis                *redis.Client
+	s3Client             *s3.MinioClient
 	config               *config.Config
 	logger               *slog.Logger
-	// 任务上下文管理器
-	taskContexts sync.Map // map[uuid.UUID]context.CancelFunc
-}
-
-// processScreeningTaskAsync 异步处理筛选任务
-func (u *ScreeningUsecase) processScreeningTaskAsync(task *db.ScreeningTask, taskResumes []*db.ScreeningTaskResume, jobDetail *domain.JobProfileDetail) {
-	// 为异步处理创建可取消的context
-	processCtx, cancel := context.WithCancel(context.Background())
-
-	// 保存取消函数到任务上下文管理器
-	u.taskContexts.Store(task.ID, cancel)
-
-	// 确保任务完成后清理上下文
-	defer func() {
-		u.taskContexts.Delete(task.ID)
-	}()
-
-	// 使用并发处理简历，提升处理效率
-	collector := NewResultCollector()
-
-	// 直接调用并发处理方法
-	u.processConcurrentResumes(processCtx, task, taskResumes, jobDetail, collector)
-
-	// 获取最终统计结果
-	processed, succeeded, failed, scoreSum, scoreCnt, histogram, tokenInput, tokenOutput := collector.GetStats()
-
-	u.logger.Info("screening task processing completed",
-		"processed", processed,
-		"succeeded", succeeded,
-		"failed", failed,
-		"scoreSum", scoreSum,
-		"scoreCnt", scoreCnt,
-		"histogram", histogram,
-		"tokenInput", tokenInput,
-		"tokenOutput", tokenOutput,
-	)
-
-	// 如果有成功处理的简历，进行排名更新
-	if succeeded > 0 {
-		if err := u.updateResumeRankings(processCtx, task.ID); err != nil {
-			u.logger.Warn("更新简历排名失败", slog.Any("task_id", task.ID), slog.Any("err", err))
-		}
-	}
-
-	finalStatus := consts.ScreeningTaskStatusCompleted
-	if failed > 0 && succeeded == 0 {
-		finalStatus = consts.ScreeningTaskStatusFailed
-	} else if failed > 0 {
-		finalStatus = consts.ScreeningTaskStatusFailed
-	}
-
-	if err := u.repo.UpdateScreeningTask(processCtx, task.ID, map[string]any{
-		"status":           finalStatus,
-		"finished_at":      time.Now(),
-		"resume_processed": processed,
-		"resume_succeeded": succeeded,
-		"resume_failed":    failed,
-		"agent_version":    u.matcher.Version(),
-	}); err != nil {
-		u.logger.Warn("更新任务完成状态失败", slog.Any("task_id", task.ID), slog.Any("err", err))
-	}
-
-	// 发布任务完成通知
-	if u.notificationUsecase != nil {
-		avgScore := safeAvg(scoreSum, scoreCnt)
-		u.publishScreeningTaskCompletedNotification(processCtx, task, processed, succeeded, avgScore)
-	}
-
-	metric := &db.ScreeningRunMetric{
-		TaskID:       task.ID,
-		AvgScore:     safeAvg(scoreSum, scoreCnt),
-		Histogram:    convertHistogramToMap(histogram),
-		TokensInput:  tokenInput,
-		TokensOutput: tokenOutput,
-		TotalCost:    0,
-	}
-	if _, err := u.repo.CreateScreeningRunMetric(processCtx, metric); err != nil {
-		u.logger.Warn("保存运行指标失败", slog.Any("task_id", task.ID), slog.Any("err", err))
-	}
 }
 
 // NewScreeningUsecase 实例化用例
@@ -119,8 +50,16 @@ func NewScreeningUsecase(
 	userRepo domain.UserRepo,
 	matcher service.MatchingService,
 	weightPreviewService service.WeightPreviewService,
+	shortlistService service.ShortlistService,
+	jobRecommendService service.JobRecommendService,
+	reportService service.ReportService,
 	notificationUsecase domain.NotificationUsecase,
 	weightTemplateRepo domain.WeightTemplateRepo,
+	dimensionRepo domain.ScreeningDimensionRepo,
+	scheduleRepo domain.ScreeningScheduleRepo,
+	producer queue.Producer,
+	redis *redis.Client,
+	s3Client *s3.MinioClient,
 	config *config.Config,
 	logger *slog.Logger,
 ) domain.ScreeningUsecase {
@@ -134,8 +73,16 @@ func NewScreeningUsecase(
 		resumeUsecase:        resumeUsecase,
 		matcher:              matcher,
 		weightPreviewService: weightPreviewService,
+		shortlistService:     shortlistService,
+		jobRecommendService:  jobRecommendService,
+		reportService:        reportService,
 		notificationUsecase:  notificationUsecase,
 		weightTemplateRepo:   weightTemplateRepo,
+		dimensionRepo:        dimensionRepo,
+		scheduleRepo:         scheduleRepo,
+		producer:             producer,
+		redis:                redis,
+		s3Client:             s3Client,
 		config:               config,
 		logger:               logger.With("module", "screening_usecase"),
 	}
@@ -177,6 +124,23 @@ func (u *ScreeningUsecase) CreateScreeningTask(ctx context.Context, req *domain.
 		}
 	}
 
+	if req.CostBudget != nil {
+		if err := u.validateCostBudgetModel(req.LLMConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	var redactionPolicy *domain.RedactionPolicy
+	if req.BlindMode {
+		redactionPolicy = domain.DefaultRedactionPolicy()
+	}
+
+	// 记录岗位自定义维度快照，任务执行期间维度被修改不影响本任务
+	customDimensions, err := u.listCustomDimensions(ctx, req.JobPositionID)
+	if err != nil {
+		return nil, err
+	}
+
 	taskID := uuid.New()
 	entity := &db.ScreeningTask{
 		ID:               taskID,
@@ -190,6 +154,13 @@ func (u *ScreeningUsecase) CreateScreeningTask(ctx context.Context, req *domain.
 		ResumeFailed:     0,
 		DimensionWeights: convertDimensionWeightsToMap(dimensionWeights),
 		LlmConfig:        req.LLMConfig,
+		TokenBudget:      req.TokenBudget,
+		CostBudget:       req.CostBudget,
+		DisableCache:     req.DisableCache,
+		KnockoutRules:    req.KnockoutRules.ToMap(),
+		BlindMode:        req.BlindMode,
+		RedactionPolicy:  redactionPolicy.ToMap(),
+		CustomDimensions: domain.CustomDimensionsToSnapshot(customDimensions),
 		CreatedAt:        time.Now(),
 		UpdatedAt:        time.Now(),
 	}
@@ -277,8 +248,8 @@ func (u *ScreeningUsecase) StartScreeningTask(ctx context.Context, req *domain.S
 		return nil, fmt.Errorf("更新任务状态失败: %w", err)
 	}
 
-	jobDetail, err := u.jobUsecase.GetByID(ctx, task.JobPositionID.String())
-	if err != nil {
+	// 提前校验岗位信息，避免投递后所有简历都失败
+	if _, err := u.jobUsecase.GetByID(ctx, task.JobPositionID.String()); err != nil {
 		if updateErr := u.repo.UpdateScreeningTask(ctx, task.ID, map[string]any{
 			"status":      consts.ScreeningTaskStatusFailed,
 			"finished_at": time.Now(),
@@ -288,10 +259,7 @@ func (u *ScreeningUsecase) StartScreeningTask(ctx context.Context, req *domain.S
 		return nil, fmt.Errorf("获取岗位信息失败: %w", err)
 	}
 
-	resumeFilter := &domain.ScreeningTaskResumeFilter{
-		TaskID: &task.ID,
-	}
-	taskResumes, _, err := u.repo.ListScreeningTaskResumes(ctx, resumeFilter)
+	taskResumes, err := u.repo.ListAllScreeningTaskResumes(ctx, task.ID)
 	if err != nil {
 		return nil, fmt.Errorf("获取任务简历列表失败: %w", err)
 	}
@@ -299,8 +267,17 @@ func (u *ScreeningUsecase) StartScreeningTask(ctx context.Context, req *domain.S
 		return nil, fmt.Errorf("任务下没有待处理的简历")
 	}
 
-	// 启动后台异步处理简历
-	go u.processScreeningTaskAsync(task, taskResumes, jobDetail)
+	// 每份简历投递一条队列消息，由筛选 Worker 处理，进程重启后可继续执行
+	if err := u.dispatchTaskResumes(ctx, task.ID, taskResumes); err != nil {
+		if updateErr := u.repo.UpdateScreeningTask(ctx, task.ID, map[string]any{
+			"status":      consts.ScreeningTaskStatusFailed,
+			"finished_at": time.Now(),
+		}); updateErr != nil {
+			u.logger.Error("更新任务状态失败", slog.Any("task_id", task.ID), slog.Any("err", updateErr))
+		}
+		return nil, err
+	}
+	u.logger.Info("筛选任务已投递", slog.Any("task_id", task.ID), slog.Int("resume_count", len(taskResumes)))
 
 	// 提取简历ID列表
 	resumeIDs := make([]uuid.UUID, len(taskResumes))
@@ -330,20 +307,12 @@ func (u *ScreeningUsecase) CancelScreeningTask(ctx context.Context, req *domain.
 		return nil, fmt.Errorf("获取任务信息失败: %w", err)
 	}
 
-	// 检查任务状态，只有运行中的任务可以取消
-	if task.Status != string(consts.ScreeningTaskStatusRunning) {
-		return nil, errcode.ErrInvalidParam.WithData("message", fmt.Sprintf("只有运行中的任务可以取消，当前状态: %s", task.Status))
+	// 检查任务状态，只有运行中或已暂停的任务可以取消
+	if task.Status != string(consts.ScreeningTaskStatusRunning) && task.Status != string(consts.ScreeningTaskStatusPaused) {
+		return nil, errcode.ErrInvalidParam.WithData("message", fmt.Sprintf("只有运行中或已暂停的任务可以取消，当前状态: %s", task.Status))
 	}
 
-	// 调用取消函数停止正在运行的任务
-	if cancelFunc, ok := u.taskContexts.LoadAndDelete(req.TaskID); ok {
-		if cancel, ok := cancelFunc.(context.CancelFunc); ok {
-			cancel() // 取消正在运行的上下文
-			u.logger.Info("成功取消正在运行的任务上下文", slog.Any("task_id", req.TaskID))
-		}
-	}
-
-	// 更新任务状态为已取消
+	// 更新任务状态为已取消，各副本上正在处理的简历通过轮询任务状态感知取消
 	now := time.Now()
 	if updateErr := u.repo.UpdateScreeningTask(ctx, req.TaskID, map[string]any{
 		"status":      consts.ScreeningTaskStatusCancelled,
@@ -354,10 +323,7 @@ func (u *ScreeningUsecase) CancelScreeningTask(ctx context.Context, req *domain.
 	}
 
 	// 更新所有待处理的简历状态为已取消
-	resumeFilter := &domain.ScreeningTaskResumeFilter{
-		TaskID: &req.TaskID,
-	}
-	taskResumes, _, err := u.repo.ListScreeningTaskResumes(ctx, resumeFilter)
+	taskResumes, err := u.repo.ListAllScreeningTaskResumes(ctx, req.TaskID)
 	if err != nil {
 		u.logger.Warn("获取任务简历列表失败", slog.Any("task_id", req.TaskID), slog.Any("err", err))
 	} else {
@@ -387,6 +353,69 @@ func (u *ScreeningUsecase) CancelScreeningTask(ctx context.Context, req *domain.
 	}, nil
 }
 
+// ContinueScreeningTask 继续因预算耗尽而暂停的任务，可同时调整预算
+func (u *ScreeningUsecase) ContinueScreeningTask(ctx context.Context, req *domain.ContinueScreeningTaskReq) (*domain.ContinueScreeningTaskResp, error) {
+	if req == nil || req.TaskID == uuid.Nil {
+		return nil, errcode.ErrInvalidParam.WithData("message", "任务ID不能为空")
+	}
+
+	task, err := u.repo.GetScreeningTask(ctx, req.TaskID)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errcode.ErrScreeningTaskNotFound
+		}
+		return nil, fmt.Errorf("获取任务信息失败: %w", err)
+	}
+	if task.Status != string(consts.ScreeningTaskStatusPaused) {
+		return nil, errcode.ErrInvalidParam.WithData("message", fmt.Sprintf("只有已暂停的任务可以继续，当前状态: %s", task.Status))
+	}
+
+	updates := map[string]any{
+		"status": consts.ScreeningTaskStatusRunning,
+	}
+	if req.TokenBudget != nil {
+		task.TokenBudget = req.TokenBudget
+		updates["token_budget"] = *req.TokenBudget
+	}
+	if req.CostBudget != nil {
+		if err := u.validateCostBudgetModel(task.LlmConfig); err != nil {
+			return nil, err
+		}
+		task.CostBudget = req.CostBudget
+		updates["cost_budget"] = *req.CostBudget
+	}
+	if reason := budgetExceededReason(task); reason != "" {
+		return nil, errcode.ErrInvalidParam.WithData("message", reason+"，请提高预算后再继续")
+	}
+
+	resumed, err := u.repo.UpdateScreeningTaskIfStatus(ctx, task.ID, consts.ScreeningTaskStatusPaused, updates)
+	if err != nil {
+		return nil, fmt.Errorf("更新任务状态失败: %w", err)
+	}
+	if !resumed {
+		return nil, errcode.ErrInvalidParam.WithData("message", "任务状态已变化，请刷新后重试")
+	}
+
+	taskResumes, err := u.repo.ListAllScreeningTaskResumes(ctx, task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("获取任务简历列表失败: %w", err)
+	}
+	pending := 0
+	for _, item := range taskResumes {
+		if item.Status == string(consts.ScreeningTaskResumeStatusPending) {
+			pending++
+		}
+	}
+	if err := u.dispatchTaskResumes(ctx, task.ID, taskResumes); err != nil {
+		return nil, err
+	}
+	// 暂停期间在途简历可能已全部完成
+	u.finalizeIfDone(ctx, task.ID)
+
+	u.logger.Info("筛选任务已继续", slog.Any("task_id", task.ID), slog.Int("pending_count", pending))
+	return &domain.ContinueScreeningTaskResp{TaskID: task.ID, PendingCount: pending}, nil
+}
+
 // DeleteScreeningTask 删除任务
 func (u *ScreeningUsecase) DeleteScreeningTask(ctx context.Context, req *domain.DeleteScreeningTaskReq) (*domain.DeleteScreeningTaskResp, error) {
 	if req == nil || req.TaskID == uuid.Nil {
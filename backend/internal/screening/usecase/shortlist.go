@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/db"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/errcode"
+)
+
+// GenerateScreeningShortlist 对任务得分最高的 N 位候选人做两两比较，生成并保存短名单排名。
+// 短名单独立于按得分的任务排名保存，重复生成会覆盖上一次结果
+func (u *ScreeningUsecase) GenerateScreeningShortlist(ctx context.Context, req *domain.GenerateScreeningShortlistReq) (*domain.ScreeningShortlistResp, error) {
+	task, err := u.repo.GetScreeningTask(ctx, req.TaskID)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errcode.ErrScreeningTaskNotFound
+		}
+		return nil, fmt.Errorf("获取任务信息失败: %w", err)
+	}
+	switch consts.ScreeningTaskStatus(task.Status) {
+	case consts.ScreeningTaskStatusCompleted, consts.ScreeningTaskStatusFailed:
+	case consts.ScreeningTaskStatusRunning:
+		return nil, errcode.ErrScreeningTaskRunning
+	default:
+		return nil, errcode.ErrInvalidParam.WithData("message", "任务完成后才能生成短名单")
+	}
+
+	topN := req.TopN
+	if topN <= 0 {
+		topN = domain.DefaultShortlistTopN
+	}
+	if topN > domain.MaxShortlistTopN {
+		topN = domain.MaxShortlistTopN
+	}
+
+	candidates, err := u.shortlistCandidates(ctx, task, topN)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) < 2 {
+		return nil, errcode.ErrInvalidParam.WithData("message", "至少需要两份有效筛选结果才能生成短名单")
+	}
+
+	jobProfile, err := u.jobUsecase.GetByID(ctx, task.JobPositionID.String())
+	if err != nil {
+		return nil, fmt.Errorf("获取岗位画像失败: %w", err)
+	}
+
+	llmConfig := req.LLMConfig
+	if len(llmConfig) == 0 {
+		llmConfig = task.LlmConfig
+	}
+
+	shortlist, err := u.shortlistService.RankShortlist(ctx, jobProfile, candidates, llmConfig)
+	if err != nil {
+		return nil, fmt.Errorf("生成短名单失败: %w", err)
+	}
+	shortlist.GeneratedBy = req.UserID
+
+	if err := u.repo.UpdateScreeningTask(ctx, task.ID, map[string]any{
+		"shortlist_ranking": shortlist.ToMap(),
+	}); err != nil {
+		return nil, fmt.Errorf("保存短名单失败: %w", err)
+	}
+
+	u.logger.Info("短名单已生成",
+		slog.Any("task_id", task.ID),
+		slog.Int("top_n", shortlist.TopN),
+		slog.Any("token_usage", shortlist.TokenUsage))
+
+	return &domain.ScreeningShortlistResp{TaskID: task.ID, Shortlist: shortlist}, nil
+}
+
+// GetScreeningShortlist 获取任务最近一次生成的短名单
+func (u *ScreeningUsecase) GetScreeningShortlist(ctx context.Context, req *domain.GetScreeningShortlistReq) (*domain.ScreeningShortlistResp, error) {
+	task, err := u.repo.GetScreeningTask(ctx, req.TaskID)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errcode.ErrScreeningTaskNotFound
+		}
+		return nil, fmt.Errorf("获取任务信息失败: %w", err)
+	}
+	return &domain.ScreeningShortlistResp{
+		TaskID:    task.ID,
+		Shortlist: domain.ParseScreeningShortlist(task.ShortlistRanking),
+	}, nil
+}
+
+// shortlistCandidates 按综合得分取前 N 份筛选结果，未通过硬性条件的结果得分为 0，不参与比较
+func (u *ScreeningUsecase) shortlistCandidates(ctx context.Context, task *db.ScreeningTask, topN int) ([]*domain.ShortlistCandidate, error) {
+	entities, _, err := u.repo.ListScreeningResults(ctx, &domain.ScreeningResultFilter{
+		TaskID:   &task.ID,
+		Page:     1,
+		PageSize: topN,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("获取筛选结果失败: %w", err)
+	}
+
+	candidates := make([]*domain.ShortlistCandidate, 0, topN)
+	for _, entity := range entities {
+		if len(entity.KnockoutReasons) > 0 {
+			continue
+		}
+		result, err := toScreeningResult(entity)
+		if err != nil {
+			u.logger.Warn("解析筛选结果失败", slog.Any("task_id", task.ID), slog.Any("resume_id", entity.ResumeID), slog.Any("err", err))
+			continue
+		}
+		candidates = append(candidates, &domain.ShortlistCandidate{ResumeID: entity.ResumeID, Result: result})
+	}
+	return candidates, nil
+}
@@ -0,0 +1,225 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/chaitin/WhaleHire/backend/internal/queue"
+	"github.com/chaitin/WhaleHire/backend/internal/screening/usecase"
+)
+
+const (
+	// consumerGroup 筛选 Worker 所属的消费者组，各副本共享同一组以分摊消息
+	consumerGroup = "screening-worker"
+	// maxConcurrency 单个副本同时处理的简历数量上限
+	maxConcurrency = 10
+	// recoveryInterval 定期巡检运行中任务的间隔，心跳超时的简历会被重新投递
+	recoveryInterval = time.Minute
+	// scheduleInterval 巡检岗位自动筛选配置的间隔
+	scheduleInterval = time.Minute
+)
+
+// ScreeningWorker 消费筛选队列，逐份处理任务简历
+type ScreeningWorker struct {
+	consumer queue.Consumer
+	usecase  domain.ScreeningUsecase
+	logger   *slog.Logger
+	wg       sync.WaitGroup
+}
+
+// NewScreeningWorker 创建筛选工作器
+func NewScreeningWorker(
+	consumer queue.Consumer,
+	usecase domain.ScreeningUsecase,
+	logger *slog.Logger,
+) *ScreeningWorker {
+	return &ScreeningWorker{
+		consumer: consumer,
+		usecase:  usecase,
+		logger:   logger.With("module", "screening_worker"),
+	}
+}
+
+// Start 启动工作器：先恢复上次退出时仍在运行的任务，再订阅筛选队列，并定期巡检心跳超时的简历与岗位自动筛选配置
+func (w *ScreeningWorker) Start(ctx context.Context) error {
+	w.logger.InfoContext(ctx, "Starting screening worker")
+
+	w.recoverTasks(ctx)
+
+	streamName := string(consts.QueueStreamScreeningTaskResumes)
+	msgCh, err := w.consumer.Subscribe(ctx, streamName, consumerGroup, consumerName())
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to screening stream: %w", err)
+	}
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		w.processMessages(ctx, msgCh)
+	}()
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		w.recoverLoop(ctx)
+	}()
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		w.scheduleLoop(ctx)
+	}()
+
+	w.logger.InfoContext(ctx, "Screening worker started successfully")
+	return nil
+}
+
+// Stop 等待正在处理的消息退出。消费者与其他 Worker 共享，不在此关闭
+func (w *ScreeningWorker) Stop(ctx context.Context) error {
+	w.logger.InfoContext(ctx, "Stopping screening worker")
+	w.wg.Wait()
+	return nil
+}
+
+// recoverLoop 定期恢复运行中的任务，处理者退出且未被队列重新投递的简历由此补投
+func (w *ScreeningWorker) recoverLoop(ctx context.Context) {
+	ticker := time.NewTicker(recoveryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.recoverTasks(ctx)
+		}
+	}
+}
+
+// recoverTasks 恢复运行中的任务，多副本间由咨询锁保证只有一个副本执行
+func (w *ScreeningWorker) recoverTasks(ctx context.Context) {
+	if err := w.usecase.RecoverRunningTasks(ctx); err != nil && ctx.Err() == nil {
+		w.logger.ErrorContext(ctx, "Failed to recover running screening tasks", slog.String("error", err.Error()))
+	}
+}
+
+// scheduleLoop 定期为到期的岗位自动筛选配置发起筛选任务，多副本间由咨询锁保证只有一个副本执行
+func (w *ScreeningWorker) scheduleLoop(ctx context.Context) {
+	ticker := time.NewTicker(scheduleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := w.usecase.RunScreeningSchedules(ctx, now); err != nil && ctx.Err() == nil {
+				w.logger.ErrorContext(ctx, "Failed to run screening schedules", slog.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+// processMessages 并发处理消息，并发数受 maxConcurrency 限制
+func (w *ScreeningWorker) processMessages(ctx context.Context, msgCh <-chan queue.Message) {
+	sem := make(chan struct{}, maxConcurrency)
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.InfoContext(ctx, "Screening worker stopped")
+			return
+		case msg, ok := <-msgCh:
+			if !ok {
+				return
+			}
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			w.wg.Add(1)
+			go func() {
+				defer func() {
+					<-sem
+					w.wg.Done()
+				}()
+				w.handleMessage(ctx, msg)
+			}()
+		}
+	}
+}
+
+// handleMessage 处理单条消息，仅在处理完成或消息无效时确认
+func (w *ScreeningWorker) handleMessage(ctx context.Context, msg queue.Message) {
+	taskID, resumeID, err := parseMessage(msg)
+	if err != nil {
+		w.logger.ErrorContext(ctx, "Invalid screening message",
+			slog.String("message_id", msg.ID),
+			slog.String("error", err.Error()),
+		)
+		w.ackMessage(ctx, msg)
+		return
+	}
+
+	if err := w.usecase.ProcessTaskResume(ctx, taskID, resumeID); err != nil {
+		if errors.Is(err, usecase.ErrTaskResumeBusy) {
+			w.logger.InfoContext(ctx, "Screening task resume is busy, waiting for redelivery",
+				slog.String("message_id", msg.ID),
+				slog.String("task_id", taskID.String()),
+				slog.String("resume_id", resumeID.String()),
+			)
+			return
+		}
+		w.logger.ErrorContext(ctx, "Failed to process screening task resume",
+			slog.String("message_id", msg.ID),
+			slog.String("task_id", taskID.String()),
+			slog.String("resume_id", resumeID.String()),
+			slog.Int64("delivery_count", msg.DeliveryCount),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	w.ackMessage(ctx, msg)
+}
+
+// ackMessage 确认消息
+func (w *ScreeningWorker) ackMessage(ctx context.Context, msg queue.Message) {
+	if err := w.consumer.Ack(ctx, msg.Stream, consumerGroup, msg.ID); err != nil {
+		w.logger.ErrorContext(ctx, "Failed to ack message",
+			slog.String("message_id", msg.ID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// parseMessage 解析消息中的任务ID与简历ID
+func parseMessage(msg queue.Message) (uuid.UUID, uuid.UUID, error) {
+	rawTaskID, _ := msg.Data["task_id"].(string)
+	taskID, err := uuid.Parse(rawTaskID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid task_id %q: %w", rawTaskID, err)
+	}
+	rawResumeID, _ := msg.Data["resume_id"].(string)
+	resumeID, err := uuid.Parse(rawResumeID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid resume_id %q: %w", rawResumeID, err)
+	}
+	return taskID, resumeID, nil
+}
+
+// consumerName 以主机名和进程号区分同一消费者组内的副本
+func consumerName() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "screening"
+	}
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
@@ -0,0 +1,36 @@
+package worker
+
+import (
+	"context"
+)
+
+// WorkerServicer 将 ScreeningWorker 封装为符合 service.Servicer 的后台服务。
+// 上下文在构造时创建，Start 与 Stop 可能在不同协程中调用，无需额外同步
+type WorkerServicer struct {
+	w      *ScreeningWorker
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewServicer 创建一个基于 ScreeningWorker 的后台服务封装。
+func NewServicer(w *ScreeningWorker) *WorkerServicer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &WorkerServicer{w: w, ctx: ctx, cancel: cancel}
+}
+
+func (ws *WorkerServicer) Name() string { return "Screening Worker" }
+
+// Start 启动筛选 Worker，并阻塞直到 Stop 被调用。Stop 先于 Start 完成时立即返回
+func (ws *WorkerServicer) Start() error {
+	if err := ws.w.Start(ws.ctx); err != nil {
+		return err
+	}
+	<-ws.ctx.Done()
+	return nil
+}
+
+// Stop 取消上下文并等待正在处理的简历退出，未完成的消息保留在队列中由其他副本或重启后继续处理。
+func (ws *WorkerServicer) Stop() error {
+	ws.cancel()
+	return ws.w.Stop(ws.ctx)
+}
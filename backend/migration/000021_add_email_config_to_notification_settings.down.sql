@@ -0,0 +1,5 @@
+-- Migration: 000021_add_email_config_to_notification_settings (DOWN)
+-- Created: 2025-01-15
+-- Description: Remove email_config column from notification_settings table
+
+ALTER TABLE "notification_settings" DROP COLUMN IF EXISTS "email_config";
@@ -0,0 +1,8 @@
+-- Migration: 000021_add_email_config_to_notification_settings
+-- Created: 2025-01-15
+-- Description: Add email_config column to notification_settings table to support SMTP email channel
+
+ALTER TABLE "notification_settings"
+ADD COLUMN IF NOT EXISTS "email_config" jsonb NULL;
+
+COMMENT ON COLUMN "notification_settings"."email_config" IS '邮件通知配置';
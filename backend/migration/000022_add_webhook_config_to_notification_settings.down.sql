@@ -0,0 +1,5 @@
+-- Migration: 000022_add_webhook_config_to_notification_settings (DOWN)
+-- Created: 2025-01-16
+-- Description: Remove webhook_config column from notification_settings table
+
+ALTER TABLE "notification_settings" DROP COLUMN IF EXISTS "webhook_config";
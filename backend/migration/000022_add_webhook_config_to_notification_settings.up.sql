@@ -0,0 +1,8 @@
+-- Migration: 000022_add_webhook_config_to_notification_settings
+-- Created: 2025-01-16
+-- Description: Add webhook_config column to notification_settings table to support signed outbound webhooks
+
+ALTER TABLE "notification_settings"
+ADD COLUMN IF NOT EXISTS "webhook_config" jsonb NULL;
+
+COMMENT ON COLUMN "notification_settings"."webhook_config" IS 'Webhook通知配置';
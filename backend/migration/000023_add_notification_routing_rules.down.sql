@@ -0,0 +1,9 @@
+-- Migration: 000023_add_notification_routing_rules (DOWN)
+-- Created: 2025-01-17
+-- Description: Remove notification routing rules and event setting link
+
+DROP INDEX IF EXISTS idx_notification_events_setting_id;
+
+ALTER TABLE "notification_events" DROP COLUMN IF EXISTS "setting_id";
+
+ALTER TABLE "notification_settings" DROP COLUMN IF EXISTS "routing_rules";
@@ -0,0 +1,14 @@
+-- Migration: 000023_add_notification_routing_rules
+-- Created: 2025-01-17
+-- Description: Add routing rules to notification_settings and link notification_events to the setting they are delivered to
+
+ALTER TABLE "notification_settings"
+ADD COLUMN IF NOT EXISTS "routing_rules" jsonb NULL;
+
+ALTER TABLE "notification_events"
+ADD COLUMN IF NOT EXISTS "setting_id" uuid NULL;
+
+CREATE INDEX IF NOT EXISTS idx_notification_events_setting_id ON notification_events(setting_id);
+
+COMMENT ON COLUMN "notification_settings"."routing_rules" IS '路由规则，为空时接收所有事件';
+COMMENT ON COLUMN "notification_events"."setting_id" IS '投递的通知设置ID，为空时按渠道广播';
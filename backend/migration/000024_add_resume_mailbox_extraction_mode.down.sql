@@ -0,0 +1,11 @@
+-- Migration: 000024_add_resume_mailbox_extraction_mode (DOWN)
+-- Created: 2025-01-18
+-- Description: Remove mailbox resume extraction mode and body/link statistics
+
+ALTER TABLE "resume_mailbox_statistics"
+DROP COLUMN IF EXISTS "link_resumes",
+DROP COLUMN IF EXISTS "body_resumes";
+
+ALTER TABLE "resume_mailbox_settings"
+DROP COLUMN IF EXISTS "link_allowed_domains",
+DROP COLUMN IF EXISTS "extraction_mode";
@@ -0,0 +1,16 @@
+-- Migration: 000024_add_resume_mailbox_extraction_mode
+-- Created: 2025-01-18
+-- Description: Add resume extraction mode (attachments / HTML body / allow-listed links) to mailbox settings and track body/link resumes in statistics
+
+ALTER TABLE "resume_mailbox_settings"
+ADD COLUMN IF NOT EXISTS "extraction_mode" character varying(32) NOT NULL DEFAULT 'attachments',
+ADD COLUMN IF NOT EXISTS "link_allowed_domains" jsonb NULL;
+
+ALTER TABLE "resume_mailbox_statistics"
+ADD COLUMN IF NOT EXISTS "body_resumes" integer NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS "link_resumes" integer NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN "resume_mailbox_settings"."extraction_mode" IS '简历提取方式：attachments/attachments_body/attachments_links';
+COMMENT ON COLUMN "resume_mailbox_settings"."link_allowed_domains" IS '允许跟随下载简历链接的域名白名单';
+COMMENT ON COLUMN "resume_mailbox_statistics"."body_resumes" IS '从邮件正文提取入库的简历数量';
+COMMENT ON COLUMN "resume_mailbox_statistics"."link_resumes" IS '从邮件链接下载入库的简历数量';
@@ -0,0 +1,6 @@
+-- Migration: 000025_add_resume_mailbox_rules (DOWN)
+-- Created: 2025-01-20
+-- Description: Remove mailbox filtering rules
+
+ALTER TABLE "resume_mailbox_settings"
+DROP COLUMN IF EXISTS "rules";
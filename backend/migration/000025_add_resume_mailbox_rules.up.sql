@@ -0,0 +1,8 @@
+-- Migration: 000025_add_resume_mailbox_rules
+-- Created: 2025-01-20
+-- Description: Add ordered filtering rules (skip / assign / tag / screen) to mailbox settings
+
+ALTER TABLE "resume_mailbox_settings"
+ADD COLUMN IF NOT EXISTS "rules" jsonb NULL;
+
+COMMENT ON COLUMN "resume_mailbox_settings"."rules" IS '邮件过滤规则，按顺序匹配';
@@ -0,0 +1,7 @@
+-- Migration: 000026_add_screening_task_resume_tokens (DOWN)
+-- Created: 2025-01-22
+-- Description: Remove per task resume token usage
+
+ALTER TABLE "screening_task_resumes"
+DROP COLUMN IF EXISTS "tokens_output",
+DROP COLUMN IF EXISTS "tokens_input";
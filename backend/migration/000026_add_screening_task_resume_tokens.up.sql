@@ -0,0 +1,10 @@
+-- Migration: 000026_add_screening_task_resume_tokens
+-- Created: 2025-01-22
+-- Description: Track token usage per task resume so queued screening can aggregate task metrics from the database
+
+ALTER TABLE "screening_task_resumes"
+ADD COLUMN IF NOT EXISTS "tokens_input" bigint NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS "tokens_output" bigint NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN "screening_task_resumes"."tokens_input" IS '输入Token数';
+COMMENT ON COLUMN "screening_task_resumes"."tokens_output" IS '输出Token数';
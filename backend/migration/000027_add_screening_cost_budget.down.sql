@@ -0,0 +1,15 @@
+-- Migration: 000027_add_screening_cost_budget (DOWN)
+-- Created: 2025-01-23
+-- Description: Remove screening cost accounting and task budgets
+
+DROP INDEX IF EXISTS "idx_screening_task_resumes_processed_at";
+
+ALTER TABLE "screening_task_resumes"
+DROP COLUMN IF EXISTS "total_cost";
+
+ALTER TABLE "screening_tasks"
+DROP COLUMN IF EXISTS "total_cost",
+DROP COLUMN IF EXISTS "tokens_output",
+DROP COLUMN IF EXISTS "tokens_input",
+DROP COLUMN IF EXISTS "cost_budget",
+DROP COLUMN IF EXISTS "token_budget";
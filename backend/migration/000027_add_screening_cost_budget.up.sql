@@ -0,0 +1,24 @@
+-- Migration: 000027_add_screening_cost_budget
+-- Created: 2025-01-23
+-- Description: Record LLM cost for screening task resumes and tasks, and add optional per-task token/cost budgets
+
+ALTER TABLE "screening_tasks"
+ADD COLUMN IF NOT EXISTS "token_budget" bigint,
+ADD COLUMN IF NOT EXISTS "cost_budget" double precision,
+ADD COLUMN IF NOT EXISTS "tokens_input" bigint NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS "tokens_output" bigint NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS "total_cost" double precision NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN "screening_tasks"."token_budget" IS 'Token预算，超出时暂停任务';
+COMMENT ON COLUMN "screening_tasks"."cost_budget" IS '费用预算，超出时暂停任务';
+COMMENT ON COLUMN "screening_tasks"."tokens_input" IS '累计输入Token数';
+COMMENT ON COLUMN "screening_tasks"."tokens_output" IS '累计输出Token数';
+COMMENT ON COLUMN "screening_tasks"."total_cost" IS '累计模型调用费用';
+
+ALTER TABLE "screening_task_resumes"
+ADD COLUMN IF NOT EXISTS "total_cost" double precision NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN "screening_task_resumes"."total_cost" IS '模型调用费用';
+
+-- 按处理时间统计月度费用
+CREATE INDEX IF NOT EXISTS "idx_screening_task_resumes_processed_at" ON "screening_task_resumes" ("processed_at");
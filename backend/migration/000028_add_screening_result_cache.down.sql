@@ -0,0 +1,14 @@
+-- Migration: 000028_add_screening_result_cache (DOWN)
+-- Created: 2025-01-24
+-- Description: Remove screening result fingerprints and the task cache switch
+
+ALTER TABLE "screening_tasks"
+DROP COLUMN IF EXISTS "disable_cache";
+
+DROP INDEX IF EXISTS "idx_screening_results_fingerprint";
+
+ALTER TABLE "screening_results"
+DROP COLUMN IF EXISTS "cached_from_id",
+DROP COLUMN IF EXISTS "agent_hash",
+DROP COLUMN IF EXISTS "resume_hash",
+DROP COLUMN IF EXISTS "job_hash";
@@ -0,0 +1,21 @@
+-- Migration: 000028_add_screening_result_cache
+-- Created: 2025-01-24
+-- Description: Store content fingerprints on screening results so unchanged job/resume pairs can reuse earlier matching output
+
+ALTER TABLE "screening_results"
+ADD COLUMN IF NOT EXISTS "job_hash" character varying(64),
+ADD COLUMN IF NOT EXISTS "resume_hash" character varying(64),
+ADD COLUMN IF NOT EXISTS "agent_hash" character varying(64),
+ADD COLUMN IF NOT EXISTS "cached_from_id" uuid;
+
+COMMENT ON COLUMN "screening_results"."job_hash" IS '岗位画像内容哈希';
+COMMENT ON COLUMN "screening_results"."resume_hash" IS '简历内容哈希';
+COMMENT ON COLUMN "screening_results"."agent_hash" IS '子Agent版本与模型指纹';
+COMMENT ON COLUMN "screening_results"."cached_from_id" IS '复用的历史筛选结果ID';
+
+CREATE INDEX IF NOT EXISTS "idx_screening_results_fingerprint" ON "screening_results" ("job_hash", "resume_hash", "agent_hash");
+
+ALTER TABLE "screening_tasks"
+ADD COLUMN IF NOT EXISTS "disable_cache" boolean NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN "screening_tasks"."disable_cache" IS '禁用筛选结果缓存，强制重新调用模型';
@@ -0,0 +1,16 @@
+-- Migration: 000029_add_screening_result_override (DOWN)
+-- Created: 2025-01-25
+-- Description: Remove recruiter overrides from screening results
+
+DROP INDEX IF EXISTS "idx_screening_results_overridden_at";
+
+ALTER TABLE "screening_results"
+DROP CONSTRAINT IF EXISTS "chk_screening_results_human_match_level";
+
+ALTER TABLE "screening_results"
+DROP COLUMN IF EXISTS "overridden_at",
+DROP COLUMN IF EXISTS "overridden_by",
+DROP COLUMN IF EXISTS "override_reason",
+DROP COLUMN IF EXISTS "human_dimension_scores",
+DROP COLUMN IF EXISTS "human_match_level",
+DROP COLUMN IF EXISTS "human_overall_score";
@@ -0,0 +1,24 @@
+-- Migration: 000029_add_screening_result_override
+-- Created: 2025-01-25
+-- Description: Store recruiter overrides of screening scores next to the original agent output
+
+ALTER TABLE "screening_results"
+ADD COLUMN IF NOT EXISTS "human_overall_score" double precision,
+ADD COLUMN IF NOT EXISTS "human_match_level" varchar(20),
+ADD COLUMN IF NOT EXISTS "human_dimension_scores" jsonb,
+ADD COLUMN IF NOT EXISTS "override_reason" text,
+ADD COLUMN IF NOT EXISTS "overridden_by" uuid,
+ADD COLUMN IF NOT EXISTS "overridden_at" timestamptz;
+
+ALTER TABLE "screening_results"
+ADD CONSTRAINT "chk_screening_results_human_match_level" CHECK ("human_match_level" IN ('excellent', 'good', 'fair', 'poor', 'no_match'));
+
+COMMENT ON COLUMN "screening_results"."human_overall_score" IS '人工校准的综合得分';
+COMMENT ON COLUMN "screening_results"."human_match_level" IS '人工校准的匹配等级';
+COMMENT ON COLUMN "screening_results"."human_dimension_scores" IS '人工校准的各维度得分';
+COMMENT ON COLUMN "screening_results"."override_reason" IS '人工校准原因';
+COMMENT ON COLUMN "screening_results"."overridden_by" IS '校准人ID';
+COMMENT ON COLUMN "screening_results"."overridden_at" IS '校准时间';
+
+-- 校准报表按校准时间筛选
+CREATE INDEX IF NOT EXISTS "idx_screening_results_overridden_at" ON "screening_results" ("overridden_at");
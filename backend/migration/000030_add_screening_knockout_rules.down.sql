@@ -0,0 +1,9 @@
+-- Migration: 000030_add_screening_knockout_rules (DOWN)
+-- Created: 2025-01-26
+-- Description: Remove screening knock-out rules
+
+ALTER TABLE "screening_results"
+DROP COLUMN IF EXISTS "knockout_reasons";
+
+ALTER TABLE "screening_tasks"
+DROP COLUMN IF EXISTS "knockout_rules";
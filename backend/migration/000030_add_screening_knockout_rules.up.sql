@@ -0,0 +1,13 @@
+-- Migration: 000030_add_screening_knockout_rules
+-- Created: 2025-01-26
+-- Description: Add hard knock-out rules to screening tasks and record the failed rules on screening results
+
+ALTER TABLE "screening_tasks"
+ADD COLUMN IF NOT EXISTS "knockout_rules" jsonb;
+
+COMMENT ON COLUMN "screening_tasks"."knockout_rules" IS '硬性淘汰条件，调用模型前校验';
+
+ALTER TABLE "screening_results"
+ADD COLUMN IF NOT EXISTS "knockout_reasons" jsonb;
+
+COMMENT ON COLUMN "screening_results"."knockout_reasons" IS '未通过的硬性淘汰条件，非空表示未调用模型直接判定为不匹配';
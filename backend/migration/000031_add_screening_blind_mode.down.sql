@@ -0,0 +1,7 @@
+-- Migration: 000031_add_screening_blind_mode (DOWN)
+-- Created: 2025-01-27
+-- Description: Remove blind screening mode
+
+ALTER TABLE "screening_tasks"
+DROP COLUMN IF EXISTS "redaction_policy",
+DROP COLUMN IF EXISTS "blind_mode";
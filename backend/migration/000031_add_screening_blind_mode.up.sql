@@ -0,0 +1,10 @@
+-- Migration: 000031_add_screening_blind_mode
+-- Created: 2025-01-27
+-- Description: Add blind screening mode and record the redaction policy applied to each screening task
+
+ALTER TABLE "screening_tasks"
+ADD COLUMN IF NOT EXISTS "blind_mode" boolean NOT NULL DEFAULT false,
+ADD COLUMN IF NOT EXISTS "redaction_policy" jsonb;
+
+COMMENT ON COLUMN "screening_tasks"."blind_mode" IS '盲筛模式，匹配前隐去候选人个人信息';
+COMMENT ON COLUMN "screening_tasks"."redaction_policy" IS '盲筛脱敏策略快照，用于合规审计';
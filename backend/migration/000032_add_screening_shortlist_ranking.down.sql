@@ -0,0 +1,6 @@
+-- Migration: 000032_add_screening_shortlist_ranking (DOWN)
+-- Created: 2025-01-27
+-- Description: Remove the screening shortlist ranking
+
+ALTER TABLE "screening_tasks"
+DROP COLUMN IF EXISTS "shortlist_ranking";
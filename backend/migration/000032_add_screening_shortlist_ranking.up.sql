@@ -0,0 +1,8 @@
+-- Migration: 000032_add_screening_shortlist_ranking
+-- Created: 2025-01-27
+-- Description: Store the pairwise-comparison shortlist ranking generated for a screening task
+
+ALTER TABLE "screening_tasks"
+ADD COLUMN IF NOT EXISTS "shortlist_ranking" jsonb;
+
+COMMENT ON COLUMN "screening_tasks"."shortlist_ranking" IS '两两比较生成的短名单排名';
@@ -0,0 +1,14 @@
+-- Migration: 000033_add_screening_dimensions (DOWN)
+-- Created: 2025-01-27
+-- Description: Drop per-job custom matching dimensions and their stored results
+
+ALTER TABLE "screening_results"
+DROP COLUMN IF EXISTS "custom_details";
+
+ALTER TABLE "screening_tasks"
+DROP COLUMN IF EXISTS "custom_dimensions";
+
+DROP INDEX IF EXISTS "uniq_screening_dimensions_job_key";
+DROP INDEX IF EXISTS "idx_screening_dimensions_job_position_id";
+
+DROP TABLE IF EXISTS "screening_dimensions";
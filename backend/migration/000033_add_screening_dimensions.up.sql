@@ -0,0 +1,44 @@
+-- Migration: 000033_add_screening_dimensions
+-- Created: 2025-01-27
+-- Description: Create screening_dimensions table for per-job custom matching dimensions and store their results
+
+CREATE TABLE IF NOT EXISTS "screening_dimensions" (
+    "id" uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    "deleted_at" timestamptz NULL,
+    "job_position_id" uuid NOT NULL,
+    "key" varchar(50) NOT NULL,
+    "name" varchar(100) NOT NULL,
+    "description" varchar(500),
+    "prompt" text NOT NULL,
+    "weight" double precision NOT NULL DEFAULT 0,
+    "created_by" uuid,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now()
+);
+
+ALTER TABLE "screening_dimensions"
+ADD CONSTRAINT "screening_dimensions_job_position_id_fk"
+FOREIGN KEY ("job_position_id") REFERENCES "job_position" ("id") ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS "idx_screening_dimensions_job_position_id" ON "screening_dimensions" ("job_position_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "uniq_screening_dimensions_job_key" ON "screening_dimensions" ("job_position_id", "key") WHERE "deleted_at" IS NULL;
+
+COMMENT ON TABLE "screening_dimensions" IS '岗位自定义匹配维度表';
+COMMENT ON COLUMN "screening_dimensions"."job_position_id" IS '所属岗位ID';
+COMMENT ON COLUMN "screening_dimensions"."key" IS '维度标识，同一岗位内唯一';
+COMMENT ON COLUMN "screening_dimensions"."name" IS '维度名称';
+COMMENT ON COLUMN "screening_dimensions"."description" IS '维度说明';
+COMMENT ON COLUMN "screening_dimensions"."prompt" IS '维度评估提示词';
+COMMENT ON COLUMN "screening_dimensions"."weight" IS '维度权重';
+COMMENT ON COLUMN "screening_dimensions"."created_by" IS '创建者ID';
+COMMENT ON COLUMN "screening_dimensions"."deleted_at" IS '删除时间（软删除）';
+
+ALTER TABLE "screening_tasks"
+ADD COLUMN IF NOT EXISTS "custom_dimensions" jsonb;
+
+COMMENT ON COLUMN "screening_tasks"."custom_dimensions" IS '创建任务时岗位自定义匹配维度快照';
+
+ALTER TABLE "screening_results"
+ADD COLUMN IF NOT EXISTS "custom_details" jsonb;
+
+COMMENT ON COLUMN "screening_results"."custom_details" IS '自定义维度匹配详情，按维度标识索引';
@@ -0,0 +1,9 @@
+-- Migration: 000034_add_screening_node_run_cache_key (DOWN)
+-- Created: 2025-01-28
+-- Description: Remove per-dimension reuse keys from node runs
+
+DROP INDEX IF EXISTS "idx_screening_node_runs_cache_key";
+
+ALTER TABLE "screening_node_runs"
+DROP COLUMN IF EXISTS "reused_from_id",
+DROP COLUMN IF EXISTS "cache_key";
@@ -0,0 +1,12 @@
+-- Migration: 000034_add_screening_node_run_cache_key
+-- Created: 2025-01-28
+-- Description: Key node runs by agent input, version and model so unchanged dimensions can be reused individually
+
+ALTER TABLE "screening_node_runs"
+ADD COLUMN IF NOT EXISTS "cache_key" character varying(64),
+ADD COLUMN IF NOT EXISTS "reused_from_id" uuid;
+
+COMMENT ON COLUMN "screening_node_runs"."cache_key" IS '维度结果复用键，由节点输入内容、Agent版本与模型计算';
+COMMENT ON COLUMN "screening_node_runs"."reused_from_id" IS '复用的历史节点运行ID';
+
+CREATE INDEX IF NOT EXISTS "idx_screening_node_runs_cache_key" ON "screening_node_runs" ("cache_key");
@@ -0,0 +1,6 @@
+-- Migration: 000035_add_resume_source (DOWN)
+-- Created: 2025-01-29
+-- Description: Remove the resume source column
+
+ALTER TABLE "resumes"
+DROP COLUMN IF EXISTS "source";
@@ -0,0 +1,8 @@
+-- Migration: 000035_add_resume_source
+-- Created: 2025-01-29
+-- Description: Record where a resume came from so mailbox rule tags survive even when no job is assigned
+
+ALTER TABLE "resumes"
+ADD COLUMN IF NOT EXISTS "source" character varying;
+
+COMMENT ON COLUMN "resumes"."source" IS '简历来源：email、manual，或邮箱规则标记的渠道';
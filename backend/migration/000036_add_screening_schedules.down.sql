@@ -0,0 +1,8 @@
+-- Migration: 000036_add_screening_schedules (DOWN)
+-- Created: 2025-01-29
+-- Description: Drop automatic screening schedules
+
+DROP INDEX IF EXISTS "idx_screening_schedules_enabled";
+DROP INDEX IF EXISTS "uniq_screening_schedules_job_position_id";
+
+DROP TABLE IF EXISTS "screening_schedules";
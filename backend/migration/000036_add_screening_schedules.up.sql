@@ -0,0 +1,36 @@
+-- Migration: 000036_add_screening_schedules
+-- Created: 2025-01-29
+-- Description: Create screening_schedules table for automatic and nightly digest screening of new applicants
+
+CREATE TABLE IF NOT EXISTS "screening_schedules" (
+    "id" uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    "job_position_id" uuid NOT NULL,
+    "mode" varchar NOT NULL DEFAULT 'instant',
+    "weight_template_id" uuid,
+    "digest_hour" bigint NOT NULL DEFAULT 2,
+    "enabled" boolean NOT NULL DEFAULT true,
+    "created_by" uuid NOT NULL,
+    "last_run_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now()
+);
+
+ALTER TABLE "screening_schedules"
+ADD CONSTRAINT "screening_schedules_job_position_id_fk"
+FOREIGN KEY ("job_position_id") REFERENCES "job_position" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "screening_schedules"
+ADD CONSTRAINT "screening_schedules_weight_template_id_fk"
+FOREIGN KEY ("weight_template_id") REFERENCES "weight_template" ("id") ON DELETE SET NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS "uniq_screening_schedules_job_position_id" ON "screening_schedules" ("job_position_id");
+CREATE INDEX IF NOT EXISTS "idx_screening_schedules_enabled" ON "screening_schedules" ("enabled");
+
+COMMENT ON TABLE "screening_schedules" IS '岗位自动筛选配置表';
+COMMENT ON COLUMN "screening_schedules"."job_position_id" IS '岗位ID，每个岗位一条配置';
+COMMENT ON COLUMN "screening_schedules"."mode" IS '自动筛选模式：instant/digest';
+COMMENT ON COLUMN "screening_schedules"."weight_template_id" IS '岗位默认权重模板ID，为空时使用系统默认权重';
+COMMENT ON COLUMN "screening_schedules"."digest_hour" IS '每日汇总筛选的执行时刻（0-23 时）';
+COMMENT ON COLUMN "screening_schedules"."enabled" IS '是否启用';
+COMMENT ON COLUMN "screening_schedules"."created_by" IS '创建者ID，自动发起的筛选任务归属该用户';
+COMMENT ON COLUMN "screening_schedules"."last_run_at" IS '最近一次执行时间';
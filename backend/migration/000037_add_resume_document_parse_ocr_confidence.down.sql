@@ -0,0 +1,6 @@
+-- Migration: 000037_add_resume_document_parse_ocr_confidence (DOWN)
+-- Created: 2025-01-29
+-- Description: Remove the OCR confidence column
+
+ALTER TABLE "resume_document_parses"
+DROP COLUMN IF EXISTS "ocr_confidence";
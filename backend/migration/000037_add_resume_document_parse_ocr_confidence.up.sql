@@ -0,0 +1,8 @@
+-- Migration: 000037_add_resume_document_parse_ocr_confidence
+-- Created: 2025-01-29
+-- Description: Record OCR confidence for scanned and image-based resumes
+
+ALTER TABLE "resume_document_parses"
+ADD COLUMN IF NOT EXISTS "ocr_confidence" double precision;
+
+COMMENT ON COLUMN "resume_document_parses"."ocr_confidence" IS 'OCR识别平均置信度（0~1），未经过OCR时为空';
@@ -0,0 +1,19 @@
+-- Migration: 000038_add_resume_duplicates (DOWN)
+-- Created: 2025-01-29
+-- Description: Drop resume_duplicates and the resume duplicate detection columns
+
+DROP TABLE IF EXISTS "resume_duplicates";
+
+DROP INDEX IF EXISTS "idx_resumes_file_hash";
+DROP INDEX IF EXISTS "idx_resumes_normalized_phone";
+DROP INDEX IF EXISTS "idx_resumes_normalized_email";
+DROP INDEX IF EXISTS "idx_resumes_normalized_name";
+DROP INDEX IF EXISTS "idx_resumes_merged_into_id";
+
+ALTER TABLE "resumes"
+DROP COLUMN IF EXISTS "file_hash",
+DROP COLUMN IF EXISTS "normalized_phone",
+DROP COLUMN IF EXISTS "normalized_email",
+DROP COLUMN IF EXISTS "normalized_name",
+DROP COLUMN IF EXISTS "merged_into_id",
+DROP COLUMN IF EXISTS "merged_at";
@@ -0,0 +1,58 @@
+-- Migration: 000038_add_resume_duplicates
+-- Created: 2025-01-29
+-- Description: Add duplicate detection keys and merge tracking to resumes, and create resume_duplicates for likely duplicate candidates
+
+ALTER TABLE "resumes"
+ADD COLUMN IF NOT EXISTS "file_hash" character varying(64),
+ADD COLUMN IF NOT EXISTS "normalized_phone" character varying,
+ADD COLUMN IF NOT EXISTS "normalized_email" character varying,
+ADD COLUMN IF NOT EXISTS "normalized_name" character varying,
+ADD COLUMN IF NOT EXISTS "merged_into_id" uuid,
+ADD COLUMN IF NOT EXISTS "merged_at" timestamptz;
+
+CREATE INDEX IF NOT EXISTS "idx_resumes_file_hash" ON "resumes" ("file_hash");
+CREATE INDEX IF NOT EXISTS "idx_resumes_normalized_phone" ON "resumes" ("normalized_phone");
+CREATE INDEX IF NOT EXISTS "idx_resumes_normalized_email" ON "resumes" ("normalized_email");
+CREATE INDEX IF NOT EXISTS "idx_resumes_normalized_name" ON "resumes" ("normalized_name");
+CREATE INDEX IF NOT EXISTS "idx_resumes_merged_into_id" ON "resumes" ("merged_into_id");
+
+COMMENT ON COLUMN "resumes"."file_hash" IS '简历文件内容SHA-256，用于识别重复上传';
+COMMENT ON COLUMN "resumes"."normalized_phone" IS '归一化手机号，用于重复检测';
+COMMENT ON COLUMN "resumes"."normalized_email" IS '归一化邮箱，用于重复检测';
+COMMENT ON COLUMN "resumes"."normalized_name" IS '归一化姓名，用于重复检测';
+COMMENT ON COLUMN "resumes"."merged_into_id" IS '合并后的主简历ID，非空表示该简历已合并，仅作为文件历史保留';
+COMMENT ON COLUMN "resumes"."merged_at" IS '合并时间';
+
+CREATE TABLE IF NOT EXISTS "resume_duplicates" (
+    "id" uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    "resume_id" uuid NOT NULL,
+    "duplicate_resume_id" uuid NOT NULL,
+    "score" double precision NOT NULL,
+    "reasons" jsonb,
+    "status" varchar NOT NULL DEFAULT 'pending',
+    "reviewed_by" uuid,
+    "reviewed_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now()
+);
+
+ALTER TABLE "resume_duplicates"
+ADD CONSTRAINT "resume_duplicates_resume_id_fk"
+FOREIGN KEY ("resume_id") REFERENCES "resumes" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "resume_duplicates"
+ADD CONSTRAINT "resume_duplicates_duplicate_resume_id_fk"
+FOREIGN KEY ("duplicate_resume_id") REFERENCES "resumes" ("id") ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX IF NOT EXISTS "uniq_resume_duplicates_pair" ON "resume_duplicates" ("resume_id", "duplicate_resume_id");
+CREATE INDEX IF NOT EXISTS "idx_resume_duplicates_duplicate_resume_id" ON "resume_duplicates" ("duplicate_resume_id");
+CREATE INDEX IF NOT EXISTS "idx_resume_duplicates_status" ON "resume_duplicates" ("status");
+
+COMMENT ON TABLE "resume_duplicates" IS '疑似重复简历表';
+COMMENT ON COLUMN "resume_duplicates"."resume_id" IS '触发检测的简历ID';
+COMMENT ON COLUMN "resume_duplicates"."duplicate_resume_id" IS '疑似重复的已有简历ID';
+COMMENT ON COLUMN "resume_duplicates"."score" IS '重复相似度 0-1';
+COMMENT ON COLUMN "resume_duplicates"."reasons" IS '判定依据：file_hash/phone/email/name/education/experience';
+COMMENT ON COLUMN "resume_duplicates"."status" IS '处理状态：pending/merged/dismissed';
+COMMENT ON COLUMN "resume_duplicates"."reviewed_by" IS '处理人ID';
+COMMENT ON COLUMN "resume_duplicates"."reviewed_at" IS '处理时间';
@@ -0,0 +1,5 @@
+-- Migration: 000039_add_resume_chunks (DOWN)
+-- Created: 2025-01-29
+-- Description: Drop resume_chunks
+
+DROP TABLE IF EXISTS "resume_chunks";
@@ -0,0 +1,33 @@
+-- Migration: 000039_add_resume_chunks
+-- Created: 2025-01-29
+-- Description: Create resume_chunks to store embedded resume summary, experience and project chunks for semantic search
+
+CREATE EXTENSION IF NOT EXISTS vector;
+
+CREATE TABLE IF NOT EXISTS "resume_chunks" (
+    "id" uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    "resume_id" uuid NOT NULL,
+    "chunk_type" varchar NOT NULL,
+    "source_id" uuid,
+    "chunk_index" integer NOT NULL DEFAULT 0,
+    "content" text NOT NULL,
+    "vector" vector(1024),
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now()
+);
+
+ALTER TABLE "resume_chunks"
+ADD CONSTRAINT "resume_chunks_resume_id_fk"
+FOREIGN KEY ("resume_id") REFERENCES "resumes" ("id") ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS "idx_resume_chunks_resume_id" ON "resume_chunks" ("resume_id");
+CREATE INDEX IF NOT EXISTS "idx_resume_chunks_vector_ivfflat" ON "resume_chunks" USING ivfflat ("vector" vector_cosine_ops) WITH (lists = 100)
+WHERE "vector" IS NOT NULL;
+
+COMMENT ON TABLE "resume_chunks" IS '简历语义检索分块表';
+COMMENT ON COLUMN "resume_chunks"."resume_id" IS '简历ID';
+COMMENT ON COLUMN "resume_chunks"."chunk_type" IS '分块类型：summary/experience/project';
+COMMENT ON COLUMN "resume_chunks"."source_id" IS '对应的工作经历或项目ID，个人简介为空';
+COMMENT ON COLUMN "resume_chunks"."chunk_index" IS '同一简历内的分块顺序';
+COMMENT ON COLUMN "resume_chunks"."content" IS '用于向量化与片段展示的分块文本';
+COMMENT ON COLUMN "resume_chunks"."vector" IS '分块语义向量，维度与 embedding 配置保持一致';
@@ -0,0 +1,8 @@
+-- Migration: 000040_add_screening_schedule_task_settings (DOWN)
+-- Created: 2025-01-30
+-- Description: Remove task settings from screening schedules
+
+ALTER TABLE "screening_schedules"
+DROP COLUMN IF EXISTS "blind_mode",
+DROP COLUMN IF EXISTS "knockout_rules",
+DROP COLUMN IF EXISTS "llm_config";
@@ -0,0 +1,12 @@
+-- Migration: 000040_add_screening_schedule_task_settings
+-- Created: 2025-01-30
+-- Description: Store LLM config, knockout rules and blind mode on screening schedules so automatically created tasks match manually created ones
+
+ALTER TABLE "screening_schedules"
+ADD COLUMN IF NOT EXISTS "llm_config" jsonb,
+ADD COLUMN IF NOT EXISTS "knockout_rules" jsonb,
+ADD COLUMN IF NOT EXISTS "blind_mode" boolean NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN "screening_schedules"."llm_config" IS '自动发起任务使用的LLM配置，为空时使用系统默认配置';
+COMMENT ON COLUMN "screening_schedules"."knockout_rules" IS '自动发起任务使用的硬性淘汰条件';
+COMMENT ON COLUMN "screening_schedules"."blind_mode" IS '自动发起的任务是否为盲筛模式';
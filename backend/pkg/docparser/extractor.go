@@ -0,0 +1,70 @@
+package docparser
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chaitin/WhaleHire/backend/config"
+)
+
+const (
+	// BackendLocal 本地提取文本，简历文件不离开内网
+	BackendLocal = "local"
+	// BackendRemote 调用外部文件解析 API 提取文本
+	BackendRemote = "remote"
+)
+
+// Extractor 文档文本提取接口，local 与 remote 两种后端均实现该接口
+type Extractor interface {
+	// ParseDocument 提取本地文件的文本内容
+	ParseDocument(ctx context.Context, filePath string) (*ParseDocumentResult, error)
+}
+
+var (
+	_ Extractor = (*DocumentParserService)(nil)
+	_ Extractor = (*LocalExtractor)(nil)
+)
+
+// NewExtractorFromConfig 按 document_parser.backend 配置创建文本提取后端，未配置时沿用 remote 后端
+func NewExtractorFromConfig(cfg *config.Config) (Extractor, error) {
+	switch cfg.DocumentParser.Backend {
+	case BackendLocal:
+		options := []LocalOption{
+			WithDocConverter(cfg.DocumentParser.DocConverter),
+			WithExtractTimeout(time.Duration(cfg.DocumentParser.Timeout) * time.Second),
+		}
+		ocrOptions, err := ocrOptionsFromConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return NewLocalExtractor(append(options, ocrOptions...)...), nil
+	case BackendRemote, "":
+		if err := ValidateConfig(cfg); err != nil {
+			return nil, err
+		}
+		return NewDocumentParserServiceFromConfig(cfg), nil
+	default:
+		return nil, fmt.Errorf("unsupported document parser backend: %s", cfg.DocumentParser.Backend)
+	}
+}
+
+// ocrOptionsFromConfig 按 document_parser.ocr 配置创建 OCR 阶段
+func ocrOptionsFromConfig(cfg *config.Config) ([]LocalOption, error) {
+	ocrCfg := cfg.DocumentParser.OCR
+	timeout := time.Duration(ocrCfg.Timeout) * time.Second
+	switch ocrCfg.Engine {
+	case OCREngineNone, "":
+		return nil, nil
+	case OCREngineTesseract:
+		return []LocalOption{
+			WithOCR(
+				NewTesseractEngine(ocrCfg.Command, ocrCfg.Languages, timeout),
+				NewPopplerRenderer(ocrCfg.Renderer, ocrCfg.DPI, timeout),
+			),
+			WithMinPageChars(ocrCfg.MinPageChars),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported ocr engine: %s", ocrCfg.Engine)
+	}
+}
@@ -0,0 +1,245 @@
+package docparser
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+// DefaultConverterTimeout .doc 转换命令的默认超时时间
+const DefaultConverterTimeout = 30 * time.Second
+
+// LocalExtractor 本地文档文本提取，支持 PDF、DOCX、DOC（借助外部转换命令）、TXT、HTML 与 RTF，
+// 配置 OCR 引擎后还支持扫描版 PDF 与 JPG/PNG 图片，文件内容不会发送到外部服务
+type LocalExtractor struct {
+	docConverter     string
+	converterTimeout time.Duration
+	ocr              OCREngine
+	renderer         PageRenderer
+	minPageChars     int
+}
+
+// LocalOption 本地提取配置选项
+type LocalOption func(*LocalExtractor)
+
+// WithDocConverter 设置 .doc 转换命令，命令以文件路径为最后一个参数，并将文本输出到标准输出
+func WithDocConverter(command string) LocalOption {
+	return func(e *LocalExtractor) {
+		e.docConverter = command
+	}
+}
+
+// WithExtractTimeout 设置外部转换命令的超时时间
+func WithExtractTimeout(timeout time.Duration) LocalOption {
+	return func(e *LocalExtractor) {
+		if timeout > 0 {
+			e.converterTimeout = timeout
+		}
+	}
+}
+
+// WithOCR 设置 OCR 引擎与 PDF 页面渲染器，renderer 为空时仅识别图片文件
+func WithOCR(engine OCREngine, renderer PageRenderer) LocalOption {
+	return func(e *LocalExtractor) {
+		e.ocr = engine
+		e.renderer = renderer
+	}
+}
+
+// WithMinPageChars 设置扫描页判定阈值
+func WithMinPageChars(n int) LocalOption {
+	return func(e *LocalExtractor) {
+		if n > 0 {
+			e.minPageChars = n
+		}
+	}
+}
+
+// NewLocalExtractor 创建本地文档文本提取
+func NewLocalExtractor(options ...LocalOption) *LocalExtractor {
+	e := &LocalExtractor{
+		converterTimeout: DefaultConverterTimeout,
+		minPageChars:     DefaultMinPageChars,
+	}
+	for _, opt := range options {
+		opt(e)
+	}
+	return e
+}
+
+// ParseDocument 按文件扩展名提取文本内容
+func (e *LocalExtractor) ParseDocument(ctx context.Context, filePath string) (*ParseDocumentResult, error) {
+	ext := strings.ToLower(filepath.Ext(filePath))
+
+	var (
+		content       string
+		ocrConfidence *float64
+		err           error
+	)
+	switch ext {
+	case ".pdf":
+		var pages []string
+		if pages, err = extractPDFPages(filePath); err == nil {
+			ocrConfidence, err = e.ocrScannedPages(ctx, filePath, pages)
+		}
+		content = strings.Join(pages, "\n")
+	case ".jpg", ".jpeg", ".png":
+		content, ocrConfidence, err = e.recognizeImage(ctx, filePath)
+	case ".docx":
+		content, err = extractDOCX(filePath)
+	case ".doc":
+		content, err = e.extractDOC(ctx, filePath)
+	case ".txt":
+		content, err = extractTXT(filePath)
+	case ".html", ".htm":
+		content, err = extractHTML(filePath)
+	case ".rtf":
+		content, err = extractRTF(filePath)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrProcessingFailed, filepath.Base(filePath), err)
+	}
+
+	return &ParseDocumentResult{
+		Content:       normalizeText(content),
+		FileType:      strings.TrimPrefix(ext, "."),
+		Filename:      filepath.Base(filePath),
+		UploadAt:      time.Now(),
+		OCRConfidence: ocrConfidence,
+	}, nil
+}
+
+// recognizeImage 识别图片简历
+func (e *LocalExtractor) recognizeImage(ctx context.Context, filePath string) (string, *float64, error) {
+	if e.ocr == nil {
+		return "", nil, fmt.Errorf("ocr engine is not configured")
+	}
+	result, err := e.ocr.Recognize(ctx, filePath)
+	if err != nil {
+		return "", nil, err
+	}
+	return result.Text, &result.Confidence, nil
+}
+
+// ocrScannedPages 将无文本层或乱码的页面渲染为图片后识别，识别结果替换原页面文本，
+// 返回 OCR 页面的平均置信度；文档其余页面有文本时，单页识别失败不影响整体提取
+func (e *LocalExtractor) ocrScannedPages(ctx context.Context, filePath string, pages []string) (*float64, error) {
+	if e.ocr == nil || e.renderer == nil {
+		return nil, nil
+	}
+
+	var scanned []int
+	for i, text := range pages {
+		if needsOCR(text, e.minPageChars) {
+			scanned = append(scanned, i)
+		}
+	}
+	if len(scanned) == 0 {
+		return nil, nil
+	}
+	hasText := len(scanned) < len(pages)
+
+	dir, err := os.MkdirTemp("", "docparser-ocr-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	var (
+		confSum    float64
+		recognized int
+	)
+	for _, i := range scanned {
+		result, err := e.recognizePage(ctx, filePath, i+1, dir)
+		if err != nil {
+			if hasText {
+				continue
+			}
+			return nil, err
+		}
+		if strings.TrimSpace(result.Text) == "" {
+			continue
+		}
+		pages[i] = result.Text
+		confSum += result.Confidence
+		recognized++
+	}
+	if recognized == 0 {
+		return nil, nil
+	}
+	confidence := confSum / float64(recognized)
+	return &confidence, nil
+}
+
+func (e *LocalExtractor) recognizePage(ctx context.Context, filePath string, page int, dir string) (*OCRResult, error) {
+	image, err := e.renderer.RenderPage(ctx, filePath, page, dir)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(image)
+	return e.ocr.Recognize(ctx, image)
+}
+
+// extractDOC 调用外部转换命令（如 antiword）提取 .doc 文本
+func (e *LocalExtractor) extractDOC(ctx context.Context, filePath string) (string, error) {
+	fields := strings.Fields(e.docConverter)
+	if len(fields) == 0 {
+		return "", fmt.Errorf("doc converter is not configured")
+	}
+	out, err := runCommand(ctx, e.converterTimeout, fields[0], append(fields[1:], filePath)...)
+	if err != nil {
+		return "", fmt.Errorf("doc converter: %w", err)
+	}
+	return decodeText(out)
+}
+
+// extractTXT 读取纯文本，非 UTF-8 编码按 UTF-16（带 BOM）或 GB18030 解码
+func extractTXT(filePath string) (string, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", err
+	}
+	return decodeText(data)
+}
+
+func decodeText(data []byte) (string, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xEF, 0xBB, 0xBF}):
+		return string(data[3:]), nil
+	case bytes.HasPrefix(data, []byte{0xFF, 0xFE}), bytes.HasPrefix(data, []byte{0xFE, 0xFF}):
+		decoded, _, err := transform.Bytes(unicode.UTF16(unicode.LittleEndian, unicode.ExpectBOM).NewDecoder(), data)
+		return string(decoded), err
+	case utf8.Valid(data):
+		return string(data), nil
+	default:
+		decoded, _, err := transform.Bytes(simplifiedchinese.GB18030.NewDecoder(), data)
+		return string(decoded), err
+	}
+}
+
+var (
+	trailingSpace = regexp.MustCompile(`[ \t\x{3000}]+\n`)
+	blankLines    = regexp.MustCompile(`\n{3,}`)
+)
+
+// normalizeText 统一换行符，去除行尾空白并合并多余空行
+func normalizeText(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+	text = strings.ReplaceAll(text, " ", " ")
+	text = trailingSpace.ReplaceAllString(text, "\n")
+	text = blankLines.ReplaceAllString(text, "\n\n")
+	return strings.TrimSpace(text)
+}
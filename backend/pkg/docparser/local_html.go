@@ -0,0 +1,99 @@
+package docparser
+
+import (
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/charset"
+)
+
+// htmlBlockTags 结束后需要换行的块级元素
+var htmlBlockTags = map[string]bool{
+	"p": true, "div": true, "li": true, "tr": true, "table": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"section": true, "article": true, "header": true, "footer": true,
+	"ul": true, "ol": true, "dl": true, "dt": true, "dd": true,
+	"pre": true, "blockquote": true, "title": true,
+}
+
+// extractHTML 提取 HTML 可见文本，按 meta 声明识别字符集
+func extractHTML(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	r, err := charset.NewReader(f, "text/html")
+	if err != nil {
+		return "", err
+	}
+	return parseHTMLText(r)
+}
+
+func parseHTMLText(r io.Reader) (string, error) {
+	var (
+		sb   strings.Builder
+		skip int
+	)
+	z := html.NewTokenizer(r)
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			if z.Err() == io.EOF {
+				return sb.String(), nil
+			}
+			return "", z.Err()
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			switch {
+			case tag == "script" || tag == "style" || tag == "noscript":
+				skip++
+			case tag == "br" || tag == "hr":
+				sb.WriteString("\n")
+			case tag == "td" || tag == "th":
+				sb.WriteString("\t")
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			if tag == "script" || tag == "style" || tag == "noscript" {
+				if skip > 0 {
+					skip--
+				}
+				continue
+			}
+			if htmlBlockTags[tag] {
+				sb.WriteString("\n")
+			}
+		case html.TextToken:
+			if skip > 0 {
+				continue
+			}
+			sb.WriteString(collapseHTMLSpace(string(z.Text()), sb.Len() == 0 || strings.HasSuffix(sb.String(), "\n")))
+		}
+	}
+}
+
+// collapseHTMLSpace 按 HTML 规则合并连续空白，行首不保留空格
+func collapseHTMLSpace(text string, lineStart bool) string {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		if lineStart || text == "" {
+			return ""
+		}
+		return " "
+	}
+	collapsed := strings.Join(fields, " ")
+	if !lineStart && strings.TrimLeftFunc(text, unicode.IsSpace) != text {
+		collapsed = " " + collapsed
+	}
+	if strings.TrimRightFunc(text, unicode.IsSpace) != text {
+		collapsed += " "
+	}
+	return collapsed
+}
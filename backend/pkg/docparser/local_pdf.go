@@ -0,0 +1,44 @@
+package docparser
+
+import (
+	"fmt"
+
+	"github.com/ledongthuc/pdf"
+)
+
+// extractPDFPages 按页提取 PDF 文本，无文本层的页面返回空字符串
+func extractPDFPages(filePath string) (pages []string, err error) {
+	// 第三方 PDF 解析在遇到损坏文件时可能 panic
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("malformed pdf: %v", r)
+		}
+	}()
+
+	f, r, err := pdf.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fonts := make(map[string]*pdf.Font)
+	for i := 1; i <= r.NumPage(); i++ {
+		page := r.Page(i)
+		if page.V.IsNull() {
+			pages = append(pages, "")
+			continue
+		}
+		for _, name := range page.Fonts() {
+			if _, ok := fonts[name]; !ok {
+				font := page.Font(name)
+				fonts[name] = &font
+			}
+		}
+		text, err := page.GetPlainText(fonts)
+		if err != nil {
+			return nil, fmt.Errorf("page %d: %w", i, err)
+		}
+		pages = append(pages, text)
+	}
+	return pages, nil
+}
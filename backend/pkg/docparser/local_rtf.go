@@ -0,0 +1,230 @@
+package docparser
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+)
+
+// rtfSkipDestinations 不包含正文的 RTF 目标组
+var rtfSkipDestinations = map[string]bool{
+	"fonttbl": true, "colortbl": true, "stylesheet": true, "info": true,
+	"pict": true, "object": true, "header": true, "footer": true,
+	"headerl": true, "headerr": true, "footerl": true, "footerr": true,
+	"listtable": true, "listoverridetable": true, "rsidtbl": true,
+	"themedata": true, "datastore": true, "latentstyles": true,
+	"generator": true, "xmlnstbl": true, "filetbl": true, "revtbl": true,
+}
+
+type rtfGroup struct {
+	skip bool
+	uc   int
+}
+
+type rtfParser struct {
+	data     []byte
+	pos      int
+	out      strings.Builder
+	pending  []byte
+	codepage int
+	groups   []rtfGroup
+	// skipChars \uN 之后需要跳过的替代字符数
+	skipChars int
+}
+
+// extractRTF 提取 RTF 正文文本，\'hh 转义按 \ansicpg 声明的代码页解码
+func extractRTF(filePath string) (string, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", err
+	}
+	return parseRTF(data), nil
+}
+
+func parseRTF(data []byte) string {
+	p := &rtfParser{data: data, codepage: 1252, groups: []rtfGroup{{uc: 1}}}
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		p.pos++
+		switch c {
+		case '{':
+			p.groups = append(p.groups, p.current())
+		case '}':
+			if len(p.groups) > 1 {
+				p.groups = p.groups[:len(p.groups)-1]
+			}
+		case '\\':
+			p.controlWord()
+		case '\r', '\n':
+		default:
+			p.writeByte(c)
+		}
+	}
+	p.flush()
+	return p.out.String()
+}
+
+func (p *rtfParser) current() rtfGroup {
+	return p.groups[len(p.groups)-1]
+}
+
+func (p *rtfParser) setCurrent(g rtfGroup) {
+	p.groups[len(p.groups)-1] = g
+}
+
+func (p *rtfParser) controlWord() {
+	if p.pos >= len(p.data) {
+		return
+	}
+	c := p.data[p.pos]
+	p.pos++
+
+	switch {
+	case c == '\\' || c == '{' || c == '}':
+		p.writeByte(c)
+		return
+	case c == '\'':
+		if p.pos+2 <= len(p.data) {
+			if b, err := strconv.ParseUint(string(p.data[p.pos:p.pos+2]), 16, 8); err == nil {
+				p.writeByte(byte(b))
+			}
+			p.pos += 2
+		}
+		return
+	case c == '*':
+		g := p.current()
+		g.skip = true
+		p.setCurrent(g)
+		return
+	case c == '~':
+		p.writeString(" ")
+		return
+	case c == '_':
+		p.writeString("-")
+		return
+	case c == '\r' || c == '\n':
+		p.writeString("\n")
+		return
+	case !isASCIILetter(c):
+		return
+	}
+
+	start := p.pos - 1
+	for p.pos < len(p.data) && isASCIILetter(p.data[p.pos]) {
+		p.pos++
+	}
+	word := string(p.data[start:p.pos])
+
+	hasParam := false
+	param := 0
+	paramStart := p.pos
+	if p.pos < len(p.data) && p.data[p.pos] == '-' {
+		p.pos++
+	}
+	for p.pos < len(p.data) && p.data[p.pos] >= '0' && p.data[p.pos] <= '9' {
+		p.pos++
+		hasParam = true
+	}
+	if hasParam {
+		param, _ = strconv.Atoi(string(p.data[paramStart:p.pos]))
+	} else {
+		p.pos = paramStart
+	}
+	if p.pos < len(p.data) && p.data[p.pos] == ' ' {
+		p.pos++
+	}
+
+	switch word {
+	case "par", "line", "row", "sect", "page":
+		p.writeString("\n")
+	case "tab", "cell":
+		p.writeString("\t")
+	case "emdash":
+		p.writeString("—")
+	case "endash":
+		p.writeString("–")
+	case "bullet":
+		p.writeString("•")
+	case "ansicpg":
+		if hasParam {
+			p.codepage = param
+		}
+	case "uc":
+		if hasParam {
+			g := p.current()
+			g.uc = param
+			p.setCurrent(g)
+		}
+	case "u":
+		if hasParam {
+			if param < 0 {
+				param += 65536
+			}
+			p.writeString(string(rune(param)))
+			p.skipChars = p.current().uc
+		}
+	default:
+		if rtfSkipDestinations[word] {
+			g := p.current()
+			g.skip = true
+			p.setCurrent(g)
+		}
+	}
+}
+
+func (p *rtfParser) writeByte(b byte) {
+	if p.current().skip {
+		return
+	}
+	if p.skipChars > 0 {
+		p.skipChars--
+		return
+	}
+	if b < 0x80 {
+		p.flush()
+		p.out.WriteByte(b)
+		return
+	}
+	p.pending = append(p.pending, b)
+}
+
+func (p *rtfParser) writeString(s string) {
+	if p.current().skip {
+		return
+	}
+	p.flush()
+	p.out.WriteString(s)
+}
+
+// flush 将累积的代码页字节解码后写入输出
+func (p *rtfParser) flush() {
+	if len(p.pending) == 0 {
+		return
+	}
+	decoded, err := rtfCodepage(p.codepage).NewDecoder().Bytes(p.pending)
+	if err != nil {
+		decoded = p.pending
+	}
+	p.out.Write(decoded)
+	p.pending = p.pending[:0]
+}
+
+func rtfCodepage(codepage int) encoding.Encoding {
+	switch codepage {
+	case 936:
+		return simplifiedchinese.GBK
+	case 950:
+		return traditionalchinese.Big5
+	default:
+		return charmap.Windows1252
+	}
+}
+
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,215 @@
+package docparser
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/simplifiedchinese"
+
+	"github.com/chaitin/WhaleHire/backend/config"
+)
+
+func writeTestFile(t *testing.T, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestLocalExtractorDOCX(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resume.docx")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	zw := zip.NewWriter(f)
+	w, err := zw.Create("word/document.xml")
+	require.NoError(t, err)
+	_, err = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>
+<w:p><w:r><w:t>张三</w:t></w:r></w:p>
+<w:p><w:r><w:t xml:space="preserve">技能：</w:t></w:r><w:r><w:t>Go</w:t><w:tab/><w:t>Kubernetes</w:t></w:r></w:p>
+</w:body></w:document>`))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	require.NoError(t, f.Close())
+
+	result, err := NewLocalExtractor().ParseDocument(context.Background(), path)
+	require.NoError(t, err)
+	assert.Equal(t, "张三\n技能：Go\tKubernetes", result.Content)
+	assert.Equal(t, "docx", result.FileType)
+	assert.Equal(t, "resume.docx", result.Filename)
+}
+
+func TestLocalExtractorHTML(t *testing.T) {
+	path := writeTestFile(t, "resume.html", []byte(`<html><head><title>简历</title><style>p{color:red}</style></head>
+<body><h1>李四</h1><p>邮箱：<b>lisi@example.com</b></p><script>alert(1)</script>
+<ul><li>Go &amp; Rust</li><li>PostgreSQL</li></ul></body></html>`))
+
+	result, err := NewLocalExtractor().ParseDocument(context.Background(), path)
+	require.NoError(t, err)
+	assert.Equal(t, "简历\n李四\n邮箱：lisi@example.com\nGo & Rust\nPostgreSQL", result.Content)
+}
+
+func TestLocalExtractorRTF(t *testing.T) {
+	path := writeTestFile(t, "resume.rtf", []byte(`{\rtf1\ansi\ansicpg936{\fonttbl{\f0\fnil SimSun;}}{\*\generator Writer;}`+
+		`\f0 \'d5\'c5\'c8\'fd\par Go\tab Rust\par \u26032?\u32463?}`))
+
+	result, err := NewLocalExtractor().ParseDocument(context.Background(), path)
+	require.NoError(t, err)
+	assert.Equal(t, "张三\nGo\tRust\n新经", result.Content)
+}
+
+func TestLocalExtractorTXT(t *testing.T) {
+	gbk, err := simplifiedchinese.GBK.NewEncoder().Bytes([]byte("王五\r\n\r\n\r\n\r\n工作经历  \r\n"))
+	require.NoError(t, err)
+
+	result, err := NewLocalExtractor().ParseDocument(context.Background(), writeTestFile(t, "resume.txt", gbk))
+	require.NoError(t, err)
+	assert.Equal(t, "王五\n\n工作经历", result.Content)
+}
+
+func TestLocalExtractorPDF(t *testing.T) {
+	result, err := NewLocalExtractor().ParseDocument(context.Background(), writeTestPDF(t, "Senior Go Engineer"))
+	require.NoError(t, err)
+	assert.Contains(t, result.Content, "Senior Go Engineer")
+	assert.Equal(t, "pdf", result.FileType)
+	assert.Nil(t, result.OCRConfidence)
+
+	// 损坏的 PDF 返回错误而不是 panic
+	_, err = NewLocalExtractor().ParseDocument(context.Background(), writeTestFile(t, "broken.pdf", []byte("%PDF-1.4 broken")))
+	assert.ErrorIs(t, err, ErrProcessingFailed)
+}
+
+type fakeOCREngine struct {
+	results map[string]*OCRResult
+	calls   []string
+}
+
+func (f *fakeOCREngine) Recognize(_ context.Context, imagePath string) (*OCRResult, error) {
+	f.calls = append(f.calls, filepath.Base(imagePath))
+	if result, ok := f.results[filepath.Base(imagePath)]; ok {
+		return result, nil
+	}
+	return nil, errors.New("unreadable image")
+}
+
+type fakePageRenderer struct {
+	err error
+}
+
+func (f *fakePageRenderer) RenderPage(_ context.Context, _ string, page int, outputDir string) (string, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+	path := filepath.Join(outputDir, fmt.Sprintf("page-%d.png", page))
+	return path, os.WriteFile(path, []byte("png"), 0o600)
+}
+
+func writeTestPDF(t *testing.T, pages ...string) string {
+	t.Helper()
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetFont("Helvetica", "", 12)
+	for _, text := range pages {
+		pdf.AddPage()
+		pdf.Cell(40, 10, text)
+	}
+	path := filepath.Join(t.TempDir(), "resume.pdf")
+	require.NoError(t, pdf.OutputFileAndClose(path))
+	return path
+}
+
+func TestLocalExtractorOCRScannedPDF(t *testing.T) {
+	// 第二页无文本层，视为扫描页
+	path := writeTestPDF(t, "Senior Go Engineer with Kubernetes experience", "")
+	engine := &fakeOCREngine{results: map[string]*OCRResult{
+		"page-2.png": {Text: "工作经历 2019-2024 鲸鱼科技", Confidence: 0.82},
+	}}
+
+	result, err := NewLocalExtractor(WithOCR(engine, &fakePageRenderer{})).ParseDocument(context.Background(), path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"page-2.png"}, engine.calls)
+	assert.Contains(t, result.Content, "Senior Go Engineer")
+	assert.Contains(t, result.Content, "工作经历 2019-2024 鲸鱼科技")
+	require.NotNil(t, result.OCRConfidence)
+	assert.InDelta(t, 0.82, *result.OCRConfidence, 1e-9)
+
+	// 其余页面有文本时，单页渲染失败不影响提取
+	result, err = NewLocalExtractor(WithOCR(engine, &fakePageRenderer{err: errors.New("render failed")})).ParseDocument(context.Background(), path)
+	require.NoError(t, err)
+	assert.Contains(t, result.Content, "Senior Go Engineer")
+	assert.Nil(t, result.OCRConfidence)
+
+	// 全部页面均为扫描页时，识别失败返回错误
+	_, err = NewLocalExtractor(WithOCR(engine, &fakePageRenderer{err: errors.New("render failed")})).ParseDocument(context.Background(), writeTestPDF(t, ""))
+	assert.ErrorIs(t, err, ErrProcessingFailed)
+}
+
+func TestLocalExtractorOCRImage(t *testing.T) {
+	path := writeTestFile(t, "resume.jpg", []byte("jpeg"))
+	engine := &fakeOCREngine{results: map[string]*OCRResult{
+		"resume.jpg": {Text: "赵六\n电话 13800000000", Confidence: 0.91},
+	}}
+
+	result, err := NewLocalExtractor(WithOCR(engine, nil)).ParseDocument(context.Background(), path)
+	require.NoError(t, err)
+	assert.Equal(t, "赵六\n电话 13800000000", result.Content)
+	assert.Equal(t, "jpg", result.FileType)
+	require.NotNil(t, result.OCRConfidence)
+	assert.InDelta(t, 0.91, *result.OCRConfidence, 1e-9)
+
+	// 未配置 OCR 时图片无法提取
+	_, err = NewLocalExtractor().ParseDocument(context.Background(), path)
+	assert.ErrorIs(t, err, ErrProcessingFailed)
+}
+
+func TestLocalExtractorErrors(t *testing.T) {
+	_, err := NewLocalExtractor().ParseDocument(context.Background(), writeTestFile(t, "resume.bmp", []byte("x")))
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+
+	_, err = NewLocalExtractor(WithDocConverter("nonexistent-doc-converter")).ParseDocument(context.Background(), writeTestFile(t, "resume.doc", []byte("x")))
+	assert.ErrorIs(t, err, ErrProcessingFailed)
+}
+
+func TestNewExtractorFromConfig(t *testing.T) {
+	cfg := &config.Config{}
+	_, err := NewExtractorFromConfig(cfg)
+	assert.Error(t, err, "remote 后端缺少 API Key 时应报错")
+
+	// 未配置后端时沿用 remote，已有部署升级后行为不变
+	cfg.DocumentParser.APIKey = "key"
+	cfg.DocumentParser.BaseURL = "https://api.example.com/v1"
+	cfg.DocumentParser.Timeout = 30
+	extractor, err := NewExtractorFromConfig(cfg)
+	require.NoError(t, err)
+	assert.IsType(t, &DocumentParserService{}, extractor)
+
+	cfg.DocumentParser.Backend = BackendRemote
+	extractor, err = NewExtractorFromConfig(cfg)
+	require.NoError(t, err)
+	assert.IsType(t, &DocumentParserService{}, extractor)
+
+	cfg.DocumentParser.Backend = "unknown"
+	_, err = NewExtractorFromConfig(cfg)
+	assert.Error(t, err)
+
+	cfg.DocumentParser.Backend = BackendLocal
+	extractor, err = NewExtractorFromConfig(cfg)
+	require.NoError(t, err)
+	assert.IsType(t, &LocalExtractor{}, extractor)
+
+	cfg.DocumentParser.OCR.Engine = OCREngineTesseract
+	extractor, err = NewExtractorFromConfig(cfg)
+	require.NoError(t, err)
+	assert.IsType(t, &TesseractEngine{}, extractor.(*LocalExtractor).ocr)
+
+	cfg.DocumentParser.OCR.Engine = "unknown"
+	_, err = NewExtractorFromConfig(cfg)
+	assert.Error(t, err)
+}
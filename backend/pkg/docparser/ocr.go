@@ -0,0 +1,189 @@
+package docparser
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// OCREngineTesseract 使用本地 tesseract 识别图片文本
+	OCREngineTesseract = "tesseract"
+	// OCREngineNone 关闭 OCR，扫描件与图片简历无法提取文本
+	OCREngineNone = "none"
+
+	// DefaultMinPageChars 页面有效字符数低于该值时视为扫描页
+	DefaultMinPageChars = 20
+)
+
+// OCRResult 图片文本识别结果
+type OCRResult struct {
+	Text       string
+	Confidence float64 // 平均置信度，取值 0~1
+}
+
+// OCREngine OCR 引擎接口
+type OCREngine interface {
+	// Recognize 识别图片中的文本
+	Recognize(ctx context.Context, imagePath string) (*OCRResult, error)
+}
+
+// PageRenderer 将 PDF 页面渲染为图片，供 OCR 识别
+type PageRenderer interface {
+	// RenderPage 渲染第 page 页（从 1 开始）到 outputDir，返回图片路径
+	RenderPage(ctx context.Context, pdfPath string, page int, outputDir string) (string, error)
+}
+
+// TesseractEngine 调用本地 tesseract 命令识别图片
+type TesseractEngine struct {
+	command   string
+	languages string
+	timeout   time.Duration
+}
+
+// NewTesseractEngine 创建 tesseract OCR 引擎
+func NewTesseractEngine(command, languages string, timeout time.Duration) *TesseractEngine {
+	return &TesseractEngine{
+		command:   command,
+		languages: languages,
+		timeout:   timeout,
+	}
+}
+
+// Recognize 以 TSV 格式输出识别结果，同时获取文本与单词置信度
+func (t *TesseractEngine) Recognize(ctx context.Context, imagePath string) (*OCRResult, error) {
+	args := []string{imagePath, "stdout"}
+	if t.languages != "" {
+		args = append(args, "-l", t.languages)
+	}
+	args = append(args, "tsv")
+
+	out, err := runCommand(ctx, t.timeout, t.command, args...)
+	if err != nil {
+		return nil, fmt.Errorf("tesseract: %w", err)
+	}
+	return parseTesseractTSV(out), nil
+}
+
+// parseTesseractTSV 按行还原单词文本，置信度取所有单词的平均值
+func parseTesseractTSV(data []byte) *OCRResult {
+	var (
+		sb       strings.Builder
+		lineKey  string
+		prevWord string
+		confSum  float64
+		words    int
+	)
+	for i, row := range strings.Split(string(data), "\n") {
+		cols := strings.Split(strings.TrimRight(row, "\r"), "\t")
+		// level page_num block_num par_num line_num word_num left top width height conf text
+		if i == 0 || len(cols) < 12 || cols[0] != "5" {
+			continue
+		}
+		text := strings.TrimSpace(cols[11])
+		conf, err := strconv.ParseFloat(cols[10], 64)
+		if text == "" || err != nil || conf < 0 {
+			continue
+		}
+
+		key := strings.Join(cols[1:5], "-")
+		switch {
+		case lineKey == "":
+		case key != lineKey:
+			sb.WriteString("\n")
+		case !isCJKBoundary(prevWord, text):
+			sb.WriteString(" ")
+		}
+		sb.WriteString(text)
+		lineKey, prevWord = key, text
+		confSum += conf
+		words++
+	}
+
+	result := &OCRResult{Text: sb.String()}
+	if words > 0 {
+		result.Confidence = confSum / float64(words) / 100
+	}
+	return result
+}
+
+// isCJKBoundary 相邻中文单词之间不插入空格
+func isCJKBoundary(prev, next string) bool {
+	last, _ := utf8.DecodeLastRuneInString(prev)
+	first, _ := utf8.DecodeRuneInString(next)
+	return isCJK(last) && isCJK(first)
+}
+
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.In(r, unicode.Hiragana, unicode.Katakana, unicode.Hangul) ||
+		(r >= 0x3000 && r <= 0x303F) || (r >= 0xFF00 && r <= 0xFFEF)
+}
+
+// PopplerRenderer 调用 pdftoppm 将 PDF 页面渲染为 PNG
+type PopplerRenderer struct {
+	command string
+	dpi     int
+	timeout time.Duration
+}
+
+// NewPopplerRenderer 创建 PDF 页面渲染器
+func NewPopplerRenderer(command string, dpi int, timeout time.Duration) *PopplerRenderer {
+	return &PopplerRenderer{
+		command: command,
+		dpi:     dpi,
+		timeout: timeout,
+	}
+}
+
+// RenderPage 渲染单页 PDF 为 PNG 图片
+func (p *PopplerRenderer) RenderPage(ctx context.Context, pdfPath string, page int, outputDir string) (string, error) {
+	prefix := filepath.Join(outputDir, fmt.Sprintf("page-%d", page))
+	pageArg := strconv.Itoa(page)
+	args := []string{"-f", pageArg, "-l", pageArg, "-r", strconv.Itoa(p.dpi), "-png", "-singlefile", pdfPath, prefix}
+	if _, err := runCommand(ctx, p.timeout, p.command, args...); err != nil {
+		return "", fmt.Errorf("render page %d: %w", page, err)
+	}
+	return prefix + ".png", nil
+}
+
+// needsOCR 判断页面是否为扫描页：有效字符过少，或乱码字符占比过高
+func needsOCR(text string, minChars int) bool {
+	valid, invalid := 0, 0
+	for _, r := range text {
+		switch {
+		case r == utf8.RuneError || unicode.Is(unicode.Co, r) || (unicode.IsControl(r) && !unicode.IsSpace(r)):
+			invalid++
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			valid++
+		}
+	}
+	return valid < minChars || invalid*10 > (valid+invalid)*3
+}
+
+// runCommand 在超时时间内执行外部命令并返回标准输出
+func runCommand(ctx context.Context, timeout time.Duration, name string, args ...string) ([]byte, error) {
+	if _, err := exec.LookPath(name); err != nil {
+		return nil, fmt.Errorf("command %s not found: %w", name, err)
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s failed: %w: %s", name, err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
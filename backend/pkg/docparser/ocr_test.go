@@ -0,0 +1,31 @@
+package docparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTesseractTSV(t *testing.T) {
+	tsv := "level\tpage_num\tblock_num\tpar_num\tline_num\tword_num\tleft\ttop\twidth\theight\tconf\ttext\n" +
+		"1\t1\t0\t0\t0\t0\t0\t0\t100\t100\t-1\t\n" +
+		"5\t1\t1\t1\t1\t1\t0\t0\t10\t10\t90\t张三\n" +
+		"5\t1\t1\t1\t1\t2\t0\t0\t10\t10\t80\t简历\n" +
+		"5\t1\t1\t1\t2\t1\t0\t0\t10\t10\t70\tGo\n" +
+		"5\t1\t1\t1\t2\t2\t0\t0\t10\t10\t60\tEngineer\n" +
+		"5\t1\t1\t1\t2\t3\t0\t0\t10\t10\t-1\t \n"
+
+	result := parseTesseractTSV([]byte(tsv))
+	assert.Equal(t, "张三简历\nGo Engineer", result.Text)
+	assert.InDelta(t, 0.75, result.Confidence, 1e-9)
+
+	assert.Equal(t, &OCRResult{}, parseTesseractTSV(nil))
+}
+
+func TestNeedsOCR(t *testing.T) {
+	assert.True(t, needsOCR("", DefaultMinPageChars))
+	assert.True(t, needsOCR("  \n 1 ", DefaultMinPageChars))
+	assert.False(t, needsOCR("高级后端工程师，五年分布式系统开发经验，熟悉 Go 与 Kubernetes", DefaultMinPageChars))
+	// 缺少 ToUnicode 映射的字体提取出大量私有区字符
+	assert.True(t, needsOCR("abcdefghijklmnopqrstuvwxyz"+string([]rune{0xE001, 0xE002, 0xE003, 0xE004, 0xE005, 0xE006, 0xE007, 0xE008, 0xE009, 0xE00A, 0xE00B, 0xE00C}), DefaultMinPageChars))
+}
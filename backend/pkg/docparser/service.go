@@ -20,12 +20,13 @@ func NewDocumentParserService(apiKey string, options ...ClientOption) *DocumentP
 
 // ParseDocumentResult 文档解析结果
 type ParseDocumentResult struct {
-	FileID   string    `json:"file_id"`
-	Content  string    `json:"content"`
-	FileType string    `json:"file_type"`
-	Filename string    `json:"filename"`
-	Title    string    `json:"title"`
-	UploadAt time.Time `json:"upload_at"`
+	FileID        string    `json:"file_id"`
+	Content       string    `json:"content"`
+	FileType      string    `json:"file_type"`
+	Filename      string    `json:"filename"`
+	Title         string    `json:"title"`
+	UploadAt      time.Time `json:"upload_at"`
+	OCRConfidence *float64  `json:"ocr_confidence,omitempty"` // OCR 识别的平均置信度（0~1），未经过 OCR 时为空
 }
 
 // ParseDocument 解析文档（一站式接口）
@@ -55,6 +55,8 @@ type AgentCallbackCollector struct {
 	rawInputs   map[string]any               // 输入汇总
 	rawErrors   map[string]error             // 错误汇总
 	tokenUsages map[string]*model.TokenUsage // 令牌使用汇总
+	// customNodeKeys 岗位自定义维度节点键，用于注册令牌用量回调
+	customNodeKeys []string
 	// enableDetailedCallbacks 标记是否注入详细的节点回调，默认关闭避免干扰主流程
 	enableDetailedCallbacks bool
 }
@@ -113,6 +115,13 @@ func (c *AgentCallbackCollector) Reset() {
 	c.tokenUsages = make(map[string]*model.TokenUsage)
 }
 
+// RegisterCustomNodes 注册岗位自定义维度节点，统计其模型令牌用量
+func (c *AgentCallbackCollector) RegisterCustomNodes(nodeKeys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.customNodeKeys = append(c.customNodeKeys, nodeKeys...)
+}
+
 // EnableDetailedCallbacks 启用详细回调，仅在示例调试中使用
 func (c *AgentCallbackCollector) EnableDetailedCallbacks() {
 	c.mu.Lock()
@@ -163,6 +172,16 @@ func (c *AgentCallbackCollector) ComposeOptions() []compose.Option {
 			DesignateNodeWithPath(compose.NewNodePath(domain.AggregatorAgent, "chat_model")),
 	)
 
+	c.mu.RLock()
+	customNodeKeys := append([]string(nil), c.customNodeKeys...)
+	c.mu.RUnlock()
+	for _, nodeKey := range customNodeKeys {
+		options = append(options,
+			compose.WithCallbacks(c.newModelUsageHandler(nodeKey)).
+				DesignateNodeWithPath(compose.NewNodePath(nodeKey, "chat_model")),
+		)
+	}
+
 	return options
 }
 
@@ -416,6 +435,17 @@ func (c *AgentCallbackCollector) AggregatorTokenUsage() (*model.TokenUsage, bool
 	return cloneTokenUsage(c.aggregatorTokenUsage), true
 }
 
+// TokenUsageOf 返回指定节点的令牌用量，用于自定义维度等未单独记录的节点
+func (c *AgentCallbackCollector) TokenUsageOf(nodeKey string) (*model.TokenUsage, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	usage, ok := c.tokenUsages[nodeKey]
+	if !ok || usage == nil {
+		return nil, false
+	}
+	return cloneTokenUsage(usage), true
+}
+
 // AggregatedMatchOutput 返回聚合后的最终匹配结果
 func (c *AgentCallbackCollector) AggregatedMatchOutput() (*domain.JobResumeMatch, bool) {
 	c.mu.RLock()
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 	"time"
 
@@ -28,14 +29,15 @@ type aggregatorState struct {
 
 // AggregatorInput 聚合器输入结构
 type AggregatorInput struct {
-	BasicMatch          *domain.BasicMatchDetail          `json:"basic_match"`
-	SkillMatch          *domain.SkillMatchDetail          `json:"skill_match"`
-	ResponsibilityMatch *domain.ResponsibilityMatchDetail `json:"responsibility_match"`
-	ExperienceMatch     *domain.ExperienceMatchDetail     `json:"experience_match"`
-	EducationMatch      *domain.EducationMatchDetail      `json:"education_match"`
-	IndustryMatch       *domain.IndustryMatchDetail       `json:"industry_match"`
-	Weights             *domain.DimensionWeights          `json:"weights"`
-	TaskMetaData        *domain.TaskMetaData              `json:"-"` // 任务元数据不参与序列化
+	BasicMatch          *domain.BasicMatchDetail             `json:"basic_match"`
+	SkillMatch          *domain.SkillMatchDetail             `json:"skill_match"`
+	ResponsibilityMatch *domain.ResponsibilityMatchDetail    `json:"responsibility_match"`
+	ExperienceMatch     *domain.ExperienceMatchDetail        `json:"experience_match"`
+	EducationMatch      *domain.EducationMatchDetail         `json:"education_match"`
+	IndustryMatch       *domain.IndustryMatchDetail          `json:"industry_match"`
+	CustomMatches       map[string]*domain.CustomMatchDetail `json:"custom_matches,omitempty"`
+	Weights             *domain.DimensionWeights             `json:"weights"`
+	TaskMetaData        *domain.TaskMetaData                 `json:"-"` // 任务元数据不参与序列化
 }
 
 type llmDimensionSummary struct {
@@ -103,6 +105,20 @@ func newInputLambda(ctx context.Context, input map[string]any, opts ...any) (map
 		}
 	}
 
+	// 岗位自定义维度结果按维度标识合并
+	for nodeKey, output := range input {
+		key, ok := domain.CustomDimensionKeyFromNode(nodeKey)
+		if !ok {
+			continue
+		}
+		if detail, ok := output.(*domain.CustomMatchDetail); ok && detail != nil {
+			if aggregatorInput.CustomMatches == nil {
+				aggregatorInput.CustomMatches = make(map[string]*domain.CustomMatchDetail)
+			}
+			aggregatorInput.CustomMatches[key] = detail
+		}
+	}
+
 	// 提取任务元数据
 	if taskMetaData, ok := input[domain.TaskMetaDataNode]; ok {
 		if metaData, ok := taskMetaData.(*domain.TaskMetaData); ok {
@@ -314,6 +330,16 @@ func (a *AggregatorAgent) validateInput(input map[string]any) error {
 		}
 	}
 
+	for nodeKey, output := range input {
+		if _, ok := domain.CustomDimensionKeyFromNode(nodeKey); !ok {
+			continue
+		}
+		if _, ok := output.(*domain.CustomMatchDetail); !ok {
+			return fmt.Errorf("%s output must be of type *CustomMatchDetail", nodeKey)
+		}
+		hasOutput = true
+	}
+
 	if !hasOutput {
 		return fmt.Errorf("at least one agent output is required")
 	}
@@ -349,6 +375,11 @@ func (a *AggregatorAgent) validateOutput(output *domain.JobResumeMatch) error {
 	if output.IndustryMatch != nil && (output.IndustryMatch.Score < 0 || output.IndustryMatch.Score > 100) {
 		return fmt.Errorf("industry match score must be between 0 and 100, got %f", output.IndustryMatch.Score)
 	}
+	for key, detail := range output.CustomMatches {
+		if detail != nil && (detail.Score < 0 || detail.Score > 100) {
+			return fmt.Errorf("custom dimension %s score must be between 0 and 100, got %f", key, detail.Score)
+		}
+	}
 
 	return nil
 }
@@ -360,7 +391,25 @@ func (a *AggregatorAgent) GetAgentType() string {
 
 // GetVersion 返回Agent版本
 func (a *AggregatorAgent) GetVersion() string {
-	return "1.1.0"
+	return "1.2.0"
+}
+
+// RecalculateOverallScore 基于已有的各维度匹配结果按给定权重重新计算综合得分，不调用模型。
+// 用于复用历史筛选结果时仅调整权重的场景
+func RecalculateOverallScore(match *domain.JobResumeMatch, weights *domain.DimensionWeights) float64 {
+	if match == nil {
+		return 0
+	}
+	scores := collectDimensionScores(&AggregatorInput{
+		BasicMatch:          match.BasicMatch,
+		SkillMatch:          match.SkillMatch,
+		ResponsibilityMatch: match.ResponsibilityMatch,
+		ExperienceMatch:     match.ExperienceMatch,
+		EducationMatch:      match.EducationMatch,
+		IndustryMatch:       match.IndustryMatch,
+		CustomMatches:       match.CustomMatches,
+	})
+	return calculateOverallScore(weights, scores)
 }
 
 func collectDimensionScores(input *AggregatorInput) map[string]float64 {
@@ -383,6 +432,11 @@ func collectDimensionScores(input *AggregatorInput) map[string]float64 {
 	if input.BasicMatch != nil {
 		scores["basic"] = input.BasicMatch.Score
 	}
+	for key, detail := range input.CustomMatches {
+		if detail != nil {
+			scores[key] = detail.Score
+		}
+	}
 	return scores
 }
 
@@ -397,6 +451,19 @@ func calculateOverallScore(weights *domain.DimensionWeights, scores map[string]f
 		scores["education"]*w.Education +
 		scores["industry"]*w.Industry +
 		scores["basic"]*w.Basic
+	if len(w.Custom) == 0 {
+		return math.Round(value*10) / 10
+	}
+
+	// 存在自定义维度时按全部权重之和归一化，内置维度权重无需为自定义维度预留空间
+	total := w.Skill + w.Responsibility + w.Experience + w.Education + w.Industry + w.Basic
+	for key, weight := range w.Custom {
+		value += scores[key] * weight
+		total += weight
+	}
+	if total > 0 {
+		value /= total
+	}
 	return math.Round(value*10) / 10
 }
 
@@ -478,6 +545,17 @@ func buildDimensionSummaries(input *AggregatorInput) []llmDimensionSummary {
 		},
 	}
 
+	for _, key := range sortedCustomKeys(input.CustomMatches) {
+		detail := input.CustomMatches[key]
+		summaries = append(summaries, llmDimensionSummary{
+			Key:        key,
+			Name:       detail.Name,
+			Score:      detail.Score,
+			Highlights: nonEmptySlice(collectFirstN(extractCustomHighlights(detail), 6)),
+			Risks:      nonEmptySlice(collectFirstN(extractCustomRisks(detail), 6)),
+		})
+	}
+
 	return summaries
 }
 
@@ -495,6 +573,7 @@ func buildFinalMatch(input *AggregatorInput, overall float64, matchedAt time.Tim
 		EducationMatch:      input.EducationMatch,
 		IndustryMatch:       input.IndustryMatch,
 		BasicMatch:          input.BasicMatch,
+		CustomMatches:       input.CustomMatches,
 		MatchedAt:           matchedAt,
 		Recommendations:     recs,
 	}
@@ -522,6 +601,11 @@ func collectStrengthsAndRisks(input *AggregatorInput) ([]string, []string) {
 	strengths = append(strengths, extractBasicHighlights(input.BasicMatch)...)
 	risks = append(risks, extractBasicRisks(input.BasicMatch)...)
 
+	for _, key := range sortedCustomKeys(input.CustomMatches) {
+		strengths = append(strengths, extractCustomHighlights(input.CustomMatches[key])...)
+		risks = append(risks, extractCustomRisks(input.CustomMatches[key])...)
+	}
+
 	return deduplicate(strengths), deduplicate(risks)
 }
 
@@ -831,6 +915,40 @@ func extractBasicRisks(basic *domain.BasicMatchDetail) []string {
 	return risks
 }
 
+func extractCustomHighlights(detail *domain.CustomMatchDetail) []string {
+	if detail == nil {
+		return nil
+	}
+	highlights := make([]string, 0, len(detail.Evidence))
+	for _, evidence := range detail.Evidence {
+		highlights = append(highlights, fmt.Sprintf("%s：%s", detail.Name, evidence))
+	}
+	return highlights
+}
+
+func extractCustomRisks(detail *domain.CustomMatchDetail) []string {
+	if detail == nil {
+		return nil
+	}
+	risks := make([]string, 0, len(detail.Gaps))
+	for _, gap := range detail.Gaps {
+		risks = append(risks, fmt.Sprintf("%s：%s", detail.Name, gap))
+	}
+	return risks
+}
+
+// sortedCustomKeys 按维度标识排序，保证提示词输入稳定
+func sortedCustomKeys(details map[string]*domain.CustomMatchDetail) []string {
+	keys := make([]string, 0, len(details))
+	for key, detail := range details {
+		if detail != nil {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func mergeSlices(slices ...[]string) []string {
 	var result []string
 	for _, s := range slices {
@@ -29,9 +29,17 @@ func newInputLambda(ctx context.Context, input *domain.BasicInfoData, opts ...an
 		return nil, fmt.Errorf("input cannot be nil")
 	}
 	// 仅保留与基础匹配相关的字段，避免上下文过长
+	resumeInfo := buildResumeBasicInfo(input.Resume)
+	blindNotice := ""
+	if input.BlindMode {
+		// 盲筛模式下即使简历未完整脱敏也不向模型提供身份信息
+		resumeInfo.Name = ""
+		resumeInfo.Age = nil
+		blindNotice = BasicInfoBlindNotice
+	}
 	inputData := map[string]any{
 		"job_profile": buildJobBasicInfo(input.JobProfile),
-		"resume":      buildResumeBasicInfo(input.Resume),
+		"resume":      resumeInfo,
 	}
 
 	// 将输入转换为JSON字符串
@@ -41,7 +49,8 @@ func newInputLambda(ctx context.Context, input *domain.BasicInfoData, opts ...an
 	}
 
 	return map[string]any{
-		"input": string(inputJSON),
+		"input":        string(inputJSON),
+		"blind_notice": blindNotice,
 	}, nil
 }
 
@@ -154,7 +163,7 @@ func (a *BasicInfoAgent) GetAgentType() string {
 
 // GetVersion 返回Agent版本
 func (a *BasicInfoAgent) GetVersion() string {
-	return "1.1.0"
+	return "1.2.0"
 }
 
 type jobBasicInfo struct {
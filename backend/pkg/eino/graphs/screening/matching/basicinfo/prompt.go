@@ -71,6 +71,7 @@ const BasicInfoUserPrompt = `请分析以下候选人基本信息与职位要求
 - resume: 候选人姓名、年龄、当前城市、期望城市、工作年限、就业状态、期望薪资（包含解析后的区间与原文）、个人总结、荣誉奖项、近期经历摘要等基础信息
 - notes: 可能出现的提示信息，标记出缺失或需特别注意的要素
 
+{{.blind_notice}}
 ## 待分析数据
 {{.input}}
 
@@ -84,6 +85,12 @@ const BasicInfoUserPrompt = `请分析以下候选人基本信息与职位要求
 
 请根据系统提示中的详细评分规则，对候选人进行全面评估并严格按照JSON格式输出结果。`
 
+// BasicInfoBlindNotice 盲筛模式下追加到用户提示词的说明
+const BasicInfoBlindNotice = `## 盲筛模式
+本次为盲筛评估，候选人的姓名、性别、年龄、出生日期及联系方式已隐去。
+请仅依据地点、薪资、职能经验与到岗意愿评分，不得推测或引用候选人的性别、年龄、民族、婚育等个人属性，也不要因上述信息缺失而扣分或在notes中提示缺失。
+`
+
 // NewBasicInfoChatTemplate 创建基本信息匹配的聊天模板
 func NewBasicInfoChatTemplate(ctx context.Context) (prompt.ChatTemplate, error) {
 	config := &ChatTemplateConfig{
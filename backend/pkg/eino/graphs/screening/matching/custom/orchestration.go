@@ -0,0 +1,241 @@
+package custom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/schema"
+)
+
+const agentVersion = "1.0.0"
+
+// CustomDimensionAgent 岗位自定义维度匹配Agent，每个自定义维度对应一个实例
+type CustomDimensionAgent struct {
+	dimension *domain.CustomDimension
+	chain     *compose.Chain[*domain.CustomDimensionData, *domain.CustomMatchDetail]
+}
+
+// llmJobProfile 提供给模型的岗位摘要
+type llmJobProfile struct {
+	Name             string   `json:"name"`
+	Description      string   `json:"description,omitempty"`
+	Responsibilities []string `json:"responsibilities,omitempty"`
+	Skills           []string `json:"skills,omitempty"`
+}
+
+// llmResume 提供给模型的简历摘要，不包含联系方式等个人信息
+type llmResume struct {
+	YearsExperience    float64          `json:"years_experience,omitempty"`
+	HighestEducation   string           `json:"highest_education,omitempty"`
+	PersonalSummary    string           `json:"personal_summary,omitempty"`
+	HonorsCertificates string           `json:"honors_certificates,omitempty"`
+	OtherInfo          string           `json:"other_info,omitempty"`
+	Skills             []string         `json:"skills,omitempty"`
+	Experiences        []map[string]any `json:"experiences,omitempty"`
+	Projects           []map[string]any `json:"projects,omitempty"`
+	Educations         []map[string]any `json:"educations,omitempty"`
+}
+
+// llmOutput 模型输出
+type llmOutput struct {
+	Score    float64  `json:"score"`
+	Evidence []string `json:"evidence"`
+	Gaps     []string `json:"gaps"`
+	Analysis string   `json:"analysis"`
+}
+
+// newInputLambda 将自定义维度数据转换为模板变量
+func newInputLambda(ctx context.Context, input *domain.CustomDimensionData, opts ...any) (map[string]any, error) {
+	if input == nil || input.Dimension == nil {
+		return nil, fmt.Errorf("input cannot be nil")
+	}
+	if input.JobProfile == nil || input.JobProfile.JobProfile == nil || input.Resume == nil {
+		return nil, fmt.Errorf("job profile and resume are required")
+	}
+
+	job, err := json.Marshal(buildJobProfile(input.JobProfile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal job profile: %w", err)
+	}
+	resume, err := json.Marshal(buildResume(input.Resume))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resume: %w", err)
+	}
+
+	blindNotice := ""
+	if input.BlindMode {
+		blindNotice = CustomDimensionBlindNotice
+	}
+	description := input.Dimension.Description
+	if description == "" {
+		description = "无"
+	}
+
+	return map[string]any{
+		"dimension_name":        input.Dimension.Name,
+		"dimension_description": description,
+		"dimension_prompt":      input.Dimension.Prompt,
+		"blind_notice":          blindNotice,
+		"job_profile":           string(job),
+		"resume":                string(resume),
+	}, nil
+}
+
+// buildJobProfile 提取岗位名称、描述、职责与技能要求
+func buildJobProfile(detail *domain.JobProfileDetail) *llmJobProfile {
+	job := &llmJobProfile{Name: detail.JobProfile.Name}
+	if detail.JobProfile.Description != nil {
+		job.Description = *detail.JobProfile.Description
+	}
+	for _, resp := range detail.Responsibilities {
+		if resp != nil {
+			job.Responsibilities = append(job.Responsibilities, resp.Responsibility)
+		}
+	}
+	for _, skill := range detail.Skills {
+		if skill == nil {
+			continue
+		}
+		if skill.Type == string(consts.JobSkillTypeRequired) {
+			job.Skills = append(job.Skills, skill.Skill+"（必需）")
+		} else {
+			job.Skills = append(job.Skills, skill.Skill+"（加分）")
+		}
+	}
+	return job
+}
+
+// buildResume 提取与维度评估相关的简历内容
+func buildResume(detail *domain.ResumeDetail) *llmResume {
+	resume := &llmResume{}
+	if detail.Resume != nil {
+		resume.YearsExperience = detail.YearsExperience
+		resume.HighestEducation = detail.HighestEducation
+		resume.PersonalSummary = detail.PersonalSummary
+		resume.HonorsCertificates = detail.HonorsCertificates
+		resume.OtherInfo = detail.OtherInfo
+	}
+	for _, skill := range detail.Skills {
+		if skill != nil && skill.SkillName != "" {
+			resume.Skills = append(resume.Skills, skill.SkillName)
+		}
+	}
+	for _, exp := range detail.Experiences {
+		if exp == nil {
+			continue
+		}
+		resume.Experiences = append(resume.Experiences, map[string]any{
+			"company":         exp.Company,
+			"position":        exp.Position,
+			"description":     exp.Description,
+			"experience_type": exp.ExperienceType,
+			"start_date":      exp.StartDate,
+			"end_date":        exp.EndDate,
+		})
+	}
+	for _, project := range detail.Projects {
+		if project == nil {
+			continue
+		}
+		resume.Projects = append(resume.Projects, map[string]any{
+			"name":             project.Name,
+			"role":             project.Role,
+			"project_type":     project.ProjectType,
+			"description":      project.Description,
+			"responsibilities": project.Responsibilities,
+			"achievements":     project.Achievements,
+			"technologies":     project.Technologies,
+			"project_url":      project.ProjectURL,
+		})
+	}
+	for _, edu := range detail.Educations {
+		if edu == nil {
+			continue
+		}
+		resume.Educations = append(resume.Educations, map[string]any{
+			"school": edu.School,
+			"degree": edu.Degree,
+			"major":  edu.Major,
+		})
+	}
+	return resume
+}
+
+// NewCustomDimensionAgent 为指定自定义维度创建匹配Agent
+func NewCustomDimensionAgent(ctx context.Context, llm model.ToolCallingChatModel, dimension *domain.CustomDimension) (*CustomDimensionAgent, error) {
+	if dimension == nil || dimension.Key == "" {
+		return nil, fmt.Errorf("custom dimension is required")
+	}
+
+	chatTemplate, err := NewCustomDimensionChatTemplate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chat template: %w", err)
+	}
+
+	// 输出处理绑定维度标识和名称，模型只负责打分和分析
+	outputLambda := func(ctx context.Context, msg *schema.Message, opts ...any) (*domain.CustomMatchDetail, error) {
+		if msg == nil || msg.Content == "" {
+			return nil, fmt.Errorf("empty model output")
+		}
+		var output llmOutput
+		if err := json.Unmarshal([]byte(msg.Content), &output); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON output: %w; raw=%s", err, msg.Content)
+		}
+		return &domain.CustomMatchDetail{
+			Key:      dimension.Key,
+			Name:     dimension.Name,
+			Score:    math.Max(0, math.Min(100, output.Score)),
+			Evidence: output.Evidence,
+			Gaps:     output.Gaps,
+			Analysis: strings.TrimSpace(output.Analysis),
+		}, nil
+	}
+
+	chain := compose.NewChain[*domain.CustomDimensionData, *domain.CustomMatchDetail]()
+	chain.
+		AppendLambda(compose.InvokableLambdaWithOption(newInputLambda), compose.WithNodeName("input_processing")).
+		AppendChatTemplate(chatTemplate, compose.WithNodeName("chat_template")).
+		AppendChatModel(llm, compose.WithNodeName("chat_model"), compose.WithNodeKey("chat_model")).
+		AppendLambda(compose.InvokableLambdaWithOption(outputLambda), compose.WithNodeName("output_processing"))
+
+	return &CustomDimensionAgent{
+		dimension: dimension,
+		chain:     chain,
+	}, nil
+}
+
+// GetChain 获取处理链
+func (a *CustomDimensionAgent) GetChain() *compose.Chain[*domain.CustomDimensionData, *domain.CustomMatchDetail] {
+	return a.chain
+}
+
+// Compile 编译链为可执行的Runnable
+func (a *CustomDimensionAgent) Compile(ctx context.Context) (compose.Runnable[*domain.CustomDimensionData, *domain.CustomMatchDetail], error) {
+	runnable, err := a.chain.Compile(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile chain: %w", err)
+	}
+	return runnable, nil
+}
+
+// GetNodeKey 返回该维度在筛选图中的节点键
+func (a *CustomDimensionAgent) GetNodeKey() string {
+	return domain.CustomDimensionNodeKey(a.dimension.Key)
+}
+
+// GetAgentType 返回Agent类型
+func (a *CustomDimensionAgent) GetAgentType() string {
+	return "CustomDimensionAgent"
+}
+
+// GetVersion 返回Agent版本，附带维度定义摘要，维度提示词修改后版本随之变化
+func (a *CustomDimensionAgent) GetVersion() string {
+	return agentVersion + "+" + a.dimension.Signature()
+}
@@ -0,0 +1,73 @@
+package custom
+
+import (
+	"context"
+
+	"github.com/cloudwego/eino/components/prompt"
+	"github.com/cloudwego/eino/schema"
+)
+
+// CustomDimensionSystemPrompt 自定义维度匹配系统提示词
+const CustomDimensionSystemPrompt = `你是一名资深招聘评估专家，负责按照招聘方定义的评估维度，对候选人简历进行单一维度的匹配评估。
+
+### 评估维度
+- 名称：{{.dimension_name}}
+- 说明：{{.dimension_description}}
+
+### 评估要求（由招聘方提供）
+{{.dimension_prompt}}
+
+### 评分规则
+1. 只评估上述维度，不要考虑技能、学历等其他维度的整体匹配情况。
+2. 只依据简历中可以找到的事实打分，不要臆测；简历中没有相关信息时得分应低于 40，并在 gaps 中说明缺少哪些信息。
+3. 分数区间：85-100 明显超出要求；70-84 满足要求；55-69 部分满足；40-54 证据不足；0-39 基本不满足。
+4. 不得基于性别、年龄、姓名等个人特征做判断。{{.blind_notice}}
+
+### 输出要求
+- score：0~100 的得分
+- evidence：支撑得分的简历证据，每条一句话，最多 5 条
+- gaps：与维度要求的差距或缺失信息，最多 5 条
+- analysis：1-2 句中文综合说明
+
+输出必须是**严格合法的 JSON**，不能包含额外说明、注释或 Markdown。`
+
+// CustomDimensionBlindNotice 盲筛模式下的补充说明
+const CustomDimensionBlindNotice = `
+5. 当前为盲筛模式，简历已隐去姓名、联系方式等个人信息，请勿推测候选人身份。`
+
+// CustomDimensionUserPrompt 自定义维度匹配用户提示词
+const CustomDimensionUserPrompt = `请评估候选人在该维度上的匹配程度。
+
+## 岗位信息(JSON)
+{{.job_profile}}
+
+## 候选人简历(JSON)
+{{.resume}}
+
+## 输出格式
+{
+  "score": 0,
+  "evidence": ["证据（中文）"],
+  "gaps": ["差距（中文）"],
+  "analysis": "说明（中文）"
+}
+`
+
+// NewCustomDimensionChatTemplate 创建自定义维度聊天模板
+func NewCustomDimensionChatTemplate(ctx context.Context) (prompt.ChatTemplate, error) {
+	config := &ChatTemplateConfig{
+		FormatType: schema.GoTemplate,
+		Templates: []schema.MessagesTemplate{
+			schema.SystemMessage(CustomDimensionSystemPrompt),
+			schema.UserMessage(CustomDimensionUserPrompt),
+		},
+	}
+	ctp := prompt.FromMessages(config.FormatType, config.Templates...)
+	return ctp, nil
+}
+
+// ChatTemplateConfig 聊天模板配置
+type ChatTemplateConfig struct {
+	FormatType schema.FormatType
+	Templates  []schema.MessagesTemplate
+}
@@ -22,6 +22,7 @@ func NewDispatchData(input *domain.MatchInput) DispatchData {
 	data[domain.BasicInfoAgent] = &domain.BasicInfoData{
 		JobProfile: input.JobProfile,
 		Resume:     input.Resume,
+		BlindMode:  input.BlindMode,
 	}
 
 	// 技能 Agent 数据
@@ -57,6 +58,19 @@ func NewDispatchData(input *domain.MatchInput) DispatchData {
 		ResumeExperiences:       input.Resume.Experiences,
 	}
 
+	// 岗位自定义维度 Agent 数据，每个维度对应一个节点
+	for _, dim := range input.CustomDimensions {
+		if dim == nil {
+			continue
+		}
+		data[domain.CustomDimensionNodeKey(dim.Key)] = &domain.CustomDimensionData{
+			Dimension:  dim,
+			JobProfile: input.JobProfile,
+			Resume:     input.Resume,
+			BlindMode:  input.BlindMode,
+		}
+	}
+
 	// 任务元数据
 	data[domain.TaskMetaDataNode] = &domain.TaskMetaData{
 		JobID:            input.JobProfile.ID,
@@ -65,6 +79,17 @@ func NewDispatchData(input *domain.MatchInput) DispatchData {
 		DimensionWeights: input.DimensionWeights,
 	}
 
+	// 已复用历史结果的维度不再分发给对应 Agent，由复用节点直接交给聚合节点
+	reused := make(map[string]any, len(input.ReusedResults))
+	for nodeKey, output := range input.ReusedResults {
+		if _, ok := data[nodeKey]; !ok || output == nil {
+			continue
+		}
+		delete(data, nodeKey)
+		reused[nodeKey] = output
+	}
+	data[domain.ReusedResultsNode] = reused
+
 	return data
 }
 
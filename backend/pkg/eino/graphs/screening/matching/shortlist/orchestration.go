@@ -0,0 +1,271 @@
+package shortlist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/chaitin/WhaleHire/backend/consts"
+	"github.com/chaitin/WhaleHire/backend/domain"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/schema"
+)
+
+// PairwiseCompareAgent 候选人两两比较 Agent，判断两位候选人中谁更适合岗位
+type PairwiseCompareAgent struct {
+	chain *compose.Chain[*domain.PairwiseCompareInput, *domain.PairwiseCompareResult]
+}
+
+// llmJobProfile 提供给模型的岗位要求摘要
+type llmJobProfile struct {
+	Name                   string   `json:"name"`
+	Description            string   `json:"description,omitempty"`
+	Responsibilities       []string `json:"responsibilities,omitempty"`
+	RequiredSkills         []string `json:"required_skills,omitempty"`
+	BonusSkills            []string `json:"bonus_skills,omitempty"`
+	ExperienceRequirements []string `json:"experience_requirements,omitempty"`
+	EducationRequirements  []string `json:"education_requirements,omitempty"`
+	IndustryRequirements   []string `json:"industry_requirements,omitempty"`
+}
+
+// llmDimension 候选人单个维度的匹配摘要
+type llmDimension struct {
+	Name       string   `json:"name,omitempty"`
+	Score      float64  `json:"score"`
+	Highlights []string `json:"highlights,omitempty"`
+	Gaps       []string `json:"gaps,omitempty"`
+	Analysis   string   `json:"analysis,omitempty"`
+}
+
+// llmCandidate 提供给模型的候选人匹配摘要，不包含个人身份信息
+type llmCandidate struct {
+	OverallScore      float64                  `json:"overall_score"`
+	MatchLevel        consts.MatchLevel        `json:"match_level"`
+	HumanOverallScore *float64                 `json:"human_overall_score,omitempty"`
+	Dimensions        map[string]*llmDimension `json:"dimensions"`
+	Recommendations   []string                 `json:"recommendations,omitempty"`
+}
+
+// llmCompareOutput 模型输出
+type llmCompareOutput struct {
+	Winner     string  `json:"winner"`
+	Confidence float64 `json:"confidence"`
+	Reason     string  `json:"reason"`
+}
+
+// newInputLambda 将两两比较输入转换为模板变量
+func newInputLambda(ctx context.Context, input *domain.PairwiseCompareInput, opts ...any) (map[string]any, error) {
+	if input == nil {
+		return nil, fmt.Errorf("两两比较输入不能为空")
+	}
+	if input.JobProfile == nil || input.JobProfile.JobProfile == nil {
+		return nil, fmt.Errorf("岗位信息不能为空")
+	}
+	if input.CandidateA == nil || input.CandidateA.Result == nil || input.CandidateB == nil || input.CandidateB.Result == nil {
+		return nil, fmt.Errorf("候选人筛选结果不能为空")
+	}
+
+	job, err := json.Marshal(buildJobProfile(input.JobProfile))
+	if err != nil {
+		return nil, fmt.Errorf("序列化岗位信息失败: %w", err)
+	}
+	candidateA, err := json.Marshal(buildCandidate(input.CandidateA.Result))
+	if err != nil {
+		return nil, fmt.Errorf("序列化候选人A失败: %w", err)
+	}
+	candidateB, err := json.Marshal(buildCandidate(input.CandidateB.Result))
+	if err != nil {
+		return nil, fmt.Errorf("序列化候选人B失败: %w", err)
+	}
+
+	return map[string]any{
+		"job_profile": string(job),
+		"candidate_a": string(candidateA),
+		"candidate_b": string(candidateB),
+	}, nil
+}
+
+// buildJobProfile 提取岗位要求摘要
+func buildJobProfile(detail *domain.JobProfileDetail) *llmJobProfile {
+	job := &llmJobProfile{Name: detail.JobProfile.Name}
+	if detail.JobProfile.Description != nil {
+		job.Description = *detail.JobProfile.Description
+	}
+	for _, resp := range detail.Responsibilities {
+		if resp != nil {
+			job.Responsibilities = append(job.Responsibilities, resp.Responsibility)
+		}
+	}
+	for _, skill := range detail.Skills {
+		if skill == nil {
+			continue
+		}
+		if skill.Type == string(consts.JobSkillTypeRequired) {
+			job.RequiredSkills = append(job.RequiredSkills, skill.Skill)
+		} else {
+			job.BonusSkills = append(job.BonusSkills, skill.Skill)
+		}
+	}
+	for _, exp := range detail.ExperienceRequirements {
+		if exp == nil {
+			continue
+		}
+		text := exp.ExperienceType
+		if exp.MinYears > 0 {
+			text = fmt.Sprintf("%s（最少%d年）", text, exp.MinYears)
+		}
+		job.ExperienceRequirements = append(job.ExperienceRequirements, text)
+	}
+	for _, edu := range detail.EducationRequirements {
+		if edu != nil {
+			job.EducationRequirements = append(job.EducationRequirements, edu.EducationType)
+		}
+	}
+	for _, industry := range detail.IndustryRequirements {
+		if industry == nil {
+			continue
+		}
+		text := industry.Industry
+		if industry.CompanyName != nil && *industry.CompanyName != "" {
+			text = fmt.Sprintf("%s（%s）", text, *industry.CompanyName)
+		}
+		job.IndustryRequirements = append(job.IndustryRequirements, text)
+	}
+	return job
+}
+
+// buildCandidate 从筛选结果中提取各维度的得分、优势与差距
+func buildCandidate(result *domain.ScreeningResult) *llmCandidate {
+	candidate := &llmCandidate{
+		OverallScore:    result.OverallScore,
+		MatchLevel:      result.MatchLevel,
+		Dimensions:      make(map[string]*llmDimension),
+		Recommendations: result.Recommendations,
+	}
+	if result.Override != nil {
+		candidate.HumanOverallScore = result.Override.OverallScore
+	}
+
+	if d := result.SkillDetail; d != nil {
+		dim := &llmDimension{Score: d.Score}
+		for _, skill := range d.MissingSkills {
+			if skill != nil {
+				dim.Gaps = append(dim.Gaps, "缺少技能："+skill.Skill)
+			}
+		}
+		if d.LLMAnalysis != nil {
+			dim.Highlights = append(dim.Highlights, d.LLMAnalysis.StrengthAreas...)
+			dim.Gaps = append(dim.Gaps, d.LLMAnalysis.GapAreas...)
+		}
+		candidate.Dimensions["skill"] = dim
+	}
+	if d := result.Responsibility; d != nil {
+		dim := &llmDimension{Score: d.Score}
+		for _, matched := range d.MatchedResponsibilities {
+			if matched != nil && matched.MatchReason != "" {
+				dim.Highlights = append(dim.Highlights, matched.MatchReason)
+			}
+		}
+		for _, unmatched := range d.UnmatchedResponsibilities {
+			if unmatched != nil {
+				dim.Gaps = append(dim.Gaps, "未覆盖职责："+unmatched.Responsibility)
+			}
+		}
+		candidate.Dimensions["responsibility"] = dim
+	}
+	if d := result.ExperienceDetail; d != nil {
+		candidate.Dimensions["experience"] = &llmDimension{Score: d.Score, Analysis: d.OverallAnalysis}
+	}
+	if d := result.EducationDetail; d != nil {
+		candidate.Dimensions["education"] = &llmDimension{Score: d.Score, Analysis: d.OverallAnalysis}
+	}
+	if d := result.IndustryDetail; d != nil {
+		candidate.Dimensions["industry"] = &llmDimension{Score: d.Score, Analysis: d.OverallAnalysis}
+	}
+	if d := result.BasicDetail; d != nil {
+		candidate.Dimensions["basic"] = &llmDimension{Score: d.Score, Highlights: d.Evidence, Analysis: d.Notes}
+	}
+	// 岗位自定义维度，带上维度名称便于模型理解
+	for key, d := range result.CustomDetails {
+		if d == nil {
+			continue
+		}
+		candidate.Dimensions[key] = &llmDimension{
+			Name:       d.Name,
+			Score:      d.Score,
+			Highlights: d.Evidence,
+			Gaps:       d.Gaps,
+			Analysis:   d.Analysis,
+		}
+	}
+	return candidate
+}
+
+// newOutputLambda 解析模型输出为比较结果
+func newOutputLambda(ctx context.Context, msg *schema.Message, opts ...any) (*domain.PairwiseCompareResult, error) {
+	if msg == nil || msg.Content == "" {
+		return nil, fmt.Errorf("模型输出为空")
+	}
+
+	var output llmCompareOutput
+	if err := json.Unmarshal([]byte(msg.Content), &output); err != nil {
+		return nil, fmt.Errorf("解析模型输出失败: %w; raw=%s", err, msg.Content)
+	}
+
+	winner := strings.ToUpper(strings.TrimSpace(output.Winner))
+	if winner != domain.PairwiseCandidateA && winner != domain.PairwiseCandidateB {
+		return nil, fmt.Errorf("比较结果无效，winner 必须为 A 或 B; raw=%s", msg.Content)
+	}
+
+	confidence := output.Confidence
+	if confidence < 0 {
+		confidence = 0
+	}
+	if confidence > 1 {
+		confidence = 1
+	}
+
+	return &domain.PairwiseCompareResult{
+		Winner:     winner,
+		Confidence: confidence,
+		Reason:     strings.TrimSpace(output.Reason),
+	}, nil
+}
+
+// NewPairwiseCompareAgent 创建候选人两两比较 Agent
+func NewPairwiseCompareAgent(ctx context.Context, llm model.ToolCallingChatModel) (*PairwiseCompareAgent, error) {
+	chatTemplate, err := NewPairwiseCompareChatTemplate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("创建两两比较模板失败: %w", err)
+	}
+
+	chain := compose.NewChain[*domain.PairwiseCompareInput, *domain.PairwiseCompareResult]()
+	chain.
+		AppendLambda(compose.InvokableLambdaWithOption(newInputLambda), compose.WithNodeName("input_processing")).
+		AppendChatTemplate(chatTemplate, compose.WithNodeName("chat_template")).
+		AppendChatModel(llm, compose.WithNodeName("chat_model"), compose.WithNodeKey("chat_model")).
+		AppendLambda(compose.InvokableLambdaWithOption(newOutputLambda), compose.WithNodeName("output_processing"))
+
+	return &PairwiseCompareAgent{chain: chain}, nil
+}
+
+// GetChain 返回 Agent 处理链
+func (a *PairwiseCompareAgent) GetChain() *compose.Chain[*domain.PairwiseCompareInput, *domain.PairwiseCompareResult] {
+	return a.chain
+}
+
+// Compile 编译链为 Runnable
+func (a *PairwiseCompareAgent) Compile(ctx context.Context) (compose.Runnable[*domain.PairwiseCompareInput, *domain.PairwiseCompareResult], error) {
+	runnable, err := a.chain.Compile(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("编译两两比较链失败: %w", err)
+	}
+	return runnable, nil
+}
+
+// GetVersion 返回 Agent 版本
+func (a *PairwiseCompareAgent) GetVersion() string {
+	return "1.0.0"
+}
@@ -0,0 +1,31 @@
+package shortlist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+func TestBuildCandidateIncludesCustomDimensions(t *testing.T) {
+	candidate := buildCandidate(&domain.ScreeningResult{
+		OverallScore: 80,
+		SkillDetail:  &domain.SkillMatchDetail{Score: 85},
+		CustomDetails: map[string]*domain.CustomMatchDetail{
+			"open_source": {Key: "open_source", Name: "开源贡献", Score: 70, Evidence: []string{"维护 2 个开源项目"}, Gaps: []string{"缺少社区影响力"}},
+			"empty":       nil,
+		},
+	})
+
+	require.Contains(t, candidate.Dimensions, "skill")
+	require.Contains(t, candidate.Dimensions, "open_source")
+	assert.NotContains(t, candidate.Dimensions, "empty")
+
+	dim := candidate.Dimensions["open_source"]
+	assert.Equal(t, "开源贡献", dim.Name)
+	assert.Equal(t, 70.0, dim.Score)
+	assert.Equal(t, []string{"维护 2 个开源项目"}, dim.Highlights)
+	assert.Equal(t, []string{"缺少社区影响力"}, dim.Gaps)
+}
@@ -0,0 +1,64 @@
+package shortlist
+
+import (
+	"context"
+
+	"github.com/cloudwego/eino/components/prompt"
+	"github.com/cloudwego/eino/schema"
+)
+
+// PairwiseCompareSystemPrompt 候选人两两比较系统提示词
+const PairwiseCompareSystemPrompt = `你是一名资深招聘评审专家，需要在两位候选人之间判断谁更适合目标岗位，用于生成最终面试短名单。
+
+### 评估方式
+1. 以岗位职责和必需技能为首要依据，其次是工作经验、教育背景、行业背景和基础条件。
+2. 系统给出的综合得分和维度得分仅供参考，请结合各维度的优势、短板和风险做整体判断，不要简单比较分数高低。
+3. 若存在人工校准分数（human_overall_score），优先参考人工校准结果。
+4. 候选人以 A、B 标识，出现顺序不代表优劣，请避免位置偏好。
+5. 候选人信息已隐去个人身份，不得基于性别、年龄、姓名等个人特征做判断。
+
+### 输出要求
+- winner：更适合岗位的候选人，只能是 "A" 或 "B"，不允许平局
+- confidence：判断置信度，0~1 之间，两人差距很小时应低于 0.6
+- reason：1-2 句中文说明胜出方的关键优势及落败方的主要差距，引用具体维度证据
+
+输出必须是**严格合法的 JSON**，不能包含额外说明、注释或 Markdown。`
+
+// PairwiseCompareUserPrompt 候选人两两比较用户提示词
+const PairwiseCompareUserPrompt = `请比较以下两位候选人与岗位的匹配程度。
+
+## 岗位要求(JSON)
+{{.job_profile}}
+
+## 候选人 A(JSON)
+{{.candidate_a}}
+
+## 候选人 B(JSON)
+{{.candidate_b}}
+
+## 输出格式
+{
+  "winner": "A",
+  "confidence": 0.00,
+  "reason": "说明（中文）"
+}
+`
+
+// NewPairwiseCompareChatTemplate 创建两两比较聊天模板
+func NewPairwiseCompareChatTemplate(ctx context.Context) (prompt.ChatTemplate, error) {
+	config := &ChatTemplateConfig{
+		FormatType: schema.GoTemplate,
+		Templates: []schema.MessagesTemplate{
+			schema.SystemMessage(PairwiseCompareSystemPrompt),
+			schema.UserMessage(PairwiseCompareUserPrompt),
+		},
+	}
+	ctp := prompt.FromMessages(config.FormatType, config.Templates...)
+	return ctp, nil
+}
+
+// ChatTemplateConfig 聊天模板配置
+type ChatTemplateConfig struct {
+	FormatType schema.FormatType
+	Templates  []schema.MessagesTemplate
+}
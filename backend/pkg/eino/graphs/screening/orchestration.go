@@ -3,11 +3,13 @@ package screening
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/chaitin/WhaleHire/backend/config"
 	"github.com/chaitin/WhaleHire/backend/domain"
 	"github.com/chaitin/WhaleHire/backend/pkg/eino/graphs/screening/matching/aggregator"
 	"github.com/chaitin/WhaleHire/backend/pkg/eino/graphs/screening/matching/basicinfo"
+	"github.com/chaitin/WhaleHire/backend/pkg/eino/graphs/screening/matching/custom"
 	"github.com/chaitin/WhaleHire/backend/pkg/eino/graphs/screening/matching/dispatcher"
 	"github.com/chaitin/WhaleHire/backend/pkg/eino/graphs/screening/matching/education"
 	"github.com/chaitin/WhaleHire/backend/pkg/eino/graphs/screening/matching/experience"
@@ -32,6 +34,7 @@ type ScreeningChatGraph struct {
 	aggregatorAgent     *aggregator.AggregatorAgent
 	taskMetaProcessor   *taskmeta.TaskMetaProcessor
 	dispatcher          *dispatcher.Dispatcher
+	customAgents        map[string]*custom.CustomDimensionAgent // 按节点键索引的自定义维度Agent
 }
 
 // GetGraph 获取图结构
@@ -84,6 +87,9 @@ func (r *ScreeningChatGraph) GetSubAgentVersions() map[string]string {
 	if r.dispatcher != nil {
 		versions[domain.DispatcherNode] = r.dispatcher.GetVersion()
 	}
+	for nodeKey, agent := range r.customAgents {
+		versions[nodeKey] = agent.GetVersion()
+	}
 
 	return versions
 }
@@ -127,12 +133,34 @@ func (r *ScreeningChatGraph) GetAgentVersion(nodeKey string) string {
 		if r.dispatcher != nil {
 			return r.dispatcher.GetVersion()
 		}
+	default:
+		if agent, ok := r.customAgents[nodeKey]; ok {
+			return agent.GetVersion()
+		}
 	}
 	return ""
 }
 
-// NewScreeningChatGraph 使用配置创建智能简历匹配图
-func NewScreeningChatGraph(ctx context.Context, chatModel model.ToolCallingChatModel, cfg *config.Config) (*ScreeningChatGraph, error) {
+// CustomNodeKeys 返回图中自定义维度节点的键
+func (r *ScreeningChatGraph) CustomNodeKeys() []string {
+	keys := make([]string, 0, len(r.customAgents))
+	for nodeKey := range r.customAgents {
+		keys = append(keys, nodeKey)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// passReusedResults 复用节点处理函数，输出按节点键索引的历史维度结果
+func passReusedResults(_ context.Context, reused map[string]any) (map[string]any, error) {
+	if reused == nil {
+		return map[string]any{}, nil
+	}
+	return reused, nil
+}
+
+// NewScreeningChatGraph 使用配置创建智能简历匹配图，岗位自定义维度作为额外分支并行执行后交由聚合节点合并
+func NewScreeningChatGraph(ctx context.Context, chatModel model.ToolCallingChatModel, cfg *config.Config, customDimensions ...*domain.CustomDimension) (*ScreeningChatGraph, error) {
 
 	g := compose.NewGraph[*domain.MatchInput, *domain.JobResumeMatch]()
 
@@ -176,6 +204,18 @@ func NewScreeningChatGraph(ctx context.Context, chatModel model.ToolCallingChatM
 		return nil, fmt.Errorf("failed to create task meta processor")
 	}
 
+	customAgents := make(map[string]*custom.CustomDimensionAgent, len(customDimensions))
+	for _, dim := range customDimensions {
+		if dim == nil {
+			continue
+		}
+		agent, err := custom.NewCustomDimensionAgent(ctx, chatModel, dim)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create custom dimension agent %s: %w", dim.Key, err)
+		}
+		customAgents[agent.GetNodeKey()] = agent
+	}
+
 	// 添加调度节点
 	_ = g.AddLambdaNode(domain.DispatcherNode, compose.InvokableLambda(dispatcher.Process), compose.WithNodeName(domain.DispatcherNode))
 
@@ -210,6 +250,13 @@ func NewScreeningChatGraph(ctx context.Context, chatModel model.ToolCallingChatM
 		compose.WithOutputKey(domain.SkillAgent),
 		compose.WithNodeName(domain.SkillAgent))
 
+	for nodeKey, agent := range customAgents {
+		_ = g.AddGraphNode(nodeKey, agent.GetChain(),
+			compose.WithInputKey(nodeKey),
+			compose.WithOutputKey(nodeKey),
+			compose.WithNodeName(nodeKey))
+	}
+
 	_ = g.AddLambdaNode(domain.TaskMetaDataNode, compose.InvokableLambda(taskMetaProcessor.ProcessTaskMeta),
 		compose.WithInputKey(domain.TaskMetaDataNode),
 		compose.WithOutputKey(domain.TaskMetaDataNode),
@@ -218,22 +265,44 @@ func NewScreeningChatGraph(ctx context.Context, chatModel model.ToolCallingChatM
 	_ = g.AddGraphNode(domain.AggregatorAgent, aggregatorAgent.GetChain(),
 		compose.WithNodeName(domain.AggregatorAgent))
 
+	// 复用节点将历史维度结果原样交给聚合节点，与各 Agent 输出合并
+	_ = g.AddLambdaNode(domain.ReusedResultsNode, compose.InvokableLambda(passReusedResults),
+		compose.WithInputKey(domain.ReusedResultsNode),
+		compose.WithNodeName(domain.ReusedResultsNode))
+
+	branchNodes := []string{
+		domain.BasicInfoAgent,
+		domain.EducationAgent,
+		domain.ExperienceAgent,
+		domain.IndustryAgent,
+		domain.ResponsibilityAgent,
+		domain.SkillAgent,
+		domain.TaskMetaDataNode,
+		domain.ReusedResultsNode,
+	}
+	for nodeKey := range customAgents {
+		branchNodes = append(branchNodes, nodeKey)
+	}
+	endNodes := make(map[string]bool, len(branchNodes))
+	for _, nodeKey := range branchNodes {
+		endNodes[nodeKey] = true
+	}
+
 	_ = g.AddEdge(compose.START, domain.DispatcherNode)
-	_ = g.AddEdge(domain.DispatcherNode, domain.BasicInfoAgent)
-	_ = g.AddEdge(domain.DispatcherNode, domain.EducationAgent)
-	_ = g.AddEdge(domain.DispatcherNode, domain.ExperienceAgent)
-	_ = g.AddEdge(domain.DispatcherNode, domain.IndustryAgent)
-	_ = g.AddEdge(domain.DispatcherNode, domain.ResponsibilityAgent)
-	_ = g.AddEdge(domain.DispatcherNode, domain.SkillAgent)
-	_ = g.AddEdge(domain.DispatcherNode, domain.TaskMetaDataNode)
-
-	_ = g.AddEdge(domain.BasicInfoAgent, domain.AggregatorAgent)
-	_ = g.AddEdge(domain.EducationAgent, domain.AggregatorAgent)
-	_ = g.AddEdge(domain.ExperienceAgent, domain.AggregatorAgent)
-	_ = g.AddEdge(domain.IndustryAgent, domain.AggregatorAgent)
-	_ = g.AddEdge(domain.ResponsibilityAgent, domain.AggregatorAgent)
-	_ = g.AddEdge(domain.SkillAgent, domain.AggregatorAgent)
-	_ = g.AddEdge(domain.TaskMetaDataNode, domain.AggregatorAgent)
+	// 调度节点只分发到本次需要执行的节点，已复用结果的 Agent 不再运行
+	_ = g.AddBranch(domain.DispatcherNode, compose.NewGraphMultiBranch(func(_ context.Context, data map[string]any) (map[string]bool, error) {
+		selected := make(map[string]bool, len(data))
+		for nodeKey := range data {
+			if endNodes[nodeKey] {
+				selected[nodeKey] = true
+			}
+		}
+		return selected, nil
+	}, endNodes))
+
+	for _, nodeKey := range branchNodes {
+		_ = g.AddEdge(nodeKey, domain.AggregatorAgent)
+	}
 
 	_ = g.AddEdge(domain.AggregatorAgent, compose.END)
 
@@ -249,5 +318,6 @@ func NewScreeningChatGraph(ctx context.Context, chatModel model.ToolCallingChatM
 		aggregatorAgent:     aggregatorAgent,
 		taskMetaProcessor:   taskMetaProcessor,
 		dispatcher:          dispatcher,
+		customAgents:        customAgents,
 	}, nil
 }
@@ -0,0 +1,67 @@
+package screening
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chaitin/WhaleHire/backend/config"
+	"github.com/chaitin/WhaleHire/backend/domain"
+)
+
+// fakeChatModel 统计调用次数并返回固定的聚合建议
+type fakeChatModel struct {
+	calls atomic.Int32
+}
+
+func (m *fakeChatModel) Generate(context.Context, []*schema.Message, ...model.Option) (*schema.Message, error) {
+	m.calls.Add(1)
+	return schema.AssistantMessage(`{"recommendations":["建议安排技术面试"]}`, nil), nil
+}
+
+func (m *fakeChatModel) Stream(context.Context, []*schema.Message, ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	return nil, nil
+}
+
+func (m *fakeChatModel) WithTools([]*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return m, nil
+}
+
+func TestReusedResultsSkipAgents(t *testing.T) {
+	ctx := context.Background()
+	chatModel := &fakeChatModel{}
+	graph, err := NewScreeningChatGraph(ctx, chatModel, &config.Config{})
+	require.NoError(t, err)
+	runnable, err := graph.Compile(ctx)
+	require.NoError(t, err)
+
+	weights := domain.DefaultDimensionWeights
+	input := &domain.MatchInput{
+		JobProfile:       &domain.JobProfileDetail{JobProfile: &domain.JobProfile{ID: "job-1"}},
+		Resume:           &domain.ResumeDetail{Resume: &domain.Resume{ID: "resume-1"}},
+		DimensionWeights: &weights,
+		MatchTaskID:      "task-1:resume-1",
+		ReusedResults: map[string]any{
+			domain.BasicInfoAgent:      &domain.BasicMatchDetail{Score: 80},
+			domain.EducationAgent:      &domain.EducationMatchDetail{Score: 80},
+			domain.ExperienceAgent:     &domain.ExperienceMatchDetail{Score: 80},
+			domain.IndustryAgent:       &domain.IndustryMatchDetail{Score: 80},
+			domain.ResponsibilityAgent: &domain.ResponsibilityMatchDetail{Score: 80},
+			domain.SkillAgent:          &domain.SkillMatchDetail{Score: 80},
+		},
+	}
+
+	// 所有维度均复用时只有聚合节点调用模型，综合分与建议按本次结果重新生成
+	match, err := runnable.Invoke(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), chatModel.calls.Load())
+	require.NotNil(t, match.SkillMatch)
+	assert.Equal(t, 80.0, match.SkillMatch.Score)
+	assert.InDelta(t, 80.0, match.OverallScore, 0.01)
+	assert.Equal(t, []string{"建议安排技术面试"}, match.Recommendations)
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ModelPrice 模型单价，单位为每百万 tokens 的费用
+type ModelPrice struct {
+	InputPerMillion  float64 `json:"input_per_million"`
+	OutputPerMillion float64 `json:"output_per_million"`
+}
+
+// PriceTable 模型价格表
+type PriceTable map[ModelKey]ModelPrice
+
+// DefaultPriceTable 内置的常用模型价格（USD / 百万 tokens），可通过配置覆盖
+func DefaultPriceTable() PriceTable {
+	return PriceTable{
+		{Type: ModelTypeOpenAI, Name: "deepseek-chat"}:     {InputPerMillion: 0.27, OutputPerMillion: 1.10},
+		{Type: ModelTypeOpenAI, Name: "deepseek-reasoner"}: {InputPerMillion: 0.55, OutputPerMillion: 2.19},
+		{Type: ModelTypeOpenAI, Name: "gpt-4o"}:            {InputPerMillion: 2.50, OutputPerMillion: 10.00},
+		{Type: ModelTypeOpenAI, Name: "gpt-4o-mini"}:       {InputPerMillion: 0.15, OutputPerMillion: 0.60},
+		{Type: ModelTypeOpenAI, Name: "gpt-4.1"}:           {InputPerMillion: 2.00, OutputPerMillion: 8.00},
+		{Type: ModelTypeOpenAI, Name: "gpt-4.1-mini"}:      {InputPerMillion: 0.40, OutputPerMillion: 1.60},
+	}
+}
+
+// ParsePriceTable 解析配置中的价格表并合并到内置价格之上。
+// 格式为分号分隔的条目，每条为 "类型/模型名=输入单价:输出单价"，类型省略时默认为 openai，
+// 例如 "openai/gpt-4o=2.5:10;qwen-plus=0.4:1.2"
+func ParsePriceTable(spec string) (PriceTable, error) {
+	table := DefaultPriceTable()
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, prices, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid price entry %q: missing '='", entry)
+		}
+		key := ModelKey{Type: ModelTypeOpenAI, Name: strings.TrimSpace(name)}
+		if modelType, modelName, ok := strings.Cut(key.Name, "/"); ok {
+			key = ModelKey{Type: ModelType(strings.TrimSpace(modelType)), Name: strings.TrimSpace(modelName)}
+		}
+		if key.Name == "" {
+			return nil, fmt.Errorf("invalid price entry %q: empty model name", entry)
+		}
+
+		inputStr, outputStr, ok := strings.Cut(prices, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid price entry %q: expected input:output", entry)
+		}
+		input, err := strconv.ParseFloat(strings.TrimSpace(inputStr), 64)
+		if err != nil || input < 0 {
+			return nil, fmt.Errorf("invalid input price in %q", entry)
+		}
+		output, err := strconv.ParseFloat(strings.TrimSpace(outputStr), 64)
+		if err != nil || output < 0 {
+			return nil, fmt.Errorf("invalid output price in %q", entry)
+		}
+		table[key] = ModelPrice{InputPerMillion: input, OutputPerMillion: output}
+	}
+	return table, nil
+}
+
+// Lookup 查询模型单价。未精确匹配时按最长前缀匹配带版本后缀的模型名，
+// 例如 "gpt-4o-mini-2024-07-18" 使用 "gpt-4o-mini" 的价格
+func (t PriceTable) Lookup(key ModelKey) (ModelPrice, bool) {
+	if price, ok := t[key]; ok {
+		return price, true
+	}
+
+	var (
+		matched ModelPrice
+		longest int
+	)
+	for k, price := range t {
+		if k.Type != key.Type || len(k.Name) <= longest {
+			continue
+		}
+		if strings.HasPrefix(key.Name, k.Name+"-") {
+			matched, longest = price, len(k.Name)
+		}
+	}
+	return matched, longest > 0
+}
+
+// Cost 按模型单价计算一次调用的费用，未配置价格的模型返回 0，调用方需通过 Lookup 判断价格是否已知
+func (t PriceTable) Cost(key ModelKey, inputTokens, outputTokens int64) float64 {
+	price, ok := t.Lookup(key)
+	if !ok {
+		return 0
+	}
+	return (float64(inputTokens)*price.InputPerMillion + float64(outputTokens)*price.OutputPerMillion) / 1_000_000
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePriceTable(t *testing.T) {
+	table, err := ParsePriceTable(" openai/gpt-4o=3:12 ; qwen-plus=0.4:1.2;custom/local-llm=0:0 ")
+	require.NoError(t, err)
+
+	// 配置覆盖内置价格，省略类型时默认为 openai
+	assert.Equal(t, ModelPrice{InputPerMillion: 3, OutputPerMillion: 12}, table[ModelKey{Type: ModelTypeOpenAI, Name: "gpt-4o"}])
+	assert.Equal(t, ModelPrice{InputPerMillion: 0.4, OutputPerMillion: 1.2}, table[ModelKey{Type: ModelTypeOpenAI, Name: "qwen-plus"}])
+	assert.Contains(t, table, ModelKey{Type: "custom", Name: "local-llm"})
+	// 未覆盖的内置价格保留
+	assert.Contains(t, table, ModelKey{Type: ModelTypeOpenAI, Name: "deepseek-chat"})
+
+	empty, err := ParsePriceTable("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultPriceTable(), empty)
+
+	for _, spec := range []string{"gpt-4o", "=1:2", "gpt-4o=1", "gpt-4o=a:2", "gpt-4o=1:-2"} {
+		_, err := ParsePriceTable(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestPriceTableCost(t *testing.T) {
+	table := DefaultPriceTable()
+
+	cost := table.Cost(ModelKey{Type: ModelTypeOpenAI, Name: "gpt-4o"}, 1_000_000, 500_000)
+	assert.InDelta(t, 2.5+5.0, cost, 1e-9)
+
+	// 带日期后缀的模型按最长前缀匹配，gpt-4o-mini 优先于 gpt-4o
+	dated := ModelKey{Type: ModelTypeOpenAI, Name: "gpt-4o-mini-2024-07-18"}
+	price, ok := table.Lookup(dated)
+	require.True(t, ok)
+	assert.Equal(t, table[ModelKey{Type: ModelTypeOpenAI, Name: "gpt-4o-mini"}], price)
+	assert.InDelta(t, 0.15, table.Cost(dated, 1_000_000, 0), 1e-9)
+
+	// 未配置价格的模型费用为 0，且 Lookup 返回未找到
+	unknown := ModelKey{Type: ModelTypeOpenAI, Name: "qwen-plus"}
+	_, ok = table.Lookup(unknown)
+	assert.False(t, ok)
+	assert.Zero(t, table.Cost(unknown, 1000, 1000))
+
+	// 前缀匹配要求以连字符分隔，且类型一致
+	_, ok = table.Lookup(ModelKey{Type: ModelTypeOpenAI, Name: "gpt-4omni"})
+	assert.False(t, ok)
+	_, ok = table.Lookup(ModelKey{Type: "custom", Name: "gpt-4o"})
+	assert.False(t, ok)
+}